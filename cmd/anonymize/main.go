@@ -0,0 +1,116 @@
+// Package main copies the production database into a staging database with
+// personal data anonymized, for realistic load testing without leaking PII.
+//
+// The source is the database the service is configured with (MONGODB_URI and
+// MONGODB_DATABASE). The target database is dropped collection by collection
+// and replaced, so it must never be the source.
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/alejaam/tourney-rank/internal/config"
+	"github.com/alejaam/tourney-rank/internal/infra/mongodb"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// unusablePasswordHash is stored for every user when no staging password is
+// given; it is not a bcrypt hash, so no password matches it.
+const unusablePasswordHash = "!"
+
+func main() {
+	if err := run(); err != nil {
+		slog.Error("anonymize failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	targetURI := flag.String("target-uri", os.Getenv("ANONYMIZE_TARGET_URI"), "MongoDB URI of the staging deployment (defaults to the source URI)")
+	targetDB := flag.String("target-db", os.Getenv("ANONYMIZE_TARGET_DATABASE"), "staging database to replace")
+	password := flag.String("password", os.Getenv("ANONYMIZE_PASSWORD"), "password every staging user can log in with; empty disables logins")
+	key := flag.String("key", os.Getenv("ANONYMIZE_KEY"), "secret keying the replacements; random when empty, so runs differ")
+	flag.Parse()
+
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, nil))
+	slog.SetDefault(logger)
+
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+
+	if *targetDB == "" {
+		return errors.New("target database is required")
+	}
+	if *targetURI == "" {
+		*targetURI = cfg.MongoDBURI
+	}
+	if *targetURI == cfg.MongoDBURI && *targetDB == cfg.MongoDBDatabase {
+		return errors.New("target database must differ from the source")
+	}
+
+	secret := []byte(*key)
+	if len(secret) == 0 {
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			return fmt.Errorf("generate key: %w", err)
+		}
+	}
+
+	passwordHash := unusablePasswordHash
+	if *password != "" {
+		hash, err := bcrypt.GenerateFromPassword([]byte(*password), bcrypt.DefaultCost)
+		if err != nil {
+			return fmt.Errorf("hash password: %w", err)
+		}
+		passwordHash = string(hash)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	source, err := mongodb.NewClient(ctx, mongodb.Config{
+		URI:          cfg.MongoDBURI,
+		DatabaseName: cfg.MongoDBDatabase,
+	}, logger)
+	if err != nil {
+		return fmt.Errorf("connect to source: %w", err)
+	}
+	defer source.Close(context.Background())
+
+	target, err := mongodb.NewClient(ctx, mongodb.Config{
+		URI:          *targetURI,
+		DatabaseName: *targetDB,
+	}, logger)
+	if err != nil {
+		return fmt.Errorf("connect to target: %w", err)
+	}
+	defer target.Close(context.Background())
+
+	anonymizer := mongodb.NewAnonymizer(source.Database(), target.Database(), secret, passwordHash, logger)
+	result, err := anonymizer.Run(ctx)
+	if err != nil {
+		return err
+	}
+
+	var total int64
+	for _, n := range result.Copied {
+		total += n
+	}
+	logger.Info("anonymized copy complete",
+		"target_database", *targetDB,
+		"collections", len(result.Copied),
+		"documents", total,
+		"skipped", result.Skipped,
+	)
+	return nil
+}
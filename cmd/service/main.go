@@ -11,15 +11,45 @@ import (
 	"time"
 
 	"github.com/alejaam/tourney-rank/internal/config"
+	"github.com/alejaam/tourney-rank/internal/domain/developer"
+	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/matchmaking"
+	playerdomain "github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/ranking"
+	tournamentdomain "github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/domain/user"
+	"github.com/alejaam/tourney-rank/internal/infra/captcha"
+	"github.com/alejaam/tourney-rank/internal/infra/chaos"
+	"github.com/alejaam/tourney-rank/internal/infra/evidence"
 	httpserver "github.com/alejaam/tourney-rank/internal/infra/http"
 	"github.com/alejaam/tourney-rank/internal/infra/http/handlers"
+	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
+	"github.com/alejaam/tourney-rank/internal/infra/leaderboardsync"
+	"github.com/alejaam/tourney-rank/internal/infra/logging"
+	"github.com/alejaam/tourney-rank/internal/infra/mail"
 	"github.com/alejaam/tourney-rank/internal/infra/mongodb"
+	"github.com/alejaam/tourney-rank/internal/infra/objectstore"
+	"github.com/alejaam/tourney-rank/internal/infra/pwned"
+	"github.com/alejaam/tourney-rank/internal/infra/websocket"
+	activityusecase "github.com/alejaam/tourney-rank/internal/usecase/activity"
 	"github.com/alejaam/tourney-rank/internal/usecase/admin"
+	anomalyusecase "github.com/alejaam/tourney-rank/internal/usecase/anomaly"
 	"github.com/alejaam/tourney-rank/internal/usecase/auth"
+	captureusecase "github.com/alejaam/tourney-rank/internal/usecase/capture"
+	conductusecase "github.com/alejaam/tourney-rank/internal/usecase/conduct"
+	developerusecase "github.com/alejaam/tourney-rank/internal/usecase/developer"
+	integrityusecase "github.com/alejaam/tourney-rank/internal/usecase/integrity"
+	ladderusecase "github.com/alejaam/tourney-rank/internal/usecase/ladder"
 	leaderboardusecase "github.com/alejaam/tourney-rank/internal/usecase/leaderboard"
 	matchusecase "github.com/alejaam/tourney-rank/internal/usecase/match"
+	matchmakingusecase "github.com/alejaam/tourney-rank/internal/usecase/matchmaking"
+	mirrorusecase "github.com/alejaam/tourney-rank/internal/usecase/mirror"
+	organizationusecase "github.com/alejaam/tourney-rank/internal/usecase/organization"
 	playerusecase "github.com/alejaam/tourney-rank/internal/usecase/player"
+	seasonusecase "github.com/alejaam/tourney-rank/internal/usecase/season"
+	statsusecase "github.com/alejaam/tourney-rank/internal/usecase/stats"
 	teamusecase "github.com/alejaam/tourney-rank/internal/usecase/team"
+	tierusecase "github.com/alejaam/tourney-rank/internal/usecase/tier"
 	tournamentusecase "github.com/alejaam/tourney-rank/internal/usecase/tournament"
 	userusecase "github.com/alejaam/tourney-rank/internal/usecase/user"
 )
@@ -52,6 +82,12 @@ func run() error {
 		return err
 	}
 
+	// Scrub personal data and secrets from everything logged from here on
+	if redact := logging.ParseCategories(cfg.LogRedact, cfg.LogRedactTerms); redact.Enabled() {
+		logger = slog.New(logging.NewHandler(logger.Handler(), redact))
+		slog.SetDefault(logger)
+	}
+
 	logger.Info("TourneyRank starting",
 		"version", Version,
 		"environment", cfg.Environment,
@@ -66,10 +102,25 @@ func run() error {
 	sigChan := make(chan os.Signal, 1)
 	signal.Notify(sigChan, os.Interrupt, syscall.SIGTERM)
 
+	// Trip a circuit breaker on consecutive failed or slow MongoDB commands when configured
+	var mongoBreaker *mongodb.Breaker
+	if cfg.MongoBreakerFailures > 0 {
+		mongoBreaker = mongodb.NewBreaker(cfg.MongoBreakerFailures, cfg.MongoBreakerCooldown, cfg.MongoBreakerSlowCommand)
+	}
+
 	// Initialize MongoDB connection
 	mongoClient, err := mongodb.NewClient(ctx, mongodb.Config{
-		URI:          cfg.MongoDBURI,
-		DatabaseName: cfg.MongoDBDatabase,
+		URI:                cfg.MongoDBURI,
+		DatabaseName:       cfg.MongoDBDatabase,
+		Breaker:            mongoBreaker,
+		OperationTimeout:   cfg.MongoDBQueryTimeout,
+		SlowQueryThreshold: cfg.MongoDBSlowQueryAbove,
+		MinPoolSize:        uint64(cfg.MongoDBMinPoolSize),
+		MaxPoolSize:        uint64(cfg.MongoDBMaxPoolSize),
+		MaxConnIdleTime:    cfg.MongoDBMaxConnIdleTime,
+		Region:             cfg.Region,
+		ReadTags:           cfg.MongoDBReadTags,
+		ReadMaxStaleness:   cfg.MongoDBReadMaxStaleness,
 	}, logger)
 	if err != nil {
 		return fmt.Errorf("connect to mongodb: %w", err)
@@ -77,60 +128,358 @@ func run() error {
 	defer mongoClient.Close(ctx)
 
 	// Initialize repositories
-	gameRepo := mongodb.NewGameRepository(mongoClient)
-	playerRepo := mongodb.NewPlayerRepository(mongoClient)
+	gameStore := mongodb.NewGameRepository(mongoClient)
+	playerStore := mongodb.NewPlayerRepository(mongoClient)
 	playerStatsRepo := mongodb.NewPlayerStatsRepository(mongoClient)
 	userRepo := mongodb.NewUserRepository(mongoClient)
-	tournamentRepo := mongodb.NewTournamentRepository(mongoClient.Database())
-	teamRepo := mongodb.NewTeamRepository(mongoClient.Database())
-	matchRepo := mongodb.NewMatchRepository(mongoClient.Database())
+	emailChangeRepo := mongodb.NewEmailChangeRepository(mongoClient.Database())
+	consentRepo := mongodb.NewConsentRepository(mongoClient.Database())
+	tournamentStore := mongodb.NewTournamentRepository(mongoClient.Database())
+	tournamentExportRepo := mongodb.NewTournamentExportRepository(mongoClient.Database())
+	tournamentBackupRepo := mongodb.NewTournamentBackupRepository(mongoClient.Database())
+	teamStore := mongodb.NewTeamRepository(mongoClient.Database())
+	teamHistoryRepo := mongodb.NewTeamHistoryRepository(mongoClient.Database())
+	teamBroadcastRepo := mongodb.NewTeamBroadcastRepository(mongoClient.Database())
+	rosterOverlapRepo := mongodb.NewRosterOverlapRepository(mongoClient.Database())
+	honorVoteRepo := mongodb.NewHonorVoteRepository(mongoClient.Database())
+	conductRepo := mongodb.NewConductRepository(mongoClient.Database())
+	joinRequestRepo := mongodb.NewJoinRequestRepository(mongoClient.Database())
+	registrationQueueRepo := mongodb.NewRegistrationQueueRepository(mongoClient.Database())
+	matchStore := mongodb.NewMatchRepository(mongoClient.Database()).WithRegion(cfg.Region)
+	matchCommentRepo := mongodb.NewMatchCommentRepository(mongoClient.Database())
+	connectorRepo := mongodb.NewConnectorRepository(mongoClient.Database())
+	statCorrectionRepo := mongodb.NewStatCorrectionRepository(mongoClient.Database())
+	noteRepo := mongodb.NewNoteRepository(mongoClient.Database())
+	queueRepo := mongodb.NewMatchmakingQueueRepository(mongoClient.Database())
+	lobbyRepo := mongodb.NewMatchmakingLobbyRepository(mongoClient.Database())
+	ladderRepo := mongodb.NewLadderRepository(mongoClient.Database())
+	ladderChallengeRepo := mongodb.NewLadderChallengeRepository(mongoClient.Database())
+	ladderSnapshotRepo := mongodb.NewLadderSnapshotRepository(mongoClient.Database())
+	tierBoundaryRepo := mongodb.NewTierBoundaryRepository(mongoClient.Database())
+	organizationRepo := mongodb.NewOrganizationRepository(mongoClient.Database())
+	ratingHistoryRepo := mongodb.NewRatingHistoryRepository(mongoClient.Database())
+	seasonRewardRepo := mongodb.NewSeasonRewardRepository(mongoClient.Database())
+	seasonRepo := mongodb.NewSeasonRepository(mongoClient.Database())
+	requestCaptureRepo := mongodb.NewRequestCaptureRepository(mongoClient.Database())
+	cohortReportRepo := mongodb.NewCohortReportRepository(mongoClient.Database())
+	integrityReportRepo := mongodb.NewIntegrityReportRepository(mongoClient.Database())
+	leaderboardSyncRepo := mongodb.NewLeaderboardSyncRepository(mongoClient.Database())
+	activityRepo := mongodb.NewActivityRepository(mongoClient.Database())
+	siteActivityRepo := mongodb.NewSiteActivityRepository(mongoClient.Database())
+	developerKeyRepo := mongodb.NewDeveloperKeyRepository(mongoClient.Database())
+	developerUsageRepo := mongodb.NewDeveloperUsageRepository(mongoClient.Database())
 
 	// Ensure database indexes
-	if err := gameRepo.EnsureIndexes(ctx); err != nil {
+	if err := gameStore.EnsureIndexes(ctx); err != nil {
 		logger.Warn("failed to ensure game indexes", "error", err)
 	}
-	if err := playerRepo.EnsureIndexes(ctx); err != nil {
+	if err := playerStore.EnsureIndexes(ctx); err != nil {
 		logger.Warn("failed to ensure player indexes", "error", err)
 	}
 	if err := userRepo.EnsureIndexes(ctx); err != nil {
 		logger.Warn("failed to ensure user indexes", "error", err)
 	}
+	if err := emailChangeRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure email change indexes", "error", err)
+	}
+	if err := consentRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure consent indexes", "error", err)
+	}
 	if err := playerStatsRepo.EnsureIndexes(ctx); err != nil {
 		logger.Warn("failed to ensure player stats indexes", "error", err)
 	}
-	if err := tournamentRepo.EnsureIndexes(ctx); err != nil {
+	if err := tournamentStore.EnsureIndexes(ctx); err != nil {
 		logger.Warn("failed to ensure tournament indexes", "error", err)
 	}
-	if err := teamRepo.EnsureIndexes(ctx); err != nil {
+	if err := tournamentExportRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure tournament export indexes", "error", err)
+	}
+	if err := tournamentBackupRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure tournament backup indexes", "error", err)
+	}
+	if err := teamStore.EnsureIndexes(ctx); err != nil {
 		logger.Warn("failed to ensure team indexes", "error", err)
 	}
-	if err := matchRepo.EnsureIndexes(ctx); err != nil {
+	if err := teamHistoryRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure team history indexes", "error", err)
+	}
+	if err := teamBroadcastRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure team broadcast indexes", "error", err)
+	}
+	if err := developerKeyRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure developer key indexes", "error", err)
+	}
+	if err := developerUsageRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure developer usage indexes", "error", err)
+	}
+	if err := rosterOverlapRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure roster overlap indexes", "error", err)
+	}
+	if err := honorVoteRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure match honor vote indexes", "error", err)
+	}
+	if err := conductRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure conduct record indexes", "error", err)
+	}
+	if err := joinRequestRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure team join request indexes", "error", err)
+	}
+	if err := registrationQueueRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure team registration queue indexes", "error", err)
+	}
+	if err := organizationRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure organization indexes", "error", err)
+	}
+	if err := ladderRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure ladder indexes", "error", err)
+	}
+	if err := ladderChallengeRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure ladder challenge indexes", "error", err)
+	}
+	if err := ladderSnapshotRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure ladder snapshot indexes", "error", err)
+	}
+	if err := matchStore.EnsureIndexes(ctx); err != nil {
 		logger.Warn("failed to ensure match indexes", "error", err)
 	}
+	if err := matchCommentRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure match comment indexes", "error", err)
+	}
+	if err := connectorRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure match connector indexes", "error", err)
+	}
+	if err := statCorrectionRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure stat correction indexes", "error", err)
+	}
+	if err := noteRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure admin note indexes", "error", err)
+	}
+	if err := queueRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure matchmaking queue indexes", "error", err)
+	}
+	if err := lobbyRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure matchmaking lobby indexes", "error", err)
+	}
+	if err := tierBoundaryRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure tier boundary indexes", "error", err)
+	}
+	if err := ratingHistoryRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure rating history indexes", "error", err)
+	}
+	if err := requestCaptureRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure request capture indexes", "error", err)
+	}
+	if err := seasonRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure season indexes", "error", err)
+	}
+	if err := seasonRewardRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure season reward indexes", "error", err)
+	}
+	if err := cohortReportRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure cohort report indexes", "error", err)
+	}
+	if err := integrityReportRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure integrity report indexes", "error", err)
+	}
+	if err := leaderboardSyncRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure leaderboard sync indexes", "error", err)
+	}
+	if err := activityRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure activity event indexes", "error", err)
+	}
+	if err := siteActivityRepo.EnsureIndexes(ctx); err != nil {
+		logger.Warn("failed to ensure site activity collection", "error", err)
+	}
+
+	// Simulate MongoDB failures on the busiest repositories; config refuses
+	// fault injection in production
+	var (
+		gameRepo       chaos.GameStore             = gameStore
+		tournamentRepo tournamentdomain.Repository = tournamentStore
+		teamRepo       chaos.TeamStore             = teamStore
+		matchRepo      chaos.MatchStore            = matchStore
+		playerRepo     playerdomain.Repository     = playerStore
+	)
+	if injector := chaos.NewInjector(cfg.FaultDBLatency, cfg.FaultDBErrorRate); cfg.FaultInjection && injector.Enabled() {
+		logger.Warn("injecting MongoDB faults",
+			"latency", cfg.FaultDBLatency,
+			"error_rate", cfg.FaultDBErrorRate,
+		)
+		gameRepo = chaos.NewGameRepository(gameRepo, injector)
+		tournamentRepo = chaos.NewTournamentRepository(tournamentRepo, injector)
+		teamRepo = chaos.NewTeamRepository(teamRepo, injector)
+		matchRepo = chaos.NewMatchRepository(matchRepo, injector)
+		playerRepo = chaos.NewPlayerRepository(playerRepo, injector)
+	}
+
+	// Initialize mailer; without an SMTP server, mail is only logged
+	var mailer auth.Mailer = mail.NewLogMailer(logger)
+	if cfg.SMTPAddr != "" {
+		mailer = mail.NewSMTPMailer(cfg.SMTPAddr, cfg.SMTPUsername, cfg.SMTPPassword, cfg.MailFrom)
+	}
+
+	// Password rules; breached passwords are only rejected when enabled
+	passwordPolicy := user.PasswordPolicy{
+		MinLength:     cfg.PasswordMinLength,
+		RequireUpper:  cfg.PasswordRequireUpper,
+		RequireLower:  cfg.PasswordRequireLower,
+		RequireDigit:  cfg.PasswordRequireDigit,
+		RequireSymbol: cfg.PasswordRequireSymbol,
+	}
+	var breachChecker auth.BreachChecker
+	if cfg.PasswordBreachCheck {
+		breachChecker = pwned.NewChecker()
+	}
+
+	policyVersions := user.PolicyVersions{Terms: cfg.TermsVersion, Privacy: cfg.PrivacyVersion}
 
 	// Initialize services
-	authService := auth.NewService(userRepo, cfg.JWTSecret, 24*time.Hour)
+	authService := auth.NewService(userRepo, cfg.JWTSecret, 24*time.Hour).
+		WithEmailChange(emailChangeRepo, mailer, cfg.AppBaseURL).
+		WithPasswordPolicy(passwordPolicy, breachChecker).
+		WithConsent(consentRepo, policyVersions).
+		WithPlayers(playerRepo, playerStatsRepo).
+		WithLogger(logger)
+	if cfg.CaptchaProvider != "" {
+		verifier, err := captcha.New(cfg.CaptchaProvider, cfg.CaptchaSecret)
+		if err != nil {
+			return fmt.Errorf("configuring captcha: %w", err)
+		}
+		authService.WithCaptcha(verifier, cfg.CaptchaLoginFailures)
+	}
 	userService := userusecase.NewService(userRepo)
 	playerService := playerusecase.NewService(playerRepo)
-	leaderboardService := leaderboardusecase.NewService(playerStatsRepo, gameRepo)
-	tournamentService := tournamentusecase.NewService(tournamentRepo, teamRepo, gameRepo)
-	teamService := teamusecase.NewService(teamRepo, tournamentRepo, playerRepo)
-	matchService := matchusecase.NewService(matchRepo, teamRepo, tournamentRepo, playerRepo, playerStatsRepo, playerService, nil)
+	leaderboardService := leaderboardusecase.NewService(playerStatsRepo, gameRepo, playerRepo).
+		WithOrphanRepair(playerStatsRepo).
+		WithSeasons(seasonRepo)
+	rankSnapshotWorker := leaderboardusecase.NewWorker(leaderboardService, cfg.RankSnapshotInterval, logger)
+	leaderboardRepairWorker := leaderboardusecase.NewRepairWorker(leaderboardService, cfg.LeaderboardRepairInterval, logger)
+	leaderboardSyncService := leaderboardusecase.NewSyncService(leaderboardSyncRepo, gameRepo, leaderboardService, leaderboardsync.NewPublisher(), logger).
+		WithAlerts(mailer, cfg.LeaderboardSyncAlertEmail)
+	leaderboardSyncWorker := leaderboardusecase.NewSyncWorker(leaderboardSyncService, cfg.LeaderboardSyncInterval, logger)
+	anomalyService := anomalyusecase.NewService(playerRepo, playerStatsRepo, cfg.NewAccountAge)
+	conductService := conductusecase.NewService(conductRepo, playerRepo, playerdomain.ConductPolicy{
+		HalfLife:  cfg.ConductHalfLife,
+		MVPWeight: cfg.SportsmanshipMVPWeight,
+	})
+	organizationService := organizationusecase.NewService(organizationRepo, userRepo, tournamentRepo).
+		WithCircuit(teamRepo, matchRepo)
+	tournamentService := tournamentusecase.NewService(tournamentRepo, teamRepo, gameRepo, playerStatsRepo, matchRepo).
+		WithActivityLog(activityRepo).
+		WithSiteFeed(siteActivityRepo).
+		WithExports(tournamentExportRepo, []byte(cfg.JWTSecret)).
+		WithImports(playerRepo, userRepo).
+		WithRulebooks(mongodb.NewTournamentRulebookRepository(mongoClient.Database())).
+		WithPlayerProfiles(playerRepo).
+		WithRosterOverlaps(rosterOverlapRepo).
+		WithConduct(conductService).
+		WithOrganizations(organizationService)
+	if cfg.BackupStoreURL != "" {
+		backupStore := objectstore.NewHTTPStore(cfg.BackupStoreURL, cfg.BackupStoreToken, 0)
+		tournamentService.WithBackups(mongodb.NewTournamentSnapshotter(mongoClient.Database()), tournamentBackupRepo, backupStore)
+	}
+	tournamentExportWorker := tournamentusecase.NewExportWorker(tournamentService, cfg.TournamentExportInterval, logger)
+	teamService := teamusecase.NewService(teamRepo, tournamentRepo, playerRepo, playerStatsRepo, joinRequestRepo).
+		WithActivityLog(activityRepo).
+		WithHistory(teamHistoryRepo).
+		WithRegistrationQueue(registrationQueueRepo).
+		WithBroadcasts(teamBroadcastRepo).
+		WithConcurrentTournamentLimit(cfg.MaxConcurrentTournaments).
+		WithAnomalies(anomalyService).
+		WithSportsmanship(cfg.SportsmanshipMVPWeight).
+		WithConduct(conductService).
+		WithOrganizations(organizationService).
+		WithTeamCleanup(matchRepo)
+	registrationQueueWorker := teamusecase.NewQueueWorker(teamService, cfg.RegistrationQueueInterval, logger)
+	teamCleanupWorker := teamusecase.NewCleanupWorker(teamService, cfg.TeamCleanupInterval, logger)
+	rankingService := ranking.NewService(ranking.NewWarzoneCalculator(), ranking.NewDefaultCalculator()).
+		WithTierBoundaries(tierBoundaryRepo)
+	matchService := matchusecase.NewService(matchRepo, matchCommentRepo, teamRepo, tournamentRepo, gameRepo, playerRepo, playerStatsRepo, playerService, rankingService).
+		WithActivityLog(activityRepo).
+		WithSiteFeed(siteActivityRepo).
+		WithNotes(noteRepo).
+		WithConnectors(connectorRepo, connectorRepo).
+		WithEvidenceHasher(evidence.NewHasher(matchdomain.MaxScreenshotBytes)).
+		WithRosterOverlaps(rosterOverlapRepo).
+		WithAnomalies(anomalyService).
+		WithHonors(honorVoteRepo).
+		WithConduct(conductService).
+		WithSeasons(seasonRepo).
+		WithCorrections(statCorrectionRepo)
+	activityService := activityusecase.NewService(activityRepo, siteActivityRepo, playerRepo)
+	ladderService := ladderusecase.NewService(ladderRepo, ladderChallengeRepo, ladderSnapshotRepo, tournamentRepo, teamRepo, matchRepo, gameRepo).
+		WithActivityLog(activityRepo).
+		WithConduct(conductService)
+	ladderWorker := ladderusecase.NewWorker(ladderService, cfg.LadderInterval, logger)
+	seasonService := seasonusecase.NewService(seasonRepo, gameRepo, leaderboardService)
+	seasonWorker := seasonusecase.NewWorker(seasonService, cfg.SeasonRolloverInterval, logger)
+
+	// Initialize matchmaking with WebSocket notifications
+	wsHub := websocket.NewHub(logger)
+	matchmakingService := matchmakingusecase.NewService(
+		queueRepo,
+		lobbyRepo,
+		gameRepo,
+		teamRepo,
+		playerStatsRepo,
+		rankingService,
+		wsHub,
+		matchmaking.MatcherConfig{
+			LobbySize:       cfg.MatchmakingLobbySize,
+			MaxRatingSpread: cfg.MatchmakingMaxRatingSpread,
+		},
+	).WithActivityLog(activityRepo).WithSiteFeed(siteActivityRepo).WithTeammateSuggestions(playerRepo)
+	matchmakingWorker := matchmakingusecase.NewWorker(matchmakingService, cfg.MatchmakingInterval, logger)
+
+	// Initialize tier boundaries, recalibration and season rewards
+	tierService := tierusecase.NewService(tierBoundaryRepo, ratingHistoryRepo, playerStatsRepo, gameRepo, rankingService).
+		WithActivityLog(activityRepo).
+		WithSiteFeed(siteActivityRepo).
+		WithRewards(seasonRewardRepo)
+	tierWorker := tierusecase.NewWorker(tierService, cfg.TierRecalibrationInterval, logger)
+
+	// Initialize platform stats overview
+	statsService := statsusecase.NewService(playerRepo, playerStatsRepo, matchRepo, tournamentRepo, gameRepo)
+	statsWorker := statsusecase.NewWorker(statsService, cfg.StatsRefreshInterval, logger)
+	cohortService := statsusecase.NewCohortService(playerRepo, matchRepo, cohortReportRepo)
+	cohortWorker := statsusecase.NewCohortWorker(cohortService, cfg.CohortReportInterval, logger)
+
+	// Initialize data integrity checks
+	integrityService := integrityusecase.NewService(leaderboardService, playerStatsRepo, matchRepo, teamRepo, integrityReportRepo)
+	integrityWorker := integrityusecase.NewWorker(integrityService, cfg.IntegrityCheckInterval, cfg.IntegrityAutoRepair, logger)
 
 	// Initialize admin services
-	adminUserService := admin.NewUserService(userRepo)
-	adminGameService := admin.NewGameService(gameRepo)
-	adminPlayerService := admin.NewPlayerService(playerRepo)
+	adminUserService := admin.NewUserService(userRepo).WithConsent(consentRepo, policyVersions).WithCaptainSuccession(teamService)
+	adminGameService := admin.NewGameService(gameRepo, playerStatsRepo, rankingService)
+	adminPlayerService := admin.NewPlayerService(playerRepo).WithCaptainSuccession(teamService)
+	adminNoteService := admin.NewNoteService(noteRepo, matchRepo, playerRepo, teamRepo)
 
 	// Initialize HTTP handlers
 	gameHandler := handlers.NewGameHandler(gameRepo, logger)
-	leaderboardHandler := handlers.NewLeaderboardHandler(leaderboardService, logger)
+	leaderboardHandler := handlers.NewLeaderboardHandler(leaderboardService, leaderboardSyncService, logger)
 	authHandler := handlers.NewAuthHandler(authService, userService, logger)
-	adminHandler := handlers.NewAdminHandler(adminUserService, adminGameService, adminPlayerService, logger)
-	playerHandler := handlers.NewPlayerHandler(playerService, playerStatsRepo, gameRepo, logger)
+	adminHandler := handlers.NewAdminHandler(adminUserService, adminGameService, adminPlayerService, adminNoteService, logger)
+	playerHandler := handlers.NewPlayerHandler(playerService, playerStatsRepo, gameRepo, matchRepo, logger)
 	tournamentHandler := handlers.NewTournamentHandler(tournamentService, logger)
 	teamHandler := handlers.NewTeamHandler(teamService, logger)
 	matchHandler := handlers.NewMatchHandler(logger, matchService)
+	matchmakingHandler := handlers.NewMatchmakingHandler(matchmakingService, logger)
+	tierHandler := handlers.NewTierHandler(tierService, logger)
+	statsHandler := handlers.NewStatsHandler(statsService, cohortService, logger)
+	integrityHandler := handlers.NewIntegrityHandler(integrityService, logger)
+	activityHandler := handlers.NewActivityHandler(activityService, logger)
+	ladderHandler := handlers.NewLadderHandler(ladderService, logger)
+	seasonHandler := handlers.NewSeasonHandler(seasonService, logger)
+	organizationHandler := handlers.NewOrganizationHandler(organizationService, logger)
+	developerService := developerusecase.NewService(developerKeyRepo, developerUsageRepo, developer.Quota{
+		PerMinute: cfg.DeveloperQuotaPerMinute,
+		PerDay:    cfg.DeveloperQuotaPerDay,
+	}).WithAnonymousQuota(developer.Quota{
+		PerMinute: cfg.AnonymousQuotaPerMinute,
+		PerDay:    cfg.AnonymousQuotaPerDay,
+	})
+	developerHandler := handlers.NewDeveloperHandler(developerService, logger)
+	captureHandler := handlers.NewCaptureHandler(captureusecase.NewService(requestCaptureRepo, matchService), logger)
 
 	// TODO: Initialize Redis cache when needed
 	// cache, err := redis.Connect(ctx, cfg.RedisURL)
@@ -147,28 +496,133 @@ func run() error {
 		httpserver.WithJWTSecret(cfg.JWTSecret),
 		httpserver.WithVersion(Version),
 		httpserver.WithMongoDBChecker(mongoClient.Ping),
+		httpserver.WithDataLoader(gameRepo, playerRepo),
 		httpserver.WithGameHandler(gameHandler),
 		httpserver.WithLeaderboardHandler(leaderboardHandler),
 		httpserver.WithTournamentHandler(tournamentHandler),
 		httpserver.WithTeamHandler(teamHandler),
 		httpserver.WithMatchHandler(matchHandler),
+		httpserver.WithMatchmakingHandler(matchmakingHandler),
+		httpserver.WithTierHandler(tierHandler),
+		httpserver.WithStatsHandler(statsHandler),
+		httpserver.WithIntegrityHandler(integrityHandler),
+		httpserver.WithActivityHandler(activityHandler),
+		httpserver.WithLadderHandler(ladderHandler),
+		httpserver.WithSeasonHandler(seasonHandler),
+		httpserver.WithOrganizationHandler(organizationHandler),
+		httpserver.WithDeveloperHandler(developerHandler),
+		httpserver.WithCaptureHandler(captureHandler),
+		httpserver.WithAPIKeyQuota(developerService),
+		httpserver.WithActiveAccountGate(authService),
+	}
+	if len(policyVersions.Required()) > 0 {
+		routerOpts = append(routerOpts, httpserver.WithConsentGate(authService))
+	}
+
+	// Publish read-only snapshots to object storage when configured
+	var mirrorWorker *mirrorusecase.Worker
+	if cfg.MirrorUploadURL != "" {
+		store := objectstore.NewHTTPStore(cfg.MirrorUploadURL, cfg.MirrorUploadToken, cfg.MirrorInterval)
+		mirrorService := mirrorusecase.NewService(store, cfg.MirrorPublicURL, 3*cfg.MirrorInterval, gameRepo, leaderboardService, tournamentService)
+		mirrorWorker = mirrorusecase.NewWorker(mirrorService, cfg.MirrorInterval, logger)
+		if cfg.MirrorRedirectAbove > 0 {
+			routerOpts = append(routerOpts, httpserver.WithMirrorRedirect(mirrorService, cfg.MirrorRedirectAbove))
+		}
+	}
+
+	// Delay and fail API requests so clients can be tested against failures
+	if cfg.FaultInjection {
+		logger.Warn("injecting API faults",
+			"latency", cfg.FaultLatency,
+			"error_rate", cfg.FaultErrorRate,
+			"status", cfg.FaultErrorStatus,
+		)
+		faults := middleware.NewFaultInjector(
+			middleware.FaultRule{Latency: cfg.FaultLatency, ErrorRate: cfg.FaultErrorRate},
+			cfg.FaultRouteLatencies, cfg.FaultRouteErrorRates, cfg.FaultErrorStatus, logger,
+		)
+		routerOpts = append(routerOpts, httpserver.WithFaultInjection(faults))
+	}
+
+	// Record match submission and verification exchanges for debugging
+	if cfg.RequestCapture {
+		logger.Warn("capturing match submission and verification requests", "ttl", cfg.RequestCaptureTTL)
+		routerOpts = append(routerOpts, httpserver.WithRequestCapture(middleware.NewRequestCapture(requestCaptureRepo, cfg.RequestCaptureTTL, logger)))
+	}
+
+	// Shed load with 503 + Retry-After when route groups or MongoDB are saturated
+	if cfg.LoadShedLimit > 0 || len(cfg.LoadShedGroupLimits) > 0 {
+		routerOpts = append(routerOpts, httpserver.WithLoadShedding(middleware.NewLoadShedder(cfg.LoadShedLimit, cfg.LoadShedGroupLimits, logger)))
+	}
+	if mongoBreaker != nil {
+		routerOpts = append(routerOpts, httpserver.WithCircuitBreaker(mongoBreaker))
+	}
+	if cfg.Region != "" {
+		routerOpts = append(routerOpts, httpserver.WithRegion(cfg.Region))
+	}
+	if cfg.CompressionEnabled {
+		routerOpts = append(routerOpts, httpserver.WithCompression(cfg.CompressionMinSize))
+	}
+	if cfg.EnableMetrics {
+		routerOpts = append(routerOpts, httpserver.WithMetrics(mongoClient.PoolStats(), leaderboardService.Metrics()))
 	}
 
 	// Add health checkers if dependencies are configured
+	if cfg.MongoDBLatencyBudget > 0 {
+		routerOpts = append(routerOpts, httpserver.WithLatencyBudget("mongodb", httpserver.LatencyBudget{P99: cfg.MongoDBLatencyBudget, Window: cfg.LatencyBudgetWindow}))
+	}
 	// if cache != nil {
 	//     routerOpts = append(routerOpts, httpserver.WithRedisChecker(cache.Ping))
+	//     if cfg.RedisLatencyBudget > 0 {
+	//         routerOpts = append(routerOpts, httpserver.WithLatencyBudget("redis", httpserver.LatencyBudget{P99: cfg.RedisLatencyBudget, Window: cfg.LatencyBudgetWindow}))
+	//     }
 	// }
 
 	router := httpserver.NewRouter(logger, routerOpts...)
 
 	// Create and start HTTP server
-	server := httpserver.NewServer(cfg.HTTPAddr(), router, logger)
+	var serverOpts []httpserver.ServerOption
+	wsServerOpts := []httpserver.ServerOption{httpserver.WithoutHTTP2()} // WebSocket upgrades need HTTP/1.1
+	if cfg.TLSCertFile != "" {
+		serverOpts = append(serverOpts, httpserver.WithTLS(cfg.TLSCertFile, cfg.TLSKeyFile))
+		wsServerOpts = append(wsServerOpts, httpserver.WithTLS(cfg.TLSCertFile, cfg.TLSKeyFile))
+	}
+	if !cfg.HTTP2Enabled {
+		serverOpts = append(serverOpts, httpserver.WithoutHTTP2())
+	}
+	server := httpserver.NewServer(cfg.HTTPAddr(), router, logger, serverOpts...)
 
-	// Start server in goroutine
-	serverErr := make(chan error, 1)
+	// Create WebSocket server for real-time notifications
+	wsServer := httpserver.NewServer(cfg.WSAddr(), wsHub.Handler(cfg.JWTSecret), logger, wsServerOpts...)
+
+	// Start servers in goroutines
+	serverErr := make(chan error, 2)
 	go func() {
 		serverErr <- server.Start()
 	}()
+	go func() {
+		serverErr <- wsServer.Start()
+	}()
+
+	// Start background workers
+	workerCtx, stopWorker := context.WithCancel(ctx)
+	defer stopWorker()
+	go matchmakingWorker.Run(workerCtx)
+	go tierWorker.Run(workerCtx)
+	go rankSnapshotWorker.Run(workerCtx)
+	go leaderboardSyncWorker.Run(workerCtx)
+	go leaderboardRepairWorker.Run(workerCtx)
+	go statsWorker.Run(workerCtx)
+	go cohortWorker.Run(workerCtx)
+	go integrityWorker.Run(workerCtx)
+	go tournamentExportWorker.Run(workerCtx)
+	go registrationQueueWorker.Run(workerCtx)
+	go teamCleanupWorker.Run(workerCtx)
+	go ladderWorker.Run(workerCtx)
+	go seasonWorker.Run(workerCtx)
+	if mirrorWorker != nil {
+		go mirrorWorker.Run(workerCtx)
+	}
 
 	// Wait for shutdown signal or server error
 	select {
@@ -182,11 +636,18 @@ func run() error {
 	shutdownCtx, shutdownCancel := context.WithTimeout(ctx, cfg.ShutdownTimeout)
 	defer shutdownCancel()
 
+	stopWorker()
+
 	if err := server.Shutdown(shutdownCtx); err != nil {
 		logger.Error("server shutdown error", "error", err)
 		return err
 	}
 
+	if err := wsServer.Shutdown(shutdownCtx); err != nil {
+		logger.Error("websocket server shutdown error", "error", err)
+		return err
+	}
+
 	logger.Info("application stopped gracefully")
 	return nil
 }
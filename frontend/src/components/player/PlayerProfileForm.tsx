@@ -35,6 +35,7 @@ export const PlayerProfileForm = ({ player, onSuccess, onCancel }: PlayerProfile
         language: player?.language || '',
         avatar_url: player?.avatar_url || '',
         bio: player?.bio || '',
+        hide_from_leaderboards: player?.hide_from_leaderboards || false,
     });
 
     const [platformIds, setPlatformIds] = useState<Record<string, string>>(
@@ -82,7 +83,7 @@ export const PlayerProfileForm = ({ player, onSuccess, onCancel }: PlayerProfile
         };
 
         if (isEditing) {
-            updateMutation.mutate(requestData);
+            updateMutation.mutate({ ...requestData, hide_from_leaderboards: formData.hide_from_leaderboards });
         } else {
             createMutation.mutate(requestData as CreateProfileRequest);
         }
@@ -205,6 +206,27 @@ export const PlayerProfileForm = ({ player, onSuccess, onCancel }: PlayerProfile
                 </div>
             </div>
 
+            {/* Privacy */}
+            {isEditing && (
+                <div className="space-y-2 pt-4 border-t border-gray-700">
+                    <h3 className="text-sm font-medium text-gray-300">Privacy</h3>
+                    <label className="flex items-start gap-3 text-sm text-gray-300">
+                        <input
+                            type="checkbox"
+                            checked={formData.hide_from_leaderboards}
+                            onChange={(e) => setFormData({ ...formData, hide_from_leaderboards: e.target.checked })}
+                            className="mt-1 h-4 w-4 rounded border-gray-700 bg-gray-800"
+                        />
+                        <span>
+                            Hide me from public leaderboards
+                            <span className="block text-gray-500">
+                                You appear as "Anonymous" to others. Your rank still counts and you can see it on your profile.
+                            </span>
+                        </span>
+                    </label>
+                </div>
+            )}
+
             {/* Platform IDs */}
             <div className="space-y-4 pt-4 border-t border-gray-700">
                 <h3 className="text-sm font-medium text-gray-300">Platform IDs</h3>
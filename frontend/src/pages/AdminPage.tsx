@@ -1,14 +1,15 @@
+import { isAxiosError } from 'axios';
 import { useEffect, useState } from 'react';
 import { useNavigate } from 'react-router-dom';
 import { Button, Card, CardContent, CardHeader, CardTitle, Input } from '../components/ui';
 import { adminApi } from '../services/admin';
 import { useAuthStore } from '../store/authStore';
-import type { Game, Player, User } from '../types/api';
+import type { Game, IntegrityCheckKind, IntegrityReport, Player, User } from '../types/api';
 
 export const AdminPage = () => {
     const user = useAuthStore((state) => state.user);
     const navigate = useNavigate();
-    const [activeTab, setActiveTab] = useState<'users' | 'games' | 'players'>('users');
+    const [activeTab, setActiveTab] = useState<'users' | 'games' | 'players' | 'integrity'>('users');
 
     // Redirect if not admin
     useEffect(() => {
@@ -27,7 +28,7 @@ export const AdminPage = () => {
                 {/* Header */}
                 <div className="mb-8">
                     <h1 className="text-3xl font-bold text-white mb-2">Admin Panel</h1>
-                    <p className="text-gray-400">Manage users, games, and players</p>
+                    <p className="text-gray-400">Manage users, games, players, and data integrity</p>
                 </div>
 
                 {/* Tabs */}
@@ -59,12 +60,22 @@ export const AdminPage = () => {
                     >
                         Players
                     </button>
+                    <button
+                        onClick={() => setActiveTab('integrity')}
+                        className={`px-4 py-2 font-medium transition-colors ${activeTab === 'integrity'
+                            ? 'text-blue-500 border-b-2 border-blue-500'
+                            : 'text-gray-400 hover:text-gray-300'
+                            }`}
+                    >
+                        Integrity
+                    </button>
                 </div>
 
                 {/* Content */}
                 {activeTab === 'users' && <UserManagement />}
                 {activeTab === 'games' && <GameManagement />}
                 {activeTab === 'players' && <PlayerManagement />}
+                {activeTab === 'integrity' && <DataIntegrity />}
             </div>
         </div>
     );
@@ -83,7 +94,7 @@ const UserManagement = () => {
         try {
             setLoading(true);
             const data = await adminApi.users.list();
-            setUsers(data.users || []);
+            setUsers(data.items || []);
         } catch (error) {
             console.error('Failed to load users:', error);
             setUsers([]);
@@ -225,7 +236,7 @@ const GameManagement = () => {
         try {
             setLoading(true);
             const data = await adminApi.games.list();
-            setGames(data.games || []);
+            setGames(data.items || []);
         } catch (error) {
             console.error('Failed to load games:', error);
             setGames([]);
@@ -408,7 +419,7 @@ const PlayerManagement = () => {
             try {
                 setLoading(true);
                 const data = await adminApi.players.list();
-                setPlayers(data.players || []);
+                setPlayers(data.items || []);
             } catch (error) {
                 console.error('Failed to load players:', error);
                 setPlayers([]);
@@ -528,3 +539,131 @@ const PlayerManagement = () => {
         </Card>
     );
 };
+
+const integrityCheckLabels: Record<IntegrityCheckKind, { title: string; reference: string }> = {
+    orphaned_player_stats: { title: 'Player stats without a player', reference: 'Player ID' },
+    match_missing_team: { title: 'Matches without a team', reference: 'Team ID' },
+    team_missing_tournament: { title: 'Teams without a tournament', reference: 'Tournament ID' },
+};
+
+// Data Integrity Component
+const DataIntegrity = () => {
+    const [report, setReport] = useState<IntegrityReport | null>(null);
+    const [loading, setLoading] = useState(true);
+    const [running, setRunning] = useState(false);
+
+    useEffect(() => {
+        const loadReport = async () => {
+            try {
+                setLoading(true);
+                setReport(await adminApi.integrity.latest());
+            } catch (error) {
+                // No report exists until the first scheduled or manual run
+                if (!isAxiosError(error) || error.response?.status !== 404) {
+                    console.error('Failed to load integrity report:', error);
+                }
+                setReport(null);
+            } finally {
+                setLoading(false);
+            }
+        };
+
+        loadReport();
+    }, []);
+
+    const handleRun = async (repair: boolean) => {
+        if (repair && !confirm('Remove the dangling records found? Verified matches are kept.')) return;
+
+        try {
+            setRunning(true);
+            setReport(await adminApi.integrity.run(repair));
+        } catch (error) {
+            console.error('Failed to run integrity check:', error);
+            alert('Failed to run integrity check');
+        } finally {
+            setRunning(false);
+        }
+    };
+
+    if (loading) {
+        return <div className="text-gray-400">Loading integrity report...</div>;
+    }
+
+    return (
+        <Card>
+            <CardHeader>
+                <div className="flex justify-between items-center">
+                    <div>
+                        <CardTitle>Data Integrity</CardTitle>
+                        {report && (
+                            <p className="text-sm text-gray-500 mt-1">
+                                Last {report.repair ? 'repair' : 'check'}: {new Date(report.generated_at).toLocaleString()}
+                            </p>
+                        )}
+                    </div>
+                    <div className="flex gap-2">
+                        <Button variant="secondary" isLoading={running} onClick={() => handleRun(false)}>
+                            Run Check
+                        </Button>
+                        <Button variant="danger" disabled={running} onClick={() => handleRun(true)}>
+                            Check & Repair
+                        </Button>
+                    </div>
+                </div>
+            </CardHeader>
+            <CardContent>
+                {!report ? (
+                    <div className="text-center py-8 text-gray-500">
+                        <p>No integrity report yet</p>
+                        <p className="text-sm mt-2">Checks run daily, or click "Run Check" to run one now</p>
+                    </div>
+                ) : (
+                    <div className="grid gap-4">
+                        {report.checks.map((check) => (
+                            <div key={check.kind} className="border border-gray-700 rounded-lg p-4">
+                                <div className="flex justify-between items-start">
+                                    <h3 className="text-white font-medium">{integrityCheckLabels[check.kind].title}</h3>
+                                    <span
+                                        className={`px-2 py-1 rounded text-xs font-medium ${check.found === 0
+                                            ? 'bg-green-500/20 text-green-400'
+                                            : 'bg-yellow-500/20 text-yellow-400'
+                                            }`}
+                                    >
+                                        {check.found === 0 ? 'OK' : `${check.found}${check.truncated ? '+' : ''} found`}
+                                    </span>
+                                </div>
+                                {report.repair && check.found > 0 && (
+                                    <p className="text-sm text-gray-400 mt-2">
+                                        {check.repaired} repaired, {check.kept} kept, {check.failed} failed
+                                    </p>
+                                )}
+                                {check.samples.length > 0 && (
+                                    <table className="w-full mt-3 text-sm">
+                                        <thead>
+                                            <tr className="border-b border-gray-700">
+                                                <th className="text-left py-2 px-2 text-gray-400 font-medium">Record ID</th>
+                                                <th className="text-left py-2 px-2 text-gray-400 font-medium">
+                                                    {integrityCheckLabels[check.kind].reference}
+                                                </th>
+                                                <th className="text-left py-2 px-2 text-gray-400 font-medium">Note</th>
+                                            </tr>
+                                        </thead>
+                                        <tbody>
+                                            {check.samples.map((finding) => (
+                                                <tr key={finding.id} className="border-b border-gray-800">
+                                                    <td className="py-2 px-2 text-gray-300 font-mono">{finding.id}</td>
+                                                    <td className="py-2 px-2 text-gray-500 font-mono">{finding.reference}</td>
+                                                    <td className="py-2 px-2 text-gray-400">{finding.note || '-'}</td>
+                                                </tr>
+                                            ))}
+                                        </tbody>
+                                    </table>
+                                )}
+                            </div>
+                        ))}
+                    </div>
+                )}
+            </CardContent>
+        </Card>
+    );
+};
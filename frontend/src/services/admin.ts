@@ -2,11 +2,17 @@ import api from "../lib/axios";
 import type {
   CreateGameRequest,
   CreatePlayerRequest,
+  CorrectStatsRequest,
   Game,
+  IntegrityReport,
   ListGamesResponse,
   ListPlayersResponse,
   ListUsersResponse,
+  Page,
   Player,
+  ReplayResult,
+  RequestCapture,
+  StatCorrection,
   UpdateGameRequest,
   UpdatePlayerRequest,
   UpdateRoleRequest,
@@ -98,4 +104,47 @@ export const adminApi = {
       return response.data;
     },
   },
+
+  // Data Integrity
+  integrity: {
+    latest: async (): Promise<IntegrityReport> => {
+      const response = await api.get<IntegrityReport>("/admin/integrity");
+      return response.data;
+    },
+
+    run: async (repair = false): Promise<IntegrityReport> => {
+      const response = await api.post<IntegrityReport>("/admin/integrity", null, {
+        params: repair ? { repair: true } : undefined,
+      });
+      return response.data;
+    },
+  },
+
+  // Bulk Stat Corrections
+  statCorrections: {
+    list: async (gameId: string): Promise<Page<StatCorrection>> => {
+      const response = await api.get<Page<StatCorrection>>(`/admin/games/${gameId}/stat-corrections`);
+      return response.data;
+    },
+
+    apply: async (data: CorrectStatsRequest): Promise<StatCorrection> => {
+      const response = await api.post<StatCorrection>("/admin/stat-corrections", data);
+      return response.data;
+    },
+  },
+
+  // Request Captures
+  captures: {
+    list: async (kind?: RequestCapture["kind"]): Promise<Page<RequestCapture>> => {
+      const response = await api.get<Page<RequestCapture>>("/admin/request-captures", {
+        params: kind ? { kind } : undefined,
+      });
+      return response.data;
+    },
+
+    replay: async (id: string): Promise<ReplayResult> => {
+      const response = await api.post<ReplayResult>(`/admin/request-captures/${id}/replay`);
+      return response.data;
+    },
+  },
 };
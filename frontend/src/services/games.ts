@@ -1,8 +1,8 @@
 import api from '../lib/axios';
-import type { Game } from '../types/api';
+import type { Game, Page } from '../types/api';
 
 export const gamesApi = {
-  list: async (): Promise<{ games: Game[]; total: number }> => {
+  list: async (): Promise<Page<Game>> => {
     const response = await api.get('/games');
     return response.data;
   },
@@ -1,6 +1,8 @@
 import api from "../lib/axios";
 import type {
   Match,
+  Page,
+  ResubmitMatchRequest,
   SubmitMatchRequest,
   TeamWithMembers,
   Tournament,
@@ -47,18 +49,24 @@ export const matchApi = {
     return response.data;
   },
 
+  /**
+   * Correct a rejected match and send it back for review
+   */
+  resubmitMatch: async (
+    matchId: string,
+    data: ResubmitMatchRequest,
+  ): Promise<Match> => {
+    const response = await api.put<Match>(`/matches/${matchId}`, data);
+    return response.data;
+  },
+
   /**
    * Get current player's match history
    */
   getMyMatches: async (
     limit = 10,
     offset = 0,
-  ): Promise<{
-    matches: Match[];
-    total: number;
-    limit: number;
-    offset: number;
-  }> => {
+  ): Promise<Page<Match>> => {
     const response = await api.get("/players/me/matches", {
       params: { limit, offset },
     });
@@ -72,12 +80,7 @@ export const matchApi = {
     tournamentId: string,
     limit = 10,
     offset = 0,
-  ): Promise<{
-    matches: Match[];
-    total: number;
-    limit: number;
-    offset: number;
-  }> => {
+  ): Promise<Page<Match>> => {
     const response = await api.get(`/matches/tournament/${tournamentId}`, {
       params: { limit, offset },
     });
@@ -98,12 +101,7 @@ export const matchApi = {
   getUnverifiedMatches: async (
     limit = 10,
     offset = 0,
-  ): Promise<{
-    matches: Match[];
-    total: number;
-    limit: number;
-    offset: number;
-  }> => {
+  ): Promise<Page<Match>> => {
     const response = await api.get("/admin/matches/unverified", {
       params: { limit, offset },
     });
@@ -127,4 +125,15 @@ export const matchApi = {
     );
     return response.data;
   },
+
+  /**
+   * Admin: Void a verified match and reverse its stats
+   */
+  voidMatch: async (matchId: string, reason: string): Promise<Match> => {
+    const response = await api.patch<Match>(
+      `/admin/matches/${matchId}/void`,
+      { reason },
+    );
+    return response.data;
+  },
 };
@@ -0,0 +1,14 @@
+import api from '../lib/axios';
+import type { Page, Season } from '../types/api';
+
+export const seasonsApi = {
+  listByGame: async (gameId: string): Promise<Page<Season>> => {
+    const response = await api.get(`/games/${gameId}/seasons`);
+    return response.data;
+  },
+
+  getById: async (id: string): Promise<Season> => {
+    const response = await api.get<Season>(`/seasons/${id}`);
+    return response.data;
+  },
+};
@@ -34,17 +34,35 @@ export interface Game {
   description: string;
   stat_schema: Record<string, StatField>;
   ranking_weights: Record<string, number>;
+  modes?: GameMode[];
+  maps?: string[];
+  playlists?: string[];
   platform_id_format: string;
   is_active: boolean;
   created_at: string;
   updated_at: string;
 }
 
+export interface GameMode {
+  slug: string;
+  name: string;
+  ranking_weights?: Record<string, number>;
+}
+
 export interface StatField {
   type: string;
   min?: number;
   max?: number;
   label: string;
+  cap?: number;
+  scale?: number;
+  rollup?: StatRollup;
+}
+
+// How a lifetime stat accumulates a value reported in each match.
+export interface StatRollup {
+  source: string;
+  aggregate: "sum" | "max" | "avg";
 }
 
 // Players & Leaderboard
@@ -59,12 +77,38 @@ export interface Player {
   region?: string;
   preferred_platform?: string;
   language?: string;
+  hide_from_leaderboards: boolean;
+  honors: PlayerHonors;
+  availability?: PlayerAvailability;
   is_banned: boolean;
   banned_at?: string;
   created_at: string;
   updated_at: string;
 }
 
+export interface PlayerHonors {
+  mvp_votes: number;
+  commends: number;
+}
+
+export interface AvailabilityWindow {
+  day: number; // 0 is Sunday
+  start: string; // HH:MM
+  end: string; // HH:MM
+}
+
+export interface PlayerAvailability {
+  timezone: string;
+  windows: AvailabilityWindow[];
+}
+
+export interface AvailabilityOverlap {
+  player_id: string;
+  display_name: string;
+  minutes: number;
+  windows: AvailabilityWindow[]; // In UTC
+}
+
 export interface LeaderboardEntry {
   rank: number;
   player_id: string;
@@ -103,22 +147,49 @@ export interface PlayerGameStatsDetail extends PlayerStats {
   percentile: number;
 }
 
-// Admin API Types
-export interface ListUsersResponse {
-  users: User[];
-  total: number;
+// Seasons. A season's leaderboard is served by the leaderboard endpoints
+// with ?season_id=; final standings are kept once a season is archived.
+export interface SeasonStanding {
+  rank: number;
+  player_id: string;
+  display_name: string;
+  ranking_score: number;
+  tier: PlayerStats["tier"];
+  matches_played: number;
+  anonymous?: boolean;
 }
 
-export interface ListGamesResponse {
-  games: Game[];
-  total: number;
+export interface Season {
+  id: string;
+  game_id: string;
+  number: number;
+  name: string;
+  starts_at: string;
+  ends_at: string;
+  status: "scheduled" | "active" | "ended" | "archived";
+  standings?: SeasonStanding[];
+  archived_at?: string;
+  created_by?: string;
+  created_at: string;
+  updated_at: string;
 }
 
-export interface ListPlayersResponse {
-  players: Player[];
+// Every list endpoint responds with a page. limit is 0 when the list is
+// not paginated and every item is returned.
+export interface Page<T> {
+  items: T[];
   total: number;
+  limit: number;
+  offset: number;
 }
 
+// Admin API Types
+export type ListUsersResponse = Page<User>;
+
+export type ListGamesResponse = Page<Game>;
+
+export type ListPlayersResponse = Page<Player>;
+
 export interface UpdateRoleRequest {
   role: "user" | "admin";
 }
@@ -156,6 +227,108 @@ export interface UpdatePlayerRequest {
   platform_ids?: Record<string, string>;
 }
 
+export type IntegrityCheckKind =
+  | "orphaned_player_stats"
+  | "match_missing_team"
+  | "team_missing_tournament";
+
+export interface IntegrityFinding {
+  id: string;
+  reference: string;
+  note?: string;
+}
+
+export interface IntegrityCheck {
+  kind: IntegrityCheckKind;
+  found: number;
+  truncated: boolean;
+  samples: IntegrityFinding[];
+  repaired: number;
+  kept: number;
+  failed: number;
+}
+
+export interface IntegrityReport {
+  id: string;
+  generated_at: string;
+  repair: boolean;
+  checks: IntegrityCheck[];
+}
+
+// Request captures record match submission and verification exchanges,
+// sanitized, while REQUEST_CAPTURE is enabled
+export interface RequestCapture {
+  id: string;
+  kind:
+    | "submission"
+    | "resubmission"
+    | "connector_submission"
+    | "verification"
+    | "amendment"
+    | "unverification"
+    | "void";
+  method: string;
+  path: string;
+  user_id?: string;
+  request_body?: string;
+  status: number;
+  response_body?: string;
+  body_dropped?: boolean;
+  duration_ms: number;
+  captured_at: string;
+  expires_at: string;
+}
+
+// Bulk corrections of a stat mis-keyed across verified matches. Each changed
+// match is amended and its players' stats adjusted by the difference.
+export type CorrectionField = "kills" | "damage" | "assists" | "deaths" | "downs";
+
+export interface StatTransform {
+  op: "move" | "swap" | "scale";
+  field: CorrectionField;
+  to?: CorrectionField;
+  factor?: number;
+}
+
+export interface StatCorrectionFilter {
+  game_id: string;
+  tournament_id?: string;
+  player_id?: string;
+  verified_from?: string;
+  verified_to?: string;
+}
+
+export interface CorrectStatsRequest extends StatCorrectionFilter {
+  transform: StatTransform;
+  reason: string;
+  dry_run?: boolean;
+}
+
+export interface StatCorrection {
+  id: string;
+  filter: StatCorrectionFilter;
+  transform: StatTransform;
+  reason: string;
+  dry_run?: boolean;
+  scanned: number;
+  matches: {
+    match_id: string;
+    amendment_id?: string;
+    changes: { player_id: string; field: CorrectionField; from: number; to: number }[];
+  }[];
+  error?: string;
+  created_by: string;
+  created_at: string;
+}
+
+export interface ReplayResult {
+  capture_id: string;
+  original_status: number;
+  accepted: boolean;
+  error?: string;
+  match?: Match;
+}
+
 // Player Profile (for authenticated users creating/updating their own profile)
 export interface CreateProfileRequest {
   display_name: string;
@@ -177,6 +350,7 @@ export interface UpdateProfileRequest {
   region?: string;
   preferred_platform?: string;
   language?: string;
+  hide_from_leaderboards?: boolean;
 }
 
 // Tournaments
@@ -184,24 +358,142 @@ export interface Tournament {
   id: string;
   game_id: string;
   name: string;
-  team_size: "solo" | "duos" | "trios" | "quads";
+  team_size: number; // Players fielded in each match
+  roster_size?: number; // Players a team may register, substitutes included
   status: "draft" | "open" | "active" | "finished" | "canceled";
   start_date: string;
   end_date: string;
+  languages?: string[]; // ISO 639-1 codes
   created_by: string;
+  series_id?: string;
+  invites?: TournamentInvite[]; // Invite-only tournaments, in the order sent
   created_at: string;
   updated_at: string;
+  rulebook?: TournamentRulebook;
+}
+
+// Reserves take the slots that offered invitations give up.
+export interface TournamentInvite {
+  player_id: string;
+  name?: string;
+  status: "offered" | "reserve" | "accepted" | "declined";
+  team_id?: string;
+  invited_at: string;
+  responded_at?: string;
+}
+
+export interface TournamentInviteList extends Page<TournamentInvite> {
+  invite_only: boolean;
+  slots: number; // 0 when every invitation is offered
+  slots_taken: number;
+}
+
+// Seeds follow a finished tournament's final standings, matched by roster.
+export interface TournamentSeeding {
+  source_tournament_id: string;
+  source: {
+    team_id: string;
+    team_name: string;
+    rank: number;
+    member_ids: string[];
+  }[];
+  entries: {
+    seed: number;
+    team_id: string;
+    team_name: string;
+    source_team_id?: string;
+    source_rank?: number;
+    overlap: number; // Share of players in common with the source team
+    match: "exact" | "partial" | "manual" | "none";
+  }[];
+  imported_by: string;
+  imported_at: string;
+  updated_at: string;
+  pending: number; // Changed rosters waiting for the organizer
+}
+
+export interface TournamentLanguage {
+  code: string;
+  name: string;
+}
+
+// Rules and FAQ sections; content is markdown.
+export interface TournamentSection {
+  id: string;
+  kind: "rules" | "faq";
+  title: string;
+  content: string;
+  position: number;
+  updated_at: string;
+  updated_by: string;
+}
+
+export interface TournamentRulebook {
+  tournament_id: string;
+  sections: TournamentSection[];
+  updated_at: string;
+}
+
+// Teams sharing a platform ID, flagged for organizer review.
+export interface RosterOverlap {
+  id: string;
+  tournament_id: string;
+  platform: string;
+  platform_id: string;
+  team_ids: string[];
+  player_ids: string[];
+  match_id: string;
+  status: "pending" | "confirmed" | "dismissed";
+  note?: string;
+  reviewed_by?: string;
+  reviewed_at?: string;
+  detected_at: string;
+}
+
+// Reports, no-shows and lost disputes behind a player's conduct score.
+export interface ConductRecord {
+  id: string;
+  player_id: string;
+  kind: "report" | "no_show" | "dispute_lost";
+  tournament_id?: string;
+  match_id?: string;
+  note?: string;
+  recorded_by: string;
+  created_at: string;
+}
+
+export interface PlayerConduct {
+  player_id: string;
+  score: number;
+  honors: PlayerHonors;
+  records: ConductRecord[];
 }
 
 export interface CreateTournamentRequest {
   game_id: string;
   name: string;
-  team_size: "solo" | "duos" | "trios" | "quads";
+  team_size: number;
+  roster_size?: number;
   start_date: string;
   end_date: string;
+  languages?: string[];
 }
 
 // Teams
+export type StaffRole = "coach" | "manager";
+
+export interface StaffMember {
+  player_id: string;
+  role: StaffRole;
+  added_at: string;
+}
+
+// Set by the tournament's cleanup policy while a team is not taking part.
+export interface TeamCleanupFlag {
+  reason: "empty" | "inactive";
+  flagged_at: string;
+}
+
 export interface Team {
   id: string;
   tournament_id: string;
@@ -209,8 +501,10 @@ export interface Team {
   tag: string;
   captain_id: string;
   member_ids: string[];
+  staff?: StaffMember[];
   invite_code: string;
   logo_url?: string;
+  cleanup_flag?: TeamCleanupFlag;
   created_at: string;
   updated_at: string;
 }
@@ -226,11 +520,22 @@ export interface TeamWithMembers {
     display_name: string;
     avatar_url?: string;
   }>;
+  broadcasts: TeamBroadcast[];
   invite_code: string;
   created_at: string;
   updated_at: string;
 }
 
+export interface TeamBroadcast {
+  id: string;
+  team_id: string;
+  tournament_id: string;
+  sender_id: string;
+  message: string;
+  recipients: number;
+  created_at: string;
+}
+
 // Matches & Match Reports
 export interface PlayerMatchStats {
   player_id: string;
@@ -247,7 +552,10 @@ export interface Match {
   tournament_id: string;
   team_id: string;
   game_id: string;
-  status: "draft" | "verified" | "rejected";
+  mode?: string;
+  map?: string;
+  playlist?: string;
+  status: "draft" | "verified" | "rejected" | "voided";
   team_placement: number;
   team_kills: number;
   player_stats: PlayerMatchStats[];
@@ -257,6 +565,19 @@ export interface Match {
   created_at: string;
   updated_at: string;
   verified_at?: string;
+  revisions?: MatchRevision[];
+}
+
+export interface MatchRevision {
+  team_placement: number;
+  team_kills: number;
+  player_stats: PlayerMatchStats[];
+  screenshot_url: string;
+  submitted_by: string;
+  rejection_reason: string;
+  rejected_by: string;
+  rejected_at: string;
+  replaced_at: string;
 }
 
 export interface SubmitMatchRequest {
@@ -268,6 +589,45 @@ export interface SubmitMatchRequest {
   screenshot_url: string;
 }
 
+export type ResubmitMatchRequest = Omit<
+  SubmitMatchRequest,
+  "tournament_id" | "team_id"
+>;
+
+// Developer API keys
+export interface DeveloperApiKey {
+  id: string;
+  user_id: string;
+  name: string;
+  hint: string;
+  created_at: string;
+  revoked_at?: string;
+}
+
+export interface CreatedDeveloperApiKey extends DeveloperApiKey {
+  key: string;
+}
+
+export interface DeveloperKeyUsage {
+  key: DeveloperApiKey;
+  today: number;
+  remaining: number;
+  reset_at: string;
+  days: Array<{
+    start: string;
+    requests: number;
+    rejected: number;
+  }>;
+}
+
+export interface DeveloperUsage {
+  quota: {
+    per_minute: number;
+    per_day: number;
+  };
+  keys: DeveloperKeyUsage[];
+}
+
 // API Error
 export interface ApiError {
   error: string;
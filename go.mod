@@ -5,6 +5,7 @@ go 1.22
 require (
 	github.com/golang-jwt/jwt/v5 v5.3.0
 	github.com/google/uuid v1.6.0
+	github.com/gorilla/websocket v1.5.3
 	github.com/stretchr/testify v1.8.4
 	go.mongodb.org/mongo-driver v1.17.6
 	golang.org/x/crypto v0.26.0
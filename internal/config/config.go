@@ -6,18 +6,34 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 )
 
 // Config holds all application configuration.
 type Config struct {
 	// Server configuration
-	HTTPPort string
-	WSPort   string
+	HTTPPort           string
+	WSPort             string
+	TLSCertFile        string // Serves HTTPS when set along with TLSKeyFile
+	TLSKeyFile         string
+	HTTP2Enabled       bool // Negotiated over TLS only
+	CompressionEnabled bool
+	CompressionMinSize int // Smallest response body, in bytes, that is gzipped
 
 	// Database configuration
-	MongoDBURI      string
-	MongoDBDatabase string
+	MongoDBURI             string
+	MongoDBDatabase        string
+	MongoDBQueryTimeout    time.Duration // Deadline for each operation whose context has none; 0 disables it
+	MongoDBSlowQueryAbove  time.Duration // Queries taking at least this long are logged; 0 disables logging
+	MongoDBMinPoolSize     int
+	MongoDBMaxPoolSize     int
+	MongoDBMaxConnIdleTime time.Duration // Idle connections are closed after this long; 0 keeps them
+
+	// Multi-region settings
+	Region                  string            // Region this instance runs in; stamped on writes and sent as X-Served-Region
+	MongoDBReadTags         map[string]string // Replica set member tags preferred for leaderboard reads
+	MongoDBReadMaxStaleness time.Duration     // Regional members lagging further behind are skipped; 0 disables the check
 
 	// Redis configuration
 	RedisURL string
@@ -25,9 +41,117 @@ type Config struct {
 	// Application settings
 	Environment     string
 	LogLevel        string
+	LogRedact       []string // Categories scrubbed from logs: email, token, ip, or none
+	LogRedactTerms  []string // Extra words scrubbed from logs, e.g. profanity
 	ShutdownTimeout time.Duration
 	JWTSecret       string
 
+	// Matchmaking settings
+	MatchmakingInterval        time.Duration
+	MatchmakingLobbySize       int
+	MatchmakingMaxRatingSpread float64
+
+	// Tier settings
+	TierRecalibrationInterval time.Duration
+
+	// Leaderboard settings
+	RankSnapshotInterval      time.Duration
+	LeaderboardSyncInterval   time.Duration
+	LeaderboardSyncAlertEmail string
+	LeaderboardRepairInterval time.Duration
+
+	// Stats settings
+	StatsRefreshInterval time.Duration
+	CohortReportInterval time.Duration
+
+	// Data integrity settings
+	IntegrityCheckInterval time.Duration
+	IntegrityAutoRepair    bool // Remove dangling records on scheduled runs, not only report them
+
+	// Anomaly detection settings
+	NewAccountAge time.Duration // Accounts younger than this are checked for smurfing
+
+	// Tournament settings
+	TournamentExportInterval  time.Duration
+	RegistrationQueueInterval time.Duration
+	TeamCleanupInterval       time.Duration // How often tournaments' cleanup policies flag empty and inactive teams
+	LadderInterval            time.Duration
+	SeasonRolloverInterval    time.Duration // How often ended seasons are archived and the next ones started
+	MaxConcurrentTournaments  int           // Overlapping open or active tournaments a player may compete in; 0 leaves it to each tournament's rules
+	SportsmanshipMVPWeight    float64       // Points an MVP vote adds to a player's sportsmanship score; a commend adds 1
+	ConductHalfLife           time.Duration // How long it takes a conduct record's penalty to halve
+
+	// Password policy settings
+	PasswordMinLength     int
+	PasswordRequireUpper  bool
+	PasswordRequireLower  bool
+	PasswordRequireDigit  bool
+	PasswordRequireSymbol bool
+	PasswordBreachCheck   bool // Reject passwords found by Have I Been Pwned
+
+	// Policy versions users must consent to; consent is not required when empty
+	TermsVersion   string
+	PrivacyVersion string
+
+	// CAPTCHA settings
+	CaptchaProvider      string // hcaptcha or turnstile; disabled when empty
+	CaptchaSecret        string
+	CaptchaLoginFailures int // Failed logins before a CAPTCHA is required
+
+	// Read-only mirror settings
+	MirrorUploadURL     string // Object storage base URL snapshots are PUT to; disabled when empty
+	MirrorUploadToken   string
+	MirrorPublicURL     string // Where the uploaded snapshots are served from, e.g. a CDN
+	MirrorInterval      time.Duration
+	MirrorRedirectAbove int64 // In-flight requests above which reads are redirected to the mirror; 0 never redirects
+
+	// Tournament backup settings
+	BackupStoreURL   string // Object storage base URL tournament backups are kept under; disabled when empty
+	BackupStoreToken string
+
+	// Load shedding settings
+	LoadShedLimit       int            // Concurrent requests per API route group; 0 disables shedding
+	LoadShedGroupLimits map[string]int // Per-group overrides, e.g. leaderboard=400,admin=20
+
+	// Public API quota settings; every developer API key gets the same quota
+	DeveloperQuotaPerMinute int64 // Requests per key per minute; 0 is unlimited
+	DeveloperQuotaPerDay    int64 // Requests per key per UTC day; 0 is unlimited
+
+	// Quota of API requests made without a key, per client address
+	AnonymousQuotaPerMinute int64 // Requests per address per minute; 0 is unlimited
+	AnonymousQuotaPerDay    int64 // Requests per address per UTC day; 0 is unlimited
+
+	// MongoDB circuit breaker settings
+	MongoBreakerFailures    int // Consecutive failed or slow commands that open the breaker; 0 disables it
+	MongoBreakerCooldown    time.Duration
+	MongoBreakerSlowCommand time.Duration // Commands slower than this count as failures
+
+	// Readiness latency budgets
+	MongoDBLatencyBudget time.Duration // p99 MongoDB readiness check latency allowed; 0 disables the budget
+	RedisLatencyBudget   time.Duration // p99 Redis readiness check latency allowed; 0 disables the budget
+	LatencyBudgetWindow  time.Duration // How long p99 must stay over budget before readiness fails
+
+	// Fault injection settings, for testing clients against failures; refused in production
+	FaultInjection       bool
+	FaultLatency         time.Duration            // Added to every API request
+	FaultErrorRate       float64                  // Share of API requests failed, from 0 to 1
+	FaultRouteLatencies  map[string]time.Duration // Per route group overrides, e.g. leaderboard=2s
+	FaultRouteErrorRates map[string]float64       // Per route group overrides, e.g. matches=0.3
+	FaultErrorStatus     int                      // Status injected API errors are answered with
+	FaultDBLatency       time.Duration            // Added to game, tournament, team, match and player repository calls
+	FaultDBErrorRate     float64                  // Share of those repository calls failed
+
+	// Request capture settings, for debugging match submission and verification issues
+	RequestCapture    bool
+	RequestCaptureTTL time.Duration // How long captures are kept
+
+	// Mail settings
+	AppBaseURL   string // Frontend origin used in emailed links
+	SMTPAddr     string // host:port; mail is logged instead of sent when empty
+	SMTPUsername string
+	SMTPPassword string
+	MailFrom     string
+
 	// Feature flags
 	EnableMetrics bool
 	EnableTracing bool
@@ -37,20 +161,141 @@ type Config struct {
 func Load() (*Config, error) {
 	cfg := &Config{
 		// Server defaults
-		HTTPPort: getEnv("HTTP_PORT", "8080"),
-		WSPort:   getEnv("WS_PORT", "8081"),
+		HTTPPort:           getEnv("HTTP_PORT", "8080"),
+		WSPort:             getEnv("WS_PORT", "8081"),
+		TLSCertFile:        getEnv("TLS_CERT_FILE", ""),
+		TLSKeyFile:         getEnv("TLS_KEY_FILE", ""),
+		HTTP2Enabled:       getBoolEnv("HTTP2_ENABLED", true),
+		CompressionEnabled: getBoolEnv("COMPRESSION_ENABLED", true),
+		CompressionMinSize: getIntEnv("COMPRESSION_MIN_SIZE", 1024),
 
 		// Database defaults
-		MongoDBURI:      getEnv("MONGODB_URI", "mongodb://localhost:27017"),
-		MongoDBDatabase: getEnv("MONGODB_DATABASE", "tourneyrank"),
-		RedisURL:        getEnv("REDIS_URL", ""),
+		MongoDBURI:             getEnv("MONGODB_URI", "mongodb://localhost:27017"),
+		MongoDBDatabase:        getEnv("MONGODB_DATABASE", "tourneyrank"),
+		MongoDBQueryTimeout:    getDurationEnv("MONGODB_QUERY_TIMEOUT", 30*time.Second),
+		MongoDBSlowQueryAbove:  getDurationEnv("MONGODB_SLOW_QUERY_THRESHOLD", 500*time.Millisecond),
+		MongoDBMinPoolSize:     getIntEnv("MONGODB_MIN_POOL_SIZE", 0),
+		MongoDBMaxPoolSize:     getIntEnv("MONGODB_MAX_POOL_SIZE", 100),
+		MongoDBMaxConnIdleTime: getDurationEnv("MONGODB_MAX_CONN_IDLE_TIME", 0),
+
+		// Multi-region defaults
+		Region:                  getEnv("REGION", ""),
+		MongoDBReadTags:         getMapEnv("MONGODB_READ_TAGS"),
+		MongoDBReadMaxStaleness: getDurationEnv("MONGODB_READ_MAX_STALENESS", 0),
+		RedisURL:                getEnv("REDIS_URL", ""),
 
 		// Application defaults
 		Environment:     getEnv("ENVIRONMENT", "development"),
 		LogLevel:        getEnv("LOG_LEVEL", "info"),
+		LogRedact:       getListEnv("LOG_REDACT", []string{"email", "token", "ip"}),
+		LogRedactTerms:  getListEnv("LOG_REDACT_TERMS", nil),
 		ShutdownTimeout: getDurationEnv("SHUTDOWN_TIMEOUT", 15*time.Second),
 		JWTSecret:       getEnv("JWT_SECRET", "super-secret-key-change-me"),
 
+		// Matchmaking defaults
+		MatchmakingInterval:        getDurationEnv("MATCHMAKING_INTERVAL", 10*time.Second),
+		MatchmakingLobbySize:       getIntEnv("MATCHMAKING_LOBBY_SIZE", 8),
+		MatchmakingMaxRatingSpread: getFloatEnv("MATCHMAKING_MAX_RATING_SPREAD", 150),
+
+		// Tier defaults
+		TierRecalibrationInterval: getDurationEnv("TIER_RECALIBRATION_INTERVAL", time.Hour),
+
+		// Leaderboard defaults
+		RankSnapshotInterval:      getDurationEnv("RANK_SNAPSHOT_INTERVAL", time.Hour),
+		LeaderboardSyncInterval:   getDurationEnv("LEADERBOARD_SYNC_INTERVAL", time.Minute),
+		LeaderboardSyncAlertEmail: getEnv("LEADERBOARD_SYNC_ALERT_EMAIL", ""),
+		LeaderboardRepairInterval: getDurationEnv("LEADERBOARD_REPAIR_INTERVAL", 24*time.Hour),
+
+		// Stats defaults
+		StatsRefreshInterval: getDurationEnv("STATS_REFRESH_INTERVAL", 15*time.Minute),
+		CohortReportInterval: getDurationEnv("COHORT_REPORT_INTERVAL", 24*time.Hour),
+
+		// Data integrity defaults
+		IntegrityCheckInterval: getDurationEnv("INTEGRITY_CHECK_INTERVAL", 24*time.Hour),
+		IntegrityAutoRepair:    getBoolEnv("INTEGRITY_AUTO_REPAIR", false),
+
+		// Anomaly detection defaults
+		NewAccountAge: getDurationEnv("NEW_ACCOUNT_AGE", 30*24*time.Hour),
+
+		// Tournament defaults
+		TournamentExportInterval:  getDurationEnv("TOURNAMENT_EXPORT_INTERVAL", 10*time.Second),
+		RegistrationQueueInterval: getDurationEnv("REGISTRATION_QUEUE_INTERVAL", time.Second),
+		TeamCleanupInterval:       getDurationEnv("TEAM_CLEANUP_INTERVAL", 10*time.Minute),
+		LadderInterval:            getDurationEnv("LADDER_INTERVAL", time.Minute),
+		SeasonRolloverInterval:    getDurationEnv("SEASON_ROLLOVER_INTERVAL", 5*time.Minute),
+		MaxConcurrentTournaments:  getIntEnv("MAX_CONCURRENT_TOURNAMENTS", 0),
+		SportsmanshipMVPWeight:    getFloatEnv("SPORTSMANSHIP_MVP_WEIGHT", 2),
+		ConductHalfLife:           getDurationEnv("CONDUCT_HALF_LIFE", 90*24*time.Hour),
+
+		// Password policy defaults
+		PasswordMinLength:     getIntEnv("PASSWORD_MIN_LENGTH", 8),
+		PasswordRequireUpper:  getBoolEnv("PASSWORD_REQUIRE_UPPER", false),
+		PasswordRequireLower:  getBoolEnv("PASSWORD_REQUIRE_LOWER", false),
+		PasswordRequireDigit:  getBoolEnv("PASSWORD_REQUIRE_DIGIT", false),
+		PasswordRequireSymbol: getBoolEnv("PASSWORD_REQUIRE_SYMBOL", false),
+		PasswordBreachCheck:   getBoolEnv("PASSWORD_BREACH_CHECK", false),
+
+		// Policy defaults
+		TermsVersion:   getEnv("TERMS_VERSION", ""),
+		PrivacyVersion: getEnv("PRIVACY_VERSION", ""),
+
+		// CAPTCHA defaults
+		CaptchaProvider:      getEnv("CAPTCHA_PROVIDER", ""),
+		CaptchaSecret:        getEnv("CAPTCHA_SECRET", ""),
+		CaptchaLoginFailures: getIntEnv("CAPTCHA_LOGIN_FAILURES", 3),
+
+		// Read-only mirror defaults
+		MirrorUploadURL:     getEnv("MIRROR_UPLOAD_URL", ""),
+		MirrorUploadToken:   getEnv("MIRROR_UPLOAD_TOKEN", ""),
+		MirrorPublicURL:     getEnv("MIRROR_PUBLIC_URL", ""),
+		MirrorInterval:      getDurationEnv("MIRROR_INTERVAL", 30*time.Second),
+		MirrorRedirectAbove: int64(getIntEnv("MIRROR_REDIRECT_IN_FLIGHT", 0)),
+
+		// Tournament backup defaults
+		BackupStoreURL:   getEnv("BACKUP_STORE_URL", ""),
+		BackupStoreToken: getEnv("BACKUP_STORE_TOKEN", ""),
+
+		// Public API quota defaults
+		DeveloperQuotaPerMinute: int64(getIntEnv("DEVELOPER_QUOTA_PER_MINUTE", 120)),
+		DeveloperQuotaPerDay:    int64(getIntEnv("DEVELOPER_QUOTA_PER_DAY", 50000)),
+		AnonymousQuotaPerMinute: int64(getIntEnv("ANONYMOUS_QUOTA_PER_MINUTE", 300)),
+		AnonymousQuotaPerDay:    int64(getIntEnv("ANONYMOUS_QUOTA_PER_DAY", 0)),
+
+		// Load shedding defaults
+		LoadShedLimit:       getIntEnv("LOAD_SHED_LIMIT", 0),
+		LoadShedGroupLimits: getIntMapEnv("LOAD_SHED_GROUP_LIMITS"),
+
+		// MongoDB circuit breaker defaults
+		MongoBreakerFailures:    getIntEnv("MONGODB_BREAKER_FAILURES", 0),
+		MongoBreakerCooldown:    getDurationEnv("MONGODB_BREAKER_COOLDOWN", 10*time.Second),
+		MongoBreakerSlowCommand: getDurationEnv("MONGODB_BREAKER_SLOW_COMMAND", 2*time.Second),
+
+		// Readiness latency budget defaults
+		MongoDBLatencyBudget: getDurationEnv("MONGODB_LATENCY_BUDGET", 0),
+		RedisLatencyBudget:   getDurationEnv("REDIS_LATENCY_BUDGET", 0),
+		LatencyBudgetWindow:  getDurationEnv("LATENCY_BUDGET_WINDOW", time.Minute),
+
+		// Fault injection defaults
+		FaultInjection:       getBoolEnv("FAULT_INJECTION", false),
+		FaultLatency:         getDurationEnv("FAULT_LATENCY", 0),
+		FaultErrorRate:       getFloatEnv("FAULT_ERROR_RATE", 0),
+		FaultRouteLatencies:  getDurationMapEnv("FAULT_ROUTE_LATENCIES"),
+		FaultRouteErrorRates: getFloatMapEnv("FAULT_ROUTE_ERROR_RATES"),
+		FaultErrorStatus:     getIntEnv("FAULT_ERROR_STATUS", 503),
+		FaultDBLatency:       getDurationEnv("FAULT_DB_LATENCY", 0),
+		FaultDBErrorRate:     getFloatEnv("FAULT_DB_ERROR_RATE", 0),
+
+		// Request capture defaults
+		RequestCapture:    getBoolEnv("REQUEST_CAPTURE", false),
+		RequestCaptureTTL: getDurationEnv("REQUEST_CAPTURE_TTL", 72*time.Hour),
+
+		// Mail defaults
+		AppBaseURL:   getEnv("APP_BASE_URL", "http://localhost:5173"),
+		SMTPAddr:     getEnv("SMTP_ADDR", ""),
+		SMTPUsername: getEnv("SMTP_USERNAME", ""),
+		SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+		MailFrom:     getEnv("MAIL_FROM", "no-reply@tourneyrank.local"),
+
 		// Feature flags
 		EnableMetrics: getBoolEnv("ENABLE_METRICS", false),
 		EnableTracing: getBoolEnv("ENABLE_TRACING", false),
@@ -74,6 +319,213 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("HTTP_PORT must be a valid port number: %w", err)
 	}
 
+	for _, category := range c.LogRedact {
+		switch category {
+		case "email", "token", "ip", "none":
+		default:
+			return fmt.Errorf("LOG_REDACT must list email, token, ip or none, got %q", category)
+		}
+	}
+
+	if c.MatchmakingLobbySize < 2 {
+		return fmt.Errorf("MATCHMAKING_LOBBY_SIZE must be at least 2")
+	}
+
+	if c.MatchmakingInterval <= 0 {
+		return fmt.Errorf("MATCHMAKING_INTERVAL must be positive")
+	}
+
+	if c.TierRecalibrationInterval <= 0 {
+		return fmt.Errorf("TIER_RECALIBRATION_INTERVAL must be positive")
+	}
+
+	if c.RankSnapshotInterval <= 0 {
+		return fmt.Errorf("RANK_SNAPSHOT_INTERVAL must be positive")
+	}
+
+	if c.LeaderboardSyncInterval <= 0 {
+		return fmt.Errorf("LEADERBOARD_SYNC_INTERVAL must be positive")
+	}
+
+	if c.LeaderboardRepairInterval <= 0 {
+		return fmt.Errorf("LEADERBOARD_REPAIR_INTERVAL must be positive")
+	}
+
+	if c.StatsRefreshInterval <= 0 {
+		return fmt.Errorf("STATS_REFRESH_INTERVAL must be positive")
+	}
+
+	if c.CohortReportInterval <= 0 {
+		return fmt.Errorf("COHORT_REPORT_INTERVAL must be positive")
+	}
+
+	if c.IntegrityCheckInterval <= 0 {
+		return fmt.Errorf("INTEGRITY_CHECK_INTERVAL must be positive")
+	}
+
+	if c.NewAccountAge <= 0 {
+		return fmt.Errorf("NEW_ACCOUNT_AGE must be positive")
+	}
+
+	if c.TournamentExportInterval <= 0 {
+		return fmt.Errorf("TOURNAMENT_EXPORT_INTERVAL must be positive")
+	}
+
+	if c.RegistrationQueueInterval <= 0 {
+		return fmt.Errorf("REGISTRATION_QUEUE_INTERVAL must be positive")
+	}
+
+	if c.TeamCleanupInterval <= 0 {
+		return fmt.Errorf("TEAM_CLEANUP_INTERVAL must be positive")
+	}
+
+	if c.MaxConcurrentTournaments < 0 {
+		return fmt.Errorf("MAX_CONCURRENT_TOURNAMENTS cannot be negative")
+	}
+
+	if c.SportsmanshipMVPWeight < 0 {
+		return fmt.Errorf("SPORTSMANSHIP_MVP_WEIGHT cannot be negative")
+	}
+
+	if c.ConductHalfLife <= 0 {
+		return fmt.Errorf("CONDUCT_HALF_LIFE must be positive")
+	}
+
+	if c.LadderInterval <= 0 {
+		return fmt.Errorf("LADDER_INTERVAL must be positive")
+	}
+
+	if c.SeasonRolloverInterval <= 0 {
+		return fmt.Errorf("SEASON_ROLLOVER_INTERVAL must be positive")
+	}
+
+	if c.PasswordMinLength < 8 {
+		return fmt.Errorf("PASSWORD_MIN_LENGTH must be at least 8")
+	}
+
+	switch c.CaptchaProvider {
+	case "":
+	case "hcaptcha", "turnstile":
+		if c.CaptchaSecret == "" {
+			return fmt.Errorf("CAPTCHA_SECRET is required when CAPTCHA_PROVIDER is set")
+		}
+	default:
+		return fmt.Errorf("CAPTCHA_PROVIDER must be hcaptcha or turnstile")
+	}
+
+	if c.CaptchaLoginFailures < 0 {
+		return fmt.Errorf("CAPTCHA_LOGIN_FAILURES cannot be negative")
+	}
+
+	if c.MirrorUploadURL != "" {
+		if c.MirrorPublicURL == "" {
+			return fmt.Errorf("MIRROR_PUBLIC_URL is required when MIRROR_UPLOAD_URL is set")
+		}
+		if c.MirrorInterval <= 0 {
+			return fmt.Errorf("MIRROR_INTERVAL must be positive")
+		}
+	}
+
+	if c.MirrorRedirectAbove < 0 {
+		return fmt.Errorf("MIRROR_REDIRECT_IN_FLIGHT cannot be negative")
+	}
+
+	if c.DeveloperQuotaPerMinute < 0 || c.DeveloperQuotaPerDay < 0 {
+		return fmt.Errorf("DEVELOPER_QUOTA_PER_MINUTE and DEVELOPER_QUOTA_PER_DAY cannot be negative")
+	}
+
+	if c.AnonymousQuotaPerMinute < 0 || c.AnonymousQuotaPerDay < 0 {
+		return fmt.Errorf("ANONYMOUS_QUOTA_PER_MINUTE and ANONYMOUS_QUOTA_PER_DAY cannot be negative")
+	}
+
+	if c.MongoDBQueryTimeout < 0 {
+		return fmt.Errorf("MONGODB_QUERY_TIMEOUT cannot be negative")
+	}
+
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return fmt.Errorf("TLS_CERT_FILE and TLS_KEY_FILE must be set together")
+	}
+
+	if c.CompressionMinSize < 0 {
+		return fmt.Errorf("COMPRESSION_MIN_SIZE cannot be negative")
+	}
+
+	if c.MongoDBSlowQueryAbove < 0 {
+		return fmt.Errorf("MONGODB_SLOW_QUERY_THRESHOLD cannot be negative")
+	}
+
+	if c.MongoDBMaxPoolSize < 1 {
+		return fmt.Errorf("MONGODB_MAX_POOL_SIZE must be at least 1")
+	}
+
+	if c.MongoDBMinPoolSize < 0 || c.MongoDBMinPoolSize > c.MongoDBMaxPoolSize {
+		return fmt.Errorf("MONGODB_MIN_POOL_SIZE must be between 0 and MONGODB_MAX_POOL_SIZE")
+	}
+
+	if c.MongoDBMaxConnIdleTime < 0 {
+		return fmt.Errorf("MONGODB_MAX_CONN_IDLE_TIME cannot be negative")
+	}
+
+	// MongoDB rejects a max staleness below 90 seconds
+	if c.MongoDBReadMaxStaleness < 0 || (c.MongoDBReadMaxStaleness > 0 && c.MongoDBReadMaxStaleness < 90*time.Second) {
+		return fmt.Errorf("MONGODB_READ_MAX_STALENESS must be 0 or at least 90s")
+	}
+
+	if c.LoadShedLimit < 0 {
+		return fmt.Errorf("LOAD_SHED_LIMIT cannot be negative")
+	}
+
+	for group, limit := range c.LoadShedGroupLimits {
+		if limit < 0 {
+			return fmt.Errorf("LOAD_SHED_GROUP_LIMITS: limit for %s cannot be negative", group)
+		}
+	}
+
+	if c.MongoBreakerFailures < 0 {
+		return fmt.Errorf("MONGODB_BREAKER_FAILURES cannot be negative")
+	}
+
+	if c.MongoBreakerFailures > 0 && c.MongoBreakerCooldown <= 0 {
+		return fmt.Errorf("MONGODB_BREAKER_COOLDOWN must be positive")
+	}
+
+	if c.MongoDBLatencyBudget < 0 || c.RedisLatencyBudget < 0 {
+		return fmt.Errorf("MONGODB_LATENCY_BUDGET and REDIS_LATENCY_BUDGET cannot be negative")
+	}
+
+	if (c.MongoDBLatencyBudget > 0 || c.RedisLatencyBudget > 0) && c.LatencyBudgetWindow <= 0 {
+		return fmt.Errorf("LATENCY_BUDGET_WINDOW must be positive")
+	}
+
+	if c.FaultInjection {
+		if c.IsProduction() {
+			return fmt.Errorf("FAULT_INJECTION cannot be enabled in production")
+		}
+		if c.FaultLatency < 0 || c.FaultDBLatency < 0 {
+			return fmt.Errorf("FAULT_LATENCY and FAULT_DB_LATENCY cannot be negative")
+		}
+		if c.FaultErrorRate < 0 || c.FaultErrorRate > 1 || c.FaultDBErrorRate < 0 || c.FaultDBErrorRate > 1 {
+			return fmt.Errorf("FAULT_ERROR_RATE and FAULT_DB_ERROR_RATE must be between 0 and 1")
+		}
+		for group, latency := range c.FaultRouteLatencies {
+			if latency < 0 {
+				return fmt.Errorf("FAULT_ROUTE_LATENCIES: latency for %s cannot be negative", group)
+			}
+		}
+		for group, rate := range c.FaultRouteErrorRates {
+			if rate < 0 || rate > 1 {
+				return fmt.Errorf("FAULT_ROUTE_ERROR_RATES: rate for %s must be between 0 and 1", group)
+			}
+		}
+		if c.FaultErrorStatus < 400 || c.FaultErrorStatus > 599 {
+			return fmt.Errorf("FAULT_ERROR_STATUS must be an HTTP error status")
+		}
+	}
+
+	if c.RequestCapture && (c.RequestCaptureTTL <= 0 || c.RequestCaptureTTL > 30*24*time.Hour) {
+		return fmt.Errorf("REQUEST_CAPTURE_TTL must be positive and at most 30 days")
+	}
+
 	return nil
 }
 
@@ -120,6 +572,36 @@ func getBoolEnv(key string, defaultValue bool) bool {
 	return parsed
 }
 
+// getIntEnv retrieves an integer environment variable.
+func getIntEnv(key string, defaultValue int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.Atoi(value)
+	if err != nil {
+		return defaultValue
+	}
+
+	return parsed
+}
+
+// getFloatEnv retrieves a float environment variable.
+func getFloatEnv(key string, defaultValue float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	parsed, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return defaultValue
+	}
+
+	return parsed
+}
+
 // getDurationEnv retrieves a duration environment variable.
 func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	value := os.Getenv(key)
@@ -135,6 +617,115 @@ func getDurationEnv(key string, defaultValue time.Duration) time.Duration {
 	return parsed
 }
 
+// getListEnv retrieves a comma-separated list, trimming entries and skipping empty ones.
+func getListEnv(key string, defaultValue []string) []string {
+	value := os.Getenv(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	var parsed []string
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			parsed = append(parsed, entry)
+		}
+	}
+
+	return parsed
+}
+
+// getIntMapEnv retrieves a comma-separated list of key=integer pairs.
+// Malformed entries are skipped.
+func getIntMapEnv(key string) map[string]int {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+
+	parsed := make(map[string]int)
+	for _, entry := range strings.Split(value, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || k == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			continue
+		}
+		parsed[k] = n
+	}
+
+	return parsed
+}
+
+// getFloatMapEnv retrieves a comma-separated list of key=float pairs.
+// Malformed entries are skipped.
+func getFloatMapEnv(key string) map[string]float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+
+	parsed := make(map[string]float64)
+	for _, entry := range strings.Split(value, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || k == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			continue
+		}
+		parsed[k] = f
+	}
+
+	return parsed
+}
+
+// getDurationMapEnv retrieves a comma-separated list of key=duration pairs.
+// Malformed entries are skipped.
+func getDurationMapEnv(key string) map[string]time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+
+	parsed := make(map[string]time.Duration)
+	for _, entry := range strings.Split(value, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || k == "" {
+			continue
+		}
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			continue
+		}
+		parsed[k] = d
+	}
+
+	return parsed
+}
+
+// getMapEnv retrieves a comma-separated list of key=value pairs.
+// Malformed entries are skipped.
+func getMapEnv(key string) map[string]string {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil
+	}
+
+	parsed := make(map[string]string)
+	for _, entry := range strings.Split(value, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || k == "" {
+			continue
+		}
+		parsed[k] = v
+	}
+
+	return parsed
+}
+
 // MustGetEnv retrieves an environment variable or panics if not set.
 func MustGetEnv(key string) string {
 	value := os.Getenv(key)
@@ -27,6 +27,7 @@ func TestLoad(t *testing.T) {
 		assert.Equal(t, "development", cfg.Environment)
 		assert.Equal(t, "info", cfg.LogLevel)
 		assert.Equal(t, 15*time.Second, cfg.ShutdownTimeout)
+		assert.Equal(t, []string{"email", "token", "ip"}, cfg.LogRedact)
 	})
 
 	t.Run("loads from environment variables", func(t *testing.T) {
@@ -59,6 +60,82 @@ func TestLoad(t *testing.T) {
 		require.Error(t, err)
 		assert.Contains(t, err.Error(), "HTTP_PORT must be a valid port number")
 	})
+
+	t.Run("returns error for MONGODB_MIN_POOL_SIZE above the maximum", func(t *testing.T) {
+		os.Setenv("MONGODB_MIN_POOL_SIZE", "50")
+		os.Setenv("MONGODB_MAX_POOL_SIZE", "20")
+		defer os.Unsetenv("MONGODB_MIN_POOL_SIZE")
+		defer os.Unsetenv("MONGODB_MAX_POOL_SIZE")
+
+		_, err := Load()
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "MONGODB_MIN_POOL_SIZE must be between 0 and MONGODB_MAX_POOL_SIZE")
+	})
+
+	t.Run("returns error for unknown LOG_REDACT category", func(t *testing.T) {
+		os.Setenv("LOG_REDACT", "email,phone")
+		defer os.Unsetenv("LOG_REDACT")
+
+		_, err := Load()
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "LOG_REDACT must list email, token, ip or none")
+	})
+
+	t.Run("returns error for PASSWORD_MIN_LENGTH below 8", func(t *testing.T) {
+		os.Setenv("PASSWORD_MIN_LENGTH", "6")
+		defer os.Unsetenv("PASSWORD_MIN_LENGTH")
+
+		_, err := Load()
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "PASSWORD_MIN_LENGTH must be at least 8")
+	})
+
+	t.Run("returns error for CAPTCHA_PROVIDER without secret", func(t *testing.T) {
+		os.Setenv("CAPTCHA_PROVIDER", "turnstile")
+		defer os.Unsetenv("CAPTCHA_PROVIDER")
+
+		_, err := Load()
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "CAPTCHA_SECRET is required")
+	})
+
+	t.Run("returns error for MIRROR_UPLOAD_URL without public URL", func(t *testing.T) {
+		os.Setenv("MIRROR_UPLOAD_URL", "https://bucket.example.com/mirror")
+		defer os.Unsetenv("MIRROR_UPLOAD_URL")
+
+		_, err := Load()
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "MIRROR_PUBLIC_URL is required")
+	})
+
+	t.Run("returns error for FAULT_INJECTION in production", func(t *testing.T) {
+		os.Setenv("FAULT_INJECTION", "true")
+		os.Setenv("ENVIRONMENT", "production")
+		defer os.Unsetenv("FAULT_INJECTION")
+		defer os.Unsetenv("ENVIRONMENT")
+
+		_, err := Load()
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "FAULT_INJECTION cannot be enabled in production")
+	})
+
+	t.Run("returns error for too long REQUEST_CAPTURE_TTL", func(t *testing.T) {
+		os.Setenv("REQUEST_CAPTURE", "true")
+		os.Setenv("REQUEST_CAPTURE_TTL", "1000h")
+		defer os.Unsetenv("REQUEST_CAPTURE")
+		defer os.Unsetenv("REQUEST_CAPTURE_TTL")
+
+		_, err := Load()
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "REQUEST_CAPTURE_TTL must be positive and at most 30 days")
+	})
 }
 
 func TestConfig_IsDevelopment(t *testing.T) {
@@ -164,3 +241,166 @@ func TestGetDurationEnv(t *testing.T) {
 		})
 	}
 }
+
+func TestGetIntEnv(t *testing.T) {
+	tests := []struct {
+		name         string
+		envValue     string
+		defaultValue int
+		want         int
+	}{
+		{"valid int", "12", 8, 12},
+		{"empty uses default", "", 8, 8},
+		{"invalid uses default", "invalid", 8, 8},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "TEST_INT_VAR"
+			if tt.envValue != "" {
+				os.Setenv(key, tt.envValue)
+				defer os.Unsetenv(key)
+			} else {
+				os.Unsetenv(key)
+			}
+
+			got := getIntEnv(key, tt.defaultValue)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetListEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     []string
+	}{
+		{"entries", "email, token ,ip", []string{"email", "token", "ip"}},
+		{"empty uses default", "", []string{"default"}},
+		{"blank entries skipped", "email,, ,ip", []string{"email", "ip"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "TEST_LIST_VAR"
+			if tt.envValue != "" {
+				os.Setenv(key, tt.envValue)
+				defer os.Unsetenv(key)
+			} else {
+				os.Unsetenv(key)
+			}
+
+			got := getListEnv(key, []string{"default"})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetIntMapEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     map[string]int
+	}{
+		{"pairs", "leaderboard=400, admin=20", map[string]int{"leaderboard": 400, "admin": 20}},
+		{"empty", "", nil},
+		{"malformed entries skipped", "leaderboard=400,admin,teams=x,=5", map[string]int{"leaderboard": 400}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "TEST_INT_MAP_VAR"
+			if tt.envValue != "" {
+				os.Setenv(key, tt.envValue)
+				defer os.Unsetenv(key)
+			} else {
+				os.Unsetenv(key)
+			}
+
+			got := getIntMapEnv(key)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetFloatMapEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     map[string]float64
+	}{
+		{"pairs", "matches=0.3, leaderboard=1", map[string]float64{"matches": 0.3, "leaderboard": 1}},
+		{"empty", "", nil},
+		{"malformed entries skipped", "matches=0.3,teams,admin=x,=0.5", map[string]float64{"matches": 0.3}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "TEST_FLOAT_MAP_VAR"
+			if tt.envValue != "" {
+				os.Setenv(key, tt.envValue)
+				defer os.Unsetenv(key)
+			} else {
+				os.Unsetenv(key)
+			}
+
+			got := getFloatMapEnv(key)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetDurationMapEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     map[string]time.Duration
+	}{
+		{"pairs", "leaderboard=2s, matches=500ms", map[string]time.Duration{"leaderboard": 2 * time.Second, "matches": 500 * time.Millisecond}},
+		{"empty", "", nil},
+		{"malformed entries skipped", "leaderboard=2s,teams,admin=5,=1s", map[string]time.Duration{"leaderboard": 2 * time.Second}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "TEST_DURATION_MAP_VAR"
+			if tt.envValue != "" {
+				os.Setenv(key, tt.envValue)
+				defer os.Unsetenv(key)
+			} else {
+				os.Unsetenv(key)
+			}
+
+			got := getDurationMapEnv(key)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestGetMapEnv(t *testing.T) {
+	tests := []struct {
+		name     string
+		envValue string
+		want     map[string]string
+	}{
+		{"pairs", "region=latam, dc=sao-paulo", map[string]string{"region": "latam", "dc": "sao-paulo"}},
+		{"empty", "", nil},
+		{"malformed entries skipped", "region=eu,latam,=x", map[string]string{"region": "eu"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			key := "TEST_MAP_VAR"
+			if tt.envValue != "" {
+				os.Setenv(key, tt.envValue)
+				defer os.Unsetenv(key)
+			} else {
+				os.Unsetenv(key)
+			}
+
+			got := getMapEnv(key)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,217 @@
+// Package activity provides the per-player event log behind profile activity feeds.
+package activity
+
+import (
+	"context"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+// Type identifies the kind of activity event.
+type Type string
+
+const (
+	// TypeMatchVerified marks a match the player took part in being verified.
+	TypeMatchVerified Type = "match_verified"
+
+	// TypeMatchUnverified marks a verified match the player took part in being
+	// reverted to draft, which removes it from the player's stats.
+	TypeMatchUnverified Type = "match_unverified"
+
+	// TypeMatchVoided marks a verified match the player took part in being
+	// voided, which removes it from the player's stats for good.
+	TypeMatchVoided Type = "match_voided"
+
+	// TypeTierChanged marks the player's tier in a game changing.
+	TypeTierChanged Type = "tier_changed"
+
+	// TypeTeamJoined marks the player creating or joining a tournament team.
+	TypeTeamJoined Type = "team_joined"
+
+	// TypeTournamentResult marks a tournament the player competed in finishing.
+	TypeTournamentResult Type = "tournament_result"
+
+	// TypePersonalRecord marks a verified match beating one of the player's personal bests in a game.
+	TypePersonalRecord Type = "personal_record"
+
+	// TypeSeasonReward marks the player receiving the reward for their final tier in a season.
+	TypeSeasonReward Type = "season_reward"
+
+	// TypeTeamBroadcast marks the captain of one of the player's teams broadcasting a message to it.
+	TypeTeamBroadcast Type = "team_broadcast"
+
+	// TypeDeadlineReminder marks a deadline the player's team has yet to act on coming up.
+	TypeDeadlineReminder Type = "deadline_reminder"
+
+	// TypeCaptainSucceeded marks one of the player's teams getting a new captain
+	// without the previous captain handing over.
+	TypeCaptainSucceeded Type = "captain_succeeded"
+)
+
+// Event is a single entry in a player's activity log. Only the fields relevant
+// to the event type are set.
+type Event struct {
+	ID            uuid.UUID         `bson:"_id" json:"id"`
+	PlayerID      uuid.UUID         `bson:"player_id" json:"player_id"`
+	Type          Type              `bson:"type" json:"type"`
+	GameID        *uuid.UUID        `bson:"game_id,omitempty" json:"game_id,omitempty"`
+	TournamentID  *uuid.UUID        `bson:"tournament_id,omitempty" json:"tournament_id,omitempty"`
+	TeamID        *uuid.UUID        `bson:"team_id,omitempty" json:"team_id,omitempty"`
+	MatchID       *uuid.UUID        `bson:"match_id,omitempty" json:"match_id,omitempty"`
+	TeamName      string            `bson:"team_name,omitempty" json:"team_name,omitempty"`
+	Placement     int               `bson:"placement,omitempty" json:"placement,omitempty"`
+	Kills         int               `bson:"kills,omitempty" json:"kills,omitempty"`
+	PreviousTier  player.Tier       `bson:"previous_tier,omitempty" json:"previous_tier,omitempty"`
+	Tier          player.Tier       `bson:"tier,omitempty" json:"tier,omitempty"`
+	Rank          int               `bson:"rank,omitempty" json:"rank,omitempty"`
+	TeamCount     int               `bson:"team_count,omitempty" json:"team_count,omitempty"`
+	Season        string            `bson:"season,omitempty" json:"season,omitempty"`
+	Badge         string            `bson:"badge,omitempty" json:"badge,omitempty"`
+	Title         string            `bson:"title,omitempty" json:"title,omitempty"`
+	XP            int               `bson:"xp,omitempty" json:"xp,omitempty"`
+	Record        player.RecordKind `bson:"record,omitempty" json:"record,omitempty"`
+	Value         int               `bson:"value,omitempty" json:"value,omitempty"`
+	PreviousValue int               `bson:"previous_value,omitempty" json:"previous_value,omitempty"`
+	Message       string            `bson:"message,omitempty" json:"message,omitempty"`
+	DueAt         *time.Time        `bson:"due_at,omitempty" json:"due_at,omitempty"`
+	OccurredAt    time.Time         `bson:"occurred_at" json:"occurred_at"`
+}
+
+func newEvent(playerID uuid.UUID, t Type) *Event {
+	return &Event{
+		ID:         uuid.New(),
+		PlayerID:   playerID,
+		Type:       t,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+// NewMatchVerified records a verified match with the team's placement and the player's kills.
+func NewMatchVerified(playerID, matchID, tournamentID, gameID uuid.UUID, placement, kills int) *Event {
+	e := newEvent(playerID, TypeMatchVerified)
+	e.MatchID = &matchID
+	e.TournamentID = &tournamentID
+	e.GameID = &gameID
+	e.Placement = placement
+	e.Kills = kills
+	return e
+}
+
+// NewMatchUnverified records the verification of a match being withdrawn.
+func NewMatchUnverified(playerID, matchID, tournamentID, gameID, teamID uuid.UUID) *Event {
+	e := newEvent(playerID, TypeMatchUnverified)
+	e.MatchID = &matchID
+	e.TournamentID = &tournamentID
+	e.GameID = &gameID
+	e.TeamID = &teamID
+	return e
+}
+
+// NewMatchVoided records a verified match being voided.
+func NewMatchVoided(playerID, matchID, tournamentID, gameID, teamID uuid.UUID) *Event {
+	e := newEvent(playerID, TypeMatchVoided)
+	e.MatchID = &matchID
+	e.TournamentID = &tournamentID
+	e.GameID = &gameID
+	e.TeamID = &teamID
+	return e
+}
+
+// NewTierChanged records a player's tier in a game moving from previous to tier.
+func NewTierChanged(playerID, gameID uuid.UUID, previous, tier player.Tier) *Event {
+	e := newEvent(playerID, TypeTierChanged)
+	e.GameID = &gameID
+	e.PreviousTier = previous
+	e.Tier = tier
+	return e
+}
+
+// NewTeamJoined records a player joining a tournament team.
+func NewTeamJoined(playerID, teamID, tournamentID uuid.UUID, teamName string) *Event {
+	e := newEvent(playerID, TypeTeamJoined)
+	e.TeamID = &teamID
+	e.TournamentID = &tournamentID
+	e.TeamName = teamName
+	return e
+}
+
+// NewTournamentResult records a team's final rank out of teamCount ranked teams.
+func NewTournamentResult(playerID, tournamentID, gameID, teamID uuid.UUID, teamName string, rank, teamCount int) *Event {
+	e := newEvent(playerID, TypeTournamentResult)
+	e.TournamentID = &tournamentID
+	e.GameID = &gameID
+	e.TeamID = &teamID
+	e.TeamName = teamName
+	e.Rank = rank
+	e.TeamCount = teamCount
+	return e
+}
+
+// NewPersonalRecord records a match beating a player's personal best in a game.
+func NewPersonalRecord(playerID, matchID, gameID uuid.UUID, record player.RecordKind, value, previous int) *Event {
+	e := newEvent(playerID, TypePersonalRecord)
+	e.MatchID = &matchID
+	e.GameID = &gameID
+	e.Record = record
+	e.Value = value
+	e.PreviousValue = previous
+	return e
+}
+
+// NewSeasonReward records the reward a player received for finishing a season in tier.
+func NewSeasonReward(playerID, gameID uuid.UUID, season string, tier player.Tier, badge, title string, xp int) *Event {
+	e := newEvent(playerID, TypeSeasonReward)
+	e.GameID = &gameID
+	e.Season = season
+	e.Tier = tier
+	e.Badge = badge
+	e.Title = title
+	e.XP = xp
+	return e
+}
+
+// NewTeamBroadcast records a message broadcast to a player's team by its captain.
+func NewTeamBroadcast(playerID, teamID, tournamentID uuid.UUID, teamName, message string) *Event {
+	e := newEvent(playerID, TypeTeamBroadcast)
+	e.TeamID = &teamID
+	e.TournamentID = &tournamentID
+	e.TeamName = teamName
+	e.Message = message
+	return e
+}
+
+// NewDeadlineReminder reminds a player that their team has to act by dueAt.
+func NewDeadlineReminder(playerID, teamID, tournamentID uuid.UUID, teamName, message string, dueAt time.Time) *Event {
+	e := newEvent(playerID, TypeDeadlineReminder)
+	e.TeamID = &teamID
+	e.TournamentID = &tournamentID
+	e.TeamName = teamName
+	e.Message = message
+	e.DueAt = &dueAt
+	return e
+}
+
+// NewCaptainSucceeded tells a player their team has a new captain. The message
+// names them and says why captaincy passed on.
+func NewCaptainSucceeded(playerID, teamID, tournamentID uuid.UUID, teamName, message string) *Event {
+	e := newEvent(playerID, TypeCaptainSucceeded)
+	e.TeamID = &teamID
+	e.TournamentID = &tournamentID
+	e.TeamName = teamName
+	e.Message = message
+	return e
+}
+
+// Repository persists the activity event log.
+type Repository interface {
+	// Create appends events to the log.
+	Create(ctx context.Context, events ...*Event) error
+
+	// ListByPlayer returns a player's events, newest first.
+	ListByPlayer(ctx context.Context, playerID uuid.UUID, limit, offset int) ([]*Event, error)
+
+	// CountByPlayer returns the number of events logged for a player.
+	CountByPlayer(ctx context.Context, playerID uuid.UUID) (int64, error)
+}
@@ -0,0 +1,108 @@
+package activity
+
+import (
+	"context"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+// SiteFeedSize is how many events the public site-wide feed retains.
+const SiteFeedSize = 1000
+
+const (
+	// TypeTournamentFinished marks a tournament finishing.
+	TypeTournamentFinished Type = "tournament_finished"
+
+	// TypeTierPromoted marks a player moving up a tier.
+	TypeTierPromoted Type = "tier_promoted"
+)
+
+// SiteEvent is an entry in the public site-wide activity feed. Events that
+// name a player keep the player's ID so privacy settings can be applied when
+// the feed is read; the ID itself is never exposed.
+type SiteEvent struct {
+	ID             uuid.UUID         `bson:"_id" json:"id"`
+	Type           Type              `bson:"type" json:"type"`
+	PlayerID       *uuid.UUID        `bson:"player_id,omitempty" json:"-"`
+	PlayerName     string            `bson:"-" json:"player_name,omitempty"`
+	GameID         *uuid.UUID        `bson:"game_id,omitempty" json:"game_id,omitempty"`
+	TournamentID   *uuid.UUID        `bson:"tournament_id,omitempty" json:"tournament_id,omitempty"`
+	TournamentName string            `bson:"tournament_name,omitempty" json:"tournament_name,omitempty"`
+	TeamID         *uuid.UUID        `bson:"team_id,omitempty" json:"team_id,omitempty"`
+	TeamName       string            `bson:"team_name,omitempty" json:"team_name,omitempty"`
+	MatchID        *uuid.UUID        `bson:"match_id,omitempty" json:"match_id,omitempty"`
+	Placement      int               `bson:"placement,omitempty" json:"placement,omitempty"`
+	Kills          int               `bson:"kills,omitempty" json:"kills,omitempty"`
+	PreviousTier   player.Tier       `bson:"previous_tier,omitempty" json:"previous_tier,omitempty"`
+	Tier           player.Tier       `bson:"tier,omitempty" json:"tier,omitempty"`
+	Record         player.RecordKind `bson:"record,omitempty" json:"record,omitempty"`
+	Value          int               `bson:"value,omitempty" json:"value,omitempty"`
+	OccurredAt     time.Time         `bson:"occurred_at" json:"occurred_at"`
+}
+
+func newSiteEvent(t Type) *SiteEvent {
+	return &SiteEvent{
+		ID:         uuid.New(),
+		Type:       t,
+		OccurredAt: time.Now().UTC(),
+	}
+}
+
+// NewSiteMatchVerified records a verified match with the team's placement and kills.
+func NewSiteMatchVerified(matchID, tournamentID, gameID, teamID uuid.UUID, tournamentName, teamName string, placement, kills int) *SiteEvent {
+	e := newSiteEvent(TypeMatchVerified)
+	e.MatchID = &matchID
+	e.TournamentID = &tournamentID
+	e.GameID = &gameID
+	e.TeamID = &teamID
+	e.TournamentName = tournamentName
+	e.TeamName = teamName
+	e.Placement = placement
+	e.Kills = kills
+	return e
+}
+
+// NewSiteTournamentFinished records a tournament finishing. winner is nil when
+// no team has a verified match.
+func NewSiteTournamentFinished(tournamentID, gameID uuid.UUID, tournamentName string, winner *uuid.UUID, winnerName string) *SiteEvent {
+	e := newSiteEvent(TypeTournamentFinished)
+	e.TournamentID = &tournamentID
+	e.GameID = &gameID
+	e.TournamentName = tournamentName
+	e.TeamID = winner
+	e.TeamName = winnerName
+	return e
+}
+
+// NewSiteTierPromoted records a player moving up from previous to tier in a game.
+func NewSiteTierPromoted(playerID, gameID uuid.UUID, previous, tier player.Tier) *SiteEvent {
+	e := newSiteEvent(TypeTierPromoted)
+	e.PlayerID = &playerID
+	e.GameID = &gameID
+	e.PreviousTier = previous
+	e.Tier = tier
+	return e
+}
+
+// NewSitePersonalRecord records a player beating a personal best in a game.
+func NewSitePersonalRecord(playerID, matchID, gameID uuid.UUID, record player.RecordKind, value int) *SiteEvent {
+	e := newSiteEvent(TypePersonalRecord)
+	e.PlayerID = &playerID
+	e.MatchID = &matchID
+	e.GameID = &gameID
+	e.Record = record
+	e.Value = value
+	return e
+}
+
+// SiteFeedRepository persists the public site-wide activity feed. Only the
+// newest SiteFeedSize events are kept.
+type SiteFeedRepository interface {
+	// Append adds an event to the feed.
+	Append(ctx context.Context, event *SiteEvent) error
+
+	// ListRecent returns the newest events, newest first.
+	ListRecent(ctx context.Context, limit int) ([]*SiteEvent, error)
+}
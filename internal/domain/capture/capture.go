@@ -0,0 +1,171 @@
+// Package capture provides recorded API exchanges for debugging match
+// submission and verification issues. Captures are opt-in, sanitized before
+// they are stored and expire after a configured time.
+package capture
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxBodyBytes is the most of a request or response body kept. Longer
+	// bodies are dropped rather than cut, since a cut JSON body cannot be
+	// sanitized.
+	MaxBodyBytes = 64 << 10
+
+	// Redacted replaces the value of sensitive fields.
+	Redacted = "[REDACTED]"
+)
+
+var (
+	ErrNotFound      = errors.New("request capture not found")
+	ErrInvalidKind   = errors.New("invalid request capture kind")
+	ErrNotReplayable = errors.New("only captured match submissions can be replayed")
+)
+
+// Kind is the endpoint a capture was recorded on.
+type Kind string
+
+const (
+	KindSubmission          Kind = "submission"           // POST /api/v1/matches/report
+	KindResubmission        Kind = "resubmission"         // PUT /api/v1/matches/{id}
+	KindConnectorSubmission Kind = "connector_submission" // POST /api/v1/connectors/matches
+	KindVerification        Kind = "verification"         // PATCH /api/v1/admin/matches/{id}/verify
+	KindAmendment           Kind = "amendment"            // POST /api/v1/admin/matches/{id}/amendments
+	KindUnverification      Kind = "unverification"       // POST /api/v1/admin/matches/{id}/unverify
+	KindVoid                Kind = "void"                 // PATCH /api/v1/admin/matches/{id}/void
+)
+
+// IsValid reports whether the kind is known.
+func (k Kind) IsValid() bool {
+	switch k {
+	case KindSubmission, KindResubmission, KindConnectorSubmission,
+		KindVerification, KindAmendment, KindUnverification, KindVoid:
+		return true
+	}
+	return false
+}
+
+// sensitiveFields are substrings of field names whose values are never
+// stored, matched case-insensitively.
+var sensitiveFields = []string{"password", "token", "secret", "signature", "api_key", "authorization", "email"}
+
+// Exchange is a request and the response it got, as seen by the server.
+type Exchange struct {
+	Kind              Kind
+	Method            string
+	Path              string
+	UserID            string // Empty for requests not made by a user
+	Request           []byte
+	RequestTruncated  bool // Request holds only the start of a longer body
+	Status            int
+	Response          []byte
+	ResponseTruncated bool // Response holds only the start of a longer body
+	Duration          time.Duration
+}
+
+// Capture is a sanitized exchange kept for debugging.
+type Capture struct {
+	ID           uuid.UUID `bson:"_id" json:"id"`
+	Kind         Kind      `bson:"kind" json:"kind"`
+	Method       string    `bson:"method" json:"method"`
+	Path         string    `bson:"path" json:"path"`
+	UserID       string    `bson:"user_id,omitempty" json:"user_id,omitempty"`
+	RequestBody  string    `bson:"request_body,omitempty" json:"request_body,omitempty"`
+	Status       int       `bson:"status" json:"status"`
+	ResponseBody string    `bson:"response_body,omitempty" json:"response_body,omitempty"`
+	BodyDropped  bool      `bson:"body_dropped,omitempty" json:"body_dropped,omitempty"` // A body was too long or not JSON and was not kept
+	DurationMS   int64     `bson:"duration_ms" json:"duration_ms"`
+	CapturedAt   time.Time `bson:"captured_at" json:"captured_at"`
+	ExpiresAt    time.Time `bson:"expires_at" json:"expires_at"`
+}
+
+// NewCapture sanitizes an exchange into a capture that expires after ttl.
+func NewCapture(ex Exchange, ttl time.Duration, now time.Time) *Capture {
+	request, requestKept := Sanitize(ex.Request, ex.RequestTruncated)
+	response, responseKept := Sanitize(ex.Response, ex.ResponseTruncated)
+
+	return &Capture{
+		ID:           uuid.New(),
+		Kind:         ex.Kind,
+		Method:       ex.Method,
+		Path:         ex.Path,
+		UserID:       ex.UserID,
+		RequestBody:  request,
+		Status:       ex.Status,
+		ResponseBody: response,
+		BodyDropped:  !requestKept || !responseKept,
+		DurationMS:   ex.Duration.Milliseconds(),
+		CapturedAt:   now,
+		ExpiresAt:    now.Add(ttl),
+	}
+}
+
+// Replayable reports whether the capture can be replayed against the
+// dry-run submission path.
+func (c *Capture) Replayable() bool {
+	return c.Kind == KindSubmission && c.RequestBody != ""
+}
+
+// Sanitize returns a JSON body with the values of sensitive fields replaced
+// by Redacted, and whether the body was kept. Truncated, oversized and
+// non-JSON bodies are dropped, since they cannot be checked for secrets. An
+// empty body is kept as it is.
+func Sanitize(body []byte, truncated bool) (string, bool) {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return "", !truncated
+	}
+	if truncated || len(body) > MaxBodyBytes {
+		return "", false
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var v interface{}
+	if err := decoder.Decode(&v); err != nil || decoder.More() {
+		return "", false
+	}
+
+	sanitized, err := json.Marshal(redact(v))
+	if err != nil {
+		return "", false
+	}
+	return string(sanitized), true
+}
+
+// redact replaces the values of sensitive fields throughout a decoded JSON value.
+func redact(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			if isSensitive(key) {
+				v[key] = Redacted
+				continue
+			}
+			v[key] = redact(value)
+		}
+	case []interface{}:
+		for i, value := range v {
+			v[i] = redact(value)
+		}
+	}
+	return v
+}
+
+// isSensitive reports whether a field name looks like it holds a secret or
+// personal data.
+func isSensitive(field string) bool {
+	field = strings.ToLower(field)
+	for _, s := range sensitiveFields {
+		if strings.Contains(field, s) {
+			return true
+		}
+	}
+	return false
+}
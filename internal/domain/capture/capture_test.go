@@ -0,0 +1,87 @@
+package capture
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		body      string
+		truncated bool
+		expected  string
+		kept      bool
+	}{
+		{"empty", "", false, "", true},
+		{"empty truncated", "", true, "", false},
+		{"plain", `{"team_kills":7,"screenshot_url":"https://cdn.example.com/a.png"}`, false, `{"screenshot_url":"https://cdn.example.com/a.png","team_kills":7}`, true},
+		{"nested secrets", `{"connector":{"Secret":"s3cr3t","name":"bot"},"users":[{"email":"a@example.com"}]}`, false, `{"connector":{"Secret":"[REDACTED]","name":"bot"},"users":[{"email":"[REDACTED]"}]}`, true},
+		{"large numbers kept exact", `{"damage":12345678901234567890}`, false, `{"damage":12345678901234567890}`, true},
+		{"not json", "team_kills=7", false, "", false},
+		{"trailing data", `{"a":1} {"b":2}`, false, "", false},
+		{"truncated", `{"password":"hunter2"`, true, "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			sanitized, kept := Sanitize([]byte(tt.body), tt.truncated)
+			assert.Equal(t, tt.expected, sanitized)
+			assert.Equal(t, tt.kept, kept)
+		})
+	}
+}
+
+func TestSanitize_TooLong(t *testing.T) {
+	t.Parallel()
+
+	body := `{"notes":"` + strings.Repeat("x", MaxBodyBytes) + `"}`
+	sanitized, kept := Sanitize([]byte(body), false)
+	assert.Empty(t, sanitized)
+	assert.False(t, kept)
+}
+
+func TestNewCapture(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	c := NewCapture(Exchange{
+		Kind:     KindSubmission,
+		Method:   "POST",
+		Path:     "/api/v1/matches/report",
+		UserID:   uuid.NewString(),
+		Request:  []byte(`{"team_kills":7}`),
+		Status:   400,
+		Response: []byte(`{"error":"team size mismatch"}`),
+		Duration: 42 * time.Millisecond,
+	}, 72*time.Hour, now)
+
+	assert.Equal(t, `{"team_kills":7}`, c.RequestBody)
+	assert.Equal(t, `{"error":"team size mismatch"}`, c.ResponseBody)
+	assert.False(t, c.BodyDropped)
+	assert.Equal(t, int64(42), c.DurationMS)
+	assert.Equal(t, now.Add(72*time.Hour), c.ExpiresAt)
+	assert.True(t, c.Replayable())
+
+	dropped := NewCapture(Exchange{Kind: KindVerification, Request: []byte("x"), RequestTruncated: true}, time.Hour, now)
+	assert.True(t, dropped.BodyDropped)
+	assert.False(t, dropped.Replayable())
+}
+
+func TestKind_IsValid(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, KindSubmission.IsValid())
+	require.True(t, KindVoid.IsValid())
+	require.False(t, Kind("login").IsValid())
+	require.False(t, Kind("").IsValid())
+}
@@ -0,0 +1,18 @@
+package capture
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository persists request captures. Stores are expected to delete
+// captures once they expire.
+type Repository interface {
+	Create(ctx context.Context, c *Capture) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Capture, error)
+
+	// List returns the newest captures, of one kind or, for the empty kind,
+	// of every kind.
+	List(ctx context.Context, kind Kind, limit int64) ([]*Capture, error)
+}
@@ -0,0 +1,103 @@
+// Package developer provides API keys and quotas for community developers
+// using the public API.
+package developer
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// KeyPrefix starts every API key, so leaked keys are easy to recognize.
+	KeyPrefix = "trk_"
+
+	// MaxKeysPerUser is how many active keys a user may hold.
+	MaxKeysPerUser = 5
+
+	// MaxKeyNameLength is the longest key name, in characters.
+	MaxKeyNameLength = 50
+
+	// hintLength is how many characters of a key are kept to tell keys apart.
+	hintLength = 8
+)
+
+var (
+	ErrKeyNotFound    = errors.New("API key not found")
+	ErrInvalidKey     = errors.New("invalid API key")
+	ErrTooManyKeys    = errors.New("too many active API keys")
+	ErrInvalidKeyName = errors.New("API key name must be 1 to 50 characters")
+)
+
+// APIKey identifies a developer's requests to the public API. Only a hash of
+// the key is stored; the key itself is shown once, when it is created.
+type APIKey struct {
+	ID        uuid.UUID  `bson:"_id" json:"id"`
+	UserID    uuid.UUID  `bson:"user_id" json:"user_id"`
+	Name      string     `bson:"name" json:"name"`
+	Hint      string     `bson:"hint" json:"hint"` // The key's first characters
+	KeyHash   string     `bson:"key_hash" json:"-"`
+	CreatedAt time.Time  `bson:"created_at" json:"created_at"`
+	RevokedAt *time.Time `bson:"revoked_at,omitempty" json:"revoked_at,omitempty"`
+}
+
+// NewAPIKey creates a key for the user and returns it with the key to hand
+// to the developer.
+func NewAPIKey(userID uuid.UUID, name string, now time.Time) (*APIKey, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxKeyNameLength {
+		return nil, "", ErrInvalidKeyName
+	}
+
+	raw := make([]byte, 24)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("generating API key: %w", err)
+	}
+	key := KeyPrefix + base64.RawURLEncoding.EncodeToString(raw)
+
+	return &APIKey{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		Hint:      key[:len(KeyPrefix)+hintLength],
+		KeyHash:   HashKey(key),
+		CreatedAt: now,
+	}, key, nil
+}
+
+// HashKey returns the stored form of an API key.
+func HashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Active reports whether the key still authorizes requests.
+func (k *APIKey) Active() bool {
+	return k.RevokedAt == nil
+}
+
+// Revoke stops the key from authorizing requests.
+func (k *APIKey) Revoke(now time.Time) {
+	if k.RevokedAt == nil {
+		k.RevokedAt = &now
+	}
+}
+
+// KeyRepository persists API keys.
+type KeyRepository interface {
+	Create(ctx context.Context, key *APIKey) error
+	Update(ctx context.Context, key *APIKey) error
+	GetByID(ctx context.Context, id uuid.UUID) (*APIKey, error)
+	GetByHash(ctx context.Context, keyHash string) (*APIKey, error)
+	// ListByUser returns the user's keys, revoked ones included, oldest first.
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]*APIKey, error)
+}
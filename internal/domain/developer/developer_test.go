@@ -0,0 +1,93 @@
+package developer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewAPIKey(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	now := time.Now().UTC()
+
+	k, key, err := NewAPIKey(userID, "  Stats bot ", now)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(key, KeyPrefix))
+	require.Equal(t, "Stats bot", k.Name)
+	require.Equal(t, userID, k.UserID)
+	require.True(t, strings.HasPrefix(key, k.Hint))
+	require.Equal(t, HashKey(key), k.KeyHash)
+	require.NotContains(t, k.KeyHash, key)
+	require.True(t, k.Active())
+
+	_, other, err := NewAPIKey(userID, "Stats bot", now)
+	require.NoError(t, err)
+	require.NotEqual(t, key, other)
+
+	_, _, err = NewAPIKey(userID, "   ", now)
+	require.ErrorIs(t, err, ErrInvalidKeyName)
+	_, _, err = NewAPIKey(userID, strings.Repeat("a", MaxKeyNameLength+1), now)
+	require.ErrorIs(t, err, ErrInvalidKeyName)
+}
+
+func TestAPIKey_Revoke(t *testing.T) {
+	t.Parallel()
+
+	k, _, err := NewAPIKey(uuid.New(), "Stats bot", time.Now().UTC())
+	require.NoError(t, err)
+
+	revokedAt := time.Now().UTC()
+	k.Revoke(revokedAt)
+	require.False(t, k.Active())
+
+	k.Revoke(revokedAt.Add(time.Hour))
+	require.Equal(t, revokedAt, *k.RevokedAt, "revoking twice keeps the first time")
+}
+
+func TestWindow(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 14, 15, 9, 26, 0, time.UTC)
+
+	require.Equal(t, time.Date(2026, 3, 14, 15, 9, 0, 0, time.UTC), WindowMinute.Start(now))
+	require.Equal(t, time.Date(2026, 3, 14, 15, 10, 0, 0, time.UTC), WindowMinute.Reset(now))
+	require.Equal(t, time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC), WindowDay.Start(now))
+	require.Equal(t, time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC), WindowDay.Reset(now))
+}
+
+func TestQuota_Check(t *testing.T) {
+	t.Parallel()
+
+	keyID := uuid.New()
+	now := time.Date(2026, 3, 14, 15, 9, 26, 0, time.UTC)
+	q := Quota{PerMinute: 2, PerDay: 10}
+
+	d := q.Check(keyID, 1, 4, now)
+	require.True(t, d.Allowed)
+	require.Equal(t, int64(10), d.Limit)
+	require.Equal(t, int64(6), d.Remaining)
+	require.Equal(t, WindowDay.Reset(now), d.ResetAt)
+
+	d = q.Check(keyID, 3, 5, now)
+	require.False(t, d.Allowed)
+	require.Equal(t, WindowMinute, d.Window)
+	require.Equal(t, int64(2), d.Limit)
+	require.Equal(t, WindowMinute.Reset(now), d.ResetAt)
+
+	d = q.Check(keyID, 1, 10, now)
+	require.True(t, d.Allowed, "the last request of the day is allowed")
+	require.Zero(t, d.Remaining)
+
+	d = q.Check(keyID, 1, 11, now)
+	require.False(t, d.Allowed)
+	require.Equal(t, WindowDay, d.Window)
+
+	d = Quota{}.Check(keyID, 1000, 100000, now)
+	require.True(t, d.Allowed, "zero leaves both windows unlimited")
+	require.Zero(t, d.Limit)
+}
@@ -0,0 +1,101 @@
+package developer
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Window is a fixed period a quota counts requests in.
+type Window string
+
+const (
+	WindowMinute Window = "minute"
+	WindowDay    Window = "day"
+)
+
+// Start returns the start of the window containing t.
+func (w Window) Start(t time.Time) time.Time {
+	t = t.UTC()
+	if w == WindowDay {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	}
+	return t.Truncate(time.Minute)
+}
+
+// Reset returns when the window containing t ends.
+func (w Window) Reset(t time.Time) time.Time {
+	if w == WindowDay {
+		return w.Start(t).AddDate(0, 0, 1)
+	}
+	return w.Start(t).Add(time.Minute)
+}
+
+// Quota limits the requests each key may make per minute and per day. Every
+// request made with a key counts, including rejected ones. Zero leaves a
+// window unlimited.
+type Quota struct {
+	PerMinute int64 `json:"per_minute"`
+	PerDay    int64 `json:"per_day"`
+}
+
+// Decision is the outcome of checking a request against its key's quota.
+// Limit, Remaining and ResetAt describe the daily window unless the minute
+// window rejected the request.
+type Decision struct {
+	KeyID     uuid.UUID
+	Allowed   bool
+	Window    Window // Window that rejected the request
+	Limit     int64
+	Remaining int64
+	ResetAt   time.Time
+}
+
+// Check decides whether a request is within the quota, given the key's
+// request counts in the current minute and day, this request included.
+func (q Quota) Check(keyID uuid.UUID, minute, day int64, now time.Time) Decision {
+	if q.PerMinute > 0 && minute > q.PerMinute {
+		return Decision{KeyID: keyID, Window: WindowMinute, Limit: q.PerMinute, ResetAt: WindowMinute.Reset(now)}
+	}
+
+	d := Decision{KeyID: keyID, Allowed: true, Limit: q.PerDay, ResetAt: WindowDay.Reset(now)}
+	if q.PerDay > 0 {
+		d.Remaining = max(q.PerDay-day, 0)
+		if day > q.PerDay {
+			d.Allowed = false
+			d.Window = WindowDay
+		}
+	}
+	return d
+}
+
+// anonymousNamespace derives the IDs anonymous requests are counted under.
+var anonymousNamespace = uuid.MustParse("5d0f3c1e-8a4b-4f7e-9c2d-6b1a7e3f9d40")
+
+// AnonymousID returns the ID that requests made without a key from a client
+// address are counted under, in place of a key ID.
+func AnonymousID(address string) uuid.UUID {
+	return uuid.NewSHA1(anonymousNamespace, []byte(address))
+}
+
+// Usage is a key's request count in one window.
+type Usage struct {
+	KeyID    uuid.UUID `bson:"key_id" json:"-"`
+	Window   Window    `bson:"window" json:"-"`
+	Start    time.Time `bson:"start" json:"start"`
+	Requests int64     `bson:"requests" json:"requests"`
+	Rejected int64     `bson:"rejected" json:"rejected"` // Requests refused for exceeding the quota
+}
+
+// UsageRepository counts requests made with API keys.
+type UsageRepository interface {
+	// Count adds a request to the key's window starting at start and returns
+	// the window's new request count.
+	Count(ctx context.Context, keyID uuid.UUID, window Window, start time.Time) (int64, error)
+	// Reject records a rejected request in the key's window starting at start.
+	Reject(ctx context.Context, keyID uuid.UUID, window Window, start time.Time) error
+	// List returns the key's usage in windows starting at or after from,
+	// oldest first.
+	List(ctx context.Context, keyID uuid.UUID, window Window, from time.Time) ([]*Usage, error)
+}
@@ -0,0 +1,325 @@
+package game
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// MaxStatFormulaLength is the longest derived stat formula accepted, in characters.
+const MaxStatFormulaLength = 200
+
+// StatMatchesPlayed is the variable derived stat formulas use for the number
+// of matches a player has played.
+const StatMatchesPlayed = "matches_played"
+
+// StatFormula is a parsed derived stat expression, e.g.
+// "total_damage / total_kills". It supports numbers, stat keys, the
+// operators + - * / and parentheses.
+type StatFormula struct {
+	root formulaNode
+	vars []string
+}
+
+// ParseStatFormula parses a derived stat expression.
+func ParseStatFormula(expression string) (*StatFormula, error) {
+	if len(expression) > MaxStatFormulaLength {
+		return nil, fmt.Errorf("%w: formula is longer than %d characters", ErrInvalidStatSchema, MaxStatFormulaLength)
+	}
+
+	p := &formulaParser{input: expression, vars: make(map[string]bool)}
+	p.next()
+	root, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokenEOF {
+		return nil, p.errorf("unexpected %q", p.tok.text)
+	}
+
+	vars := make([]string, 0, len(p.vars))
+	for v := range p.vars {
+		vars = append(vars, v)
+	}
+	sort.Strings(vars)
+
+	return &StatFormula{root: root, vars: vars}, nil
+}
+
+// Stats returns the stat keys the formula reads, sorted.
+func (f *StatFormula) Stats() []string {
+	return f.vars
+}
+
+// Eval computes the formula. Stats missing from values count as zero and a
+// division by zero yields zero, so a player without kills has a
+// damage-per-kill of 0 rather than no value.
+func (f *StatFormula) Eval(values map[string]float64) float64 {
+	return f.root.eval(values)
+}
+
+// IsDerived reports whether the field is computed from a formula rather
+// than reported in matches.
+func (f StatField) IsDerived() bool {
+	return f.Formula != ""
+}
+
+// HasDerived reports whether any field of the schema is derived.
+func (s StatSchema) HasDerived() bool {
+	for _, field := range s {
+		if field.IsDerived() {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the schema's derived fields and rollups: every formula
+// must parse and may read reported stats only, which rules out cycles
+// between derived stats.
+func (s StatSchema) Validate() error {
+	if err := s.validateRollups(); err != nil {
+		return err
+	}
+
+	for key, field := range s {
+		if !field.IsDerived() {
+			continue
+		}
+
+		formula, err := ParseStatFormula(field.Formula)
+		if err != nil {
+			return fmt.Errorf("stat %q: %w", key, err)
+		}
+		for _, stat := range formula.Stats() {
+			if s[stat].IsDerived() {
+				return fmt.Errorf("%w: stat %q reads derived stat %q", ErrInvalidStatSchema, key, stat)
+			}
+		}
+	}
+	return nil
+}
+
+// DerivedStats computes the game's derived stats from a player's
+// accumulated stats. Non-numeric stats are ignored.
+func (g *Game) DerivedStats(stats map[string]interface{}, matchesPlayed int) map[string]float64 {
+	var values map[string]float64
+	derived := make(map[string]float64)
+
+	for key, field := range g.StatSchema {
+		if !field.IsDerived() {
+			continue
+		}
+		// Schemas are validated when saved; a formula that no longer parses
+		// is skipped rather than failing the match that triggered it.
+		formula, err := ParseStatFormula(field.Formula)
+		if err != nil {
+			continue
+		}
+
+		if values == nil {
+			values = numericStats(stats)
+			values[StatMatchesPlayed] = float64(matchesPlayed)
+		}
+		derived[key] = formula.Eval(values)
+	}
+
+	return derived
+}
+
+// numericStats returns the numeric entries of a stats map as float64.
+func numericStats(stats map[string]interface{}) map[string]float64 {
+	values := make(map[string]float64, len(stats)+1)
+	for key, v := range stats {
+		switch n := v.(type) {
+		case int:
+			values[key] = float64(n)
+		case int32:
+			values[key] = float64(n)
+		case int64:
+			values[key] = float64(n)
+		case float64:
+			values[key] = n
+		}
+	}
+	return values
+}
+
+type formulaNode interface {
+	eval(values map[string]float64) float64
+}
+
+type numberNode float64
+
+func (n numberNode) eval(map[string]float64) float64 { return float64(n) }
+
+type statNode string
+
+func (n statNode) eval(values map[string]float64) float64 { return values[string(n)] }
+
+type negateNode struct{ operand formulaNode }
+
+func (n negateNode) eval(values map[string]float64) float64 { return -n.operand.eval(values) }
+
+type binaryNode struct {
+	op          byte
+	left, right formulaNode
+}
+
+func (n binaryNode) eval(values map[string]float64) float64 {
+	left, right := n.left.eval(values), n.right.eval(values)
+	switch n.op {
+	case '+':
+		return left + right
+	case '-':
+		return left - right
+	case '*':
+		return left * right
+	default:
+		if right == 0 {
+			return 0
+		}
+		return left / right
+	}
+}
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenNumber
+	tokenStat
+	tokenOperator
+)
+
+type formulaToken struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// formulaParser is a recursive descent parser over the grammar
+//
+//	sum     = product { ("+" | "-") product }
+//	product = unary { ("*" | "/") unary }
+//	unary   = "-" unary | operand
+//	operand = number | stat | "(" sum ")"
+type formulaParser struct {
+	input string
+	pos   int
+	tok   formulaToken
+	vars  map[string]bool
+}
+
+func (p *formulaParser) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: formula at position %d: %s", ErrInvalidStatSchema, p.tok.pos+1, fmt.Sprintf(format, args...))
+}
+
+// next advances to the following token.
+func (p *formulaParser) next() {
+	for p.pos < len(p.input) && (p.input[p.pos] == ' ' || p.input[p.pos] == '\t') {
+		p.pos++
+	}
+	start := p.pos
+	if p.pos >= len(p.input) {
+		p.tok = formulaToken{kind: tokenEOF, text: "end of formula", pos: start}
+		return
+	}
+
+	c := p.input[p.pos]
+	switch {
+	case c >= '0' && c <= '9' || c == '.':
+		for p.pos < len(p.input) && (p.input[p.pos] >= '0' && p.input[p.pos] <= '9' || p.input[p.pos] == '.') {
+			p.pos++
+		}
+		p.tok = formulaToken{kind: tokenNumber, text: p.input[start:p.pos], pos: start}
+	case c >= 'a' && c <= 'z' || c == '_':
+		for p.pos < len(p.input) && isStatKeyChar(p.input[p.pos]) {
+			p.pos++
+		}
+		p.tok = formulaToken{kind: tokenStat, text: p.input[start:p.pos], pos: start}
+	default:
+		p.pos++
+		p.tok = formulaToken{kind: tokenOperator, text: string(c), pos: start}
+	}
+}
+
+func isStatKeyChar(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= '0' && c <= '9' || c == '_'
+}
+
+func (p *formulaParser) parseSum() (formulaNode, error) {
+	left, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokenOperator && (p.tok.text == "+" || p.tok.text == "-") {
+		op := p.tok.text[0]
+		p.next()
+		right, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *formulaParser) parseProduct() (formulaNode, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for p.tok.kind == tokenOperator && (p.tok.text == "*" || p.tok.text == "/") {
+		op := p.tok.text[0]
+		p.next()
+		right, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		left = binaryNode{op: op, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *formulaParser) parseUnary() (formulaNode, error) {
+	if p.tok.kind == tokenOperator && p.tok.text == "-" {
+		p.next()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return negateNode{operand: operand}, nil
+	}
+	return p.parseOperand()
+}
+
+func (p *formulaParser) parseOperand() (formulaNode, error) {
+	tok := p.tok
+	switch {
+	case tok.kind == tokenNumber:
+		value, err := strconv.ParseFloat(tok.text, 64)
+		if err != nil {
+			return nil, p.errorf("invalid number %q", tok.text)
+		}
+		p.next()
+		return numberNode(value), nil
+	case tok.kind == tokenStat:
+		p.vars[tok.text] = true
+		p.next()
+		return statNode(tok.text), nil
+	case tok.kind == tokenOperator && tok.text == "(":
+		p.next()
+		inner, err := p.parseSum()
+		if err != nil {
+			return nil, err
+		}
+		if p.tok.kind != tokenOperator || p.tok.text != ")" {
+			return nil, p.errorf("expected \")\", found %q", p.tok.text)
+		}
+		p.next()
+		return inner, nil
+	default:
+		return nil, p.errorf("expected a number, stat or \"(\", found %q", tok.text)
+	}
+}
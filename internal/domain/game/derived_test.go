@@ -0,0 +1,122 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStatFormula(t *testing.T) {
+	t.Parallel()
+
+	values := map[string]float64{"total_damage": 1200, "total_kills": 8, "total_deaths": 4, "matches_played": 3}
+
+	tests := []struct {
+		name          string
+		expression    string
+		expected      float64
+		expectedStats []string
+		expectedErr   bool
+	}{
+		{name: "ratio", expression: "total_damage / total_kills", expected: 150, expectedStats: []string{"total_damage", "total_kills"}},
+		{name: "precedence", expression: "total_kills + total_deaths * 2", expected: 16, expectedStats: []string{"total_deaths", "total_kills"}},
+		{name: "parentheses", expression: "(total_kills + total_deaths) / matches_played", expected: 4, expectedStats: []string{"matches_played", "total_deaths", "total_kills"}},
+		{name: "unary minus and decimals", expression: "-total_deaths * 0.5 + 10", expected: 8, expectedStats: []string{"total_deaths"}},
+		{name: "division by zero is zero", expression: "total_kills / total_assists", expected: 0, expectedStats: []string{"total_assists", "total_kills"}},
+		{name: "empty", expression: "", expectedErr: true},
+		{name: "dangling operator", expression: "total_kills /", expectedErr: true},
+		{name: "unbalanced parentheses", expression: "(total_kills + 1", expectedErr: true},
+		{name: "unknown character", expression: "total_kills % 2", expectedErr: true},
+		{name: "malformed number", expression: "1.2.3", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			f, err := ParseStatFormula(tt.expression)
+			if tt.expectedErr {
+				require.ErrorIs(t, err, ErrInvalidStatSchema)
+				return
+			}
+			require.NoError(t, err)
+			assert.InDelta(t, tt.expected, f.Eval(values), 1e-9)
+			assert.Equal(t, tt.expectedStats, f.Stats())
+		})
+	}
+}
+
+func TestStatSchema_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		schema      StatSchema
+		expectedErr bool
+	}{
+		{
+			name: "reported stats only",
+			schema: StatSchema{
+				"kills":           StatField{Type: "integer"},
+				"damage_per_kill": StatField{Type: "float", Formula: "total_damage / total_kills"},
+			},
+		},
+		{
+			name:        "invalid formula",
+			schema:      StatSchema{"broken": StatField{Formula: "total_kills *"}},
+			expectedErr: true,
+		},
+		{
+			name:        "reads itself",
+			schema:      StatSchema{"loop": StatField{Formula: "loop + 1"}},
+			expectedErr: true,
+		},
+		{
+			name: "reads another derived stat",
+			schema: StatSchema{
+				"kd":        StatField{Formula: "total_kills / total_deaths"},
+				"double_kd": StatField{Formula: "kd * 2"},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tt.schema.Validate()
+			if tt.expectedErr {
+				require.ErrorIs(t, err, ErrInvalidStatSchema)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGame_DerivedStats(t *testing.T) {
+	t.Parallel()
+
+	g := &Game{StatSchema: StatSchema{
+		"kills":            StatField{Type: "integer"},
+		"damage_per_kill":  StatField{Formula: "total_damage / total_kills"},
+		"kills_per_match":  StatField{Formula: "total_kills / matches_played"},
+		"label_is_ignored": StatField{Formula: "nickname + 1"},
+	}}
+
+	derived := g.DerivedStats(map[string]interface{}{
+		"total_damage": int64(900),
+		"total_kills":  int32(6),
+		"nickname":     "ace",
+	}, 2)
+
+	assert.Equal(t, map[string]float64{
+		"damage_per_kill":  150,
+		"kills_per_match":  3,
+		"label_is_ignored": 1,
+	}, derived)
+
+	assert.Empty(t, (&Game{StatSchema: StatSchema{"kills": StatField{}}}).DerivedStats(nil, 0))
+}
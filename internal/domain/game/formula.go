@@ -0,0 +1,62 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidRankingFormula is returned when a ranking formula definition is malformed.
+var ErrInvalidRankingFormula = errors.New("invalid ranking formula")
+
+// FormulaPerMatch normalizes a formula term by matches played.
+const FormulaPerMatch = "match"
+
+// RankingFormula is a configurable weighted expression used to score players.
+// When set on a game it replaces the compiled-in calculator for that game.
+//
+// Each term reads a stat, optionally divides it by matches played or by another
+// stat, scales it, caps it, and contributes value*weight to the total. The total
+// is multiplied by Scale.
+type RankingFormula struct {
+	Terms []FormulaTerm `json:"terms" bson:"terms"`
+	Scale float64       `json:"scale" bson:"scale"`
+}
+
+// FormulaTerm is a single weighted component of a ranking formula.
+type FormulaTerm struct {
+	Stat       string   `json:"stat" bson:"stat"`                   // stat key, e.g. total_kills
+	Per        string   `json:"per,omitempty" bson:"per,omitempty"` // "match", another stat key, or empty
+	Multiplier float64  `json:"multiplier" bson:"multiplier"`       // applied before the cap
+	Cap        *float64 `json:"cap,omitempty" bson:"cap,omitempty"` // upper bound for the term value
+	Weight     float64  `json:"weight" bson:"weight"`               // contribution to the total
+}
+
+// Validate checks that the formula can be evaluated.
+func (f *RankingFormula) Validate() error {
+	if len(f.Terms) == 0 {
+		return fmt.Errorf("%w: at least one term is required", ErrInvalidRankingFormula)
+	}
+	if f.Scale <= 0 {
+		return fmt.Errorf("%w: scale must be positive", ErrInvalidRankingFormula)
+	}
+
+	for i, term := range f.Terms {
+		if term.Stat == "" {
+			return fmt.Errorf("%w: term %d has no stat", ErrInvalidRankingFormula, i)
+		}
+		if term.Per == term.Stat {
+			return fmt.Errorf("%w: term %d divides %q by itself", ErrInvalidRankingFormula, i, term.Stat)
+		}
+		if term.Multiplier <= 0 {
+			return fmt.Errorf("%w: term %d multiplier must be positive", ErrInvalidRankingFormula, i)
+		}
+		if term.Cap != nil && *term.Cap <= 0 {
+			return fmt.Errorf("%w: term %d cap must be positive", ErrInvalidRankingFormula, i)
+		}
+		if term.Weight < 0 {
+			return fmt.Errorf("%w: term %d weight cannot be negative", ErrInvalidRankingFormula, i)
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package game
 
 import (
 	"errors"
+	"math"
 	"time"
 
 	"github.com/google/uuid"
@@ -27,6 +28,7 @@ var (
 
 // Game represents a competitive game supported by the platform.
 // Each game has its own stat schema and ranking weights.
+// RankingFormula, when set, overrides the compiled-in ranking calculator.
 type Game struct {
 	ID               uuid.UUID
 	Name             string
@@ -34,7 +36,13 @@ type Game struct {
 	Description      string
 	StatSchema       StatSchema
 	RankingWeights   RankingWeights
+	RankingFormula   *RankingFormula
+	Modes            []Mode
+	Maps             []string
+	Playlists        []string
 	PlatformIDFormat string
+	MinTeamSize      int // Smallest lineup the game's tournaments may use; 0 for no minimum
+	MaxTeamSize      int // Largest lineup the game's tournaments may use; 0 for no maximum
 	IsActive         bool
 	CreatedAt        time.Time
 	UpdatedAt        time.Time
@@ -45,13 +53,26 @@ type Game struct {
 type StatSchema map[string]StatField
 
 // StatField defines a single statistic field with validation rules.
+// Cap and Scale control how calculators normalize the stat into points:
+// a value at or above Cap is worth Scale points. A field with a Formula is
+// derived: it is computed from the player's other stats instead of reported.
+// A field with a Rollup is a lifetime stat accumulated from a value reported
+// in each match.
 type StatField struct {
-	Type  string      `json:"type"`  // integer, float, string
-	Min   interface{} `json:"min"`   // minimum value (optional)
-	Max   interface{} `json:"max"`   // maximum value (optional)
-	Label string      `json:"label"` // human-readable label
+	Type    string      `json:"type"`              // integer, float, string
+	Min     interface{} `json:"min"`               // minimum value (optional)
+	Max     interface{} `json:"max"`               // maximum value (optional)
+	Label   string      `json:"label"`             // human-readable label
+	Cap     float64     `json:"cap,omitempty"`     // value that earns full points (optional)
+	Scale   float64     `json:"scale,omitempty"`   // points awarded at the cap (optional)
+	Formula string      `json:"formula,omitempty"` // derived stat expression, e.g. total_damage / total_kills (optional)
+	Rollup  *Rollup     `json:"rollup,omitempty"`  // how the stat accumulates a match value (optional)
 }
 
+// DefaultNormalizedScale is the number of points a stat at its cap is worth
+// when the schema does not specify a scale.
+const DefaultNormalizedScale = 100.0
+
 // RankingWeights defines how different metrics are weighted for ranking calculation.
 // The sum of all weights must equal 1.0.
 type RankingWeights map[string]float64
@@ -70,6 +91,10 @@ func NewGame(name, slug, description, platformIDFormat string, schema StatSchema
 		return nil, err
 	}
 
+	if err := schema.Validate(); err != nil {
+		return nil, err
+	}
+
 	return &Game{
 		ID:               uuid.New(),
 		Name:             name,
@@ -107,6 +132,27 @@ func (g *Game) UpdateWeights(weights RankingWeights) error {
 	return nil
 }
 
+// NormalizeStat maps a stat value onto the 0..Scale points range using the
+// schema's normalization parameters, falling back to defaultCap when the
+// stat has no cap configured.
+func (g *Game) NormalizeStat(key string, value, defaultCap float64) float64 {
+	field := g.StatSchema[key]
+
+	limit := defaultCap
+	if field.Cap > 0 {
+		limit = field.Cap
+	}
+	scale := DefaultNormalizedScale
+	if field.Scale > 0 {
+		scale = field.Scale
+	}
+	if limit <= 0 || value <= 0 {
+		return 0
+	}
+
+	return math.Min(value/limit, 1) * scale
+}
+
 // ValidateStat checks if a stat value is valid according to the schema.
 func (g *Game) ValidateStat(statName string, value interface{}) error {
 	field, exists := g.StatSchema[statName]
@@ -191,3 +191,163 @@ func TestValidateRankingWeights(t *testing.T) {
 		})
 	}
 }
+
+func TestRankingFormula_Validate(t *testing.T) {
+	t.Parallel()
+
+	zero := 0.0
+	valid := func() RankingFormula {
+		return RankingFormula{
+			Scale: 10,
+			Terms: []FormulaTerm{{Stat: "total_kills", Per: FormulaPerMatch, Multiplier: 5, Weight: 1}},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(f *RankingFormula)
+		wantErr bool
+	}{
+		{name: "valid", mutate: func(f *RankingFormula) {}},
+		{name: "no terms", mutate: func(f *RankingFormula) { f.Terms = nil }, wantErr: true},
+		{name: "zero scale", mutate: func(f *RankingFormula) { f.Scale = 0 }, wantErr: true},
+		{name: "missing stat", mutate: func(f *RankingFormula) { f.Terms[0].Stat = "" }, wantErr: true},
+		{name: "self divisor", mutate: func(f *RankingFormula) { f.Terms[0].Per = "total_kills" }, wantErr: true},
+		{name: "zero multiplier", mutate: func(f *RankingFormula) { f.Terms[0].Multiplier = 0 }, wantErr: true},
+		{name: "zero cap", mutate: func(f *RankingFormula) { f.Terms[0].Cap = &zero }, wantErr: true},
+		{name: "negative weight", mutate: func(f *RankingFormula) { f.Terms[0].Weight = -1 }, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			f := valid()
+			tc.mutate(&f)
+			err := f.Validate()
+			if tc.wantErr {
+				require.ErrorIs(t, err, ErrInvalidRankingFormula)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGame_NormalizeStat(t *testing.T) {
+	t.Parallel()
+
+	g := &Game{StatSchema: StatSchema{
+		"damage": StatField{Type: "integer", Cap: 2000, Scale: 50},
+		"kills":  StatField{Type: "integer"},
+	}}
+
+	require.InDelta(t, 25, g.NormalizeStat("damage", 1000, 3000), 0.0001)
+	require.InDelta(t, 50, g.NormalizeStat("damage", 5000, 3000), 0.0001)
+	require.InDelta(t, 50, g.NormalizeStat("kills", 10, 20), 0.0001)
+	require.InDelta(t, 100, g.NormalizeStat("kd_ratio", 6, 5), 0.0001)
+	require.Zero(t, g.NormalizeStat("kd_ratio", 0, 5))
+}
+
+func TestValidateModes(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		modes   []Mode
+		wantErr error
+	}{
+		{name: "no modes", modes: nil},
+		{name: "valid", modes: []Mode{{Slug: "br", Name: "Battle Royale"}, {Slug: "resurgence", Name: "Resurgence"}}},
+		{name: "missing slug", modes: []Mode{{Name: "Battle Royale"}}, wantErr: ErrInvalidMode},
+		{name: "duplicate slug", modes: []Mode{{Slug: "br"}, {Slug: "br"}}, wantErr: ErrInvalidMode},
+		{name: "invalid weights", modes: []Mode{{Slug: "br", RankingWeights: RankingWeights{"kd_ratio": 2}}}, wantErr: ErrInvalidRankingWeights},
+		{name: "invalid formula", modes: []Mode{{Slug: "br", RankingFormula: &RankingFormula{}}}, wantErr: ErrInvalidRankingFormula},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateModes(tc.modes)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestGame_ForMode(t *testing.T) {
+	t.Parallel()
+
+	g := &Game{
+		RankingWeights: RankingWeights{"kd_ratio": 1},
+		Modes: []Mode{
+			{Slug: "br"},
+			{Slug: "resurgence", RankingWeights: RankingWeights{"win_rate": 1}},
+		},
+	}
+
+	require.True(t, g.HasMode(""))
+	require.True(t, g.HasMode("br"))
+	require.False(t, g.HasMode("plunder"))
+
+	require.Same(t, g, g.ForMode(""))
+	require.Equal(t, g.RankingWeights, g.ForMode("br").RankingWeights)
+	require.Equal(t, RankingWeights{"win_rate": 1}, g.ForMode("resurgence").RankingWeights)
+	require.Equal(t, RankingWeights{"kd_ratio": 1}, g.RankingWeights, "game must not be modified")
+}
+
+func TestGame_ValidateMatchMetadata(t *testing.T) {
+	t.Parallel()
+
+	g := &Game{Maps: []string{"verdansk", "rebirth"}, Playlists: []string{"ranked"}}
+
+	require.NoError(t, g.ValidateMatchMetadata("", ""))
+	require.NoError(t, g.ValidateMatchMetadata("rebirth", "ranked"))
+	require.ErrorIs(t, g.ValidateMatchMetadata("ashika", ""), ErrInvalidMap)
+	require.ErrorIs(t, g.ValidateMatchMetadata("", "casual"), ErrInvalidPlaylist)
+
+	require.NoError(t, ValidateMapsAndPlaylists([]string{"verdansk"}, nil))
+	require.ErrorIs(t, ValidateMapsAndPlaylists([]string{"verdansk", "verdansk"}, nil), ErrInvalidMap)
+	require.ErrorIs(t, ValidateMapsAndPlaylists(nil, []string{""}), ErrInvalidPlaylist)
+}
+
+func TestGame_ValidateTeamSize(t *testing.T) {
+	t.Parallel()
+
+	require.ErrorIs(t, ValidateTeamSizeBounds(-1, 0), ErrInvalidTeamSizeBounds)
+	require.ErrorIs(t, ValidateTeamSizeBounds(6, 5), ErrInvalidTeamSizeBounds)
+	require.NoError(t, ValidateTeamSizeBounds(5, 0))
+	require.NoError(t, ValidateTeamSizeBounds(0, 0))
+
+	tests := []struct {
+		name     string
+		min, max int
+		size     int
+		wantErr  bool
+	}{
+		{name: "no bounds", size: 10},
+		{name: "within bounds", min: 5, max: 6, size: 6},
+		{name: "below minimum", min: 5, max: 6, size: 4, wantErr: true},
+		{name: "above maximum", min: 5, max: 6, size: 7, wantErr: true},
+		{name: "minimum only", min: 5, size: 8},
+		{name: "maximum only", max: 4, size: 5, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			g := &Game{Name: "Valorant", MinTeamSize: tc.min, MaxTeamSize: tc.max}
+			err := g.ValidateTeamSize(tc.size)
+			if tc.wantErr {
+				require.ErrorIs(t, err, ErrTeamSizeNotSupported)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidMap is returned when a map is not in the game's map list.
+	ErrInvalidMap = errors.New("invalid map")
+
+	// ErrInvalidPlaylist is returned when a playlist is not in the game's playlist list.
+	ErrInvalidPlaylist = errors.New("invalid playlist")
+)
+
+// ValidateMapsAndPlaylists checks that map and playlist names are present and unique.
+func ValidateMapsAndPlaylists(maps, playlists []string) error {
+	if err := validateNames(maps, ErrInvalidMap); err != nil {
+		return err
+	}
+	return validateNames(playlists, ErrInvalidPlaylist)
+}
+
+func validateNames(names []string, errInvalid error) error {
+	seen := make(map[string]bool, len(names))
+	for _, name := range names {
+		if name == "" {
+			return fmt.Errorf("%w: name is required", errInvalid)
+		}
+		if seen[name] {
+			return fmt.Errorf("%w: duplicate name %q", errInvalid, name)
+		}
+		seen[name] = true
+	}
+	return nil
+}
+
+// ValidateMatchMetadata checks that a match's optional map and playlist
+// belong to the game. Empty values are always valid.
+func (g *Game) ValidateMatchMetadata(mapName, playlist string) error {
+	if mapName != "" && !contains(g.Maps, mapName) {
+		return fmt.Errorf("%w: %s", ErrInvalidMap, mapName)
+	}
+	if playlist != "" && !contains(g.Playlists, playlist) {
+		return fmt.Errorf("%w: %s", ErrInvalidPlaylist, playlist)
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
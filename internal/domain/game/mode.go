@@ -0,0 +1,82 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrInvalidMode is returned when a game mode is unknown or malformed.
+var ErrInvalidMode = errors.New("invalid game mode")
+
+// Mode is a variant of a game, such as battle royale solos or resurgence,
+// whose stats are tracked and ranked separately. Ranking weights or a formula
+// set on a mode override the game's own for that mode's stats.
+type Mode struct {
+	Slug           string          `json:"slug" bson:"slug"`
+	Name           string          `json:"name" bson:"name"`
+	RankingWeights RankingWeights  `json:"ranking_weights,omitempty" bson:"ranking_weights,omitempty"`
+	RankingFormula *RankingFormula `json:"ranking_formula,omitempty" bson:"ranking_formula,omitempty"`
+}
+
+// ValidateModes checks that mode slugs are present and unique and that any
+// ranking overrides are valid.
+func ValidateModes(modes []Mode) error {
+	seen := make(map[string]bool, len(modes))
+	for _, m := range modes {
+		if m.Slug == "" {
+			return fmt.Errorf("%w: slug is required", ErrInvalidMode)
+		}
+		if seen[m.Slug] {
+			return fmt.Errorf("%w: duplicate slug %q", ErrInvalidMode, m.Slug)
+		}
+		seen[m.Slug] = true
+
+		if m.RankingWeights != nil {
+			if err := validateRankingWeights(m.RankingWeights); err != nil {
+				return fmt.Errorf("mode %q: %w", m.Slug, err)
+			}
+		}
+		if m.RankingFormula != nil {
+			if err := m.RankingFormula.Validate(); err != nil {
+				return fmt.Errorf("mode %q: %w", m.Slug, err)
+			}
+		}
+	}
+	return nil
+}
+
+// HasMode reports whether the game defines a mode. The empty mode, meaning
+// stats across all modes, is always valid.
+func (g *Game) HasMode(slug string) bool {
+	if slug == "" {
+		return true
+	}
+	for _, m := range g.Modes {
+		if m.Slug == slug {
+			return true
+		}
+	}
+	return false
+}
+
+// ForMode returns a copy of the game with the mode's ranking overrides applied.
+// Unknown or empty modes return the game unchanged.
+func (g *Game) ForMode(slug string) *Game {
+	if slug == "" {
+		return g
+	}
+	for _, m := range g.Modes {
+		if m.Slug != slug {
+			continue
+		}
+		scoped := *g
+		if m.RankingWeights != nil {
+			scoped.RankingWeights = m.RankingWeights
+		}
+		if m.RankingFormula != nil {
+			scoped.RankingFormula = m.RankingFormula
+		}
+		return &scoped
+	}
+	return g
+}
@@ -6,6 +6,7 @@ import "context"
 type Repository interface {
 	Create(ctx context.Context, game *Game) error
 	GetByID(ctx context.Context, id string) (*Game, error)
+	GetByIDs(ctx context.Context, ids []string) ([]*Game, error)
 	GetBySlug(ctx context.Context, slug string) (*Game, error)
 	GetAll(ctx context.Context) ([]*Game, error)
 	Update(ctx context.Context, game *Game) error
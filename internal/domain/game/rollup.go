@@ -0,0 +1,175 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// Aggregation is how a lifetime stat combines the values a player reports
+// across their matches.
+type Aggregation string
+
+const (
+	// AggregateSum adds every match's value to a running total.
+	AggregateSum Aggregation = "sum"
+
+	// AggregateMax keeps the best value of any single match.
+	AggregateMax Aggregation = "max"
+
+	// AggregateAvg keeps the mean value per match played.
+	AggregateAvg Aggregation = "avg"
+)
+
+// IsValid reports whether the aggregation is supported.
+func (a Aggregation) IsValid() bool {
+	switch a {
+	case AggregateSum, AggregateMax, AggregateAvg:
+		return true
+	}
+	return false
+}
+
+// Accumulate returns a lifetime stat after a match reporting value, where
+// matches is the number of matches the stat covers including that one.
+func (a Aggregation) Accumulate(current, value float64, matches int) float64 {
+	switch a {
+	case AggregateMax:
+		return math.Max(current, value)
+	case AggregateAvg:
+		if matches <= 0 {
+			return 0
+		}
+		return current + (value-current)/float64(matches)
+	default:
+		return current + value
+	}
+}
+
+// Remove returns a lifetime stat with a match reporting value taken back out,
+// where matches is the number of matches the stat covers without that one. A best
+// cannot be taken back without the rest of the match history, so a max is
+// left as it is.
+func (a Aggregation) Remove(current, value float64, matches int) float64 {
+	switch a {
+	case AggregateMax:
+		return current
+	case AggregateAvg:
+		if matches <= 0 {
+			return 0
+		}
+		return (current*float64(matches+1) - value) / float64(matches)
+	default:
+		return current - value
+	}
+}
+
+// SourceWon is the rollup source read from the match rather than the
+// player's line: 1 when the player's team placed first, otherwise 0.
+const SourceWon = "won"
+
+// Rollup maps a value reported in each match onto a lifetime stat.
+type Rollup struct {
+	Source    string      `json:"source"`    // match field (kills, damage, assists, deaths, downs), won or reported custom stat
+	Aggregate Aggregation `json:"aggregate"` // sum, max or avg
+}
+
+// DefaultRollups are the lifetime stats every game keeps from the core match
+// fields: totals, and the averages and win rate profiles show without going
+// back to the matches. A schema field with the same key and its own rollup
+// replaces the default.
+var DefaultRollups = map[string]Rollup{
+	"total_kills":   {Source: "kills", Aggregate: AggregateSum},
+	"total_damage":  {Source: "damage", Aggregate: AggregateSum},
+	"total_assists": {Source: "assists", Aggregate: AggregateSum},
+	"total_deaths":  {Source: "deaths", Aggregate: AggregateSum},
+	"total_downs":   {Source: "downs", Aggregate: AggregateSum},
+	"avg_kills":     {Source: "kills", Aggregate: AggregateAvg},
+	"avg_damage":    {Source: "damage", Aggregate: AggregateAvg},
+	"win_rate":      {Source: SourceWon, Aggregate: AggregateAvg},
+}
+
+// IsRollup reports whether a stat is accumulated from match values, by
+// default or by the schema, rather than reported under its own key.
+func (s StatSchema) IsRollup(key string) bool {
+	if s[key].Rollup != nil {
+		return true
+	}
+	_, ok := DefaultRollups[key]
+	return ok
+}
+
+// Rollups returns how the game's lifetime stats accumulate match values,
+// keyed by lifetime stat: the defaults, overridden and extended by the
+// schema's own rollups.
+func (s StatSchema) Rollups() map[string]Rollup {
+	rollups := make(map[string]Rollup, len(DefaultRollups))
+	for key, r := range DefaultRollups {
+		rollups[key] = r
+	}
+	for key, field := range s {
+		if field.Rollup != nil {
+			rollups[key] = *field.Rollup
+		}
+	}
+	return rollups
+}
+
+// StartingValue returns the value a rollup starts from for a player whose
+// stats predate it, and the number of matches that value covers. An average
+// of a source that is also summed starts from the sum per match played,
+// covering every match; anything else starts from zero, covering none, so
+// the matches it never saw do not weigh on it.
+func StartingValue(rollups map[string]Rollup, key string, stats map[string]interface{}, matchesPlayed int) (float64, int) {
+	r := rollups[key]
+	if r.Aggregate != AggregateAvg || matchesPlayed <= 0 {
+		return 0, 0
+	}
+
+	for sumKey, sum := range rollups {
+		if sum.Source == r.Source && sum.Aggregate == AggregateSum {
+			return numericStats(stats)[sumKey] / float64(matchesPlayed), matchesPlayed
+		}
+	}
+	return 0, 0
+}
+
+// validateRollups checks every rollup names a known aggregation and a value
+// that is reported in matches rather than computed.
+func (s StatSchema) validateRollups() error {
+	keys := make([]string, 0, len(s))
+	for key, field := range s {
+		if field.Rollup != nil {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		field := s[key]
+		switch r := field.Rollup; {
+		case field.IsDerived():
+			return fmt.Errorf("%w: stat %q has both a formula and a rollup", ErrInvalidStatSchema, key)
+		case !r.Aggregate.IsValid():
+			return fmt.Errorf("%w: stat %q: rollup aggregate must be sum, max or avg", ErrInvalidStatSchema, key)
+		case !isStatKey(r.Source):
+			return fmt.Errorf("%w: stat %q: rollup source %q is not a stat key", ErrInvalidStatSchema, key, r.Source)
+		case s[r.Source].IsDerived() || s.IsRollup(r.Source):
+			return fmt.Errorf("%w: stat %q: rollup source %q is not reported in matches", ErrInvalidStatSchema, key, r.Source)
+		}
+	}
+	return nil
+}
+
+// isStatKey reports whether a name is a well-formed stat key.
+func isStatKey(name string) bool {
+	if name == "" || name[0] >= '0' && name[0] <= '9' {
+		return false
+	}
+	for i := 0; i < len(name); i++ {
+		if !isStatKeyChar(name[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,128 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregation_AccumulateRemove(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		aggregate   Aggregation
+		current     float64
+		value       float64
+		matches     int
+		accumulated float64
+	}{
+		{name: "sum", aggregate: AggregateSum, current: 40, value: 12, matches: 5, accumulated: 52},
+		{name: "max keeps the best", aggregate: AggregateMax, current: 18, value: 12, matches: 5, accumulated: 18},
+		{name: "max takes a new best", aggregate: AggregateMax, current: 18, value: 25, matches: 5, accumulated: 25},
+		{name: "avg", aggregate: AggregateAvg, current: 10, value: 16, matches: 4, accumulated: 11.5},
+		{name: "avg of the first match", aggregate: AggregateAvg, current: 0, value: 7, matches: 1, accumulated: 7},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.aggregate.Accumulate(tt.current, tt.value, tt.matches)
+			assert.InDelta(t, tt.accumulated, got, 1e-9)
+
+			if tt.aggregate != AggregateMax {
+				assert.InDelta(t, tt.current, tt.aggregate.Remove(got, tt.value, tt.matches-1), 1e-9, "removing the match restores the stat")
+			}
+		})
+	}
+
+	assert.InDelta(t, 25.0, AggregateMax.Remove(25, 25, 4), 1e-9, "a best is not taken back")
+	assert.InDelta(t, 0.0, AggregateAvg.Remove(7, 7, 0), 1e-9, "no matches left")
+}
+
+func TestStatSchema_Rollups(t *testing.T) {
+	t.Parallel()
+
+	schema := StatSchema{
+		"kills":        StatField{Type: "integer"},
+		"total_kills":  StatField{Rollup: &Rollup{Source: "eliminations", Aggregate: AggregateSum}},
+		"best_damage":  StatField{Rollup: &Rollup{Source: "damage", Aggregate: AggregateMax}},
+		"avg_headshot": StatField{Rollup: &Rollup{Source: "headshots", Aggregate: AggregateAvg}},
+	}
+
+	rollups := schema.Rollups()
+	assert.Equal(t, Rollup{Source: "eliminations", Aggregate: AggregateSum}, rollups["total_kills"])
+	assert.Equal(t, DefaultRollups["total_damage"], rollups["total_damage"])
+	assert.Equal(t, AggregateMax, rollups["best_damage"].Aggregate)
+	assert.Len(t, rollups, len(DefaultRollups)+2)
+	assert.Equal(t, Rollup{Source: "kills", Aggregate: AggregateSum}, DefaultRollups["total_kills"], "defaults are not changed")
+
+	assert.True(t, schema.IsRollup("total_deaths"))
+	assert.True(t, schema.IsRollup("avg_headshot"))
+	assert.False(t, schema.IsRollup("kills"))
+}
+
+func TestStatSchema_ValidateRollups(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		field       StatField
+		expectedErr bool
+	}{
+		{name: "sum of a custom stat", field: StatField{Rollup: &Rollup{Source: "headshots", Aggregate: AggregateSum}}},
+		{name: "max of a core field", field: StatField{Rollup: &Rollup{Source: "kills", Aggregate: AggregateMax}}},
+		{name: "unknown aggregate", field: StatField{Rollup: &Rollup{Source: "kills", Aggregate: "median"}}, expectedErr: true},
+		{name: "missing source", field: StatField{Rollup: &Rollup{Aggregate: AggregateSum}}, expectedErr: true},
+		{name: "malformed source", field: StatField{Rollup: &Rollup{Source: "Kills!", Aggregate: AggregateSum}}, expectedErr: true},
+		{name: "source is a rollup", field: StatField{Rollup: &Rollup{Source: "total_kills", Aggregate: AggregateMax}}, expectedErr: true},
+		{name: "source is derived", field: StatField{Rollup: &Rollup{Source: "kd", Aggregate: AggregateAvg}}, expectedErr: true},
+		{name: "formula and rollup", field: StatField{Formula: "total_kills / 2", Rollup: &Rollup{Source: "kills", Aggregate: AggregateSum}}, expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			schema := StatSchema{
+				"kd":   StatField{Formula: "total_kills / total_deaths"},
+				"stat": tt.field,
+			}
+			err := schema.Validate()
+			if tt.expectedErr {
+				require.ErrorIs(t, err, ErrInvalidStatSchema)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestStartingValue(t *testing.T) {
+	t.Parallel()
+
+	rollups := StatSchema{
+		"best_kills": StatField{Rollup: &Rollup{Source: "kills", Aggregate: AggregateMax}},
+	}.Rollups()
+	stats := map[string]interface{}{"total_kills": int64(30), "total_damage": 4500.0}
+
+	value, samples := StartingValue(rollups, "avg_kills", stats, 3)
+	assert.InDelta(t, 10.0, value, 1e-9, "average of a summed source")
+	assert.Equal(t, 3, samples, "covers every match played")
+
+	value, _ = StartingValue(rollups, "avg_damage", stats, 3)
+	assert.InDelta(t, 1500.0, value, 1e-9)
+
+	value, samples = StartingValue(rollups, "win_rate", stats, 3)
+	assert.Zero(t, value, "wins are not summed")
+	assert.Zero(t, samples, "covers none of the matches played")
+
+	value, _ = StartingValue(rollups, "best_kills", stats, 3)
+	assert.Zero(t, value, "only averages are seeded")
+
+	value, samples = StartingValue(rollups, "avg_kills", stats, 0)
+	assert.Zero(t, value, "no matches played")
+	assert.Zero(t, samples)
+}
@@ -0,0 +1,43 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	// ErrInvalidTeamSizeBounds is returned when a game's team size bounds are negative or inverted.
+	ErrInvalidTeamSizeBounds = errors.New("team size bounds cannot be negative and the minimum cannot exceed the maximum")
+
+	// ErrTeamSizeNotSupported is returned when a tournament's team size is outside its game's bounds.
+	ErrTeamSizeNotSupported = errors.New("team size is not supported by the game")
+)
+
+// ValidateTeamSizeBounds checks a game's minimum and maximum team size. Zero
+// leaves a bound unset.
+func ValidateTeamSizeBounds(minSize, maxSize int) error {
+	if minSize < 0 || maxSize < 0 || (maxSize > 0 && minSize > maxSize) {
+		return ErrInvalidTeamSizeBounds
+	}
+	return nil
+}
+
+// ValidateTeamSize checks that a tournament lineup of size players fits the
+// game's team size bounds.
+func (g *Game) ValidateTeamSize(size int) error {
+	if size < g.MinTeamSize || (g.MaxTeamSize > 0 && size > g.MaxTeamSize) {
+		return fmt.Errorf("%w: %s supports %s", ErrTeamSizeNotSupported, g.Name, g.teamSizeRange())
+	}
+	return nil
+}
+
+func (g *Game) teamSizeRange() string {
+	switch {
+	case g.MaxTeamSize == 0:
+		return fmt.Sprintf("at least %d players", g.MinTeamSize)
+	case g.MinTeamSize == g.MaxTeamSize:
+		return fmt.Sprintf("exactly %d players", g.MaxTeamSize)
+	default:
+		return fmt.Sprintf("%d to %d players", max(g.MinTeamSize, 1), g.MaxTeamSize)
+	}
+}
@@ -0,0 +1,90 @@
+// Package integrity describes checks for records whose references point at
+// data that no longer exists.
+package integrity
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ScanLimit caps how many dangling records one check collects.
+const ScanLimit = 500
+
+// SampleSize is how many findings a report keeps per check.
+const SampleSize = 20
+
+// ErrReportNotFound is returned when no integrity report has been generated yet.
+var ErrReportNotFound = errors.New("integrity report not found")
+
+// Kind identifies an integrity check.
+type Kind string
+
+const (
+	// KindOrphanedPlayerStats finds player stats whose player profile is gone.
+	KindOrphanedPlayerStats Kind = "orphaned_player_stats"
+	// KindMatchMissingTeam finds matches whose submitting team is gone.
+	KindMatchMissingTeam Kind = "match_missing_team"
+	// KindTeamMissingTournament finds teams whose tournament is gone.
+	KindTeamMissingTournament Kind = "team_missing_tournament"
+)
+
+// Finding is a record holding a reference to something that does not exist.
+type Finding struct {
+	ID        string `bson:"id" json:"id"`
+	Reference string `bson:"reference" json:"reference"`
+	Note      string `bson:"note,omitempty" json:"note,omitempty"`
+}
+
+// Check is the outcome of one kind of integrity check. Kept counts findings
+// deliberately left in place by a repair, such as verified matches.
+type Check struct {
+	Kind      Kind      `bson:"kind" json:"kind"`
+	Found     int       `bson:"found" json:"found"`
+	Truncated bool      `bson:"truncated" json:"truncated"`
+	Samples   []Finding `bson:"samples" json:"samples"`
+	Repaired  int       `bson:"repaired" json:"repaired"`
+	Kept      int       `bson:"kept" json:"kept"`
+	Failed    int       `bson:"failed" json:"failed"`
+}
+
+// NewCheck summarizes the findings of a check that scanned up to ScanLimit records.
+func NewCheck(kind Kind, findings []Finding) Check {
+	samples := findings
+	if len(samples) > SampleSize {
+		samples = samples[:SampleSize]
+	}
+	return Check{
+		Kind:      kind,
+		Found:     len(findings),
+		Truncated: len(findings) >= ScanLimit,
+		Samples:   append([]Finding{}, samples...),
+	}
+}
+
+// Report is the result of one integrity run.
+type Report struct {
+	ID          uuid.UUID `bson:"_id" json:"id"`
+	GeneratedAt time.Time `bson:"generated_at" json:"generated_at"`
+	Repair      bool      `bson:"repair" json:"repair"`
+	Checks      []Check   `bson:"checks" json:"checks"`
+}
+
+// Found returns the number of dangling records across all checks.
+func (r *Report) Found() int {
+	total := 0
+	for _, c := range r.Checks {
+		total += c.Found
+	}
+	return total
+}
+
+// Repository stores integrity reports.
+type Repository interface {
+	// Save stores a report.
+	Save(ctx context.Context, report *Report) error
+	// GetLatest returns the most recently generated report.
+	GetLatest(ctx context.Context) (*Report, error)
+}
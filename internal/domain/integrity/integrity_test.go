@@ -0,0 +1,56 @@
+package integrity
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func findings(n int) []Finding {
+	out := make([]Finding, n)
+	for i := range out {
+		out[i] = Finding{ID: strconv.Itoa(i), Reference: "gone"}
+	}
+	return out
+}
+
+func TestNewCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		found         int
+		wantSamples   int
+		wantTruncated bool
+	}{
+		{name: "none", found: 0, wantSamples: 0},
+		{name: "fewer than sample size", found: 3, wantSamples: 3},
+		{name: "more than sample size", found: SampleSize + 5, wantSamples: SampleSize},
+		{name: "scan limit reached", found: ScanLimit, wantSamples: SampleSize, wantTruncated: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := NewCheck(KindMatchMissingTeam, findings(tc.found))
+			require.Equal(t, KindMatchMissingTeam, c.Kind)
+			require.Equal(t, tc.found, c.Found)
+			require.Equal(t, tc.wantTruncated, c.Truncated)
+			require.Len(t, c.Samples, tc.wantSamples)
+			require.NotNil(t, c.Samples)
+		})
+	}
+}
+
+func TestReport_Found(t *testing.T) {
+	t.Parallel()
+
+	r := &Report{Checks: []Check{
+		NewCheck(KindOrphanedPlayerStats, findings(2)),
+		NewCheck(KindMatchMissingTeam, nil),
+		NewCheck(KindTeamMissingTournament, findings(4)),
+	}}
+	require.Equal(t, 6, r.Found())
+}
@@ -0,0 +1,190 @@
+package ladder
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ChallengeResponseWindow is how long a challenged team has to accept
+	// before the challenge is forfeited to the challenger.
+	ChallengeResponseWindow = 48 * time.Hour
+
+	// ChallengePlayWindow is how long both teams have, once a challenge is
+	// accepted, to play and get a verified match reported in the season.
+	ChallengePlayWindow = 72 * time.Hour
+)
+
+var (
+	ErrChallengeNotFound     = errors.New("challenge not found")
+	ErrChallengeOutOfRange   = errors.New("opponent is not within challenge range above the challenger")
+	ErrChallengeNotPending   = errors.New("challenge is not pending")
+	ErrChallengeInProgress   = errors.New("team already has an open challenge")
+	ErrNotChallengedTeam     = errors.New("only the challenged team can respond")
+	ErrSameTeam              = errors.New("a team cannot challenge itself")
+	ErrChallengeNotResolving = errors.New("challenge is not awaiting a result")
+)
+
+// ChallengeStatus represents where a challenge is in its lifecycle.
+type ChallengeStatus string
+
+const (
+	ChallengeStatusPending   ChallengeStatus = "pending"   // Waiting for the defender to respond
+	ChallengeStatusAccepted  ChallengeStatus = "accepted"  // Waiting for both teams' results
+	ChallengeStatusCompleted ChallengeStatus = "completed" // Decided; WinnerTeamID is set
+)
+
+// Outcome explains how a completed challenge was decided.
+type Outcome string
+
+const (
+	OutcomePlayed   Outcome = "played"   // Both teams played; the better placement won
+	OutcomeDeclined Outcome = "declined" // The defender declined and forfeited
+	OutcomeExpired  Outcome = "expired"  // A deadline passed; see Challenge.Expire
+)
+
+// Challenge is a challenger team's bid to take a higher-ranked defender's rung.
+// Once accepted, each team's next verified match in the season's tournament
+// decides it: the better placement wins and a tie goes to the defender.
+type Challenge struct {
+	ID                uuid.UUID       `bson:"_id" json:"id"`
+	LadderID          uuid.UUID       `bson:"ladder_id" json:"ladder_id"`
+	Season            int             `bson:"season" json:"season"`
+	TournamentID      uuid.UUID       `bson:"tournament_id" json:"tournament_id"` // The season's tournament, where the deciding matches are played
+	ChallengerTeamID  uuid.UUID       `bson:"challenger_team_id" json:"challenger_team_id"`
+	DefenderTeamID    uuid.UUID       `bson:"defender_team_id" json:"defender_team_id"`
+	Status            ChallengeStatus `bson:"status" json:"status"`
+	Outcome           Outcome         `bson:"outcome,omitempty" json:"outcome,omitempty"`
+	WinnerTeamID      *uuid.UUID      `bson:"winner_team_id,omitempty" json:"winner_team_id,omitempty"`
+	ChallengerMatchID *uuid.UUID      `bson:"challenger_match_id,omitempty" json:"challenger_match_id,omitempty"`
+	DefenderMatchID   *uuid.UUID      `bson:"defender_match_id,omitempty" json:"defender_match_id,omitempty"`
+	CreatedBy         uuid.UUID       `bson:"created_by" json:"created_by"`
+	CreatedAt         time.Time       `bson:"created_at" json:"created_at"`
+	RespondBy         time.Time       `bson:"respond_by" json:"respond_by"`
+	AcceptedAt        *time.Time      `bson:"accepted_at,omitempty" json:"accepted_at,omitempty"`
+	PlayBy            *time.Time      `bson:"play_by,omitempty" json:"play_by,omitempty"`
+	CompletedAt       *time.Time      `bson:"completed_at,omitempty" json:"completed_at,omitempty"`
+	Forfeit           *Forfeit        `bson:"forfeit,omitempty" json:"forfeit,omitempty"`       // The latest no-show report, if any
+	Reschedule        *Reschedule     `bson:"reschedule,omitempty" json:"reschedule,omitempty"` // The latest play deadline change, if any
+	Reminders         []Reminder      `bson:"reminders,omitempty" json:"-"`                     // Deadline reminders sent so far
+}
+
+// NewChallenge creates a pending challenge in the ladder's current season.
+func NewChallenge(l *Ladder, challengerID, defenderID, createdBy uuid.UUID, now time.Time) (*Challenge, error) {
+	if challengerID == defenderID {
+		return nil, ErrSameTeam
+	}
+	if err := l.CanChallenge(challengerID, defenderID); err != nil {
+		return nil, err
+	}
+	return &Challenge{
+		ID:               uuid.New(),
+		LadderID:         l.ID,
+		Season:           l.Season,
+		TournamentID:     l.TournamentID,
+		ChallengerTeamID: challengerID,
+		DefenderTeamID:   defenderID,
+		Status:           ChallengeStatusPending,
+		CreatedBy:        createdBy,
+		CreatedAt:        now,
+		RespondBy:        now.Add(ChallengeResponseWindow),
+	}, nil
+}
+
+// Accept starts the play window.
+func (c *Challenge) Accept(now time.Time) error {
+	if c.Status != ChallengeStatusPending {
+		return ErrChallengeNotPending
+	}
+	playBy := now.Add(ChallengePlayWindow)
+	c.Status = ChallengeStatusAccepted
+	c.AcceptedAt = &now
+	c.PlayBy = &playBy
+	return nil
+}
+
+// Decline forfeits the challenge to the challenger.
+func (c *Challenge) Decline(now time.Time) error {
+	if c.Status != ChallengeStatusPending {
+		return ErrChallengeNotPending
+	}
+	c.complete(OutcomeDeclined, c.ChallengerTeamID, now)
+	return nil
+}
+
+// Resolve decides an accepted challenge from the placement each team got in
+// the match that counts for it.
+func (c *Challenge) Resolve(challengerMatchID uuid.UUID, challengerPlacement int, defenderMatchID uuid.UUID, defenderPlacement int, now time.Time) error {
+	if c.Status != ChallengeStatusAccepted {
+		return ErrChallengeNotResolving
+	}
+	c.ChallengerMatchID = &challengerMatchID
+	c.DefenderMatchID = &defenderMatchID
+
+	winner := c.DefenderTeamID
+	if challengerPlacement < defenderPlacement {
+		winner = c.ChallengerTeamID
+	}
+	c.complete(OutcomePlayed, winner, now)
+	return nil
+}
+
+// Expired reports whether the challenge's current deadline has passed.
+func (c *Challenge) Expired(now time.Time) bool {
+	switch c.Status {
+	case ChallengeStatusPending:
+		return !now.Before(c.RespondBy)
+	case ChallengeStatusAccepted:
+		return c.PlayBy != nil && !now.Before(*c.PlayBy)
+	}
+	return false
+}
+
+// Expire settles a challenge whose deadline passed. An unanswered challenge
+// goes to the challenger. After acceptance, a team that played beats one that
+// did not; when neither played the defender keeps its rung. played reports
+// whether each side has a counting match.
+func (c *Challenge) Expire(challengerPlayed, defenderPlayed bool, now time.Time) {
+	winner := c.DefenderTeamID
+	if c.Status == ChallengeStatusPending || (challengerPlayed && !defenderPlayed) {
+		winner = c.ChallengerTeamID
+	}
+	c.complete(OutcomeExpired, winner, now)
+}
+
+// Open reports whether the challenge is still undecided.
+func (c *Challenge) Open() bool {
+	return c.Status == ChallengeStatusPending || c.Status == ChallengeStatusAccepted
+}
+
+func (c *Challenge) complete(outcome Outcome, winner uuid.UUID, now time.Time) {
+	c.Status = ChallengeStatusCompleted
+	c.Outcome = outcome
+	c.WinnerTeamID = &winner
+	c.CompletedAt = &now
+}
+
+// Loser returns the team that lost a completed challenge.
+func (c *Challenge) Loser() uuid.UUID {
+	if c.WinnerTeamID != nil && *c.WinnerTeamID == c.ChallengerTeamID {
+		return c.DefenderTeamID
+	}
+	return c.ChallengerTeamID
+}
+
+// ChallengeRepository persists ladder challenges.
+type ChallengeRepository interface {
+	Create(ctx context.Context, c *Challenge) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Challenge, error)
+	Update(ctx context.Context, c *Challenge) error
+	// ListByLadder returns a ladder season's challenges, newest first.
+	ListByLadder(ctx context.Context, ladderID uuid.UUID, season int) ([]*Challenge, error)
+	// ListOpen returns every pending or accepted challenge, oldest first.
+	ListOpen(ctx context.Context) ([]*Challenge, error)
+	// HasOpen reports whether a team is the challenger or defender of a
+	// pending or accepted challenge on the ladder.
+	HasOpen(ctx context.Context, ladderID, teamID uuid.UUID) (bool, error)
+}
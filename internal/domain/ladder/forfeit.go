@@ -0,0 +1,193 @@
+package ladder
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ForfeitResponseWindow is how long the reported team has to contest a
+	// no-show report before the forfeit is upheld automatically.
+	ForfeitResponseWindow = 24 * time.Hour
+
+	// MaxForfeitNoteLength caps forfeit reasons, responses and decision notes, in characters.
+	MaxForfeitNoteLength = 500
+
+	// MaxForfeitPenalty caps the extra rungs a forfeiting team drops.
+	MaxForfeitPenalty = 10
+
+	// OutcomeForfeit means the loser did not show up; see Challenge.ReportForfeit.
+	OutcomeForfeit Outcome = "forfeit"
+)
+
+var (
+	ErrInvalidForfeitPenalty = errors.New("forfeit penalty must be between 0 and 10 rungs")
+	ErrForfeitNoteTooLong    = errors.New("forfeit notes cannot exceed 500 characters")
+	ErrNotChallengeTeam      = errors.New("team is not part of this challenge")
+	ErrForfeitOpen           = errors.New("challenge already has an open forfeit report")
+	ErrForfeitNotFound       = errors.New("challenge has no open forfeit report")
+	ErrForfeitNotPending     = errors.New("forfeit report is not awaiting the reported team's response")
+	ErrNotForfeitedTeam      = errors.New("only the reported team can contest a forfeit report")
+)
+
+// ForfeitStatus represents where a forfeit report is in its lifecycle.
+type ForfeitStatus string
+
+const (
+	ForfeitStatusPending   ForfeitStatus = "pending"   // Waiting for the reported team to respond
+	ForfeitStatusContested ForfeitStatus = "contested" // Waiting for an organizer's decision
+	ForfeitStatusUpheld    ForfeitStatus = "upheld"    // The reported team forfeited the challenge
+	ForfeitStatusDismissed ForfeitStatus = "dismissed" // The challenge goes on
+)
+
+// Forfeit is a team's report that its opponent did not show up for an
+// accepted challenge. DecidedBy is nil when the report was upheld because
+// the reported team did not respond in time.
+type Forfeit struct {
+	ReportedByTeamID uuid.UUID     `bson:"reported_by_team_id" json:"reported_by_team_id"`
+	ReportedBy       uuid.UUID     `bson:"reported_by" json:"reported_by"`
+	Reason           string        `bson:"reason,omitempty" json:"reason,omitempty"`
+	ReportedAt       time.Time     `bson:"reported_at" json:"reported_at"`
+	RespondBy        time.Time     `bson:"respond_by" json:"respond_by"`
+	Status           ForfeitStatus `bson:"status" json:"status"`
+	Response         string        `bson:"response,omitempty" json:"response,omitempty"`
+	RespondedAt      *time.Time    `bson:"responded_at,omitempty" json:"responded_at,omitempty"`
+	DecidedBy        *uuid.UUID    `bson:"decided_by,omitempty" json:"decided_by,omitempty"`
+	DecisionNote     string        `bson:"decision_note,omitempty" json:"decision_note,omitempty"`
+	DecidedAt        *time.Time    `bson:"decided_at,omitempty" json:"decided_at,omitempty"`
+}
+
+// Open reports whether the forfeit report is still undecided.
+func (f *Forfeit) Open() bool {
+	return f.Status == ForfeitStatusPending || f.Status == ForfeitStatusContested
+}
+
+// Opponent returns the other team of the challenge, or uuid.Nil if teamID is
+// not part of it.
+func (c *Challenge) Opponent(teamID uuid.UUID) uuid.UUID {
+	switch teamID {
+	case c.ChallengerTeamID:
+		return c.DefenderTeamID
+	case c.DefenderTeamID:
+		return c.ChallengerTeamID
+	}
+	return uuid.Nil
+}
+
+// ReportForfeit records teamID's report that its opponent did not show up.
+// The opponent has ForfeitResponseWindow to contest it.
+func (c *Challenge) ReportForfeit(teamID, reportedBy uuid.UUID, reason string, now time.Time) error {
+	if c.Status != ChallengeStatusAccepted {
+		return ErrChallengeNotResolving
+	}
+	if c.Opponent(teamID) == uuid.Nil {
+		return ErrNotChallengeTeam
+	}
+	if c.Forfeit != nil && c.Forfeit.Open() {
+		return ErrForfeitOpen
+	}
+	reason, err := forfeitNote(reason)
+	if err != nil {
+		return err
+	}
+
+	c.Forfeit = &Forfeit{
+		ReportedByTeamID: teamID,
+		ReportedBy:       reportedBy,
+		Reason:           reason,
+		ReportedAt:       now,
+		RespondBy:        now.Add(ForfeitResponseWindow),
+		Status:           ForfeitStatusPending,
+	}
+	return nil
+}
+
+// ContestForfeit records the reported team's response. The report then
+// waits for an organizer's decision.
+func (c *Challenge) ContestForfeit(teamID uuid.UUID, response string, now time.Time) error {
+	if c.Forfeit == nil || !c.Forfeit.Open() {
+		return ErrForfeitNotFound
+	}
+	if c.Forfeit.Status != ForfeitStatusPending || !now.Before(c.Forfeit.RespondBy) {
+		return ErrForfeitNotPending
+	}
+	if teamID != c.Opponent(c.Forfeit.ReportedByTeamID) {
+		return ErrNotForfeitedTeam
+	}
+	response, err := forfeitNote(response)
+	if err != nil {
+		return err
+	}
+
+	c.Forfeit.Status = ForfeitStatusContested
+	c.Forfeit.Response = response
+	c.Forfeit.RespondedAt = &now
+	return nil
+}
+
+// ForfeitLapsed reports whether the reported team let the response window
+// pass without contesting.
+func (c *Challenge) ForfeitLapsed(now time.Time) bool {
+	return c.Forfeit != nil && c.Forfeit.Status == ForfeitStatusPending && !now.Before(c.Forfeit.RespondBy)
+}
+
+// DecideForfeit settles an open forfeit report. Upholding it completes the
+// challenge in favor of the reporting team; dismissing it lets the challenge
+// go on. decidedBy is nil when a lapsed report is upheld automatically.
+func (c *Challenge) DecideForfeit(uphold bool, decidedBy *uuid.UUID, note string, now time.Time) error {
+	if c.Status != ChallengeStatusAccepted || c.Forfeit == nil || !c.Forfeit.Open() {
+		return ErrForfeitNotFound
+	}
+	note, err := forfeitNote(note)
+	if err != nil {
+		return err
+	}
+
+	c.Forfeit.DecidedBy = decidedBy
+	c.Forfeit.DecisionNote = note
+	c.Forfeit.DecidedAt = &now
+	if !uphold {
+		c.Forfeit.Status = ForfeitStatusDismissed
+		return nil
+	}
+	c.Forfeit.Status = ForfeitStatusUpheld
+	c.complete(OutcomeForfeit, c.Forfeit.ReportedByTeamID, now)
+	return nil
+}
+
+// SetForfeitPenalty sets how many extra rungs a team that forfeits a
+// challenge drops, after the winner took its rung.
+func (l *Ladder) SetForfeitPenalty(rungs int) error {
+	if rungs < 0 || rungs > MaxForfeitPenalty {
+		return ErrInvalidForfeitPenalty
+	}
+	l.ForfeitPenalty = rungs
+	return nil
+}
+
+// Demote moves a team down by up to rungs places; the teams it passes move up
+// one rung each. It reports whether the rungs changed.
+func (l *Ladder) Demote(teamID uuid.UUID, rungs int, now time.Time) bool {
+	pos := l.Position(teamID)
+	if pos == 0 || rungs <= 0 || pos == len(l.Rungs) {
+		return false
+	}
+
+	target := min(pos+rungs, len(l.Rungs))
+	copy(l.Rungs[pos-1:target-1], l.Rungs[pos:target])
+	l.Rungs[target-1] = teamID
+	l.UpdatedAt = now
+	return true
+}
+
+func forfeitNote(note string) (string, error) {
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > MaxForfeitNoteLength {
+		return "", ErrForfeitNoteTooLong
+	}
+	return note, nil
+}
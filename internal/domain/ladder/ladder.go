@@ -0,0 +1,182 @@
+// Package ladder provides domain entities and logic for always-on ladder
+// competition. Each ladder season runs on a regular tournament, so teams
+// register and report matches through the existing team and match flows;
+// the ladder adds an ordered ranking that changes through challenges.
+package ladder
+
+import (
+	"errors"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultSeasonWeeks is how long a ladder season runs when none is given.
+	DefaultSeasonWeeks = 8
+
+	// MaxSeasonWeeks is the longest ladder season.
+	MaxSeasonWeeks = 52
+
+	// DefaultChallengeRange is how many rungs above itself a team may challenge when none is given.
+	DefaultChallengeRange = 3
+)
+
+var (
+	ErrNotFound            = errors.New("ladder not found")
+	ErrConflict            = errors.New("ladder was changed concurrently")
+	ErrLadderExists        = errors.New("game already has a ladder")
+	ErrInvalidName         = errors.New("ladder name cannot be empty")
+	ErrInvalidGame         = errors.New("game ID cannot be empty")
+	ErrInvalidSeasonLength = errors.New("season must last between 1 and 52 weeks")
+	ErrInvalidRange        = errors.New("challenge range must be at least 1")
+	ErrAlreadyOnLadder     = errors.New("team is already on the ladder")
+	ErrNotOnLadder         = errors.New("team is not on the ladder")
+	ErrNotSeasonTeam       = errors.New("team is not registered for the current ladder season")
+	ErrTeamInactive        = errors.New("team is disbanded or disqualified")
+)
+
+// Ladder is an always-on competition for a game. Teams are ranked on rungs,
+// best first: a team joins at the bottom and climbs by beating teams up to
+// ChallengeRange rungs above it. Every SeasonWeeks weeks the season rolls
+// over onto a new tournament and the rungs are cleared.
+type Ladder struct {
+	ID              uuid.UUID           `bson:"_id" json:"id"`
+	GameID          uuid.UUID           `bson:"game_id" json:"game_id"`
+	Name            string              `bson:"name" json:"name"`
+	TeamSize        tournament.TeamSize `bson:"team_size" json:"team_size"`
+	SeasonWeeks     int                 `bson:"season_weeks" json:"season_weeks"`
+	ChallengeRange  int                 `bson:"challenge_range" json:"challenge_range"`
+	ForfeitPenalty  int                 `bson:"forfeit_penalty,omitempty" json:"forfeit_penalty"` // Extra rungs a team that forfeits a challenge drops
+	Season          int                 `bson:"season" json:"season"`
+	TournamentID    uuid.UUID           `bson:"tournament_id" json:"tournament_id"` // Tournament the current season runs on
+	SeasonStartedAt time.Time           `bson:"season_started_at" json:"season_started_at"`
+	SeasonEndsAt    time.Time           `bson:"season_ends_at" json:"season_ends_at"`
+	Rungs           []uuid.UUID         `bson:"rungs" json:"rungs"` // Team IDs, best first
+	LastSnapshotAt  *time.Time          `bson:"last_snapshot_at,omitempty" json:"last_snapshot_at,omitempty"`
+	CreatedBy       uuid.UUID           `bson:"created_by" json:"created_by"`
+	CreatedAt       time.Time           `bson:"created_at" json:"created_at"`
+	UpdatedAt       time.Time           `bson:"updated_at" json:"updated_at"`
+	Version         int                 `bson:"version" json:"-"` // Incremented on every update to detect concurrent changes
+}
+
+// NewLadder creates a ladder without a season; StartSeason opens the first one.
+// A zero seasonWeeks or challengeRange selects the default.
+func NewLadder(gameID, createdBy uuid.UUID, name string, teamSize tournament.TeamSize, seasonWeeks, challengeRange int) (*Ladder, error) {
+	if gameID == uuid.Nil {
+		return nil, ErrInvalidGame
+	}
+	if name == "" {
+		return nil, ErrInvalidName
+	}
+	if !teamSize.IsValid() {
+		return nil, tournament.ErrInvalidTeamSize
+	}
+	if seasonWeeks == 0 {
+		seasonWeeks = DefaultSeasonWeeks
+	}
+	if seasonWeeks < 1 || seasonWeeks > MaxSeasonWeeks {
+		return nil, ErrInvalidSeasonLength
+	}
+	if challengeRange == 0 {
+		challengeRange = DefaultChallengeRange
+	}
+	if challengeRange < 1 {
+		return nil, ErrInvalidRange
+	}
+
+	now := time.Now().UTC()
+	return &Ladder{
+		ID:             uuid.New(),
+		GameID:         gameID,
+		Name:           name,
+		TeamSize:       teamSize,
+		SeasonWeeks:    seasonWeeks,
+		ChallengeRange: challengeRange,
+		Rungs:          []uuid.UUID{},
+		CreatedBy:      createdBy,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}, nil
+}
+
+// SeasonLength is how long each season runs.
+func (l *Ladder) SeasonLength() time.Duration {
+	return time.Duration(l.SeasonWeeks) * 7 * 24 * time.Hour
+}
+
+// SeasonOver reports whether the current season has ended.
+func (l *Ladder) SeasonOver(now time.Time) bool {
+	return !now.Before(l.SeasonEndsAt)
+}
+
+// StartSeason opens the next season on the given tournament. The rungs are
+// cleared: teams register for the new season's tournament and join again.
+func (l *Ladder) StartSeason(tournamentID uuid.UUID, now time.Time) {
+	l.Season++
+	l.TournamentID = tournamentID
+	l.SeasonStartedAt = now
+	l.SeasonEndsAt = now.Add(l.SeasonLength())
+	l.Rungs = []uuid.UUID{}
+	l.UpdatedAt = now
+}
+
+// Position returns a team's rung, 1 for the top, or 0 if it is not on the ladder.
+func (l *Ladder) Position(teamID uuid.UUID) int {
+	for i, id := range l.Rungs {
+		if id == teamID {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// Join places a team on the bottom rung.
+func (l *Ladder) Join(teamID uuid.UUID, now time.Time) error {
+	if l.Position(teamID) > 0 {
+		return ErrAlreadyOnLadder
+	}
+	l.Rungs = append(l.Rungs, teamID)
+	l.UpdatedAt = now
+	return nil
+}
+
+// Leave removes a team from the ladder; the teams below it move up a rung.
+func (l *Ladder) Leave(teamID uuid.UUID, now time.Time) error {
+	pos := l.Position(teamID)
+	if pos == 0 {
+		return ErrNotOnLadder
+	}
+	l.Rungs = append(l.Rungs[:pos-1], l.Rungs[pos:]...)
+	l.UpdatedAt = now
+	return nil
+}
+
+// CanChallenge checks that challenger may challenge opponent: both are on the
+// ladder and the opponent is above the challenger by at most ChallengeRange rungs.
+func (l *Ladder) CanChallenge(challengerID, opponentID uuid.UUID) error {
+	challenger, opponent := l.Position(challengerID), l.Position(opponentID)
+	if challenger == 0 || opponent == 0 {
+		return ErrNotOnLadder
+	}
+	if opponent >= challenger || challenger-opponent > l.ChallengeRange {
+		return ErrChallengeOutOfRange
+	}
+	return nil
+}
+
+// Promote moves a challenge winner onto the rung of the team it beat when the
+// winner was below it; the beaten team and those between move down one rung.
+// It reports whether the rungs changed.
+func (l *Ladder) Promote(winnerID, loserID uuid.UUID, now time.Time) bool {
+	winner, loser := l.Position(winnerID), l.Position(loserID)
+	if winner == 0 || loser == 0 || winner < loser {
+		return false
+	}
+
+	copy(l.Rungs[loser:winner], l.Rungs[loser-1:winner-1])
+	l.Rungs[loser-1] = winnerID
+	l.UpdatedAt = now
+	return true
+}
@@ -0,0 +1,463 @@
+package ladder
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestLadder(t *testing.T, teams int) (*Ladder, []uuid.UUID) {
+	t.Helper()
+
+	l, err := NewLadder(uuid.New(), uuid.New(), "Weekly Ladder", tournament.TeamSizeTrios, 0, 2)
+	require.NoError(t, err)
+
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	l.StartSeason(uuid.New(), now)
+
+	ids := make([]uuid.UUID, teams)
+	for i := range ids {
+		ids[i] = uuid.New()
+		require.NoError(t, l.Join(ids[i], now))
+	}
+	return l, ids
+}
+
+func TestNewLadder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		gameID         uuid.UUID
+		ladderName     string
+		teamSize       tournament.TeamSize
+		seasonWeeks    int
+		challengeRange int
+		expectedErr    error
+	}{
+		{name: "applies defaults", gameID: uuid.New(), ladderName: "Ladder", teamSize: tournament.TeamSizeDuos},
+		{name: "missing game", ladderName: "Ladder", teamSize: tournament.TeamSizeDuos, expectedErr: ErrInvalidGame},
+		{name: "empty name", gameID: uuid.New(), teamSize: tournament.TeamSizeDuos, expectedErr: ErrInvalidName},
+		{name: "invalid team size", gameID: uuid.New(), ladderName: "Ladder", teamSize: tournament.MaxTeamSize + 1, expectedErr: tournament.ErrInvalidTeamSize},
+		{name: "season too long", gameID: uuid.New(), ladderName: "Ladder", teamSize: tournament.TeamSizeDuos, seasonWeeks: 53, expectedErr: ErrInvalidSeasonLength},
+		{name: "negative range", gameID: uuid.New(), ladderName: "Ladder", teamSize: tournament.TeamSizeDuos, challengeRange: -1, expectedErr: ErrInvalidRange},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			l, err := NewLadder(tt.gameID, uuid.New(), tt.ladderName, tt.teamSize, tt.seasonWeeks, tt.challengeRange)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, DefaultSeasonWeeks, l.SeasonWeeks)
+			assert.Equal(t, DefaultChallengeRange, l.ChallengeRange)
+			assert.Zero(t, l.Season)
+		})
+	}
+}
+
+func TestLadder_StartSeason(t *testing.T) {
+	t.Parallel()
+
+	l, _ := newTestLadder(t, 3)
+	first := l.SeasonEndsAt
+
+	assert.False(t, l.SeasonOver(first.Add(-time.Second)))
+	assert.True(t, l.SeasonOver(first))
+
+	tournamentID := uuid.New()
+	l.StartSeason(tournamentID, first)
+
+	assert.Equal(t, 2, l.Season)
+	assert.Equal(t, tournamentID, l.TournamentID)
+	assert.Equal(t, first.Add(8*7*24*time.Hour), l.SeasonEndsAt)
+	assert.Empty(t, l.Rungs)
+}
+
+func TestLadder_JoinAndLeave(t *testing.T) {
+	t.Parallel()
+
+	l, ids := newTestLadder(t, 3)
+	now := time.Now()
+
+	require.ErrorIs(t, l.Join(ids[0], now), ErrAlreadyOnLadder)
+	assert.Equal(t, 3, l.Position(ids[2]))
+
+	require.NoError(t, l.Leave(ids[0], now))
+	assert.Equal(t, []uuid.UUID{ids[1], ids[2]}, l.Rungs)
+	assert.Zero(t, l.Position(ids[0]))
+
+	require.ErrorIs(t, l.Leave(ids[0], now), ErrNotOnLadder)
+}
+
+func TestLadder_CanChallenge(t *testing.T) {
+	t.Parallel()
+
+	l, ids := newTestLadder(t, 5)
+
+	tests := []struct {
+		name        string
+		challenger  uuid.UUID
+		opponent    uuid.UUID
+		expectedErr error
+	}{
+		{name: "one rung above", challenger: ids[4], opponent: ids[3]},
+		{name: "at the edge of the range", challenger: ids[4], opponent: ids[2]},
+		{name: "beyond the range", challenger: ids[4], opponent: ids[1], expectedErr: ErrChallengeOutOfRange},
+		{name: "opponent below", challenger: ids[1], opponent: ids[2], expectedErr: ErrChallengeOutOfRange},
+		{name: "same team", challenger: ids[1], opponent: ids[1], expectedErr: ErrChallengeOutOfRange},
+		{name: "not on ladder", challenger: uuid.New(), opponent: ids[0], expectedErr: ErrNotOnLadder},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := l.CanChallenge(tt.challenger, tt.opponent)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestLadder_Promote(t *testing.T) {
+	t.Parallel()
+
+	l, ids := newTestLadder(t, 5)
+	now := time.Now()
+
+	assert.True(t, l.Promote(ids[4], ids[2], now))
+	assert.Equal(t, []uuid.UUID{ids[0], ids[1], ids[4], ids[2], ids[3]}, l.Rungs)
+
+	// A defender that holds its rung stays put.
+	assert.False(t, l.Promote(ids[1], ids[4], now))
+	assert.Equal(t, []uuid.UUID{ids[0], ids[1], ids[4], ids[2], ids[3]}, l.Rungs)
+
+	// A team that left the ladder cannot move anyone.
+	assert.False(t, l.Promote(uuid.New(), ids[0], now))
+}
+
+func TestChallenge_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	l, ids := newTestLadder(t, 4)
+	defender, challenger := ids[1], ids[3]
+
+	_, err := NewChallenge(l, challenger, challenger, uuid.New(), now)
+	require.ErrorIs(t, err, ErrSameTeam)
+	_, err = NewChallenge(l, ids[0], defender, uuid.New(), now)
+	require.ErrorIs(t, err, ErrChallengeOutOfRange)
+
+	t.Run("played challenge goes to the better placement", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := NewChallenge(l, challenger, defender, uuid.New(), now)
+		require.NoError(t, err)
+		require.ErrorIs(t, c.Resolve(uuid.New(), 1, uuid.New(), 2, now), ErrChallengeNotResolving)
+
+		require.NoError(t, c.Accept(now))
+		require.ErrorIs(t, c.Accept(now), ErrChallengeNotPending)
+		require.NoError(t, c.Resolve(uuid.New(), 3, uuid.New(), 5, now))
+
+		assert.Equal(t, ChallengeStatusCompleted, c.Status)
+		assert.Equal(t, OutcomePlayed, c.Outcome)
+		assert.Equal(t, challenger, *c.WinnerTeamID)
+		assert.Equal(t, defender, c.Loser())
+	})
+
+	t.Run("tie goes to the defender", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := NewChallenge(l, challenger, defender, uuid.New(), now)
+		require.NoError(t, err)
+		require.NoError(t, c.Accept(now))
+		require.NoError(t, c.Resolve(uuid.New(), 4, uuid.New(), 4, now))
+
+		assert.Equal(t, defender, *c.WinnerTeamID)
+	})
+
+	t.Run("declined challenge is forfeited", func(t *testing.T) {
+		t.Parallel()
+
+		c, err := NewChallenge(l, challenger, defender, uuid.New(), now)
+		require.NoError(t, err)
+		require.NoError(t, c.Decline(now))
+
+		assert.Equal(t, OutcomeDeclined, c.Outcome)
+		assert.Equal(t, challenger, *c.WinnerTeamID)
+		assert.False(t, c.Open())
+	})
+}
+
+func TestChallenge_Expire(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	l, ids := newTestLadder(t, 2)
+	defender, challenger := ids[0], ids[1]
+
+	tests := []struct {
+		name             string
+		accept           bool
+		challengerPlayed bool
+		defenderPlayed   bool
+		expectedWinner   uuid.UUID
+	}{
+		{name: "unanswered challenge goes to the challenger", expectedWinner: challenger},
+		{name: "neither played keeps the defender", accept: true, expectedWinner: defender},
+		{name: "only the challenger played", accept: true, challengerPlayed: true, expectedWinner: challenger},
+		{name: "only the defender played", accept: true, defenderPlayed: true, expectedWinner: defender},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := NewChallenge(l, challenger, defender, uuid.New(), now)
+			require.NoError(t, err)
+
+			deadline := c.RespondBy
+			if tt.accept {
+				require.NoError(t, c.Accept(now))
+				deadline = *c.PlayBy
+			}
+			assert.False(t, c.Expired(deadline.Add(-time.Second)))
+			assert.True(t, c.Expired(deadline))
+
+			c.Expire(tt.challengerPlayed, tt.defenderPlayed, deadline)
+			assert.Equal(t, OutcomeExpired, c.Outcome)
+			assert.Equal(t, tt.expectedWinner, *c.WinnerTeamID)
+		})
+	}
+}
+
+func TestWeekStart(t *testing.T) {
+	t.Parallel()
+
+	monday := time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)
+	assert.Equal(t, monday, WeekStart(time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC)))
+	assert.Equal(t, monday, WeekStart(time.Date(2026, 3, 8, 23, 59, 0, 0, time.UTC)))
+	assert.Equal(t, monday.AddDate(0, 0, 7), WeekStart(time.Date(2026, 3, 9, 1, 0, 0, 0, time.UTC)))
+}
+
+func TestLadder_TakeSnapshot(t *testing.T) {
+	t.Parallel()
+
+	l, ids := newTestLadder(t, 2)
+	now := time.Date(2026, 3, 5, 9, 0, 0, 0, time.UTC)
+
+	assert.True(t, l.SnapshotDue(now))
+	s := l.TakeSnapshot(false, now)
+	assert.False(t, l.SnapshotDue(now.AddDate(0, 0, 3)))
+	assert.True(t, l.SnapshotDue(now.AddDate(0, 0, 4)))
+
+	assert.Equal(t, l.ID, s.LadderID)
+	assert.Equal(t, 1, s.Season)
+	assert.Equal(t, time.Date(2026, 3, 2, 0, 0, 0, 0, time.UTC), s.Week)
+	assert.Equal(t, []Standing{{Position: 1, TeamID: ids[0]}, {Position: 2, TeamID: ids[1]}}, s.Standings)
+}
+
+func TestLadder_Demote(t *testing.T) {
+	t.Parallel()
+
+	l, ids := newTestLadder(t, 5)
+	now := time.Now()
+
+	assert.True(t, l.Demote(ids[1], 2, now))
+	assert.Equal(t, []uuid.UUID{ids[0], ids[2], ids[3], ids[1], ids[4]}, l.Rungs)
+
+	// A penalty past the bottom stops at the last rung.
+	assert.True(t, l.Demote(ids[0], 10, now))
+	assert.Equal(t, []uuid.UUID{ids[2], ids[3], ids[1], ids[4], ids[0]}, l.Rungs)
+
+	assert.False(t, l.Demote(ids[0], 1, now))
+	assert.False(t, l.Demote(ids[2], 0, now))
+	assert.False(t, l.Demote(uuid.New(), 1, now))
+}
+
+func TestChallenge_Forfeit(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	l, ids := newTestLadder(t, 2)
+	defender, challenger := ids[0], ids[1]
+	organizer := uuid.New()
+
+	accepted := func(t *testing.T) *Challenge {
+		t.Helper()
+		c, err := NewChallenge(l, challenger, defender, uuid.New(), now)
+		require.NoError(t, err)
+		require.ErrorIs(t, c.ReportForfeit(challenger, uuid.New(), "", now), ErrChallengeNotResolving)
+		require.NoError(t, c.Accept(now))
+		return c
+	}
+
+	t.Run("lapsed report is upheld", func(t *testing.T) {
+		t.Parallel()
+
+		c := accepted(t)
+		require.ErrorIs(t, c.ReportForfeit(uuid.New(), uuid.New(), "", now), ErrNotChallengeTeam)
+		require.NoError(t, c.ReportForfeit(challenger, uuid.New(), " Defender never joined the lobby ", now))
+		require.ErrorIs(t, c.ReportForfeit(defender, uuid.New(), "", now), ErrForfeitOpen)
+		assert.Equal(t, "Defender never joined the lobby", c.Forfeit.Reason)
+
+		assert.False(t, c.ForfeitLapsed(c.Forfeit.RespondBy.Add(-time.Second)))
+		assert.True(t, c.ForfeitLapsed(c.Forfeit.RespondBy))
+		require.ErrorIs(t, c.ContestForfeit(defender, "", c.Forfeit.RespondBy), ErrForfeitNotPending)
+
+		require.NoError(t, c.DecideForfeit(true, nil, "", c.Forfeit.RespondBy))
+		assert.Equal(t, ForfeitStatusUpheld, c.Forfeit.Status)
+		assert.Equal(t, OutcomeForfeit, c.Outcome)
+		assert.Equal(t, challenger, *c.WinnerTeamID)
+		assert.Equal(t, defender, c.Loser())
+	})
+
+	t.Run("contested report is dismissed", func(t *testing.T) {
+		t.Parallel()
+
+		c := accepted(t)
+		require.NoError(t, c.ReportForfeit(defender, uuid.New(), "", now))
+		require.ErrorIs(t, c.ContestForfeit(defender, "", now), ErrNotForfeitedTeam)
+		require.NoError(t, c.ContestForfeit(challenger, "We were in lobby 3", now))
+		assert.Equal(t, ForfeitStatusContested, c.Forfeit.Status)
+		assert.False(t, c.ForfeitLapsed(now.Add(ForfeitResponseWindow)))
+
+		require.NoError(t, c.DecideForfeit(false, &organizer, "Lobby logs show both teams", now))
+		assert.Equal(t, ForfeitStatusDismissed, c.Forfeit.Status)
+		assert.Equal(t, &organizer, c.Forfeit.DecidedBy)
+		assert.True(t, c.Open())
+		require.ErrorIs(t, c.DecideForfeit(true, &organizer, "", now), ErrForfeitNotFound)
+
+		// The challenge goes on, so the team can report again.
+		require.NoError(t, c.ReportForfeit(defender, uuid.New(), "", now))
+	})
+}
+
+func TestChallenge_Reschedule(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	l, ids := newTestLadder(t, 2)
+	defender, challenger := ids[0], ids[1]
+
+	accepted := func(t *testing.T) *Challenge {
+		t.Helper()
+		c, err := NewChallenge(l, challenger, defender, uuid.New(), now)
+		require.NoError(t, err)
+		require.ErrorIs(t, c.ProposeReschedule(challenger, uuid.New(), now.Add(time.Hour), "", now), ErrChallengeNotResolving)
+		require.NoError(t, c.Accept(now))
+		return c
+	}
+
+	t.Run("accepted proposal moves the deadline", func(t *testing.T) {
+		t.Parallel()
+
+		c := accepted(t)
+		original := *c.PlayBy
+		later := original.Add(48 * time.Hour)
+
+		require.ErrorIs(t, c.ProposeReschedule(uuid.New(), uuid.New(), later, "", now), ErrNotChallengeTeam)
+		require.ErrorIs(t, c.ProposeReschedule(challenger, uuid.New(), now, "", now), ErrInvalidPlayBy)
+		require.ErrorIs(t, c.ProposeReschedule(challenger, uuid.New(), original.Add(MaxRescheduleDelay+time.Second), "", now), ErrInvalidPlayBy)
+		require.NoError(t, c.ProposeReschedule(challenger, uuid.New(), later, " Weekend works better ", now))
+		require.ErrorIs(t, c.ProposeReschedule(defender, uuid.New(), later, "", now), ErrRescheduleOpen)
+		assert.Equal(t, "Weekend works better", c.Reschedule.Reason)
+		assert.Equal(t, now.Add(RescheduleResponseWindow), c.Reschedule.RespondBy)
+
+		require.ErrorIs(t, c.RespondToReschedule(challenger, true, now), ErrNotRescheduleResponder)
+		require.NoError(t, c.RespondToReschedule(defender, true, now))
+		assert.Equal(t, RescheduleStatusAccepted, c.Reschedule.Status)
+		assert.Equal(t, later, *c.PlayBy)
+		assert.Equal(t, original, c.Reschedule.PreviousPlayBy)
+		require.ErrorIs(t, c.RespondToReschedule(defender, true, now), ErrRescheduleNotFound)
+	})
+
+	t.Run("unanswered proposal lapses", func(t *testing.T) {
+		t.Parallel()
+
+		c := accepted(t)
+		original := *c.PlayBy
+		require.NoError(t, c.ProposeReschedule(defender, uuid.New(), original.Add(time.Hour), "", now))
+
+		deadline := c.Reschedule.RespondBy
+		assert.False(t, c.LapseReschedule(deadline.Add(-time.Second)))
+		require.ErrorIs(t, c.RespondToReschedule(challenger, true, deadline), ErrRescheduleNotFound)
+		assert.True(t, c.LapseReschedule(deadline))
+		assert.Equal(t, RescheduleStatusLapsed, c.Reschedule.Status)
+		assert.Equal(t, original, *c.PlayBy)
+	})
+
+	t.Run("admin override replaces a pending proposal", func(t *testing.T) {
+		t.Parallel()
+
+		c := accepted(t)
+		admin := uuid.New()
+		require.NoError(t, c.ProposeReschedule(defender, uuid.New(), c.PlayBy.Add(time.Hour), "", now))
+
+		require.ErrorIs(t, c.OverrideSchedule(now, admin, now), ErrInvalidPlayBy)
+		override := now.Add(10 * 24 * time.Hour)
+		require.NoError(t, c.OverrideSchedule(override, admin, now))
+		assert.Equal(t, RescheduleStatusOverridden, c.Reschedule.Status)
+		assert.Equal(t, &admin, c.Reschedule.DecidedBy)
+		assert.Equal(t, override, *c.PlayBy)
+		assert.False(t, c.ReschedulePending(now))
+	})
+
+	t.Run("no proposals after the deadline", func(t *testing.T) {
+		t.Parallel()
+
+		c := accepted(t)
+		require.ErrorIs(t, c.ProposeReschedule(challenger, uuid.New(), c.PlayBy.Add(time.Hour), "", *c.PlayBy), ErrChallengeDeadlinePassed)
+	})
+}
+
+func TestChallenge_DueReminder(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 3, 4, 12, 0, 0, 0, time.UTC)
+	l, ids := newTestLadder(t, 2)
+	c, err := NewChallenge(l, ids[1], ids[0], uuid.New(), now)
+	require.NoError(t, err)
+
+	// The response window is 48 hours, so the first reminder is a day out.
+	assert.Nil(t, c.DueReminder(now))
+	r := c.DueReminder(c.RespondBy.Add(-24 * time.Hour))
+	require.NotNil(t, r)
+	assert.Equal(t, DeadlineRespond, r.Deadline)
+	assert.False(t, r.Final())
+	c.Remind(r, now)
+	assert.Nil(t, c.DueReminder(c.RespondBy.Add(-2*time.Hour)))
+
+	r = c.DueReminder(c.RespondBy.Add(-time.Hour))
+	require.NotNil(t, r)
+	assert.True(t, r.Final())
+	c.Remind(r, now)
+	assert.Nil(t, c.DueReminder(c.RespondBy.Add(-time.Minute)))
+
+	// Accepting late skips straight to the final reminder once it is due.
+	require.NoError(t, c.Accept(now))
+	late := c.PlayBy.Add(-30 * time.Minute)
+	r = c.DueReminder(late)
+	require.NotNil(t, r)
+	assert.Equal(t, DeadlinePlay, r.Deadline)
+	assert.True(t, r.Final())
+	c.Remind(r, late)
+	assert.Nil(t, c.DueReminder(late))
+	assert.Nil(t, c.DueReminder(*c.PlayBy))
+
+	// A moved deadline is reminded again.
+	require.NoError(t, c.OverrideSchedule(c.PlayBy.Add(2*time.Hour), uuid.New(), late))
+	assert.NotNil(t, c.DueReminder(c.PlayBy.Add(-time.Hour)))
+}
@@ -0,0 +1,76 @@
+package ladder
+
+import (
+	"time"
+)
+
+// ReminderLeads are how long before a challenge deadline its reminders go
+// out, longest first. The last one is the final reminder.
+var ReminderLeads = []time.Duration{24 * time.Hour, time.Hour}
+
+// Deadline names the challenge deadline a reminder is for.
+type Deadline string
+
+const (
+	DeadlineRespond Deadline = "respond" // The defender must accept or decline
+	DeadlinePlay    Deadline = "play"    // Both teams must submit their match
+)
+
+// Reminder is a deadline reminder sent for a challenge.
+type Reminder struct {
+	Deadline Deadline      `bson:"deadline"`
+	DueAt    time.Time     `bson:"due_at"`
+	Lead     time.Duration `bson:"lead"`
+	SentAt   time.Time     `bson:"sent_at,omitempty"`
+}
+
+// Final reports whether this is the last reminder before the deadline.
+func (r *Reminder) Final() bool {
+	return r.Lead == ReminderLeads[len(ReminderLeads)-1]
+}
+
+// DueReminder returns the reminder due at now for the challenge's current
+// deadline, or nil if none is. Only the shortest lead that started is due, so
+// a deadline set less than an hour away gets just the final reminder.
+// Moving a deadline makes its reminders due again.
+func (c *Challenge) DueReminder(now time.Time) *Reminder {
+	var r Reminder
+	switch {
+	case c.Status == ChallengeStatusPending:
+		r.Deadline, r.DueAt = DeadlineRespond, c.RespondBy
+	case c.Status == ChallengeStatusAccepted && c.PlayBy != nil:
+		r.Deadline, r.DueAt = DeadlinePlay, *c.PlayBy
+	default:
+		return nil
+	}
+	if !now.Before(r.DueAt) {
+		return nil
+	}
+
+	for i := len(ReminderLeads) - 1; i >= 0; i-- {
+		r.Lead = ReminderLeads[i]
+		if now.Before(r.DueAt.Add(-r.Lead)) {
+			continue
+		}
+		if c.reminded(r) {
+			return nil
+		}
+		return &r
+	}
+	return nil
+}
+
+// Remind records that a reminder went out.
+func (c *Challenge) Remind(r *Reminder, now time.Time) {
+	r.SentAt = now
+	c.Reminders = append(c.Reminders, *r)
+}
+
+func (c *Challenge) reminded(r Reminder) bool {
+	for _, sent := range c.Reminders {
+		if sent.Deadline == r.Deadline && sent.DueAt.Equal(r.DueAt) && sent.Lead == r.Lead {
+			return true
+		}
+	}
+	return false
+}
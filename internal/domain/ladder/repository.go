@@ -0,0 +1,18 @@
+package ladder
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// Repository persists ladders.
+type Repository interface {
+	// Create stores a ladder. It returns ErrLadderExists if the game already has one.
+	Create(ctx context.Context, l *Ladder) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Ladder, error)
+	List(ctx context.Context) ([]*Ladder, error)
+	// Update stores the ladder if it is unchanged since it was read and
+	// increments its version; otherwise it returns ErrConflict.
+	Update(ctx context.Context, l *Ladder) error
+}
@@ -0,0 +1,172 @@
+package ladder
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// RescheduleResponseWindow is how long the other team has to answer a
+	// reschedule proposal. Unanswered proposals lapse and the current play
+	// deadline stands.
+	RescheduleResponseWindow = 24 * time.Hour
+
+	// MaxRescheduleDelay caps how far past the current play deadline a
+	// reschedule can move it.
+	MaxRescheduleDelay = 7 * 24 * time.Hour
+
+	// MaxRescheduleReasonLength caps a reschedule proposal's reason, in characters.
+	MaxRescheduleReasonLength = 500
+)
+
+var (
+	ErrInvalidPlayBy           = errors.New("new play deadline must be in the future and at most 7 days after the current one")
+	ErrRescheduleReasonTooLong = errors.New("reschedule reason cannot exceed 500 characters")
+	ErrRescheduleOpen          = errors.New("challenge already has a pending reschedule proposal")
+	ErrRescheduleNotFound      = errors.New("challenge has no pending reschedule proposal")
+	ErrNotRescheduleResponder  = errors.New("only the other team can answer a reschedule proposal")
+	ErrChallengeDeadlinePassed = errors.New("challenge play deadline has passed")
+)
+
+// RescheduleStatus represents where a reschedule proposal is in its lifecycle.
+type RescheduleStatus string
+
+const (
+	RescheduleStatusPending    RescheduleStatus = "pending"    // Waiting for the other team to answer
+	RescheduleStatusAccepted   RescheduleStatus = "accepted"   // The play deadline moved
+	RescheduleStatusDeclined   RescheduleStatus = "declined"   // The current play deadline stands
+	RescheduleStatusLapsed     RescheduleStatus = "lapsed"     // Unanswered in time; the current play deadline stands
+	RescheduleStatusOverridden RescheduleStatus = "overridden" // An admin set the play deadline
+)
+
+// Reschedule is a proposal to move an accepted challenge's play deadline.
+// When an admin sets the deadline directly, ProposedByTeamID is uuid.Nil and
+// DecidedBy is the admin.
+type Reschedule struct {
+	ProposedByTeamID uuid.UUID        `bson:"proposed_by_team_id" json:"proposed_by_team_id"`
+	ProposedBy       uuid.UUID        `bson:"proposed_by" json:"proposed_by"`
+	PlayBy           time.Time        `bson:"play_by" json:"play_by"`                   // The proposed play deadline
+	PreviousPlayBy   time.Time        `bson:"previous_play_by" json:"previous_play_by"` // The deadline it replaces
+	Reason           string           `bson:"reason,omitempty" json:"reason,omitempty"`
+	ProposedAt       time.Time        `bson:"proposed_at" json:"proposed_at"`
+	RespondBy        time.Time        `bson:"respond_by" json:"respond_by"`
+	Status           RescheduleStatus `bson:"status" json:"status"`
+	RespondedAt      *time.Time       `bson:"responded_at,omitempty" json:"responded_at,omitempty"`
+	DecidedBy        *uuid.UUID       `bson:"decided_by,omitempty" json:"decided_by,omitempty"`
+}
+
+// ProposeReschedule lets teamID propose moving the play deadline to playBy.
+// The other team has until the earlier of RescheduleResponseWindow and the
+// current deadline to answer.
+func (c *Challenge) ProposeReschedule(teamID, proposedBy uuid.UUID, playBy time.Time, reason string, now time.Time) error {
+	if c.Status != ChallengeStatusAccepted {
+		return ErrChallengeNotResolving
+	}
+	if c.Expired(now) {
+		return ErrChallengeDeadlinePassed
+	}
+	if c.Opponent(teamID) == uuid.Nil {
+		return ErrNotChallengeTeam
+	}
+	if c.Forfeit != nil && c.Forfeit.Open() {
+		return ErrForfeitOpen
+	}
+	if c.ReschedulePending(now) {
+		return ErrRescheduleOpen
+	}
+	if err := c.validPlayBy(playBy, now); err != nil {
+		return err
+	}
+	reason = strings.TrimSpace(reason)
+	if utf8.RuneCountInString(reason) > MaxRescheduleReasonLength {
+		return ErrRescheduleReasonTooLong
+	}
+
+	respondBy := now.Add(RescheduleResponseWindow)
+	if c.PlayBy.Before(respondBy) {
+		respondBy = *c.PlayBy
+	}
+	c.Reschedule = &Reschedule{
+		ProposedByTeamID: teamID,
+		ProposedBy:       proposedBy,
+		PlayBy:           playBy,
+		PreviousPlayBy:   *c.PlayBy,
+		Reason:           reason,
+		ProposedAt:       now,
+		RespondBy:        respondBy,
+		Status:           RescheduleStatusPending,
+	}
+	return nil
+}
+
+// RespondToReschedule records the other team's answer to a pending
+// proposal. Accepting moves the play deadline.
+func (c *Challenge) RespondToReschedule(teamID uuid.UUID, accept bool, now time.Time) error {
+	if c.Status != ChallengeStatusAccepted || !c.ReschedulePending(now) {
+		return ErrRescheduleNotFound
+	}
+	if teamID != c.Opponent(c.Reschedule.ProposedByTeamID) {
+		return ErrNotRescheduleResponder
+	}
+
+	c.Reschedule.RespondedAt = &now
+	if !accept {
+		c.Reschedule.Status = RescheduleStatusDeclined
+		return nil
+	}
+	c.Reschedule.Status = RescheduleStatusAccepted
+	playBy := c.Reschedule.PlayBy
+	c.PlayBy = &playBy
+	return nil
+}
+
+// OverrideSchedule lets an admin set the play deadline, replacing any
+// pending proposal.
+func (c *Challenge) OverrideSchedule(playBy time.Time, decidedBy uuid.UUID, now time.Time) error {
+	if c.Status != ChallengeStatusAccepted {
+		return ErrChallengeNotResolving
+	}
+	if !playBy.After(now) {
+		return ErrInvalidPlayBy
+	}
+
+	c.Reschedule = &Reschedule{
+		ProposedBy:     decidedBy,
+		PlayBy:         playBy,
+		PreviousPlayBy: *c.PlayBy,
+		ProposedAt:     now,
+		RespondBy:      now,
+		Status:         RescheduleStatusOverridden,
+		RespondedAt:    &now,
+		DecidedBy:      &decidedBy,
+	}
+	c.PlayBy = &playBy
+	return nil
+}
+
+// ReschedulePending reports whether a reschedule proposal is still awaiting
+// an answer.
+func (c *Challenge) ReschedulePending(now time.Time) bool {
+	return c.Reschedule != nil && c.Reschedule.Status == RescheduleStatusPending && now.Before(c.Reschedule.RespondBy)
+}
+
+// LapseReschedule marks a proposal nobody answered in time as lapsed. It
+// reports whether the proposal changed.
+func (c *Challenge) LapseReschedule(now time.Time) bool {
+	if c.Reschedule == nil || c.Reschedule.Status != RescheduleStatusPending || now.Before(c.Reschedule.RespondBy) {
+		return false
+	}
+	c.Reschedule.Status = RescheduleStatusLapsed
+	return true
+}
+
+func (c *Challenge) validPlayBy(playBy, now time.Time) error {
+	if !playBy.After(now) || playBy.Equal(*c.PlayBy) || playBy.After(c.PlayBy.Add(MaxRescheduleDelay)) {
+		return ErrInvalidPlayBy
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package ladder
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrSnapshotExists is returned when a ladder's standings were already
+// recorded for a week.
+var ErrSnapshotExists = errors.New("ladder snapshot already exists for this week")
+
+// Standing is a team's rung in a snapshot.
+type Standing struct {
+	Position int       `bson:"position" json:"position"`
+	TeamID   uuid.UUID `bson:"team_id" json:"team_id"`
+}
+
+// Snapshot records a ladder's standings for a week. There is at most one
+// weekly snapshot per ladder, season and week, plus a final one recording
+// the standings the season ended with.
+type Snapshot struct {
+	ID        uuid.UUID  `bson:"_id" json:"id"`
+	LadderID  uuid.UUID  `bson:"ladder_id" json:"ladder_id"`
+	Season    int        `bson:"season" json:"season"`
+	Week      time.Time  `bson:"week" json:"week"` // Monday 00:00 UTC of the week
+	Final     bool       `bson:"final" json:"final"`
+	Standings []Standing `bson:"standings" json:"standings"`
+	TakenAt   time.Time  `bson:"taken_at" json:"taken_at"`
+}
+
+// WeekStart returns 00:00 UTC of the Monday of t's week.
+func WeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	offset := (int(day.Weekday()) + 6) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// SnapshotDue reports whether the ladder's standings have not been recorded
+// yet in now's week.
+func (l *Ladder) SnapshotDue(now time.Time) bool {
+	return l.LastSnapshotAt == nil || WeekStart(*l.LastSnapshotAt).Before(WeekStart(now))
+}
+
+// TakeSnapshot records the ladder's current standings for now's week. A
+// weekly snapshot also marks the week as recorded.
+func (l *Ladder) TakeSnapshot(final bool, now time.Time) *Snapshot {
+	if !final {
+		l.LastSnapshotAt = &now
+	}
+
+	standings := make([]Standing, len(l.Rungs))
+	for i, id := range l.Rungs {
+		standings[i] = Standing{Position: i + 1, TeamID: id}
+	}
+	return &Snapshot{
+		ID:        uuid.New(),
+		LadderID:  l.ID,
+		Season:    l.Season,
+		Week:      WeekStart(now),
+		Final:     final,
+		Standings: standings,
+		TakenAt:   now,
+	}
+}
+
+// SnapshotRepository persists weekly ladder standings.
+type SnapshotRepository interface {
+	// Create stores a snapshot. It returns ErrSnapshotExists if the ladder
+	// season already has a weekly, or a final, snapshot for the week.
+	Create(ctx context.Context, s *Snapshot) error
+	// ListByLadder returns a ladder's snapshots, newest first. A zero season
+	// lists every season.
+	ListByLadder(ctx context.Context, ladderID uuid.UUID, season int) ([]*Snapshot, error)
+}
@@ -0,0 +1,151 @@
+package match
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidBucketUnit is returned when a submission time bucket is not supported.
+var ErrInvalidBucketUnit = errors.New("bucket must be hour or day")
+
+// BucketUnit is the width of a submission time bucket.
+type BucketUnit string
+
+const (
+	BucketHour BucketUnit = "hour"
+	BucketDay  BucketUnit = "day"
+)
+
+// IsValid reports whether the bucket unit is supported.
+func (u BucketUnit) IsValid() bool {
+	return u == BucketHour || u == BucketDay
+}
+
+// SubmissionBucket counts match submissions created within a time bucket.
+type SubmissionBucket struct {
+	Start     time.Time `json:"start"`
+	Submitted int       `json:"submitted"`
+	Verified  int       `json:"verified"`
+	Rejected  int       `json:"rejected"`
+}
+
+// Turnaround summarizes how long admins took to review submissions.
+type Turnaround struct {
+	Reviewed   int     `json:"reviewed"`
+	AvgMinutes float64 `json:"avg_minutes"`
+	MinMinutes float64 `json:"min_minutes"`
+	MaxMinutes float64 `json:"max_minutes"`
+}
+
+// PlacementCount counts verified team results at one placement on one map.
+type PlacementCount struct {
+	Map       string
+	Placement int
+	Count     int
+}
+
+// TournamentAggregates holds the raw aggregates for a tournament's matches.
+type TournamentAggregates struct {
+	Submissions []SubmissionBucket
+	Turnaround  Turnaround
+	// KillCounts maps per-player kills in a verified match to how often it occurred.
+	KillCounts  map[int]int
+	Placements  []PlacementCount
+	ActiveTeams int
+}
+
+// PlayerWeek records that a player played at least one verified match in a week.
+type PlayerWeek struct {
+	PlayerID uuid.UUID
+	Week     time.Time
+}
+
+// KillBucket counts per-player match results within a kill range. Max is
+// -1 for the open-ended top bucket.
+type KillBucket struct {
+	Label string `json:"label"`
+	Min   int    `json:"min"`
+	Max   int    `json:"max"`
+	Count int    `json:"count"`
+}
+
+// HeatmapCell counts verified team results in a placement band on a map.
+type HeatmapCell struct {
+	Map   string `json:"map"`
+	Band  string `json:"band"`
+	Count int    `json:"count"`
+}
+
+// placementBands are the placement ranges used by the heatmap, best first.
+var placementBands = []struct {
+	label    string
+	min, max int
+}{
+	{"1", 1, 1},
+	{"2-5", 2, 5},
+	{"6-10", 6, 10},
+	{"11-25", 11, 25},
+	{"26+", 26, 100},
+}
+
+// KillDistribution buckets per-player kill counts.
+func KillDistribution(counts map[int]int) []KillBucket {
+	buckets := []KillBucket{
+		{Label: "0", Min: 0, Max: 0},
+		{Label: "1-2", Min: 1, Max: 2},
+		{Label: "3-5", Min: 3, Max: 5},
+		{Label: "6-9", Min: 6, Max: 9},
+		{Label: "10-14", Min: 10, Max: 14},
+		{Label: "15+", Min: 15, Max: -1},
+	}
+
+	for kills, count := range counts {
+		for i := range buckets {
+			if kills >= buckets[i].Min && (buckets[i].Max < 0 || kills <= buckets[i].Max) {
+				buckets[i].Count += count
+				break
+			}
+		}
+	}
+
+	return buckets
+}
+
+// PlacementHeatmap groups placement counts into bands per map. Every band is
+// listed for every map so clients can render a full grid.
+func PlacementHeatmap(placements []PlacementCount) []HeatmapCell {
+	counts := make(map[string][]int)
+	for _, p := range placements {
+		name := p.Map
+		if name == "" {
+			name = UnknownMap
+		}
+		if counts[name] == nil {
+			counts[name] = make([]int, len(placementBands))
+		}
+		for i, band := range placementBands {
+			if p.Placement >= band.min && p.Placement <= band.max {
+				counts[name][i] += p.Count
+				break
+			}
+		}
+	}
+
+	maps := make([]string, 0, len(counts))
+	for name := range counts {
+		maps = append(maps, name)
+	}
+	sort.Strings(maps)
+
+	cells := make([]HeatmapCell, 0, len(maps)*len(placementBands))
+	for _, name := range maps {
+		for i, band := range placementBands {
+			cells = append(cells, HeatmapCell{Map: name, Band: band.label, Count: counts[name][i]})
+		}
+	}
+
+	return cells
+}
@@ -0,0 +1,39 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKillDistribution(t *testing.T) {
+	t.Parallel()
+
+	buckets := KillDistribution(map[int]int{0: 4, 2: 3, 5: 2, 14: 1, 15: 1, 32: 2})
+
+	counts := make(map[string]int, len(buckets))
+	for _, b := range buckets {
+		counts[b.Label] = b.Count
+	}
+
+	require.Len(t, buckets, 6)
+	require.Equal(t, map[string]int{"0": 4, "1-2": 3, "3-5": 2, "6-9": 0, "10-14": 1, "15+": 3}, counts)
+}
+
+func TestPlacementHeatmap(t *testing.T) {
+	t.Parallel()
+
+	cells := PlacementHeatmap([]PlacementCount{
+		{Map: "verdansk", Placement: 1, Count: 2},
+		{Map: "verdansk", Placement: 4, Count: 1},
+		{Map: "verdansk", Placement: 5, Count: 3},
+		{Map: "", Placement: 30, Count: 1},
+	})
+
+	require.Len(t, cells, 2*len(placementBands))
+
+	require.Equal(t, HeatmapCell{Map: UnknownMap, Band: "26+", Count: 1}, cells[4])
+	require.Equal(t, HeatmapCell{Map: "verdansk", Band: "1", Count: 2}, cells[5])
+	require.Equal(t, HeatmapCell{Map: "verdansk", Band: "2-5", Count: 4}, cells[6])
+	require.Equal(t, HeatmapCell{Map: "verdansk", Band: "6-10", Count: 0}, cells[7])
+}
@@ -0,0 +1,121 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// AttachmentType is the kind of evidence attached to a match report.
+type AttachmentType string
+
+const (
+	AttachmentScreenshot AttachmentType = "screenshot" // Image of the scoreboard or lobby
+	AttachmentVOD        AttachmentType = "vod"        // Link to a recording of the match
+)
+
+const (
+	// MaxAttachments is the most evidence files a single match report may carry.
+	MaxAttachments = 10
+
+	// MaxAttachmentCaptionLength is the longest caption accepted, in characters.
+	MaxAttachmentCaptionLength = 200
+
+	// MaxScreenshotBytes is the largest screenshot accepted.
+	MaxScreenshotBytes = 10 << 20
+)
+
+var (
+	ErrInvalidAttachment  = errors.New("invalid attachment")
+	ErrTooManyAttachments = errors.New("too many attachments")
+)
+
+// Attachment is a piece of evidence for a match report. Files are referenced
+// by URL; the metadata is supplied by the submitter and shown to reviewers.
+type Attachment struct {
+	Type        AttachmentType `bson:"type" json:"type"`
+	URL         string         `bson:"url" json:"url"`
+	Caption     string         `bson:"caption,omitempty" json:"caption,omitempty"`
+	ContentType string         `bson:"content_type,omitempty" json:"content_type,omitempty"` // MIME type, screenshots only
+	SizeBytes   int64          `bson:"size_bytes,omitempty" json:"size_bytes,omitempty"`
+	Timestamps  []VODTimestamp `bson:"timestamps,omitempty" json:"timestamps,omitempty"` // Notable moments, VODs only
+}
+
+// Validate checks the attachment's type, URL and metadata.
+func (a Attachment) Validate() error {
+	switch a.Type {
+	case AttachmentScreenshot, AttachmentVOD:
+	default:
+		return fmt.Errorf("%w: unknown type %q", ErrInvalidAttachment, a.Type)
+	}
+
+	u, err := url.Parse(a.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w: url must be an absolute http(s) URL", ErrInvalidAttachment)
+	}
+
+	if len([]rune(a.Caption)) > MaxAttachmentCaptionLength {
+		return fmt.Errorf("%w: caption cannot exceed %d characters", ErrInvalidAttachment, MaxAttachmentCaptionLength)
+	}
+	if a.SizeBytes < 0 {
+		return fmt.Errorf("%w: size cannot be negative", ErrInvalidAttachment)
+	}
+
+	if a.Type == AttachmentScreenshot {
+		if a.ContentType != "" && !strings.HasPrefix(a.ContentType, "image/") {
+			return fmt.Errorf("%w: screenshots must be images", ErrInvalidAttachment)
+		}
+		if a.SizeBytes > MaxScreenshotBytes {
+			return fmt.Errorf("%w: screenshots cannot exceed %d bytes", ErrInvalidAttachment, MaxScreenshotBytes)
+		}
+		if len(a.Timestamps) > 0 {
+			return fmt.Errorf("%w: timestamps only apply to VODs", ErrInvalidAttachment)
+		}
+		return nil
+	}
+
+	if a.ContentType != "" || a.SizeBytes != 0 {
+		return fmt.Errorf("%w: content type and size only apply to screenshots", ErrInvalidAttachment)
+	}
+	return a.validateVOD(u)
+}
+
+// SetAttachments validates and replaces the match's evidence attachments.
+func (m *Match) SetAttachments(attachments []Attachment) error {
+	if len(attachments) > MaxAttachments {
+		return ErrTooManyAttachments
+	}
+
+	seen := make(map[string]bool, len(attachments))
+	for _, a := range attachments {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+		if seen[a.URL] {
+			return fmt.Errorf("%w: duplicate url %s", ErrInvalidAttachment, a.URL)
+		}
+		seen[a.URL] = true
+	}
+
+	m.Attachments = attachments
+	return nil
+}
+
+// Evidence returns every attachment on the match, including the legacy
+// single screenshot URL when it is not already among the attachments.
+func (m *Match) Evidence() []Attachment {
+	evidence := make([]Attachment, 0, len(m.Attachments)+1)
+	legacy := m.ScreenshotURL != ""
+	for _, a := range m.Attachments {
+		if a.URL == m.ScreenshotURL {
+			legacy = false
+		}
+		evidence = append(evidence, a)
+	}
+
+	if legacy {
+		evidence = append([]Attachment{{Type: AttachmentScreenshot, URL: m.ScreenshotURL}}, evidence...)
+	}
+	return evidence
+}
@@ -0,0 +1,66 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttachmentValidate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		attachment Attachment
+		valid      bool
+	}{
+		{name: "screenshot", attachment: Attachment{Type: AttachmentScreenshot, URL: "https://cdn.example.com/a.png", ContentType: "image/png", SizeBytes: 2048}, valid: true},
+		{name: "vod", attachment: Attachment{Type: AttachmentVOD, URL: "https://twitch.tv/videos/1", Caption: "lobby 2"}, valid: true},
+		{name: "unknown type", attachment: Attachment{Type: "audio", URL: "https://example.com/a.mp3"}},
+		{name: "relative url", attachment: Attachment{Type: AttachmentScreenshot, URL: "/a.png"}},
+		{name: "non http scheme", attachment: Attachment{Type: AttachmentScreenshot, URL: "ftp://example.com/a.png"}},
+		{name: "screenshot not an image", attachment: Attachment{Type: AttachmentScreenshot, URL: "https://example.com/a.pdf", ContentType: "application/pdf"}},
+		{name: "screenshot too large", attachment: Attachment{Type: AttachmentScreenshot, URL: "https://example.com/a.png", SizeBytes: MaxScreenshotBytes + 1}},
+		{name: "vod with size", attachment: Attachment{Type: AttachmentVOD, URL: "https://example.com/v", SizeBytes: 10}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.attachment.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrInvalidAttachment)
+			}
+		})
+	}
+}
+
+func TestSetAttachments(t *testing.T) {
+	t.Parallel()
+
+	m := &Match{}
+	shot := Attachment{Type: AttachmentScreenshot, URL: "https://example.com/a.png"}
+
+	require.ErrorIs(t, m.SetAttachments(make([]Attachment, MaxAttachments+1)), ErrTooManyAttachments)
+	require.ErrorIs(t, m.SetAttachments([]Attachment{shot, shot}), ErrInvalidAttachment)
+	require.NoError(t, m.SetAttachments([]Attachment{shot}))
+	require.Len(t, m.Attachments, 1)
+}
+
+func TestEvidence(t *testing.T) {
+	t.Parallel()
+
+	vod := Attachment{Type: AttachmentVOD, URL: "https://example.com/v"}
+	shot := Attachment{Type: AttachmentScreenshot, URL: "https://example.com/a.png"}
+
+	m := &Match{ScreenshotURL: shot.URL, Attachments: []Attachment{vod}}
+	require.Equal(t, []Attachment{shot, vod}, m.Evidence())
+
+	m.Attachments = []Attachment{vod, shot}
+	require.Equal(t, []Attachment{vod, shot}, m.Evidence())
+
+	require.Empty(t, (&Match{}).Evidence())
+}
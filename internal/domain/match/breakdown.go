@@ -0,0 +1,103 @@
+package match
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// UnknownMap labels matches submitted without a map.
+	UnknownMap = "unknown"
+
+	// MapBreakdownWindow is how many recent verified matches a player's map breakdown covers.
+	MapBreakdownWindow = 100
+)
+
+// MapStats aggregates verified match results on a single map.
+type MapStats struct {
+	Map          string  `json:"map"`
+	Matches      int     `json:"matches"`
+	Wins         int     `json:"wins"`
+	Kills        int     `json:"kills"`
+	Damage       int     `json:"damage"`
+	AvgKills     float64 `json:"avg_kills"`
+	AvgPlacement float64 `json:"avg_placement"`
+	WinRate      float64 `json:"win_rate"`
+}
+
+// PlayerMapBreakdown aggregates a player's verified matches by map.
+// Kills and damage are the player's own; placement is their team's.
+func PlayerMapBreakdown(matches []Match, playerID uuid.UUID) []MapStats {
+	return breakdownByMap(matches, func(m *Match) (kills, damage int, ok bool) {
+		for _, ps := range m.PlayerStats {
+			if ps.PlayerID == playerID {
+				return ps.Kills, ps.Damage, true
+			}
+		}
+		return 0, 0, false
+	})
+}
+
+// TeamMapBreakdown aggregates verified matches by map using team totals.
+func TeamMapBreakdown(matches []Match) []MapStats {
+	return breakdownByMap(matches, func(m *Match) (kills, damage int, ok bool) {
+		for _, ps := range m.PlayerStats {
+			damage += ps.Damage
+		}
+		return m.TeamKills, damage, true
+	})
+}
+
+// breakdownByMap groups verified matches by map, most played first.
+func breakdownByMap(matches []Match, result func(m *Match) (kills, damage int, ok bool)) []MapStats {
+	byMap := make(map[string]*MapStats)
+	placements := make(map[string]int)
+
+	for i := range matches {
+		m := &matches[i]
+		if m.Status != StatusVerified {
+			continue
+		}
+		kills, damage, ok := result(m)
+		if !ok {
+			continue
+		}
+
+		name := m.Map
+		if name == "" {
+			name = UnknownMap
+		}
+		s, found := byMap[name]
+		if !found {
+			s = &MapStats{Map: name}
+			byMap[name] = s
+		}
+
+		s.Matches++
+		s.Kills += kills
+		s.Damage += damage
+		placements[name] += m.TeamPlacement
+		if m.TeamPlacement == 1 {
+			s.Wins++
+		}
+	}
+
+	breakdown := make([]MapStats, 0, len(byMap))
+	for name, s := range byMap {
+		n := float64(s.Matches)
+		s.AvgKills = float64(s.Kills) / n
+		s.AvgPlacement = float64(placements[name]) / n
+		s.WinRate = float64(s.Wins) / n
+		breakdown = append(breakdown, *s)
+	}
+
+	sort.Slice(breakdown, func(i, j int) bool {
+		if breakdown[i].Matches != breakdown[j].Matches {
+			return breakdown[i].Matches > breakdown[j].Matches
+		}
+		return breakdown[i].Map < breakdown[j].Map
+	})
+
+	return breakdown
+}
@@ -0,0 +1,65 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMapBreakdown(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := uuid.New(), uuid.New()
+	matches := []Match{
+		{Map: "verdansk", Status: StatusVerified, TeamPlacement: 1, TeamKills: 10, PlayerStats: []PlayerMatchStats{
+			{PlayerID: alice, Kills: 6, Damage: 1500},
+			{PlayerID: bob, Kills: 4, Damage: 900},
+		}},
+		{Map: "verdansk", Status: StatusVerified, TeamPlacement: 5, TeamKills: 3, PlayerStats: []PlayerMatchStats{
+			{PlayerID: alice, Kills: 2, Damage: 500},
+		}},
+		{Map: "rebirth", Status: StatusVerified, TeamPlacement: 3, TeamKills: 4, PlayerStats: []PlayerMatchStats{
+			{PlayerID: bob, Kills: 4, Damage: 1000},
+		}},
+		{Status: StatusVerified, TeamPlacement: 10, TeamKills: 1, PlayerStats: []PlayerMatchStats{
+			{PlayerID: alice, Kills: 1, Damage: 200},
+		}},
+		{Map: "rebirth", Status: StatusDraft, TeamPlacement: 1, TeamKills: 20, PlayerStats: []PlayerMatchStats{
+			{PlayerID: alice, Kills: 20, Damage: 5000},
+		}},
+	}
+
+	t.Run("player", func(t *testing.T) {
+		t.Parallel()
+
+		breakdown := PlayerMapBreakdown(matches, alice)
+		require.Len(t, breakdown, 2)
+
+		require.Equal(t, "verdansk", breakdown[0].Map)
+		require.Equal(t, 2, breakdown[0].Matches)
+		require.Equal(t, 1, breakdown[0].Wins)
+		require.Equal(t, 8, breakdown[0].Kills)
+		require.Equal(t, 2000, breakdown[0].Damage)
+		require.InDelta(t, 4.0, breakdown[0].AvgKills, 0.001)
+		require.InDelta(t, 3.0, breakdown[0].AvgPlacement, 0.001)
+		require.InDelta(t, 0.5, breakdown[0].WinRate, 0.001)
+
+		require.Equal(t, UnknownMap, breakdown[1].Map)
+		require.Equal(t, 1, breakdown[1].Matches)
+	})
+
+	t.Run("team", func(t *testing.T) {
+		t.Parallel()
+
+		breakdown := TeamMapBreakdown(matches)
+		require.Len(t, breakdown, 3)
+
+		require.Equal(t, "verdansk", breakdown[0].Map)
+		require.Equal(t, 13, breakdown[0].Kills)
+		require.Equal(t, 2900, breakdown[0].Damage)
+
+		require.Equal(t, "rebirth", breakdown[1].Map)
+		require.Equal(t, 1, breakdown[1].Matches, "draft matches are excluded")
+	})
+}
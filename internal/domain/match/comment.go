@@ -0,0 +1,61 @@
+package match
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxCommentLength is the longest comment body accepted, in characters.
+const MaxCommentLength = 1000
+
+var (
+	ErrEmptyComment     = errors.New("comment cannot be empty")
+	ErrCommentTooLong   = errors.New("comment is too long")
+	ErrCommentForbidden = errors.New("only team members and admins can comment on a match")
+)
+
+// Comment is a message in a match's discussion thread, used to clarify a
+// report while it is being verified.
+type Comment struct {
+	ID        uuid.UUID `json:"id"`
+	MatchID   uuid.UUID `json:"match_id"`
+	AuthorID  uuid.UUID `json:"author_id"`
+	Body      string    `json:"body"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// NewComment creates a comment on a match. Surrounding whitespace is trimmed
+// from the body.
+func NewComment(matchID, authorID uuid.UUID, body string) (*Comment, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, ErrEmptyComment
+	}
+	if len([]rune(body)) > MaxCommentLength {
+		return nil, ErrCommentTooLong
+	}
+
+	return &Comment{
+		ID:        uuid.New(),
+		MatchID:   matchID,
+		AuthorID:  authorID,
+		Body:      body,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// CommentRepository defines the interface for match comment persistence
+type CommentRepository interface {
+	// Create stores a new comment
+	Create(ctx context.Context, comment *Comment) error
+
+	// ListByMatch retrieves a match's comments, oldest first
+	ListByMatch(ctx context.Context, matchID string) ([]Comment, error)
+
+	// ListByMatches retrieves the comments on several matches, oldest first
+	ListByMatches(ctx context.Context, matchIDs []string) ([]Comment, error)
+}
@@ -0,0 +1,39 @@
+package match
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewComment(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		body     string
+		expected string
+		err      error
+	}{
+		{name: "trims whitespace", body: "  screenshot shows lobby 2\n", expected: "screenshot shows lobby 2"},
+		{name: "empty", body: "   ", err: ErrEmptyComment},
+		{name: "at limit", body: strings.Repeat("é", MaxCommentLength), expected: strings.Repeat("é", MaxCommentLength)},
+		{name: "too long", body: strings.Repeat("a", MaxCommentLength+1), err: ErrCommentTooLong},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			c, err := NewComment(uuid.New(), uuid.New(), tc.body)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, c.Body)
+		})
+	}
+}
@@ -0,0 +1,167 @@
+package match
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxConnectorNameLength is the longest connector name accepted, in characters.
+	MaxConnectorNameLength = 100
+
+	// ConnectorClockSkew is how far a submission's timestamp may be from the
+	// server clock. Older or future-dated submissions are rejected.
+	ConnectorClockSkew = 5 * time.Minute
+
+	// ConnectorNonceTTL is how long used nonces are remembered. It covers the
+	// whole window in which a submission's timestamp is accepted.
+	ConnectorNonceTTL = 2 * ConnectorClockSkew
+
+	// MinNonceLength and MaxNonceLength bound submission nonces.
+	MinNonceLength = 16
+	MaxNonceLength = 128
+)
+
+var (
+	ErrConnectorNotFound           = errors.New("connector not found")
+	ErrInvalidConnectorName        = errors.New("connector name is required and must be at most 100 characters")
+	ErrConnectorRevoked            = errors.New("connector has been revoked")
+	ErrInvalidConnectorSignature   = errors.New("invalid connector signature")
+	ErrStaleConnectorSubmission    = errors.New("connector submission timestamp is outside the accepted window")
+	ErrInvalidNonce                = errors.New("nonce must be 16 to 128 characters")
+	ErrReplayedConnectorSubmission = errors.New("connector submission has already been received")
+)
+
+// Connector is a machine client, such as a game stats integration, that
+// submits match reports on behalf of teams. Each submission is signed with
+// the connector's secret and carries a single-use nonce.
+type Connector struct {
+	ID         uuid.UUID  `bson:"_id" json:"id"`
+	Name       string     `bson:"name" json:"name"`
+	Secret     string     `bson:"secret" json:"-"`
+	CreatedBy  uuid.UUID  `bson:"created_by" json:"created_by"`
+	CreatedAt  time.Time  `bson:"created_at" json:"created_at"`
+	RotatedAt  *time.Time `bson:"rotated_at,omitempty" json:"rotated_at,omitempty"`
+	RevokedAt  *time.Time `bson:"revoked_at,omitempty" json:"revoked_at,omitempty"`
+	LastUsedAt *time.Time `bson:"last_used_at,omitempty" json:"last_used_at,omitempty"`
+}
+
+// NewConnector creates a connector with a freshly generated secret.
+func NewConnector(name string, createdBy uuid.UUID) (*Connector, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || len([]rune(name)) > MaxConnectorNameLength {
+		return nil, ErrInvalidConnectorName
+	}
+
+	secret, err := newConnectorSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Connector{
+		ID:        uuid.New(),
+		Name:      name,
+		Secret:    secret,
+		CreatedBy: createdBy,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// Revoked reports whether the connector may no longer submit.
+func (c *Connector) Revoked() bool {
+	return c.RevokedAt != nil
+}
+
+// Rotate replaces the connector's secret. Submissions signed with the old
+// secret are rejected from then on.
+func (c *Connector) Rotate(now time.Time) error {
+	if c.Revoked() {
+		return ErrConnectorRevoked
+	}
+	secret, err := newConnectorSecret()
+	if err != nil {
+		return err
+	}
+	c.Secret = secret
+	c.RotatedAt = &now
+	return nil
+}
+
+// Revoke permanently disables the connector.
+func (c *Connector) Revoke(now time.Time) {
+	if c.RevokedAt == nil {
+		c.RevokedAt = &now
+	}
+}
+
+// VerifySubmission checks that a submission was signed with the connector's
+// secret at a time close to now. It does not check the nonce for reuse.
+func (c *Connector) VerifySubmission(timestamp time.Time, nonce string, body []byte, signature string, now time.Time) error {
+	if c.Revoked() {
+		return ErrConnectorRevoked
+	}
+	if len(nonce) < MinNonceLength || len(nonce) > MaxNonceLength {
+		return ErrInvalidNonce
+	}
+	if skew := now.Sub(timestamp); skew > ConnectorClockSkew || skew < -ConnectorClockSkew {
+		return ErrStaleConnectorSubmission
+	}
+
+	expected := SignConnectorSubmission(c.Secret, timestamp, nonce, body)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidConnectorSignature
+	}
+	return nil
+}
+
+// SignConnectorSubmission returns the hex HMAC-SHA256 of
+// "<unix timestamp>.<nonce>.<body>" under secret.
+func SignConnectorSubmission(secret string, timestamp time.Time, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10) + "." + nonce + "."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newConnectorSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// ConnectorRepository defines persistence for match connectors.
+type ConnectorRepository interface {
+	// Create stores a new connector.
+	Create(ctx context.Context, connector *Connector) error
+
+	// GetByID retrieves a connector by ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*Connector, error)
+
+	// List returns every connector, newest first.
+	List(ctx context.Context) ([]*Connector, error)
+
+	// Update saves a connector's secret, revocation and last use.
+	Update(ctx context.Context, connector *Connector) error
+
+	// TouchLastUsed records when a connector last submitted, leaving the
+	// rest of the connector as it is stored.
+	TouchLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error
+}
+
+// NonceRepository remembers the nonces of accepted connector submissions.
+type NonceRepository interface {
+	// Claim records a connector's nonce until expiresAt. It returns
+	// ErrReplayedConnectorSubmission if the nonce was already claimed.
+	Claim(ctx context.Context, connectorID uuid.UUID, nonce string, expiresAt time.Time) error
+}
@@ -0,0 +1,87 @@
+package match
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConnector(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewConnector("  Warzone stats  ", uuid.New())
+	require.NoError(t, err)
+	require.Equal(t, "Warzone stats", c.Name)
+	require.Len(t, c.Secret, 64)
+
+	_, err = NewConnector(" ", uuid.New())
+	require.ErrorIs(t, err, ErrInvalidConnectorName)
+
+	_, err = NewConnector(strings.Repeat("a", MaxConnectorNameLength+1), uuid.New())
+	require.ErrorIs(t, err, ErrInvalidConnectorName)
+}
+
+func TestConnector_VerifySubmission(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewConnector("stats", uuid.New())
+	require.NoError(t, err)
+
+	now := time.Unix(1_700_000_000, 0)
+	nonce := "0123456789abcdef"
+	body := []byte(`{"team_placement":1}`)
+	signature := SignConnectorSubmission(c.Secret, now, nonce, body)
+
+	tests := []struct {
+		name      string
+		timestamp time.Time
+		nonce     string
+		body      []byte
+		signature string
+		err       error
+	}{
+		{name: "valid", timestamp: now, nonce: nonce, body: body, signature: signature},
+		{name: "tampered body", timestamp: now, nonce: nonce, body: []byte(`{"team_placement":2}`), signature: signature, err: ErrInvalidConnectorSignature},
+		{name: "different nonce", timestamp: now, nonce: "fedcba9876543210", body: body, signature: signature, err: ErrInvalidConnectorSignature},
+		{name: "short nonce", timestamp: now, nonce: "abc", body: body, signature: signature, err: ErrInvalidNonce},
+		{name: "stale", timestamp: now.Add(-ConnectorClockSkew - time.Second), nonce: nonce, body: body, signature: SignConnectorSubmission(c.Secret, now.Add(-ConnectorClockSkew-time.Second), nonce, body), err: ErrStaleConnectorSubmission},
+		{name: "future dated", timestamp: now.Add(ConnectorClockSkew + time.Second), nonce: nonce, body: body, signature: SignConnectorSubmission(c.Secret, now.Add(ConnectorClockSkew+time.Second), nonce, body), err: ErrStaleConnectorSubmission},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.VerifySubmission(tc.timestamp, tc.nonce, tc.body, tc.signature, now)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestConnector_RotateAndRevoke(t *testing.T) {
+	t.Parallel()
+
+	c, err := NewConnector("stats", uuid.New())
+	require.NoError(t, err)
+
+	now := time.Now()
+	nonce := "0123456789abcdef"
+	body := []byte(`{}`)
+	oldSignature := SignConnectorSubmission(c.Secret, now, nonce, body)
+
+	require.NoError(t, c.Rotate(now))
+	require.NotNil(t, c.RotatedAt)
+	require.ErrorIs(t, c.VerifySubmission(now, nonce, body, oldSignature, now), ErrInvalidConnectorSignature)
+
+	c.Revoke(now)
+	require.True(t, c.Revoked())
+	require.ErrorIs(t, c.Rotate(now), ErrConnectorRevoked)
+	require.ErrorIs(t, c.VerifySubmission(now, nonce, body, SignConnectorSubmission(c.Secret, now, nonce, body), now), ErrConnectorRevoked)
+}
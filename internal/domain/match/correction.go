@@ -0,0 +1,249 @@
+package match
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxCorrectionMatches is the most matches a single stat correction may
+// change. Larger corrections must be split with a narrower filter.
+const MaxCorrectionMatches = 500
+
+var (
+	ErrCorrectionNotFound       = errors.New("stat correction not found")
+	ErrInvalidCorrectionFilter  = errors.New("stat correction needs a game and a verified_from before verified_to")
+	ErrInvalidCorrectionField   = errors.New("stat correction field must be one of kills, damage, assists, deaths or downs")
+	ErrInvalidCorrectionOp      = errors.New("stat correction op must be move, swap or scale")
+	ErrInvalidCorrectionFactor  = errors.New("stat correction scale factor must be positive")
+	ErrInvalidCorrectionReason  = errors.New("stat correction reason is required and must be at most 500 characters")
+	ErrTooManyCorrectionMatches = errors.New("stat correction matches more than 500 matches")
+)
+
+// StatField is a core player stat a correction can change.
+type StatField string
+
+const (
+	FieldKills   StatField = "kills"
+	FieldDamage  StatField = "damage"
+	FieldAssists StatField = "assists"
+	FieldDeaths  StatField = "deaths"
+	FieldDowns   StatField = "downs"
+)
+
+// IsValid reports whether the field is a core player stat.
+func (f StatField) IsValid() bool {
+	switch f {
+	case FieldKills, FieldDamage, FieldAssists, FieldDeaths, FieldDowns:
+		return true
+	}
+	return false
+}
+
+// Value returns the field's value in a player line.
+func (f StatField) Value(ps PlayerMatchStats) int {
+	switch f {
+	case FieldKills:
+		return ps.Kills
+	case FieldDamage:
+		return ps.Damage
+	case FieldAssists:
+		return ps.Assists
+	case FieldDeaths:
+		return ps.Deaths
+	case FieldDowns:
+		return ps.Downs
+	}
+	return 0
+}
+
+// set stores the field's value in a player line.
+func (f StatField) set(ps *PlayerMatchStats, value int) {
+	switch f {
+	case FieldKills:
+		ps.Kills = value
+	case FieldDamage:
+		ps.Damage = value
+	case FieldAssists:
+		ps.Assists = value
+	case FieldDeaths:
+		ps.Deaths = value
+	case FieldDowns:
+		ps.Downs = value
+	}
+}
+
+// allStatFields lists the core stats in the order changes are reported.
+var allStatFields = []StatField{FieldKills, FieldDamage, FieldAssists, FieldDeaths, FieldDowns}
+
+// CorrectionOp is how a correction transforms a stat.
+type CorrectionOp string
+
+const (
+	// OpMove moves a value keyed into the wrong field: To takes Field's
+	// value and Field is set to zero.
+	OpMove CorrectionOp = "move"
+
+	// OpSwap exchanges the values of Field and To.
+	OpSwap CorrectionOp = "swap"
+
+	// OpScale multiplies Field by Factor, rounding to the nearest whole number.
+	OpScale CorrectionOp = "scale"
+)
+
+// Transform is the change a correction makes to each player line.
+type Transform struct {
+	Op     CorrectionOp `bson:"op" json:"op"`
+	Field  StatField    `bson:"field" json:"field"`
+	To     StatField    `bson:"to,omitempty" json:"to,omitempty"`         // Move and swap only
+	Factor float64      `bson:"factor,omitempty" json:"factor,omitempty"` // Scale only
+}
+
+// Validate checks the transform's op and the fields it names.
+func (t Transform) Validate() error {
+	if !t.Field.IsValid() {
+		return ErrInvalidCorrectionField
+	}
+	switch t.Op {
+	case OpMove, OpSwap:
+		if !t.To.IsValid() || t.To == t.Field {
+			return ErrInvalidCorrectionField
+		}
+	case OpScale:
+		if t.Factor <= 0 || math.IsInf(t.Factor, 0) || math.IsNaN(t.Factor) {
+			return ErrInvalidCorrectionFactor
+		}
+	default:
+		return ErrInvalidCorrectionOp
+	}
+	return nil
+}
+
+// Apply returns a player line with the transform applied.
+func (t Transform) Apply(ps PlayerMatchStats) PlayerMatchStats {
+	switch t.Op {
+	case OpMove:
+		t.To.set(&ps, t.Field.Value(ps))
+		t.Field.set(&ps, 0)
+	case OpSwap:
+		from, to := t.Field.Value(ps), t.To.Value(ps)
+		t.Field.set(&ps, to)
+		t.To.set(&ps, from)
+	case OpScale:
+		t.Field.set(&ps, int(math.Round(float64(t.Field.Value(ps))*t.Factor)))
+	}
+	return ps
+}
+
+// CorrectionFilter selects the verified matches a correction applies to.
+// When PlayerID is set only that player's lines are changed.
+type CorrectionFilter struct {
+	GameID       uuid.UUID  `bson:"game_id" json:"game_id"`
+	TournamentID *uuid.UUID `bson:"tournament_id,omitempty" json:"tournament_id,omitempty"`
+	PlayerID     *uuid.UUID `bson:"player_id,omitempty" json:"player_id,omitempty"`
+	VerifiedFrom *time.Time `bson:"verified_from,omitempty" json:"verified_from,omitempty"`
+	VerifiedTo   *time.Time `bson:"verified_to,omitempty" json:"verified_to,omitempty"`
+}
+
+// Validate checks the filter names a game and a sensible verification window.
+func (f CorrectionFilter) Validate() error {
+	if f.GameID == uuid.Nil {
+		return ErrInvalidCorrectionFilter
+	}
+	if f.VerifiedFrom != nil && f.VerifiedTo != nil && !f.VerifiedFrom.Before(*f.VerifiedTo) {
+		return ErrInvalidCorrectionFilter
+	}
+	return nil
+}
+
+// StatChange is one stat of one player changed by a correction.
+type StatChange struct {
+	PlayerID uuid.UUID `bson:"player_id" json:"player_id"`
+	Field    StatField `bson:"field" json:"field"`
+	From     int       `bson:"from" json:"from"`
+	To       int       `bson:"to" json:"to"`
+}
+
+// CorrectedMatch is a match changed by a correction and the amendment that
+// records the change on the match.
+type CorrectedMatch struct {
+	MatchID     uuid.UUID    `bson:"match_id" json:"match_id"`
+	AmendmentID *uuid.UUID   `bson:"amendment_id,omitempty" json:"amendment_id,omitempty"` // Empty in a dry run
+	Changes     []StatChange `bson:"changes" json:"changes"`
+}
+
+// StatCorrection is a bulk correction of mis-keyed stats across verified
+// matches. Each changed match is amended, so its players' stats are adjusted
+// by the difference, and the correction keeps every change it made.
+type StatCorrection struct {
+	ID        uuid.UUID        `bson:"_id" json:"id"`
+	Filter    CorrectionFilter `bson:"filter" json:"filter"`
+	Transform Transform        `bson:"transform" json:"transform"`
+	Reason    string           `bson:"reason" json:"reason"`
+	DryRun    bool             `bson:"-" json:"dry_run,omitempty"`
+	Scanned   int              `bson:"scanned" json:"scanned"` // Matches the filter selected, changed or not
+	Matches   []CorrectedMatch `bson:"matches" json:"matches"`
+	Error     string           `bson:"error,omitempty" json:"error,omitempty"` // Why the correction stopped part way; Matches lists what was applied
+	CreatedBy uuid.UUID        `bson:"created_by" json:"created_by"`
+	CreatedAt time.Time        `bson:"created_at" json:"created_at"`
+}
+
+// NewStatCorrection validates and creates a correction with no matches yet.
+func NewStatCorrection(filter CorrectionFilter, transform Transform, reason string, adminID uuid.UUID, now time.Time) (*StatCorrection, error) {
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+	if err := transform.Validate(); err != nil {
+		return nil, err
+	}
+	reason = strings.TrimSpace(reason)
+	if reason == "" || len([]rune(reason)) > MaxAmendmentReasonLength {
+		return nil, ErrInvalidCorrectionReason
+	}
+
+	return &StatCorrection{
+		ID:        uuid.New(),
+		Filter:    filter,
+		Transform: transform,
+		Reason:    reason,
+		Matches:   []CorrectedMatch{},
+		CreatedBy: adminID,
+		CreatedAt: now,
+	}, nil
+}
+
+// Correct returns a match's player lines with the correction applied and the
+// changes it makes. No changes means the match is left as it is.
+func (c *StatCorrection) Correct(m *Match) ([]PlayerMatchStats, []StatChange) {
+	corrected := copyPlayerStats(m.PlayerStats)
+	var changes []StatChange
+	for i, ps := range corrected {
+		if c.Filter.PlayerID != nil && ps.PlayerID != *c.Filter.PlayerID {
+			continue
+		}
+		after := c.Transform.Apply(ps)
+		for _, field := range allStatFields {
+			if from, to := field.Value(ps), field.Value(after); from != to {
+				changes = append(changes, StatChange{PlayerID: ps.PlayerID, Field: field, From: from, To: to})
+			}
+		}
+		corrected[i] = after
+	}
+	return corrected, changes
+}
+
+// CorrectionRepository defines persistence for stat corrections.
+type CorrectionRepository interface {
+	// Create stores a correction.
+	Create(ctx context.Context, correction *StatCorrection) error
+
+	// GetByID retrieves a correction by ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*StatCorrection, error)
+
+	// ListByGame returns a game's corrections, newest first.
+	ListByGame(ctx context.Context, gameID uuid.UUID) ([]*StatCorrection, error)
+}
@@ -0,0 +1,133 @@
+package match
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransform_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		transform Transform
+		wantErr   error
+	}{
+		{"move", Transform{Op: OpMove, Field: FieldKills, To: FieldDamage}, nil},
+		{"swap", Transform{Op: OpSwap, Field: FieldKills, To: FieldAssists}, nil},
+		{"scale", Transform{Op: OpScale, Field: FieldDamage, Factor: 10}, nil},
+		{"unknown field", Transform{Op: OpMove, Field: "score", To: FieldDamage}, ErrInvalidCorrectionField},
+		{"move onto itself", Transform{Op: OpMove, Field: FieldKills, To: FieldKills}, ErrInvalidCorrectionField},
+		{"swap without target", Transform{Op: OpSwap, Field: FieldKills}, ErrInvalidCorrectionField},
+		{"zero factor", Transform{Op: OpScale, Field: FieldDamage}, ErrInvalidCorrectionFactor},
+		{"negative factor", Transform{Op: OpScale, Field: FieldDamage, Factor: -2}, ErrInvalidCorrectionFactor},
+		{"unknown op", Transform{Op: "set", Field: FieldDamage}, ErrInvalidCorrectionOp},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			require.ErrorIs(t, tt.transform.Validate(), tt.wantErr)
+		})
+	}
+}
+
+func TestTransform_Apply(t *testing.T) {
+	t.Parallel()
+
+	line := PlayerMatchStats{PlayerID: uuid.New(), Kills: 1850, Damage: 0, Assists: 3, Deaths: 2}
+
+	moved := Transform{Op: OpMove, Field: FieldKills, To: FieldDamage}.Apply(line)
+	require.Equal(t, 0, moved.Kills)
+	require.Equal(t, 1850, moved.Damage)
+
+	swapped := Transform{Op: OpSwap, Field: FieldKills, To: FieldAssists}.Apply(line)
+	require.Equal(t, 3, swapped.Kills)
+	require.Equal(t, 1850, swapped.Assists)
+
+	scaled := Transform{Op: OpScale, Field: FieldDeaths, Factor: 0.75}.Apply(line)
+	require.Equal(t, 2, scaled.Deaths, "1.5 rounds to 2")
+
+	require.Equal(t, 1850, line.Kills, "the original line is unchanged")
+}
+
+func TestNewStatCorrection(t *testing.T) {
+	t.Parallel()
+
+	move := Transform{Op: OpMove, Field: FieldKills, To: FieldDamage}
+	game := uuid.New()
+	from := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(7 * 24 * time.Hour)
+
+	tests := []struct {
+		name    string
+		filter  CorrectionFilter
+		reason  string
+		wantErr error
+	}{
+		{"valid", CorrectionFilter{GameID: game, VerifiedFrom: &from, VerifiedTo: &to}, "damage keyed as kills", nil},
+		{"no game", CorrectionFilter{}, "damage keyed as kills", ErrInvalidCorrectionFilter},
+		{"window backwards", CorrectionFilter{GameID: game, VerifiedFrom: &to, VerifiedTo: &from}, "damage keyed as kills", ErrInvalidCorrectionFilter},
+		{"blank reason", CorrectionFilter{GameID: game}, "  ", ErrInvalidCorrectionReason},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			c, err := NewStatCorrection(tt.filter, move, tt.reason, uuid.New(), time.Now())
+			if tt.wantErr != nil {
+				require.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Empty(t, c.Matches)
+		})
+	}
+}
+
+func TestStatCorrection_Correct(t *testing.T) {
+	t.Parallel()
+
+	alice, bob := uuid.New(), uuid.New()
+	m, err := NewMatch(uuid.New(), uuid.New(), uuid.New(), 3, 9, []PlayerMatchStats{
+		{PlayerID: alice, Kills: 1200},
+		{PlayerID: bob, Kills: 900, Damage: 0},
+	}, "", uuid.New())
+	require.NoError(t, err)
+
+	t.Run("every player", func(t *testing.T) {
+		t.Parallel()
+		c, err := NewStatCorrection(CorrectionFilter{GameID: m.GameID}, Transform{Op: OpMove, Field: FieldKills, To: FieldDamage}, "damage keyed as kills", uuid.New(), time.Now())
+		require.NoError(t, err)
+
+		corrected, changes := c.Correct(m)
+		require.Equal(t, 1200, corrected[0].Damage)
+		require.Equal(t, 900, corrected[1].Damage)
+		require.Len(t, changes, 4)
+		require.Equal(t, StatChange{PlayerID: alice, Field: FieldKills, From: 1200, To: 0}, changes[0])
+		require.Equal(t, 1200, m.PlayerStats[0].Kills, "the match is unchanged")
+	})
+
+	t.Run("one player", func(t *testing.T) {
+		t.Parallel()
+		c, err := NewStatCorrection(CorrectionFilter{GameID: m.GameID, PlayerID: &bob}, Transform{Op: OpMove, Field: FieldKills, To: FieldDamage}, "damage keyed as kills", uuid.New(), time.Now())
+		require.NoError(t, err)
+
+		corrected, changes := c.Correct(m)
+		require.Equal(t, 1200, corrected[0].Kills)
+		require.Equal(t, 900, corrected[1].Damage)
+		require.Len(t, changes, 2)
+	})
+
+	t.Run("nothing to change", func(t *testing.T) {
+		t.Parallel()
+		c, err := NewStatCorrection(CorrectionFilter{GameID: m.GameID}, Transform{Op: OpScale, Field: FieldDowns, Factor: 3}, "downs tripled", uuid.New(), time.Now())
+		require.NoError(t, err)
+
+		_, changes := c.Correct(m)
+		require.Empty(t, changes)
+	})
+}
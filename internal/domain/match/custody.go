@@ -0,0 +1,102 @@
+package match
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EvidenceDigest is the SHA-256 of a screenshot's bytes as fetched when the
+// match was submitted.
+type EvidenceDigest struct {
+	URL    string `bson:"url" json:"url"`
+	SHA256 string `bson:"sha256" json:"sha256"`
+}
+
+// Custody is the chain-of-custody record of a match report, sealed at
+// submission. StatsSHA256 covers the canonical stats payload and SHA256 covers
+// that digest together with every screenshot digest, so a later change to
+// either the reported stats or the evidence files can be proven.
+type Custody struct {
+	StatsSHA256 string           `bson:"stats_sha256" json:"stats_sha256"`
+	Screenshots []EvidenceDigest `bson:"screenshots,omitempty" json:"screenshots,omitempty"`
+	SHA256      string           `bson:"sha256" json:"sha256"`
+	SealedAt    time.Time        `bson:"sealed_at" json:"sealed_at"`
+}
+
+// custodyPayload is the canonical form of the reported stats. Field order is
+// fixed by the struct, player lines are sorted by player ID and encoding/json
+// sorts custom stat keys, so equal reports always hash the same.
+type custodyPayload struct {
+	TournamentID  uuid.UUID          `json:"tournament_id"`
+	TeamID        uuid.UUID          `json:"team_id"`
+	GameID        uuid.UUID          `json:"game_id"`
+	Mode          string             `json:"mode"`
+	Map           string             `json:"map"`
+	Playlist      string             `json:"playlist"`
+	TeamPlacement int                `json:"team_placement"`
+	TeamKills     int                `json:"team_kills"`
+	PlayerStats   []PlayerMatchStats `json:"player_stats"`
+	SubmittedBy   uuid.UUID          `json:"submitted_by"`
+}
+
+// StatsSHA256 returns the hex SHA-256 of the match's canonical stats payload.
+func (m *Match) StatsSHA256() (string, error) {
+	stats := make([]PlayerMatchStats, len(m.PlayerStats))
+	copy(stats, m.PlayerStats)
+	sort.Slice(stats, func(i, j int) bool {
+		return stats[i].PlayerID.String() < stats[j].PlayerID.String()
+	})
+
+	payload, err := json.Marshal(custodyPayload{
+		TournamentID:  m.TournamentID,
+		TeamID:        m.TeamID,
+		GameID:        m.GameID,
+		Mode:          m.Mode,
+		Map:           m.Map,
+		Playlist:      m.Playlist,
+		TeamPlacement: m.TeamPlacement,
+		TeamKills:     m.TeamKills,
+		PlayerStats:   stats,
+		SubmittedBy:   m.SubmittedBy,
+	})
+	if err != nil {
+		return "", fmt.Errorf("encoding custody payload: %w", err)
+	}
+
+	sum := sha256.Sum256(payload)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// SealCustody records the chain-of-custody hashes of the match's stats and
+// the given screenshot digests, in the order the screenshots appear.
+func (m *Match) SealCustody(screenshots []EvidenceDigest, now time.Time) error {
+	statsHash, err := m.StatsSHA256()
+	if err != nil {
+		return err
+	}
+
+	m.Custody = &Custody{
+		StatsSHA256: statsHash,
+		Screenshots: screenshots,
+		SHA256:      custodySHA256(statsHash, screenshots),
+		SealedAt:    now,
+	}
+	return nil
+}
+
+func custodySHA256(statsHash string, screenshots []EvidenceDigest) string {
+	var b strings.Builder
+	b.WriteString("stats " + statsHash + "\n")
+	for _, s := range screenshots {
+		b.WriteString("screenshot " + s.SHA256 + " " + s.URL + "\n")
+	}
+	sum := sha256.Sum256([]byte(b.String()))
+	return hex.EncodeToString(sum[:])
+}
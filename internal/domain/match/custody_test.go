@@ -0,0 +1,54 @@
+package match
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch_StatsSHA256(t *testing.T) {
+	t.Parallel()
+
+	a := PlayerMatchStats{PlayerID: uuid.New(), Kills: 5, Damage: 1200}
+	b := PlayerMatchStats{PlayerID: uuid.New(), Kills: 2, Damage: 800}
+	m := &Match{TeamID: uuid.New(), TeamPlacement: 3, PlayerStats: []PlayerMatchStats{a, b}}
+
+	hash, err := m.StatsSHA256()
+	require.NoError(t, err)
+	require.Len(t, hash, 64)
+
+	reordered := *m
+	reordered.PlayerStats = []PlayerMatchStats{b, a}
+	same, err := reordered.StatsSHA256()
+	require.NoError(t, err)
+	require.Equal(t, hash, same)
+
+	changed := *m
+	changed.PlayerStats = []PlayerMatchStats{a, {PlayerID: b.PlayerID, Kills: 3, Damage: 800}}
+	other, err := changed.StatsSHA256()
+	require.NoError(t, err)
+	require.NotEqual(t, hash, other)
+}
+
+func TestMatch_SealCustody(t *testing.T) {
+	t.Parallel()
+
+	m := &Match{TeamID: uuid.New(), TeamPlacement: 1}
+	now := time.Now().UTC()
+
+	require.NoError(t, m.SealCustody(nil, now))
+	require.NotNil(t, m.Custody)
+	require.Equal(t, now, m.Custody.SealedAt)
+	statsOnly := m.Custody.SHA256
+
+	screenshot := EvidenceDigest{URL: "https://cdn.example.com/a.png", SHA256: "aa"}
+	require.NoError(t, m.SealCustody([]EvidenceDigest{screenshot}, now))
+	require.NotEqual(t, statsOnly, m.Custody.SHA256)
+
+	screenshot.SHA256 = "bb"
+	sealed := m.Custody.SHA256
+	require.NoError(t, m.SealCustody([]EvidenceDigest{screenshot}, now))
+	require.NotEqual(t, sealed, m.Custody.SHA256)
+}
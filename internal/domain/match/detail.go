@@ -0,0 +1,11 @@
+package match
+
+import "github.com/google/uuid"
+
+// Detail is a match together with the display names of the game and players
+// it references, resolved in the same query as the match itself.
+type Detail struct {
+	Match
+	GameName    string
+	PlayerNames map[uuid.UUID]string // Players without a profile are absent
+}
@@ -0,0 +1,59 @@
+package match
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// DefaultHeatmapWeeks is how many weeks an activity heatmap covers when none are requested.
+	DefaultHeatmapWeeks = 52
+
+	// MaxHeatmapWeeks is the longest activity heatmap, about a year.
+	MaxHeatmapWeeks = 53
+)
+
+// ErrInvalidHeatmapWeeks is returned when an activity heatmap length is out of range.
+var ErrInvalidHeatmapWeeks = errors.New("weeks must be between 1 and 53")
+
+// DayCount is the number of verified matches a player played on a UTC day.
+type DayCount struct {
+	Day     time.Time `json:"day"`
+	Matches int       `json:"matches"`
+}
+
+// HeatmapStart returns the first day of an activity heatmap covering the given
+// number of weeks up to now. Heatmap weeks start on Monday, UTC, so the grid
+// lines up with a contribution graph's columns.
+func HeatmapStart(now time.Time, weeks int) (time.Time, error) {
+	if weeks < 1 || weeks > MaxHeatmapWeeks {
+		return time.Time{}, ErrInvalidHeatmapWeeks
+	}
+
+	today := truncateDay(now)
+	monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+	return monday.AddDate(0, 0, -7*(weeks-1)), nil
+}
+
+// Heatmap returns one entry per day from start through now, filling in the
+// days without matches. Counts outside that range are ignored.
+func Heatmap(counts []DayCount, start, now time.Time) []DayCount {
+	start = truncateDay(start)
+	end := truncateDay(now)
+
+	byDay := make(map[time.Time]int, len(counts))
+	for _, c := range counts {
+		byDay[truncateDay(c.Day)] += c.Matches
+	}
+
+	var days []DayCount
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		days = append(days, DayCount{Day: day, Matches: byDay[day]})
+	}
+	return days
+}
+
+func truncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
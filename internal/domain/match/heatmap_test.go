@@ -0,0 +1,48 @@
+package match
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHeatmapStart(t *testing.T) {
+	t.Parallel()
+
+	// Thursday afternoon.
+	now := time.Date(2026, 10, 15, 17, 30, 0, 0, time.UTC)
+
+	start, err := HeatmapStart(now, 1)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC), start)
+
+	start, err = HeatmapStart(now, 3)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 9, 28, 0, 0, 0, 0, time.UTC), start)
+	assert.Equal(t, time.Monday, start.Weekday())
+
+	_, err = HeatmapStart(now, 0)
+	assert.ErrorIs(t, err, ErrInvalidHeatmapWeeks)
+	_, err = HeatmapStart(now, MaxHeatmapWeeks+1)
+	assert.ErrorIs(t, err, ErrInvalidHeatmapWeeks)
+}
+
+func TestHeatmap(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 15, 17, 30, 0, 0, time.UTC)
+	start, err := HeatmapStart(now, 1)
+	require.NoError(t, err)
+
+	days := Heatmap([]DayCount{
+		{Day: time.Date(2026, 10, 13, 0, 0, 0, 0, time.UTC), Matches: 3},
+		{Day: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC), Matches: 1},
+		{Day: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), Matches: 9},
+	}, start, now)
+
+	require.Len(t, days, 4)
+	assert.Equal(t, start, days[0].Day)
+	assert.Equal(t, []int{0, 3, 0, 1}, []int{days[0].Matches, days[1].Matches, days[2].Matches, days[3].Matches})
+}
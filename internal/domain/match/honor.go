@@ -0,0 +1,145 @@
+package match
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// HonorVoteWindow is how long after verification teammates can vote on a match.
+const HonorVoteWindow = 7 * 24 * time.Hour
+
+var (
+	ErrHonorVotingClosed = errors.New("honor voting is only open for a week after a match is verified")
+	ErrAlreadyVoted      = errors.New("you have already voted on this match")
+	ErrHonorForbidden    = errors.New("only players who played the match can vote")
+	ErrInvalidHonorVote  = errors.New("vote for a teammate who played the match, other than yourself, as MVP or for a commend")
+)
+
+// HonorVote is a teammate's MVP pick and commends for a verified match.
+type HonorVote struct {
+	ID        uuid.UUID   `bson:"_id" json:"id"`
+	MatchID   uuid.UUID   `bson:"match_id" json:"match_id"`
+	TeamID    uuid.UUID   `bson:"team_id" json:"team_id"`
+	VoterID   uuid.UUID   `bson:"voter_id" json:"voter_id"`
+	MVPID     *uuid.UUID  `bson:"mvp_id,omitempty" json:"mvp_id,omitempty"`
+	Commends  []uuid.UUID `bson:"commends" json:"commends"`
+	CreatedAt time.Time   `bson:"created_at" json:"created_at"`
+}
+
+// NewHonorVote records voterID's MVP pick and commends for m. The voter and
+// everyone they vote for must have played the match, nobody can vote for
+// themselves, and each teammate is commended at most once.
+func NewHonorVote(m *Match, voterID uuid.UUID, mvpID *uuid.UUID, commends []uuid.UUID, now time.Time) (*HonorVote, error) {
+	if m.Status != StatusVerified || m.VerifiedAt == nil || now.Sub(*m.VerifiedAt) > HonorVoteWindow {
+		return nil, ErrHonorVotingClosed
+	}
+	if !m.HasPlayer(voterID) {
+		return nil, ErrHonorForbidden
+	}
+	if mvpID == nil && len(commends) == 0 {
+		return nil, ErrInvalidHonorVote
+	}
+
+	valid := func(id uuid.UUID) bool { return id != voterID && m.HasPlayer(id) }
+	if mvpID != nil && !valid(*mvpID) {
+		return nil, ErrInvalidHonorVote
+	}
+	seen := make(map[uuid.UUID]bool, len(commends))
+	for _, id := range commends {
+		if !valid(id) || seen[id] {
+			return nil, ErrInvalidHonorVote
+		}
+		seen[id] = true
+	}
+
+	return &HonorVote{
+		ID:        uuid.New(),
+		MatchID:   m.ID,
+		TeamID:    m.TeamID,
+		VoterID:   voterID,
+		MVPID:     mvpID,
+		Commends:  append([]uuid.UUID{}, commends...),
+		CreatedAt: now.UTC(),
+	}, nil
+}
+
+// HasPlayer reports whether playerID has stats in the match.
+func (m *Match) HasPlayer(playerID uuid.UUID) bool {
+	for _, ps := range m.PlayerStats {
+		if ps.PlayerID == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// HonorTally is the MVP votes and commends one player received in a match.
+type HonorTally struct {
+	PlayerID uuid.UUID `json:"player_id"`
+	MVPVotes int       `json:"mvp_votes"`
+	Commends int       `json:"commends"`
+}
+
+// HonorSummary is the result of a match's honor voting. MVP is the player
+// with the most MVP votes; it is nil while nobody has any or the lead is tied.
+type HonorSummary struct {
+	MatchID uuid.UUID    `json:"match_id"`
+	Votes   int          `json:"votes"`
+	MVP     *uuid.UUID   `json:"mvp,omitempty"`
+	Tallies []HonorTally `json:"tallies"` // Most MVP votes first, then most commends
+}
+
+// SummarizeHonors tallies a match's votes.
+func SummarizeHonors(matchID uuid.UUID, votes []*HonorVote) *HonorSummary {
+	byPlayer := make(map[uuid.UUID]*HonorTally)
+	tally := func(id uuid.UUID) *HonorTally {
+		if byPlayer[id] == nil {
+			byPlayer[id] = &HonorTally{PlayerID: id}
+		}
+		return byPlayer[id]
+	}
+	for _, v := range votes {
+		if v.MVPID != nil {
+			tally(*v.MVPID).MVPVotes++
+		}
+		for _, id := range v.Commends {
+			tally(id).Commends++
+		}
+	}
+
+	summary := &HonorSummary{MatchID: matchID, Votes: len(votes), Tallies: make([]HonorTally, 0, len(byPlayer))}
+	for _, t := range byPlayer {
+		summary.Tallies = append(summary.Tallies, *t)
+	}
+	sort.Slice(summary.Tallies, func(i, j int) bool {
+		a, b := summary.Tallies[i], summary.Tallies[j]
+		if a.MVPVotes != b.MVPVotes {
+			return a.MVPVotes > b.MVPVotes
+		}
+		if a.Commends != b.Commends {
+			return a.Commends > b.Commends
+		}
+		return a.PlayerID.String() < b.PlayerID.String()
+	})
+
+	if n := len(summary.Tallies); n > 0 && summary.Tallies[0].MVPVotes > 0 &&
+		(n == 1 || summary.Tallies[1].MVPVotes < summary.Tallies[0].MVPVotes) {
+		mvp := summary.Tallies[0].PlayerID
+		summary.MVP = &mvp
+	}
+	return summary
+}
+
+// HonorVoteRepository stores honor votes.
+type HonorVoteRepository interface {
+	// Create stores a new vote. It returns ErrAlreadyVoted if the voter has
+	// already voted on the match.
+	Create(ctx context.Context, vote *HonorVote) error
+
+	// ListByMatch retrieves a match's votes, oldest first.
+	ListByMatch(ctx context.Context, matchID uuid.UUID) ([]*HonorVote, error)
+}
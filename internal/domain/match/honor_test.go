@@ -0,0 +1,95 @@
+package match
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHonorVote(t *testing.T) {
+	t.Parallel()
+
+	alice, bob, carol, outsider := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	verifiedAt := time.Date(2026, 5, 1, 20, 0, 0, 0, time.UTC)
+	verified := &Match{
+		ID:          uuid.New(),
+		TeamID:      uuid.New(),
+		Status:      StatusVerified,
+		VerifiedAt:  &verifiedAt,
+		PlayerStats: []PlayerMatchStats{{PlayerID: alice}, {PlayerID: bob}, {PlayerID: carol}},
+	}
+	draft := &Match{ID: uuid.New(), Status: StatusDraft, PlayerStats: verified.PlayerStats}
+	now := verifiedAt.Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		match    *Match
+		voter    uuid.UUID
+		mvp      *uuid.UUID
+		commends []uuid.UUID
+		now      time.Time
+		err      error
+	}{
+		{name: "mvp and commends", match: verified, voter: alice, mvp: &bob, commends: []uuid.UUID{bob, carol}, now: now},
+		{name: "commends only", match: verified, voter: alice, commends: []uuid.UUID{carol}, now: now},
+		{name: "last moment", match: verified, voter: alice, mvp: &bob, now: verifiedAt.Add(HonorVoteWindow)},
+		{name: "window closed", match: verified, voter: alice, mvp: &bob, now: verifiedAt.Add(HonorVoteWindow + time.Second), err: ErrHonorVotingClosed},
+		{name: "not verified", match: draft, voter: alice, mvp: &bob, now: now, err: ErrHonorVotingClosed},
+		{name: "voter did not play", match: verified, voter: outsider, mvp: &bob, now: now, err: ErrHonorForbidden},
+		{name: "empty", match: verified, voter: alice, now: now, err: ErrInvalidHonorVote},
+		{name: "self mvp", match: verified, voter: alice, mvp: &alice, now: now, err: ErrInvalidHonorVote},
+		{name: "self commend", match: verified, voter: alice, commends: []uuid.UUID{alice}, now: now, err: ErrInvalidHonorVote},
+		{name: "mvp did not play", match: verified, voter: alice, mvp: &outsider, now: now, err: ErrInvalidHonorVote},
+		{name: "duplicate commend", match: verified, voter: alice, commends: []uuid.UUID{bob, bob}, now: now, err: ErrInvalidHonorVote},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			v, err := NewHonorVote(tc.match, tc.voter, tc.mvp, tc.commends, tc.now)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.match.ID, v.MatchID)
+			require.Equal(t, tc.match.TeamID, v.TeamID)
+			require.Equal(t, tc.voter, v.VoterID)
+			require.Equal(t, tc.mvp, v.MVPID)
+		})
+	}
+}
+
+func TestSummarizeHonors(t *testing.T) {
+	t.Parallel()
+
+	matchID := uuid.New()
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+
+	summary := SummarizeHonors(matchID, []*HonorVote{
+		{VoterID: alice, MVPID: &bob, Commends: []uuid.UUID{carol}},
+		{VoterID: bob, MVPID: &carol, Commends: []uuid.UUID{alice, carol}},
+		{VoterID: carol, MVPID: &bob},
+	})
+	require.Equal(t, 3, summary.Votes)
+	require.Equal(t, &bob, summary.MVP)
+	require.Equal(t, []HonorTally{
+		{PlayerID: bob, MVPVotes: 2},
+		{PlayerID: carol, MVPVotes: 1, Commends: 2},
+		{PlayerID: alice, Commends: 1},
+	}, summary.Tallies)
+
+	tied := SummarizeHonors(matchID, []*HonorVote{
+		{VoterID: alice, MVPID: &bob},
+		{VoterID: bob, MVPID: &alice},
+	})
+	require.Nil(t, tied.MVP)
+	require.Len(t, tied.Tallies, 2)
+
+	empty := SummarizeHonors(matchID, nil)
+	require.Nil(t, empty.MVP)
+	require.Empty(t, empty.Tallies)
+}
@@ -14,6 +14,7 @@ const (
 StatusDraft    Status = "draft"    // Initial submission, pending verification
 StatusVerified Status = "verified" // Admin approved the match report
 StatusRejected Status = "rejected" // Admin rejected the match report
+StatusVoided   Status = "voided"   // Admin withdrew a verified match for good
 )
 
 // PlayerMatchStats contains individual player performance in a match
@@ -33,17 +34,26 @@ type Match struct {
 	TournamentID    uuid.UUID           `bson:"tournament_id" json:"tournament_id"`
 	TeamID          uuid.UUID           `bson:"team_id" json:"team_id"`
 	GameID          uuid.UUID           `bson:"game_id" json:"game_id"`
+	Mode            string              `bson:"mode,omitempty" json:"mode,omitempty"`
+	Map             string              `bson:"map,omitempty" json:"map,omitempty"`
+	Playlist        string              `bson:"playlist,omitempty" json:"playlist,omitempty"`
 	Status          Status              `bson:"status" json:"status"`
 	TeamPlacement   int                 `bson:"team_placement" json:"team_placement"`
 	TeamKills       int                 `bson:"team_kills" json:"team_kills"`
 	PlayerStats     []PlayerMatchStats  `bson:"player_stats" json:"player_stats"`
 	ScreenshotURL   string              `bson:"screenshot_url" json:"screenshot_url"`
+	Attachments     []Attachment        `bson:"attachments,omitempty" json:"attachments,omitempty"`
 	RejectionReason string              `bson:"rejection_reason,omitempty" json:"rejection_reason,omitempty"`
-	SubmittedBy     uuid.UUID           `bson:"submitted_by" json:"submitted_by"` // Team captain who submitted
+	SubmittedBy     uuid.UUID           `bson:"submitted_by" json:"submitted_by"` // Captain or designated submitter
 	CreatedAt       time.Time           `bson:"created_at" json:"created_at"`
 	UpdatedAt       time.Time           `bson:"updated_at" json:"updated_at"`
 	VerifiedAt      *time.Time          `bson:"verified_at,omitempty" json:"verified_at,omitempty"`
 	VerifiedBy      *uuid.UUID          `bson:"verified_by,omitempty" json:"verified_by,omitempty"`
+	Custody         *Custody            `bson:"custody,omitempty" json:"custody,omitempty"` // Evidence hashes sealed at submission
+	VerifiedSnapshot *Snapshot          `bson:"verified_snapshot,omitempty" json:"verified_snapshot,omitempty"` // Result as verified; never changes
+	Amendments      []Amendment         `bson:"amendments,omitempty" json:"amendments,omitempty"`               // Corrections made after verification
+	Reversals       []Reversal          `bson:"reversals,omitempty" json:"reversals,omitempty"`                 // Verifications withdrawn by admins
+	Revisions       []Revision          `bson:"revisions,omitempty" json:"revisions,omitempty"`                 // Rejected versions replaced by resubmissions
 }
 
 // Error definitions
@@ -59,7 +69,9 @@ ErrInvalidStatus        = errors.New("invalid match status")
 ErrAlreadyVerified      = errors.New("match has already been verified")
 ErrMatchNotDraft        = errors.New("only draft matches can be verified")
 ErrTournamentNotActive  = errors.New("tournament is not active")
-ErrNotCaptain           = errors.New("player is not the team captain")
+ErrNotCaptain           = errors.New("player is not the team captain or a designated submitter")
+ErrPlayerNotInMatch     = errors.New("player did not play in the match")
+ErrNotTeamMember        = errors.New("only team members can view the team's matches")
 )
 
 // NewMatch creates a new match with validation
@@ -73,22 +85,9 @@ playerStats []PlayerMatchStats,
 screenshotURL string,
 submittedBy uuid.UUID,
 ) (*Match, error) {
-	if teamPlacement < 1 || teamPlacement > 100 {
-		return nil, ErrInvalidPlacement
+	if err := validateResult(teamPlacement, teamKills, playerStats); err != nil {
+		return nil, err
 	}
-	if teamKills < 0 {
-		return nil, ErrInvalidKills
-	}
-	if len(playerStats) == 0 {
-		return nil, ErrMissingPlayerStats
-	}
-
-	// Validate each player's stats
-for _, ps := range playerStats {
-if ps.Kills < 0 || ps.Damage < 0 || ps.Assists < 0 || ps.Deaths < 0 || ps.Downs < 0 {
-return nil, ErrInvalidPlayerStats
-}
-}
 
 now := time.Now()
 return &Match{
@@ -118,6 +117,7 @@ m.VerifiedAt = &now
 m.VerifiedBy = &adminID
 m.UpdatedAt = now
 m.RejectionReason = ""
+m.takeSnapshot(adminID, now)
 return nil
 }
 
@@ -160,3 +160,13 @@ return float64(m.TeamKills) / float64(totalDeaths)
 func (m *Match) IsVerified() bool {
 return m.Status == StatusVerified
 }
+
+// ParseStatus parses a status filter. An empty value matches every status.
+func ParseStatus(value string) (Status, error) {
+	switch s := Status(value); s {
+	case "", StatusDraft, StatusVerified, StatusRejected, StatusVoided:
+		return s, nil
+	default:
+		return "", ErrInvalidStatus
+	}
+}
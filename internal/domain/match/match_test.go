@@ -0,0 +1,20 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseStatus(t *testing.T) {
+	t.Parallel()
+
+	for _, value := range []string{"", "draft", "verified", "rejected"} {
+		status, err := ParseStatus(value)
+		require.NoError(t, err)
+		require.Equal(t, Status(value), status)
+	}
+
+	_, err := ParseStatus("pending")
+	require.ErrorIs(t, err, ErrInvalidStatus)
+}
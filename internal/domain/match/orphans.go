@@ -0,0 +1,11 @@
+package match
+
+import "context"
+
+// OrphanRepository finds and removes matches whose team no longer exists.
+type OrphanRepository interface {
+	// FindWithMissingTeam returns up to limit matches whose team ID matches no team.
+	FindWithMissingTeam(ctx context.Context, limit int64) ([]Match, error)
+	// DeleteByID removes a match.
+	DeleteByID(ctx context.Context, id string) error
+}
@@ -0,0 +1,33 @@
+package match
+
+import "github.com/google/uuid"
+
+// PlayerLine returns a player's stat line from the match.
+func (m *Match) PlayerLine(playerID uuid.UUID) (PlayerMatchStats, bool) {
+	for _, ps := range m.PlayerStats {
+		if ps.PlayerID == playerID {
+			return ps, true
+		}
+	}
+	return PlayerMatchStats{}, false
+}
+
+// KillShare returns the percentage of the team's kills scored by a player.
+// The reported team kills are used when set, otherwise the sum of the
+// players' kills; a match without kills gives every player a share of 0.
+func (m *Match) KillShare(playerID uuid.UUID) float64 {
+	line, ok := m.PlayerLine(playerID)
+	if !ok {
+		return 0
+	}
+
+	teamKills := m.TeamKills
+	if teamKills == 0 {
+		teamKills = m.GetTotalTeamKills()
+	}
+	if teamKills == 0 {
+		return 0
+	}
+
+	return float64(line.Kills) / float64(teamKills) * 100
+}
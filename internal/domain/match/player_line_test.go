@@ -0,0 +1,39 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKillShare(t *testing.T) {
+	t.Parallel()
+
+	alice, bob, carol := uuid.New(), uuid.New(), uuid.New()
+	stats := []PlayerMatchStats{
+		{PlayerID: alice, Kills: 6},
+		{PlayerID: bob, Kills: 2},
+	}
+
+	tests := []struct {
+		name      string
+		teamKills int
+		playerID  uuid.UUID
+		expected  float64
+	}{
+		{name: "reported team kills", teamKills: 10, playerID: alice, expected: 60},
+		{name: "falls back to player kills", teamKills: 0, playerID: bob, expected: 25},
+		{name: "player not in match", teamKills: 10, playerID: carol, expected: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := &Match{TeamKills: tc.teamKills, PlayerStats: stats}
+			require.InDelta(t, tc.expected, m.KillShare(tc.playerID), 0.0001)
+		})
+	}
+
+	empty := &Match{PlayerStats: []PlayerMatchStats{{PlayerID: alice}}}
+	require.Zero(t, empty.KillShare(alice))
+}
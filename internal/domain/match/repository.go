@@ -2,6 +2,7 @@ package match
 
 import (
 	"context"
+	"time"
 )
 
 // Repository defines the interface for match persistence
@@ -12,14 +13,31 @@ type Repository interface {
 	// GetByID retrieves a match by ID
 	GetByID(ctx context.Context, id string) (*Match, error)
 
-	// GetByTournament retrieves all matches in a tournament with pagination
-	GetByTournament(ctx context.Context, tournamentID string, limit int, offset int) ([]Match, error)
+	// GetDetail retrieves a match by ID with its game and player names joined in
+	GetDetail(ctx context.Context, id string) (*Detail, error)
 
-	// GetByTeam retrieves all matches for a specific team
-	GetByTeam(ctx context.Context, teamID string, limit int, offset int) ([]Match, error)
+	// GetByTournament retrieves a tournament's matches, newest first. An empty status matches all statuses.
+	GetByTournament(ctx context.Context, tournamentID string, status Status, limit int, offset int) ([]Match, error)
 
-	// GetByPlayer retrieves all matches involving a specific player
-	GetByPlayer(ctx context.Context, playerID string, limit int, offset int) ([]Match, error)
+	// GetByTeam retrieves a team's matches, newest first. An empty status matches all statuses.
+	GetByTeam(ctx context.Context, teamID string, status Status, limit int, offset int) ([]Match, error)
+
+	// CountByTeam returns the number of a team's matches. An empty status matches all statuses.
+	CountByTeam(ctx context.Context, teamID string, status Status) (int, error)
+
+	// GetByPlayer retrieves the matches a player played in, newest first. An empty status matches all statuses.
+	GetByPlayer(ctx context.Context, playerID string, status Status, limit int, offset int) ([]Match, error)
+
+	// CountByPlayer returns the number of matches a player played in. An empty status matches all statuses.
+	CountByPlayer(ctx context.Context, playerID string, status Status) (int, error)
+
+	// GetVerifiedByPlayerAndGame retrieves a player's most recently verified matches in a game.
+	// An empty mode matches all modes.
+	GetVerifiedByPlayerAndGame(ctx context.Context, playerID string, gameID string, mode string, limit int) ([]Match, error)
+
+	// GetVerifiedForCorrection retrieves the verified matches a stat correction
+	// filter selects, oldest verified first
+	GetVerifiedForCorrection(ctx context.Context, filter CorrectionFilter, limit int) ([]Match, error)
 
 	// GetUnverified retrieves all unverified (draft) matches for admin review
 	GetUnverified(ctx context.Context, limit int, offset int) ([]Match, error)
@@ -27,11 +45,42 @@ type Repository interface {
 	// GetTournamentUnverified retrieves unverified matches in a specific tournament
 	GetTournamentUnverified(ctx context.Context, tournamentID string, limit int, offset int) ([]Match, error)
 
-	// Update updates an existing match
+	// Update replaces a draft match. It returns ErrMatchNotDraft once the match has been reviewed.
 	Update(ctx context.Context, match *Match) error
 
-	// CountByTournament returns the total number of matches in a tournament
-	CountByTournament(ctx context.Context, tournamentID string) (int, error)
+	// Review stores the verification or rejection of a draft match, together with its
+	// verified snapshot. It returns ErrMatchNotDraft if the match was already reviewed.
+	Review(ctx context.Context, match *Match) error
+
+	// Amend stores a verified match's corrected result and appends the amendment.
+	// It returns ErrMatchNotVerified if the match is not verified.
+	Amend(ctx context.Context, match *Match, amendment *Amendment) error
+
+	// Unverify withdraws a verified match's verification, storing its new
+	// status (draft, or voided) and appending the reversal.
+	// It returns ErrMatchNotVerified if the match is not verified.
+	Unverify(ctx context.Context, match *Match, reversal *Reversal) error
+
+	// Resubmit stores a rejected match's corrected result as a draft and appends the
+	// revision it replaced. It returns ErrMatchNotRejected if the match is not rejected.
+	Resubmit(ctx context.Context, match *Match, revision *Revision) error
+
+	// CountByTournament returns the number of matches in a tournament. An empty status matches all statuses.
+	CountByTournament(ctx context.Context, tournamentID string, status Status) (int, error)
+
+	// AggregateTournament computes submission, review and result aggregates for a tournament
+	AggregateTournament(ctx context.Context, tournamentID string, unit BucketUnit) (*TournamentAggregates, error)
+
+	// CountVerifiedSince returns the number of matches verified at or after since
+	CountVerifiedSince(ctx context.Context, since time.Time) (int, error)
+
+	// GetPlayerActiveWeeks returns the distinct (player, week) pairs with a verified match since the given time.
+	// Weeks start on Monday, UTC.
+	GetPlayerActiveWeeks(ctx context.Context, since time.Time) ([]PlayerWeek, error)
+
+	// CountPlayerMatchesByDay returns how many verified matches a player played in a game
+	// on each UTC day since the given time. Days without matches are omitted.
+	CountPlayerMatchesByDay(ctx context.Context, playerID string, gameID string, since time.Time) ([]DayCount, error)
 
 	// CountUnverified returns total unverified matches
 	CountUnverified(ctx context.Context) (int, error)
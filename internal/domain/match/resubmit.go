@@ -0,0 +1,97 @@
+package match
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrMatchNotRejected is returned when resubmitting a match that was not rejected.
+var ErrMatchNotRejected = errors.New("only rejected matches can be resubmitted")
+
+// Revision is a rejected version of a match's result, kept when the
+// submitter corrects the match and resubmits it.
+type Revision struct {
+	TeamPlacement   int                `json:"team_placement"`
+	TeamKills       int                `json:"team_kills"`
+	PlayerStats     []PlayerMatchStats `json:"player_stats"`
+	ScreenshotURL   string             `json:"screenshot_url"`
+	Attachments     []Attachment       `json:"attachments,omitempty"`
+	SubmittedBy     uuid.UUID          `json:"submitted_by"`
+	Custody         *Custody           `json:"custody,omitempty"` // Evidence hashes sealed when this version was submitted
+	RejectionReason string             `json:"rejection_reason"`
+	RejectedBy      uuid.UUID          `json:"rejected_by"`
+	RejectedAt      time.Time          `json:"rejected_at"`
+	ReplacedAt      time.Time          `json:"replaced_at"`
+}
+
+// Resubmit replaces the result of a rejected match with a corrected one and
+// moves the match back to draft for review. The rejected version, with the
+// reason it was rejected, is appended to the match's revisions. Attachments
+// are validated and replaced as in SetAttachments; custody must be sealed
+// again for the new result.
+func (m *Match) Resubmit(submitterID uuid.UUID, teamPlacement int, teamKills int, playerStats []PlayerMatchStats, screenshotURL string, attachments []Attachment, now time.Time) (*Revision, error) {
+	if m.Status != StatusRejected {
+		return nil, ErrMatchNotRejected
+	}
+	if err := validateResult(teamPlacement, teamKills, playerStats); err != nil {
+		return nil, err
+	}
+
+	revision := Revision{
+		TeamPlacement:   m.TeamPlacement,
+		TeamKills:       m.TeamKills,
+		PlayerStats:     copyPlayerStats(m.PlayerStats),
+		ScreenshotURL:   m.ScreenshotURL,
+		Attachments:     m.Attachments,
+		SubmittedBy:     m.SubmittedBy,
+		Custody:         m.Custody,
+		RejectionReason: m.RejectionReason,
+		RejectedAt:      m.UpdatedAt,
+		ReplacedAt:      now,
+	}
+	if m.VerifiedBy != nil {
+		revision.RejectedBy = *m.VerifiedBy
+	}
+	if m.VerifiedAt != nil {
+		revision.RejectedAt = *m.VerifiedAt
+	}
+
+	if err := m.SetAttachments(attachments); err != nil {
+		return nil, err
+	}
+
+	m.Status = StatusDraft
+	m.TeamPlacement = teamPlacement
+	m.TeamKills = teamKills
+	m.PlayerStats = copyPlayerStats(playerStats)
+	m.ScreenshotURL = screenshotURL
+	m.SubmittedBy = submitterID
+	m.RejectionReason = ""
+	m.VerifiedAt = nil
+	m.VerifiedBy = nil
+	m.Custody = nil
+	m.UpdatedAt = now
+	m.Revisions = append(m.Revisions, revision)
+	return &m.Revisions[len(m.Revisions)-1], nil
+}
+
+// validateResult checks a reported placement, team kills and player lines.
+func validateResult(teamPlacement int, teamKills int, playerStats []PlayerMatchStats) error {
+	if teamPlacement < 1 || teamPlacement > 100 {
+		return ErrInvalidPlacement
+	}
+	if teamKills < 0 {
+		return ErrInvalidKills
+	}
+	if len(playerStats) == 0 {
+		return ErrMissingPlayerStats
+	}
+	for _, ps := range playerStats {
+		if ps.Kills < 0 || ps.Damage < 0 || ps.Assists < 0 || ps.Deaths < 0 || ps.Downs < 0 {
+			return ErrInvalidPlayerStats
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package match
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch_Resubmit(t *testing.T) {
+	t.Parallel()
+
+	player := uuid.New()
+	captain := uuid.New()
+	admin := uuid.New()
+	m, err := NewMatch(uuid.New(), uuid.New(), uuid.New(), 3, 4, []PlayerMatchStats{{PlayerID: player, Kills: 4}}, "https://example.com/a.png", captain)
+	require.NoError(t, err)
+	require.NoError(t, m.SealCustody(nil, time.Now()))
+
+	corrected := []PlayerMatchStats{{PlayerID: player, Kills: 6}}
+	_, err = m.Resubmit(captain, 2, 6, corrected, "https://example.com/b.png", nil, time.Now())
+	require.ErrorIs(t, err, ErrMatchNotRejected, "drafts are edited, not resubmitted")
+
+	require.NoError(t, m.RejectMatch(admin, "kills do not match the scoreboard"))
+	rejectedAt := *m.VerifiedAt
+
+	_, err = m.Resubmit(captain, 0, 6, corrected, "https://example.com/b.png", nil, time.Now())
+	require.ErrorIs(t, err, ErrInvalidPlacement)
+	_, err = m.Resubmit(captain, 2, 6, nil, "https://example.com/b.png", nil, time.Now())
+	require.ErrorIs(t, err, ErrMissingPlayerStats)
+	require.Equal(t, StatusRejected, m.Status, "a failed resubmission leaves the match as it was")
+	require.Empty(t, m.Revisions)
+
+	now := time.Now()
+	revision, err := m.Resubmit(captain, 2, 6, corrected, "https://example.com/b.png", nil, now)
+	require.NoError(t, err)
+	require.Equal(t, StatusDraft, m.Status)
+	require.Equal(t, 2, m.TeamPlacement)
+	require.Equal(t, 6, m.PlayerStats[0].Kills)
+	require.Equal(t, "https://example.com/b.png", m.ScreenshotURL)
+	require.Empty(t, m.RejectionReason)
+	require.Nil(t, m.VerifiedAt)
+	require.Nil(t, m.VerifiedBy)
+	require.Nil(t, m.Custody, "the corrected result must be sealed again")
+
+	require.Len(t, m.Revisions, 1)
+	require.Equal(t, 3, revision.TeamPlacement)
+	require.Equal(t, 4, revision.PlayerStats[0].Kills)
+	require.Equal(t, "https://example.com/a.png", revision.ScreenshotURL)
+	require.Equal(t, "kills do not match the scoreboard", revision.RejectionReason)
+	require.Equal(t, admin, revision.RejectedBy)
+	require.Equal(t, rejectedAt, revision.RejectedAt)
+	require.Equal(t, now, revision.ReplacedAt)
+	require.NotNil(t, revision.Custody)
+
+	require.NoError(t, m.VerifyMatch(admin), "the resubmission goes back to review")
+}
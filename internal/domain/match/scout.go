@@ -0,0 +1,144 @@
+package match
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// ScoutRecentMatches is how many recent verified matches a scouting report lists.
+	ScoutRecentMatches = 10
+
+	// ScoutWindow is how many recent verified matches a scouting report's
+	// averages and head-to-head record cover.
+	ScoutWindow = 100
+)
+
+// MemberKills is a team member's share of the team's kills in verified matches.
+type MemberKills struct {
+	PlayerID  uuid.UUID `json:"player_id"`
+	Matches   int       `json:"matches"`
+	Kills     int       `json:"kills"`
+	AvgKills  float64   `json:"avg_kills"`
+	KillShare float64   `json:"kill_share"` // Fraction of the team's kills over the matches covered
+}
+
+// ScoutSummary aggregates a team's verified matches for opponents.
+type ScoutSummary struct {
+	Matches          int           `json:"matches"`
+	AveragePlacement float64       `json:"average_placement"`
+	AverageKills     float64       `json:"average_kills"`
+	Members          []MemberKills `json:"members"` // Most kills first
+}
+
+// HeadToHead compares two teams' placements in the games both played. Wins
+// count the games the team placed better than the opponent.
+type HeadToHead struct {
+	OpponentTeamID uuid.UUID `json:"opponent_team_id"`
+	Games          int       `json:"games"`
+	Wins           int       `json:"wins"`
+	Losses         int       `json:"losses"`
+	Draws          int       `json:"draws"`
+}
+
+// Scout summarizes a team's verified matches: average placement and kills and
+// how kills are distributed across members. Other statuses are ignored.
+func Scout(matches []Match) ScoutSummary {
+	var summary ScoutSummary
+	placements, teamKills := 0, 0
+	byPlayer := make(map[uuid.UUID]*MemberKills)
+
+	for i := range matches {
+		m := &matches[i]
+		if m.Status != StatusVerified {
+			continue
+		}
+
+		summary.Matches++
+		placements += m.TeamPlacement
+		teamKills += m.TeamKills
+
+		for _, ps := range m.PlayerStats {
+			mk, ok := byPlayer[ps.PlayerID]
+			if !ok {
+				mk = &MemberKills{PlayerID: ps.PlayerID}
+				byPlayer[ps.PlayerID] = mk
+			}
+			mk.Matches++
+			mk.Kills += ps.Kills
+		}
+	}
+
+	if summary.Matches == 0 {
+		summary.Members = []MemberKills{}
+		return summary
+	}
+
+	summary.AveragePlacement = float64(placements) / float64(summary.Matches)
+	summary.AverageKills = float64(teamKills) / float64(summary.Matches)
+
+	playerKills := 0
+	for _, mk := range byPlayer {
+		playerKills += mk.Kills
+	}
+
+	summary.Members = make([]MemberKills, 0, len(byPlayer))
+	for _, mk := range byPlayer {
+		mk.AvgKills = float64(mk.Kills) / float64(mk.Matches)
+		if playerKills > 0 {
+			mk.KillShare = float64(mk.Kills) / float64(playerKills)
+		}
+		summary.Members = append(summary.Members, *mk)
+	}
+	sort.Slice(summary.Members, func(i, j int) bool {
+		if summary.Members[i].Kills != summary.Members[j].Kills {
+			return summary.Members[i].Kills > summary.Members[j].Kills
+		}
+		return summary.Members[i].PlayerID.String() < summary.Members[j].PlayerID.String()
+	})
+
+	return summary
+}
+
+// HeadToHeadRecord compares a team's verified matches with an opponent's in
+// the same tournament. Matches are not linked to a shared lobby, so the
+// teams' games are paired in the order they were played: the first game of
+// one with the first of the other, and so on.
+func HeadToHeadRecord(matches, opponent []Match, opponentTeamID uuid.UUID) HeadToHead {
+	record := HeadToHead{OpponentTeamID: opponentTeamID}
+
+	ours := verifiedInPlayOrder(matches)
+	theirs := verifiedInPlayOrder(opponent)
+
+	for i := 0; i < len(ours) && i < len(theirs); i++ {
+		if ours[i].TournamentID != theirs[i].TournamentID {
+			continue
+		}
+		record.Games++
+		switch {
+		case ours[i].TeamPlacement < theirs[i].TeamPlacement:
+			record.Wins++
+		case ours[i].TeamPlacement > theirs[i].TeamPlacement:
+			record.Losses++
+		default:
+			record.Draws++
+		}
+	}
+
+	return record
+}
+
+// verifiedInPlayOrder returns the verified matches, oldest first.
+func verifiedInPlayOrder(matches []Match) []*Match {
+	verified := make([]*Match, 0, len(matches))
+	for i := range matches {
+		if matches[i].Status == StatusVerified {
+			verified = append(verified, &matches[i])
+		}
+	}
+	sort.SliceStable(verified, func(i, j int) bool {
+		return verified[i].CreatedAt.Before(verified[j].CreatedAt)
+	})
+	return verified
+}
@@ -0,0 +1,57 @@
+package match
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScout(t *testing.T) {
+	t.Parallel()
+
+	a, b := uuid.New(), uuid.New()
+	matches := []Match{
+		{Status: StatusVerified, TeamPlacement: 1, TeamKills: 8, PlayerStats: []PlayerMatchStats{{PlayerID: a, Kills: 6}, {PlayerID: b, Kills: 2}}},
+		{Status: StatusVerified, TeamPlacement: 5, TeamKills: 4, PlayerStats: []PlayerMatchStats{{PlayerID: a, Kills: 2}, {PlayerID: b, Kills: 2}}},
+		{Status: StatusDraft, TeamPlacement: 20, TeamKills: 0, PlayerStats: []PlayerMatchStats{{PlayerID: a}, {PlayerID: b}}},
+	}
+
+	summary := Scout(matches)
+	require.Equal(t, 2, summary.Matches)
+	assert.InDelta(t, 3, summary.AveragePlacement, 0.0001)
+	assert.InDelta(t, 6, summary.AverageKills, 0.0001)
+	require.Len(t, summary.Members, 2)
+	assert.Equal(t, a, summary.Members[0].PlayerID)
+	assert.Equal(t, 8, summary.Members[0].Kills)
+	assert.InDelta(t, 4, summary.Members[0].AvgKills, 0.0001)
+	assert.InDelta(t, 8.0/12.0, summary.Members[0].KillShare, 0.0001)
+
+	empty := Scout(nil)
+	require.Zero(t, empty.Matches)
+	require.Empty(t, empty.Members)
+}
+
+func TestHeadToHeadRecord(t *testing.T) {
+	t.Parallel()
+
+	tournament := uuid.New()
+	start := time.Now()
+	game := func(n, placement int) Match {
+		return Match{TournamentID: tournament, Status: StatusVerified, TeamPlacement: placement, CreatedAt: start.Add(time.Duration(n) * time.Hour)}
+	}
+
+	// Newest first, as the repository returns them.
+	ours := []Match{game(3, 4), game(2, 2), game(1, 1)}
+	theirs := []Match{game(4, 1), game(3, 3), game(2, 2), game(1, 5)}
+
+	opponent := uuid.New()
+	record := HeadToHeadRecord(ours, theirs, opponent)
+	assert.Equal(t, opponent, record.OpponentTeamID)
+	assert.Equal(t, 3, record.Games)
+	assert.Equal(t, 1, record.Wins)
+	assert.Equal(t, 1, record.Losses)
+	assert.Equal(t, 1, record.Draws)
+}
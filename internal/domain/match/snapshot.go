@@ -0,0 +1,185 @@
+package match
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxAmendmentReasonLength is the longest amendment reason accepted, in characters.
+const MaxAmendmentReasonLength = 500
+
+var (
+	ErrMatchNotVerified        = errors.New("match is not verified")
+	ErrInvalidAmendmentReason  = errors.New("amendment reason is required and must be at most 500 characters")
+	ErrAmendmentChangesRoster  = errors.New("amendment must correct the stats of the same players")
+	ErrAmendmentWithoutChanges = errors.New("amendment does not change the match result")
+	ErrInvalidUnverifyReason   = errors.New("unverify reason is required and must be at most 500 characters")
+	ErrInvalidVoidReason       = errors.New("void reason is required and must be at most 500 characters")
+)
+
+// Snapshot is the match result exactly as it was verified. It is written once,
+// when the match is verified, and never changes afterwards: corrections are
+// recorded as amendments on top of it.
+type Snapshot struct {
+	TeamPlacement int                `json:"team_placement"`
+	TeamKills     int                `json:"team_kills"`
+	PlayerStats   []PlayerMatchStats `json:"player_stats"`
+	StatsSHA256   string             `json:"stats_sha256"`
+	VerifiedBy    uuid.UUID          `json:"verified_by"`
+	VerifiedAt    time.Time          `json:"verified_at"`
+}
+
+// Amendment is an admin correction of a verified match's result. It holds the
+// corrected values; the values it replaced are those of the previous amendment
+// or, for the first one, the verified snapshot.
+type Amendment struct {
+	ID            uuid.UUID          `json:"id"`
+	TeamPlacement int                `json:"team_placement"`
+	TeamKills     int                `json:"team_kills"`
+	PlayerStats   []PlayerMatchStats `json:"player_stats"`
+	Reason        string             `json:"reason"`
+	AmendedBy     uuid.UUID          `json:"amended_by"`
+	AmendedAt     time.Time          `json:"amended_at"`
+}
+
+// Reversal records a verification being withdrawn by an admin, together with
+// the snapshot that verification had taken.
+type Reversal struct {
+	Reason     string    `json:"reason"`
+	ReversedBy uuid.UUID `json:"reversed_by"`
+	ReversedAt time.Time `json:"reversed_at"`
+	Snapshot   *Snapshot `json:"snapshot,omitempty"`
+}
+
+// takeSnapshot records the match's current result as its verified snapshot.
+func (m *Match) takeSnapshot(adminID uuid.UUID, now time.Time) {
+	// The stats hash only fails for unencodable custom stats, which would
+	// have failed custody sealing at submission already.
+	statsHash, _ := m.StatsSHA256()
+
+	m.VerifiedSnapshot = &Snapshot{
+		TeamPlacement: m.TeamPlacement,
+		TeamKills:     m.TeamKills,
+		PlayerStats:   copyPlayerStats(m.PlayerStats),
+		StatsSHA256:   statsHash,
+		VerifiedBy:    adminID,
+		VerifiedAt:    now,
+	}
+}
+
+// Amend corrects the result of a verified match. The corrected stats must
+// cover the same players as the current ones. The verified snapshot is left
+// untouched and the correction is appended to the match's amendments.
+func (m *Match) Amend(adminID uuid.UUID, reason string, teamPlacement int, teamKills int, playerStats []PlayerMatchStats, now time.Time) (*Amendment, error) {
+	if m.Status != StatusVerified {
+		return nil, ErrMatchNotVerified
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" || len([]rune(reason)) > MaxAmendmentReasonLength {
+		return nil, ErrInvalidAmendmentReason
+	}
+	if teamPlacement < 1 || teamPlacement > 100 {
+		return nil, ErrInvalidPlacement
+	}
+	if teamKills < 0 {
+		return nil, ErrInvalidKills
+	}
+
+	current := make(map[uuid.UUID]PlayerMatchStats, len(m.PlayerStats))
+	for _, ps := range m.PlayerStats {
+		current[ps.PlayerID] = ps
+	}
+	if len(playerStats) != len(current) {
+		return nil, ErrAmendmentChangesRoster
+	}
+
+	changed := teamPlacement != m.TeamPlacement || teamKills != m.TeamKills
+	seen := make(map[uuid.UUID]bool, len(playerStats))
+	for _, ps := range playerStats {
+		prev, ok := current[ps.PlayerID]
+		if !ok || seen[ps.PlayerID] {
+			return nil, ErrAmendmentChangesRoster
+		}
+		seen[ps.PlayerID] = true
+
+		if ps.Kills < 0 || ps.Damage < 0 || ps.Assists < 0 || ps.Deaths < 0 || ps.Downs < 0 {
+			return nil, ErrInvalidPlayerStats
+		}
+		if ps.Kills != prev.Kills || ps.Damage != prev.Damage || ps.Assists != prev.Assists || ps.Deaths != prev.Deaths || ps.Downs != prev.Downs {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil, ErrAmendmentWithoutChanges
+	}
+
+	amendment := Amendment{
+		ID:            uuid.New(),
+		TeamPlacement: teamPlacement,
+		TeamKills:     teamKills,
+		PlayerStats:   copyPlayerStats(playerStats),
+		Reason:        reason,
+		AmendedBy:     adminID,
+		AmendedAt:     now,
+	}
+
+	m.TeamPlacement = teamPlacement
+	m.TeamKills = teamKills
+	m.PlayerStats = copyPlayerStats(playerStats)
+	m.Amendments = append(m.Amendments, amendment)
+	m.UpdatedAt = now
+
+	return &amendment, nil
+}
+
+// Unverify reverts a match approved by mistake to draft so it can be reviewed
+// again. Its verified snapshot moves into the returned reversal, which is
+// appended to the match's reversals; amendments are kept.
+func (m *Match) Unverify(adminID uuid.UUID, reason string, now time.Time) (*Reversal, error) {
+	return m.withdraw(StatusDraft, adminID, reason, ErrInvalidUnverifyReason, now)
+}
+
+// Void withdraws a verified match for good, for a result that should never
+// have counted. It is recorded as a reversal like Unverify, but the match
+// ends up voided rather than back in review.
+func (m *Match) Void(adminID uuid.UUID, reason string, now time.Time) (*Reversal, error) {
+	return m.withdraw(StatusVoided, adminID, reason, ErrInvalidVoidReason, now)
+}
+
+// withdraw moves a verified match to status, recording the reversal.
+func (m *Match) withdraw(status Status, adminID uuid.UUID, reason string, errReason error, now time.Time) (*Reversal, error) {
+	if m.Status != StatusVerified {
+		return nil, ErrMatchNotVerified
+	}
+
+	reason = strings.TrimSpace(reason)
+	if reason == "" || len([]rune(reason)) > MaxAmendmentReasonLength {
+		return nil, errReason
+	}
+
+	reversal := Reversal{
+		Reason:     reason,
+		ReversedBy: adminID,
+		ReversedAt: now,
+		Snapshot:   m.VerifiedSnapshot,
+	}
+
+	m.Status = status
+	m.VerifiedAt = nil
+	m.VerifiedBy = nil
+	m.VerifiedSnapshot = nil
+	m.Reversals = append(m.Reversals, reversal)
+	m.UpdatedAt = now
+
+	return &reversal, nil
+}
+
+func copyPlayerStats(stats []PlayerMatchStats) []PlayerMatchStats {
+	out := make([]PlayerMatchStats, len(stats))
+	copy(out, stats)
+	return out
+}
@@ -0,0 +1,133 @@
+package match
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatch_VerifyTakesSnapshot(t *testing.T) {
+	t.Parallel()
+
+	player := uuid.New()
+	m, err := NewMatch(uuid.New(), uuid.New(), uuid.New(), 2, 4, []PlayerMatchStats{{PlayerID: player, Kills: 4}}, "", uuid.New())
+	require.NoError(t, err)
+
+	admin := uuid.New()
+	require.NoError(t, m.VerifyMatch(admin))
+	require.NotNil(t, m.VerifiedSnapshot)
+	require.Equal(t, 2, m.VerifiedSnapshot.TeamPlacement)
+	require.Equal(t, admin, m.VerifiedSnapshot.VerifiedBy)
+	require.Len(t, m.VerifiedSnapshot.StatsSHA256, 64)
+
+	_, err = m.Amend(admin, "scoreboard misread", 1, 5, []PlayerMatchStats{{PlayerID: player, Kills: 5}}, time.Now())
+	require.NoError(t, err)
+	require.Equal(t, 1, m.TeamPlacement)
+	require.Equal(t, 5, m.PlayerStats[0].Kills)
+	require.Equal(t, 2, m.VerifiedSnapshot.TeamPlacement)
+	require.Equal(t, 4, m.VerifiedSnapshot.PlayerStats[0].Kills)
+	require.Len(t, m.Amendments, 1)
+}
+
+func TestMatch_Amend(t *testing.T) {
+	t.Parallel()
+
+	player := uuid.New()
+	stats := []PlayerMatchStats{{PlayerID: player, Kills: 3}}
+
+	verified := func() *Match {
+		m, err := NewMatch(uuid.New(), uuid.New(), uuid.New(), 5, 3, stats, "", uuid.New())
+		require.NoError(t, err)
+		require.NoError(t, m.VerifyMatch(uuid.New()))
+		return m
+	}
+
+	tests := []struct {
+		name   string
+		match  func() *Match
+		reason string
+		stats  []PlayerMatchStats
+		err    error
+	}{
+		{name: "draft", match: func() *Match {
+			m, _ := NewMatch(uuid.New(), uuid.New(), uuid.New(), 5, 3, stats, "", uuid.New())
+			return m
+		}, reason: "fix", stats: []PlayerMatchStats{{PlayerID: player, Kills: 4}}, err: ErrMatchNotVerified},
+		{name: "no reason", match: verified, reason: " ", stats: []PlayerMatchStats{{PlayerID: player, Kills: 4}}, err: ErrInvalidAmendmentReason},
+		{name: "different player", match: verified, reason: "fix", stats: []PlayerMatchStats{{PlayerID: uuid.New(), Kills: 4}}, err: ErrAmendmentChangesRoster},
+		{name: "extra player", match: verified, reason: "fix", stats: []PlayerMatchStats{{PlayerID: player}, {PlayerID: uuid.New()}}, err: ErrAmendmentChangesRoster},
+		{name: "negative stats", match: verified, reason: "fix", stats: []PlayerMatchStats{{PlayerID: player, Kills: -1}}, err: ErrInvalidPlayerStats},
+		{name: "unchanged", match: verified, reason: "fix", stats: stats, err: ErrAmendmentWithoutChanges},
+		{name: "valid", match: verified, reason: "fix", stats: []PlayerMatchStats{{PlayerID: player, Kills: 4}}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := tc.match().Amend(uuid.New(), tc.reason, 5, 3, tc.stats, time.Now())
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMatch_Unverify(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewMatch(uuid.New(), uuid.New(), uuid.New(), 1, 2, []PlayerMatchStats{{PlayerID: uuid.New(), Kills: 2}}, "", uuid.New())
+	require.NoError(t, err)
+
+	_, err = m.Unverify(uuid.New(), "approved by mistake", time.Now())
+	require.ErrorIs(t, err, ErrMatchNotVerified)
+
+	require.NoError(t, m.VerifyMatch(uuid.New()))
+	snapshot := m.VerifiedSnapshot
+
+	_, err = m.Unverify(uuid.New(), "", time.Now())
+	require.ErrorIs(t, err, ErrInvalidUnverifyReason)
+
+	reversal, err := m.Unverify(uuid.New(), "approved by mistake", time.Now())
+	require.NoError(t, err)
+	require.Equal(t, StatusDraft, m.Status)
+	require.Nil(t, m.VerifiedAt)
+	require.Nil(t, m.VerifiedSnapshot)
+	require.Same(t, snapshot, reversal.Snapshot)
+	require.Len(t, m.Reversals, 1)
+
+	require.NoError(t, m.VerifyMatch(uuid.New()))
+}
+
+func TestMatch_Void(t *testing.T) {
+	t.Parallel()
+
+	m, err := NewMatch(uuid.New(), uuid.New(), uuid.New(), 1, 2, []PlayerMatchStats{{PlayerID: uuid.New(), Kills: 2}}, "", uuid.New())
+	require.NoError(t, err)
+
+	_, err = m.Void(uuid.New(), "duplicate report", time.Now())
+	require.ErrorIs(t, err, ErrMatchNotVerified)
+
+	require.NoError(t, m.VerifyMatch(uuid.New()))
+	snapshot := m.VerifiedSnapshot
+
+	_, err = m.Void(uuid.New(), "  ", time.Now())
+	require.ErrorIs(t, err, ErrInvalidVoidReason)
+
+	reversal, err := m.Void(uuid.New(), "duplicate report", time.Now())
+	require.NoError(t, err)
+	require.Equal(t, StatusVoided, m.Status)
+	require.Nil(t, m.VerifiedAt)
+	require.Nil(t, m.VerifiedSnapshot)
+	require.Same(t, snapshot, reversal.Snapshot)
+	require.Equal(t, "duplicate report", reversal.Reason)
+	require.Len(t, m.Reversals, 1)
+
+	_, err = m.Void(uuid.New(), "duplicate report", time.Now())
+	require.ErrorIs(t, err, ErrMatchNotVerified)
+	require.ErrorIs(t, m.VerifyMatch(uuid.New()), ErrMatchNotDraft)
+}
@@ -0,0 +1,70 @@
+package match
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+)
+
+// TeamStanding is a team's final position in a tournament based on its verified matches.
+type TeamStanding struct {
+	TeamID       uuid.UUID `json:"team_id"`
+	Rank         int       `json:"rank"`
+	Matches      int       `json:"matches"`
+	Wins         int       `json:"wins"`
+	Kills        int       `json:"kills"`
+	AvgPlacement float64   `json:"avg_placement"`
+}
+
+// TeamStandings ranks teams by their verified matches: most wins first, then
+// best average placement, then most kills.
+func TeamStandings(matches []Match) []TeamStanding {
+	byTeam := make(map[uuid.UUID]*TeamStanding)
+	placements := make(map[uuid.UUID]int)
+
+	for i := range matches {
+		m := &matches[i]
+		if m.Status != StatusVerified {
+			continue
+		}
+
+		s, found := byTeam[m.TeamID]
+		if !found {
+			s = &TeamStanding{TeamID: m.TeamID}
+			byTeam[m.TeamID] = s
+		}
+
+		s.Matches++
+		s.Kills += m.TeamKills
+		placements[m.TeamID] += m.TeamPlacement
+		if m.TeamPlacement == 1 {
+			s.Wins++
+		}
+	}
+
+	standings := make([]TeamStanding, 0, len(byTeam))
+	for id, s := range byTeam {
+		s.AvgPlacement = float64(placements[id]) / float64(s.Matches)
+		standings = append(standings, *s)
+	}
+
+	sort.Slice(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Wins != b.Wins {
+			return a.Wins > b.Wins
+		}
+		if a.AvgPlacement != b.AvgPlacement {
+			return a.AvgPlacement < b.AvgPlacement
+		}
+		if a.Kills != b.Kills {
+			return a.Kills > b.Kills
+		}
+		return a.TeamID.String() < b.TeamID.String()
+	})
+
+	for i := range standings {
+		standings[i].Rank = i + 1
+	}
+
+	return standings
+}
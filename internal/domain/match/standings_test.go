@@ -0,0 +1,40 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamStandings(t *testing.T) {
+	t.Parallel()
+
+	alpha, bravo, charlie := uuid.New(), uuid.New(), uuid.New()
+	matches := []Match{
+		{TeamID: alpha, Status: StatusVerified, TeamPlacement: 1, TeamKills: 8},
+		{TeamID: alpha, Status: StatusVerified, TeamPlacement: 7, TeamKills: 2},
+		{TeamID: bravo, Status: StatusVerified, TeamPlacement: 2, TeamKills: 12},
+		{TeamID: bravo, Status: StatusVerified, TeamPlacement: 4, TeamKills: 5},
+		{TeamID: charlie, Status: StatusVerified, TeamPlacement: 2, TeamKills: 3},
+		{TeamID: charlie, Status: StatusRejected, TeamPlacement: 1, TeamKills: 30},
+	}
+
+	standings := TeamStandings(matches)
+	require.Len(t, standings, 3)
+
+	require.Equal(t, alpha, standings[0].TeamID)
+	require.Equal(t, 1, standings[0].Rank)
+	require.Equal(t, 2, standings[0].Matches)
+	require.Equal(t, 1, standings[0].Wins)
+	require.Equal(t, 10, standings[0].Kills)
+	require.InDelta(t, 4.0, standings[0].AvgPlacement, 0.001)
+
+	// bravo and charlie have no wins; charlie's single match places better on average.
+	require.Equal(t, charlie, standings[1].TeamID)
+	require.Equal(t, 2, standings[1].Rank)
+	require.Equal(t, 1, standings[1].Matches)
+
+	require.Equal(t, bravo, standings[2].TeamID)
+	require.Equal(t, 3, standings[2].Rank)
+}
@@ -0,0 +1,156 @@
+package match
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// VODPlatform identifies a video platform whose links can be deep-linked to a timestamp.
+type VODPlatform string
+
+const (
+	VODPlatformTwitch  VODPlatform = "twitch"
+	VODPlatformYouTube VODPlatform = "youtube"
+	VODPlatformOther   VODPlatform = "other" // Any other host; links are not deep-linked
+)
+
+// MaxVODTimestamps is the most timestamps a single VOD attachment may carry.
+const MaxVODTimestamps = 20
+
+// VODTimestamp marks an event in a VOD, e.g. "final circle" at "1:22:10".
+type VODTimestamp struct {
+	Label string `bson:"label" json:"label"`
+	At    string `bson:"at" json:"at"` // h:mm:ss or m:ss
+}
+
+// VODLink is a timestamp resolved to a link that opens the VOD at that moment.
+type VODLink struct {
+	Label    string      `json:"label"`
+	At       string      `json:"at"`
+	Seconds  int         `json:"seconds"`
+	URL      string      `json:"url"`
+	Platform VODPlatform `json:"platform"`
+}
+
+// ParseVODTimestamp parses an h:mm:ss or m:ss timestamp into seconds.
+func ParseVODTimestamp(at string) (int, error) {
+	parts := strings.Split(at, ":")
+	if len(parts) < 2 || len(parts) > 3 {
+		return 0, fmt.Errorf("%w: timestamp %q must be h:mm:ss or m:ss", ErrInvalidAttachment, at)
+	}
+
+	seconds := 0
+	for i, p := range parts {
+		n, err := strconv.Atoi(p)
+		if err != nil || n < 0 || (i > 0 && (n > 59 || len(p) != 2)) {
+			return 0, fmt.Errorf("%w: timestamp %q must be h:mm:ss or m:ss", ErrInvalidAttachment, at)
+		}
+		seconds = seconds*60 + n
+	}
+	return seconds, nil
+}
+
+// vodPlatform identifies the platform of a VOD URL, checking that links to
+// known platforms point at a video.
+func vodPlatform(u *url.URL) (VODPlatform, error) {
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	switch host {
+	case "twitch.tv", "m.twitch.tv":
+		segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+		if len(segments) != 2 || segments[0] != "videos" || segments[1] == "" {
+			return "", fmt.Errorf("%w: twitch VODs must link to /videos/{id}", ErrInvalidAttachment)
+		}
+		return VODPlatformTwitch, nil
+	case "youtube.com", "m.youtube.com":
+		if u.Path != "/watch" || u.Query().Get("v") == "" {
+			return "", fmt.Errorf("%w: youtube VODs must link to /watch?v={id}", ErrInvalidAttachment)
+		}
+		return VODPlatformYouTube, nil
+	case "youtu.be":
+		if strings.Trim(u.Path, "/") == "" {
+			return "", fmt.Errorf("%w: youtube VODs must include a video id", ErrInvalidAttachment)
+		}
+		return VODPlatformYouTube, nil
+	default:
+		return VODPlatformOther, nil
+	}
+}
+
+// validateVOD checks a VOD attachment's platform link and timestamps.
+func (a Attachment) validateVOD(u *url.URL) error {
+	if _, err := vodPlatform(u); err != nil {
+		return err
+	}
+
+	if len(a.Timestamps) > MaxVODTimestamps {
+		return fmt.Errorf("%w: a VOD can have at most %d timestamps", ErrInvalidAttachment, MaxVODTimestamps)
+	}
+	for _, ts := range a.Timestamps {
+		if strings.TrimSpace(ts.Label) == "" {
+			return fmt.Errorf("%w: timestamp label cannot be empty", ErrInvalidAttachment)
+		}
+		if _, err := ParseVODTimestamp(ts.At); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// VODLinks resolves a VOD attachment's timestamps to links that open the
+// video at each moment. Links to unrecognized platforms point at the VOD
+// itself. Attachments that are not valid VODs have no links.
+func (a Attachment) VODLinks() []VODLink {
+	if a.Type != AttachmentVOD {
+		return nil
+	}
+	u, err := url.Parse(a.URL)
+	if err != nil {
+		return nil
+	}
+	platform, err := vodPlatform(u)
+	if err != nil {
+		return nil
+	}
+
+	links := make([]VODLink, 0, len(a.Timestamps))
+	for _, ts := range a.Timestamps {
+		seconds, err := ParseVODTimestamp(ts.At)
+		if err != nil {
+			continue
+		}
+		links = append(links, VODLink{
+			Label:    ts.Label,
+			At:       ts.At,
+			Seconds:  seconds,
+			URL:      deepLink(*u, platform, seconds),
+			Platform: platform,
+		})
+	}
+	return links
+}
+
+// deepLink returns the VOD URL with the platform's start-time parameter set.
+func deepLink(u url.URL, platform VODPlatform, seconds int) string {
+	q := u.Query()
+	switch platform {
+	case VODPlatformTwitch:
+		q.Set("t", fmt.Sprintf("%dh%dm%ds", seconds/3600, seconds%3600/60, seconds%60))
+	case VODPlatformYouTube:
+		q.Set("t", fmt.Sprintf("%ds", seconds))
+	default:
+		return u.String()
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
+
+// VODLinks returns the deep links for every VOD attached to the match.
+func (m *Match) VODLinks() []VODLink {
+	var links []VODLink
+	for _, a := range m.Attachments {
+		links = append(links, a.VODLinks()...)
+	}
+	return links
+}
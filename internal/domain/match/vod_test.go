@@ -0,0 +1,103 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseVODTimestamp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		at       string
+		expected int
+		valid    bool
+	}{
+		{at: "1:22:10", expected: 4930, valid: true},
+		{at: "22:10", expected: 1330, valid: true},
+		{at: "0:05", expected: 5, valid: true},
+		{at: "90", valid: false},
+		{at: "1:2:10", valid: false},
+		{at: "1:60", valid: false},
+		{at: "a:10", valid: false},
+		{at: "1:00:00:00", valid: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.at, func(t *testing.T) {
+			t.Parallel()
+
+			seconds, err := ParseVODTimestamp(tc.at)
+			if !tc.valid {
+				require.ErrorIs(t, err, ErrInvalidAttachment)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, seconds)
+		})
+	}
+}
+
+func TestVODValidation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		url   string
+		valid bool
+	}{
+		{name: "twitch video", url: "https://www.twitch.tv/videos/123456", valid: true},
+		{name: "twitch channel", url: "https://twitch.tv/somestreamer", valid: false},
+		{name: "youtube watch", url: "https://www.youtube.com/watch?v=abc123", valid: true},
+		{name: "youtube without id", url: "https://youtube.com/watch", valid: false},
+		{name: "youtube short link", url: "https://youtu.be/abc123", valid: true},
+		{name: "youtube short link without id", url: "https://youtu.be/", valid: false},
+		{name: "other host", url: "https://vods.example.com/match.mp4", valid: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := Attachment{Type: AttachmentVOD, URL: tc.url}.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, ErrInvalidAttachment)
+			}
+		})
+	}
+
+	unlabeled := Attachment{Type: AttachmentVOD, URL: "https://youtu.be/abc", Timestamps: []VODTimestamp{{At: "1:00"}}}
+	require.ErrorIs(t, unlabeled.Validate(), ErrInvalidAttachment)
+
+	onScreenshot := Attachment{Type: AttachmentScreenshot, URL: "https://example.com/a.png", Timestamps: []VODTimestamp{{Label: "x", At: "1:00"}}}
+	require.ErrorIs(t, onScreenshot.Validate(), ErrInvalidAttachment)
+}
+
+func TestVODLinks(t *testing.T) {
+	t.Parallel()
+
+	finalCircle := []VODTimestamp{{Label: "final circle", At: "1:22:10"}}
+	m := &Match{Attachments: []Attachment{
+		{Type: AttachmentScreenshot, URL: "https://example.com/a.png"},
+		{Type: AttachmentVOD, URL: "https://www.twitch.tv/videos/42", Timestamps: finalCircle},
+		{Type: AttachmentVOD, URL: "https://www.youtube.com/watch?v=abc", Timestamps: finalCircle},
+		{Type: AttachmentVOD, URL: "https://vods.example.com/m.mp4", Timestamps: finalCircle},
+	}}
+
+	links := m.VODLinks()
+	require.Len(t, links, 3)
+
+	require.Equal(t, VODPlatformTwitch, links[0].Platform)
+	require.Equal(t, "https://www.twitch.tv/videos/42?t=1h22m10s", links[0].URL)
+	require.Equal(t, 4930, links[0].Seconds)
+
+	require.Equal(t, VODPlatformYouTube, links[1].Platform)
+	require.Equal(t, "https://www.youtube.com/watch?t=4930s&v=abc", links[1].URL)
+
+	require.Equal(t, VODPlatformOther, links[2].Platform)
+	require.Equal(t, "https://vods.example.com/m.mp4", links[2].URL)
+	require.Equal(t, "final circle", links[2].Label)
+}
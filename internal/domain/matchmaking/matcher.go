@@ -0,0 +1,78 @@
+package matchmaking
+
+import (
+	"sort"
+)
+
+// MatcherConfig controls how queue entries are grouped into lobbies.
+type MatcherConfig struct {
+	// LobbySize is the number of players a lobby needs before it is formed.
+	LobbySize int
+
+	// MaxRatingSpread is the largest rating gap allowed between entries in one lobby.
+	MaxRatingSpread float64
+}
+
+// FormLobbies groups waiting entries of a single game and tier bracket into
+// lobbies of exactly cfg.LobbySize players. Entries are ordered by rating and
+// each lobby only takes entries within cfg.MaxRatingSpread of its lowest rated
+// entry, so participants face similarly rated opponents. Ties in rating are
+// broken by queue time. Entries that cannot be placed are left for the next run.
+func FormLobbies(entries []*QueueEntry, cfg MatcherConfig) [][]*QueueEntry {
+	if cfg.LobbySize < 2 {
+		return nil
+	}
+
+	candidates := make([]*QueueEntry, 0, len(entries))
+	for _, e := range entries {
+		if e.Status == EntryStatusWaiting && e.Size() > 0 && e.Size() <= cfg.LobbySize {
+			candidates = append(candidates, e)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].Rating != candidates[j].Rating {
+			return candidates[i].Rating < candidates[j].Rating
+		}
+		return candidates[i].JoinedAt.Before(candidates[j].JoinedAt)
+	})
+
+	used := make([]bool, len(candidates))
+	var lobbies [][]*QueueEntry
+
+	for start := range candidates {
+		if used[start] {
+			continue
+		}
+
+		group := []int{start}
+		players := candidates[start].Size()
+
+		for next := start + 1; next < len(candidates) && players < cfg.LobbySize; next++ {
+			if used[next] {
+				continue
+			}
+			if candidates[next].Rating-candidates[start].Rating > cfg.MaxRatingSpread {
+				break
+			}
+			if players+candidates[next].Size() > cfg.LobbySize {
+				continue
+			}
+			group = append(group, next)
+			players += candidates[next].Size()
+		}
+
+		if players != cfg.LobbySize || len(group) < 2 {
+			continue
+		}
+
+		lobby := make([]*QueueEntry, 0, len(group))
+		for _, idx := range group {
+			used[idx] = true
+			lobby = append(lobby, candidates[idx])
+		}
+		lobbies = append(lobbies, lobby)
+	}
+
+	return lobbies
+}
@@ -0,0 +1,247 @@
+// Package matchmaking provides domain entities and logic for pickup scrim matchmaking.
+package matchmaking
+
+import (
+	"errors"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+var (
+	ErrEntryNotFound     = errors.New("queue entry not found")
+	ErrLobbyNotFound     = errors.New("lobby not found")
+	ErrAlreadyQueued     = errors.New("player is already in a matchmaking queue")
+	ErrInvalidGame       = errors.New("game ID cannot be empty")
+	ErrInvalidPlayer     = errors.New("player ID cannot be empty")
+	ErrInvalidTier       = errors.New("invalid matchmaking tier")
+	ErrGameNotActive     = errors.New("game is not active")
+	ErrNotEntryOwner     = errors.New("only the player who queued the entry can leave the queue")
+	ErrEntryNotWaiting   = errors.New("queue entry is not waiting")
+	ErrInvalidLobbySize  = errors.New("lobby needs at least two queue entries")
+	ErrLobbyNotOpen      = errors.New("lobby is not open")
+	ErrNotInLobby        = errors.New("player is not part of the lobby")
+	ErrInvalidResults    = errors.New("lobby results must place every entry exactly once")
+	ErrInvalidPlacement  = errors.New("placement must be between 1 and the number of lobby entries")
+	ErrPlayerNotInResult = errors.New("player stats reference a player outside the entry")
+)
+
+// EntryStatus represents the lifecycle of a queue entry.
+type EntryStatus string
+
+const (
+	EntryStatusWaiting   EntryStatus = "waiting"
+	EntryStatusMatched   EntryStatus = "matched"
+	EntryStatusCompleted EntryStatus = "completed"
+	EntryStatusCancelled EntryStatus = "cancelled"
+)
+
+// LobbyStatus represents the lifecycle of a scrim lobby.
+type LobbyStatus string
+
+const (
+	LobbyStatusOpen      LobbyStatus = "open"
+	LobbyStatusCompleted LobbyStatus = "completed"
+)
+
+// QueueEntry is a solo player or a team waiting for a scrim in a game and tier bracket.
+type QueueEntry struct {
+	ID        uuid.UUID   `bson:"_id" json:"id"`
+	GameID    uuid.UUID   `bson:"game_id" json:"game_id"`
+	PlayerID  uuid.UUID   `bson:"player_id" json:"player_id"` // Player who queued (captain for teams)
+	TeamID    *uuid.UUID  `bson:"team_id,omitempty" json:"team_id,omitempty"`
+	MemberIDs []uuid.UUID `bson:"member_ids" json:"member_ids"`
+	Tier      player.Tier `bson:"tier" json:"tier"`
+	Rating    float64     `bson:"rating" json:"rating"`
+	Status    EntryStatus `bson:"status" json:"status"`
+	LobbyID   *uuid.UUID  `bson:"lobby_id,omitempty" json:"lobby_id,omitempty"`
+	JoinedAt  time.Time   `bson:"joined_at" json:"joined_at"`
+	UpdatedAt time.Time   `bson:"updated_at" json:"updated_at"`
+}
+
+// NewQueueEntry creates a waiting queue entry. A nil teamID queues the player solo.
+func NewQueueEntry(gameID, playerID uuid.UUID, teamID *uuid.UUID, memberIDs []uuid.UUID, tier player.Tier, rating float64) (*QueueEntry, error) {
+	if gameID == uuid.Nil {
+		return nil, ErrInvalidGame
+	}
+	if playerID == uuid.Nil {
+		return nil, ErrInvalidPlayer
+	}
+	if tier.Level() < 0 {
+		return nil, ErrInvalidTier
+	}
+	if len(memberIDs) == 0 {
+		memberIDs = []uuid.UUID{playerID}
+	}
+
+	now := time.Now().UTC()
+	return &QueueEntry{
+		ID:        uuid.New(),
+		GameID:    gameID,
+		PlayerID:  playerID,
+		TeamID:    teamID,
+		MemberIDs: memberIDs,
+		Tier:      tier,
+		Rating:    rating,
+		Status:    EntryStatusWaiting,
+		JoinedAt:  now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Size returns the number of players the entry brings into a lobby.
+func (e *QueueEntry) Size() int {
+	return len(e.MemberIDs)
+}
+
+// HasMember checks if a player is part of the entry.
+func (e *QueueEntry) HasMember(playerID uuid.UUID) bool {
+	for _, id := range e.MemberIDs {
+		if id == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// Cancel removes a waiting entry from the queue.
+func (e *QueueEntry) Cancel() error {
+	if e.Status != EntryStatusWaiting {
+		return ErrEntryNotWaiting
+	}
+	e.Status = EntryStatusCancelled
+	e.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// AssignLobby marks a waiting entry as matched into a lobby.
+func (e *QueueEntry) AssignLobby(lobbyID uuid.UUID) error {
+	if e.Status != EntryStatusWaiting {
+		return ErrEntryNotWaiting
+	}
+	e.Status = EntryStatusMatched
+	e.LobbyID = &lobbyID
+	e.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// MarkCompleted closes a matched entry once its lobby results are reported,
+// freeing its members to queue again.
+func (e *QueueEntry) MarkCompleted() {
+	e.Status = EntryStatusCompleted
+	e.UpdatedAt = time.Now().UTC()
+}
+
+// EntryResult is the reported outcome of one queue entry in a lobby.
+type EntryResult struct {
+	EntryID     uuid.UUID                `bson:"entry_id" json:"entry_id"`
+	Placement   int                      `bson:"placement" json:"placement"`
+	PlayerStats []match.PlayerMatchStats `bson:"player_stats" json:"player_stats"`
+}
+
+// Lobby is a scrim formed from similarly rated queue entries.
+type Lobby struct {
+	ID            uuid.UUID     `bson:"_id" json:"id"`
+	GameID        uuid.UUID     `bson:"game_id" json:"game_id"`
+	Tier          player.Tier   `bson:"tier" json:"tier"`
+	EntryIDs      []uuid.UUID   `bson:"entry_ids" json:"entry_ids"`
+	PlayerIDs     []uuid.UUID   `bson:"player_ids" json:"player_ids"`
+	AverageRating float64       `bson:"average_rating" json:"average_rating"`
+	Status        LobbyStatus   `bson:"status" json:"status"`
+	Results       []EntryResult `bson:"results,omitempty" json:"results,omitempty"`
+	ReportedBy    *uuid.UUID    `bson:"reported_by,omitempty" json:"reported_by,omitempty"`
+	CreatedAt     time.Time     `bson:"created_at" json:"created_at"`
+	UpdatedAt     time.Time     `bson:"updated_at" json:"updated_at"`
+	CompletedAt   *time.Time    `bson:"completed_at,omitempty" json:"completed_at,omitempty"`
+}
+
+// NewLobby creates an open lobby from matched queue entries.
+func NewLobby(gameID uuid.UUID, tier player.Tier, entries []*QueueEntry) (*Lobby, error) {
+	if gameID == uuid.Nil {
+		return nil, ErrInvalidGame
+	}
+	if len(entries) < 2 {
+		return nil, ErrInvalidLobbySize
+	}
+
+	entryIDs := make([]uuid.UUID, 0, len(entries))
+	var playerIDs []uuid.UUID
+	var ratingSum float64
+	for _, e := range entries {
+		entryIDs = append(entryIDs, e.ID)
+		playerIDs = append(playerIDs, e.MemberIDs...)
+		ratingSum += e.Rating
+	}
+
+	now := time.Now().UTC()
+	return &Lobby{
+		ID:            uuid.New(),
+		GameID:        gameID,
+		Tier:          tier,
+		EntryIDs:      entryIDs,
+		PlayerIDs:     playerIDs,
+		AverageRating: ratingSum / float64(len(entries)),
+		Status:        LobbyStatusOpen,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}, nil
+}
+
+// HasPlayer checks if a player was matched into the lobby.
+func (l *Lobby) HasPlayer(playerID uuid.UUID) bool {
+	for _, id := range l.PlayerIDs {
+		if id == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// HasEntry checks if a queue entry belongs to the lobby.
+func (l *Lobby) HasEntry(entryID uuid.UUID) bool {
+	for _, id := range l.EntryIDs {
+		if id == entryID {
+			return true
+		}
+	}
+	return false
+}
+
+// Complete records the lobby results. Every entry must be placed exactly once
+// with a unique placement between 1 and the number of entries.
+func (l *Lobby) Complete(results []EntryResult, reportedBy uuid.UUID) error {
+	if l.Status != LobbyStatusOpen {
+		return ErrLobbyNotOpen
+	}
+	if len(results) != len(l.EntryIDs) {
+		return ErrInvalidResults
+	}
+
+	seenEntries := make(map[uuid.UUID]bool, len(results))
+	seenPlacements := make(map[int]bool, len(results))
+	for _, r := range results {
+		if !l.HasEntry(r.EntryID) || seenEntries[r.EntryID] {
+			return ErrInvalidResults
+		}
+		if r.Placement < 1 || r.Placement > len(l.EntryIDs) || seenPlacements[r.Placement] {
+			return ErrInvalidPlacement
+		}
+		for _, ps := range r.PlayerStats {
+			if ps.Kills < 0 || ps.Damage < 0 || ps.Assists < 0 || ps.Deaths < 0 || ps.Downs < 0 {
+				return match.ErrInvalidPlayerStats
+			}
+		}
+		seenEntries[r.EntryID] = true
+		seenPlacements[r.Placement] = true
+	}
+
+	now := time.Now().UTC()
+	l.Status = LobbyStatusCompleted
+	l.Results = results
+	l.ReportedBy = &reportedBy
+	l.CompletedAt = &now
+	l.UpdatedAt = now
+	return nil
+}
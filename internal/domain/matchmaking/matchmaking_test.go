@@ -0,0 +1,142 @@
+package matchmaking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEntry(t *testing.T, rating float64, size int) *QueueEntry {
+	t.Helper()
+
+	members := make([]uuid.UUID, size)
+	for i := range members {
+		members[i] = uuid.New()
+	}
+
+	e, err := NewQueueEntry(uuid.New(), members[0], nil, members, player.TierIntermediate, rating)
+	require.NoError(t, err)
+	return e
+}
+
+func TestFormLobbies(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		ratings        []float64
+		sizes          []int
+		cfg            MatcherConfig
+		expectedGroups [][]int
+	}{
+		{
+			name:           "pairs similarly rated solos",
+			ratings:        []float64{100, 900, 120, 880},
+			sizes:          []int{1, 1, 1, 1},
+			cfg:            MatcherConfig{LobbySize: 2, MaxRatingSpread: 50},
+			expectedGroups: [][]int{{0, 2}, {3, 1}},
+		},
+		{
+			name:           "leaves entries outside the spread waiting",
+			ratings:        []float64{100, 400},
+			sizes:          []int{1, 1},
+			cfg:            MatcherConfig{LobbySize: 2, MaxRatingSpread: 100},
+			expectedGroups: nil,
+		},
+		{
+			name:           "fills lobby with mixed team sizes",
+			ratings:        []float64{500, 510, 520},
+			sizes:          []int{2, 3, 2},
+			cfg:            MatcherConfig{LobbySize: 4, MaxRatingSpread: 100},
+			expectedGroups: [][]int{{0, 2}},
+		},
+		{
+			name:           "skips oversized entries",
+			ratings:        []float64{500, 500},
+			sizes:          []int{5, 1},
+			cfg:            MatcherConfig{LobbySize: 4, MaxRatingSpread: 100},
+			expectedGroups: nil,
+		},
+		{
+			name:           "invalid lobby size",
+			ratings:        []float64{500, 500},
+			sizes:          []int{1, 1},
+			cfg:            MatcherConfig{LobbySize: 1, MaxRatingSpread: 100},
+			expectedGroups: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			entries := make([]*QueueEntry, len(tc.ratings))
+			for i, rating := range tc.ratings {
+				entries[i] = newTestEntry(t, rating, tc.sizes[i])
+			}
+
+			lobbies := FormLobbies(entries, tc.cfg)
+
+			require.Len(t, lobbies, len(tc.expectedGroups))
+			for i, group := range tc.expectedGroups {
+				require.Len(t, lobbies[i], len(group))
+				for j, idx := range group {
+					require.Equal(t, entries[idx].ID, lobbies[i][j].ID)
+				}
+			}
+		})
+	}
+}
+
+func TestFormLobbies_PrefersOldestOnTies(t *testing.T) {
+	t.Parallel()
+
+	older := newTestEntry(t, 300, 1)
+	older.JoinedAt = time.Now().Add(-time.Minute)
+	newer := newTestEntry(t, 300, 1)
+	newest := newTestEntry(t, 300, 1)
+	newest.JoinedAt = time.Now().Add(time.Minute)
+
+	lobbies := FormLobbies([]*QueueEntry{newest, newer, older}, MatcherConfig{LobbySize: 2, MaxRatingSpread: 0})
+
+	require.Len(t, lobbies, 1)
+	require.Equal(t, older.ID, lobbies[0][0].ID)
+	require.Equal(t, newer.ID, lobbies[0][1].ID)
+}
+
+func TestLobby_Complete(t *testing.T) {
+	t.Parallel()
+
+	a := newTestEntry(t, 100, 1)
+	b := newTestEntry(t, 110, 1)
+
+	newLobby := func(t *testing.T) *Lobby {
+		l, err := NewLobby(a.GameID, player.TierIntermediate, []*QueueEntry{a, b})
+		require.NoError(t, err)
+		return l
+	}
+
+	t.Run("valid results", func(t *testing.T) {
+		l := newLobby(t)
+		err := l.Complete([]EntryResult{{EntryID: a.ID, Placement: 2}, {EntryID: b.ID, Placement: 1}}, a.PlayerID)
+		require.NoError(t, err)
+		require.Equal(t, LobbyStatusCompleted, l.Status)
+		require.NotNil(t, l.CompletedAt)
+
+		err = l.Complete([]EntryResult{{EntryID: a.ID, Placement: 1}, {EntryID: b.ID, Placement: 2}}, a.PlayerID)
+		require.ErrorIs(t, err, ErrLobbyNotOpen)
+	})
+
+	t.Run("missing entry", func(t *testing.T) {
+		l := newLobby(t)
+		err := l.Complete([]EntryResult{{EntryID: a.ID, Placement: 1}}, a.PlayerID)
+		require.ErrorIs(t, err, ErrInvalidResults)
+	})
+
+	t.Run("duplicate placement", func(t *testing.T) {
+		l := newLobby(t)
+		err := l.Complete([]EntryResult{{EntryID: a.ID, Placement: 1}, {EntryID: b.ID, Placement: 1}}, a.PlayerID)
+		require.ErrorIs(t, err, ErrInvalidPlacement)
+	})
+}
@@ -0,0 +1,50 @@
+package matchmaking
+
+import (
+	"context"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+// QueueRepository defines the interface for queue entry persistence operations.
+type QueueRepository interface {
+	// Create stores a new queue entry.
+	Create(ctx context.Context, entry *QueueEntry) error
+
+	// GetByID retrieves a queue entry by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*QueueEntry, error)
+
+	// Update updates an existing queue entry.
+	Update(ctx context.Context, entry *QueueEntry) error
+
+	// GetActiveByPlayer retrieves the waiting or matched entry that includes a player.
+	GetActiveByPlayer(ctx context.Context, playerID uuid.UUID) (*QueueEntry, error)
+
+	// GetWaiting retrieves all waiting entries for a game and tier bracket, oldest first.
+	GetWaiting(ctx context.Context, gameID uuid.UUID, tier player.Tier) ([]*QueueEntry, error)
+
+	// GetWaitingBrackets returns the distinct game and tier brackets that have waiting entries.
+	GetWaitingBrackets(ctx context.Context) ([]Bracket, error)
+
+	// GetByLobbyID retrieves all entries matched into a lobby.
+	GetByLobbyID(ctx context.Context, lobbyID uuid.UUID) ([]*QueueEntry, error)
+}
+
+// LobbyRepository defines the interface for lobby persistence operations.
+type LobbyRepository interface {
+	// Create stores a new lobby.
+	Create(ctx context.Context, lobby *Lobby) error
+
+	// GetByID retrieves a lobby by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*Lobby, error)
+
+	// Update updates an existing lobby.
+	Update(ctx context.Context, lobby *Lobby) error
+}
+
+// Bracket identifies a matchmaking queue by game and tier.
+type Bracket struct {
+	GameID uuid.UUID   `bson:"game_id" json:"game_id"`
+	Tier   player.Tier `bson:"tier" json:"tier"`
+}
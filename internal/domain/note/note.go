@@ -0,0 +1,78 @@
+// Package note provides private admin notes attached to matches, players and teams.
+package note
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxBodyLength is the longest note body accepted, in characters.
+const MaxBodyLength = 2000
+
+var (
+	ErrEmptyBody      = errors.New("note cannot be empty")
+	ErrBodyTooLong    = errors.New("note is too long")
+	ErrInvalidSubject = errors.New("invalid note subject")
+)
+
+// Subject is the kind of record a note is attached to.
+type Subject string
+
+const (
+	SubjectMatch  Subject = "match"
+	SubjectPlayer Subject = "player"
+	SubjectTeam   Subject = "team"
+)
+
+// Note is a private remark an admin leaves on a match, player or team, such
+// as "warned for late submissions twice". Notes are only shown to admins.
+type Note struct {
+	ID        uuid.UUID `bson:"_id" json:"id"`
+	Subject   Subject   `bson:"subject" json:"subject"`
+	SubjectID uuid.UUID `bson:"subject_id" json:"subject_id"`
+	AuthorID  uuid.UUID `bson:"author_id" json:"author_id"`
+	Body      string    `bson:"body" json:"body"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+// New creates a note on a subject. Surrounding whitespace is trimmed from the body.
+func New(subject Subject, subjectID, authorID uuid.UUID, body string) (*Note, error) {
+	switch subject {
+	case SubjectMatch, SubjectPlayer, SubjectTeam:
+	default:
+		return nil, ErrInvalidSubject
+	}
+
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return nil, ErrEmptyBody
+	}
+	if len([]rune(body)) > MaxBodyLength {
+		return nil, ErrBodyTooLong
+	}
+
+	return &Note{
+		ID:        uuid.New(),
+		Subject:   subject,
+		SubjectID: subjectID,
+		AuthorID:  authorID,
+		Body:      body,
+		CreatedAt: time.Now().UTC(),
+	}, nil
+}
+
+// Repository persists admin notes.
+type Repository interface {
+	// Create stores a new note.
+	Create(ctx context.Context, n *Note) error
+
+	// ListBySubject returns the notes on a subject, newest first.
+	ListBySubject(ctx context.Context, subject Subject, subjectID uuid.UUID) ([]*Note, error)
+
+	// ListBySubjects returns the notes on several subjects of the same kind, newest first.
+	ListBySubjects(ctx context.Context, subject Subject, subjectIDs []uuid.UUID) ([]*Note, error)
+}
@@ -0,0 +1,40 @@
+package note
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		subject Subject
+		body    string
+		err     error
+	}{
+		{name: "valid", subject: SubjectPlayer, body: " warned for late submissions twice "},
+		{name: "unknown subject", subject: "tournament", body: "x", err: ErrInvalidSubject},
+		{name: "empty", subject: SubjectMatch, body: "\n\t", err: ErrEmptyBody},
+		{name: "too long", subject: SubjectTeam, body: strings.Repeat("a", MaxBodyLength+1), err: ErrBodyTooLong},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			n, err := New(tc.subject, uuid.New(), uuid.New(), tc.body)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, strings.TrimSpace(tc.body), n.Body)
+			require.Equal(t, tc.subject, n.Subject)
+		})
+	}
+}
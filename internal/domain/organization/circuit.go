@@ -0,0 +1,134 @@
+package organization
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxPointsPlaces caps how many placements a circuit points table can award.
+	MaxPointsPlaces = 64
+
+	// MaxFinalsSpots caps how many teams a series can qualify to its finals.
+	MaxFinalsSpots = 64
+)
+
+var (
+	ErrInvalidPoints      = errors.New("points table must award 1 to 64 places, with non-negative points that never increase")
+	ErrInvalidFinalsSpots = errors.New("a finals tournament needs between 1 and 64 spots")
+	ErrFinalsNotInSeries  = errors.New("finals tournament must belong to the series")
+	ErrFinalsQualified    = errors.New("series finals have already been qualified")
+)
+
+// Placement is a team's final rank in one of a series' tournaments.
+type Placement struct {
+	TournamentID uuid.UUID
+	TeamID       uuid.UUID
+	TeamName     string
+	Rank         int
+}
+
+// CircuitStanding is a team's position in a series' cumulative leaderboard.
+// Teams register anew for every tournament, so they are matched across the
+// series by name, ignoring case.
+type CircuitStanding struct {
+	Rank        int       `json:"rank"`
+	TeamName    string    `json:"team_name"`
+	TeamID      uuid.UUID `json:"team_id"` // The team's entry in the latest tournament it played
+	Points      int       `json:"points"`
+	Tournaments int       `json:"tournaments"`
+	BestRank    int       `json:"best_rank"`
+}
+
+// SetCircuit sets the series' points table and, optionally, the finals
+// tournament its top finalsSpots teams qualify to. The circuit cannot change
+// once the finals have been qualified.
+func (s *Series) SetCircuit(points []int, finalsTournamentID *uuid.UUID, finalsSpots int) error {
+	if s.QualifiedAt != nil {
+		return ErrFinalsQualified
+	}
+	if len(points) == 0 || len(points) > MaxPointsPlaces {
+		return ErrInvalidPoints
+	}
+	for i, p := range points {
+		if p < 0 || (i > 0 && p > points[i-1]) {
+			return ErrInvalidPoints
+		}
+	}
+	if (finalsTournamentID == nil) != (finalsSpots == 0) || finalsSpots < 0 || finalsSpots > MaxFinalsSpots {
+		return ErrInvalidFinalsSpots
+	}
+
+	s.Points = points
+	s.FinalsTournamentID = finalsTournamentID
+	s.FinalsSpots = finalsSpots
+	return nil
+}
+
+// PointsFor returns the circuit points awarded for a placement. Placements
+// past the end of the points table earn none.
+func (s *Series) PointsFor(rank int) int {
+	if rank < 1 || rank > len(s.Points) {
+		return 0
+	}
+	return s.Points[rank-1]
+}
+
+// IsFinals reports whether a tournament is the series' finals.
+func (s *Series) IsFinals(tournamentID uuid.UUID) bool {
+	return s.FinalsTournamentID != nil && *s.FinalsTournamentID == tournamentID
+}
+
+// Qualify marks the series' finals as qualified.
+func (s *Series) Qualify(now time.Time) error {
+	if s.QualifiedAt != nil {
+		return ErrFinalsQualified
+	}
+	s.QualifiedAt = &now
+	return nil
+}
+
+// Standings totals the circuit points of the given placements, which must be
+// in the order the tournaments were played. Teams are ranked by points, then
+// by their best placement, then by how many tournaments they played.
+func (s *Series) Standings(placements []Placement) []CircuitStanding {
+	byName := make(map[string]*CircuitStanding)
+	var order []string
+	for _, p := range placements {
+		key := strings.ToLower(strings.TrimSpace(p.TeamName))
+		st, ok := byName[key]
+		if !ok {
+			st = &CircuitStanding{BestRank: p.Rank}
+			byName[key] = st
+			order = append(order, key)
+		}
+		st.TeamName = p.TeamName
+		st.TeamID = p.TeamID
+		st.Points += s.PointsFor(p.Rank)
+		st.Tournaments++
+		st.BestRank = min(st.BestRank, p.Rank)
+	}
+
+	standings := make([]CircuitStanding, 0, len(order))
+	for _, key := range order {
+		standings = append(standings, *byName[key])
+	}
+	sort.SliceStable(standings, func(i, j int) bool {
+		a, b := standings[i], standings[j]
+		if a.Points != b.Points {
+			return a.Points > b.Points
+		}
+		if a.BestRank != b.BestRank {
+			return a.BestRank < b.BestRank
+		}
+		return a.Tournaments > b.Tournaments
+	})
+	for i := range standings {
+		standings[i].Rank = i + 1
+	}
+	return standings
+}
@@ -0,0 +1,81 @@
+package organization
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeries_SetCircuit(t *testing.T) {
+	t.Parallel()
+
+	finals := uuid.New()
+	tests := []struct {
+		name    string
+		points  []int
+		finals  *uuid.UUID
+		spots   int
+		wantErr error
+	}{
+		{name: "points only", points: []int{10, 6, 4, 2}},
+		{name: "with finals", points: []int{10, 6, 6, 0}, finals: &finals, spots: 8},
+		{name: "empty table", points: nil, wantErr: ErrInvalidPoints},
+		{name: "increasing", points: []int{10, 12}, wantErr: ErrInvalidPoints},
+		{name: "negative", points: []int{10, -1}, wantErr: ErrInvalidPoints},
+		{name: "finals without spots", points: []int{10}, finals: &finals, wantErr: ErrInvalidFinalsSpots},
+		{name: "spots without finals", points: []int{10}, spots: 4, wantErr: ErrInvalidFinalsSpots},
+		{name: "too many spots", points: []int{10}, finals: &finals, spots: MaxFinalsSpots + 1, wantErr: ErrInvalidFinalsSpots},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var s Series
+			err := s.SetCircuit(tc.points, tc.finals, tc.spots)
+			if tc.wantErr != nil {
+				require.ErrorIs(t, err, tc.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.points, s.Points)
+			require.Equal(t, tc.spots, s.FinalsSpots)
+		})
+	}
+}
+
+func TestSeries_Qualify(t *testing.T) {
+	t.Parallel()
+
+	var s Series
+	require.NoError(t, s.SetCircuit([]int{10}, nil, 0))
+	require.NoError(t, s.Qualify(time.Date(2026, 5, 1, 20, 0, 0, 0, time.UTC)))
+	require.ErrorIs(t, s.Qualify(time.Date(2026, 5, 2, 20, 0, 0, 0, time.UTC)), ErrFinalsQualified)
+	require.ErrorIs(t, s.SetCircuit([]int{12}, nil, 0), ErrFinalsQualified)
+}
+
+func TestSeries_Standings(t *testing.T) {
+	t.Parallel()
+
+	s := Series{Points: []int{10, 6, 4}}
+	week1, week2 := uuid.New(), uuid.New()
+	alpha1, alpha2, bravo, charlie, delta := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+
+	standings := s.Standings([]Placement{
+		{TournamentID: week1, TeamID: alpha1, TeamName: "Alpha", Rank: 1},
+		{TournamentID: week1, TeamID: bravo, TeamName: "Bravo", Rank: 2},
+		{TournamentID: week1, TeamID: delta, TeamName: "Delta", Rank: 4},
+		{TournamentID: week2, TeamID: charlie, TeamName: "Charlie", Rank: 1},
+		{TournamentID: week2, TeamID: alpha2, TeamName: "alpha", Rank: 3},
+		{TournamentID: week2, TeamID: bravo, TeamName: "Bravo", Rank: 2},
+	})
+
+	require.Len(t, standings, 4)
+	require.Equal(t, CircuitStanding{Rank: 1, TeamName: "alpha", TeamID: alpha2, Points: 14, Tournaments: 2, BestRank: 1}, standings[0])
+	require.Equal(t, CircuitStanding{Rank: 2, TeamName: "Bravo", TeamID: bravo, Points: 12, Tournaments: 2, BestRank: 2}, standings[1])
+	require.Equal(t, CircuitStanding{Rank: 3, TeamName: "Charlie", TeamID: charlie, Points: 10, Tournaments: 1, BestRank: 1}, standings[2])
+	// Placing past the end of the points table still counts as played
+	require.Equal(t, CircuitStanding{Rank: 4, TeamName: "Delta", TeamID: delta, Points: 0, Tournaments: 1, BestRank: 4}, standings[3])
+}
@@ -0,0 +1,222 @@
+// Package organization provides organizations that run tournament series and
+// the staff who organize them.
+package organization
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxNameLength caps organization and series names, in characters.
+	MaxNameLength = 60
+
+	// MaxStaff caps how many staff an organization can have, besides its owner.
+	MaxStaff = 50
+)
+
+var (
+	ErrNotFound           = errors.New("organization not found")
+	ErrSeriesNotFound     = errors.New("series not found")
+	ErrInvalidName        = errors.New("name must be between 1 and 60 characters")
+	ErrInvalidRole        = errors.New("staff role must be admin or organizer")
+	ErrAlreadyStaff       = errors.New("user is already on the organization's staff")
+	ErrNotStaff           = errors.New("user is not on the organization's staff")
+	ErrTooManyStaff       = errors.New("organization cannot have more than 50 staff")
+	ErrOwnerIsStaff       = errors.New("the organization's owner cannot be added to or removed from its staff")
+	ErrNotAdmin           = errors.New("only the organization's owner and admins can manage it")
+	ErrTournamentInSeries = errors.New("tournament already belongs to a series")
+)
+
+// Role is a staff role in an organization.
+type Role string
+
+const (
+	// RoleAdmin organizes the series' tournaments and manages the
+	// organization's staff and series.
+	RoleAdmin Role = "admin"
+
+	// RoleOrganizer organizes every tournament in the organization's series.
+	RoleOrganizer Role = "organizer"
+)
+
+// ParseRole parses a staff role.
+func ParseRole(value string) (Role, error) {
+	switch r := Role(value); r {
+	case RoleAdmin, RoleOrganizer:
+		return r, nil
+	default:
+		return "", ErrInvalidRole
+	}
+}
+
+// Staff is a user who organizes an organization's tournaments.
+type Staff struct {
+	UserID  uuid.UUID `bson:"user_id" json:"user_id"`
+	Role    Role      `bson:"role" json:"role"`
+	AddedAt time.Time `bson:"added_at" json:"added_at"`
+}
+
+// Series is a recurring set of tournaments, such as a weekly cup. Staff carry
+// organizer permissions across every tournament in it.
+type Series struct {
+	ID                 uuid.UUID  `bson:"id" json:"id"`
+	Name               string     `bson:"name" json:"name"`
+	Points             []int      `bson:"points,omitempty" json:"points,omitempty"` // Circuit points by placement, first place first
+	FinalsTournamentID *uuid.UUID `bson:"finals_tournament_id,omitempty" json:"finals_tournament_id,omitempty"`
+	FinalsSpots        int        `bson:"finals_spots,omitempty" json:"finals_spots,omitempty"` // Top circuit teams qualified to the finals
+	QualifiedAt        *time.Time `bson:"qualified_at,omitempty" json:"qualified_at,omitempty"`
+	CreatedAt          time.Time  `bson:"created_at" json:"created_at"`
+}
+
+// Organization runs tournament series. Its staff are managed here rather than
+// per tournament.
+type Organization struct {
+	ID        uuid.UUID `bson:"_id" json:"id"`
+	Name      string    `bson:"name" json:"name"`
+	OwnerID   uuid.UUID `bson:"owner_id" json:"owner_id"`
+	Staff     []Staff   `bson:"staff" json:"staff"`
+	Series    []Series  `bson:"series" json:"series"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
+}
+
+// New creates an organization owned by ownerID.
+func New(name string, ownerID uuid.UUID) (*Organization, error) {
+	name, err := validName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return &Organization{
+		ID:        uuid.New(),
+		Name:      name,
+		OwnerID:   ownerID,
+		Staff:     []Staff{},
+		Series:    []Series{},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// AddStaff adds a user to the organization's staff.
+func (o *Organization) AddStaff(userID uuid.UUID, role Role, now time.Time) error {
+	if _, err := ParseRole(string(role)); err != nil {
+		return err
+	}
+	if userID == o.OwnerID {
+		return ErrOwnerIsStaff
+	}
+	if o.staff(userID) != nil {
+		return ErrAlreadyStaff
+	}
+	if len(o.Staff) >= MaxStaff {
+		return ErrTooManyStaff
+	}
+
+	o.Staff = append(o.Staff, Staff{UserID: userID, Role: role, AddedAt: now})
+	o.UpdatedAt = now
+	return nil
+}
+
+// RemoveStaff removes a user from the organization's staff.
+func (o *Organization) RemoveStaff(userID uuid.UUID, now time.Time) error {
+	if userID == o.OwnerID {
+		return ErrOwnerIsStaff
+	}
+
+	kept := make([]Staff, 0, len(o.Staff))
+	for _, s := range o.Staff {
+		if s.UserID != userID {
+			kept = append(kept, s)
+		}
+	}
+	if len(kept) == len(o.Staff) {
+		return ErrNotStaff
+	}
+
+	o.Staff = kept
+	o.UpdatedAt = now
+	return nil
+}
+
+// IsAdmin reports whether a user may manage the organization: its owner and
+// admins can.
+func (o *Organization) IsAdmin(userID uuid.UUID) bool {
+	if userID == o.OwnerID {
+		return true
+	}
+	s := o.staff(userID)
+	return s != nil && s.Role == RoleAdmin
+}
+
+// CanOrganize reports whether a user carries organizer permissions in the
+// organization's series: its owner and every staff member do.
+func (o *Organization) CanOrganize(userID uuid.UUID) bool {
+	return userID == o.OwnerID || o.staff(userID) != nil
+}
+
+// AddSeries starts a new series.
+func (o *Organization) AddSeries(name string, now time.Time) (*Series, error) {
+	name, err := validName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	o.Series = append(o.Series, Series{ID: uuid.New(), Name: name, CreatedAt: now})
+	o.UpdatedAt = now
+	return &o.Series[len(o.Series)-1], nil
+}
+
+// HasSeries reports whether the series belongs to the organization.
+func (o *Organization) HasSeries(seriesID uuid.UUID) bool {
+	return o.FindSeries(seriesID) != nil
+}
+
+// FindSeries returns one of the organization's series, or nil.
+func (o *Organization) FindSeries(seriesID uuid.UUID) *Series {
+	for i := range o.Series {
+		if o.Series[i].ID == seriesID {
+			return &o.Series[i]
+		}
+	}
+	return nil
+}
+
+func (o *Organization) staff(userID uuid.UUID) *Staff {
+	for i := range o.Staff {
+		if o.Staff[i].UserID == userID {
+			return &o.Staff[i]
+		}
+	}
+	return nil
+}
+
+func validName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxNameLength {
+		return "", ErrInvalidName
+	}
+	return name, nil
+}
+
+// Repository persists organizations.
+type Repository interface {
+	// Create stores a new organization.
+	Create(ctx context.Context, o *Organization) error
+
+	// GetByID returns an organization, or ErrNotFound.
+	GetByID(ctx context.Context, id uuid.UUID) (*Organization, error)
+
+	// GetBySeries returns the organization running a series, or ErrNotFound.
+	GetBySeries(ctx context.Context, seriesID uuid.UUID) (*Organization, error)
+
+	// Update replaces an existing organization.
+	Update(ctx context.Context, o *Organization) error
+}
@@ -0,0 +1,74 @@
+package organization
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	o, err := New("  Friday Night Esports ", uuid.New())
+	require.NoError(t, err)
+	require.Equal(t, "Friday Night Esports", o.Name)
+
+	_, err = New(" ", uuid.New())
+	require.ErrorIs(t, err, ErrInvalidName)
+	_, err = New(strings.Repeat("a", MaxNameLength+1), uuid.New())
+	require.ErrorIs(t, err, ErrInvalidName)
+}
+
+func TestOrganization_Staff(t *testing.T) {
+	t.Parallel()
+
+	owner, admin, organizer, outsider := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	o, err := New("FNE", owner)
+	require.NoError(t, err)
+	now := time.Date(2026, 5, 1, 20, 0, 0, 0, time.UTC)
+
+	require.ErrorIs(t, o.AddStaff(admin, "caster", now), ErrInvalidRole)
+	require.ErrorIs(t, o.AddStaff(owner, RoleAdmin, now), ErrOwnerIsStaff)
+	require.NoError(t, o.AddStaff(admin, RoleAdmin, now))
+	require.NoError(t, o.AddStaff(organizer, RoleOrganizer, now))
+	require.ErrorIs(t, o.AddStaff(organizer, RoleAdmin, now), ErrAlreadyStaff)
+
+	require.True(t, o.IsAdmin(owner))
+	require.True(t, o.IsAdmin(admin))
+	require.False(t, o.IsAdmin(organizer))
+	require.True(t, o.CanOrganize(owner))
+	require.True(t, o.CanOrganize(admin))
+	require.True(t, o.CanOrganize(organizer))
+	require.False(t, o.CanOrganize(outsider))
+
+	require.ErrorIs(t, o.RemoveStaff(owner, now), ErrOwnerIsStaff)
+	require.ErrorIs(t, o.RemoveStaff(outsider, now), ErrNotStaff)
+	require.NoError(t, o.RemoveStaff(organizer, now))
+	require.False(t, o.CanOrganize(organizer))
+
+	for len(o.Staff) < MaxStaff {
+		require.NoError(t, o.AddStaff(uuid.New(), RoleOrganizer, now))
+	}
+	require.ErrorIs(t, o.AddStaff(outsider, RoleOrganizer, now), ErrTooManyStaff)
+}
+
+func TestOrganization_AddSeries(t *testing.T) {
+	t.Parallel()
+
+	o, err := New("FNE", uuid.New())
+	require.NoError(t, err)
+	now := time.Date(2026, 5, 1, 20, 0, 0, 0, time.UTC)
+
+	s, err := o.AddSeries(" Weekly Cup ", now)
+	require.NoError(t, err)
+	require.Equal(t, "Weekly Cup", s.Name)
+	require.True(t, o.HasSeries(s.ID))
+	require.False(t, o.HasSeries(uuid.New()))
+
+	_, err = o.AddSeries("", now)
+	require.ErrorIs(t, err, ErrInvalidName)
+	require.Len(t, o.Series, 1)
+}
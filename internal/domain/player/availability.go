@@ -0,0 +1,187 @@
+package player
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+)
+
+const (
+	// MaxAvailabilityWindows caps how many weekly windows a player can list.
+	MaxAvailabilityWindows = 28
+
+	minutesPerDay  = 24 * 60
+	minutesPerWeek = 7 * minutesPerDay
+)
+
+var (
+	ErrInvalidTimezone            = errors.New("availability timezone must be an IANA time zone name")
+	ErrInvalidAvailabilityWindow  = errors.New("availability windows need a day from 0 (Sunday) to 6 and HH:MM start and end times, with the end after the start")
+	ErrTooManyAvailabilityWindows = errors.New("availability cannot have more than 28 windows")
+)
+
+// AvailabilityWindow is a weekly time range. Windows cannot cross midnight;
+// "24:00" ends one at the end of the day.
+type AvailabilityWindow struct {
+	Day   time.Weekday `bson:"day" json:"day"`     // 0 is Sunday
+	Start string       `bson:"start" json:"start"` // HH:MM
+	End   string       `bson:"end" json:"end"`     // HH:MM
+}
+
+// Availability is when a player is usually free to play, in their time zone.
+type Availability struct {
+	Timezone string               `bson:"timezone" json:"timezone"` // e.g. "America/Mexico_City"
+	Windows  []AvailabilityWindow `bson:"windows" json:"windows"`
+}
+
+// Validate checks the time zone and windows.
+func (a *Availability) Validate() error {
+	if _, err := time.LoadLocation(a.Timezone); err != nil || a.Timezone == "" {
+		return ErrInvalidTimezone
+	}
+	if len(a.Windows) > MaxAvailabilityWindows {
+		return ErrTooManyAvailabilityWindows
+	}
+	for _, w := range a.Windows {
+		if _, _, err := w.minutes(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SetAvailability replaces the player's availability; nil clears it.
+func (p *Player) SetAvailability(a *Availability) error {
+	if a != nil {
+		if err := a.Validate(); err != nil {
+			return err
+		}
+	}
+	p.Availability = a
+	p.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// AvailabilityOverlap returns the weekly windows, in UTC, when both players
+// are available, and their total length in minutes. Time zone offsets are
+// taken at the given time, so the result follows daylight saving changes.
+func AvailabilityOverlap(a, b *Availability, at time.Time) ([]AvailabilityWindow, int) {
+	if a == nil || b == nil {
+		return []AvailabilityWindow{}, 0
+	}
+
+	var overlap []span
+	x, y := a.weekly(at), b.weekly(at)
+	for i, j := 0, 0; i < len(x) && j < len(y); {
+		start, end := max(x[i].start, y[j].start), min(x[i].end, y[j].end)
+		if start < end {
+			overlap = append(overlap, span{start, end})
+		}
+		if x[i].end < y[j].end {
+			i++
+		} else {
+			j++
+		}
+	}
+
+	windows := []AvailabilityWindow{}
+	total := 0
+	for _, s := range overlap {
+		total += s.end - s.start
+		for start := s.start; start < s.end; {
+			day := start / minutesPerDay
+			end := min(s.end, (day+1)*minutesPerDay)
+			windows = append(windows, AvailabilityWindow{
+				Day:   time.Weekday(day),
+				Start: clock(start - day*minutesPerDay),
+				End:   clock(end - day*minutesPerDay),
+			})
+			start = end
+		}
+	}
+	return windows, total
+}
+
+// span is a range of minutes into a UTC week starting Sunday at midnight.
+type span struct{ start, end int }
+
+// weekly returns the availability as sorted, merged UTC week spans. Windows
+// that wrap around the end of the week are split.
+func (a *Availability) weekly(at time.Time) []span {
+	loc, err := time.LoadLocation(a.Timezone)
+	if err != nil {
+		return nil
+	}
+	_, offset := at.In(loc).Zone()
+	shift := -offset / 60
+
+	var spans []span
+	for _, w := range a.Windows {
+		start, end, err := w.minutes()
+		if err != nil {
+			continue
+		}
+		// The shift is under a day, so adding a week keeps the start positive.
+		length := end - start
+		start = (int(w.Day)*minutesPerDay + start + shift + minutesPerWeek) % minutesPerWeek
+		end = start + length
+		if end > minutesPerWeek {
+			spans = append(spans, span{start, minutesPerWeek}, span{0, end - minutesPerWeek})
+		} else {
+			spans = append(spans, span{start, end})
+		}
+	}
+
+	sort.Slice(spans, func(i, j int) bool { return spans[i].start < spans[j].start })
+	var merged []span
+	for _, s := range spans {
+		if n := len(merged); n > 0 && s.start <= merged[n-1].end {
+			merged[n-1].end = max(merged[n-1].end, s.end)
+			continue
+		}
+		merged = append(merged, s)
+	}
+	return merged
+}
+
+// minutes returns the window's start and end as minutes into its day.
+func (w AvailabilityWindow) minutes() (int, int, error) {
+	if w.Day < time.Sunday || w.Day > time.Saturday {
+		return 0, 0, ErrInvalidAvailabilityWindow
+	}
+	start, err := parseClock(w.Start)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parseClock(w.End)
+	if err != nil {
+		return 0, 0, err
+	}
+	if end <= start {
+		return 0, 0, ErrInvalidAvailabilityWindow
+	}
+	return start, end, nil
+}
+
+func parseClock(value string) (int, error) {
+	if len(value) != 5 || value[2] != ':' {
+		return 0, ErrInvalidAvailabilityWindow
+	}
+	digits := value[:2] + value[3:]
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return 0, ErrInvalidAvailabilityWindow
+		}
+	}
+	h := int(digits[0]-'0')*10 + int(digits[1]-'0')
+	m := int(digits[2]-'0')*10 + int(digits[3]-'0')
+	if m > 59 || h > 24 || (h == 24 && m != 0) {
+		return 0, ErrInvalidAvailabilityWindow
+	}
+	return h*60 + m, nil
+}
+
+func clock(minutes int) string {
+	return fmt.Sprintf("%02d:%02d", minutes/60, minutes%60)
+}
@@ -0,0 +1,85 @@
+package player
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAvailability_Validate(t *testing.T) {
+	t.Parallel()
+
+	window := func(day time.Weekday, start, end string) []AvailabilityWindow {
+		return []AvailabilityWindow{{Day: day, Start: start, End: end}}
+	}
+
+	tests := []struct {
+		name string
+		a    Availability
+		err  error
+	}{
+		{name: "valid", a: Availability{Timezone: "America/Mexico_City", Windows: window(time.Friday, "18:00", "24:00")}},
+		{name: "no windows", a: Availability{Timezone: "UTC"}},
+		{name: "missing timezone", a: Availability{Windows: window(time.Friday, "18:00", "22:00")}, err: ErrInvalidTimezone},
+		{name: "unknown timezone", a: Availability{Timezone: "Mars/Olympus"}, err: ErrInvalidTimezone},
+		{name: "end before start", a: Availability{Timezone: "UTC", Windows: window(time.Friday, "22:00", "18:00")}, err: ErrInvalidAvailabilityWindow},
+		{name: "bad clock", a: Availability{Timezone: "UTC", Windows: window(time.Friday, "+1:00", "18:00")}, err: ErrInvalidAvailabilityWindow},
+		{name: "past midnight", a: Availability{Timezone: "UTC", Windows: window(time.Friday, "18:00", "24:30")}, err: ErrInvalidAvailabilityWindow},
+		{name: "bad day", a: Availability{Timezone: "UTC", Windows: window(7, "18:00", "22:00")}, err: ErrInvalidAvailabilityWindow},
+		{name: "too many windows", a: Availability{Timezone: "UTC", Windows: make([]AvailabilityWindow, MaxAvailabilityWindows+1)}, err: ErrTooManyAvailabilityWindows},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.a.Validate()
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestAvailabilityOverlap(t *testing.T) {
+	t.Parallel()
+
+	// Mexico City is UTC-6 all year; Madrid is UTC+2 in July.
+	at := time.Date(2026, 7, 1, 12, 0, 0, 0, time.UTC)
+	mexico := &Availability{Timezone: "America/Mexico_City", Windows: []AvailabilityWindow{
+		{Day: time.Friday, Start: "18:00", End: "23:00"},   // Sat 00:00-05:00 UTC
+		{Day: time.Saturday, Start: "10:00", End: "14:00"}, // Sat 16:00-20:00 UTC
+	}}
+	madrid := &Availability{Timezone: "Europe/Madrid", Windows: []AvailabilityWindow{
+		{Day: time.Saturday, Start: "05:00", End: "09:00"}, // Sat 03:00-07:00 UTC
+		{Day: time.Saturday, Start: "19:00", End: "21:00"}, // Sat 17:00-19:00 UTC
+	}}
+
+	windows, minutes := AvailabilityOverlap(mexico, madrid, at)
+	assert.Equal(t, []AvailabilityWindow{
+		{Day: time.Saturday, Start: "03:00", End: "05:00"},
+		{Day: time.Saturday, Start: "17:00", End: "19:00"},
+	}, windows)
+	assert.Equal(t, 240, minutes)
+
+	// Windows wrapping around the end of the UTC week are split.
+	tokyo := &Availability{Timezone: "Asia/Tokyo", Windows: []AvailabilityWindow{{Day: time.Sunday, Start: "06:00", End: "10:00"}}} // Sat 21:00 - Sun 01:00 UTC
+	utc := &Availability{Timezone: "UTC", Windows: []AvailabilityWindow{
+		{Day: time.Saturday, Start: "22:00", End: "24:00"},
+		{Day: time.Sunday, Start: "00:00", End: "00:30"},
+	}}
+	windows, minutes = AvailabilityOverlap(tokyo, utc, at)
+	assert.Equal(t, []AvailabilityWindow{
+		{Day: time.Sunday, Start: "00:00", End: "00:30"},
+		{Day: time.Saturday, Start: "22:00", End: "24:00"},
+	}, windows)
+	assert.Equal(t, 150, minutes)
+
+	windows, minutes = AvailabilityOverlap(mexico, nil, at)
+	assert.Empty(t, windows)
+	assert.Zero(t, minutes)
+}
@@ -0,0 +1,28 @@
+package player
+
+// Averages are a player's per-match stat averages in a game.
+type Averages struct {
+	MatchesPlayed int     `json:"matches_played"`
+	Kills         float64 `json:"kills"`
+	Damage        float64 `json:"damage"`
+	Assists       float64 `json:"assists"`
+	Deaths        float64 `json:"deaths"`
+	Downs         float64 `json:"downs"`
+}
+
+// Averages returns the per-match averages of the accumulated match totals.
+// A player without matches averages zero everywhere.
+func (ps *PlayerStats) Averages() Averages {
+	avg := Averages{MatchesPlayed: ps.MatchesPlayed}
+	if ps.MatchesPlayed == 0 {
+		return avg
+	}
+
+	n := float64(ps.MatchesPlayed)
+	avg.Kills = ps.GetStatAsFloat("total_kills") / n
+	avg.Damage = ps.GetStatAsFloat("total_damage") / n
+	avg.Assists = ps.GetStatAsFloat("total_assists") / n
+	avg.Deaths = ps.GetStatAsFloat("total_deaths") / n
+	avg.Downs = ps.GetStatAsFloat("total_downs") / n
+	return avg
+}
@@ -0,0 +1,29 @@
+package player
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPlayerStatsAverages(t *testing.T) {
+	t.Parallel()
+
+	ps := &PlayerStats{
+		MatchesPlayed: 4,
+		Stats: map[string]interface{}{
+			"total_kills":  10,
+			"total_damage": 3000.0,
+			"total_deaths": int64(8),
+		},
+	}
+
+	avg := ps.Averages()
+	require.Equal(t, 4, avg.MatchesPlayed)
+	require.InDelta(t, 2.5, avg.Kills, 0.0001)
+	require.InDelta(t, 750, avg.Damage, 0.0001)
+	require.InDelta(t, 2, avg.Deaths, 0.0001)
+	require.Zero(t, avg.Assists)
+
+	require.Equal(t, Averages{}, (&PlayerStats{}).Averages())
+}
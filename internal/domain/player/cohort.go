@@ -0,0 +1,94 @@
+package player
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// CohortWindowWeeks is how many signup weeks a cohort report covers.
+const CohortWindowWeeks = 26
+
+// ErrCohortReportNotFound is returned when no cohort report has been generated yet.
+var ErrCohortReportNotFound = errors.New("cohort report not found")
+
+// WeekStart returns midnight UTC on the Monday of t's week.
+func WeekStart(t time.Time) time.Time {
+	t = t.UTC()
+	daysSinceMonday := (int(t.Weekday()) + 6) % 7
+	return time.Date(t.Year(), t.Month(), t.Day()-daysSinceMonday, 0, 0, 0, 0, time.UTC)
+}
+
+// CohortWeek is the share of a cohort active a given number of weeks after signup.
+type CohortWeek struct {
+	Offset int     `bson:"offset" json:"offset"`
+	Active int     `bson:"active" json:"active"`
+	Rate   float64 `bson:"rate" json:"rate"`
+}
+
+// Cohort groups players by signup week and tracks how many played in each later week.
+type Cohort struct {
+	Week      time.Time    `bson:"week" json:"week"`
+	Players   int          `bson:"players" json:"players"`
+	Retention []CohortWeek `bson:"retention" json:"retention"`
+}
+
+// CohortReport is a generated set of weekly signup cohorts.
+type CohortReport struct {
+	ID          uuid.UUID `bson:"_id" json:"id"`
+	GeneratedAt time.Time `bson:"generated_at" json:"generated_at"`
+	Cohorts     []Cohort  `bson:"cohorts" json:"cohorts"`
+}
+
+// BuildCohorts groups players by signup week and counts, for each week since
+// signup up to the current week, how many of them were active. activeWeeks
+// holds the week starts in which each player played at least one match.
+func BuildCohorts(signups map[uuid.UUID]time.Time, activeWeeks map[uuid.UUID][]time.Time, now time.Time) []Cohort {
+	const week = 7 * 24 * time.Hour
+	current := WeekStart(now)
+
+	members := make(map[time.Time][]uuid.UUID)
+	for id, signedUp := range signups {
+		w := WeekStart(signedUp)
+		if w.After(current) {
+			continue
+		}
+		members[w] = append(members[w], id)
+	}
+
+	cohorts := make([]Cohort, 0, len(members))
+	for w, ids := range members {
+		offsets := int(current.Sub(w)/week) + 1
+		active := make([]int, offsets)
+
+		for _, id := range ids {
+			seen := make(map[int]bool)
+			for _, played := range activeWeeks[id] {
+				offset := int(WeekStart(played).Sub(w) / week)
+				if offset < 0 || offset >= offsets || seen[offset] {
+					continue
+				}
+				seen[offset] = true
+				active[offset]++
+			}
+		}
+
+		retention := make([]CohortWeek, offsets)
+		for i, n := range active {
+			retention[i] = CohortWeek{Offset: i, Active: n, Rate: float64(n) / float64(len(ids))}
+		}
+		cohorts = append(cohorts, Cohort{Week: w, Players: len(ids), Retention: retention})
+	}
+
+	sort.Slice(cohorts, func(i, j int) bool { return cohorts[i].Week.Before(cohorts[j].Week) })
+	return cohorts
+}
+
+// CohortRepository persists generated cohort reports.
+type CohortRepository interface {
+	Save(ctx context.Context, report *CohortReport) error
+	GetLatest(ctx context.Context) (*CohortReport, error)
+}
@@ -0,0 +1,59 @@
+package player
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWeekStart(t *testing.T) {
+	t.Parallel()
+
+	// 2026-10-15 is a Thursday.
+	thursday := time.Date(2026, 10, 15, 18, 30, 0, 0, time.UTC)
+	monday := time.Date(2026, 10, 12, 0, 0, 0, 0, time.UTC)
+
+	require.Equal(t, monday, WeekStart(thursday))
+	require.Equal(t, monday, WeekStart(monday))
+	require.Equal(t, monday, WeekStart(time.Date(2026, 10, 18, 23, 59, 0, 0, time.UTC)))
+}
+
+func TestBuildCohorts(t *testing.T) {
+	t.Parallel()
+
+	week0 := time.Date(2026, 9, 28, 0, 0, 0, 0, time.UTC)
+	week1 := week0.AddDate(0, 0, 7)
+	week2 := week0.AddDate(0, 0, 14)
+	now := week2.Add(36 * time.Hour)
+
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	signups := map[uuid.UUID]time.Time{
+		a: week0.Add(2 * time.Hour),
+		b: week0.Add(72 * time.Hour),
+		c: week1.Add(time.Hour),
+	}
+	activeWeeks := map[uuid.UUID][]time.Time{
+		a: {week0, week1, week2},
+		b: {week0, week0.Add(time.Hour), week2},
+		c: {week2},
+	}
+
+	cohorts := BuildCohorts(signups, activeWeeks, now)
+	require.Len(t, cohorts, 2)
+
+	require.Equal(t, week0, cohorts[0].Week)
+	require.Equal(t, 2, cohorts[0].Players)
+	require.Equal(t, []CohortWeek{
+		{Offset: 0, Active: 2, Rate: 1},
+		{Offset: 1, Active: 1, Rate: 0.5},
+		{Offset: 2, Active: 2, Rate: 1},
+	}, cohorts[0].Retention)
+
+	require.Equal(t, week1, cohorts[1].Week)
+	require.Equal(t, []CohortWeek{
+		{Offset: 0, Active: 0, Rate: 0},
+		{Offset: 1, Active: 1, Rate: 1},
+	}, cohorts[1].Retention)
+}
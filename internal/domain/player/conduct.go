@@ -0,0 +1,142 @@
+package player
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// ConductKind is the kind of a conduct record.
+type ConductKind string
+
+const (
+	ConductReport      ConductKind = "report"       // An organizer report, e.g. for toxicity or cheating suspicion
+	ConductNoShow      ConductKind = "no_show"      // The player did not show up for a scheduled match
+	ConductDisputeLost ConductKind = "dispute_lost" // A match report by the player was rejected or unverified
+)
+
+const (
+	// MaxConductScore is the score of a player without conduct records.
+	MaxConductScore = 100.0
+	// MaxHonorOffset caps how many penalty points honors can make up for.
+	MaxHonorOffset = 20.0
+	// DefaultConductHalfLife is how long it takes a record's penalty to halve.
+	DefaultConductHalfLife = 90 * 24 * time.Hour
+	// MaxConductNoteLength caps a conduct record's note, in characters.
+	MaxConductNoteLength = 500
+)
+
+var (
+	ErrInvalidConductKind  = errors.New("conduct kind must be report, no_show or dispute_lost")
+	ErrConductNoteTooLong  = errors.New("conduct note cannot exceed 500 characters")
+	ErrInvalidConductScore = errors.New("minimum conduct score must be between 0 and 100")
+)
+
+// IsValid checks if the conduct kind is valid.
+func (k ConductKind) IsValid() bool {
+	switch k {
+	case ConductReport, ConductNoShow, ConductDisputeLost:
+		return true
+	}
+	return false
+}
+
+// Penalty is how many points a fresh record of this kind takes off the
+// conduct score.
+func (k ConductKind) Penalty() float64 {
+	switch k {
+	case ConductReport:
+		return 5
+	case ConductNoShow:
+		return 10
+	case ConductDisputeLost:
+		return 8
+	}
+	return 0
+}
+
+// ConductRecord is a mark against a player's conduct. RecordedBy is the
+// organizer or admin who reported the player, or the admin whose review of
+// a match report made it a lost dispute.
+type ConductRecord struct {
+	ID           uuid.UUID   `bson:"_id" json:"id"`
+	PlayerID     uuid.UUID   `bson:"player_id" json:"player_id"`
+	Kind         ConductKind `bson:"kind" json:"kind"`
+	TournamentID *uuid.UUID  `bson:"tournament_id,omitempty" json:"tournament_id,omitempty"`
+	MatchID      *uuid.UUID  `bson:"match_id,omitempty" json:"match_id,omitempty"`
+	Note         string      `bson:"note,omitempty" json:"note,omitempty"`
+	RecordedBy   uuid.UUID   `bson:"recorded_by" json:"recorded_by"`
+	CreatedAt    time.Time   `bson:"created_at" json:"created_at"`
+}
+
+// NewConductRecord creates a conduct record for playerID.
+func NewConductRecord(playerID uuid.UUID, kind ConductKind, note string, recordedBy uuid.UUID, now time.Time) (*ConductRecord, error) {
+	if !kind.IsValid() {
+		return nil, ErrInvalidConductKind
+	}
+	note = strings.TrimSpace(note)
+	if utf8.RuneCountInString(note) > MaxConductNoteLength {
+		return nil, ErrConductNoteTooLong
+	}
+
+	return &ConductRecord{
+		ID:         uuid.New(),
+		PlayerID:   playerID,
+		Kind:       kind,
+		Note:       note,
+		RecordedBy: recordedBy,
+		CreatedAt:  now.UTC(),
+	}, nil
+}
+
+// ValidateMinConductScore checks a tournament's minimum conduct score.
+func ValidateMinConductScore(score float64) error {
+	if score < 0 || score > MaxConductScore {
+		return ErrInvalidConductScore
+	}
+	return nil
+}
+
+// ConductPolicy turns conduct records and honors into a conduct score.
+type ConductPolicy struct {
+	HalfLife  time.Duration // How long it takes a record's penalty to halve; zero uses DefaultConductHalfLife
+	MVPWeight float64       // Weight of MVP votes in the honors offset, see Honors.Sportsmanship
+}
+
+// Score computes a player's conduct score, from 0 to MaxConductScore. Each
+// record takes its kind's penalty off, decaying by half every HalfLife.
+// Honors make up for penalty points, up to MaxHonorOffset, but never lift
+// the score above MaxConductScore.
+func (p ConductPolicy) Score(records []*ConductRecord, honors Honors, now time.Time) float64 {
+	halfLife := p.HalfLife
+	if halfLife <= 0 {
+		halfLife = DefaultConductHalfLife
+	}
+
+	var penalty float64
+	for _, r := range records {
+		age := now.Sub(r.CreatedAt)
+		if age < 0 {
+			age = 0
+		}
+		penalty += r.Kind.Penalty() * math.Pow(0.5, float64(age)/float64(halfLife))
+	}
+	penalty -= math.Min(honors.Sportsmanship(p.MVPWeight), MaxHonorOffset)
+
+	score := math.Max(0, math.Min(MaxConductScore, MaxConductScore-penalty))
+	return math.Round(score*10) / 10
+}
+
+// ConductRepository stores conduct records.
+type ConductRepository interface {
+	// Create stores a new conduct record.
+	Create(ctx context.Context, record *ConductRecord) error
+
+	// ListByPlayers retrieves the records of the given players, newest first.
+	ListByPlayers(ctx context.Context, playerIDs []uuid.UUID) ([]*ConductRecord, error)
+}
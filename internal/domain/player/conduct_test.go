@@ -0,0 +1,79 @@
+package player
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewConductRecord(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		kind ConductKind
+		note string
+		err  error
+	}{
+		{name: "report", kind: ConductReport, note: "  abusive voice chat  "},
+		{name: "no show without note", kind: ConductNoShow},
+		{name: "unknown kind", kind: "toxic", err: ErrInvalidConductKind},
+		{name: "note at limit", kind: ConductReport, note: strings.Repeat("é", MaxConductNoteLength)},
+		{name: "note too long", kind: ConductReport, note: strings.Repeat("a", MaxConductNoteLength+1), err: ErrConductNoteTooLong},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := NewConductRecord(uuid.New(), tc.kind, tc.note, uuid.New(), time.Now())
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.kind, r.Kind)
+			require.Equal(t, strings.TrimSpace(tc.note), r.Note)
+		})
+	}
+}
+
+func TestConductPolicy_Score(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	policy := ConductPolicy{HalfLife: 30 * 24 * time.Hour, MVPWeight: 2}
+	record := func(kind ConductKind, age time.Duration) *ConductRecord {
+		return &ConductRecord{Kind: kind, CreatedAt: now.Add(-age)}
+	}
+
+	tests := []struct {
+		name     string
+		records  []*ConductRecord
+		honors   Honors
+		expected float64
+	}{
+		{name: "clean", expected: 100},
+		{name: "fresh records", records: []*ConductRecord{record(ConductNoShow, 0), record(ConductReport, 0)}, expected: 85},
+		{name: "decayed by one half-life", records: []*ConductRecord{record(ConductNoShow, 30*24*time.Hour)}, expected: 95},
+		{name: "honors offset penalties", records: []*ConductRecord{record(ConductNoShow, 0)}, honors: Honors{MVPVotes: 1, Commends: 3}, expected: 95},
+		{name: "honors offset is capped", records: []*ConductRecord{record(ConductNoShow, 0), record(ConductNoShow, 0), record(ConductNoShow, 0)}, honors: Honors{Commends: 50}, expected: 90},
+		{name: "honors never exceed the maximum", honors: Honors{Commends: 5}, expected: 100},
+		{name: "floored at zero", records: []*ConductRecord{
+			record(ConductNoShow, 0), record(ConductNoShow, 0), record(ConductNoShow, 0), record(ConductNoShow, 0), record(ConductNoShow, 0),
+			record(ConductNoShow, 0), record(ConductNoShow, 0), record(ConductNoShow, 0), record(ConductNoShow, 0), record(ConductNoShow, 0),
+			record(ConductNoShow, 0),
+		}, expected: 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			require.InDelta(t, tc.expected, policy.Score(tc.records, tc.honors, now), 0.0001)
+		})
+	}
+}
@@ -0,0 +1,70 @@
+package player
+
+import "math"
+
+const (
+	// ConsistencyWindow is the number of most recent verified matches used to measure consistency.
+	ConsistencyWindow = 20
+
+	// MinConsistencySamples is the number of matches needed before consistency is measured.
+	// Below it calculators fall back to BaselineConsistency.
+	MinConsistencySamples = 5
+
+	// BaselineConsistency is the consistency score assumed without enough match history.
+	BaselineConsistency = 70.0
+)
+
+// MatchSample is a single match's contribution to consistency.
+type MatchSample struct {
+	Kills     float64
+	Placement float64
+}
+
+// ConsistencyScore returns a 0-100 score from the coefficient of variation of
+// kills and placement across samples, where 100 means identical performance
+// every match. It returns false when there are too few samples.
+func ConsistencyScore(samples []MatchSample) (float64, bool) {
+	if len(samples) < MinConsistencySamples {
+		return 0, false
+	}
+
+	kills := make([]float64, len(samples))
+	placements := make([]float64, len(samples))
+	for i, s := range samples {
+		kills[i] = s.Kills
+		placements[i] = s.Placement
+	}
+
+	cv := (coefficientOfVariation(kills) + coefficientOfVariation(placements)) / 2
+	return math.Max(0, 1-cv) * 100, true
+}
+
+// Consistency returns the measured consistency score, or BaselineConsistency
+// when not enough verified matches have been recorded yet.
+func (ps *PlayerStats) Consistency() float64 {
+	if ps.ConsistencySamples < MinConsistencySamples {
+		return BaselineConsistency
+	}
+	return ps.ConsistencyScore
+}
+
+// coefficientOfVariation returns the population standard deviation divided by the mean.
+// A zero mean yields 0 so an all-zero series counts as perfectly consistent.
+func coefficientOfVariation(values []float64) float64 {
+	var sum float64
+	for _, v := range values {
+		sum += v
+	}
+	mean := sum / float64(len(values))
+	if mean == 0 {
+		return 0
+	}
+
+	var variance float64
+	for _, v := range values {
+		variance += (v - mean) * (v - mean)
+	}
+	variance /= float64(len(values))
+
+	return math.Sqrt(variance) / mean
+}
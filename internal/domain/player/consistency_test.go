@@ -0,0 +1,35 @@
+package player
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestConsistencyScore(t *testing.T) {
+	t.Parallel()
+
+	steady := []MatchSample{{5, 3}, {5, 3}, {5, 3}, {5, 3}, {5, 3}}
+	score, ok := ConsistencyScore(steady)
+	require.True(t, ok)
+	require.InDelta(t, 100, score, 0.0001)
+
+	// Kills CV is 2 and placement CV is 0, averaging to a CV of 1.
+	swingy := []MatchSample{{0, 1}, {0, 1}, {0, 1}, {0, 1}, {10, 1}}
+	score, ok = ConsistencyScore(swingy)
+	require.True(t, ok)
+	require.InDelta(t, 0, score, 0.0001)
+
+	_, ok = ConsistencyScore(steady[:MinConsistencySamples-1])
+	require.False(t, ok)
+}
+
+func TestPlayerStats_Consistency(t *testing.T) {
+	t.Parallel()
+
+	ps := &PlayerStats{ConsistencyScore: 92, ConsistencySamples: MinConsistencySamples - 1}
+	require.Equal(t, BaselineConsistency, ps.Consistency())
+
+	ps.ConsistencySamples = MinConsistencySamples
+	require.Equal(t, 92.0, ps.Consistency())
+}
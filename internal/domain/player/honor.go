@@ -0,0 +1,15 @@
+package player
+
+// Honors accumulates the MVP votes and commends a player received from
+// teammates after verified matches.
+type Honors struct {
+	MVPVotes int `bson:"mvp_votes" json:"mvp_votes"`
+	Commends int `bson:"commends" json:"commends"`
+}
+
+// Sportsmanship combines a player's honors into one score: each commend is
+// worth a point and each MVP vote mvpWeight points. A zero weight counts
+// commends only.
+func (h Honors) Sportsmanship(mvpWeight float64) float64 {
+	return float64(h.Commends) + mvpWeight*float64(h.MVPVotes)
+}
@@ -0,0 +1,182 @@
+package player
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SyncProvider is an external system leaderboard snapshots are pushed to.
+type SyncProvider string
+
+const (
+	SyncGoogleSheets SyncProvider = "google_sheets" // Writes rows to a spreadsheet tab using a service account
+	SyncJSONBucket   SyncProvider = "json_bucket"   // PUTs the snapshot as a JSON object to a bucket URL
+	SyncPartnerAPI   SyncProvider = "partner_api"   // POSTs the snapshot to a partner endpoint, signed with HMAC-SHA256
+)
+
+const (
+	// DefaultSyncLimit is how many leaderboard entries are pushed when a sync does not set a limit.
+	DefaultSyncLimit = 100
+
+	// MaxSyncLimit is the most leaderboard entries a sync may push.
+	MaxSyncLimit = 1000
+
+	// MinSyncInterval is the shortest schedule a sync may run on.
+	MinSyncInterval = 5 * time.Minute
+
+	// SyncAlertThreshold is how many consecutive failures trigger an alert.
+	SyncAlertThreshold = 3
+
+	// DefaultSyncSheet is the spreadsheet tab written when a Google Sheets sync does not name one.
+	DefaultSyncSheet = "Leaderboard"
+)
+
+var (
+	ErrLeaderboardSyncNotFound = errors.New("leaderboard sync not found")
+	ErrInvalidLeaderboardSync  = errors.New("invalid leaderboard sync")
+)
+
+// LeaderboardSync configures a scheduled push of one game's leaderboard to
+// an external system.
+type LeaderboardSync struct {
+	ID                  uuid.UUID    `bson:"_id" json:"id"`
+	GameID              uuid.UUID    `bson:"game_id" json:"game_id"`
+	Mode                string       `bson:"mode,omitempty" json:"mode,omitempty"` // Empty syncs the all-modes leaderboard
+	Name                string       `bson:"name" json:"name"`
+	Provider            SyncProvider `bson:"provider" json:"provider"`
+	Endpoint            string       `bson:"endpoint" json:"endpoint"`                 // Spreadsheet ID for Google Sheets, URL otherwise
+	Sheet               string       `bson:"sheet,omitempty" json:"sheet,omitempty"`   // Spreadsheet tab, Google Sheets only
+	Credential          string       `bson:"credential,omitempty" json:"-"`            // Service account key, bearer token or HMAC secret
+	Limit               int          `bson:"limit" json:"limit"`                       // Number of top entries pushed
+	IntervalMinutes     int          `bson:"interval_minutes" json:"interval_minutes"` // How often the sync runs
+	Enabled             bool         `bson:"enabled" json:"enabled"`
+	LastRunAt           *time.Time   `bson:"last_run_at,omitempty" json:"last_run_at,omitempty"`
+	LastSuccessAt       *time.Time   `bson:"last_success_at,omitempty" json:"last_success_at,omitempty"`
+	LastError           string       `bson:"last_error,omitempty" json:"last_error,omitempty"`
+	ConsecutiveFailures int          `bson:"consecutive_failures" json:"consecutive_failures"`
+	CreatedAt           time.Time    `bson:"created_at" json:"created_at"`
+	UpdatedAt           time.Time    `bson:"updated_at" json:"updated_at"`
+}
+
+// Validate checks the sync's provider settings, limit and schedule, and
+// fills in defaults for the limit and spreadsheet tab.
+func (s *LeaderboardSync) Validate() error {
+	if strings.TrimSpace(s.Name) == "" {
+		return fmt.Errorf("%w: name is required", ErrInvalidLeaderboardSync)
+	}
+	if s.GameID == uuid.Nil {
+		return fmt.Errorf("%w: game is required", ErrInvalidLeaderboardSync)
+	}
+
+	switch s.Provider {
+	case SyncGoogleSheets:
+		if strings.TrimSpace(s.Endpoint) == "" {
+			return fmt.Errorf("%w: spreadsheet id is required", ErrInvalidLeaderboardSync)
+		}
+		if s.Credential == "" {
+			return fmt.Errorf("%w: google sheets syncs require a service account key", ErrInvalidLeaderboardSync)
+		}
+		if s.Sheet == "" {
+			s.Sheet = DefaultSyncSheet
+		}
+	case SyncJSONBucket, SyncPartnerAPI:
+		u, err := url.Parse(s.Endpoint)
+		if err != nil || u.Scheme != "https" || u.Host == "" {
+			return fmt.Errorf("%w: endpoint must be an absolute https URL", ErrInvalidLeaderboardSync)
+		}
+		if s.Provider == SyncPartnerAPI && s.Credential == "" {
+			return fmt.Errorf("%w: partner API syncs require a signing secret", ErrInvalidLeaderboardSync)
+		}
+		if s.Sheet != "" {
+			return fmt.Errorf("%w: sheet only applies to google sheets syncs", ErrInvalidLeaderboardSync)
+		}
+	default:
+		return fmt.Errorf("%w: unknown provider %q", ErrInvalidLeaderboardSync, s.Provider)
+	}
+
+	if s.Limit == 0 {
+		s.Limit = DefaultSyncLimit
+	}
+	if s.Limit < 1 || s.Limit > MaxSyncLimit {
+		return fmt.Errorf("%w: limit must be between 1 and %d", ErrInvalidLeaderboardSync, MaxSyncLimit)
+	}
+	if time.Duration(s.IntervalMinutes)*time.Minute < MinSyncInterval {
+		return fmt.Errorf("%w: interval must be at least %d minutes", ErrInvalidLeaderboardSync, int(MinSyncInterval.Minutes()))
+	}
+	return nil
+}
+
+// Due reports whether an enabled sync should run at now.
+func (s *LeaderboardSync) Due(now time.Time) bool {
+	if !s.Enabled {
+		return false
+	}
+	if s.LastRunAt == nil {
+		return true
+	}
+	return !now.Before(s.LastRunAt.Add(time.Duration(s.IntervalMinutes) * time.Minute))
+}
+
+// RecordSuccess marks a successful push and resets the failure streak.
+func (s *LeaderboardSync) RecordSuccess(now time.Time) {
+	s.LastRunAt = &now
+	s.LastSuccessAt = &now
+	s.LastError = ""
+	s.ConsecutiveFailures = 0
+}
+
+// RecordFailure marks a failed push. It reports whether the failure streak
+// just reached SyncAlertThreshold, so each outage is alerted once.
+func (s *LeaderboardSync) RecordFailure(err error, now time.Time) bool {
+	s.LastRunAt = &now
+	s.LastError = err.Error()
+	s.ConsecutiveFailures++
+	return s.ConsecutiveFailures == SyncAlertThreshold
+}
+
+// LeaderboardSnapshotEntry is one ranked player in a pushed snapshot.
+type LeaderboardSnapshotEntry struct {
+	Rank               int       `json:"rank"`
+	PlayerID           uuid.UUID `json:"player_id"`
+	DisplayName        string    `json:"display_name"`
+	RankingScore       float64   `json:"ranking_score"`
+	ConservativeRating float64   `json:"conservative_rating"`
+	Tier               string    `json:"tier"`
+	MatchesPlayed      int       `json:"matches_played"`
+}
+
+// LeaderboardSnapshot is the top of a game's leaderboard at a point in time.
+type LeaderboardSnapshot struct {
+	GameID      uuid.UUID                  `json:"game_id"`
+	GameName    string                     `json:"game_name"`
+	Mode        string                     `json:"mode,omitempty"`
+	GeneratedAt time.Time                  `json:"generated_at"`
+	Entries     []LeaderboardSnapshotEntry `json:"entries"`
+}
+
+// Rows returns the snapshot as a header row followed by one row per entry,
+// for tabular destinations such as spreadsheets.
+func (s *LeaderboardSnapshot) Rows() [][]any {
+	rows := make([][]any, 0, len(s.Entries)+1)
+	rows = append(rows, []any{"rank", "player_id", "display_name", "ranking_score", "conservative_rating", "tier", "matches_played"})
+	for _, e := range s.Entries {
+		rows = append(rows, []any{e.Rank, e.PlayerID.String(), e.DisplayName, e.RankingScore, e.ConservativeRating, e.Tier, e.MatchesPlayed})
+	}
+	return rows
+}
+
+// LeaderboardSyncRepository persists leaderboard sync configurations.
+type LeaderboardSyncRepository interface {
+	Create(ctx context.Context, sync *LeaderboardSync) error
+	GetByID(ctx context.Context, id uuid.UUID) (*LeaderboardSync, error)
+	ListByGame(ctx context.Context, gameID uuid.UUID) ([]*LeaderboardSync, error)
+	ListEnabled(ctx context.Context) ([]*LeaderboardSync, error)
+	Update(ctx context.Context, sync *LeaderboardSync) error
+	Delete(ctx context.Context, id uuid.UUID) error
+}
@@ -0,0 +1,106 @@
+package player
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaderboardSync_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := func(provider SyncProvider) LeaderboardSync {
+		s := LeaderboardSync{
+			GameID:          uuid.New(),
+			Name:            "partner feed",
+			Provider:        provider,
+			Endpoint:        "https://partner.example.com/leaderboard",
+			Credential:      "secret",
+			IntervalMinutes: 15,
+		}
+		if provider == SyncGoogleSheets {
+			s.Endpoint = "1AbCdEf"
+		}
+		return s
+	}
+
+	tests := []struct {
+		name   string
+		modify func(s *LeaderboardSync)
+		valid  bool
+	}{
+		{name: "partner api", modify: func(*LeaderboardSync) {}, valid: true},
+		{name: "bucket without token", modify: func(s *LeaderboardSync) { s.Provider, s.Credential = SyncJSONBucket, "" }, valid: true},
+		{name: "partner without secret", modify: func(s *LeaderboardSync) { s.Credential = "" }},
+		{name: "plain http endpoint", modify: func(s *LeaderboardSync) { s.Endpoint = "http://partner.example.com" }},
+		{name: "unknown provider", modify: func(s *LeaderboardSync) { s.Provider = "ftp" }},
+		{name: "missing name", modify: func(s *LeaderboardSync) { s.Name = " " }},
+		{name: "interval too short", modify: func(s *LeaderboardSync) { s.IntervalMinutes = 1 }},
+		{name: "limit too large", modify: func(s *LeaderboardSync) { s.Limit = MaxSyncLimit + 1 }},
+		{name: "sheet on partner api", modify: func(s *LeaderboardSync) { s.Sheet = "Tab" }},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := valid(SyncPartnerAPI)
+			tc.modify(&s)
+			err := s.Validate()
+			if tc.valid {
+				require.NoError(t, err)
+				require.Equal(t, DefaultSyncLimit, s.Limit)
+			} else {
+				require.ErrorIs(t, err, ErrInvalidLeaderboardSync)
+			}
+		})
+	}
+
+	sheets := valid(SyncGoogleSheets)
+	require.NoError(t, sheets.Validate())
+	require.Equal(t, DefaultSyncSheet, sheets.Sheet)
+}
+
+func TestLeaderboardSync_Schedule(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	s := &LeaderboardSync{IntervalMinutes: 10}
+	require.False(t, s.Due(now))
+
+	s.Enabled = true
+	require.True(t, s.Due(now))
+
+	s.RecordSuccess(now)
+	require.False(t, s.Due(now.Add(9*time.Minute)))
+	require.True(t, s.Due(now.Add(10*time.Minute)))
+
+	failure := errors.New("timeout")
+	for i := 1; i < SyncAlertThreshold; i++ {
+		require.False(t, s.RecordFailure(failure, now))
+	}
+	require.True(t, s.RecordFailure(failure, now))
+	require.False(t, s.RecordFailure(failure, now))
+	require.Equal(t, "timeout", s.LastError)
+
+	s.RecordSuccess(now)
+	require.Zero(t, s.ConsecutiveFailures)
+	require.Empty(t, s.LastError)
+}
+
+func TestLeaderboardSnapshot_Rows(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.New()
+	snapshot := &LeaderboardSnapshot{Entries: []LeaderboardSnapshotEntry{
+		{Rank: 1, PlayerID: id, DisplayName: "ghost", RankingScore: 1500, ConservativeRating: 1400, Tier: "elite", MatchesPlayed: 42},
+	}}
+
+	rows := snapshot.Rows()
+	require.Len(t, rows, 2)
+	require.Equal(t, "rank", rows[0][0])
+	require.Equal(t, []any{1, id.String(), "ghost", 1500.0, 1400.0, "elite", 42}, rows[1])
+}
@@ -0,0 +1,72 @@
+package player
+
+import (
+	"errors"
+
+	"github.com/google/uuid"
+)
+
+// ErrInvalidListSort is returned when a player list sort key is not recognized.
+var ErrInvalidListSort = errors.New("invalid player list sort")
+
+// ListSort selects how an admin player listing is ordered.
+type ListSort string
+
+const (
+	ListSortDisplayName   ListSort = "display_name"
+	ListSortCreatedAt     ListSort = "created_at"
+	ListSortMatchesPlayed ListSort = "matches_played"
+	ListSortRankingScore  ListSort = "ranking_score"
+)
+
+// ParseListSort parses a sort query value. An empty value sorts by display name.
+func ParseListSort(value string) (ListSort, error) {
+	switch s := ListSort(value); s {
+	case "":
+		return ListSortDisplayName, nil
+	case ListSortDisplayName, ListSortCreatedAt, ListSortMatchesPlayed, ListSortRankingScore:
+		return s, nil
+	default:
+		return "", ErrInvalidListSort
+	}
+}
+
+// UsesStats reports whether sorting by s needs the stats summary.
+func (s ListSort) UsesStats() bool {
+	return s == ListSortMatchesPlayed || s == ListSortRankingScore
+}
+
+// ListFilter narrows, orders and pages an admin player listing. Zero values match everything.
+type ListFilter struct {
+	Banned       *bool
+	Region       string
+	Platform     string    // Preferred platform
+	GameID       uuid.UUID // Restricts the stats summary to one game
+	HasStats     bool      // Only players with stats, in GameID if set
+	IncludeStats bool      // Join a StatsSummary onto each entry
+	Sort         ListSort
+	Descending   bool
+	Limit        int64
+	Offset       int64
+}
+
+// Validate checks the filter's platform.
+func (f ListFilter) Validate() error {
+	if f.Platform != "" && !isValidPlatform(f.Platform) {
+		return ErrInvalidPlatform
+	}
+	return nil
+}
+
+// StatsSummary condenses a player's overall stats across games, or in a single game.
+type StatsSummary struct {
+	Games         int     `json:"games"`
+	MatchesPlayed int     `json:"matches_played"`
+	RankingScore  float64 `json:"ranking_score"` // Best across the summarized games
+}
+
+// ListEntry is a player in an admin listing, with their stats summary when requested.
+type ListEntry struct {
+	Player *Player
+	Stats  *StatsSummary
+}
@@ -0,0 +1,47 @@
+package player
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// OrphanedStats is a player stats record no player profile claims. Its fields
+// are kept as stored, since the player ID may not be a valid UUID at all.
+type OrphanedStats struct {
+	ID       string
+	PlayerID string
+	GameID   string
+	Mode     string
+}
+
+// OrphanRepair is how an orphaned stats record is reconciled.
+type OrphanRepair string
+
+const (
+	// OrphanRelink points the stats at the profile their player ID names in a
+	// non-canonical form, such as upper case or braced.
+	OrphanRelink OrphanRepair = "relink"
+	// OrphanDelete removes stats whose player is gone or whose player ID is unreadable.
+	OrphanDelete OrphanRepair = "delete"
+)
+
+// CanonicalPlayerID returns the player ID in the form profiles are stored
+// under, when the stored ID is a valid UUID written some other way.
+func (o OrphanedStats) CanonicalPlayerID() (uuid.UUID, bool) {
+	id, err := uuid.Parse(o.PlayerID)
+	if err != nil || id.String() == o.PlayerID {
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// OrphanedStatsRepository finds and resolves stats without a player profile.
+type OrphanedStatsRepository interface {
+	// FindOrphaned returns up to limit stats records whose player ID matches no profile.
+	FindOrphaned(ctx context.Context, limit int64) ([]OrphanedStats, error)
+	// RelinkPlayer rewrites the player ID of a stats record.
+	RelinkPlayer(ctx context.Context, statsID string, playerID uuid.UUID) error
+	// DeleteOrphaned removes a stats record.
+	DeleteOrphaned(ctx context.Context, statsID string) error
+}
@@ -0,0 +1,41 @@
+package player
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrphanedStats_CanonicalPlayerID(t *testing.T) {
+	t.Parallel()
+
+	id := uuid.MustParse("6f1c2d3e-4a5b-4c6d-8e7f-9a0b1c2d3e4f")
+
+	tests := []struct {
+		name     string
+		playerID string
+		wantOK   bool
+	}{
+		{name: "canonical", playerID: id.String()},
+		{name: "upper case", playerID: "6F1C2D3E-4A5B-4C6D-8E7F-9A0B1C2D3E4F", wantOK: true},
+		{name: "braced", playerID: "{" + id.String() + "}", wantOK: true},
+		{name: "urn", playerID: "urn:uuid:" + id.String(), wantOK: true},
+		{name: "malformed", playerID: "player-42"},
+		{name: "empty", playerID: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := OrphanedStats{PlayerID: tc.playerID}.CanonicalPlayerID()
+			require.Equal(t, tc.wantOK, ok)
+			if tc.wantOK {
+				require.Equal(t, id, got)
+			} else {
+				require.Equal(t, uuid.Nil, got)
+			}
+		})
+	}
+}
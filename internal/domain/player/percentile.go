@@ -0,0 +1,29 @@
+package player
+
+import "math"
+
+// StatPercentile describes where a player's per-match stat average sits among all players of a game.
+type StatPercentile struct {
+	PerMatch   float64 `json:"per_match"`
+	TopPercent float64 `json:"top_percent"`
+}
+
+// TopPercent returns the share of players, in percent, at or above a player
+// given how many players strictly beat them. A result of 8 reads "top 8%".
+func TopPercent(above, total int64) float64 {
+	if total <= 0 {
+		return 100
+	}
+	pct := float64(above+1) / float64(total) * 100
+	return math.Min(math.Ceil(pct), 100)
+}
+
+// IsNumericStat reports whether a stored stat value can be ranked.
+func IsNumericStat(value interface{}) bool {
+	switch value.(type) {
+	case int, int32, int64, float64:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,17 @@
+package player
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTopPercent(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, 1.0, TopPercent(0, 100))
+	require.Equal(t, 8.0, TopPercent(7, 100))
+	require.Equal(t, 100.0, TopPercent(9, 10))
+	require.Equal(t, 34.0, TopPercent(0, 3))
+	require.Equal(t, 100.0, TopPercent(0, 0))
+}
@@ -48,6 +48,23 @@ const (
 	TierBeginner Tier = "beginner"
 )
 
+// Level returns the ordinal position of the tier, from beginner (0) to elite (3).
+// Unknown tiers return -1.
+func (t Tier) Level() int {
+	switch t {
+	case TierBeginner:
+		return 0
+	case TierIntermediate:
+		return 1
+	case TierAdvanced:
+		return 2
+	case TierElite:
+		return 3
+	default:
+		return -1
+	}
+}
+
 // Platform represents gaming platforms.
 type Platform string
 
@@ -83,24 +100,37 @@ type Player struct {
 	Region            string            `bson:"region,omitempty" json:"region,omitempty"`
 	PreferredPlatform string            `bson:"preferred_platform,omitempty" json:"preferred_platform,omitempty"`
 	Language          string            `bson:"language,omitempty" json:"language,omitempty"`
+	HideActivity      bool              `bson:"hide_activity" json:"hide_activity"`                   // Keeps the player out of the public activity feed
+	HideFromBoards    bool              `bson:"hide_from_leaderboards" json:"hide_from_leaderboards"` // Shown anonymously on public leaderboards
 	IsBanned          bool              `bson:"is_banned" json:"is_banned"`
 	BannedAt          *time.Time        `bson:"banned_at,omitempty" json:"banned_at,omitempty"`
+	IsDeactivated     bool              `bson:"is_deactivated" json:"is_deactivated"` // Owner deactivated their account; hidden from leaderboards and search
+	Honors            Honors            `bson:"honors" json:"honors"`                 // MVP votes and commends from teammates
+	Availability      *Availability     `bson:"availability,omitempty" json:"availability,omitempty"`
 	CreatedAt         time.Time         `bson:"created_at" json:"created_at"`
 	UpdatedAt         time.Time         `bson:"updated_at" json:"updated_at"`
 }
 
 // PlayerStats represents a player's statistics for a specific game.
 type PlayerStats struct {
-	ID            uuid.UUID
-	PlayerID      uuid.UUID
-	GameID        uuid.UUID
-	Stats         map[string]interface{} // Flexible stats storage
-	MatchesPlayed int
-	RankingScore  float64
-	Tier          Tier
-	LastMatchAt   *time.Time
-	CreatedAt     time.Time
-	UpdatedAt     time.Time
+	ID                 uuid.UUID
+	PlayerID           uuid.UUID
+	GameID             uuid.UUID
+	Mode               string                 // Game mode slug; empty for stats across all modes
+	SeasonID           uuid.UUID              // Season the stats cover; uuid.Nil for all-time stats
+	Stats              map[string]interface{} // Flexible stats storage
+	RollupSamples      map[string]int         // Matches each average in Stats covers, keyed by stat
+	MatchesPlayed      int
+	RankingScore       float64
+	RatingDeviation    float64 // Uncertainty of RankingScore, shrinks as matches are played
+	Tier               Tier
+	ConsistencyScore   float64 // 0-100, measured over the last ConsistencySamples verified matches
+	ConsistencySamples int
+	Records            PersonalRecords // Personal bests from verified matches
+	LastMatchAt        *time.Time
+	PlayerDeactivated  bool // Owner deactivated their account; kept off leaderboards
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
 }
 
 // NewPlayer creates a new Player instance.
@@ -171,6 +201,31 @@ func (p *Player) SetPlatformID(platform, id string) {
 	p.UpdatedAt = time.Now()
 }
 
+// SetHideActivity sets whether the player is left out of the public activity feed.
+func (p *Player) SetHideActivity(hide bool) {
+	p.HideActivity = hide
+	p.UpdatedAt = time.Now()
+}
+
+// SetHideFromBoards sets whether the player is shown anonymously on public
+// leaderboards. Their stats still count toward everyone else's rank.
+func (p *Player) SetHideFromBoards(hide bool) {
+	p.HideFromBoards = hide
+	p.UpdatedAt = time.Now()
+}
+
+// ShowsPublicActivity reports whether the player's activity may appear in public feeds.
+func (p *Player) ShowsPublicActivity() bool {
+	return !p.HideActivity && !p.IsBanned && !p.IsDeactivated
+}
+
+// SetDeactivated hides or restores the player when their owner deactivates
+// or reactivates their account.
+func (p *Player) SetDeactivated(deactivated bool) {
+	p.IsDeactivated = deactivated
+	p.UpdatedAt = time.Now().UTC()
+}
+
 // Ban marks a player as banned.
 func (p *Player) Ban() {
 	now := time.Now().UTC()
@@ -196,15 +251,16 @@ func (p *Player) GetPlatformID(platform string) (string, bool) {
 // NewPlayerStats creates a new PlayerStats instance.
 func NewPlayerStats(playerID, gameID uuid.UUID) *PlayerStats {
 	return &PlayerStats{
-		ID:            uuid.New(),
-		PlayerID:      playerID,
-		GameID:        gameID,
-		Stats:         make(map[string]interface{}),
-		MatchesPlayed: 0,
-		RankingScore:  0.0,
-		Tier:          TierBeginner,
-		CreatedAt:     time.Now(),
-		UpdatedAt:     time.Now(),
+		ID:              uuid.New(),
+		PlayerID:        playerID,
+		GameID:          gameID,
+		Stats:           make(map[string]interface{}),
+		MatchesPlayed:   0,
+		RankingScore:    0.0,
+		RatingDeviation: InitialRatingDeviation * DefaultScoreRange,
+		Tier:            TierBeginner,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
 	}
 }
 
@@ -214,6 +270,7 @@ func (ps *PlayerStats) UpdateStats(newStats map[string]interface{}) {
 		ps.Stats[key] = value
 	}
 	ps.MatchesPlayed++
+	ps.RatingDeviation = RatingDeviationAfter(ps.MatchesPlayed, DefaultScoreRange)
 	ps.UpdatedAt = time.Now()
 	now := time.Now()
 	ps.LastMatchAt = &now
@@ -0,0 +1,31 @@
+package player
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseListSort(t *testing.T) {
+	t.Parallel()
+
+	sort, err := ParseListSort("")
+	require.NoError(t, err)
+	require.Equal(t, ListSortDisplayName, sort)
+	require.False(t, sort.UsesStats())
+
+	sort, err = ParseListSort("ranking_score")
+	require.NoError(t, err)
+	require.True(t, sort.UsesStats())
+
+	_, err = ParseListSort("kd_ratio")
+	require.ErrorIs(t, err, ErrInvalidListSort)
+}
+
+func TestListFilterValidate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ListFilter{}.Validate())
+	require.NoError(t, ListFilter{Platform: string(PlatformPC)}.Validate())
+	require.ErrorIs(t, ListFilter{Platform: "Dreamcast"}.Validate(), ErrInvalidPlatform)
+}
@@ -0,0 +1,116 @@
+package player
+
+import (
+	"errors"
+	"math"
+	"time"
+)
+
+const (
+	// InitialRatingDeviation is the uncertainty assigned to an unrated player,
+	// as a fraction of the score range of the game's ranking calculator.
+	InitialRatingDeviation = 0.35
+
+	// MinRatingDeviation is the floor the uncertainty shrinks to with play, as
+	// a fraction of the calculator's score range.
+	MinRatingDeviation = 0.03
+
+	// DefaultScoreRange is the score range assumed for a deviation before the
+	// ranking is calculated for its game, matching the 0-1000 Warzone scale.
+	DefaultScoreRange = 1000.0
+
+	// ProvisionalMatches is the number of rated matches a player needs before
+	// their rating is no longer considered provisional.
+	ProvisionalMatches = 10
+
+	// RankDeltaWindow is how far back leaderboard rank movement is measured.
+	RankDeltaWindow = 24 * time.Hour
+
+	// confidenceZ is the z-score for a 95% confidence interval.
+	confidenceZ = 1.96
+)
+
+// ErrInvalidLeaderboardSort is returned when a leaderboard sort key is not recognized.
+var ErrInvalidLeaderboardSort = errors.New("invalid leaderboard sort")
+
+// LeaderboardSort selects how leaderboard entries are ordered.
+type LeaderboardSort string
+
+const (
+	// LeaderboardSortScore orders by ranking score.
+	LeaderboardSortScore LeaderboardSort = "score"
+
+	// LeaderboardSortConservative orders by ranking score minus rating deviation,
+	// so players with few matches do not outrank proven players.
+	LeaderboardSortConservative LeaderboardSort = "conservative"
+)
+
+// ParseLeaderboardSort parses a sort query value. An empty value sorts by score.
+func ParseLeaderboardSort(value string) (LeaderboardSort, error) {
+	switch LeaderboardSort(value) {
+	case "", LeaderboardSortScore:
+		return LeaderboardSortScore, nil
+	case LeaderboardSortConservative:
+		return LeaderboardSortConservative, nil
+	default:
+		return "", ErrInvalidLeaderboardSort
+	}
+}
+
+// ErrInvalidRankingMode is returned when a leaderboard ranking mode is not recognized.
+var ErrInvalidRankingMode = errors.New("invalid ranking mode")
+
+// RankingMode selects how leaderboard positions are numbered. Entries are
+// always ordered deterministically: equal ratings fall back to more matches
+// played, then the earlier last match, then the player ID.
+type RankingMode string
+
+const (
+	// RankingOrdinal numbers every entry by its position (1, 2, 3, 4).
+	RankingOrdinal RankingMode = "ordinal"
+
+	// RankingCompetition gives entries with equal ratings the same rank and
+	// skips the positions they share (1, 2, 2, 4).
+	RankingCompetition RankingMode = "competition"
+)
+
+// ParseRankingMode parses a ranking query value. An empty value ranks by position.
+func ParseRankingMode(value string) (RankingMode, error) {
+	switch RankingMode(value) {
+	case "", RankingOrdinal:
+		return RankingOrdinal, nil
+	case RankingCompetition:
+		return RankingCompetition, nil
+	default:
+		return "", ErrInvalidRankingMode
+	}
+}
+
+// RatingDeviationAfter returns the rating deviation after a number of rated
+// matches, in the units of a calculator whose scores span scoreRange. Each
+// match shrinks the deviation as 1/RD² grows by 1/RD₀², down to
+// MinRatingDeviation of the range.
+func RatingDeviationAfter(matchesPlayed int, scoreRange float64) float64 {
+	if matchesPlayed < 0 {
+		matchesPlayed = 0
+	}
+	rd := InitialRatingDeviation / math.Sqrt(1+float64(matchesPlayed))
+	return math.Max(rd, MinRatingDeviation) * scoreRange
+}
+
+// ConfidenceInterval returns the 95% confidence interval around a ranking score.
+func ConfidenceInterval(score, deviation float64) (low, high float64) {
+	margin := confidenceZ * deviation
+	return score - margin, score + margin
+}
+
+// ConservativeRating returns the ranking score minus its deviation.
+func ConservativeRating(score, deviation float64) float64 {
+	return score - deviation
+}
+
+// IsProvisional reports whether a rating based on matchesPlayed rated matches
+// is still provisional.
+func IsProvisional(matchesPlayed int) bool {
+	return matchesPlayed < ProvisionalMatches
+}
@@ -0,0 +1,78 @@
+package player
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRatingDeviationAfter(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		matchesPlayed int
+		scoreRange    float64
+		expected      float64
+	}{
+		{name: "unrated", matchesPlayed: 0, scoreRange: 1000, expected: 350},
+		{name: "negative treated as unrated", matchesPlayed: -3, scoreRange: 1000, expected: 350},
+		{name: "three matches", matchesPlayed: 3, scoreRange: 1000, expected: 175},
+		{name: "floors at minimum", matchesPlayed: 10000, scoreRange: 1000, expected: 30},
+		{name: "scales with a narrower range", matchesPlayed: 3, scoreRange: 300, expected: 52.5},
+		{name: "floor scales with the range", matchesPlayed: 10000, scoreRange: 300, expected: 9},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.InDelta(t, tc.expected, RatingDeviationAfter(tc.matchesPlayed, tc.scoreRange), 0.0001)
+		})
+	}
+}
+
+func TestConfidenceInterval(t *testing.T) {
+	t.Parallel()
+
+	low, high := ConfidenceInterval(500, 100)
+	require.InDelta(t, 304, low, 0.0001)
+	require.InDelta(t, 696, high, 0.0001)
+	require.InDelta(t, 400, ConservativeRating(500, 100), 0.0001)
+}
+
+func TestParseLeaderboardSort(t *testing.T) {
+	t.Parallel()
+
+	sortBy, err := ParseLeaderboardSort("")
+	require.NoError(t, err)
+	require.Equal(t, LeaderboardSortScore, sortBy)
+
+	sortBy, err = ParseLeaderboardSort("conservative")
+	require.NoError(t, err)
+	require.Equal(t, LeaderboardSortConservative, sortBy)
+
+	_, err = ParseLeaderboardSort("kills")
+	require.ErrorIs(t, err, ErrInvalidLeaderboardSort)
+}
+
+func TestParseRankingMode(t *testing.T) {
+	t.Parallel()
+
+	ranking, err := ParseRankingMode("")
+	require.NoError(t, err)
+	require.Equal(t, RankingOrdinal, ranking)
+
+	ranking, err = ParseRankingMode("competition")
+	require.NoError(t, err)
+	require.Equal(t, RankingCompetition, ranking)
+
+	_, err = ParseRankingMode("dense")
+	require.ErrorIs(t, err, ErrInvalidRankingMode)
+}
+
+func TestIsProvisional(t *testing.T) {
+	t.Parallel()
+
+	require.True(t, IsProvisional(0))
+	require.True(t, IsProvisional(ProvisionalMatches-1))
+	require.False(t, IsProvisional(ProvisionalMatches))
+}
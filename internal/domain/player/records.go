@@ -0,0 +1,63 @@
+package player
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RecordKind identifies a personal best.
+type RecordKind string
+
+const (
+	RecordMostKills     RecordKind = "most_kills"
+	RecordHighestDamage RecordKind = "highest_damage"
+	RecordBestPlacement RecordKind = "best_placement"
+)
+
+// PersonalRecord is a personal best and the verified match it was set in.
+type PersonalRecord struct {
+	Value   int       `bson:"value" json:"value"`
+	MatchID uuid.UUID `bson:"match_id" json:"match_id"`
+	SetAt   time.Time `bson:"set_at" json:"set_at"`
+}
+
+// PersonalRecords are a player's bests in a game. A record is nil until the
+// player has a verified match.
+type PersonalRecords struct {
+	MostKills     *PersonalRecord `bson:"most_kills,omitempty" json:"most_kills,omitempty"`
+	HighestDamage *PersonalRecord `bson:"highest_damage,omitempty" json:"highest_damage,omitempty"`
+	BestPlacement *PersonalRecord `bson:"best_placement,omitempty" json:"best_placement,omitempty"` // Lowest placement number
+}
+
+// BrokenRecord is a personal best beaten by a verified match.
+type BrokenRecord struct {
+	Kind     RecordKind
+	Previous PersonalRecord
+	Record   PersonalRecord
+}
+
+// ApplyMatchRecords updates the personal records with a verified match's
+// result and returns the records it beat. A player's first match sets every
+// record without beating any, and equalling a record does not replace it.
+func (ps *PlayerStats) ApplyMatchRecords(matchID uuid.UUID, kills, damage, placement int, at time.Time) []BrokenRecord {
+	var broken []BrokenRecord
+
+	apply := func(kind RecordKind, current **PersonalRecord, value int, better bool) {
+		record := PersonalRecord{Value: value, MatchID: matchID, SetAt: at}
+		switch {
+		case *current == nil:
+			*current = &record
+		case better:
+			broken = append(broken, BrokenRecord{Kind: kind, Previous: **current, Record: record})
+			*current = &record
+		}
+	}
+
+	r := &ps.Records
+	apply(RecordMostKills, &r.MostKills, kills, r.MostKills != nil && kills > r.MostKills.Value)
+	apply(RecordHighestDamage, &r.HighestDamage, damage, r.HighestDamage != nil && damage > r.HighestDamage.Value)
+	apply(RecordBestPlacement, &r.BestPlacement, placement, r.BestPlacement != nil && placement < r.BestPlacement.Value)
+
+	return broken
+}
@@ -0,0 +1,38 @@
+package player
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestApplyMatchRecords(t *testing.T) {
+	t.Parallel()
+
+	ps := &PlayerStats{}
+	now := time.Now().UTC()
+
+	first := uuid.New()
+	broken := ps.ApplyMatchRecords(first, 5, 1200, 4, now)
+	require.Empty(t, broken, "the first match sets records without beating any")
+	require.Equal(t, 5, ps.Records.MostKills.Value)
+	require.Equal(t, first, ps.Records.BestPlacement.MatchID)
+
+	// Equalling kills and a worse placement keep the records; more damage beats it.
+	second := uuid.New()
+	broken = ps.ApplyMatchRecords(second, 5, 1500, 9, now)
+	require.Len(t, broken, 1)
+	require.Equal(t, RecordHighestDamage, broken[0].Kind)
+	require.Equal(t, 1200, broken[0].Previous.Value)
+	require.Equal(t, 1500, broken[0].Record.Value)
+	require.Equal(t, first, ps.Records.MostKills.MatchID)
+	require.Equal(t, second, ps.Records.HighestDamage.MatchID)
+
+	broken = ps.ApplyMatchRecords(uuid.New(), 8, 100, 1, now)
+	require.Len(t, broken, 2)
+	require.Equal(t, RecordMostKills, broken[0].Kind)
+	require.Equal(t, RecordBestPlacement, broken[1].Kind)
+	require.Equal(t, 1, ps.Records.BestPlacement.Value)
+}
@@ -6,8 +6,27 @@ import "context"
 type Repository interface {
 	Create(ctx context.Context, player *Player) error
 	GetByID(ctx context.Context, id string) (*Player, error)
+	GetByIDs(ctx context.Context, ids []string) ([]*Player, error)
 	GetByUserID(ctx context.Context, userID string) (*Player, error)
+
+	// GetByIDFields and GetByUserIDFields retrieve a player loading only the
+	// given fields, by their JSON names, besides the IDs. Nil fields loads
+	// every field.
+	GetByIDFields(ctx context.Context, id string, fields []string) (*Player, error)
+	GetByUserIDFields(ctx context.Context, userID string, fields []string) (*Player, error)
+
+	GetByPlatformID(ctx context.Context, platform, platformID string) (*Player, error)
 	GetAll(ctx context.Context) ([]*Player, error)
 	Update(ctx context.Context, player *Player) error
 	Delete(ctx context.Context, id string) error
+	Count(ctx context.Context) (int64, error)
+
+	// AddHonors adds delta to a player's accumulated honors.
+	AddHonors(ctx context.Context, id string, delta Honors) error
+
+	// ListFiltered returns a page of players matching filter, with their stats summaries if requested.
+	ListFiltered(ctx context.Context, filter ListFilter) ([]ListEntry, error)
+
+	// CountFiltered returns the number of players matching filter, ignoring its paging.
+	CountFiltered(ctx context.Context, filter ListFilter) (int64, error)
 }
@@ -0,0 +1,107 @@
+package player
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultNewAccountAge is how young an account must be to be checked for smurfing.
+	DefaultNewAccountAge = 30 * 24 * time.Hour
+	// SmurfSigma is how many standard deviations above their tier average a
+	// new account must perform to be flagged.
+	SmurfSigma = 2.0
+	// SmurfMinMatches is how many matches a player needs before their
+	// performance is compared; fewer is too noisy to mean anything.
+	SmurfMinMatches = 3
+)
+
+// TierBaseline is the spread of kills per match among a tier's players.
+type TierBaseline struct {
+	Tier    Tier    `json:"tier"`
+	Players int     `json:"players"`
+	Mean    float64 `json:"mean"`
+	StdDev  float64 `json:"std_dev"`
+}
+
+// BuildTierBaselines computes the mean and standard deviation of kills per
+// match for each tier. Players with fewer than SmurfMinMatches matches are
+// left out.
+func BuildTierBaselines(stats []*PlayerStats) map[Tier]TierBaseline {
+	kills := make(map[Tier][]float64)
+	for _, ps := range stats {
+		if ps.MatchesPlayed < SmurfMinMatches {
+			continue
+		}
+		kills[ps.Tier] = append(kills[ps.Tier], ps.Averages().Kills)
+	}
+
+	baselines := make(map[Tier]TierBaseline, len(kills))
+	for tier, values := range kills {
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		mean := sum / float64(len(values))
+
+		var squares float64
+		for _, v := range values {
+			squares += (v - mean) * (v - mean)
+		}
+		baselines[tier] = TierBaseline{
+			Tier:    tier,
+			Players: len(values),
+			Mean:    mean,
+			StdDev:  math.Sqrt(squares / float64(len(values))),
+		}
+	}
+	return baselines
+}
+
+// SmurfFlag marks a new account performing far above its tier, which may be
+// an experienced player on a fresh account.
+type SmurfFlag struct {
+	PlayerID      uuid.UUID `json:"player_id"`
+	GameID        uuid.UUID `json:"game_id"`
+	Tier          Tier      `json:"tier"`
+	AccountAge    int       `json:"account_age_days"`
+	KillsPerMatch float64   `json:"kills_per_match"`
+	TierMean      float64   `json:"tier_mean"`
+	TierStdDev    float64   `json:"tier_std_dev"`
+	Sigma         float64   `json:"sigma"` // Standard deviations above the tier mean
+}
+
+// CheckSmurf flags p when their account is younger than maxAge and their kills
+// per match are more than SmurfSigma standard deviations above the average of
+// their tier. It returns nil when p is not flagged, including when the tier
+// has no spread to compare against.
+func CheckSmurf(p *Player, ps *PlayerStats, baselines map[Tier]TierBaseline, maxAge time.Duration, now time.Time) *SmurfFlag {
+	age := now.Sub(p.CreatedAt)
+	if age >= maxAge || ps == nil || ps.MatchesPlayed < SmurfMinMatches {
+		return nil
+	}
+
+	baseline, ok := baselines[ps.Tier]
+	if !ok || baseline.StdDev == 0 {
+		return nil
+	}
+
+	kills := ps.Averages().Kills
+	sigma := (kills - baseline.Mean) / baseline.StdDev
+	if sigma <= SmurfSigma {
+		return nil
+	}
+
+	return &SmurfFlag{
+		PlayerID:      p.ID,
+		GameID:        ps.GameID,
+		Tier:          ps.Tier,
+		AccountAge:    int(age / (24 * time.Hour)),
+		KillsPerMatch: kills,
+		TierMean:      baseline.Mean,
+		TierStdDev:    baseline.StdDev,
+		Sigma:         sigma,
+	}
+}
@@ -0,0 +1,81 @@
+package player
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func smurfStats(tier Tier, matches, kills int) *PlayerStats {
+	return &PlayerStats{
+		PlayerID:      uuid.New(),
+		GameID:        uuid.New(),
+		Tier:          tier,
+		MatchesPlayed: matches,
+		Stats:         map[string]interface{}{"total_kills": kills},
+	}
+}
+
+func TestBuildTierBaselines(t *testing.T) {
+	t.Parallel()
+
+	baselines := BuildTierBaselines([]*PlayerStats{
+		smurfStats(TierIntermediate, 10, 20),
+		smurfStats(TierIntermediate, 10, 40),
+		smurfStats(TierIntermediate, 2, 30), // Too few matches
+		smurfStats(TierElite, 5, 50),
+	})
+
+	require.Len(t, baselines, 2)
+	require.Equal(t, 2, baselines[TierIntermediate].Players)
+	require.InDelta(t, 3, baselines[TierIntermediate].Mean, 0.0001)
+	require.InDelta(t, 1, baselines[TierIntermediate].StdDev, 0.0001)
+	require.InDelta(t, 10, baselines[TierElite].Mean, 0.0001)
+	require.Zero(t, baselines[TierElite].StdDev)
+}
+
+func TestCheckSmurf(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 6, 1, 12, 0, 0, 0, time.UTC)
+	baselines := map[Tier]TierBaseline{
+		TierIntermediate: {Tier: TierIntermediate, Players: 40, Mean: 3, StdDev: 1},
+		TierElite:        {Tier: TierElite, Players: 1, Mean: 10},
+	}
+
+	tests := []struct {
+		name    string
+		age     time.Duration
+		stats   *PlayerStats
+		flagged bool
+	}{
+		{name: "new and far above tier", age: 5 * 24 * time.Hour, stats: smurfStats(TierIntermediate, 4, 24), flagged: true},
+		{name: "new at two sigma", age: 5 * 24 * time.Hour, stats: smurfStats(TierIntermediate, 4, 20)},
+		{name: "established account", age: DefaultNewAccountAge, stats: smurfStats(TierIntermediate, 4, 24)},
+		{name: "too few matches", age: time.Hour, stats: smurfStats(TierIntermediate, 2, 20)},
+		{name: "tier without spread", age: time.Hour, stats: smurfStats(TierElite, 4, 80)},
+		{name: "tier without baseline", age: time.Hour, stats: smurfStats(TierAdvanced, 4, 80)},
+		{name: "no stats", age: time.Hour},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			p := &Player{ID: uuid.New(), CreatedAt: now.Add(-tc.age)}
+			flag := CheckSmurf(p, tc.stats, baselines, DefaultNewAccountAge, now)
+			if !tc.flagged {
+				require.Nil(t, flag)
+				return
+			}
+			require.NotNil(t, flag)
+			require.Equal(t, p.ID, flag.PlayerID)
+			require.Equal(t, tc.stats.GameID, flag.GameID)
+			require.Equal(t, 5, flag.AccountAge)
+			require.InDelta(t, 6, flag.KillsPerMatch, 0.0001)
+			require.InDelta(t, 3, flag.Sigma, 0.0001)
+		})
+	}
+}
@@ -2,42 +2,79 @@ package player
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
 
 // LeaderboardEntry represents a single entry in a leaderboard.
 type LeaderboardEntry struct {
-	Rank          int                    `json:"rank"`
-	PlayerID      uuid.UUID              `json:"player_id"`
-	DisplayName   string                 `json:"display_name"`
-	AvatarURL     string                 `json:"avatar_url"`
-	RankingScore  float64                `json:"ranking_score"`
-	Tier          Tier                   `json:"tier"`
-	MatchesPlayed int                    `json:"matches_played"`
-	Stats         map[string]interface{} `json:"stats"`
+	Rank            int                    `json:"rank"`
+	PlayerID        uuid.UUID              `json:"player_id"`
+	DisplayName     string                 `json:"display_name"`
+	AvatarURL       string                 `json:"avatar_url"`
+	RankingScore    float64                `json:"ranking_score"`
+	RatingDeviation float64                `json:"rating_deviation"`
+	Tier            Tier                   `json:"tier"`
+	MatchesPlayed   int                    `json:"matches_played"`
+	Stats           map[string]interface{} `json:"stats"`
+	LastMatchAt     *time.Time             `json:"last_match_at,omitempty"`
+	PreviousRank    int                    `json:"previous_rank,omitempty"`   // Rank in the latest snapshot at least RankDeltaWindow old, 0 if none
+	DataIncomplete  bool                   `json:"data_incomplete,omitempty"` // Stats could not be matched to a player profile
+	Anonymous       bool                   `json:"anonymous,omitempty"`       // The player opted out of public leaderboards
 }
 
 // PlayerRankInfo contains rank information for a player.
 type PlayerRankInfo struct {
-	Rank         int64
-	RankingScore float64
-	Tier         Tier
+	Rank            int64
+	RankingScore    float64
+	RatingDeviation float64
+	Tier            Tier
+}
+
+// StatsScope selects which of a player's stats documents for a game are
+// read or written: across all modes or for one mode, and all-time or for one
+// season. The zero scope is the all-time stats across all modes.
+type StatsScope struct {
+	Mode     string    // Game mode slug; empty for stats across all modes
+	SeasonID uuid.UUID // uuid.Nil for all-time stats
 }
 
 // StatsRepository defines the contract for PlayerStats persistence.
+// Methods without a scope or mode parameter operate on all-time stats across
+// all modes.
 type StatsRepository interface {
 	Create(ctx context.Context, stats *PlayerStats) error
 	GetByID(ctx context.Context, id uuid.UUID) (*PlayerStats, error)
 	GetByPlayerAndGame(ctx context.Context, playerID, gameID uuid.UUID) (*PlayerStats, error)
 	GetByPlayer(ctx context.Context, playerID uuid.UUID) ([]*PlayerStats, error)
+	GetByGame(ctx context.Context, gameID uuid.UUID, limit int64) ([]*PlayerStats, error)
 	GetOrCreate(ctx context.Context, playerID, gameID uuid.UUID) (*PlayerStats, error)
+	GetOrCreateForScope(ctx context.Context, playerID, gameID uuid.UUID, scope StatsScope) (*PlayerStats, error)
 	Update(ctx context.Context, stats *PlayerStats) error
-	UpdateRanking(ctx context.Context, id uuid.UUID, score float64, tier Tier) error
+	UpdateRanking(ctx context.Context, id uuid.UUID, score, deviation float64, tier Tier) error
+	UpdateConsistency(ctx context.Context, id uuid.UUID, score float64, samples int) error
+	UpdateRecords(ctx context.Context, id uuid.UUID, records PersonalRecords) error
+
+	// SetDerivedStats overwrites the given stats with computed values, leaving the others untouched.
+	SetDerivedStats(ctx context.Context, id uuid.UUID, derived map[string]float64) error
+
+	// SetRollups overwrites the given max and average stats and the number
+	// of matches each of the averages covers.
+	SetRollups(ctx context.Context, id uuid.UUID, rolled map[string]float64, samples map[string]int) error
 	IncrementStats(ctx context.Context, id uuid.UUID, statsToAdd map[string]interface{}) error
-	GetLeaderboard(ctx context.Context, gameID uuid.UUID, limit, offset int64) ([]LeaderboardEntry, error)
-	GetLeaderboardByTier(ctx context.Context, gameID uuid.UUID, tier Tier, limit int64) ([]LeaderboardEntry, error)
-	GetPlayerRank(ctx context.Context, playerID, gameID uuid.UUID) (*PlayerRankInfo, error)
-	CountByGame(ctx context.Context, gameID uuid.UUID) (int64, error)
-	GetTierDistribution(ctx context.Context, gameID uuid.UUID) (map[Tier]int64, error)
+
+	// AdjustStats adds deltas to a player's stat totals and matchesPlayed to their match
+	// count, without recording a new match. It corrects or reverses a verified match.
+	AdjustStats(ctx context.Context, id uuid.UUID, deltas map[string]int, matchesPlayed int) error
+	GetLeaderboard(ctx context.Context, gameID uuid.UUID, scope StatsScope, sortBy LeaderboardSort, ranking RankingMode, limit, offset int64) ([]LeaderboardEntry, error)
+	GetLeaderboardByTier(ctx context.Context, gameID uuid.UUID, scope StatsScope, tier Tier, sortBy LeaderboardSort, ranking RankingMode, limit int64) ([]LeaderboardEntry, error)
+	GetPlayerRank(ctx context.Context, playerID, gameID uuid.UUID, scope StatsScope) (*PlayerRankInfo, error)
+	CountByGame(ctx context.Context, gameID uuid.UUID, scope StatsScope) (int64, error)
+	CountStatAbove(ctx context.Context, gameID uuid.UUID, statName string, perMatch float64) (above, total int64, err error)
+	GetTierDistribution(ctx context.Context, gameID uuid.UUID, scope StatsScope) (map[Tier]int64, error)
+	SnapshotRanks(ctx context.Context, gameID uuid.UUID, mode string, takenAt time.Time) error
+
+	// SetPlayerDeactivated hides or restores all of a player's stats on leaderboards.
+	SetPlayerDeactivated(ctx context.Context, playerID uuid.UUID, deactivated bool) error
 }
@@ -41,21 +41,21 @@ func (wc *WarzoneCalculator) Calculate(ctx context.Context, stats *player.Player
 		kdRatio = kills
 	}
 
-	// Normalize K/D to 0-100 scale (cap at 5.0 K/D = 100 points)
-	kdScore := math.Min(kdRatio*20, 100)
+	// Normalize to 0-100 using the game's stat schema.
+	// Defaults: 5.0 K/D, 20 kills and 3000 damage per match earn full points.
+	kdScore := g.NormalizeStat("kd_ratio", kdRatio, 5)
 
 	// Calculate average kills per match
 	avgKills := kills / float64(stats.MatchesPlayed)
-	avgKillsScore := math.Min(avgKills*5, 100) // Cap at 20 kills = 100 points
+	avgKillsScore := g.NormalizeStat("kills", avgKills, 20)
 
 	// Calculate average damage per match
 	avgDamage := damage / float64(stats.MatchesPlayed)
-	avgDamageScore := math.Min(avgDamage/30, 100) // Cap at 3000 damage = 100 points
+	avgDamageScore := g.NormalizeStat("damage", avgDamage, 3000)
 
-	// Consistency: use coefficient of variation from kills
-	// For now, simplified - would require match-by-match data
-	// Assume 70 as baseline consistency
-	consistencyScore := 70.0
+	// Consistency: 1 - coefficient of variation of kills and placement over
+	// recent verified matches, with a baseline until enough history exists
+	consistencyScore := stats.Consistency()
 
 	// Get weights from game configuration
 	weights := g.RankingWeights
@@ -82,6 +82,11 @@ func (wc *WarzoneCalculator) SupportsGame(gameSlug string) bool {
 	return gameSlug == "warzone"
 }
 
+// ScoreRange returns 1000; weighted stat points are scaled to 0-1000.
+func (wc *WarzoneCalculator) ScoreRange(g *game.Game) float64 {
+	return 1000
+}
+
 // getWeight retrieves weight from map with fallback to default.
 func getWeight(weights game.RankingWeights, key string, defaultValue float64) float64 {
 	if val, exists := weights[key]; exists {
@@ -117,3 +122,98 @@ func (dc *DefaultCalculator) SupportsGame(gameSlug string) bool {
 	// Default calculator supports all games as fallback
 	return true
 }
+
+// ScoreRange returns 300, the span of K/D 0 to 3 that covers nearly every
+// player; scores above it come from the uncapped K/D and match bonus.
+func (dc *DefaultCalculator) ScoreRange(g *game.Game) float64 {
+	return 300
+}
+
+// FormulaCalculator evaluates a game's configured ranking formula.
+type FormulaCalculator struct{}
+
+// NewFormulaCalculator creates a new formula calculator.
+func NewFormulaCalculator() *FormulaCalculator {
+	return &FormulaCalculator{}
+}
+
+// Calculate evaluates g.RankingFormula against the player's stats.
+// Terms whose divisor is zero contribute their undivided value, matching how
+// the compiled-in calculators treat a zero-death K/D.
+func (fc *FormulaCalculator) Calculate(ctx context.Context, stats *player.PlayerStats, g *game.Game) (float64, error) {
+	formula := g.RankingFormula
+	if formula == nil {
+		return 0, ErrUnsupportedGame
+	}
+	if stats.MatchesPlayed == 0 {
+		return 0, nil
+	}
+
+	var score float64
+	for _, term := range formula.Terms {
+		value := formulaStat(stats, term.Stat)
+
+		var divisor float64
+		switch term.Per {
+		case "":
+			divisor = 1
+		case game.FormulaPerMatch:
+			divisor = float64(stats.MatchesPlayed)
+		default:
+			divisor = formulaStat(stats, term.Per)
+		}
+		if divisor > 0 {
+			value /= divisor
+		}
+
+		value *= term.Multiplier
+		if term.Cap != nil {
+			value = math.Min(value, *term.Cap)
+		}
+
+		score += value * term.Weight
+	}
+
+	return score * formula.Scale, nil
+}
+
+// SupportsGame returns false; formula games are selected by configuration, not slug.
+func (fc *FormulaCalculator) SupportsGame(gameSlug string) bool {
+	return false
+}
+
+// ScoreRange returns the highest score the formula allows when every term is
+// capped. Formulas with an uncapped term have no ceiling and use
+// player.DefaultScoreRange.
+func (fc *FormulaCalculator) ScoreRange(g *game.Game) float64 {
+	formula := g.RankingFormula
+	if formula == nil {
+		return player.DefaultScoreRange
+	}
+
+	var ceiling float64
+	for _, term := range formula.Terms {
+		if term.Cap == nil {
+			return player.DefaultScoreRange
+		}
+		ceiling += math.Abs(*term.Cap * term.Weight)
+	}
+	if ceiling == 0 {
+		return player.DefaultScoreRange
+	}
+
+	return ceiling * formula.Scale
+}
+
+// formulaStat reads a stat for a formula term. matches_played and consistency
+// resolve to tracked fields so formulas can reference them like any other stat.
+func formulaStat(stats *player.PlayerStats, key string) float64 {
+	switch key {
+	case "matches_played":
+		return float64(stats.MatchesPlayed)
+	case "consistency":
+		return stats.Consistency()
+	default:
+		return stats.GetStatAsFloat(key)
+	}
+}
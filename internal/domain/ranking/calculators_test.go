@@ -0,0 +1,144 @@
+package ranking
+
+import (
+	"context"
+	"testing"
+
+	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFormulaCalculator_Calculate(t *testing.T) {
+	t.Parallel()
+
+	kdCap := 100.0
+	stats := player.NewPlayerStats(uuid.New(), uuid.New())
+	stats.MatchesPlayed = 10
+	stats.Stats["total_kills"] = 50
+	stats.Stats["total_deaths"] = 25
+	stats.Stats["total_wins"] = 2
+
+	tests := []struct {
+		name     string
+		formula  *game.RankingFormula
+		expected float64
+	}{
+		{
+			name: "per match",
+			formula: &game.RankingFormula{
+				Scale: 10,
+				Terms: []game.FormulaTerm{
+					{Stat: "total_kills", Per: game.FormulaPerMatch, Multiplier: 5, Weight: 1},
+				},
+			},
+			expected: 250,
+		},
+		{
+			name: "ratio with cap",
+			formula: &game.RankingFormula{
+				Scale: 1,
+				Terms: []game.FormulaTerm{
+					{Stat: "total_kills", Per: "total_deaths", Multiplier: 80, Cap: &kdCap, Weight: 0.5},
+					{Stat: "total_wins", Multiplier: 1, Weight: 0.5},
+				},
+			},
+			expected: 51,
+		},
+		{
+			name: "zero divisor keeps raw value",
+			formula: &game.RankingFormula{
+				Scale: 1,
+				Terms: []game.FormulaTerm{
+					{Stat: "total_kills", Per: "total_assists", Multiplier: 1, Weight: 1},
+				},
+			},
+			expected: 50,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			g := &game.Game{Slug: "custom", RankingFormula: tc.formula}
+			score, err := NewFormulaCalculator().Calculate(context.Background(), stats, g)
+			require.NoError(t, err)
+			require.InDelta(t, tc.expected, score, 0.0001)
+		})
+	}
+}
+
+func TestService_CalculateRanking_PrefersFormula(t *testing.T) {
+	t.Parallel()
+
+	stats := player.NewPlayerStats(uuid.New(), uuid.New())
+	stats.MatchesPlayed = 4
+	stats.Stats["total_kills"] = 8
+
+	g := &game.Game{
+		Slug: "warzone",
+		RankingFormula: &game.RankingFormula{
+			Scale: 1,
+			Terms: []game.FormulaTerm{{Stat: "total_kills", Per: game.FormulaPerMatch, Multiplier: 1, Weight: 1}},
+		},
+	}
+
+	score, _, err := NewService(NewWarzoneCalculator(), NewDefaultCalculator()).CalculateRanking(context.Background(), stats, g)
+	require.NoError(t, err)
+	require.InDelta(t, 2, score, 0.0001)
+}
+
+func TestService_RatingDeviation_ScalesToCalculator(t *testing.T) {
+	t.Parallel()
+
+	kdCap := 5.0
+	winsCap := 10.0
+
+	tests := []struct {
+		name          string
+		game          *game.Game
+		matchesPlayed int
+		expected      float64
+	}{
+		{name: "warzone unrated", game: &game.Game{Slug: "warzone"}, matchesPlayed: 0, expected: 350},
+		{name: "warzone after three matches", game: &game.Game{Slug: "warzone"}, matchesPlayed: 3, expected: 175},
+		{name: "warzone floor", game: &game.Game{Slug: "warzone"}, matchesPlayed: 10000, expected: 30},
+		{name: "default unrated", game: &game.Game{Slug: "valorant"}, matchesPlayed: 0, expected: 105},
+		{name: "default after three matches", game: &game.Game{Slug: "valorant"}, matchesPlayed: 3, expected: 52.5},
+		{name: "default floor", game: &game.Game{Slug: "valorant"}, matchesPlayed: 10000, expected: 9},
+		{
+			name: "capped formula",
+			game: &game.Game{Slug: "custom", RankingFormula: &game.RankingFormula{
+				Scale: 10,
+				Terms: []game.FormulaTerm{
+					{Stat: "total_kills", Per: "total_deaths", Multiplier: 1, Cap: &kdCap, Weight: 0.6},
+					{Stat: "total_wins", Multiplier: 1, Cap: &winsCap, Weight: 0.4},
+				},
+			}},
+			matchesPlayed: 3,
+			expected:      12.25,
+		},
+		{
+			name: "uncapped formula uses the default range",
+			game: &game.Game{Slug: "custom", RankingFormula: &game.RankingFormula{
+				Scale: 1,
+				Terms: []game.FormulaTerm{{Stat: "total_kills", Multiplier: 1, Weight: 1}},
+			}},
+			matchesPlayed: 3,
+			expected:      175,
+		},
+	}
+
+	service := NewService(NewWarzoneCalculator(), NewDefaultCalculator())
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			stats := player.NewPlayerStats(uuid.New(), uuid.New())
+			stats.MatchesPlayed = tc.matchesPlayed
+			require.InDelta(t, tc.expected, service.RatingDeviation(stats, tc.game), 0.0001)
+		})
+	}
+}
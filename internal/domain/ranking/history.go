@@ -0,0 +1,62 @@
+package ranking
+
+import (
+	"context"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+// HistoryReason explains why a rating history entry was recorded.
+type HistoryReason string
+
+const (
+	// HistoryReasonRecalibration marks a tier change made by the recalibration job.
+	HistoryReasonRecalibration HistoryReason = "recalibration"
+
+	// HistoryReasonGrandfathered marks a player kept above the new cutoffs by grandfathering.
+	HistoryReasonGrandfathered HistoryReason = "grandfathered"
+
+	// HistoryReasonSeasonEnd records the score and tier a player finished a
+	// season with, which the season's rewards are distributed from.
+	HistoryReasonSeasonEnd HistoryReason = "season_end"
+)
+
+// HistoryEntry records a change to a player's rating or tier.
+type HistoryEntry struct {
+	ID              uuid.UUID     `bson:"_id" json:"id"`
+	PlayerID        uuid.UUID     `bson:"player_id" json:"player_id"`
+	GameID          uuid.UUID     `bson:"game_id" json:"game_id"`
+	Score           float64       `bson:"score" json:"score"`
+	PreviousTier    player.Tier   `bson:"previous_tier" json:"previous_tier"`
+	Tier            player.Tier   `bson:"tier" json:"tier"`
+	Reason          HistoryReason `bson:"reason" json:"reason"`
+	BoundaryVersion int           `bson:"boundary_version" json:"boundary_version"`
+	CreatedAt       time.Time     `bson:"created_at" json:"created_at"`
+}
+
+// NewHistoryEntry creates a rating history entry.
+func NewHistoryEntry(playerID, gameID uuid.UUID, score float64, previousTier, tier player.Tier, reason HistoryReason, boundaryVersion int) *HistoryEntry {
+	return &HistoryEntry{
+		ID:              uuid.New(),
+		PlayerID:        playerID,
+		GameID:          gameID,
+		Score:           score,
+		PreviousTier:    previousTier,
+		Tier:            tier,
+		Reason:          reason,
+		BoundaryVersion: boundaryVersion,
+		CreatedAt:       time.Now().UTC(),
+	}
+}
+
+// HistoryRepository persists rating history.
+type HistoryRepository interface {
+	Create(ctx context.Context, entry *HistoryEntry) error
+	ListByPlayer(ctx context.Context, playerID, gameID uuid.UUID, limit int64) ([]*HistoryEntry, error)
+
+	// ListByBoundaryVersion returns a game's entries recorded for a reason
+	// under a tier boundaries version.
+	ListByBoundaryVersion(ctx context.Context, gameID uuid.UUID, version int, reason HistoryReason) ([]*HistoryEntry, error)
+}
@@ -0,0 +1,92 @@
+package ranking
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+// ErrRewardAlreadyDistributed is returned when a player's reward for a game
+// season has already been recorded.
+var ErrRewardAlreadyDistributed = errors.New("season reward already distributed")
+
+// Reward is what a player receives for the tier they finished a season in.
+type Reward struct {
+	Badge string `bson:"badge" json:"badge"`
+	Title string `bson:"title,omitempty" json:"title,omitempty"`
+	XP    int    `bson:"xp" json:"xp"`
+}
+
+// seasonXP is the experience awarded per final tier.
+var seasonXP = map[player.Tier]int{
+	player.TierBeginner:     100,
+	player.TierIntermediate: 250,
+	player.TierAdvanced:     500,
+	player.TierElite:        1000,
+}
+
+// SeasonReward returns the reward for finishing a season in a tier. Every
+// tier earns a badge and XP; advanced and elite players also earn a title.
+func SeasonReward(season string, tier player.Tier) Reward {
+	r := Reward{
+		Badge: "season:" + season + ":" + string(tier),
+		XP:    seasonXP[tier],
+	}
+	if TierRank(tier) >= TierRank(player.TierAdvanced) {
+		r.Title = season + " " + strings.ToUpper(string(tier[:1])) + string(tier[1:])
+	}
+	return r
+}
+
+// ClosedSeason reports the season that ended when current took over from
+// previous, the version before it. Unlabeled versions never close a season.
+func ClosedSeason(current, previous *TierBoundaries) (string, bool) {
+	if current == nil || previous == nil || previous.Season == "" || previous.Season == current.Season {
+		return "", false
+	}
+	return previous.Season, true
+}
+
+// RewardDistribution records the reward a player received for a game season.
+// There is at most one per player, game and season, which makes distributing
+// a season's rewards safe to repeat.
+type RewardDistribution struct {
+	ID            uuid.UUID   `bson:"_id" json:"id"`
+	PlayerID      uuid.UUID   `bson:"player_id" json:"player_id"`
+	GameID        uuid.UUID   `bson:"game_id" json:"game_id"`
+	Season        string      `bson:"season" json:"season"`
+	Tier          player.Tier `bson:"tier" json:"tier"`
+	Reward        Reward      `bson:"reward" json:"reward"`
+	DistributedAt time.Time   `bson:"distributed_at" json:"distributed_at"`
+	NotifiedAt    *time.Time  `bson:"notified_at,omitempty" json:"-"`
+}
+
+// NewRewardDistribution creates the distribution record of a player's season reward.
+func NewRewardDistribution(playerID, gameID uuid.UUID, season string, tier player.Tier, now time.Time) *RewardDistribution {
+	return &RewardDistribution{
+		ID:            uuid.New(),
+		PlayerID:      playerID,
+		GameID:        gameID,
+		Season:        season,
+		Tier:          tier,
+		Reward:        SeasonReward(season, tier),
+		DistributedAt: now,
+	}
+}
+
+// RewardRepository persists season reward distributions.
+type RewardRepository interface {
+	// Create stores a distribution. It returns ErrRewardAlreadyDistributed if
+	// the player already received a reward for the game season.
+	Create(ctx context.Context, d *RewardDistribution) error
+	// ListUnnotified returns a game season's distributions whose player has not been notified yet.
+	ListUnnotified(ctx context.Context, gameID uuid.UUID, season string) ([]*RewardDistribution, error)
+	// MarkNotified records that the player was notified of a distribution.
+	MarkNotified(ctx context.Context, id uuid.UUID, at time.Time) error
+	// ListByPlayer returns a player's rewards in a game, newest first.
+	ListByPlayer(ctx context.Context, playerID, gameID uuid.UUID) ([]*RewardDistribution, error)
+}
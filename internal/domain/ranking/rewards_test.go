@@ -0,0 +1,43 @@
+package ranking
+
+import (
+	"testing"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeasonReward(t *testing.T) {
+	t.Parallel()
+
+	beginner := SeasonReward("S1", player.TierBeginner)
+	require.Equal(t, "season:S1:beginner", beginner.Badge)
+	require.Empty(t, beginner.Title)
+	require.Equal(t, 100, beginner.XP)
+
+	elite := SeasonReward("S1", player.TierElite)
+	require.Equal(t, "S1 Elite", elite.Title)
+	require.Greater(t, elite.XP, SeasonReward("S1", player.TierAdvanced).XP)
+}
+
+func TestClosedSeason(t *testing.T) {
+	t.Parallel()
+
+	s1 := &TierBoundaries{Version: 1, Season: "S1"}
+	s1Revised := &TierBoundaries{Version: 2, Season: "S1"}
+	s2 := &TierBoundaries{Version: 3, Season: "S2"}
+	unlabeled := &TierBoundaries{Version: 1}
+
+	season, closed := ClosedSeason(s2, s1Revised)
+	require.True(t, closed)
+	require.Equal(t, "S1", season)
+
+	_, closed = ClosedSeason(s1Revised, s1)
+	require.False(t, closed)
+
+	_, closed = ClosedSeason(s1, unlabeled)
+	require.False(t, closed)
+
+	_, closed = ClosedSeason(s1, nil)
+	require.False(t, closed)
+}
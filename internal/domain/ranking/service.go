@@ -4,6 +4,8 @@ package ranking
 import (
 	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/alejaam/tourney-rank/internal/domain/game"
 	"github.com/alejaam/tourney-rank/internal/domain/player"
@@ -26,23 +28,41 @@ type Calculator interface {
 
 	// SupportsGame returns true if this calculator can handle the given game.
 	SupportsGame(gameSlug string) bool
+
+	// ScoreRange returns the span of scores the calculator typically produces
+	// for a game, which rating deviations are measured against.
+	ScoreRange(game *game.Game) float64
 }
 
 // Service orchestrates ranking calculations using appropriate strategies.
 type Service struct {
 	calculators []Calculator
+	formula     *FormulaCalculator
+	boundaries  BoundaryRepository
 }
 
 // NewService creates a new ranking service with registered calculators.
+// Games with a configured ranking formula always use the formula calculator.
 func NewService(calculators ...Calculator) *Service {
 	return &Service{
 		calculators: calculators,
+		formula:     NewFormulaCalculator(),
 	}
 }
 
+// WithTierBoundaries makes tier assignment use each game's versioned tier
+// boundaries instead of the default cutoffs.
+func (s *Service) WithTierBoundaries(repo BoundaryRepository) *Service {
+	s.boundaries = repo
+	return s
+}
+
 // CalculateRanking calculates ranking score and tier for a player in a specific game.
+// Stats tracked for a game mode are scored with that mode's ranking overrides.
 func (s *Service) CalculateRanking(ctx context.Context, stats *player.PlayerStats, game *game.Game) (float64, player.Tier, error) {
-	calculator := s.findCalculator(game.Slug)
+	game = game.ForMode(stats.Mode)
+
+	calculator := s.calculatorFor(game)
 	if calculator == nil {
 		return 0, player.TierBeginner, ErrUnsupportedGame
 	}
@@ -52,13 +72,79 @@ func (s *Service) CalculateRanking(ctx context.Context, stats *player.PlayerStat
 		return 0, player.TierBeginner, err
 	}
 
-	// Tier determination would typically require comparing with other players
-	// For now, use a simple score-based tier assignment
-	tier := determineTierByScore(score)
+	tier, err := s.TierFor(ctx, game.ID, score, time.Now())
+	if err != nil {
+		return 0, player.TierBeginner, err
+	}
 
 	return score, tier, nil
 }
 
+// EffectiveBoundaries returns the tier boundaries in effect for a game at a
+// given time, and the version before it when its grace period is active.
+// Games without configured boundaries, and the version before the first,
+// use DefaultTierBoundaries.
+func (s *Service) EffectiveBoundaries(ctx context.Context, gameID uuid.UUID, at time.Time) (current, previous *TierBoundaries, err error) {
+	if s.boundaries == nil {
+		return DefaultTierBoundaries(), nil, nil
+	}
+
+	current, err = s.boundaries.GetEffective(ctx, gameID, at)
+	if errors.Is(err, ErrBoundariesNotFound) {
+		return DefaultTierBoundaries(), nil, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("get tier boundaries: %w", err)
+	}
+
+	if !current.InGracePeriod(at) {
+		return current, nil, nil
+	}
+
+	previous, err = s.boundaries.GetByVersion(ctx, gameID, current.Version-1)
+	if errors.Is(err, ErrBoundariesNotFound) {
+		return current, DefaultTierBoundaries(), nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("get previous tier boundaries: %w", err)
+	}
+
+	return current, previous, nil
+}
+
+// TierFor returns the tier for a score in a game, honoring grandfathering.
+func (s *Service) TierFor(ctx context.Context, gameID uuid.UUID, score float64, at time.Time) (player.Tier, error) {
+	current, previous, err := s.EffectiveBoundaries(ctx, gameID, at)
+	if err != nil {
+		return player.TierBeginner, err
+	}
+
+	tier, _ := ResolveTier(score, current, previous, at)
+	return tier, nil
+}
+
+// RatingDeviation returns the rating deviation of a player's stats, scaled to
+// the score range of the calculator that ranks them.
+func (s *Service) RatingDeviation(stats *player.PlayerStats, game *game.Game) float64 {
+	game = game.ForMode(stats.Mode)
+
+	scoreRange := player.DefaultScoreRange
+	if calculator := s.calculatorFor(game); calculator != nil {
+		scoreRange = calculator.ScoreRange(game)
+	}
+
+	return player.RatingDeviationAfter(stats.MatchesPlayed, scoreRange)
+}
+
+// calculatorFor returns the calculator for a game: its configured formula, or
+// the first registered calculator supporting its slug.
+func (s *Service) calculatorFor(game *game.Game) Calculator {
+	if game.RankingFormula != nil {
+		return s.formula
+	}
+	return s.findCalculator(game.Slug)
+}
+
 // findCalculator finds the appropriate calculator for a game.
 func (s *Service) findCalculator(gameSlug string) Calculator {
 	for _, calc := range s.calculators {
@@ -69,21 +155,6 @@ func (s *Service) findCalculator(gameSlug string) Calculator {
 	return nil
 }
 
-// determineTierByScore determines tier based on absolute score.
-// In a real scenario, this should use percentile ranking among all players.
-func determineTierByScore(score float64) player.Tier {
-	switch {
-	case score >= 800:
-		return player.TierElite
-	case score >= 600:
-		return player.TierAdvanced
-	case score >= 400:
-		return player.TierIntermediate
-	default:
-		return player.TierBeginner
-	}
-}
-
 // UpdatePlayerRanking updates a player's ranking score and tier.
 func UpdatePlayerRanking(ctx context.Context, stats *player.PlayerStats, score float64, tier player.Tier) error {
 	return stats.UpdateRankingScore(score, tier)
@@ -0,0 +1,185 @@
+package ranking
+
+import (
+	"math"
+	"sort"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+// largestMoversLimit caps how many individual movers a simulation report lists.
+const largestMoversLimit = 10
+
+// SimulatedPlayer holds a player's score and tier under the current and candidate configurations.
+type SimulatedPlayer struct {
+	PlayerID       uuid.UUID
+	CurrentScore   float64
+	CurrentTier    player.Tier
+	CandidateScore float64
+	CandidateTier  player.Tier
+}
+
+// MovementBucket counts players whose rank moved within [Min, Max].
+// Positive movement means the player climbed the leaderboard.
+type MovementBucket struct {
+	Label string `json:"label"`
+	Min   int    `json:"min"`
+	Max   int    `json:"max"`
+	Count int    `json:"count"`
+}
+
+// TierTransition counts players moving from one tier to another.
+type TierTransition struct {
+	From  player.Tier `json:"from"`
+	To    player.Tier `json:"to"`
+	Count int         `json:"count"`
+}
+
+// PlayerMovement describes how a single player is affected by a candidate configuration.
+type PlayerMovement struct {
+	PlayerID       uuid.UUID   `json:"player_id"`
+	CurrentRank    int         `json:"current_rank"`
+	CandidateRank  int         `json:"candidate_rank"`
+	Movement       int         `json:"movement"`
+	CurrentScore   float64     `json:"current_score"`
+	CandidateScore float64     `json:"candidate_score"`
+	CurrentTier    player.Tier `json:"current_tier"`
+	CandidateTier  player.Tier `json:"candidate_tier"`
+}
+
+// SimulationReport summarizes the impact of a candidate ranking configuration.
+type SimulationReport struct {
+	PlayersEvaluated  int              `json:"players_evaluated"`
+	PlayersMoved      int              `json:"players_moved"`
+	MeanScoreChange   float64          `json:"mean_score_change"`
+	MeanAbsRankChange float64          `json:"mean_abs_rank_change"`
+	RankMovement      []MovementBucket `json:"rank_movement"`
+	TierChurn         []TierTransition `json:"tier_churn"`
+	TierChurnRate     float64          `json:"tier_churn_rate"`
+	LargestMovers     []PlayerMovement `json:"largest_movers"`
+}
+
+// movementBuckets returns the empty rank movement histogram, from largest drop to largest climb.
+func movementBuckets() []MovementBucket {
+	return []MovementBucket{
+		{Label: "down 26+", Min: math.MinInt, Max: -26},
+		{Label: "down 11-25", Min: -25, Max: -11},
+		{Label: "down 6-10", Min: -10, Max: -6},
+		{Label: "down 1-5", Min: -5, Max: -1},
+		{Label: "unchanged", Min: 0, Max: 0},
+		{Label: "up 1-5", Min: 1, Max: 5},
+		{Label: "up 6-10", Min: 6, Max: 10},
+		{Label: "up 11-25", Min: 11, Max: 25},
+		{Label: "up 26+", Min: 26, Max: math.MaxInt},
+	}
+}
+
+// BuildSimulationReport ranks players under both configurations and summarizes the differences.
+func BuildSimulationReport(players []SimulatedPlayer) SimulationReport {
+	report := SimulationReport{
+		PlayersEvaluated: len(players),
+		RankMovement:     movementBuckets(),
+		TierChurn:        []TierTransition{},
+		LargestMovers:    []PlayerMovement{},
+	}
+	if len(players) == 0 {
+		return report
+	}
+
+	currentRanks := rankBy(players, func(p SimulatedPlayer) float64 { return p.CurrentScore })
+	candidateRanks := rankBy(players, func(p SimulatedPlayer) float64 { return p.CandidateScore })
+
+	movements := make([]PlayerMovement, 0, len(players))
+	churn := make(map[[2]player.Tier]int)
+	var scoreDelta, absRankDelta float64
+	var tierChanges int
+
+	for _, p := range players {
+		m := PlayerMovement{
+			PlayerID:       p.PlayerID,
+			CurrentRank:    currentRanks[p.PlayerID],
+			CandidateRank:  candidateRanks[p.PlayerID],
+			CurrentScore:   p.CurrentScore,
+			CandidateScore: p.CandidateScore,
+			CurrentTier:    p.CurrentTier,
+			CandidateTier:  p.CandidateTier,
+		}
+		m.Movement = m.CurrentRank - m.CandidateRank
+		movements = append(movements, m)
+
+		scoreDelta += p.CandidateScore - p.CurrentScore
+		absRankDelta += math.Abs(float64(m.Movement))
+		if m.Movement != 0 {
+			report.PlayersMoved++
+		}
+
+		for i := range report.RankMovement {
+			if m.Movement >= report.RankMovement[i].Min && m.Movement <= report.RankMovement[i].Max {
+				report.RankMovement[i].Count++
+				break
+			}
+		}
+
+		if p.CurrentTier != p.CandidateTier {
+			churn[[2]player.Tier{p.CurrentTier, p.CandidateTier}]++
+			tierChanges++
+		}
+	}
+
+	n := float64(len(players))
+	report.MeanScoreChange = scoreDelta / n
+	report.MeanAbsRankChange = absRankDelta / n
+	report.TierChurnRate = float64(tierChanges) / n
+
+	for key, count := range churn {
+		report.TierChurn = append(report.TierChurn, TierTransition{From: key[0], To: key[1], Count: count})
+	}
+	sort.Slice(report.TierChurn, func(i, j int) bool {
+		if report.TierChurn[i].Count != report.TierChurn[j].Count {
+			return report.TierChurn[i].Count > report.TierChurn[j].Count
+		}
+		if report.TierChurn[i].From != report.TierChurn[j].From {
+			return report.TierChurn[i].From < report.TierChurn[j].From
+		}
+		return report.TierChurn[i].To < report.TierChurn[j].To
+	})
+
+	sort.SliceStable(movements, func(i, j int) bool {
+		return absInt(movements[i].Movement) > absInt(movements[j].Movement)
+	})
+	for _, m := range movements {
+		if m.Movement == 0 || len(report.LargestMovers) == largestMoversLimit {
+			break
+		}
+		report.LargestMovers = append(report.LargestMovers, m)
+	}
+
+	return report
+}
+
+// rankBy assigns 1-based ranks by descending score, breaking ties by player ID.
+func rankBy(players []SimulatedPlayer, score func(SimulatedPlayer) float64) map[uuid.UUID]int {
+	sorted := make([]SimulatedPlayer, len(players))
+	copy(sorted, players)
+	sort.Slice(sorted, func(i, j int) bool {
+		si, sj := score(sorted[i]), score(sorted[j])
+		if si != sj {
+			return si > sj
+		}
+		return sorted[i].PlayerID.String() < sorted[j].PlayerID.String()
+	})
+
+	ranks := make(map[uuid.UUID]int, len(sorted))
+	for i, p := range sorted {
+		ranks[p.PlayerID] = i + 1
+	}
+	return ranks
+}
+
+func absInt(v int) int {
+	if v < 0 {
+		return -v
+	}
+	return v
+}
@@ -0,0 +1,49 @@
+package ranking
+
+import (
+	"testing"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildSimulationReport(t *testing.T) {
+	t.Parallel()
+
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	report := BuildSimulationReport([]SimulatedPlayer{
+		{PlayerID: a, CurrentScore: 900, CurrentTier: player.TierElite, CandidateScore: 500, CandidateTier: player.TierIntermediate},
+		{PlayerID: b, CurrentScore: 700, CurrentTier: player.TierAdvanced, CandidateScore: 700, CandidateTier: player.TierAdvanced},
+		{PlayerID: c, CurrentScore: 300, CurrentTier: player.TierBeginner, CandidateScore: 850, CandidateTier: player.TierElite},
+	})
+
+	require.Equal(t, 3, report.PlayersEvaluated)
+	require.Equal(t, 2, report.PlayersMoved)
+	require.InDelta(t, 50, report.MeanScoreChange, 0.0001)
+	require.InDelta(t, 2.0/3.0, report.TierChurnRate, 0.0001)
+	require.Len(t, report.TierChurn, 2)
+
+	counts := make(map[string]int)
+	for _, bucket := range report.RankMovement {
+		counts[bucket.Label] = bucket.Count
+	}
+	require.Equal(t, 1, counts["up 1-5"])
+	require.Equal(t, 1, counts["down 1-5"])
+	require.Equal(t, 1, counts["unchanged"])
+
+	require.Len(t, report.LargestMovers, 2)
+	require.Equal(t, a, report.LargestMovers[0].PlayerID)
+	require.Equal(t, -2, report.LargestMovers[0].Movement)
+	require.Equal(t, c, report.LargestMovers[1].PlayerID)
+	require.Equal(t, 2, report.LargestMovers[1].Movement)
+}
+
+func TestBuildSimulationReport_Empty(t *testing.T) {
+	t.Parallel()
+
+	report := BuildSimulationReport(nil)
+	require.Zero(t, report.PlayersEvaluated)
+	require.Empty(t, report.TierChurn)
+	require.Len(t, report.RankMovement, len(movementBuckets()))
+}
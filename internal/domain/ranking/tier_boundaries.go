@@ -0,0 +1,167 @@
+package ranking
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrBoundariesNotFound is returned when a game has no tier boundaries configured.
+	ErrBoundariesNotFound = errors.New("tier boundaries not found")
+
+	// ErrInvalidBoundaries is returned when tier cutoffs are not strictly increasing.
+	ErrInvalidBoundaries = errors.New("tier cutoffs must be positive and increase from intermediate to elite")
+
+	// ErrInvalidGrandfatherPolicy is returned when a grandfathering policy is unknown or incomplete.
+	ErrInvalidGrandfatherPolicy = errors.New("invalid grandfather policy")
+)
+
+// GrandfatherPolicy controls what happens to players whose tier would drop
+// only because the cutoffs changed.
+type GrandfatherPolicy string
+
+const (
+	// GrandfatherNone applies new cutoffs immediately.
+	GrandfatherNone GrandfatherPolicy = "none"
+
+	// GrandfatherUntil keeps the better of the previous and new tier until GraceUntil.
+	GrandfatherUntil GrandfatherPolicy = "until"
+)
+
+// TierBoundaries is a versioned set of score cutoffs for a game's tiers.
+// A version applies from EffectiveFrom until a later version takes effect.
+type TierBoundaries struct {
+	ID            uuid.UUID         `bson:"_id" json:"id"`
+	GameID        uuid.UUID         `bson:"game_id" json:"game_id"`
+	Version       int               `bson:"version" json:"version"`
+	Season        string            `bson:"season,omitempty" json:"season,omitempty"`
+	Intermediate  float64           `bson:"intermediate" json:"intermediate"`
+	Advanced      float64           `bson:"advanced" json:"advanced"`
+	Elite         float64           `bson:"elite" json:"elite"`
+	EffectiveFrom time.Time         `bson:"effective_from" json:"effective_from"`
+	Policy        GrandfatherPolicy `bson:"policy" json:"policy"`
+	GraceUntil    *time.Time        `bson:"grace_until,omitempty" json:"grace_until,omitempty"`
+	CreatedBy     uuid.UUID         `bson:"created_by" json:"created_by"`
+	CreatedAt     time.Time         `bson:"created_at" json:"created_at"`
+}
+
+// DefaultTierBoundaries returns the cutoffs used when a game has none configured.
+func DefaultTierBoundaries() *TierBoundaries {
+	return &TierBoundaries{
+		Intermediate: 400,
+		Advanced:     600,
+		Elite:        800,
+		Policy:       GrandfatherNone,
+	}
+}
+
+// NewTierBoundaries creates a new boundaries version with validation.
+// The version number is assigned by the caller from the previous version.
+func NewTierBoundaries(
+	gameID uuid.UUID,
+	version int,
+	season string,
+	intermediate, advanced, elite float64,
+	effectiveFrom time.Time,
+	policy GrandfatherPolicy,
+	graceUntil *time.Time,
+	createdBy uuid.UUID,
+) (*TierBoundaries, error) {
+	if intermediate <= 0 || advanced <= intermediate || elite <= advanced {
+		return nil, ErrInvalidBoundaries
+	}
+
+	if policy == "" {
+		policy = GrandfatherNone
+	}
+	switch policy {
+	case GrandfatherNone:
+		graceUntil = nil
+	case GrandfatherUntil:
+		if graceUntil == nil || !graceUntil.After(effectiveFrom) {
+			return nil, ErrInvalidGrandfatherPolicy
+		}
+	default:
+		return nil, ErrInvalidGrandfatherPolicy
+	}
+
+	return &TierBoundaries{
+		ID:            uuid.New(),
+		GameID:        gameID,
+		Version:       version,
+		Season:        season,
+		Intermediate:  intermediate,
+		Advanced:      advanced,
+		Elite:         elite,
+		EffectiveFrom: effectiveFrom.UTC(),
+		Policy:        policy,
+		GraceUntil:    graceUntil,
+		CreatedBy:     createdBy,
+		CreatedAt:     time.Now().UTC(),
+	}, nil
+}
+
+// TierFor returns the tier a score falls into under these cutoffs.
+func (b *TierBoundaries) TierFor(score float64) player.Tier {
+	switch {
+	case score >= b.Elite:
+		return player.TierElite
+	case score >= b.Advanced:
+		return player.TierAdvanced
+	case score >= b.Intermediate:
+		return player.TierIntermediate
+	default:
+		return player.TierBeginner
+	}
+}
+
+// InGracePeriod reports whether grandfathering is in effect at the given time.
+func (b *TierBoundaries) InGracePeriod(at time.Time) bool {
+	return b.Policy == GrandfatherUntil && b.GraceUntil != nil && at.Before(*b.GraceUntil)
+}
+
+// ResolveTier returns the tier for a score under the current boundaries,
+// honoring the current version's grandfathering policy against the previous
+// version. The second result reports whether the player was grandfathered,
+// i.e. holds a higher tier than the current cutoffs alone would give.
+func ResolveTier(score float64, current, previous *TierBoundaries, at time.Time) (player.Tier, bool) {
+	tier := current.TierFor(score)
+	if previous == nil || !current.InGracePeriod(at) {
+		return tier, false
+	}
+
+	if old := previous.TierFor(score); TierRank(old) > TierRank(tier) {
+		return old, true
+	}
+	return tier, false
+}
+
+// TierRank orders tiers from lowest to highest.
+func TierRank(t player.Tier) int {
+	switch t {
+	case player.TierElite:
+		return 3
+	case player.TierAdvanced:
+		return 2
+	case player.TierIntermediate:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// BoundaryRepository persists tier boundary versions.
+type BoundaryRepository interface {
+	Create(ctx context.Context, b *TierBoundaries) error
+	// GetEffective returns the latest version in effect at the given time.
+	GetEffective(ctx context.Context, gameID uuid.UUID, at time.Time) (*TierBoundaries, error)
+	// GetByVersion returns a specific version.
+	GetByVersion(ctx context.Context, gameID uuid.UUID, version int) (*TierBoundaries, error)
+	// GetLatest returns the highest version regardless of effective date.
+	GetLatest(ctx context.Context, gameID uuid.UUID) (*TierBoundaries, error)
+	ListByGame(ctx context.Context, gameID uuid.UUID) ([]*TierBoundaries, error)
+}
@@ -0,0 +1,73 @@
+package ranking
+
+import (
+	"testing"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTierBoundaries(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	grace := now.Add(14 * 24 * time.Hour)
+
+	tests := []struct {
+		name         string
+		cutoffs      [3]float64
+		policy       GrandfatherPolicy
+		graceUntil   *time.Time
+		expectedErr  error
+		expectPolicy GrandfatherPolicy
+	}{
+		{name: "defaults to no grandfathering", cutoffs: [3]float64{400, 600, 800}, expectPolicy: GrandfatherNone},
+		{name: "grace period", cutoffs: [3]float64{450, 650, 850}, policy: GrandfatherUntil, graceUntil: &grace, expectPolicy: GrandfatherUntil},
+		{name: "grace without end", cutoffs: [3]float64{450, 650, 850}, policy: GrandfatherUntil, expectedErr: ErrInvalidGrandfatherPolicy},
+		{name: "unknown policy", cutoffs: [3]float64{450, 650, 850}, policy: "forever", expectedErr: ErrInvalidGrandfatherPolicy},
+		{name: "non increasing cutoffs", cutoffs: [3]float64{600, 600, 800}, expectedErr: ErrInvalidBoundaries},
+		{name: "zero intermediate", cutoffs: [3]float64{0, 600, 800}, expectedErr: ErrInvalidBoundaries},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			b, err := NewTierBoundaries(uuid.New(), 1, "S1", tc.cutoffs[0], tc.cutoffs[1], tc.cutoffs[2], now, tc.policy, tc.graceUntil, uuid.New())
+			if tc.expectedErr != nil {
+				require.ErrorIs(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectPolicy, b.Policy)
+		})
+	}
+}
+
+func TestResolveTier(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	grace := now.Add(time.Hour)
+	previous := DefaultTierBoundaries()
+	current, err := NewTierBoundaries(uuid.New(), 2, "", 500, 700, 900, now.Add(-time.Hour), GrandfatherUntil, &grace, uuid.New())
+	require.NoError(t, err)
+
+	tier, grandfathered := ResolveTier(850, current, previous, now)
+	require.Equal(t, player.TierElite, tier)
+	require.True(t, grandfathered)
+
+	tier, grandfathered = ResolveTier(950, current, previous, now)
+	require.Equal(t, player.TierElite, tier)
+	require.False(t, grandfathered)
+
+	tier, grandfathered = ResolveTier(850, current, previous, grace.Add(time.Minute))
+	require.Equal(t, player.TierAdvanced, tier)
+	require.False(t, grandfathered)
+
+	tier, grandfathered = ResolveTier(850, current, nil, now)
+	require.Equal(t, player.TierAdvanced, tier)
+	require.False(t, grandfathered)
+}
@@ -0,0 +1,29 @@
+package season
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repository persists seasons.
+type Repository interface {
+	// Create stores a season. It returns ErrOverlap if the game already has a
+	// season sharing any of its time.
+	Create(ctx context.Context, s *Season) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Season, error)
+
+	// ListByGame returns a game's seasons, newest first.
+	ListByGame(ctx context.Context, gameID uuid.UUID) ([]*Season, error)
+
+	// GetCurrent returns the game's unarchived season running at t, or
+	// ErrNotFound if there is none.
+	GetCurrent(ctx context.Context, gameID uuid.UUID, t time.Time) (*Season, error)
+
+	// ListEnded returns the unarchived seasons of every game that ended by now,
+	// oldest first.
+	ListEnded(ctx context.Context, now time.Time) ([]*Season, error)
+
+	Update(ctx context.Context, s *Season) error
+}
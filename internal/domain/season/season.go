@@ -0,0 +1,170 @@
+// Package season provides domain entities and logic for ranked seasons. A
+// season is a stretch of time over which a game's players are ranked afresh:
+// matches verified while it runs count towards season-scoped player stats,
+// and once it ends its final standings are archived and the next season
+// starts.
+package season
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxLength is the longest a season may run.
+	MaxLength = 365 * 24 * time.Hour
+
+	// MaxStandings is how many of a season's top players are archived when it ends.
+	MaxStandings = 1000
+
+	// MaxNameLength is the longest season name accepted, in characters.
+	MaxNameLength = 60
+)
+
+var (
+	ErrNotFound        = errors.New("season not found")
+	ErrInvalidGame     = errors.New("game ID cannot be empty")
+	ErrInvalidName     = errors.New("season name must be at most 60 characters")
+	ErrInvalidWindow   = errors.New("season must end after it starts and last at most 365 days")
+	ErrOverlap         = errors.New("season overlaps another season of the game")
+	ErrNotEnded        = errors.New("season has not ended yet")
+	ErrAlreadyArchived = errors.New("season is already archived")
+)
+
+// Status is where a season is in its lifecycle. It is derived from the
+// season's dates and whether it was archived, never stored.
+type Status string
+
+const (
+	StatusScheduled Status = "scheduled"
+	StatusActive    Status = "active"
+	StatusEnded     Status = "ended" // Over, waiting for the rollover to archive it
+	StatusArchived  Status = "archived"
+)
+
+// Standing is a player's final place in an archived season.
+type Standing struct {
+	Rank          int         `bson:"rank" json:"rank"`
+	PlayerID      uuid.UUID   `bson:"player_id" json:"player_id"`
+	DisplayName   string      `bson:"display_name" json:"display_name"`
+	RankingScore  float64     `bson:"ranking_score" json:"ranking_score"`
+	Tier          player.Tier `bson:"tier" json:"tier"`
+	MatchesPlayed int         `bson:"matches_played" json:"matches_played"`
+	Anonymous     bool        `bson:"-" json:"anonymous,omitempty"` // Withheld when served: the player opts out of public leaderboards
+}
+
+// Season is a numbered ranked season of a game. Seasons of a game never
+// overlap; the rollover starts each one where the previous one ended.
+type Season struct {
+	ID         uuid.UUID  `bson:"_id" json:"id"`
+	GameID     uuid.UUID  `bson:"game_id" json:"game_id"`
+	Number     int        `bson:"number" json:"number"`
+	Name       string     `bson:"name" json:"name"`
+	StartsAt   time.Time  `bson:"starts_at" json:"starts_at"`
+	EndsAt     time.Time  `bson:"ends_at" json:"ends_at"`
+	Standings  []Standing `bson:"standings,omitempty" json:"standings,omitempty"` // Final standings, best first, once archived
+	ArchivedAt *time.Time `bson:"archived_at,omitempty" json:"archived_at,omitempty"`
+	CreatedBy  uuid.UUID  `bson:"created_by,omitempty" json:"created_by,omitempty"` // Nil for seasons started by the rollover
+	CreatedAt  time.Time  `bson:"created_at" json:"created_at"`
+	UpdatedAt  time.Time  `bson:"updated_at" json:"updated_at"`
+}
+
+// NewSeason creates a game's season number over [startsAt, endsAt). An
+// empty name defaults to "Season <number>".
+func NewSeason(gameID, createdBy uuid.UUID, number int, name string, startsAt, endsAt time.Time) (*Season, error) {
+	if gameID == uuid.Nil {
+		return nil, ErrInvalidGame
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = fmt.Sprintf("Season %d", number)
+	}
+	if len([]rune(name)) > MaxNameLength {
+		return nil, ErrInvalidName
+	}
+	if !endsAt.After(startsAt) || endsAt.Sub(startsAt) > MaxLength {
+		return nil, ErrInvalidWindow
+	}
+
+	now := time.Now().UTC()
+	return &Season{
+		ID:        uuid.New(),
+		GameID:    gameID,
+		Number:    number,
+		Name:      name,
+		StartsAt:  startsAt.UTC(),
+		EndsAt:    endsAt.UTC(),
+		CreatedBy: createdBy,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}, nil
+}
+
+// Length is how long the season runs.
+func (s *Season) Length() time.Duration {
+	return s.EndsAt.Sub(s.StartsAt)
+}
+
+// Status returns the season's status at now.
+func (s *Season) Status(now time.Time) Status {
+	switch {
+	case s.ArchivedAt != nil:
+		return StatusArchived
+	case now.Before(s.StartsAt):
+		return StatusScheduled
+	case now.Before(s.EndsAt):
+		return StatusActive
+	default:
+		return StatusEnded
+	}
+}
+
+// Contains reports whether t falls within the season.
+func (s *Season) Contains(t time.Time) bool {
+	return !t.Before(s.StartsAt) && t.Before(s.EndsAt)
+}
+
+// Overlaps reports whether the season shares any time with other.
+func (s *Season) Overlaps(other *Season) bool {
+	return s.StartsAt.Before(other.EndsAt) && other.StartsAt.Before(s.EndsAt)
+}
+
+// Archive records the season's final standings. Only a season that has ended
+// can be archived, and only once; its stats are frozen from then on.
+func (s *Season) Archive(standings []Standing, now time.Time) error {
+	if s.ArchivedAt != nil {
+		return ErrAlreadyArchived
+	}
+	if now.Before(s.EndsAt) {
+		return ErrNotEnded
+	}
+	if len(standings) > MaxStandings {
+		standings = standings[:MaxStandings]
+	}
+
+	s.Standings = standings
+	s.ArchivedAt = &now
+	s.UpdatedAt = now
+	return nil
+}
+
+// Next returns the season that follows this one: numbered after it, as long
+// as it and starting when it ends.
+func (s *Season) Next() *Season {
+	now := time.Now().UTC()
+	return &Season{
+		ID:        uuid.New(),
+		GameID:    s.GameID,
+		Number:    s.Number + 1,
+		Name:      fmt.Sprintf("Season %d", s.Number+1),
+		StartsAt:  s.EndsAt,
+		EndsAt:    s.EndsAt.Add(s.Length()),
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
@@ -0,0 +1,112 @@
+package season
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var seasonStart = time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func TestNewSeason(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		gameID       uuid.UUID
+		seasonName   string
+		endsAt       time.Time
+		expectedName string
+		expectedErr  error
+	}{
+		{"valid", uuid.New(), "Winter Split", seasonStart.AddDate(0, 3, 0), "Winter Split", nil},
+		{"default name", uuid.New(), "  ", seasonStart.AddDate(0, 3, 0), "Season 3", nil},
+		{"missing game", uuid.Nil, "", seasonStart.AddDate(0, 3, 0), "", ErrInvalidGame},
+		{"name too long", uuid.New(), strings.Repeat("x", MaxNameLength+1), seasonStart.AddDate(0, 3, 0), "", ErrInvalidName},
+		{"ends before it starts", uuid.New(), "", seasonStart, "", ErrInvalidWindow},
+		{"longer than a year", uuid.New(), "", seasonStart.AddDate(1, 0, 1), "", ErrInvalidWindow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s, err := NewSeason(tt.gameID, uuid.New(), 3, tt.seasonName, seasonStart, tt.endsAt)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedName, s.Name)
+			assert.Equal(t, 3, s.Number)
+		})
+	}
+}
+
+func TestSeason_Status(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewSeason(uuid.New(), uuid.New(), 1, "", seasonStart, seasonStart.AddDate(0, 1, 0))
+	require.NoError(t, err)
+
+	assert.Equal(t, StatusScheduled, s.Status(seasonStart.Add(-time.Second)))
+	assert.Equal(t, StatusActive, s.Status(seasonStart))
+	assert.Equal(t, StatusEnded, s.Status(s.EndsAt))
+
+	require.NoError(t, s.Archive(nil, s.EndsAt))
+	assert.Equal(t, StatusArchived, s.Status(s.EndsAt))
+}
+
+func TestSeason_Overlaps(t *testing.T) {
+	t.Parallel()
+
+	gameID := uuid.New()
+	first, err := NewSeason(gameID, uuid.Nil, 1, "", seasonStart, seasonStart.AddDate(0, 1, 0))
+	require.NoError(t, err)
+
+	second := first.Next()
+	assert.False(t, first.Overlaps(second), "consecutive seasons share no time")
+
+	overlapping, err := NewSeason(gameID, uuid.Nil, 2, "", seasonStart.AddDate(0, 0, 20), seasonStart.AddDate(0, 2, 0))
+	require.NoError(t, err)
+	assert.True(t, first.Overlaps(overlapping))
+	assert.True(t, overlapping.Overlaps(first))
+}
+
+func TestSeason_Archive(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewSeason(uuid.New(), uuid.New(), 1, "", seasonStart, seasonStart.AddDate(0, 1, 0))
+	require.NoError(t, err)
+
+	standings := []Standing{{Rank: 1, PlayerID: uuid.New(), RankingScore: 1800}}
+
+	require.ErrorIs(t, s.Archive(standings, s.EndsAt.Add(-time.Second)), ErrNotEnded)
+
+	require.NoError(t, s.Archive(standings, s.EndsAt))
+	assert.Equal(t, standings, s.Standings)
+	require.NotNil(t, s.ArchivedAt)
+
+	require.ErrorIs(t, s.Archive(standings, s.EndsAt), ErrAlreadyArchived)
+}
+
+func TestSeason_Next(t *testing.T) {
+	t.Parallel()
+
+	s, err := NewSeason(uuid.New(), uuid.New(), 4, "Spring Split", seasonStart, seasonStart.AddDate(0, 0, 90))
+	require.NoError(t, err)
+
+	next := s.Next()
+	assert.Equal(t, s.GameID, next.GameID)
+	assert.Equal(t, 5, next.Number)
+	assert.Equal(t, "Season 5", next.Name)
+	assert.Equal(t, s.EndsAt, next.StartsAt)
+	assert.Equal(t, s.Length(), next.Length())
+	assert.Equal(t, uuid.Nil, next.CreatedBy)
+	assert.True(t, next.Contains(s.EndsAt))
+	assert.False(t, s.Contains(s.EndsAt))
+}
@@ -0,0 +1,77 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxBroadcastLength caps a broadcast message, in characters.
+	MaxBroadcastLength = 280
+
+	// BroadcastsPerWindow is how many broadcasts a team may send per BroadcastWindow.
+	BroadcastsPerWindow = 5
+
+	// BroadcastWindow is the sliding window BroadcastsPerWindow applies to.
+	BroadcastWindow = time.Hour
+
+	// RecentBroadcasts is how many broadcasts the team details include.
+	RecentBroadcasts = 5
+)
+
+var (
+	ErrInvalidBroadcast     = errors.New("broadcast message must be between 1 and 280 characters")
+	ErrBroadcastRateLimited = errors.New("team has sent too many broadcasts recently")
+)
+
+// Broadcast is a message a captain sends to the whole team, such as the time
+// of the next match.
+type Broadcast struct {
+	ID           uuid.UUID `bson:"_id" json:"id"`
+	TeamID       uuid.UUID `bson:"team_id" json:"team_id"`
+	TournamentID uuid.UUID `bson:"tournament_id" json:"tournament_id"`
+	SenderID     uuid.UUID `bson:"sender_id" json:"sender_id"`
+	Message      string    `bson:"message" json:"message"`
+	Recipients   int       `bson:"recipients" json:"recipients"` // Members and staff other than the sender it was delivered to
+	CreatedAt    time.Time `bson:"created_at" json:"created_at"`
+}
+
+// NewBroadcast creates a broadcast from the team's captain to its other
+// members and its staff.
+func NewBroadcast(t *Team, senderID uuid.UUID, message string, now time.Time) (*Broadcast, error) {
+	if !t.IsCaptain(senderID) {
+		return nil, ErrNotCaptain
+	}
+
+	message = strings.TrimSpace(message)
+	if message == "" || utf8.RuneCountInString(message) > MaxBroadcastLength {
+		return nil, ErrInvalidBroadcast
+	}
+
+	return &Broadcast{
+		ID:           uuid.New(),
+		TeamID:       t.ID,
+		TournamentID: t.TournamentID,
+		SenderID:     senderID,
+		Message:      message,
+		Recipients:   len(t.Recipients()) - 1,
+		CreatedAt:    now.UTC(),
+	}, nil
+}
+
+// BroadcastRepository persists team broadcasts.
+type BroadcastRepository interface {
+	// Create stores a new broadcast.
+	Create(ctx context.Context, b *Broadcast) error
+
+	// ListByTeam returns a team's broadcasts, newest first.
+	ListByTeam(ctx context.Context, teamID uuid.UUID, limit int64) ([]*Broadcast, error)
+
+	// CountSince returns the number of broadcasts a team sent at or after since.
+	CountSince(ctx context.Context, teamID uuid.UUID, since time.Time) (int64, error)
+}
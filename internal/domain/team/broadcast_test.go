@@ -0,0 +1,64 @@
+package team
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBroadcast(t *testing.T) {
+	t.Parallel()
+
+	captain, member := uuid.New(), uuid.New()
+	tm, err := NewTeam(uuid.New(), captain, "Alpha")
+	require.NoError(t, err)
+	require.NoError(t, tm.AddMember(member))
+
+	now := time.Date(2026, 5, 1, 21, 0, 0, 0, time.FixedZone("BRT", -3*3600))
+	b, err := NewBroadcast(tm, captain, "  we play at 9pm  ", now)
+	require.NoError(t, err)
+	require.Equal(t, tm.ID, b.TeamID)
+	require.Equal(t, tm.TournamentID, b.TournamentID)
+	require.Equal(t, "we play at 9pm", b.Message)
+	require.Equal(t, 1, b.Recipients)
+	require.Equal(t, time.UTC, b.CreatedAt.Location())
+
+	require.NoError(t, tm.AddStaff(uuid.New(), StaffCoach))
+	b, err = NewBroadcast(tm, captain, "we play at 9pm", now)
+	require.NoError(t, err)
+	require.Equal(t, 2, b.Recipients)
+
+	_, err = NewBroadcast(tm, member, "we play at 9pm", now)
+	require.ErrorIs(t, err, ErrNotCaptain)
+}
+
+func TestNewBroadcast_Message(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		message string
+		wantErr error
+	}{
+		{name: "at limit", message: strings.Repeat("á", MaxBroadcastLength)},
+		{name: "empty", message: "", wantErr: ErrInvalidBroadcast},
+		{name: "blank", message: " \n\t", wantErr: ErrInvalidBroadcast},
+		{name: "too long", message: strings.Repeat("a", MaxBroadcastLength+1), wantErr: ErrInvalidBroadcast},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			captain := uuid.New()
+			tm, err := NewTeam(uuid.New(), captain, "Alpha")
+			require.NoError(t, err)
+
+			_, err = NewBroadcast(tm, captain, tc.message, time.Now())
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,51 @@
+package team
+
+import "time"
+
+// CleanupReason is why the tournament's cleanup policy flagged a team.
+type CleanupReason string
+
+const (
+	CleanupEmpty    CleanupReason = "empty"    // No member besides the captain after registration closed
+	CleanupInactive CleanupReason = "inactive" // No verified match by the policy's round
+)
+
+// CleanupFlag marks a team the cleanup policy considers not to be taking part.
+type CleanupFlag struct {
+	Reason    CleanupReason `bson:"reason" json:"reason"`
+	FlaggedAt time.Time     `bson:"flagged_at" json:"flagged_at"`
+}
+
+// CleanupCheck returns why the cleanup policy should flag the team, or ""
+// when it is taking part. registrationClosed reports whether teams can no
+// longer register and roundReached whether the tournament has reached the
+// policy's round; verifiedMatches is the team's number of verified matches.
+func (t *Team) CleanupCheck(registrationClosed, roundReached bool, verifiedMatches int) CleanupReason {
+	switch {
+	case t.Status == StatusDisbanded || t.Status == StatusDisqualified || t.Status == StatusEliminated:
+		return ""
+	case registrationClosed && len(t.MemberIDs) <= 1:
+		return CleanupEmpty
+	case roundReached && verifiedMatches == 0:
+		return CleanupInactive
+	default:
+		return ""
+	}
+}
+
+// SetCleanupFlag flags the team for reason, or clears the flag when reason is
+// empty. It reports whether the flag changed.
+func (t *Team) SetCleanupFlag(reason CleanupReason, now time.Time) bool {
+	switch {
+	case reason == "" && t.CleanupFlag == nil:
+		return false
+	case reason == "":
+		t.CleanupFlag = nil
+	case t.CleanupFlag != nil && t.CleanupFlag.Reason == reason:
+		return false
+	default:
+		t.CleanupFlag = &CleanupFlag{Reason: reason, FlaggedAt: now}
+	}
+	t.UpdatedAt = now
+	return true
+}
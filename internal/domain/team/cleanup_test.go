@@ -0,0 +1,49 @@
+package team
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeam_CleanupCheck(t *testing.T) {
+	t.Parallel()
+
+	tm, err := NewTeam(uuid.New(), uuid.New(), "Alpha")
+	require.NoError(t, err)
+
+	require.Empty(t, tm.CleanupCheck(false, false, 0), "registration still open")
+	require.Equal(t, CleanupEmpty, tm.CleanupCheck(true, true, 0), "an empty team is flagged as empty first")
+
+	require.NoError(t, tm.AddMember(uuid.New()))
+	require.Empty(t, tm.CleanupCheck(true, false, 0))
+	require.Equal(t, CleanupInactive, tm.CleanupCheck(true, true, 0))
+	require.Empty(t, tm.CleanupCheck(true, true, 1))
+
+	require.NoError(t, tm.UpdateStatus(StatusDisbanded))
+	require.Empty(t, tm.CleanupCheck(true, true, 0), "disbanded teams are left alone")
+}
+
+func TestTeam_SetCleanupFlag(t *testing.T) {
+	t.Parallel()
+
+	tm, err := NewTeam(uuid.New(), uuid.New(), "Alpha")
+	require.NoError(t, err)
+	now := time.Now().UTC()
+
+	require.False(t, tm.SetCleanupFlag("", now))
+	require.True(t, tm.SetCleanupFlag(CleanupEmpty, now))
+	require.Equal(t, CleanupEmpty, tm.CleanupFlag.Reason)
+
+	later := now.Add(time.Hour)
+	require.False(t, tm.SetCleanupFlag(CleanupEmpty, later))
+	require.Equal(t, now, tm.CleanupFlag.FlaggedAt, "a standing flag keeps its time")
+
+	require.True(t, tm.SetCleanupFlag(CleanupInactive, later))
+	require.Equal(t, later, tm.CleanupFlag.FlaggedAt)
+
+	require.True(t, tm.SetCleanupFlag("", later))
+	require.Nil(t, tm.CleanupFlag)
+}
@@ -0,0 +1,137 @@
+package team
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Permission is a team permission a captain can grant to members.
+type Permission string
+
+const (
+	// PermissionCanSubmit allows a member to submit match reports.
+	PermissionCanSubmit Permission = "can_submit"
+)
+
+// HistoryAction describes a change recorded in a team's history.
+type HistoryAction string
+
+const (
+	// HistoryCreated marks the team being created by its captain.
+	HistoryCreated HistoryAction = "created"
+
+	// HistoryMemberJoined marks a player joining the roster.
+	HistoryMemberJoined HistoryAction = "member_joined"
+
+	// HistoryMemberLeft marks a player leaving or being removed from the roster.
+	HistoryMemberLeft HistoryAction = "member_left"
+
+	// HistoryCaptainTransferred marks captaincy passing to another member.
+	HistoryCaptainTransferred HistoryAction = "captain_transferred"
+
+	// HistoryRenamed marks a change of the team's name.
+	HistoryRenamed HistoryAction = "renamed"
+
+	// HistoryDisqualified marks the team being disqualified by an admin.
+	HistoryDisqualified HistoryAction = "disqualified"
+
+	// HistoryPermissionGranted marks a permission granted to a member.
+	HistoryPermissionGranted HistoryAction = "permission_granted"
+
+	// HistoryPermissionRevoked marks a permission withdrawn from a member.
+	HistoryPermissionRevoked HistoryAction = "permission_revoked"
+
+	// HistoryStaffAdded marks a coach or manager joining the team's staff.
+	HistoryStaffAdded HistoryAction = "staff_added"
+
+	// HistoryStaffRemoved marks a coach or manager leaving the team's staff.
+	HistoryStaffRemoved HistoryAction = "staff_removed"
+
+	// HistoryCaptainSucceeded marks captaincy passing to a member without the
+	// captain handing it over.
+	HistoryCaptainSucceeded HistoryAction = "captain_succeeded"
+
+	// HistoryCleanedUp marks the team being disbanded by its tournament's
+	// cleanup policy.
+	HistoryCleanedUp HistoryAction = "cleaned_up"
+)
+
+// HistoryEntry records a change made to a team. Entries are never modified
+// once written.
+type HistoryEntry struct {
+	ID         uuid.UUID     `bson:"_id" json:"id"`
+	TeamID     uuid.UUID     `bson:"team_id" json:"team_id"`
+	Action     HistoryAction `bson:"action" json:"action"`
+	ActorID    uuid.UUID     `bson:"actor_id" json:"actor_id"`
+	PlayerID   uuid.UUID     `bson:"player_id" json:"player_id"` // Player the change applies to
+	Permission Permission    `bson:"permission,omitempty" json:"permission,omitempty"`
+	From       string        `bson:"from,omitempty" json:"from,omitempty"`     // Previous name for renames
+	To         string        `bson:"to,omitempty" json:"to,omitempty"`         // New name for renames
+	Reason     string        `bson:"reason,omitempty" json:"reason,omitempty"` // Reason given for a disqualification, captain succession or cleanup
+	CreatedAt  time.Time     `bson:"created_at" json:"created_at"`
+}
+
+// NewHistoryEntry creates a history entry for an action taken by actorID
+// affecting playerID.
+func NewHistoryEntry(teamID uuid.UUID, action HistoryAction, actorID, playerID uuid.UUID) *HistoryEntry {
+	return &HistoryEntry{
+		ID:        uuid.New(),
+		TeamID:    teamID,
+		Action:    action,
+		ActorID:   actorID,
+		PlayerID:  playerID,
+		CreatedAt: time.Now().UTC(),
+	}
+}
+
+// NewRename creates a history entry for the team being renamed by actorID.
+func NewRename(teamID, actorID uuid.UUID, from, to string) *HistoryEntry {
+	entry := NewHistoryEntry(teamID, HistoryRenamed, actorID, actorID)
+	entry.From = from
+	entry.To = to
+	return entry
+}
+
+// NewDisqualification creates a history entry for the team being disqualified by an admin.
+func NewDisqualification(teamID, adminID uuid.UUID, reason string) *HistoryEntry {
+	entry := NewHistoryEntry(teamID, HistoryDisqualified, adminID, uuid.Nil)
+	entry.Reason = reason
+	return entry
+}
+
+// NewSuccession creates a history entry for captaincy passing to playerID.
+// actorID is the organizer for an override and uuid.Nil otherwise.
+func NewSuccession(teamID, actorID, playerID uuid.UUID, reason SuccessionReason) *HistoryEntry {
+	entry := NewHistoryEntry(teamID, HistoryCaptainSucceeded, actorID, playerID)
+	entry.Reason = string(reason)
+	return entry
+}
+
+// NewCleanup creates a history entry for the team being disbanded by its
+// tournament's cleanup policy.
+func NewCleanup(teamID uuid.UUID, reason CleanupReason) *HistoryEntry {
+	entry := NewHistoryEntry(teamID, HistoryCleanedUp, uuid.Nil, uuid.Nil)
+	entry.Reason = string(reason)
+	return entry
+}
+
+// NewPermissionChange creates a history entry for a permission granted to or
+// revoked from playerID by actorID.
+func NewPermissionChange(teamID, actorID, playerID uuid.UUID, permission Permission, granted bool) *HistoryEntry {
+	action := HistoryPermissionRevoked
+	if granted {
+		action = HistoryPermissionGranted
+	}
+
+	entry := NewHistoryEntry(teamID, action, actorID, playerID)
+	entry.Permission = permission
+	return entry
+}
+
+// HistoryRepository persists team history.
+type HistoryRepository interface {
+	Create(ctx context.Context, entry *HistoryEntry) error
+	ListByTeam(ctx context.Context, teamID uuid.UUID, limit int64) ([]*HistoryEntry, error)
+}
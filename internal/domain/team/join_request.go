@@ -0,0 +1,91 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrJoinRequestNotFound   = errors.New("join request not found")
+	ErrJoinRequestExists     = errors.New("player already has a pending request for this team")
+	ErrJoinRequestNotPending = errors.New("join request has already been decided")
+)
+
+// JoinRequestStatus is the state of a request to join a team.
+type JoinRequestStatus string
+
+const (
+	JoinRequestPending  JoinRequestStatus = "pending"
+	JoinRequestApproved JoinRequestStatus = "approved"
+	JoinRequestDeclined JoinRequestStatus = "declined"
+)
+
+// JoinRequest is a player's application to join a team that requires approval.
+type JoinRequest struct {
+	ID           uuid.UUID         `bson:"_id" json:"id"`
+	TeamID       uuid.UUID         `bson:"team_id" json:"team_id"`
+	TournamentID uuid.UUID         `bson:"tournament_id" json:"tournament_id"`
+	PlayerID     uuid.UUID         `bson:"player_id" json:"player_id"`
+	Message      string            `bson:"message,omitempty" json:"message,omitempty"`
+	Status       JoinRequestStatus `bson:"status" json:"status"`
+	DecidedBy    *uuid.UUID        `bson:"decided_by,omitempty" json:"decided_by,omitempty"`
+	DecidedAt    *time.Time        `bson:"decided_at,omitempty" json:"decided_at,omitempty"`
+	CreatedAt    time.Time         `bson:"created_at" json:"created_at"`
+}
+
+// NewJoinRequest creates a pending request from a player to join a team.
+func NewJoinRequest(t *Team, playerID uuid.UUID, message string) *JoinRequest {
+	return &JoinRequest{
+		ID:           uuid.New(),
+		TeamID:       t.ID,
+		TournamentID: t.TournamentID,
+		PlayerID:     playerID,
+		Message:      message,
+		Status:       JoinRequestPending,
+		CreatedAt:    time.Now().UTC(),
+	}
+}
+
+// Approve marks the request as approved by the captain.
+func (r *JoinRequest) Approve(captainID uuid.UUID) error {
+	return r.decide(JoinRequestApproved, captainID)
+}
+
+// Decline marks the request as declined by the captain.
+func (r *JoinRequest) Decline(captainID uuid.UUID) error {
+	return r.decide(JoinRequestDeclined, captainID)
+}
+
+func (r *JoinRequest) decide(status JoinRequestStatus, captainID uuid.UUID) error {
+	if r.Status != JoinRequestPending {
+		return ErrJoinRequestNotPending
+	}
+
+	now := time.Now().UTC()
+	r.Status = status
+	r.DecidedBy = &captainID
+	r.DecidedAt = &now
+	return nil
+}
+
+// JoinRequestRepository persists join requests.
+type JoinRequestRepository interface {
+	// Create stores a new join request. Returns ErrJoinRequestExists if the
+	// player already has a pending request for the team.
+	Create(ctx context.Context, req *JoinRequest) error
+
+	// GetByID retrieves a join request by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*JoinRequest, error)
+
+	// Update updates an existing join request.
+	Update(ctx context.Context, req *JoinRequest) error
+
+	// ListPendingByTeam retrieves a team's pending requests, oldest first.
+	ListPendingByTeam(ctx context.Context, teamID uuid.UUID) ([]*JoinRequest, error)
+
+	// ListPendingByPlayer retrieves a player's pending requests, newest first.
+	ListPendingByPlayer(ctx context.Context, playerID uuid.UUID) ([]*JoinRequest, error)
+}
@@ -0,0 +1,15 @@
+package team
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+// OrphanRepository finds and removes teams whose tournament no longer exists.
+type OrphanRepository interface {
+	// FindWithMissingTournament returns up to limit teams whose tournament ID matches no tournament.
+	FindWithMissingTournament(ctx context.Context, limit int64) ([]*Team, error)
+	// Delete removes a team.
+	Delete(ctx context.Context, id uuid.UUID) error
+}
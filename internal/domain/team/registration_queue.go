@@ -0,0 +1,104 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// TicketRetention is how long a processed registration ticket can still be looked up.
+const TicketRetention = 24 * time.Hour
+
+var (
+	ErrTicketNotFound = errors.New("registration ticket not found")
+	ErrAlreadyQueued  = errors.New("player is already waiting in this tournament's registration queue")
+)
+
+// TicketStatus is the state of a queued team registration.
+type TicketStatus string
+
+const (
+	TicketWaiting    TicketStatus = "waiting"    // In line for the registration worker
+	TicketProcessing TicketStatus = "processing" // Claimed by the registration worker
+	TicketRegistered TicketStatus = "registered" // The team was created
+	TicketFailed     TicketStatus = "failed"     // The team could not be created; see Error
+)
+
+// RegistrationTicket is a player's place in a tournament's registration
+// queue. Tickets are processed in the order they were issued, so players
+// register in the order they arrived however many requests race at opening.
+type RegistrationTicket struct {
+	ID           uuid.UUID    `bson:"_id" json:"id"`
+	TournamentID uuid.UUID    `bson:"tournament_id" json:"tournament_id"`
+	PlayerID     uuid.UUID    `bson:"player_id" json:"player_id"`
+	Name         string       `bson:"name" json:"name"`
+	Tag          string       `bson:"tag,omitempty" json:"tag,omitempty"`
+	LogoURL      string       `bson:"logo_url,omitempty" json:"logo_url,omitempty"`
+	Status       TicketStatus `bson:"status" json:"status"`
+	TeamID       *uuid.UUID   `bson:"team_id,omitempty" json:"team_id,omitempty"`
+	Error        string       `bson:"error,omitempty" json:"error,omitempty"`
+	CreatedAt    time.Time    `bson:"created_at" json:"created_at"`
+	ProcessedAt  *time.Time   `bson:"processed_at,omitempty" json:"processed_at,omitempty"`
+	ExpiresAt    *time.Time   `bson:"expires_at,omitempty" json:"expires_at,omitempty"` // When the processed ticket is discarded
+}
+
+// NewRegistrationTicket issues a waiting ticket for a player to register a team.
+func NewRegistrationTicket(tournamentID, playerID uuid.UUID, name, tag, logoURL string) (*RegistrationTicket, error) {
+	if name == "" {
+		return nil, ErrInvalidName
+	}
+
+	return &RegistrationTicket{
+		ID:           uuid.New(),
+		TournamentID: tournamentID,
+		PlayerID:     playerID,
+		Name:         name,
+		Tag:          tag,
+		LogoURL:      logoURL,
+		Status:       TicketWaiting,
+		CreatedAt:    time.Now().UTC(),
+	}, nil
+}
+
+// Register marks the ticket as processed with the created team.
+func (t *RegistrationTicket) Register(teamID uuid.UUID, now time.Time) {
+	t.Status = TicketRegistered
+	t.TeamID = &teamID
+	t.finish(now)
+}
+
+// Fail marks the ticket as processed without creating a team.
+func (t *RegistrationTicket) Fail(reason string, now time.Time) {
+	t.Status = TicketFailed
+	t.Error = reason
+	t.finish(now)
+}
+
+func (t *RegistrationTicket) finish(now time.Time) {
+	expires := now.Add(TicketRetention)
+	t.ProcessedAt = &now
+	t.ExpiresAt = &expires
+}
+
+// RegistrationQueueRepository persists registration tickets.
+type RegistrationQueueRepository interface {
+	// Create stores a new ticket. Returns ErrAlreadyQueued if the player
+	// already has a waiting ticket for the tournament.
+	Create(ctx context.Context, ticket *RegistrationTicket) error
+
+	// GetByID retrieves a ticket by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*RegistrationTicket, error)
+
+	// ClaimNext atomically marks the oldest waiting ticket as processing and
+	// returns it, or returns ErrTicketNotFound when none is waiting.
+	ClaimNext(ctx context.Context) (*RegistrationTicket, error)
+
+	// Update saves a ticket's status and outcome.
+	Update(ctx context.Context, ticket *RegistrationTicket) error
+
+	// CountAhead returns how many tickets of the same tournament are waiting
+	// in front of the given one.
+	CountAhead(ctx context.Context, ticket *RegistrationTicket) (int64, error)
+}
@@ -0,0 +1,35 @@
+package team
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistrationTicket_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewRegistrationTicket(uuid.New(), uuid.New(), "", "", "")
+	require.ErrorIs(t, err, ErrInvalidName)
+
+	ticket, err := NewRegistrationTicket(uuid.New(), uuid.New(), "Night Owls", "OWL", "")
+	require.NoError(t, err)
+	require.Equal(t, TicketWaiting, ticket.Status)
+	require.Nil(t, ticket.ExpiresAt)
+
+	now := time.Now().UTC()
+	teamID := uuid.New()
+	ticket.Register(teamID, now)
+	require.Equal(t, TicketRegistered, ticket.Status)
+	require.Equal(t, teamID, *ticket.TeamID)
+	require.Equal(t, now.Add(TicketRetention), *ticket.ExpiresAt)
+
+	failed, err := NewRegistrationTicket(uuid.New(), uuid.New(), "Late", "", "")
+	require.NoError(t, err)
+	failed.Fail("tournament is full", now)
+	require.Equal(t, TicketFailed, failed.Status)
+	require.Equal(t, "tournament is full", failed.Error)
+	require.Nil(t, failed.TeamID)
+}
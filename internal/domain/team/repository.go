@@ -14,6 +14,10 @@ type Repository interface {
 	// GetByID retrieves a team by its ID.
 	GetByID(ctx context.Context, id uuid.UUID) (*Team, error)
 
+	// GetByIDFields retrieves a team loading only the given fields, by their
+	// JSON names, besides its ID. Nil fields loads every field.
+	GetByIDFields(ctx context.Context, id uuid.UUID, fields []string) (*Team, error)
+
 	// GetByInviteCode retrieves a team by its invite code.
 	GetByInviteCode(ctx context.Context, inviteCode string) (*Team, error)
 
@@ -0,0 +1,128 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrRosterOverlapNotFound = errors.New("roster overlap not found")
+	ErrRosterOverlapExists   = errors.New("roster overlap has already been flagged")
+	ErrRosterOverlapReviewed = errors.New("roster overlap has already been reviewed")
+	ErrInvalidOverlapReview  = errors.New("review status must be confirmed or dismissed")
+)
+
+// RosterOverlapStatus is the state of an organizer's review of a roster overlap.
+type RosterOverlapStatus string
+
+const (
+	RosterOverlapPending   RosterOverlapStatus = "pending"
+	RosterOverlapConfirmed RosterOverlapStatus = "confirmed" // The accounts belong to the same person
+	RosterOverlapDismissed RosterOverlapStatus = "dismissed"
+)
+
+// RosterOverlap flags two teams in a tournament whose rosters include
+// different accounts with the same platform ID, which may be one person
+// playing for both teams.
+type RosterOverlap struct {
+	ID           uuid.UUID           `bson:"_id" json:"id"`
+	TournamentID uuid.UUID           `bson:"tournament_id" json:"tournament_id"`
+	Key          string              `bson:"key" json:"-"` // Identifies the platform ID and team pair; flagged once
+	Platform     string              `bson:"platform" json:"platform"`
+	PlatformID   string              `bson:"platform_id" json:"platform_id"`
+	TeamIDs      []uuid.UUID         `bson:"team_ids" json:"team_ids"`
+	PlayerIDs    []uuid.UUID         `bson:"player_ids" json:"player_ids"` // The account on each team, in TeamIDs order
+	MatchID      uuid.UUID           `bson:"match_id" json:"match_id"`     // Verified match that surfaced the overlap
+	Status       RosterOverlapStatus `bson:"status" json:"status"`
+	Note         string              `bson:"note,omitempty" json:"note,omitempty"`
+	ReviewedBy   *uuid.UUID          `bson:"reviewed_by,omitempty" json:"reviewed_by,omitempty"`
+	ReviewedAt   *time.Time          `bson:"reviewed_at,omitempty" json:"reviewed_at,omitempty"`
+	DetectedAt   time.Time           `bson:"detected_at" json:"detected_at"`
+}
+
+// DetectRosterOverlaps compares the platform IDs of t's members with those of
+// the other teams in its tournament. platformIDs maps player IDs to their
+// platform IDs. Disbanded teams are skipped.
+func DetectRosterOverlaps(t *Team, others []*Team, platformIDs map[uuid.UUID]map[string]string, matchID uuid.UUID, now time.Time) []*RosterOverlap {
+	var overlaps []*RosterOverlap
+	for _, other := range others {
+		if other.ID == t.ID || other.TournamentID != t.TournamentID || other.Status == StatusDisbanded {
+			continue
+		}
+		for _, memberID := range t.MemberIDs {
+			for platform, platformID := range platformIDs[memberID] {
+				if platformID == "" {
+					continue
+				}
+				for _, otherID := range other.MemberIDs {
+					if otherID == memberID || !strings.EqualFold(platformIDs[otherID][platform], platformID) {
+						continue
+					}
+					overlaps = append(overlaps, newRosterOverlap(t, other, memberID, otherID, platform, platformID, matchID, now))
+				}
+			}
+		}
+	}
+
+	sort.Slice(overlaps, func(i, j int) bool { return overlaps[i].Key < overlaps[j].Key })
+	return overlaps
+}
+
+func newRosterOverlap(a, b *Team, playerA, playerB uuid.UUID, platform, platformID string, matchID uuid.UUID, now time.Time) *RosterOverlap {
+	// Order the pair so that either team's verification yields the same key
+	if b.ID.String() < a.ID.String() {
+		a, b = b, a
+		playerA, playerB = playerB, playerA
+	}
+	platformID = strings.ToLower(platformID)
+	return &RosterOverlap{
+		ID:           uuid.New(),
+		TournamentID: a.TournamentID,
+		Key:          strings.Join([]string{platform, platformID, a.ID.String(), b.ID.String()}, "/"),
+		Platform:     platform,
+		PlatformID:   platformID,
+		TeamIDs:      []uuid.UUID{a.ID, b.ID},
+		PlayerIDs:    []uuid.UUID{playerA, playerB},
+		MatchID:      matchID,
+		Status:       RosterOverlapPending,
+		DetectedAt:   now.UTC(),
+	}
+}
+
+// Review records the organizer's decision on the overlap.
+func (o *RosterOverlap) Review(status RosterOverlapStatus, reviewerID uuid.UUID, note string, now time.Time) error {
+	if status != RosterOverlapConfirmed && status != RosterOverlapDismissed {
+		return ErrInvalidOverlapReview
+	}
+	if o.Status != RosterOverlapPending {
+		return ErrRosterOverlapReviewed
+	}
+
+	now = now.UTC()
+	o.Status = status
+	o.Note = strings.TrimSpace(note)
+	o.ReviewedBy = &reviewerID
+	o.ReviewedAt = &now
+	return nil
+}
+
+// RosterOverlapRepository persists roster overlaps.
+type RosterOverlapRepository interface {
+	// Create stores a new roster overlap. Returns ErrRosterOverlapExists if the
+	// same platform ID was already flagged for the same teams.
+	Create(ctx context.Context, overlap *RosterOverlap) error
+
+	// GetByID retrieves a roster overlap by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*RosterOverlap, error)
+
+	// Update updates an existing roster overlap.
+	Update(ctx context.Context, overlap *RosterOverlap) error
+
+	// ListByTournament retrieves a tournament's roster overlaps, newest first.
+	ListByTournament(ctx context.Context, tournamentID uuid.UUID) ([]*RosterOverlap, error)
+}
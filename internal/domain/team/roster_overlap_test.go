@@ -0,0 +1,83 @@
+package team
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectRosterOverlaps(t *testing.T) {
+	t.Parallel()
+
+	tournamentID := uuid.New()
+	alice, bob, carol, dave, erin := uuid.New(), uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	alpha, err := NewTeam(tournamentID, alice, "Alpha")
+	require.NoError(t, err)
+	require.NoError(t, alpha.AddMember(bob))
+	bravo, err := NewTeam(tournamentID, carol, "Bravo")
+	require.NoError(t, err)
+	disbanded, err := NewTeam(tournamentID, dave, "Charlie")
+	require.NoError(t, err)
+	disbanded.Status = StatusDisbanded
+	elsewhere, err := NewTeam(uuid.New(), erin, "Delta")
+	require.NoError(t, err)
+
+	platformIDs := map[uuid.UUID]map[string]string{
+		alice: {"activision_id": "", "epic_id": "alice"},
+		bob:   {"activision_id": "Bob#1234"},
+		carol: {"activision_id": "bob#1234"},
+		dave:  {"activision_id": "Bob#1234"},
+		erin:  {"activision_id": "Bob#1234"},
+	}
+	matchID := uuid.New()
+	now := time.Date(2026, 5, 1, 21, 0, 0, 0, time.FixedZone("BRT", -3*3600))
+
+	overlaps := DetectRosterOverlaps(alpha, []*Team{alpha, bravo, disbanded, elsewhere}, platformIDs, matchID, now)
+	require.Len(t, overlaps, 1)
+	o := overlaps[0]
+	require.Equal(t, tournamentID, o.TournamentID)
+	require.Equal(t, "activision_id", o.Platform)
+	require.Equal(t, "bob#1234", o.PlatformID)
+	require.ElementsMatch(t, []uuid.UUID{alpha.ID, bravo.ID}, o.TeamIDs)
+	require.Equal(t, matchID, o.MatchID)
+	require.Equal(t, RosterOverlapPending, o.Status)
+	require.Equal(t, time.UTC, o.DetectedAt.Location())
+	for i, teamID := range o.TeamIDs {
+		if teamID == alpha.ID {
+			require.Equal(t, bob, o.PlayerIDs[i])
+		} else {
+			require.Equal(t, carol, o.PlayerIDs[i])
+		}
+	}
+
+	// Verifying the other team's match flags the same overlap
+	reverse := DetectRosterOverlaps(bravo, []*Team{alpha}, platformIDs, uuid.New(), now)
+	require.Len(t, reverse, 1)
+	require.Equal(t, o.Key, reverse[0].Key)
+	require.Equal(t, o.TeamIDs, reverse[0].TeamIDs)
+	require.Equal(t, o.PlayerIDs, reverse[0].PlayerIDs)
+}
+
+func TestRosterOverlap_Review(t *testing.T) {
+	t.Parallel()
+
+	newOverlap := func() *RosterOverlap {
+		return &RosterOverlap{ID: uuid.New(), Status: RosterOverlapPending}
+	}
+	reviewer := uuid.New()
+	now := time.Now()
+
+	o := newOverlap()
+	require.NoError(t, o.Review(RosterOverlapDismissed, reviewer, "  different people  ", now))
+	require.Equal(t, RosterOverlapDismissed, o.Status)
+	require.Equal(t, "different people", o.Note)
+	require.Equal(t, &reviewer, o.ReviewedBy)
+	require.NotNil(t, o.ReviewedAt)
+	require.ErrorIs(t, o.Review(RosterOverlapConfirmed, reviewer, "", now), ErrRosterOverlapReviewed)
+
+	for _, status := range []RosterOverlapStatus{RosterOverlapPending, "", "banned"} {
+		require.ErrorIs(t, newOverlap().Review(status, reviewer, "", now), ErrInvalidOverlapReview)
+	}
+}
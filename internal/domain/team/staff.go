@@ -0,0 +1,116 @@
+package team
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxStaff caps how many non-playing staff a team can have.
+const MaxStaff = 3
+
+var (
+	ErrInvalidStaffRole = errors.New("staff role must be coach or manager")
+	ErrAlreadyStaff     = errors.New("player is already on the team's staff")
+	ErrNotStaff         = errors.New("player is not on the team's staff")
+	ErrTooManyStaff     = errors.New("team cannot have more than 3 staff")
+)
+
+// StaffRole is a non-playing role on a team.
+type StaffRole string
+
+const (
+	StaffCoach   StaffRole = "coach"
+	StaffManager StaffRole = "manager"
+)
+
+// ParseStaffRole parses a staff role.
+func ParseStaffRole(value string) (StaffRole, error) {
+	switch r := StaffRole(value); r {
+	case StaffCoach, StaffManager:
+		return r, nil
+	default:
+		return "", ErrInvalidStaffRole
+	}
+}
+
+// StaffMember is a non-playing coach or manager. Staff see the team's match
+// drafts, can reschedule its matches and get its notifications, but they do
+// not count toward the roster and never appear in match stats.
+type StaffMember struct {
+	PlayerID uuid.UUID `bson:"player_id" json:"player_id"`
+	Role     StaffRole `bson:"role" json:"role"`
+	AddedAt  time.Time `bson:"added_at" json:"added_at"`
+}
+
+// AddStaff adds a player who is not on the roster to the team's staff.
+func (t *Team) AddStaff(playerID uuid.UUID, role StaffRole) error {
+	if _, err := ParseStaffRole(string(role)); err != nil {
+		return err
+	}
+	if t.HasMember(playerID) {
+		return ErrPlayerAlreadyInTeam
+	}
+	if t.IsStaff(playerID) {
+		return ErrAlreadyStaff
+	}
+	if len(t.Staff) >= MaxStaff {
+		return ErrTooManyStaff
+	}
+
+	now := time.Now().UTC()
+	t.Staff = append(t.Staff, StaffMember{PlayerID: playerID, Role: role, AddedAt: now})
+	t.UpdatedAt = now
+	return nil
+}
+
+// RemoveStaff removes a player from the team's staff.
+func (t *Team) RemoveStaff(playerID uuid.UUID) error {
+	kept := make([]StaffMember, 0, len(t.Staff))
+	for _, s := range t.Staff {
+		if s.PlayerID != playerID {
+			kept = append(kept, s)
+		}
+	}
+	if len(kept) == len(t.Staff) {
+		return ErrNotStaff
+	}
+
+	t.Staff = kept
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// IsStaff reports whether a player is one of the team's coaches or managers.
+func (t *Team) IsStaff(playerID uuid.UUID) bool {
+	for _, s := range t.Staff {
+		if s.PlayerID == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// CanView reports whether a player may see the team's private details, such
+// as match reports awaiting verification: its members and staff can.
+func (t *Team) CanView(playerID uuid.UUID) bool {
+	return t.HasMember(playerID) || t.IsStaff(playerID)
+}
+
+// CanSchedule reports whether a player may reschedule the team's matches:
+// its captain and staff can.
+func (t *Team) CanSchedule(playerID uuid.UUID) bool {
+	return t.IsCaptain(playerID) || t.IsStaff(playerID)
+}
+
+// Recipients returns everyone who gets the team's notifications: its
+// members, then its staff.
+func (t *Team) Recipients() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(t.MemberIDs)+len(t.Staff))
+	ids = append(ids, t.MemberIDs...)
+	for _, s := range t.Staff {
+		ids = append(ids, s.PlayerID)
+	}
+	return ids
+}
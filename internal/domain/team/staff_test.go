@@ -0,0 +1,43 @@
+package team
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStaff(t *testing.T) {
+	t.Parallel()
+
+	captain, member, coach, outsider := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	tm, err := NewTeam(uuid.New(), captain, "Alpha")
+	require.NoError(t, err)
+	require.NoError(t, tm.AddMember(member))
+
+	require.ErrorIs(t, tm.AddStaff(coach, "analyst"), ErrInvalidStaffRole)
+	require.ErrorIs(t, tm.AddStaff(member, StaffCoach), ErrPlayerAlreadyInTeam)
+	require.NoError(t, tm.AddStaff(coach, StaffCoach))
+	require.ErrorIs(t, tm.AddStaff(coach, StaffManager), ErrAlreadyStaff)
+	require.ErrorIs(t, tm.AddMember(coach), ErrAlreadyStaff)
+
+	// Staff are off the roster but can follow and schedule the team's matches.
+	require.Len(t, tm.MemberIDs, 2)
+	require.False(t, tm.HasMember(coach))
+	require.False(t, tm.CanSubmit(coach))
+	require.True(t, tm.CanView(coach))
+	require.True(t, tm.CanSchedule(coach))
+	require.True(t, tm.CanView(member))
+	require.False(t, tm.CanSchedule(member))
+	require.False(t, tm.CanView(outsider))
+	require.Equal(t, []uuid.UUID{captain, member, coach}, tm.Recipients())
+
+	for i := len(tm.Staff); i < MaxStaff; i++ {
+		require.NoError(t, tm.AddStaff(uuid.New(), StaffManager))
+	}
+	require.ErrorIs(t, tm.AddStaff(outsider, StaffManager), ErrTooManyStaff)
+
+	require.NoError(t, tm.RemoveStaff(coach))
+	require.False(t, tm.IsStaff(coach))
+	require.ErrorIs(t, tm.RemoveStaff(coach), ErrNotStaff)
+}
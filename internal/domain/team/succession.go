@@ -0,0 +1,51 @@
+package team
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ErrAlreadyCaptain is returned when appointing the team's current captain.
+var ErrAlreadyCaptain = errors.New("player is already the team's captain")
+
+// SuccessionReason is why captaincy passed on without the captain handing it over.
+type SuccessionReason string
+
+const (
+	SuccessionBanned   SuccessionReason = "captain_banned"     // The captain's player was banned
+	SuccessionDeleted  SuccessionReason = "captain_deleted"    // The captain's account was deleted
+	SuccessionOverride SuccessionReason = "organizer_override" // A tournament organizer appointed the captain
+)
+
+// Successor returns the longest-tenured member other than the captain that
+// eligible accepts, or uuid.Nil if there is none. Members are kept in the
+// order they joined.
+func (t *Team) Successor(eligible func(uuid.UUID) bool) uuid.UUID {
+	for _, id := range t.MemberIDs {
+		if id != t.CaptainID && eligible(id) {
+			return id
+		}
+	}
+	return uuid.Nil
+}
+
+// SucceedCaptain hands captaincy to a member. A captain whose account was
+// deleted also leaves the roster.
+func (t *Team) SucceedCaptain(newCaptainID uuid.UUID, reason SuccessionReason) error {
+	if newCaptainID == t.CaptainID {
+		return ErrAlreadyCaptain
+	}
+	previous := t.CaptainID
+	if err := t.TransferCaptaincy(newCaptainID); err != nil {
+		return err
+	}
+	t.SubmitterIDs = without(t.SubmitterIDs, newCaptainID)
+	if reason == SuccessionDeleted {
+		t.MemberIDs = without(t.MemberIDs, previous)
+		t.SubmitterIDs = without(t.SubmitterIDs, previous)
+	}
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
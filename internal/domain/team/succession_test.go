@@ -0,0 +1,71 @@
+package team
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeam_Successor(t *testing.T) {
+	t.Parallel()
+
+	captain, first, second := uuid.New(), uuid.New(), uuid.New()
+	tm, err := NewTeam(uuid.New(), captain, "Alpha")
+	require.NoError(t, err)
+
+	anyone := func(uuid.UUID) bool { return true }
+	require.Equal(t, uuid.Nil, tm.Successor(anyone), "a captain alone has no successor")
+
+	require.NoError(t, tm.AddMember(first))
+	require.NoError(t, tm.AddMember(second))
+	require.Equal(t, first, tm.Successor(anyone), "longest-tenured member first")
+
+	notFirst := func(id uuid.UUID) bool { return id != first }
+	require.Equal(t, second, tm.Successor(notFirst))
+
+	// Tenure follows the join order, not who captains the team.
+	require.NoError(t, tm.TransferCaptaincy(first))
+	require.Equal(t, captain, tm.Successor(anyone))
+}
+
+func TestTeam_SucceedCaptain(t *testing.T) {
+	t.Parallel()
+
+	captain, member, submitter := uuid.New(), uuid.New(), uuid.New()
+	newTeam := func() *Team {
+		tm, err := NewTeam(uuid.New(), captain, "Alpha")
+		require.NoError(t, err)
+		require.NoError(t, tm.AddMember(member))
+		require.NoError(t, tm.AddMember(submitter))
+		require.NoError(t, tm.GrantSubmit(member))
+		return tm
+	}
+
+	banned := newTeam()
+	require.NoError(t, banned.SucceedCaptain(member, SuccessionBanned))
+	require.True(t, banned.IsCaptain(member))
+	require.True(t, banned.HasMember(captain), "a banned captain stays on the roster")
+	require.Empty(t, banned.SubmitterIDs, "the captain submits without a grant")
+
+	deleted := newTeam()
+	require.NoError(t, deleted.SucceedCaptain(member, SuccessionDeleted))
+	require.True(t, deleted.IsCaptain(member))
+	require.False(t, deleted.HasMember(captain))
+	require.Equal(t, []uuid.UUID{member, submitter}, deleted.MemberIDs)
+
+	tm := newTeam()
+	require.ErrorIs(t, tm.SucceedCaptain(captain, SuccessionOverride), ErrAlreadyCaptain)
+	require.ErrorIs(t, tm.SucceedCaptain(uuid.New(), SuccessionOverride), ErrPlayerNotInTeam)
+}
+
+func TestNewSuccession(t *testing.T) {
+	t.Parallel()
+
+	teamID, playerID := uuid.New(), uuid.New()
+	entry := NewSuccession(teamID, uuid.Nil, playerID, SuccessionBanned)
+	require.Equal(t, HistoryCaptainSucceeded, entry.Action)
+	require.Equal(t, uuid.Nil, entry.ActorID)
+	require.Equal(t, playerID, entry.PlayerID)
+	require.Equal(t, "captain_banned", entry.Reason)
+}
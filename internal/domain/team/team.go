@@ -19,30 +19,62 @@ var (
 	ErrCannotRemoveCaptain = errors.New("cannot remove captain from team")
 	ErrInvalidInviteCode   = errors.New("invalid invite code")
 	ErrTeamNotReady        = errors.New("team is not ready")
+	ErrAlreadySubmitter    = errors.New("player can already submit matches")
+	ErrNotSubmitter        = errors.New("player cannot submit matches")
+	ErrCaptainPermission   = errors.New("captain's permissions cannot be changed")
+	ErrApprovalRequired    = errors.New("team requires captain approval to join")
+	ErrInvalidJoinMode     = errors.New("invalid join mode")
+	ErrAlreadyDisqualified = errors.New("team is already disqualified")
 )
 
 type Status string
 
 const (
-	StatusPending    Status = "pending"
-	StatusReady      Status = "ready"
-	StatusActive     Status = "active"
-	StatusEliminated Status = "eliminated"
-	StatusDisbanded  Status = "disbanded"
+	StatusPending      Status = "pending"
+	StatusReady        Status = "ready"
+	StatusActive       Status = "active"
+	StatusEliminated   Status = "eliminated"
+	StatusDisbanded    Status = "disbanded"
+	StatusDisqualified Status = "disqualified"
 )
 
+// JoinMode controls how players become members of a team.
+type JoinMode string
+
+const (
+	// JoinModeInvite admits anyone with the invite code immediately.
+	JoinModeInvite JoinMode = "invite"
+
+	// JoinModeApproval requires players to apply and the captain to approve.
+	JoinModeApproval JoinMode = "approval"
+)
+
+// ParseJoinMode parses a join mode.
+func ParseJoinMode(value string) (JoinMode, error) {
+	switch m := JoinMode(value); m {
+	case JoinModeInvite, JoinModeApproval:
+		return m, nil
+	default:
+		return "", ErrInvalidJoinMode
+	}
+}
+
 type Team struct {
-	ID           uuid.UUID   `bson:"_id" json:"id"`
-	TournamentID uuid.UUID   `bson:"tournament_id" json:"tournament_id"`
-	Name         string      `bson:"name" json:"name"`
-	Tag          string      `bson:"tag,omitempty" json:"tag,omitempty"`
-	CaptainID    uuid.UUID   `bson:"captain_id" json:"captain_id"`
-	MemberIDs    []uuid.UUID `bson:"member_ids" json:"member_ids"`
-	Status       Status      `bson:"status" json:"status"`
-	InviteCode   string      `bson:"invite_code" json:"invite_code"`
-	LogoURL      string      `bson:"logo_url,omitempty" json:"logo_url,omitempty"`
-	CreatedAt    time.Time   `bson:"created_at" json:"created_at"`
-	UpdatedAt    time.Time   `bson:"updated_at" json:"updated_at"`
+	ID           uuid.UUID     `bson:"_id" json:"id"`
+	TournamentID uuid.UUID     `bson:"tournament_id" json:"tournament_id"`
+	Name         string        `bson:"name" json:"name"`
+	Tag          string        `bson:"tag,omitempty" json:"tag,omitempty"`
+	CaptainID    uuid.UUID     `bson:"captain_id" json:"captain_id"`
+	MemberIDs    []uuid.UUID   `bson:"member_ids" json:"member_ids"`
+	SubmitterIDs []uuid.UUID   `bson:"submitter_ids,omitempty" json:"submitter_ids"` // Members besides the captain allowed to submit matches
+	Staff        []StaffMember `bson:"staff,omitempty" json:"staff,omitempty"`       // Non-playing coaches and managers, not on the roster
+	Status       Status        `bson:"status" json:"status"`
+	InviteCode   string        `bson:"invite_code" json:"invite_code"`
+	JoinMode     JoinMode      `bson:"join_mode,omitempty" json:"join_mode"`
+	LogoURL      string        `bson:"logo_url,omitempty" json:"logo_url,omitempty"`
+	CleanupFlag  *CleanupFlag  `bson:"cleanup_flag,omitempty" json:"cleanup_flag,omitempty"` // Set while the tournament's cleanup policy flags the team
+	CreatedAt    time.Time     `bson:"created_at" json:"created_at"`
+	UpdatedAt    time.Time     `bson:"updated_at" json:"updated_at"`
 }
 
 func NewTeam(tournamentID, captainID uuid.UUID, name string) (*Team, error) {
@@ -62,6 +94,7 @@ func NewTeam(tournamentID, captainID uuid.UUID, name string) (*Team, error) {
 		MemberIDs:    []uuid.UUID{captainID},
 		Status:       StatusPending,
 		InviteCode:   generateInviteCode(),
+		JoinMode:     JoinModeInvite,
 		CreatedAt:    now,
 		UpdatedAt:    now,
 	}, nil
@@ -71,6 +104,9 @@ func (t *Team) AddMember(playerID uuid.UUID) error {
 	if t.HasMember(playerID) {
 		return ErrPlayerAlreadyInTeam
 	}
+	if t.IsStaff(playerID) {
+		return ErrAlreadyStaff
+	}
 
 	t.MemberIDs = append(t.MemberIDs, playerID)
 	t.UpdatedAt = time.Now().UTC()
@@ -85,13 +121,8 @@ func (t *Team) RemoveMember(playerID uuid.UUID) error {
 		return ErrCannotRemoveCaptain
 	}
 
-	newMembers := make([]uuid.UUID, 0, len(t.MemberIDs)-1)
-	for _, id := range t.MemberIDs {
-		if id != playerID {
-			newMembers = append(newMembers, id)
-		}
-	}
-	t.MemberIDs = newMembers
+	t.MemberIDs = without(t.MemberIDs, playerID)
+	t.SubmitterIDs = without(t.SubmitterIDs, playerID)
 	t.UpdatedAt = time.Now().UTC()
 	return nil
 }
@@ -109,6 +140,51 @@ func (t *Team) IsCaptain(playerID uuid.UUID) bool {
 	return t.CaptainID == playerID
 }
 
+// CanSubmit reports whether a player may submit match reports for the team:
+// the captain always can, other members only once granted the permission.
+func (t *Team) CanSubmit(playerID uuid.UUID) bool {
+	if t.IsCaptain(playerID) {
+		return true
+	}
+	for _, id := range t.SubmitterIDs {
+		if id == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+// GrantSubmit lets a member submit match reports on the captain's behalf.
+func (t *Team) GrantSubmit(playerID uuid.UUID) error {
+	if !t.HasMember(playerID) {
+		return ErrPlayerNotInTeam
+	}
+	if t.IsCaptain(playerID) {
+		return ErrCaptainPermission
+	}
+	if t.CanSubmit(playerID) {
+		return ErrAlreadySubmitter
+	}
+
+	t.SubmitterIDs = append(t.SubmitterIDs, playerID)
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+// RevokeSubmit withdraws a member's permission to submit match reports.
+func (t *Team) RevokeSubmit(playerID uuid.UUID) error {
+	if t.IsCaptain(playerID) {
+		return ErrCaptainPermission
+	}
+	if !t.CanSubmit(playerID) {
+		return ErrNotSubmitter
+	}
+
+	t.SubmitterIDs = without(t.SubmitterIDs, playerID)
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
 func (t *Team) TransferCaptaincy(newCaptainID uuid.UUID) error {
 	if !t.HasMember(newCaptainID) {
 		return ErrPlayerNotInTeam
@@ -125,6 +201,14 @@ func (t *Team) UpdateStatus(newStatus Status) error {
 	return nil
 }
 
+// Disqualify removes the team from competition.
+func (t *Team) Disqualify() error {
+	if t.Status == StatusDisqualified {
+		return ErrAlreadyDisqualified
+	}
+	return t.UpdateStatus(StatusDisqualified)
+}
+
 func (t *Team) SetTag(tag string) {
 	t.Tag = tag
 	t.UpdatedAt = time.Now().UTC()
@@ -140,6 +224,18 @@ func (t *Team) RegenerateInviteCode() {
 	t.UpdatedAt = time.Now().UTC()
 }
 
+// RequiresApproval reports whether players must apply to join the team.
+// Teams created before join modes existed admit by invite code.
+func (t *Team) RequiresApproval() bool {
+	return t.JoinMode == JoinModeApproval
+}
+
+// SetJoinMode changes how players join the team.
+func (t *Team) SetJoinMode(mode JoinMode) {
+	t.JoinMode = mode
+	t.UpdatedAt = time.Now().UTC()
+}
+
 func (t *Team) IsReady() bool {
 	return t.Status == StatusReady || t.Status == StatusActive
 }
@@ -148,6 +244,17 @@ func (t *Team) MemberCount() int {
 	return len(t.MemberIDs)
 }
 
+// without returns ids with every occurrence of id removed.
+func without(ids []uuid.UUID, id uuid.UUID) []uuid.UUID {
+	kept := make([]uuid.UUID, 0, len(ids))
+	for _, other := range ids {
+		if other != id {
+			kept = append(kept, other)
+		}
+	}
+	return kept
+}
+
 func generateInviteCode() string {
 	return uuid.New().String()[:8]
 }
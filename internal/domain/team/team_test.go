@@ -0,0 +1,82 @@
+package team
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSubmitPermission(t *testing.T) {
+	t.Parallel()
+
+	captain, member, outsider := uuid.New(), uuid.New(), uuid.New()
+	tm, err := NewTeam(uuid.New(), captain, "Alpha")
+	require.NoError(t, err)
+	require.NoError(t, tm.AddMember(member))
+
+	require.True(t, tm.CanSubmit(captain))
+	require.False(t, tm.CanSubmit(member))
+
+	require.ErrorIs(t, tm.GrantSubmit(outsider), ErrPlayerNotInTeam)
+	require.ErrorIs(t, tm.GrantSubmit(captain), ErrCaptainPermission)
+	require.ErrorIs(t, tm.RevokeSubmit(captain), ErrCaptainPermission)
+	require.ErrorIs(t, tm.RevokeSubmit(member), ErrNotSubmitter)
+
+	require.NoError(t, tm.GrantSubmit(member))
+	require.True(t, tm.CanSubmit(member))
+	require.ErrorIs(t, tm.GrantSubmit(member), ErrAlreadySubmitter)
+
+	require.NoError(t, tm.RevokeSubmit(member))
+	require.False(t, tm.CanSubmit(member))
+
+	require.NoError(t, tm.GrantSubmit(member))
+	require.NoError(t, tm.RemoveMember(member))
+	require.False(t, tm.CanSubmit(member))
+	require.Empty(t, tm.SubmitterIDs)
+}
+
+func TestJoinRequestDecision(t *testing.T) {
+	t.Parallel()
+
+	captain, applicant := uuid.New(), uuid.New()
+	tm, err := NewTeam(uuid.New(), captain, "Alpha")
+	require.NoError(t, err)
+	require.False(t, tm.RequiresApproval())
+
+	tm.SetJoinMode(JoinModeApproval)
+	require.True(t, tm.RequiresApproval())
+
+	req := NewJoinRequest(tm, applicant, "I main support")
+	require.Equal(t, JoinRequestPending, req.Status)
+	require.Equal(t, tm.TournamentID, req.TournamentID)
+
+	require.NoError(t, req.Approve(captain))
+	require.Equal(t, JoinRequestApproved, req.Status)
+	require.Equal(t, captain, *req.DecidedBy)
+	require.NotNil(t, req.DecidedAt)
+
+	require.ErrorIs(t, req.Decline(captain), ErrJoinRequestNotPending)
+}
+
+func TestParseJoinMode(t *testing.T) {
+	t.Parallel()
+
+	mode, err := ParseJoinMode("approval")
+	require.NoError(t, err)
+	require.Equal(t, JoinModeApproval, mode)
+
+	_, err = ParseJoinMode("open")
+	require.ErrorIs(t, err, ErrInvalidJoinMode)
+}
+
+func TestDisqualify(t *testing.T) {
+	t.Parallel()
+
+	tm, err := NewTeam(uuid.New(), uuid.New(), "Alpha")
+	require.NoError(t, err)
+
+	require.NoError(t, tm.Disqualify())
+	require.Equal(t, StatusDisqualified, tm.Status)
+	require.ErrorIs(t, tm.Disqualify(), ErrAlreadyDisqualified)
+}
@@ -0,0 +1,98 @@
+package tournament
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaxBackupReasonLength caps the note stored with a backup.
+const MaxBackupReasonLength = 500
+
+var (
+	ErrBackupNotFound      = errors.New("tournament backup not found")
+	ErrInvalidBackupReason = errors.New("backup reason must be at most 500 characters")
+	ErrBackupMismatch      = errors.New("backup belongs to a different tournament")
+)
+
+// Snapshot is a tournament's stored documents together with its teams and
+// matches. Documents are kept in the storage's own JSON encoding, so a
+// restore writes back exactly what was read, fields unknown to the domain included.
+type Snapshot struct {
+	Tournament json.RawMessage   `json:"tournament"`
+	Teams      []json.RawMessage `json:"teams"`
+	Matches    []json.RawMessage `json:"matches"`
+}
+
+// Backup records a tournament snapshot uploaded to object storage, typically
+// taken before a risky admin operation.
+type Backup struct {
+	ID           uuid.UUID  `bson:"_id" json:"id"`
+	TournamentID uuid.UUID  `bson:"tournament_id" json:"tournament_id"`
+	Key          string     `bson:"key" json:"key"` // Object storage key of the snapshot
+	Reason       string     `bson:"reason,omitempty" json:"reason,omitempty"`
+	CreatedBy    uuid.UUID  `bson:"created_by" json:"created_by"`
+	Teams        int        `bson:"teams" json:"teams"`
+	Matches      int        `bson:"matches" json:"matches"`
+	SizeBytes    int        `bson:"size_bytes" json:"size_bytes"`
+	CreatedAt    time.Time  `bson:"created_at" json:"created_at"`
+	RestoredAt   *time.Time `bson:"restored_at,omitempty" json:"restored_at,omitempty"` // Last time the tournament was rolled back to this backup
+	RestoredBy   *uuid.UUID `bson:"restored_by,omitempty" json:"restored_by,omitempty"`
+}
+
+// NewBackup creates a backup record of snapshot. Its object key sorts
+// chronologically under the tournament's prefix.
+func NewBackup(tournamentID, createdBy uuid.UUID, reason string, snapshot *Snapshot, now time.Time) (*Backup, error) {
+	if len(reason) > MaxBackupReasonLength {
+		return nil, ErrInvalidBackupReason
+	}
+
+	id := uuid.New()
+	now = now.UTC()
+	return &Backup{
+		ID:           id,
+		TournamentID: tournamentID,
+		Key:          fmt.Sprintf("tournament-backups/%s/%s-%s.json", tournamentID, now.Format("20060102T150405Z"), id),
+		Reason:       reason,
+		CreatedBy:    createdBy,
+		Teams:        len(snapshot.Teams),
+		Matches:      len(snapshot.Matches),
+		CreatedAt:    now,
+	}, nil
+}
+
+// MarkRestored records that the tournament was rolled back to this backup.
+func (b *Backup) MarkRestored(by uuid.UUID, now time.Time) {
+	b.RestoredAt = &now
+	b.RestoredBy = &by
+}
+
+// BackupRepository stores backup records.
+type BackupRepository interface {
+	// Create stores a new backup record.
+	Create(ctx context.Context, backup *Backup) error
+
+	// GetByID retrieves a backup record by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*Backup, error)
+
+	// ListByTournament retrieves a tournament's backups, newest first.
+	ListByTournament(ctx context.Context, tournamentID uuid.UUID) ([]*Backup, error)
+
+	// Update replaces an existing backup record.
+	Update(ctx context.Context, backup *Backup) error
+}
+
+// Snapshotter reads and writes a tournament's documents as a whole.
+type Snapshotter interface {
+	// Snapshot returns the tournament's documents. It returns ErrNotFound when
+	// the tournament does not exist.
+	Snapshot(ctx context.Context, id uuid.UUID) (*Snapshot, error)
+
+	// Restore replaces the tournament, its teams and its matches with the
+	// snapshot's. Teams and matches created after the snapshot are removed.
+	Restore(ctx context.Context, id uuid.UUID, snapshot *Snapshot) error
+}
@@ -0,0 +1,62 @@
+package tournament
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewBackup(t *testing.T) {
+	t.Parallel()
+
+	tournamentID := uuid.New()
+	createdBy := uuid.New()
+	now := time.Date(2026, 3, 14, 15, 9, 26, 0, time.FixedZone("CET", 3600))
+	snapshot := &Snapshot{
+		Tournament: json.RawMessage(`{}`),
+		Teams:      []json.RawMessage{json.RawMessage(`{}`), json.RawMessage(`{}`)},
+		Matches:    []json.RawMessage{json.RawMessage(`{}`)},
+	}
+
+	b, err := NewBackup(tournamentID, createdBy, "before bulk disqualification", snapshot, now)
+	require.NoError(t, err)
+	require.Equal(t, tournamentID, b.TournamentID)
+	require.Equal(t, createdBy, b.CreatedBy)
+	require.Equal(t, 2, b.Teams)
+	require.Equal(t, 1, b.Matches)
+	require.Equal(t, time.UTC, b.CreatedAt.Location())
+	require.Equal(t, "tournament-backups/"+tournamentID.String()+"/20260314T140926Z-"+b.ID.String()+".json", b.Key)
+	require.Nil(t, b.RestoredAt)
+
+	restorer := uuid.New()
+	b.MarkRestored(restorer, now)
+	require.Equal(t, &now, b.RestoredAt)
+	require.Equal(t, &restorer, b.RestoredBy)
+}
+
+func TestNewBackup_Reason(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		reason  string
+		wantErr error
+	}{
+		{name: "empty", reason: ""},
+		{name: "at limit", reason: strings.Repeat("a", MaxBackupReasonLength)},
+		{name: "too long", reason: strings.Repeat("a", MaxBackupReasonLength+1), wantErr: ErrInvalidBackupReason},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewBackup(uuid.New(), uuid.New(), tc.reason, &Snapshot{}, time.Now())
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package tournament
+
+import (
+	"errors"
+	"time"
+)
+
+// DefaultInactiveRound is the round by which a team must have a verified
+// match when the cleanup policy does not set one.
+const DefaultInactiveRound = 2
+
+var ErrInvalidCleanupRound = errors.New("cleanup round cannot be negative")
+
+// CleanupPolicy flags teams that are not really taking part: teams without a
+// member besides the captain once registration has closed, and teams without
+// a verified match once the tournament reaches InactiveRound. The
+// tournament's round is the number of verified matches of its busiest team.
+type CleanupPolicy struct {
+	InactiveRound int  `bson:"inactive_round,omitempty" json:"inactive_round,omitempty"` // 0 means DefaultInactiveRound
+	AutoDisband   bool `bson:"auto_disband,omitempty" json:"auto_disband,omitempty"`     // Disband flagged teams, freeing their slots, instead of only flagging them
+}
+
+// Validate checks the policy's round.
+func (p CleanupPolicy) Validate() error {
+	if p.InactiveRound < 0 {
+		return ErrInvalidCleanupRound
+	}
+	return nil
+}
+
+// Round returns the round by which teams must have a verified match.
+func (p CleanupPolicy) Round() int {
+	if p.InactiveRound == 0 {
+		return DefaultInactiveRound
+	}
+	return p.InactiveRound
+}
+
+// RegistrationClosed reports whether teams can no longer register: the
+// registration deadline has passed, or the tournament has started without
+// late registration.
+func (t *Tournament) RegistrationClosed(now time.Time) bool {
+	if t.Rules.RegistrationDeadline != nil && now.After(*t.Rules.RegistrationDeadline) {
+		return true
+	}
+	return t.Status == StatusActive && !t.Rules.AllowLateRegistration
+}
@@ -0,0 +1,35 @@
+package tournament
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTournament_RegistrationClosed(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	tr := &Tournament{Status: StatusOpen}
+	require.False(t, tr.RegistrationClosed(now))
+
+	deadline := now.Add(-time.Minute)
+	tr.Rules.RegistrationDeadline = &deadline
+	require.True(t, tr.RegistrationClosed(now))
+
+	tr.Rules.RegistrationDeadline = nil
+	tr.Status = StatusActive
+	require.True(t, tr.RegistrationClosed(now))
+
+	tr.Rules.AllowLateRegistration = true
+	require.False(t, tr.RegistrationClosed(now))
+}
+
+func TestCleanupPolicy(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, DefaultInactiveRound, CleanupPolicy{}.Round())
+	require.Equal(t, 3, CleanupPolicy{InactiveRound: 3}.Round())
+	require.ErrorIs(t, Rules{Cleanup: &CleanupPolicy{InactiveRound: -1}}.Validate(), ErrInvalidCleanupRound)
+}
@@ -0,0 +1,63 @@
+package tournament
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrConcurrentTournamentLimit = errors.New("player has reached the limit of concurrent tournaments")
+	ErrInvalidConcurrentLimit    = errors.New("max concurrent tournaments cannot be negative")
+)
+
+// ConcurrentTournamentError names the tournament a player is already
+// competing in that clashes with the one they are joining. It matches
+// ErrConcurrentTournamentLimit.
+type ConcurrentTournamentError struct {
+	Limit    int
+	Conflict *Tournament
+}
+
+func (e *ConcurrentTournamentError) Error() string {
+	return fmt.Sprintf("player is already competing in %q, which overlaps this tournament (limit of %d concurrent tournaments)", e.Conflict.Name, e.Limit)
+}
+
+func (e *ConcurrentTournamentError) Unwrap() error {
+	return ErrConcurrentTournamentLimit
+}
+
+// Overlaps reports whether the two tournaments' schedules overlap.
+func (t *Tournament) Overlaps(other *Tournament) bool {
+	return t.StartDate.Before(other.EndDate) && other.StartDate.Before(t.EndDate)
+}
+
+// CheckConcurrentTournaments checks that a player already in the joined
+// tournaments may also compete in t. Only open and active tournaments whose
+// schedule overlaps t count. The limit is the strictest of platformLimit,
+// t's rule and the rules of the overlapping tournaments; zero means no limit.
+func (t *Tournament) CheckConcurrentTournaments(platformLimit int, joined []*Tournament) error {
+	limit := minLimit(platformLimit, t.Rules.MaxConcurrentTournaments)
+
+	var overlapping []*Tournament
+	for _, other := range joined {
+		if other.ID == t.ID || (other.Status != StatusOpen && other.Status != StatusActive) || !t.Overlaps(other) {
+			continue
+		}
+		overlapping = append(overlapping, other)
+		limit = minLimit(limit, other.Rules.MaxConcurrentTournaments)
+	}
+
+	// The limit counts t itself.
+	if limit == 0 || len(overlapping) < limit {
+		return nil
+	}
+	return &ConcurrentTournamentError{Limit: limit, Conflict: overlapping[0]}
+}
+
+// minLimit returns the stricter of two limits, where zero means no limit.
+func minLimit(a, b int) int {
+	if a == 0 || (b > 0 && b < a) {
+		return b
+	}
+	return a
+}
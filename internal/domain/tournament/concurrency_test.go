@@ -0,0 +1,69 @@
+package tournament
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTournament_CheckConcurrentTournaments(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, 6, 1, 0, 0, 0, 0, time.UTC)
+	event := func(status Status, from, to int, limit int) *Tournament {
+		tr, err := NewTournament(uuid.New(), uuid.New(), "Event", TeamSizeDuos, start.AddDate(0, 0, from), start.AddDate(0, 0, to))
+		require.NoError(t, err)
+		tr.Status = status
+		tr.Rules.MaxConcurrentTournaments = limit
+		return tr
+	}
+
+	tests := []struct {
+		name          string
+		platformLimit int
+		target        *Tournament
+		joined        []*Tournament
+		wantConflict  int // Index into joined, or -1
+	}{
+		{name: "no limit", target: event(StatusOpen, 0, 7, 0), joined: []*Tournament{event(StatusActive, 0, 7, 0)}, wantConflict: -1},
+		{name: "platform limit", platformLimit: 1, target: event(StatusOpen, 0, 7, 0), joined: []*Tournament{event(StatusActive, 3, 10, 0)}, wantConflict: 0},
+		{name: "target rule", target: event(StatusOpen, 0, 7, 1), joined: []*Tournament{event(StatusActive, 3, 10, 0)}, wantConflict: 0},
+		{name: "joined tournament rule", target: event(StatusOpen, 0, 7, 0), joined: []*Tournament{event(StatusActive, 3, 10, 1)}, wantConflict: 0},
+		{name: "strictest limit wins", platformLimit: 3, target: event(StatusOpen, 0, 7, 2), joined: []*Tournament{event(StatusActive, 1, 2, 0), event(StatusOpen, 4, 5, 0)}, wantConflict: 0},
+		{name: "under limit", platformLimit: 2, target: event(StatusOpen, 0, 7, 0), joined: []*Tournament{event(StatusActive, 1, 2, 0)}, wantConflict: -1},
+		{name: "back to back", platformLimit: 1, target: event(StatusOpen, 7, 14, 0), joined: []*Tournament{event(StatusActive, 0, 7, 0)}, wantConflict: -1},
+		{name: "finished and draft ignored", platformLimit: 1, target: event(StatusOpen, 0, 7, 0), joined: []*Tournament{event(StatusFinished, 0, 7, 0), event(StatusDraft, 0, 7, 0)}, wantConflict: -1},
+		{name: "skips non overlapping", platformLimit: 1, target: event(StatusOpen, 0, 7, 0), joined: []*Tournament{event(StatusActive, 20, 30, 0), event(StatusActive, 6, 8, 0)}, wantConflict: 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.target.CheckConcurrentTournaments(tc.platformLimit, tc.joined)
+			if tc.wantConflict < 0 {
+				require.NoError(t, err)
+				return
+			}
+
+			require.ErrorIs(t, err, ErrConcurrentTournamentLimit)
+			var limitErr *ConcurrentTournamentError
+			require.True(t, errors.As(err, &limitErr))
+			require.Same(t, tc.joined[tc.wantConflict], limitErr.Conflict)
+		})
+	}
+}
+
+func TestTournament_CheckConcurrentTournaments_IgnoresItself(t *testing.T) {
+	t.Parallel()
+
+	tr, err := NewTournament(uuid.New(), uuid.New(), "Cup", TeamSizeDuos, time.Now(), time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	tr.Status = StatusOpen
+	tr.Rules.MaxConcurrentTournaments = 1
+
+	require.NoError(t, tr.CheckConcurrentTournaments(0, []*Tournament{tr}))
+}
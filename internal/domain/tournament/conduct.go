@@ -0,0 +1,19 @@
+package tournament
+
+import "errors"
+
+// ErrConductNotEligible is returned when a player's conduct score is below
+// the tournament's minimum.
+var ErrConductNotEligible = errors.New("player conduct score is below the tournament's minimum")
+
+// HasConductRequirement reports whether the tournament requires a minimum
+// conduct score.
+func (r Rules) HasConductRequirement() bool {
+	return r.MinConductScore > 0
+}
+
+// IsConductEligible reports whether a player with the given conduct score
+// may register.
+func (t *Tournament) IsConductEligible(score float64) bool {
+	return !t.Rules.HasConductRequirement() || score >= t.Rules.MinConductScore
+}
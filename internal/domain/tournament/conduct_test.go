@@ -0,0 +1,40 @@
+package tournament
+
+import (
+	"testing"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsConductEligible(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		min      float64
+		score    float64
+		eligible bool
+	}{
+		{name: "no requirement", min: 0, score: 0, eligible: true},
+		{name: "at minimum", min: 80, score: 80, eligible: true},
+		{name: "below minimum", min: 80, score: 79.9, eligible: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := &Tournament{Rules: Rules{MinConductScore: tc.min}}
+			require.Equal(t, tc.eligible, tr.IsConductEligible(tc.score))
+		})
+	}
+}
+
+func TestRulesValidate_MinConductScore(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, Rules{MinConductScore: player.MaxConductScore}.Validate())
+	require.ErrorIs(t, Rules{MinConductScore: -1}.Validate(), player.ErrInvalidConductScore)
+	require.ErrorIs(t, Rules{MinConductScore: 101}.Validate(), player.ErrInvalidConductScore)
+}
@@ -0,0 +1,138 @@
+package tournament
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ExportStatus is the processing state of a tournament export.
+type ExportStatus string
+
+const (
+	ExportPending ExportStatus = "pending" // Waiting for the export worker
+	ExportRunning ExportStatus = "running" // Claimed by the export worker
+	ExportDone    ExportStatus = "done"    // Bundle generated and downloadable
+	ExportFailed  ExportStatus = "failed"  // Generation failed; a new export may be requested
+)
+
+const (
+	// ExportRetention is how long a generated bundle stays downloadable.
+	ExportRetention = 24 * time.Hour
+
+	// ExportLinkTTL is how long a signed download link is valid.
+	ExportLinkTTL = 15 * time.Minute
+)
+
+var (
+	ErrExportNotFound         = errors.New("tournament export not found")
+	ErrExportNotReady         = errors.New("tournament export is not ready")
+	ErrInvalidExportSignature = errors.New("invalid or expired export download link")
+)
+
+// Export is a request to bundle a tournament's data for download. The bundle
+// is generated asynchronously by the export worker.
+type Export struct {
+	ID           uuid.UUID    `bson:"_id" json:"id"`
+	TournamentID uuid.UUID    `bson:"tournament_id" json:"tournament_id"`
+	RequestedBy  uuid.UUID    `bson:"requested_by" json:"requested_by"`
+	Status       ExportStatus `bson:"status" json:"status"`
+	Error        string       `bson:"error,omitempty" json:"error,omitempty"`
+	Bundle       []byte       `bson:"bundle,omitempty" json:"-"` // Zip archive, set once done
+	SizeBytes    int          `bson:"size_bytes,omitempty" json:"size_bytes,omitempty"`
+	CreatedAt    time.Time    `bson:"created_at" json:"created_at"`
+	CompletedAt  *time.Time   `bson:"completed_at,omitempty" json:"completed_at,omitempty"`
+	ExpiresAt    *time.Time   `bson:"expires_at,omitempty" json:"expires_at,omitempty"` // When the bundle is discarded
+}
+
+// NewExport creates a pending export of a tournament.
+func NewExport(tournamentID, requestedBy uuid.UUID) *Export {
+	return &Export{
+		ID:           uuid.New(),
+		TournamentID: tournamentID,
+		RequestedBy:  requestedBy,
+		Status:       ExportPending,
+		CreatedAt:    time.Now().UTC(),
+	}
+}
+
+// Complete stores the generated bundle and starts its retention period.
+func (e *Export) Complete(bundle []byte, now time.Time) {
+	expires := now.Add(ExportRetention)
+	e.Status = ExportDone
+	e.Error = ""
+	e.Bundle = bundle
+	e.SizeBytes = len(bundle)
+	e.CompletedAt = &now
+	e.ExpiresAt = &expires
+}
+
+// Fail marks the export as failed with the given reason.
+func (e *Export) Fail(reason string, now time.Time) {
+	e.Status = ExportFailed
+	e.Error = reason
+	e.CompletedAt = &now
+}
+
+// Expired reports whether a finished bundle is past its retention period.
+func (e *Export) Expired(now time.Time) bool {
+	return e.ExpiresAt != nil && !now.Before(*e.ExpiresAt)
+}
+
+// Reusable reports whether the export is still in progress or downloadable,
+// so that a new request should return it instead of starting another.
+func (e *Export) Reusable(now time.Time) bool {
+	switch e.Status {
+	case ExportPending, ExportRunning:
+		return true
+	case ExportDone:
+		return !e.Expired(now)
+	default:
+		return false
+	}
+}
+
+// SignExportDownload returns the signature authorizing a download of the
+// export until expires.
+func SignExportDownload(secret []byte, id uuid.UUID, expires time.Time) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(id.String() + ":" + strconv.FormatInt(expires.Unix(), 10)))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyExportDownload checks a download signature and its expiry.
+func VerifyExportDownload(secret []byte, id uuid.UUID, expires time.Time, signature string, now time.Time) error {
+	if !now.Before(expires) {
+		return ErrInvalidExportSignature
+	}
+	expected := SignExportDownload(secret, id, expires)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrInvalidExportSignature
+	}
+	return nil
+}
+
+// ExportRepository defines persistence for tournament exports.
+type ExportRepository interface {
+	// Create stores a new export.
+	Create(ctx context.Context, export *Export) error
+
+	// GetByID retrieves an export by its ID.
+	GetByID(ctx context.Context, id uuid.UUID) (*Export, error)
+
+	// GetLatestByTournament returns the most recently requested export of a tournament.
+	GetLatestByTournament(ctx context.Context, tournamentID uuid.UUID) (*Export, error)
+
+	// ClaimPending atomically marks the oldest pending export as running and
+	// returns it, or returns ErrExportNotFound when none is waiting.
+	ClaimPending(ctx context.Context) (*Export, error)
+
+	// Update saves an export's status and bundle.
+	Update(ctx context.Context, export *Export) error
+}
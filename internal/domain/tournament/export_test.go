@@ -0,0 +1,47 @@
+package tournament
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExport_Lifecycle(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	e := NewExport(uuid.New(), uuid.New())
+	require.Equal(t, ExportPending, e.Status)
+	require.True(t, e.Reusable(now))
+
+	e.Complete([]byte("zip"), now)
+	require.Equal(t, ExportDone, e.Status)
+	require.Equal(t, 3, e.SizeBytes)
+	require.True(t, e.Reusable(now))
+	require.False(t, e.Expired(now.Add(ExportRetention-time.Second)))
+	require.True(t, e.Expired(now.Add(ExportRetention)))
+	require.False(t, e.Reusable(now.Add(ExportRetention)))
+
+	failed := NewExport(uuid.New(), uuid.New())
+	failed.Fail("boom", now)
+	require.Equal(t, ExportFailed, failed.Status)
+	require.False(t, failed.Reusable(now))
+}
+
+func TestExportDownloadSignature(t *testing.T) {
+	t.Parallel()
+
+	secret := []byte("secret")
+	id := uuid.New()
+	now := time.Now().UTC()
+	expires := now.Add(ExportLinkTTL)
+	sig := SignExportDownload(secret, id, expires)
+
+	require.NoError(t, VerifyExportDownload(secret, id, expires, sig, now))
+	require.ErrorIs(t, VerifyExportDownload(secret, id, expires, sig, expires), ErrInvalidExportSignature)
+	require.ErrorIs(t, VerifyExportDownload([]byte("other"), id, expires, sig, now), ErrInvalidExportSignature)
+	require.ErrorIs(t, VerifyExportDownload(secret, uuid.New(), expires, sig, now), ErrInvalidExportSignature)
+	require.ErrorIs(t, VerifyExportDownload(secret, id, expires.Add(time.Minute), sig, now), ErrInvalidExportSignature)
+}
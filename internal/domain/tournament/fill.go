@@ -0,0 +1,161 @@
+package tournament
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// FillTrendWindow is how far back registrations are used to fit the registration trend.
+	FillTrendWindow = 14 * 24 * time.Hour
+
+	// FillHorizonDays is how many days ahead a fill date is searched for.
+	FillHorizonDays = 90
+)
+
+// FillOutcome is the projected registration outcome of a tournament.
+type FillOutcome string
+
+const (
+	FillUnlimited FillOutcome = "unlimited" // No team cap to fill
+	FillFull      FillOutcome = "full"      // Every slot is taken
+	FillClosed    FillOutcome = "closed"    // Registration has closed with slots left
+	FillOnTrack   FillOutcome = "on_track"  // The trend fills the remaining slots by the deadline
+	FillAtRisk    FillOutcome = "at_risk"   // The trend leaves slots empty at the deadline
+)
+
+// FillProjection projects whether a tournament's team slots fill before
+// registration closes. The daily registration rate is fitted with a straight
+// line over the last FillTrendWindow and extrapolated, never below zero.
+type FillProjection struct {
+	Capacity          int         `json:"capacity"` // 0 when the tournament has no team cap
+	RegisteredTeams   int         `json:"registered_teams"`
+	RemainingSlots    int         `json:"remaining_slots"`
+	Deadline          time.Time   `json:"deadline"` // Registration deadline, or the start date without one
+	DaysRemaining     float64     `json:"days_remaining"`
+	VelocityPerDay    float64     `json:"velocity_per_day"` // Average registrations per day over the trend window
+	TrendPerDay       float64     `json:"trend_per_day"`    // Daily change of the registration rate
+	ProjectedTeams    int         `json:"projected_teams"`  // Teams expected by the deadline, capped at capacity
+	ProjectedFillRate float64     `json:"projected_fill_rate"`
+	ProjectedFillAt   *time.Time  `json:"projected_fill_at,omitempty"`  // When the trend fills every slot, within FillHorizonDays
+	SuggestedDeadline *time.Time  `json:"suggested_deadline,omitempty"` // Set when at risk but the trend fills the slots later
+	Outcome           FillOutcome `json:"outcome"`
+}
+
+// ProjectFill projects a tournament's registrations from the times its
+// current teams registered.
+func ProjectFill(t *Tournament, registeredAt []time.Time, now time.Time) FillProjection {
+	p := FillProjection{
+		Capacity:        t.Rules.MaxTeams,
+		RegisteredTeams: len(registeredAt),
+		Deadline:        t.StartDate,
+	}
+	if t.Rules.RegistrationDeadline != nil {
+		p.Deadline = *t.Rules.RegistrationDeadline
+	}
+	p.DaysRemaining = math.Max(0, p.Deadline.Sub(now).Hours()/24)
+
+	// Fit daily registrations over the trend window, one point per day.
+	windowStart := now.Add(-FillTrendWindow)
+	if t.CreatedAt.After(windowStart) {
+		windowStart = t.CreatedAt
+	}
+	days := int(math.Ceil(now.Sub(windowStart).Hours() / 24))
+	if days < 1 {
+		days = 1
+	}
+	daily := make([]float64, days)
+	inWindow := 0
+	for _, at := range registeredAt {
+		if at.Before(windowStart) || at.After(now) {
+			continue
+		}
+		i := int(at.Sub(windowStart).Hours() / 24)
+		if i >= days {
+			i = days - 1
+		}
+		daily[i]++
+		inWindow++
+	}
+	p.VelocityPerDay = float64(inWindow) / math.Max(1, now.Sub(windowStart).Hours()/24)
+	intercept, slope := linearFit(daily)
+	p.TrendPerDay = slope
+
+	rate := func(ahead int) float64 {
+		return math.Max(0, intercept+slope*float64(days+ahead))
+	}
+
+	switch {
+	case p.Capacity <= 0:
+		p.Outcome = FillUnlimited
+		p.ProjectedTeams = p.RegisteredTeams + int(projectRegistrations(rate, p.DaysRemaining))
+		return p
+	case p.RegisteredTeams >= p.Capacity:
+		p.Outcome = FillFull
+		p.ProjectedTeams = p.Capacity
+		p.ProjectedFillRate = 1
+		return p
+	}
+
+	p.RemainingSlots = p.Capacity - p.RegisteredTeams
+	expected := projectRegistrations(rate, p.DaysRemaining)
+	p.ProjectedTeams = min(p.Capacity, p.RegisteredTeams+int(expected))
+	p.ProjectedFillRate = float64(p.ProjectedTeams) / float64(p.Capacity)
+
+	cumulative := 0.0
+	for day := 0; day < FillHorizonDays; day++ {
+		cumulative += rate(day)
+		if cumulative >= float64(p.RemainingSlots) {
+			at := now.Add(time.Duration(day+1) * 24 * time.Hour)
+			p.ProjectedFillAt = &at
+			break
+		}
+	}
+
+	switch {
+	case !now.Before(p.Deadline) || t.Status == StatusFinished || t.Status == StatusCanceled:
+		p.Outcome = FillClosed
+	case p.ProjectedTeams >= p.Capacity:
+		p.Outcome = FillOnTrack
+	default:
+		p.Outcome = FillAtRisk
+		if p.ProjectedFillAt != nil {
+			p.SuggestedDeadline = p.ProjectedFillAt
+		}
+	}
+
+	return p
+}
+
+// projectRegistrations sums the projected daily rate over the given number of
+// days, counting a partial last day proportionally.
+func projectRegistrations(rate func(ahead int) float64, days float64) float64 {
+	total := 0.0
+	for day := 0; float64(day) < days; day++ {
+		total += rate(day) * math.Min(1, days-float64(day))
+	}
+	return total
+}
+
+// linearFit returns the least-squares line through (i, values[i]).
+func linearFit(values []float64) (intercept, slope float64) {
+	n := float64(len(values))
+	if len(values) < 2 {
+		if len(values) == 1 {
+			return values[0], 0
+		}
+		return 0, 0
+	}
+
+	var sumX, sumY, sumXY, sumXX float64
+	for i, y := range values {
+		x := float64(i)
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+	slope = (n*sumXY - sumX*sumY) / (n*sumXX - sumX*sumX)
+	intercept = (sumY - slope*sumX) / n
+	return intercept, slope
+}
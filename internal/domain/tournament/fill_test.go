@@ -0,0 +1,73 @@
+package tournament
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func fillTournament(now time.Time, maxTeams int, deadlineIn time.Duration) *Tournament {
+	deadline := now.Add(deadlineIn)
+	return &Tournament{
+		Status:    StatusOpen,
+		StartDate: deadline.Add(24 * time.Hour),
+		CreatedAt: now.Add(-10 * 24 * time.Hour),
+		Rules:     Rules{MaxTeams: maxTeams, RegistrationDeadline: &deadline},
+	}
+}
+
+// registrations returns perDay registrations on each of the last days days.
+func registrations(now time.Time, days, perDay int) []time.Time {
+	var out []time.Time
+	for d := 0; d < days; d++ {
+		for i := 0; i < perDay; i++ {
+			out = append(out, now.Add(-time.Duration(d)*24*time.Hour-time.Hour))
+		}
+	}
+	return out
+}
+
+func TestProjectFill(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, 10, 15, 12, 0, 0, 0, time.UTC)
+
+	t.Run("steady registrations fill before the deadline", func(t *testing.T) {
+		t.Parallel()
+		p := ProjectFill(fillTournament(now, 30, 10*24*time.Hour), registrations(now, 10, 2), now)
+		require.Equal(t, 20, p.RegisteredTeams)
+		require.Equal(t, 10, p.RemainingSlots)
+		require.InDelta(t, 2, p.VelocityPerDay, 0.0001)
+		require.InDelta(t, 0, p.TrendPerDay, 0.0001)
+		require.Equal(t, FillOnTrack, p.Outcome)
+		require.Equal(t, 30, p.ProjectedTeams)
+		require.NotNil(t, p.ProjectedFillAt)
+		require.Nil(t, p.SuggestedDeadline)
+	})
+
+	t.Run("slow registrations suggest a later deadline", func(t *testing.T) {
+		t.Parallel()
+		p := ProjectFill(fillTournament(now, 30, 2*24*time.Hour), registrations(now, 10, 1), now)
+		require.Equal(t, FillAtRisk, p.Outcome)
+		require.Equal(t, 12, p.ProjectedTeams)
+		require.InDelta(t, 0.4, p.ProjectedFillRate, 0.0001)
+		require.NotNil(t, p.SuggestedDeadline)
+		require.True(t, p.SuggestedDeadline.After(now.Add(2*24*time.Hour)))
+	})
+
+	t.Run("stalled registrations never fill", func(t *testing.T) {
+		t.Parallel()
+		p := ProjectFill(fillTournament(now, 30, 5*24*time.Hour), nil, now)
+		require.Equal(t, FillAtRisk, p.Outcome)
+		require.Nil(t, p.ProjectedFillAt)
+		require.Nil(t, p.SuggestedDeadline)
+	})
+
+	t.Run("full, closed and unlimited", func(t *testing.T) {
+		t.Parallel()
+		require.Equal(t, FillFull, ProjectFill(fillTournament(now, 5, 24*time.Hour), registrations(now, 5, 1), now).Outcome)
+		require.Equal(t, FillClosed, ProjectFill(fillTournament(now, 30, -time.Hour), registrations(now, 5, 1), now).Outcome)
+		require.Equal(t, FillUnlimited, ProjectFill(fillTournament(now, 0, 24*time.Hour), registrations(now, 5, 1), now).Outcome)
+	})
+}
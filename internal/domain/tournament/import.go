@@ -0,0 +1,258 @@
+package tournament
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ImportFormat identifies the external platform an import was exported from.
+type ImportFormat string
+
+const (
+	ImportBattlefy   ImportFormat = "battlefy"
+	ImportToornament ImportFormat = "toornament"
+)
+
+// MaxImportTeams is the most teams a single import may contain.
+const MaxImportTeams = 512
+
+var (
+	ErrInvalidImportFormat = errors.New("import format must be battlefy or toornament")
+	ErrInvalidImportData   = errors.New("invalid import data")
+)
+
+// IsValid reports whether the format is supported.
+func (f ImportFormat) IsValid() bool {
+	return f == ImportBattlefy || f == ImportToornament
+}
+
+// ImportedPlayer is a roster entry from an external platform. Players are
+// matched to local players by PlatformID first, then by Email. When no
+// player is marked as captain, the first matched player captains the team.
+type ImportedPlayer struct {
+	Name       string `json:"name"`
+	Email      string `json:"email,omitempty"`
+	PlatformID string `json:"platform_id,omitempty"`
+	Captain    bool   `json:"captain,omitempty"`
+}
+
+// ImportedTeam is a team and its roster from an external platform.
+type ImportedTeam struct {
+	Name    string           `json:"name"`
+	Players []ImportedPlayer `json:"players"`
+}
+
+// ImportedResult is one team's result in one external match.
+type ImportedResult struct {
+	Round     int    `json:"round"`
+	Match     int    `json:"match"`
+	Team      string `json:"team"`
+	Placement int    `json:"placement"`
+	Score     int    `json:"score"`
+}
+
+// ImportData is an external tournament normalized for import.
+type ImportData struct {
+	Teams   []ImportedTeam   `json:"teams"`
+	Results []ImportedResult `json:"results"`
+}
+
+// ParseImport normalizes an external export. platformKey names the
+// Toornament custom field holding each player's platform ID; Battlefy
+// exports always use the player's in-game name.
+func ParseImport(format ImportFormat, data []byte, platformKey string) (*ImportData, error) {
+	var (
+		parsed *ImportData
+		err    error
+	)
+	switch format {
+	case ImportBattlefy:
+		parsed, err = parseBattlefy(data)
+	case ImportToornament:
+		parsed, err = parseToornament(data, platformKey)
+	default:
+		return nil, ErrInvalidImportFormat
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parsed.Teams) == 0 {
+		return nil, fmt.Errorf("%w: no teams found", ErrInvalidImportData)
+	}
+	if len(parsed.Teams) > MaxImportTeams {
+		return nil, fmt.Errorf("%w: at most %d teams can be imported", ErrInvalidImportData, MaxImportTeams)
+	}
+	seen := make(map[string]bool, len(parsed.Teams))
+	for _, t := range parsed.Teams {
+		key := strings.ToLower(t.Name)
+		if t.Name == "" {
+			return nil, fmt.Errorf("%w: team name cannot be empty", ErrInvalidImportData)
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("%w: duplicate team %q", ErrInvalidImportData, t.Name)
+		}
+		seen[key] = true
+	}
+	return parsed, nil
+}
+
+// battlefyExport is the combined Battlefy teams and matches export.
+type battlefyExport struct {
+	Teams []struct {
+		Name      string `json:"name"`
+		CaptainID string `json:"captainID"`
+		Players   []struct {
+			ID         string `json:"_id"`
+			InGameName string `json:"inGameName"`
+			Username   string `json:"username"`
+			Email      string `json:"email"`
+		} `json:"players"`
+	} `json:"teams"`
+	Matches []struct {
+		RoundNumber int          `json:"roundNumber"`
+		MatchNumber int          `json:"matchNumber"`
+		IsBye       bool         `json:"isBye"`
+		Top         battlefySlot `json:"top"`
+		Bottom      battlefySlot `json:"bottom"`
+	} `json:"matches"`
+}
+
+type battlefySlot struct {
+	Team *struct {
+		Name string `json:"name"`
+	} `json:"team"`
+	Score  int  `json:"score"`
+	Winner bool `json:"winner"`
+}
+
+func parseBattlefy(data []byte) (*ImportData, error) {
+	var export battlefyExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImportData, err)
+	}
+
+	parsed := &ImportData{}
+	for _, t := range export.Teams {
+		team := ImportedTeam{Name: strings.TrimSpace(t.Name)}
+		for _, p := range t.Players {
+			name := p.InGameName
+			if name == "" {
+				name = p.Username
+			}
+			team.Players = append(team.Players, ImportedPlayer{
+				Name:       strings.TrimSpace(name),
+				Email:      strings.TrimSpace(p.Email),
+				PlatformID: strings.TrimSpace(p.InGameName),
+				Captain:    p.ID != "" && p.ID == t.CaptainID,
+			})
+		}
+		parsed.Teams = append(parsed.Teams, team)
+	}
+
+	for _, m := range export.Matches {
+		if m.IsBye {
+			continue
+		}
+		for _, slot := range []battlefySlot{m.Top, m.Bottom} {
+			if slot.Team == nil {
+				continue
+			}
+			placement := 2
+			if slot.Winner {
+				placement = 1
+			}
+			parsed.Results = append(parsed.Results, ImportedResult{
+				Round:     m.RoundNumber,
+				Match:     m.MatchNumber,
+				Team:      strings.TrimSpace(slot.Team.Name),
+				Placement: placement,
+				Score:     slot.Score,
+			})
+		}
+	}
+	return parsed, nil
+}
+
+// toornamentExport is the combined Toornament participants and matches export.
+type toornamentExport struct {
+	Participants []struct {
+		Name   string `json:"name"`
+		Lineup []struct {
+			Name         string         `json:"name"`
+			Email        string         `json:"email"`
+			CustomFields map[string]any `json:"custom_fields"`
+		} `json:"lineup"`
+	} `json:"participants"`
+	Matches []struct {
+		RoundNumber int    `json:"round_number"`
+		Number      int    `json:"number"`
+		Status      string `json:"status"`
+		Opponents   []struct {
+			Rank        int    `json:"rank"`
+			Result      string `json:"result"`
+			Score       int    `json:"score"`
+			Participant *struct {
+				Name string `json:"name"`
+			} `json:"participant"`
+		} `json:"opponents"`
+	} `json:"matches"`
+}
+
+func parseToornament(data []byte, platformKey string) (*ImportData, error) {
+	var export toornamentExport
+	if err := json.Unmarshal(data, &export); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImportData, err)
+	}
+
+	parsed := &ImportData{}
+	for _, p := range export.Participants {
+		team := ImportedTeam{Name: strings.TrimSpace(p.Name)}
+		for _, member := range p.Lineup {
+			var platformID string
+			if platformKey != "" {
+				if v, ok := member.CustomFields[platformKey].(string); ok {
+					platformID = strings.TrimSpace(v)
+				}
+			}
+			team.Players = append(team.Players, ImportedPlayer{
+				Name:       strings.TrimSpace(member.Name),
+				Email:      strings.TrimSpace(member.Email),
+				PlatformID: platformID,
+			})
+		}
+		parsed.Teams = append(parsed.Teams, team)
+	}
+
+	for _, m := range export.Matches {
+		if m.Status != "" && m.Status != "completed" {
+			continue
+		}
+		for _, o := range m.Opponents {
+			if o.Participant == nil {
+				continue
+			}
+			placement := o.Rank
+			if placement == 0 {
+				switch o.Result {
+				case "win", "draw":
+					placement = 1
+				case "loss":
+					placement = 2
+				default:
+					continue
+				}
+			}
+			parsed.Results = append(parsed.Results, ImportedResult{
+				Round:     m.RoundNumber,
+				Match:     m.Number,
+				Team:      strings.TrimSpace(o.Participant.Name),
+				Placement: placement,
+				Score:     o.Score,
+			})
+		}
+	}
+	return parsed, nil
+}
@@ -0,0 +1,99 @@
+package tournament
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseImport_Battlefy(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`{
+		"teams": [
+			{"name": "Alpha", "captainID": "p2", "players": [
+				{"_id": "p1", "inGameName": "ghost#123", "email": "ghost@example.com"},
+				{"_id": "p2", "username": "reaper"}
+			]},
+			{"name": "Bravo", "players": []}
+		],
+		"matches": [
+			{"roundNumber": 1, "matchNumber": 1,
+			 "top": {"team": {"name": "Alpha"}, "score": 2, "winner": true},
+			 "bottom": {"team": {"name": "Bravo"}, "score": 1}},
+			{"roundNumber": 2, "matchNumber": 1, "isBye": true, "top": {"team": {"name": "Alpha"}}}
+		]
+	}`)
+
+	parsed, err := ParseImport(ImportBattlefy, data, "")
+	require.NoError(t, err)
+	require.Len(t, parsed.Teams, 2)
+
+	alpha := parsed.Teams[0]
+	require.Equal(t, ImportedPlayer{Name: "ghost#123", Email: "ghost@example.com", PlatformID: "ghost#123"}, alpha.Players[0])
+	require.Equal(t, ImportedPlayer{Name: "reaper", Captain: true}, alpha.Players[1])
+
+	require.Equal(t, []ImportedResult{
+		{Round: 1, Match: 1, Team: "Alpha", Placement: 1, Score: 2},
+		{Round: 1, Match: 1, Team: "Bravo", Placement: 2, Score: 1},
+	}, parsed.Results)
+}
+
+func TestParseImport_Toornament(t *testing.T) {
+	t.Parallel()
+
+	data := []byte(`{
+		"participants": [
+			{"name": "Alpha", "lineup": [
+				{"name": "ghost", "email": "ghost@example.com", "custom_fields": {"activision_id": "ghost#123"}}
+			]},
+			{"name": "Bravo", "lineup": [{"name": "soap"}]}
+		],
+		"matches": [
+			{"round_number": 1, "number": 3, "status": "completed", "opponents": [
+				{"rank": 4, "score": 12, "participant": {"name": "Alpha"}},
+				{"result": "loss", "participant": {"name": "Bravo"}},
+				{"participant": null}
+			]},
+			{"round_number": 2, "number": 1, "status": "pending", "opponents": [
+				{"rank": 1, "participant": {"name": "Alpha"}}
+			]}
+		]
+	}`)
+
+	parsed, err := ParseImport(ImportToornament, data, "activision_id")
+	require.NoError(t, err)
+	require.Equal(t, "ghost#123", parsed.Teams[0].Players[0].PlatformID)
+	require.Empty(t, parsed.Teams[1].Players[0].PlatformID)
+
+	require.Equal(t, []ImportedResult{
+		{Round: 1, Match: 3, Team: "Alpha", Placement: 4, Score: 12},
+		{Round: 1, Match: 3, Team: "Bravo", Placement: 2},
+	}, parsed.Results)
+}
+
+func TestParseImport_Invalid(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		format   ImportFormat
+		data     string
+		expected error
+	}{
+		{name: "unknown format", format: "challonge", data: `{}`, expected: ErrInvalidImportFormat},
+		{name: "malformed json", format: ImportBattlefy, data: `{"teams":`, expected: ErrInvalidImportData},
+		{name: "no teams", format: ImportToornament, data: `{"participants": []}`, expected: ErrInvalidImportData},
+		{name: "unnamed team", format: ImportBattlefy, data: `{"teams": [{"name": " "}]}`, expected: ErrInvalidImportData},
+		{name: "duplicate team", format: ImportBattlefy, data: `{"teams": [{"name": "Alpha"}, {"name": "alpha"}]}`, expected: ErrInvalidImportData},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := ParseImport(tc.format, []byte(tc.data), "")
+			require.ErrorIs(t, err, tc.expected)
+		})
+	}
+}
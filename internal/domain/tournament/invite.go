@@ -0,0 +1,196 @@
+package tournament
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// MaxInvites caps how many invitations, reserves included, a tournament can hold.
+	MaxInvites = 256
+	// MaxInviteNameLength caps an invitation's display name, in characters.
+	MaxInviteNameLength = 50
+)
+
+var (
+	ErrNotInvited        = errors.New("tournament is invite-only and the player has no open invitation")
+	ErrAlreadyInvited    = errors.New("player is already invited to this tournament")
+	ErrInviteNotFound    = errors.New("invitation not found")
+	ErrInviteAccepted    = errors.New("invitation was already accepted by a registered team")
+	ErrInviteNotOpen     = errors.New("invitation is no longer open")
+	ErrTooManyInvites    = errors.New("tournament cannot have more than 256 invitations")
+	ErrInvalidInviteName = errors.New("invitation name cannot exceed 50 characters")
+)
+
+// InviteStatus represents where an invitation is in its lifecycle.
+type InviteStatus string
+
+const (
+	InviteOffered  InviteStatus = "offered"  // Holds a slot; the player may register a team
+	InviteReserve  InviteStatus = "reserve"  // Waits for a slot to free up
+	InviteAccepted InviteStatus = "accepted" // The player registered a team
+	InviteDeclined InviteStatus = "declined" // The player gave up the slot, or their team withdrew
+)
+
+// Invite lets a player register a team in an invite-only tournament.
+// Invitations are offered in the order they were sent while slots remain;
+// later ones wait as reserves and take the slots that are given up.
+type Invite struct {
+	PlayerID    uuid.UUID    `bson:"player_id" json:"player_id"`           // The captain who may register a team
+	Name        string       `bson:"name,omitempty" json:"name,omitempty"` // e.g. the qualifying team's name
+	Status      InviteStatus `bson:"status" json:"status"`
+	TeamID      *uuid.UUID   `bson:"team_id,omitempty" json:"team_id,omitempty"` // The registered team once accepted
+	InvitedAt   time.Time    `bson:"invited_at" json:"invited_at"`
+	RespondedAt *time.Time   `bson:"responded_at,omitempty" json:"responded_at,omitempty"`
+}
+
+// InviteSlots returns how many invitations can be offered at once: the team
+// cap, or 0 when every invitation is offered.
+func (t *Tournament) InviteSlots() int {
+	return t.Rules.MaxTeams
+}
+
+// SlotsTaken returns how many invitations hold a slot.
+func (t *Tournament) SlotsTaken() int {
+	taken := 0
+	for _, inv := range t.Invites {
+		if inv.Status == InviteOffered || inv.Status == InviteAccepted {
+			taken++
+		}
+	}
+	return taken
+}
+
+// FindInvite returns the player's invitation, or nil.
+func (t *Tournament) FindInvite(playerID uuid.UUID) *Invite {
+	for i := range t.Invites {
+		if t.Invites[i].PlayerID == playerID {
+			return &t.Invites[i]
+		}
+	}
+	return nil
+}
+
+// CanRegister reports whether the player may register a team: the
+// tournament is open to everyone, or the player holds an offered invitation.
+func (t *Tournament) CanRegister(playerID uuid.UUID) bool {
+	if !t.Rules.InviteOnly {
+		return true
+	}
+	inv := t.FindInvite(playerID)
+	return inv != nil && inv.Status == InviteOffered
+}
+
+// Invite adds an invitation for the player. It is offered if a slot is free
+// and kept as a reserve otherwise.
+func (t *Tournament) Invite(playerID uuid.UUID, name string, now time.Time) (*Invite, error) {
+	if t.FindInvite(playerID) != nil {
+		return nil, ErrAlreadyInvited
+	}
+	if len(t.Invites) >= MaxInvites {
+		return nil, ErrTooManyInvites
+	}
+	name = strings.TrimSpace(name)
+	if utf8.RuneCountInString(name) > MaxInviteNameLength {
+		return nil, ErrInvalidInviteName
+	}
+
+	status := InviteReserve
+	if t.slotFree() {
+		status = InviteOffered
+	}
+	t.Invites = append(t.Invites, Invite{
+		PlayerID:  playerID,
+		Name:      name,
+		Status:    status,
+		InvitedAt: now,
+	})
+	t.UpdatedAt = now
+	return &t.Invites[len(t.Invites)-1], nil
+}
+
+// AcceptInvite records that the player registered teamID with their offered
+// invitation.
+func (t *Tournament) AcceptInvite(playerID, teamID uuid.UUID, now time.Time) error {
+	inv := t.FindInvite(playerID)
+	if inv == nil || inv.Status != InviteOffered {
+		return ErrNotInvited
+	}
+	inv.Status = InviteAccepted
+	inv.TeamID = &teamID
+	inv.RespondedAt = &now
+	t.UpdatedAt = now
+	return nil
+}
+
+// DeclineInvite gives up the player's offered or reserve invitation. A freed
+// slot goes to the first reserve.
+func (t *Tournament) DeclineInvite(playerID uuid.UUID, now time.Time) error {
+	inv := t.FindInvite(playerID)
+	if inv == nil {
+		return ErrInviteNotFound
+	}
+	if inv.Status != InviteOffered && inv.Status != InviteReserve {
+		return ErrInviteNotOpen
+	}
+	inv.Status = InviteDeclined
+	inv.RespondedAt = &now
+	t.ReallocateInvites(now)
+	return nil
+}
+
+// ReleaseInvite frees the slot of an accepted invitation whose team
+// withdrew. It reports whether the team held one.
+func (t *Tournament) ReleaseInvite(teamID uuid.UUID, now time.Time) bool {
+	for i := range t.Invites {
+		inv := &t.Invites[i]
+		if inv.Status == InviteAccepted && inv.TeamID != nil && *inv.TeamID == teamID {
+			inv.Status = InviteDeclined
+			t.ReallocateInvites(now)
+			return true
+		}
+	}
+	return false
+}
+
+// RevokeInvite withdraws a player's invitation that has not been accepted.
+func (t *Tournament) RevokeInvite(playerID uuid.UUID, now time.Time) error {
+	for i, inv := range t.Invites {
+		if inv.PlayerID != playerID {
+			continue
+		}
+		if inv.Status == InviteAccepted {
+			return ErrInviteAccepted
+		}
+		t.Invites = append(t.Invites[:i], t.Invites[i+1:]...)
+		t.ReallocateInvites(now)
+		return nil
+	}
+	return ErrInviteNotFound
+}
+
+// ReallocateInvites offers free slots to reserves in the order they were
+// invited. It returns how many reserves were offered a slot.
+func (t *Tournament) ReallocateInvites(now time.Time) int {
+	offered := 0
+	for i := range t.Invites {
+		if !t.slotFree() {
+			break
+		}
+		if t.Invites[i].Status == InviteReserve {
+			t.Invites[i].Status = InviteOffered
+			offered++
+		}
+	}
+	t.UpdatedAt = now
+	return offered
+}
+
+func (t *Tournament) slotFree() bool {
+	slots := t.InviteSlots()
+	return slots <= 0 || t.SlotsTaken() < slots
+}
@@ -0,0 +1,148 @@
+package tournament
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newInviteOnlyTournament(t *testing.T, slots int) *Tournament {
+	t.Helper()
+	now := time.Now().UTC()
+	tour, err := NewTournament(uuid.New(), uuid.New(), "Finals", TeamSizeDuos, now.Add(time.Hour), now.Add(2*time.Hour))
+	require.NoError(t, err)
+	tour.Rules.InviteOnly = true
+	tour.Rules.MaxTeams = slots
+	return tour
+}
+
+func TestTournament_Invite(t *testing.T) {
+	t.Parallel()
+
+	tour := newInviteOnlyTournament(t, 2)
+	now := time.Now().UTC()
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+
+	inv, err := tour.Invite(first, " Night Owls ", now)
+	require.NoError(t, err)
+	require.Equal(t, InviteOffered, inv.Status)
+	require.Equal(t, "Night Owls", inv.Name)
+
+	_, err = tour.Invite(second, "", now)
+	require.NoError(t, err)
+	inv, err = tour.Invite(third, "", now)
+	require.NoError(t, err)
+	require.Equal(t, InviteReserve, inv.Status, "no slot left")
+	require.Equal(t, 2, tour.SlotsTaken())
+
+	_, err = tour.Invite(first, "", now)
+	require.ErrorIs(t, err, ErrAlreadyInvited)
+	_, err = tour.Invite(uuid.New(), strings.Repeat("x", MaxInviteNameLength+1), now)
+	require.ErrorIs(t, err, ErrInvalidInviteName)
+
+	require.True(t, tour.CanRegister(first))
+	require.False(t, tour.CanRegister(third), "reserves wait for a slot")
+	require.False(t, tour.CanRegister(uuid.New()))
+
+	tour.Rules.InviteOnly = false
+	require.True(t, tour.CanRegister(uuid.New()))
+}
+
+func TestTournament_Invite_Unlimited(t *testing.T) {
+	t.Parallel()
+
+	tour := newInviteOnlyTournament(t, 0)
+	for i := 0; i < 5; i++ {
+		inv, err := tour.Invite(uuid.New(), "", time.Now())
+		require.NoError(t, err)
+		require.Equal(t, InviteOffered, inv.Status)
+	}
+}
+
+func TestTournament_DeclineInvite(t *testing.T) {
+	t.Parallel()
+
+	tour := newInviteOnlyTournament(t, 1)
+	now := time.Now().UTC()
+	first, second, third := uuid.New(), uuid.New(), uuid.New()
+	for _, id := range []uuid.UUID{first, second, third} {
+		_, err := tour.Invite(id, "", now)
+		require.NoError(t, err)
+	}
+
+	// A reserve declining frees nothing.
+	require.NoError(t, tour.DeclineInvite(second, now))
+	require.Equal(t, InviteDeclined, tour.FindInvite(second).Status)
+	require.Equal(t, InviteReserve, tour.FindInvite(third).Status)
+
+	// The slot skips the declined reserve and goes to the next one.
+	require.NoError(t, tour.DeclineInvite(first, now))
+	require.Equal(t, InviteOffered, tour.FindInvite(third).Status)
+
+	require.ErrorIs(t, tour.DeclineInvite(first, now), ErrInviteNotOpen)
+	require.ErrorIs(t, tour.DeclineInvite(uuid.New(), now), ErrInviteNotFound)
+}
+
+func TestTournament_AcceptAndReleaseInvite(t *testing.T) {
+	t.Parallel()
+
+	tour := newInviteOnlyTournament(t, 1)
+	now := time.Now().UTC()
+	first, second := uuid.New(), uuid.New()
+	_, err := tour.Invite(first, "", now)
+	require.NoError(t, err)
+	_, err = tour.Invite(second, "", now)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, tour.AcceptInvite(second, uuid.New(), now), ErrNotInvited)
+
+	teamID := uuid.New()
+	require.NoError(t, tour.AcceptInvite(first, teamID, now))
+	inv := tour.FindInvite(first)
+	require.Equal(t, InviteAccepted, inv.Status)
+	require.Equal(t, teamID, *inv.TeamID)
+	require.False(t, tour.CanRegister(first), "already registered")
+	require.ErrorIs(t, tour.RevokeInvite(first, now), ErrInviteAccepted)
+
+	require.False(t, tour.ReleaseInvite(uuid.New(), now))
+	require.True(t, tour.ReleaseInvite(teamID, now))
+	require.Equal(t, InviteDeclined, inv.Status)
+	require.Equal(t, InviteOffered, tour.FindInvite(second).Status)
+}
+
+func TestTournament_RevokeInvite(t *testing.T) {
+	t.Parallel()
+
+	tour := newInviteOnlyTournament(t, 1)
+	now := time.Now().UTC()
+	first, second := uuid.New(), uuid.New()
+	_, err := tour.Invite(first, "", now)
+	require.NoError(t, err)
+	_, err = tour.Invite(second, "", now)
+	require.NoError(t, err)
+
+	require.NoError(t, tour.RevokeInvite(first, now))
+	require.Nil(t, tour.FindInvite(first))
+	require.Equal(t, InviteOffered, tour.FindInvite(second).Status)
+	require.ErrorIs(t, tour.RevokeInvite(first, now), ErrInviteNotFound)
+}
+
+func TestTournament_ReallocateInvites(t *testing.T) {
+	t.Parallel()
+
+	tour := newInviteOnlyTournament(t, 1)
+	now := time.Now().UTC()
+	for i := 0; i < 3; i++ {
+		_, err := tour.Invite(uuid.New(), "", now)
+		require.NoError(t, err)
+	}
+
+	// Raising the team cap offers the new slots to reserves.
+	tour.Rules.MaxTeams = 2
+	require.Equal(t, 1, tour.ReallocateInvites(now))
+	require.Equal(t, 2, tour.SlotsTaken())
+	require.Equal(t, 0, tour.ReallocateInvites(now))
+}
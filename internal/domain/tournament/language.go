@@ -0,0 +1,82 @@
+package tournament
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// MaxLanguages caps the number of languages a tournament can list.
+const MaxLanguages = 5
+
+var (
+	ErrInvalidLanguage  = errors.New("unsupported tournament language")
+	ErrTooManyLanguages = errors.New("tournament can list at most 5 languages")
+)
+
+// Language is a locale tournaments can be run in.
+type Language struct {
+	Code string `json:"code"` // ISO 639-1
+	Name string `json:"name"`
+}
+
+// supportedLanguages lists the locales tournaments can be run in. Names match
+// the language options of player profiles.
+var supportedLanguages = []Language{
+	{Code: "en", Name: "English"},
+	{Code: "es", Name: "Spanish"},
+	{Code: "pt", Name: "Portuguese"},
+	{Code: "fr", Name: "French"},
+	{Code: "de", Name: "German"},
+	{Code: "it", Name: "Italian"},
+	{Code: "ja", Name: "Japanese"},
+	{Code: "ko", Name: "Korean"},
+	{Code: "zh", Name: "Chinese"},
+}
+
+// SupportedLanguages returns the locales tournaments can be run in.
+func SupportedLanguages() []Language {
+	return append([]Language(nil), supportedLanguages...)
+}
+
+// LanguageCode returns the code of a supported language given either its
+// code or its name, case-insensitively. Player profiles store names.
+func LanguageCode(language string) (string, bool) {
+	language = strings.TrimSpace(language)
+	for _, l := range supportedLanguages {
+		if strings.EqualFold(language, l.Code) || strings.EqualFold(language, l.Name) {
+			return l.Code, true
+		}
+	}
+	return "", false
+}
+
+// SetLanguages sets the languages the tournament is run in, as codes and
+// without duplicates. An empty list means the tournament does not say.
+func (t *Tournament) SetLanguages(languages []string) error {
+	codes := make([]string, 0, len(languages))
+	for _, language := range languages {
+		code, ok := LanguageCode(language)
+		if !ok {
+			return ErrInvalidLanguage
+		}
+		if !containsString(codes, code) {
+			codes = append(codes, code)
+		}
+	}
+	if len(codes) > MaxLanguages {
+		return ErrTooManyLanguages
+	}
+	t.Languages = codes
+	t.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,74 @@
+package tournament
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLanguageCode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		language string
+		want     string
+		wantOK   bool
+	}{
+		{name: "code", language: "es", want: "es", wantOK: true},
+		{name: "upper case code", language: "PT", want: "pt", wantOK: true},
+		{name: "profile name", language: "Japanese", want: "ja", wantOK: true},
+		{name: "padded name", language: " german ", want: "de", wantOK: true},
+		{name: "empty", language: ""},
+		{name: "unsupported", language: "Klingon"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := LanguageCode(tc.language)
+			require.Equal(t, tc.wantOK, ok)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestTournament_SetLanguages(t *testing.T) {
+	t.Parallel()
+
+	newTournament := func(t *testing.T) *Tournament {
+		tr, err := NewTournament(uuid.New(), uuid.New(), "Cup", TeamSizeDuos, time.Now(), time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		return tr
+	}
+
+	t.Run("normalizes and deduplicates", func(t *testing.T) {
+		t.Parallel()
+
+		tr := newTournament(t)
+		require.NoError(t, tr.SetLanguages([]string{"Spanish", "en", "ES"}))
+		require.Equal(t, []string{"es", "en"}, tr.Languages)
+
+		require.NoError(t, tr.SetLanguages(nil))
+		require.Empty(t, tr.Languages)
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		t.Parallel()
+
+		tr := newTournament(t)
+		require.NoError(t, tr.SetLanguages([]string{"en"}))
+		require.ErrorIs(t, tr.SetLanguages([]string{"fr", "xx"}), ErrInvalidLanguage)
+		require.Equal(t, []string{"en"}, tr.Languages)
+	})
+
+	t.Run("too many", func(t *testing.T) {
+		t.Parallel()
+
+		tr := newTournament(t)
+		require.ErrorIs(t, tr.SetLanguages([]string{"en", "es", "pt", "fr", "de", "it"}), ErrTooManyLanguages)
+	})
+}
@@ -14,6 +14,10 @@ type Repository interface {
 	// GetByID retrieves a tournament by its ID.
 	GetByID(ctx context.Context, id uuid.UUID) (*Tournament, error)
 
+	// GetByIDFields retrieves a tournament loading only the given fields, by
+	// their JSON names, besides its ID. Nil fields loads every field.
+	GetByIDFields(ctx context.Context, id uuid.UUID, fields []string) (*Tournament, error)
+
 	// Update updates an existing tournament.
 	Update(ctx context.Context, tournament *Tournament) error
 
@@ -23,6 +27,9 @@ type Repository interface {
 	// List retrieves tournaments with optional filtering.
 	List(ctx context.Context, filter ListFilter) ([]*Tournament, error)
 
+	// Count returns the number of tournaments matching a filter, ignoring its limit and offset.
+	Count(ctx context.Context, filter ListFilter) (int64, error)
+
 	// GetByGameID retrieves all tournaments for a specific game.
 	GetByGameID(ctx context.Context, gameID uuid.UUID) ([]*Tournament, error)
 
@@ -47,6 +54,12 @@ type ListFilter struct {
 	// CreatedBy filters by creator user ID (optional).
 	CreatedBy *uuid.UUID
 
+	// SeriesID filters by organization series (optional).
+	SeriesID *uuid.UUID
+
+	// Language filters by a language code the tournament is run in (optional).
+	Language string
+
 	// Limit is the maximum number of results to return.
 	Limit int
 
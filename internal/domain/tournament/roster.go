@@ -0,0 +1,41 @@
+package tournament
+
+import "errors"
+
+const (
+	// MaxTeamSize is the largest lineup a tournament can field.
+	MaxTeamSize TeamSize = 10
+	// MaxRosterSize is the largest roster a team can register, substitutes included.
+	MaxRosterSize = 16
+)
+
+// ErrInvalidRosterSize is returned when a roster size is below the team size or above MaxRosterSize.
+var ErrInvalidRosterSize = errors.New("roster size must be at least the team size and at most 16")
+
+// SetRosterSize sets how many players a team may register. Zero limits
+// rosters to the team size, leaving no room for substitutes.
+func (t *Tournament) SetRosterSize(size int) error {
+	if size != 0 && (size < int(t.TeamSize) || size > MaxRosterSize) {
+		return ErrInvalidRosterSize
+	}
+	t.RosterSize = size
+	return nil
+}
+
+// MaxRoster returns how many players a team may register.
+func (t *Tournament) MaxRoster() int {
+	if t.RosterSize > 0 {
+		return t.RosterSize
+	}
+	return int(t.TeamSize)
+}
+
+// LineupSize returns how many players a team with members registered players
+// must report in a match: the team size, or every member of a team that has
+// not filled its lineup.
+func (t *Tournament) LineupSize(members int) int {
+	if members < int(t.TeamSize) {
+		return members
+	}
+	return int(t.TeamSize)
+}
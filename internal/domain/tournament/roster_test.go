@@ -0,0 +1,63 @@
+package tournament
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTeamSize_IsValid(t *testing.T) {
+	t.Parallel()
+
+	require.False(t, TeamSize(0).IsValid())
+	require.True(t, TeamSizeSolo.IsValid())
+	require.True(t, TeamSize(5).IsValid())
+	require.True(t, MaxTeamSize.IsValid())
+	require.False(t, (MaxTeamSize + 1).IsValid())
+
+	require.Equal(t, "quads", TeamSizeQuads.String())
+	require.Equal(t, "5-player", TeamSize(5).String())
+	require.Equal(t, "unknown", (MaxTeamSize + 1).String())
+}
+
+func TestTournament_SetRosterSize(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		size       int
+		wantErr    error
+		wantRoster int
+	}{
+		{name: "defaults to team size", size: 0, wantRoster: 3},
+		{name: "team size", size: 3, wantRoster: 3},
+		{name: "with substitutes", size: 5, wantRoster: 5},
+		{name: "at limit", size: MaxRosterSize, wantRoster: MaxRosterSize},
+		{name: "below team size", size: 2, wantErr: ErrInvalidRosterSize},
+		{name: "above limit", size: MaxRosterSize + 1, wantErr: ErrInvalidRosterSize},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			tr := newTestTournament(t)
+			err := tr.SetRosterSize(tc.size)
+			require.ErrorIs(t, err, tc.wantErr)
+			if tc.wantErr == nil {
+				require.Equal(t, tc.wantRoster, tr.MaxRoster())
+			}
+		})
+	}
+}
+
+func TestTournament_LineupSize(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTournament(t)
+	require.NoError(t, tr.SetRosterSize(5))
+
+	require.Equal(t, 2, tr.LineupSize(2))
+	require.Equal(t, 3, tr.LineupSize(3))
+	require.Equal(t, 3, tr.LineupSize(5))
+}
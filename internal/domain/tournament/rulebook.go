@@ -0,0 +1,131 @@
+package tournament
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+)
+
+// SectionKind groups rulebook sections on the tournament overview.
+type SectionKind string
+
+const (
+	SectionRules SectionKind = "rules"
+	SectionFAQ   SectionKind = "faq"
+)
+
+const (
+	// MaxRulebookSections caps the number of sections in a rulebook.
+	MaxRulebookSections = 50
+	// MaxSectionTitleLength caps a section title, in characters.
+	MaxSectionTitleLength = 120
+	// MaxSectionContentLength caps a section's markdown content, in characters.
+	MaxSectionContentLength = 20000
+)
+
+var (
+	ErrRulebookNotFound   = errors.New("tournament rulebook not found")
+	ErrTooManySections    = errors.New("rulebook can have at most 50 sections")
+	ErrInvalidSectionKind = errors.New("section kind must be rules or faq")
+	ErrInvalidSection     = errors.New("section title must be 1-120 characters and content at most 20000 characters")
+	ErrDuplicateSection   = errors.New("section appears more than once in the rulebook")
+)
+
+// IsValid checks if the section kind is valid.
+func (k SectionKind) IsValid() bool {
+	return k == SectionRules || k == SectionFAQ
+}
+
+// Section is one rules or FAQ entry of a tournament's rulebook. Content is
+// markdown and is rendered by the client.
+type Section struct {
+	ID        uuid.UUID   `bson:"id" json:"id"`
+	Kind      SectionKind `bson:"kind" json:"kind"`
+	Title     string      `bson:"title" json:"title"`
+	Content   string      `bson:"content" json:"content"`
+	Position  int         `bson:"position" json:"position"`
+	UpdatedAt time.Time   `bson:"updated_at" json:"updated_at"`
+	UpdatedBy uuid.UUID   `bson:"updated_by" json:"updated_by"`
+}
+
+// Rulebook holds a tournament's structured rules and FAQ, in display order.
+// It replaces the free-text Description for rules content; Description stays
+// as a short summary.
+type Rulebook struct {
+	TournamentID uuid.UUID  `bson:"_id" json:"tournament_id"`
+	Sections     []*Section `bson:"sections" json:"sections"`
+	UpdatedAt    time.Time  `bson:"updated_at" json:"updated_at"`
+}
+
+// NewRulebook creates an empty rulebook for a tournament.
+func NewRulebook(tournamentID uuid.UUID) *Rulebook {
+	return &Rulebook{TournamentID: tournamentID, Sections: []*Section{}}
+}
+
+// SetSections replaces the rulebook's sections with sections, in the given
+// order. Sections without an ID are new and get one. A section whose kind,
+// title and content are unchanged keeps its previous UpdatedAt and UpdatedBy,
+// so readers can tell which entries actually changed.
+func (rb *Rulebook) SetSections(sections []*Section, updatedBy uuid.UUID, now time.Time) error {
+	if len(sections) > MaxRulebookSections {
+		return ErrTooManySections
+	}
+
+	previous := make(map[uuid.UUID]*Section, len(rb.Sections))
+	for _, s := range rb.Sections {
+		previous[s.ID] = s
+	}
+
+	now = now.UTC()
+	seen := make(map[uuid.UUID]bool, len(sections))
+	result := make([]*Section, 0, len(sections))
+	for i, in := range sections {
+		s := &Section{
+			ID:        in.ID,
+			Kind:      in.Kind,
+			Title:     strings.TrimSpace(in.Title),
+			Content:   strings.TrimSpace(in.Content),
+			Position:  i,
+			UpdatedAt: now,
+			UpdatedBy: updatedBy,
+		}
+		if !s.Kind.IsValid() {
+			return ErrInvalidSectionKind
+		}
+		if s.Title == "" || utf8.RuneCountInString(s.Title) > MaxSectionTitleLength ||
+			utf8.RuneCountInString(s.Content) > MaxSectionContentLength {
+			return ErrInvalidSection
+		}
+
+		if s.ID == uuid.Nil {
+			s.ID = uuid.New()
+		} else if seen[s.ID] {
+			return ErrDuplicateSection
+		}
+		seen[s.ID] = true
+
+		if old, ok := previous[s.ID]; ok && old.Kind == s.Kind && old.Title == s.Title && old.Content == s.Content {
+			s.UpdatedAt = old.UpdatedAt
+			s.UpdatedBy = old.UpdatedBy
+		}
+		result = append(result, s)
+	}
+
+	rb.Sections = result
+	rb.UpdatedAt = now
+	return nil
+}
+
+// RulebookRepository stores tournament rulebooks.
+type RulebookRepository interface {
+	// GetByTournament retrieves a tournament's rulebook. It returns
+	// ErrRulebookNotFound when none has been written yet.
+	GetByTournament(ctx context.Context, tournamentID uuid.UUID) (*Rulebook, error)
+
+	// Save creates or replaces a tournament's rulebook.
+	Save(ctx context.Context, rulebook *Rulebook) error
+}
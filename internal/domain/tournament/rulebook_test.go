@@ -0,0 +1,90 @@
+package tournament
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRulebook_SetSections(t *testing.T) {
+	t.Parallel()
+
+	organizer := uuid.New()
+	editor := uuid.New()
+	created := time.Date(2026, 5, 1, 12, 0, 0, 0, time.FixedZone("CEST", 7200))
+	edited := created.Add(24 * time.Hour)
+
+	rb := NewRulebook(uuid.New())
+	require.NoError(t, rb.SetSections([]*Section{
+		{Kind: SectionRules, Title: " Scoring ", Content: "1 point per kill"},
+		{Kind: SectionFAQ, Title: "Can I swap players?", Content: "No."},
+	}, organizer, created))
+	require.Len(t, rb.Sections, 2)
+	require.Equal(t, "Scoring", rb.Sections[0].Title)
+	require.Equal(t, time.UTC, rb.UpdatedAt.Location())
+	scoring, faq := rb.Sections[0], rb.Sections[1]
+	require.NotEqual(t, uuid.Nil, scoring.ID)
+
+	// Reorder, edit the FAQ and add a section.
+	require.NoError(t, rb.SetSections([]*Section{
+		{ID: faq.ID, Kind: SectionFAQ, Title: "Can I swap players?", Content: "Only before the tournament starts."},
+		{Kind: SectionRules, Title: "Disputes", Content: "Contact an admin."},
+		{ID: scoring.ID, Kind: SectionRules, Title: "Scoring", Content: "1 point per kill"},
+	}, editor, edited))
+	require.Len(t, rb.Sections, 3)
+	require.Equal(t, edited.UTC(), rb.UpdatedAt)
+
+	require.Equal(t, faq.ID, rb.Sections[0].ID)
+	require.Equal(t, 0, rb.Sections[0].Position)
+	require.Equal(t, edited.UTC(), rb.Sections[0].UpdatedAt)
+	require.Equal(t, editor, rb.Sections[0].UpdatedBy)
+
+	require.Equal(t, 1, rb.Sections[1].Position)
+	require.Equal(t, editor, rb.Sections[1].UpdatedBy)
+
+	require.Equal(t, scoring.ID, rb.Sections[2].ID)
+	require.Equal(t, 2, rb.Sections[2].Position)
+	require.Equal(t, created.UTC(), rb.Sections[2].UpdatedAt)
+	require.Equal(t, organizer, rb.Sections[2].UpdatedBy)
+}
+
+func TestRulebook_SetSections_Invalid(t *testing.T) {
+	t.Parallel()
+
+	dup := uuid.New()
+	tooMany := make([]*Section, MaxRulebookSections+1)
+	for i := range tooMany {
+		tooMany[i] = &Section{Kind: SectionRules, Title: "Rule"}
+	}
+
+	tests := []struct {
+		name     string
+		sections []*Section
+		wantErr  error
+	}{
+		{name: "empty", sections: []*Section{}},
+		{name: "unknown kind", sections: []*Section{{Kind: "lore", Title: "History"}}, wantErr: ErrInvalidSectionKind},
+		{name: "blank title", sections: []*Section{{Kind: SectionFAQ, Title: "  "}}, wantErr: ErrInvalidSection},
+		{name: "title too long", sections: []*Section{{Kind: SectionFAQ, Title: strings.Repeat("é", MaxSectionTitleLength+1)}}, wantErr: ErrInvalidSection},
+		{name: "content at limit", sections: []*Section{{Kind: SectionRules, Title: "Rule", Content: strings.Repeat("é", MaxSectionContentLength)}}},
+		{name: "content too long", sections: []*Section{{Kind: SectionRules, Title: "Rule", Content: strings.Repeat("a", MaxSectionContentLength+1)}}, wantErr: ErrInvalidSection},
+		{name: "duplicate", sections: []*Section{{ID: dup, Kind: SectionRules, Title: "A"}, {ID: dup, Kind: SectionRules, Title: "B"}}, wantErr: ErrDuplicateSection},
+		{name: "too many", sections: tooMany, wantErr: ErrTooManySections},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			rb := NewRulebook(uuid.New())
+			err := rb.SetSections(tc.sections, uuid.New(), time.Now())
+			require.ErrorIs(t, err, tc.wantErr)
+			if tc.wantErr != nil {
+				require.Empty(t, rb.Sections)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package tournament
+
+import (
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+var (
+	ErrSeedingNotFound      = errors.New("tournament has no imported seeding")
+	ErrSeedingLocked        = errors.New("seeding cannot change once the tournament has started")
+	ErrInvalidSeedingSource = errors.New("seeding source must be another tournament that has finished")
+	ErrSeedTeamNotFound     = errors.New("team is not part of the seeding")
+	ErrSourceTeamNotFound   = errors.New("team did not place in the seeding source")
+	ErrSourceTeamTaken      = errors.New("source team is already matched to another team")
+)
+
+// SeedMatch describes how a team was matched to a team of the seeding source.
+type SeedMatch string
+
+const (
+	SeedExact   SeedMatch = "exact"   // Same roster as the source team
+	SeedPartial SeedMatch = "partial" // Some players in common; waits for the organizer to confirm
+	SeedManual  SeedMatch = "manual"  // Set by the organizer
+	SeedNone    SeedMatch = "none"    // No player placed in the source
+)
+
+// SeedTeam is a team's roster as used for roster matching.
+type SeedTeam struct {
+	ID        uuid.UUID
+	Name      string
+	MemberIDs []uuid.UUID
+}
+
+// SourcePlacement is a team's final rank in the seeding source.
+type SourcePlacement struct {
+	TeamID    uuid.UUID   `bson:"team_id" json:"team_id"`
+	TeamName  string      `bson:"team_name" json:"team_name"`
+	Rank      int         `bson:"rank" json:"rank"`
+	MemberIDs []uuid.UUID `bson:"member_ids" json:"member_ids"`
+}
+
+// SeedEntry is a team's seed and the source team it was seeded from.
+type SeedEntry struct {
+	Seed         int        `bson:"seed" json:"seed"` // 1 is the top seed
+	TeamID       uuid.UUID  `bson:"team_id" json:"team_id"`
+	TeamName     string     `bson:"team_name" json:"team_name"`
+	SourceTeamID *uuid.UUID `bson:"source_team_id,omitempty" json:"source_team_id,omitempty"`
+	SourceRank   int        `bson:"source_rank,omitempty" json:"source_rank,omitempty"`
+	Overlap      float64    `bson:"overlap" json:"overlap"` // Share of players in common with the source team
+	Match        SeedMatch  `bson:"match" json:"match"`
+}
+
+// Seeding orders a tournament's teams by the final standings of an earlier
+// tournament. Teams are matched to the source teams whose rosters they share
+// the most players with; changed rosters are only proposals until the
+// organizer resolves them. Seeds follow the source ranks, and teams without a
+// source team come last in the order they were imported.
+type Seeding struct {
+	SourceTournamentID uuid.UUID         `bson:"source_tournament_id" json:"source_tournament_id"`
+	Source             []SourcePlacement `bson:"source" json:"source"`
+	Entries            []SeedEntry       `bson:"entries" json:"entries"` // In seed order
+	ImportedBy         uuid.UUID         `bson:"imported_by" json:"imported_by"`
+	ImportedAt         time.Time         `bson:"imported_at" json:"imported_at"`
+	UpdatedAt          time.Time         `bson:"updated_at" json:"updated_at"`
+}
+
+// NewSeeding matches teams to the source placements by roster overlap: the
+// pairs sharing the largest share of players are matched first, each source
+// team at most once, with ties going to the better source rank.
+func NewSeeding(sourceID uuid.UUID, source []SourcePlacement, teams []SeedTeam, importedBy uuid.UUID, now time.Time) *Seeding {
+	type candidate struct {
+		team, source int
+		overlap      float64
+	}
+	var candidates []candidate
+	for i, tm := range teams {
+		for j, src := range source {
+			if overlap := rosterOverlap(tm.MemberIDs, src.MemberIDs); overlap > 0 {
+				candidates = append(candidates, candidate{i, j, overlap})
+			}
+		}
+	}
+	sort.SliceStable(candidates, func(a, b int) bool {
+		if candidates[a].overlap != candidates[b].overlap {
+			return candidates[a].overlap > candidates[b].overlap
+		}
+		return source[candidates[a].source].Rank < source[candidates[b].source].Rank
+	})
+
+	entries := make([]SeedEntry, len(teams))
+	for i, tm := range teams {
+		entries[i] = SeedEntry{TeamID: tm.ID, TeamName: tm.Name, Match: SeedNone}
+	}
+	taken := make(map[int]bool, len(source))
+	for _, c := range candidates {
+		if taken[c.source] || entries[c.team].SourceTeamID != nil {
+			continue
+		}
+		taken[c.source] = true
+		entries[c.team].setSource(&source[c.source], c.overlap)
+		entries[c.team].Match = SeedPartial
+		if c.overlap == 1 {
+			entries[c.team].Match = SeedExact
+		}
+	}
+
+	s := &Seeding{
+		SourceTournamentID: sourceID,
+		Source:             source,
+		Entries:            entries,
+		ImportedBy:         importedBy,
+		ImportedAt:         now,
+		UpdatedAt:          now,
+	}
+	s.order()
+	return s
+}
+
+// Pending returns how many teams have a changed roster that the organizer
+// has not resolved yet.
+func (s *Seeding) Pending() int {
+	pending := 0
+	for _, e := range s.Entries {
+		if e.Match == SeedPartial {
+			pending++
+		}
+	}
+	return pending
+}
+
+// Resolve sets the source team of a team with the given roster; nil seeds it
+// without one. Confirming a proposal uses the proposed source team.
+func (s *Seeding) Resolve(teamID uuid.UUID, memberIDs []uuid.UUID, sourceTeamID *uuid.UUID, now time.Time) error {
+	entry := s.entry(teamID)
+	if entry == nil {
+		return ErrSeedTeamNotFound
+	}
+
+	if sourceTeamID == nil {
+		entry.SourceTeamID, entry.SourceRank, entry.Overlap = nil, 0, 0
+	} else {
+		src := s.placement(*sourceTeamID)
+		if src == nil {
+			return ErrSourceTeamNotFound
+		}
+		for _, e := range s.Entries {
+			if e.TeamID != teamID && e.SourceTeamID != nil && *e.SourceTeamID == src.TeamID {
+				return ErrSourceTeamTaken
+			}
+		}
+		entry.setSource(src, rosterOverlap(memberIDs, src.MemberIDs))
+	}
+	entry.Match = SeedManual
+	s.UpdatedAt = now
+	s.order()
+	return nil
+}
+
+// order sorts the entries by source rank, teams without one last, and
+// numbers the seeds.
+func (s *Seeding) order() {
+	sort.SliceStable(s.Entries, func(i, j int) bool {
+		a, b := s.Entries[i].SourceRank, s.Entries[j].SourceRank
+		if a == 0 || b == 0 {
+			return a != 0 && b == 0
+		}
+		return a < b
+	})
+	for i := range s.Entries {
+		s.Entries[i].Seed = i + 1
+	}
+}
+
+func (s *Seeding) entry(teamID uuid.UUID) *SeedEntry {
+	for i := range s.Entries {
+		if s.Entries[i].TeamID == teamID {
+			return &s.Entries[i]
+		}
+	}
+	return nil
+}
+
+func (s *Seeding) placement(teamID uuid.UUID) *SourcePlacement {
+	for i := range s.Source {
+		if s.Source[i].TeamID == teamID {
+			return &s.Source[i]
+		}
+	}
+	return nil
+}
+
+func (e *SeedEntry) setSource(src *SourcePlacement, overlap float64) {
+	id := src.TeamID
+	e.SourceTeamID = &id
+	e.SourceRank = src.Rank
+	e.Overlap = overlap
+}
+
+// CanReseed reports whether the tournament's seeding may still change.
+func (t *Tournament) CanReseed() bool {
+	return t.Status == StatusDraft || t.Status == StatusOpen
+}
+
+// rosterOverlap returns the players two rosters have in common as a share of
+// the larger roster.
+func rosterOverlap(a, b []uuid.UUID) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	in := make(map[uuid.UUID]bool, len(b))
+	for _, id := range b {
+		in[id] = true
+	}
+	shared := 0
+	for _, id := range a {
+		if in[id] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(max(len(a), len(b)))
+}
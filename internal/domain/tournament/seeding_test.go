@@ -0,0 +1,95 @@
+package tournament
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSeeding(t *testing.T) {
+	t.Parallel()
+
+	p := make([]uuid.UUID, 8)
+	for i := range p {
+		p[i] = uuid.New()
+	}
+	source := []SourcePlacement{
+		{TeamID: uuid.New(), TeamName: "Champions", Rank: 1, MemberIDs: []uuid.UUID{p[0], p[1]}},
+		{TeamID: uuid.New(), TeamName: "Runners-up", Rank: 2, MemberIDs: []uuid.UUID{p[2], p[3]}},
+		{TeamID: uuid.New(), TeamName: "Third", Rank: 3, MemberIDs: []uuid.UUID{p[4], p[5]}},
+	}
+	teams := []SeedTeam{
+		{ID: uuid.New(), Name: "Newcomers", MemberIDs: []uuid.UUID{p[6], p[7]}},
+		{ID: uuid.New(), Name: "Third Again", MemberIDs: []uuid.UUID{p[4], p[5]}},
+		{ID: uuid.New(), Name: "Half Champions", MemberIDs: []uuid.UUID{p[0], p[6]}},
+	}
+
+	s := NewSeeding(uuid.New(), source, teams, uuid.New(), time.Now())
+	require.Len(t, s.Entries, 3)
+
+	require.Equal(t, teams[2].ID, s.Entries[0].TeamID)
+	require.Equal(t, 1, s.Entries[0].Seed)
+	require.Equal(t, SeedPartial, s.Entries[0].Match)
+	require.Equal(t, source[0].TeamID, *s.Entries[0].SourceTeamID)
+	require.InDelta(t, 0.5, s.Entries[0].Overlap, 1e-9)
+
+	require.Equal(t, teams[1].ID, s.Entries[1].TeamID)
+	require.Equal(t, SeedExact, s.Entries[1].Match)
+	require.Equal(t, 3, s.Entries[1].SourceRank)
+
+	require.Equal(t, teams[0].ID, s.Entries[2].TeamID)
+	require.Equal(t, SeedNone, s.Entries[2].Match)
+	require.Nil(t, s.Entries[2].SourceTeamID)
+	require.Equal(t, 3, s.Entries[2].Seed)
+
+	require.Equal(t, 1, s.Pending())
+}
+
+func TestNewSeeding_SourceTeamMatchedOnce(t *testing.T) {
+	t.Parallel()
+
+	a, b, c := uuid.New(), uuid.New(), uuid.New()
+	source := []SourcePlacement{{TeamID: uuid.New(), Rank: 1, MemberIDs: []uuid.UUID{a, b}}}
+	teams := []SeedTeam{
+		{ID: uuid.New(), MemberIDs: []uuid.UUID{a, c}},
+		{ID: uuid.New(), MemberIDs: []uuid.UUID{a, b}},
+	}
+
+	s := NewSeeding(uuid.New(), source, teams, uuid.New(), time.Now())
+	require.Equal(t, teams[1].ID, s.Entries[0].TeamID, "the closest roster wins the source team")
+	require.Equal(t, SeedExact, s.Entries[0].Match)
+	require.Equal(t, SeedNone, s.Entries[1].Match)
+}
+
+func TestSeeding_Resolve(t *testing.T) {
+	t.Parallel()
+
+	a, b, c, d := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	source := []SourcePlacement{
+		{TeamID: uuid.New(), Rank: 1, MemberIDs: []uuid.UUID{a, b}},
+		{TeamID: uuid.New(), Rank: 2, MemberIDs: []uuid.UUID{c, d}},
+	}
+	changed := SeedTeam{ID: uuid.New(), MemberIDs: []uuid.UUID{a, uuid.New()}}
+	same := SeedTeam{ID: uuid.New(), MemberIDs: []uuid.UUID{c, d}}
+	s := NewSeeding(uuid.New(), source, []SeedTeam{same, changed}, uuid.New(), time.Now())
+	require.Equal(t, changed.ID, s.Entries[0].TeamID)
+
+	// Organizer decides the changed roster is a new team.
+	require.NoError(t, s.Resolve(changed.ID, changed.MemberIDs, nil, time.Now()))
+	require.Equal(t, same.ID, s.Entries[0].TeamID)
+	require.Equal(t, changed.ID, s.Entries[1].TeamID)
+	require.Equal(t, SeedManual, s.Entries[1].Match)
+	require.Zero(t, s.Entries[1].SourceRank)
+	require.Zero(t, s.Pending())
+
+	require.ErrorIs(t, s.Resolve(changed.ID, changed.MemberIDs, &source[1].TeamID, time.Now()), ErrSourceTeamTaken)
+	unknown := uuid.New()
+	require.ErrorIs(t, s.Resolve(changed.ID, changed.MemberIDs, &unknown, time.Now()), ErrSourceTeamNotFound)
+	require.ErrorIs(t, s.Resolve(unknown, nil, nil, time.Now()), ErrSeedTeamNotFound)
+
+	require.NoError(t, s.Resolve(changed.ID, changed.MemberIDs, &source[0].TeamID, time.Now()))
+	require.Equal(t, changed.ID, s.Entries[0].TeamID)
+	require.InDelta(t, 0.5, s.Entries[0].Overlap, 1e-9)
+}
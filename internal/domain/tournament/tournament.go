@@ -3,8 +3,10 @@ package tournament
 
 import (
 "errors"
+"fmt"
 "time"
 
+"github.com/alejaam/tourney-rank/internal/domain/player"
 "github.com/google/uuid"
 )
 
@@ -16,6 +18,12 @@ ErrInvalidStatus = errors.New("invalid tournament status")
 ErrInvalidDates = errors.New("start date must be before end date")
 ErrTournamentNotActive = errors.New("tournament is not active")
 ErrRegistrationClosed = errors.New("tournament registration is closed")
+ErrTournamentFull = errors.New("tournament is full")
+ErrRegistrationQueued = errors.New("tournament registration goes through the registration queue")
+ErrInvalidTierRange = errors.New("invalid tier eligibility range")
+ErrPlayerNotEligible = errors.New("player tier is not eligible for this tournament")
+ErrInvalidScoreCap = errors.New("invalid team score cap")
+ErrTeamScoreCapExceeded = errors.New("team ranking score exceeds tournament cap")
 )
 
 type Status string
@@ -50,17 +58,14 @@ TeamSizeTrios TeamSize = 3
 TeamSizeQuads TeamSize = 4
 )
 
+// ValidTeamSizes returns the team sizes that have a name. Any size up to
+// MaxTeamSize is valid.
 func ValidTeamSizes() []TeamSize {
 	return []TeamSize{TeamSizeSolo, TeamSizeDuos, TeamSizeTrios, TeamSizeQuads}
 }
 
 func (ts TeamSize) IsValid() bool {
-	for _, valid := range ValidTeamSizes() {
-		if ts == valid {
-			return true
-		}
-	}
-	return false
+	return ts >= TeamSizeSolo && ts <= MaxTeamSize
 }
 
 func (ts TeamSize) String() string {
@@ -74,10 +79,21 @@ func (ts TeamSize) String() string {
 	case TeamSizeQuads:
 		return "quads"
 	default:
+		if ts.IsValid() {
+			return fmt.Sprintf("%d-player", ts)
+		}
 		return "unknown"
 	}
 }
 
+// ScoreCapMode defines how member ranking scores are combined for a team score cap.
+type ScoreCapMode string
+
+const (
+	ScoreCapModeSum     ScoreCapMode = "sum"
+	ScoreCapModeAverage ScoreCapMode = "average"
+)
+
 type Rules struct {
 	MaxTeams int `bson:"max_teams" json:"max_teams"`
 	MinMatches int `bson:"min_matches" json:"min_matches"`
@@ -85,6 +101,94 @@ type Rules struct {
 	RequireVerification bool `bson:"require_verification" json:"require_verification"`
 	AllowLateRegistration bool `bson:"allow_late_registration" json:"allow_late_registration"`
 	RegistrationDeadline *time.Time `bson:"registration_deadline,omitempty" json:"registration_deadline,omitempty"`
+	// RegistrationQueue makes teams register through the first-come, first-served
+	// registration queue instead of directly, for events expected to fill at opening.
+	RegistrationQueue bool `bson:"registration_queue,omitempty" json:"registration_queue,omitempty"`
+	// MinTier and MaxTier bound the tiers allowed to register (optional).
+	MinTier player.Tier `bson:"min_tier,omitempty" json:"min_tier,omitempty"`
+	MaxTier player.Tier `bson:"max_tier,omitempty" json:"max_tier,omitempty"`
+	// TierExemptPlayerIDs lists players the organizer allows regardless of tier.
+	TierExemptPlayerIDs []uuid.UUID `bson:"tier_exempt_player_ids,omitempty" json:"tier_exempt_player_ids,omitempty"`
+	// TeamScoreCap limits the combined ranking score of a roster (0 disables the cap).
+	TeamScoreCap float64 `bson:"team_score_cap,omitempty" json:"team_score_cap,omitempty"`
+	TeamScoreCapMode ScoreCapMode `bson:"team_score_cap_mode,omitempty" json:"team_score_cap_mode,omitempty"`
+	// MaxConcurrentTournaments limits how many overlapping open or active
+	// tournaments, this one included, a player may compete in (0 disables the rule).
+	MaxConcurrentTournaments int `bson:"max_concurrent_tournaments,omitempty" json:"max_concurrent_tournaments,omitempty"`
+	// MinConductScore is the conduct score players need to register (0 disables the rule).
+	MinConductScore float64 `bson:"min_conduct_score,omitempty" json:"min_conduct_score,omitempty"`
+	// InviteOnly lets only players holding an offered invitation register a
+	// team; MaxTeams is then the number of invitation slots.
+	InviteOnly bool `bson:"invite_only,omitempty" json:"invite_only,omitempty"`
+	// Cleanup flags, and optionally disbands, empty and inactive teams (optional).
+	Cleanup *CleanupPolicy `bson:"cleanup,omitempty" json:"cleanup,omitempty"`
+}
+
+// Validate checks that the rules are internally consistent.
+func (r Rules) Validate() error {
+	if err := r.ValidateTierRange(); err != nil {
+		return err
+	}
+	if r.TeamScoreCap < 0 {
+		return ErrInvalidScoreCap
+	}
+	if r.MaxConcurrentTournaments < 0 {
+		return ErrInvalidConcurrentLimit
+	}
+	if err := player.ValidateMinConductScore(r.MinConductScore); err != nil {
+		return err
+	}
+	if r.Cleanup != nil {
+		if err := r.Cleanup.Validate(); err != nil {
+			return err
+		}
+	}
+	switch r.TeamScoreCapMode {
+	case "", ScoreCapModeSum, ScoreCapModeAverage:
+	default:
+		return ErrInvalidScoreCap
+	}
+	return nil
+}
+
+// ValidateTierRange checks that configured tier bounds are known tiers and ordered.
+func (r Rules) ValidateTierRange() error {
+	if r.MinTier != "" && r.MinTier.Level() < 0 {
+		return ErrInvalidTierRange
+	}
+	if r.MaxTier != "" && r.MaxTier.Level() < 0 {
+		return ErrInvalidTierRange
+	}
+	if r.MinTier != "" && r.MaxTier != "" && r.MinTier.Level() > r.MaxTier.Level() {
+		return ErrInvalidTierRange
+	}
+	return nil
+}
+
+// HasTeamScoreCap reports whether the rules limit combined roster ranking scores.
+func (r Rules) HasTeamScoreCap() bool {
+	return r.TeamScoreCap > 0
+}
+
+// ExceedsTeamScoreCap reports whether the given member ranking scores break the cap.
+// Scores are summed unless the cap mode is average.
+func (r Rules) ExceedsTeamScoreCap(scores []float64) bool {
+	if !r.HasTeamScoreCap() || len(scores) == 0 {
+		return false
+	}
+	var total float64
+	for _, score := range scores {
+		total += score
+	}
+	if r.TeamScoreCapMode == ScoreCapModeAverage {
+		total /= float64(len(scores))
+	}
+	return total > r.TeamScoreCap
+}
+
+// HasTierRestriction reports whether the rules restrict registration by tier.
+func (r Rules) HasTierRestriction() bool {
+	return r.MinTier != "" || r.MaxTier != ""
 }
 
 type Tournament struct {
@@ -92,14 +196,19 @@ type Tournament struct {
 	GameID uuid.UUID `bson:"game_id" json:"game_id"`
 	Name string `bson:"name" json:"name"`
 	Description string `bson:"description,omitempty" json:"description,omitempty"`
-	TeamSize TeamSize `bson:"team_size" json:"team_size"`
+	TeamSize TeamSize `bson:"team_size" json:"team_size"` // Lineup size: players fielded in each match
+	RosterSize int `bson:"roster_size,omitempty" json:"roster_size,omitempty"` // Players a team may register, including substitutes; 0 means TeamSize
 	Status Status `bson:"status" json:"status"`
 	Rules Rules `bson:"rules" json:"rules"`
 	StartDate time.Time `bson:"start_date" json:"start_date"`
 	EndDate time.Time `bson:"end_date" json:"end_date"`
 	PrizePool string `bson:"prize_pool,omitempty" json:"prize_pool,omitempty"`
 	BannerURL string `bson:"banner_url,omitempty" json:"banner_url,omitempty"`
+	Languages []string `bson:"languages,omitempty" json:"languages,omitempty"` // Language codes, see SupportedLanguages
 	CreatedBy uuid.UUID `bson:"created_by" json:"created_by"`
+	SeriesID *uuid.UUID `bson:"series_id,omitempty" json:"series_id,omitempty"` // Organization series it belongs to; the series' staff organize it too
+	Invites []Invite `bson:"invites,omitempty" json:"invites,omitempty"` // Invitations to register, in the order they were sent; see Rules.InviteOnly
+	Seeding *Seeding `bson:"seeding,omitempty" json:"seeding,omitempty"` // Seeding imported from an earlier tournament's standings
 	CreatedAt time.Time `bson:"created_at" json:"created_at"`
 	UpdatedAt time.Time `bson:"updated_at" json:"updated_at"`
 }
@@ -198,3 +307,23 @@ func (t *Tournament) SetBannerURL(bannerURL string) {
 	t.BannerURL = bannerURL
 	t.UpdatedAt = time.Now().UTC()
 }
+
+// IsTierEligible reports whether a player with the given tier may register.
+// Players on the organizer's exception list are always eligible.
+func (t *Tournament) IsTierEligible(playerID uuid.UUID, tier player.Tier) bool {
+	if !t.Rules.HasTierRestriction() {
+		return true
+	}
+	for _, id := range t.Rules.TierExemptPlayerIDs {
+		if id == playerID {
+			return true
+		}
+	}
+	if t.Rules.MinTier != "" && tier.Level() < t.Rules.MinTier.Level() {
+		return false
+	}
+	if t.Rules.MaxTier != "" && tier.Level() > t.Rules.MaxTier.Level() {
+		return false
+	}
+	return true
+}
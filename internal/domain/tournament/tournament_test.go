@@ -0,0 +1,112 @@
+package tournament
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTournament(t *testing.T) *Tournament {
+	t.Helper()
+
+	start := time.Now().UTC()
+	tr, err := NewTournament(uuid.New(), uuid.New(), "Test Cup", TeamSizeTrios, start, start.Add(24*time.Hour))
+	require.NoError(t, err)
+	return tr
+}
+
+func TestRules_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		rules         Rules
+		expectedError bool
+	}{
+		{
+			name:          "no restriction",
+			rules:         Rules{},
+			expectedError: false,
+		},
+		{
+			name:          "max tier only",
+			rules:         Rules{MaxTier: player.TierIntermediate},
+			expectedError: false,
+		},
+		{
+			name:          "ordered range",
+			rules:         Rules{MinTier: player.TierIntermediate, MaxTier: player.TierAdvanced},
+			expectedError: false,
+		},
+		{
+			name:          "inverted range",
+			rules:         Rules{MinTier: player.TierElite, MaxTier: player.TierBeginner},
+			expectedError: true,
+		},
+		{
+			name:          "unknown tier",
+			rules:         Rules{MaxTier: player.Tier("legendary")},
+			expectedError: true,
+		},
+		{
+			name:          "negative score cap",
+			rules:         Rules{TeamScoreCap: -1},
+			expectedError: true,
+		},
+		{
+			name:          "unknown score cap mode",
+			rules:         Rules{TeamScoreCap: 500, TeamScoreCapMode: "median"},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.rules.Validate()
+
+			if tc.expectedError {
+				require.Error(t, err)
+				require.True(t, errors.Is(err, ErrInvalidTierRange) || errors.Is(err, ErrInvalidScoreCap))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTournament_IsTierEligible(t *testing.T) {
+	t.Parallel()
+
+	tr := newTestTournament(t)
+	playerID := uuid.New()
+
+	require.True(t, tr.IsTierEligible(playerID, player.TierElite))
+
+	tr.Rules.MaxTier = player.TierIntermediate
+	require.True(t, tr.IsTierEligible(playerID, player.TierBeginner))
+	require.True(t, tr.IsTierEligible(playerID, player.TierIntermediate))
+	require.False(t, tr.IsTierEligible(playerID, player.TierAdvanced))
+
+	tr.Rules.MinTier = player.TierIntermediate
+	require.False(t, tr.IsTierEligible(playerID, player.TierBeginner))
+
+	tr.Rules.TierExemptPlayerIDs = []uuid.UUID{playerID}
+	require.True(t, tr.IsTierEligible(playerID, player.TierElite))
+	require.False(t, tr.IsTierEligible(uuid.New(), player.TierElite))
+}
+
+func TestRules_ExceedsTeamScoreCap(t *testing.T) {
+	t.Parallel()
+
+	scores := []float64{300, 500, 700}
+
+	require.False(t, Rules{}.ExceedsTeamScoreCap(scores))
+	require.True(t, Rules{TeamScoreCap: 1000}.ExceedsTeamScoreCap(scores))
+	require.False(t, Rules{TeamScoreCap: 1500, TeamScoreCapMode: ScoreCapModeSum}.ExceedsTeamScoreCap(scores))
+	require.False(t, Rules{TeamScoreCap: 500, TeamScoreCapMode: ScoreCapModeAverage}.ExceedsTeamScoreCap(scores))
+	require.True(t, Rules{TeamScoreCap: 499, TeamScoreCapMode: ScoreCapModeAverage}.ExceedsTeamScoreCap(scores))
+}
@@ -0,0 +1,146 @@
+package user
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Policy is a legal document users must consent to.
+type Policy string
+
+const (
+	PolicyTerms   Policy = "terms"
+	PolicyPrivacy Policy = "privacy"
+)
+
+var (
+	// ErrConsentRequired is returned when the current policy versions have not been accepted.
+	ErrConsentRequired = errors.New("current terms of service and privacy policy must be accepted")
+
+	// ErrStaleConsentVersion is returned when accepting a policy version that is no longer current.
+	ErrStaleConsentVersion = errors.New("accepted policy version is not current")
+)
+
+// PolicyVersions holds the current version of each policy. A policy with an
+// empty version is not in force and needs no consent.
+type PolicyVersions struct {
+	Terms   string `json:"terms,omitempty"`
+	Privacy string `json:"privacy,omitempty"`
+}
+
+// Of returns the current version of a policy.
+func (v PolicyVersions) Of(p Policy) string {
+	switch p {
+	case PolicyTerms:
+		return v.Terms
+	case PolicyPrivacy:
+		return v.Privacy
+	default:
+		return ""
+	}
+}
+
+// Required returns the policies in force.
+func (v PolicyVersions) Required() []Policy {
+	var policies []Policy
+	for _, p := range []Policy{PolicyTerms, PolicyPrivacy} {
+		if v.Of(p) != "" {
+			policies = append(policies, p)
+		}
+	}
+	return policies
+}
+
+// Check reports whether accepted matches the current version of every policy in force.
+func (v PolicyVersions) Check(accepted PolicyVersions) error {
+	for _, p := range v.Required() {
+		switch accepted.Of(p) {
+		case v.Of(p):
+		case "":
+			return ErrConsentRequired
+		default:
+			return ErrStaleConsentVersion
+		}
+	}
+	return nil
+}
+
+// Consent records a user accepting a version of a policy. Records are never
+// updated; a new version adds a new record.
+type Consent struct {
+	ID         uuid.UUID `bson:"_id" json:"id"`
+	UserID     uuid.UUID `bson:"user_id" json:"user_id"`
+	Policy     Policy    `bson:"policy" json:"policy"`
+	Version    string    `bson:"version" json:"version"`
+	IP         string    `bson:"ip,omitempty" json:"-"`
+	AcceptedAt time.Time `bson:"accepted_at" json:"accepted_at"`
+}
+
+// NewConsents records a user accepting the current version of every policy in force.
+func NewConsents(userID uuid.UUID, versions PolicyVersions, ip string) []*Consent {
+	now := time.Now().UTC()
+	var consents []*Consent
+	for _, p := range versions.Required() {
+		consents = append(consents, &Consent{
+			ID:         uuid.New(),
+			UserID:     userID,
+			Policy:     p,
+			Version:    versions.Of(p),
+			IP:         ip,
+			AcceptedAt: now,
+		})
+	}
+	return consents
+}
+
+// ConsentStatus is where a user stands on one policy.
+type ConsentStatus struct {
+	Policy          Policy     `json:"policy"`
+	CurrentVersion  string     `json:"current_version"`
+	AcceptedVersion string     `json:"accepted_version,omitempty"`
+	AcceptedAt      *time.Time `json:"accepted_at,omitempty"`
+	UpToDate        bool       `json:"up_to_date"`
+}
+
+// ConsentStatuses compares a user's latest consent to each policy in force.
+func ConsentStatuses(latest map[Policy]*Consent, versions PolicyVersions) []ConsentStatus {
+	statuses := make([]ConsentStatus, 0, 2)
+	for _, p := range versions.Required() {
+		status := ConsentStatus{Policy: p, CurrentVersion: versions.Of(p)}
+		if c, ok := latest[p]; ok {
+			acceptedAt := c.AcceptedAt
+			status.AcceptedVersion = c.Version
+			status.AcceptedAt = &acceptedAt
+			status.UpToDate = c.Version == status.CurrentVersion
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// HasCurrentConsent reports whether a user's latest consent covers every policy in force.
+func HasCurrentConsent(latest map[Policy]*Consent, versions PolicyVersions) bool {
+	for _, s := range ConsentStatuses(latest, versions) {
+		if !s.UpToDate {
+			return false
+		}
+	}
+	return true
+}
+
+// ConsentVersionCount is the number of users whose latest consent to a policy is a version.
+type ConsentVersionCount struct {
+	Policy  Policy `bson:"policy" json:"policy"`
+	Version string `bson:"version" json:"version"`
+	Users   int64  `bson:"users" json:"users"`
+}
+
+// ConsentRepository persists consent records.
+type ConsentRepository interface {
+	Create(ctx context.Context, consents []*Consent) error
+	Latest(ctx context.Context, userID uuid.UUID) (map[Policy]*Consent, error)
+	CountLatestVersions(ctx context.Context) ([]ConsentVersionCount, error)
+}
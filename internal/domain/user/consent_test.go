@@ -0,0 +1,49 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPolicyVersionsCheck(t *testing.T) {
+	t.Parallel()
+
+	versions := PolicyVersions{Terms: "2024-06", Privacy: "2024-01"}
+
+	require.NoError(t, versions.Check(PolicyVersions{Terms: "2024-06", Privacy: "2024-01"}))
+	require.ErrorIs(t, versions.Check(PolicyVersions{Terms: "2024-06"}), ErrConsentRequired)
+	require.ErrorIs(t, versions.Check(PolicyVersions{Terms: "2023-01", Privacy: "2024-01"}), ErrStaleConsentVersion)
+
+	termsOnly := PolicyVersions{Terms: "v2"}
+	require.Equal(t, []Policy{PolicyTerms}, termsOnly.Required())
+	require.NoError(t, termsOnly.Check(PolicyVersions{Terms: "v2"}))
+	require.NoError(t, PolicyVersions{}.Check(PolicyVersions{}))
+}
+
+func TestConsentStatuses(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	old := PolicyVersions{Terms: "v1", Privacy: "v1"}
+	current := PolicyVersions{Terms: "v2", Privacy: "v1"}
+
+	latest := map[Policy]*Consent{}
+	for _, c := range NewConsents(userID, old, "127.0.0.1") {
+		require.Equal(t, userID, c.UserID)
+		latest[c.Policy] = c
+	}
+	require.True(t, HasCurrentConsent(latest, old))
+	require.False(t, HasCurrentConsent(latest, current))
+
+	statuses := ConsentStatuses(latest, current)
+	require.Len(t, statuses, 2)
+	require.Equal(t, PolicyTerms, statuses[0].Policy)
+	require.Equal(t, "v1", statuses[0].AcceptedVersion)
+	require.False(t, statuses[0].UpToDate)
+	require.True(t, statuses[1].UpToDate)
+
+	require.False(t, HasCurrentConsent(nil, current))
+	require.True(t, HasCurrentConsent(nil, PolicyVersions{}))
+}
@@ -0,0 +1,54 @@
+package user
+
+import (
+	"errors"
+	"time"
+)
+
+// DeactivationGracePeriod is how long a deactivated account keeps its data
+// and can be reactivated by its owner.
+const DeactivationGracePeriod = 30 * 24 * time.Hour
+
+var (
+	// ErrAccountDeactivated is returned when logging in to a deactivated account.
+	ErrAccountDeactivated = errors.New("account is deactivated")
+
+	// ErrAlreadyDeactivated is returned when deactivating an account twice.
+	ErrAlreadyDeactivated = errors.New("account is already deactivated")
+
+	// ErrReactivationExpired is returned when the grace period for reactivation has passed.
+	ErrReactivationExpired = errors.New("account can no longer be reactivated")
+)
+
+// IsDeactivated reports whether the user deactivated their account.
+func (u *User) IsDeactivated() bool {
+	return u.DeactivatedAt != nil
+}
+
+// Deactivate hides the account until its owner reactivates it.
+func (u *User) Deactivate() error {
+	if u.IsDeactivated() {
+		return ErrAlreadyDeactivated
+	}
+
+	now := time.Now().UTC()
+	u.DeactivatedAt = &now
+	u.UpdatedAt = now
+	return nil
+}
+
+// Reactivate restores a deactivated account within the grace period.
+func (u *User) Reactivate() error {
+	if !u.IsDeactivated() {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	if now.Sub(*u.DeactivatedAt) > DeactivationGracePeriod {
+		return ErrReactivationExpired
+	}
+
+	u.DeactivatedAt = nil
+	u.UpdatedAt = now
+	return nil
+}
@@ -0,0 +1,29 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeactivation(t *testing.T) {
+	t.Parallel()
+
+	u, err := NewUser("player", "player@example.com", "password123")
+	require.NoError(t, err)
+	require.False(t, u.IsDeactivated())
+	require.NoError(t, u.Reactivate())
+
+	require.NoError(t, u.Deactivate())
+	require.True(t, u.IsDeactivated())
+	require.ErrorIs(t, u.Deactivate(), ErrAlreadyDeactivated)
+
+	require.NoError(t, u.Reactivate())
+	require.False(t, u.IsDeactivated())
+
+	lapsed := time.Now().UTC().Add(-DeactivationGracePeriod - time.Hour)
+	u.DeactivatedAt = &lapsed
+	require.ErrorIs(t, u.Reactivate(), ErrReactivationExpired)
+	require.True(t, u.IsDeactivated())
+}
@@ -0,0 +1,101 @@
+package user
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EmailChangeTTL is how long a new address has to be verified before the request lapses.
+const EmailChangeTTL = 24 * time.Hour
+
+var (
+	// ErrInvalidEmailAddress is returned when an email address cannot be parsed.
+	ErrInvalidEmailAddress = errors.New("invalid email address")
+
+	// ErrEmailUnchanged is returned when the new email matches the current one.
+	ErrEmailUnchanged = errors.New("new email matches the current email")
+
+	// ErrEmailTaken is returned when another user already has the email.
+	ErrEmailTaken = errors.New("email already registered")
+
+	// ErrEmailChangeNotFound is returned when a verification token matches no pending change.
+	ErrEmailChangeNotFound = errors.New("email change not found")
+
+	// ErrEmailChangeExpired is returned when a verification token has lapsed.
+	ErrEmailChangeExpired = errors.New("email change has expired")
+)
+
+// EmailChange is a pending switch to a new email address. The address only
+// replaces the user's email once the token sent to it is presented. Only a
+// hash of the token is stored.
+type EmailChange struct {
+	ID        uuid.UUID `bson:"_id" json:"id"`
+	UserID    uuid.UUID `bson:"user_id" json:"user_id"`
+	NewEmail  string    `bson:"new_email" json:"new_email"`
+	TokenHash string    `bson:"token_hash" json:"-"`
+	ExpiresAt time.Time `bson:"expires_at" json:"expires_at"`
+	CreatedAt time.Time `bson:"created_at" json:"created_at"`
+}
+
+// NewEmailChange starts a change to newEmail and returns it with the
+// verification token to send to that address.
+func NewEmailChange(userID uuid.UUID, newEmail string) (*EmailChange, string, error) {
+	email, err := NormalizeEmail(newEmail)
+	if err != nil {
+		return nil, "", err
+	}
+
+	raw := make([]byte, 32)
+	if _, err := rand.Read(raw); err != nil {
+		return nil, "", fmt.Errorf("generating email change token: %w", err)
+	}
+	token := base64.RawURLEncoding.EncodeToString(raw)
+
+	now := time.Now().UTC()
+	return &EmailChange{
+		ID:        uuid.New(),
+		UserID:    userID,
+		NewEmail:  email,
+		TokenHash: HashEmailChangeToken(token),
+		ExpiresAt: now.Add(EmailChangeTTL),
+		CreatedAt: now,
+	}, token, nil
+}
+
+// Expired reports whether the change can no longer be verified at now.
+func (c *EmailChange) Expired(now time.Time) bool {
+	return !now.Before(c.ExpiresAt)
+}
+
+// HashEmailChangeToken returns the stored form of a verification token.
+func HashEmailChangeToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// NormalizeEmail validates a bare email address and lower-cases it.
+func NormalizeEmail(email string) (string, error) {
+	email = strings.TrimSpace(email)
+	addr, err := mail.ParseAddress(email)
+	if err != nil || addr.Address != email {
+		return "", ErrInvalidEmailAddress
+	}
+	return strings.ToLower(email), nil
+}
+
+// EmailChangeRepository persists pending email changes.
+type EmailChangeRepository interface {
+	Create(ctx context.Context, change *EmailChange) error
+	GetByTokenHash(ctx context.Context, tokenHash string) (*EmailChange, error)
+	DeleteByUser(ctx context.Context, userID uuid.UUID) error
+}
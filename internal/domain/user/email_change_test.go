@@ -0,0 +1,44 @@
+package user
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeEmail(t *testing.T) {
+	t.Parallel()
+
+	email, err := NormalizeEmail("  Player@Example.com ")
+	require.NoError(t, err)
+	require.Equal(t, "player@example.com", email)
+
+	for _, invalid := range []string{"", "player", "Player <player@example.com>", "a@b@c"} {
+		_, err := NormalizeEmail(invalid)
+		require.ErrorIs(t, err, ErrInvalidEmailAddress, invalid)
+	}
+}
+
+func TestNewEmailChange(t *testing.T) {
+	t.Parallel()
+
+	userID := uuid.New()
+	change, token, err := NewEmailChange(userID, "New@Example.com")
+	require.NoError(t, err)
+	require.Equal(t, userID, change.UserID)
+	require.Equal(t, "new@example.com", change.NewEmail)
+	require.NotEmpty(t, token)
+	require.NotEqual(t, token, change.TokenHash)
+	require.Equal(t, HashEmailChangeToken(token), change.TokenHash)
+
+	require.False(t, change.Expired(change.CreatedAt))
+	require.True(t, change.Expired(change.CreatedAt.Add(EmailChangeTTL)))
+
+	_, other, err := NewEmailChange(userID, "new@example.com")
+	require.NoError(t, err)
+	require.NotEqual(t, token, other)
+
+	_, _, err = NewEmailChange(userID, "not-an-email")
+	require.ErrorIs(t, err, ErrInvalidEmailAddress)
+}
@@ -0,0 +1,69 @@
+package user
+
+import (
+	"errors"
+	"time"
+)
+
+var (
+	// ErrInvalidListSort is returned when a user list sort key is not recognized.
+	ErrInvalidListSort = errors.New("invalid user list sort")
+
+	// ErrInvalidRole is returned when a role is not recognized.
+	ErrInvalidRole = errors.New("invalid role")
+
+	// ErrInvalidDateRange is returned when a created-at range ends before it starts.
+	ErrInvalidDateRange = errors.New("created_to must not be before created_from")
+)
+
+// ListSort selects how a user listing is ordered.
+type ListSort string
+
+const (
+	ListSortCreatedAt ListSort = "created_at"
+	ListSortUsername  ListSort = "username"
+	ListSortEmail     ListSort = "email"
+)
+
+// ParseListSort parses a sort query value. An empty value sorts by creation time.
+func ParseListSort(value string) (ListSort, error) {
+	switch s := ListSort(value); s {
+	case "":
+		return ListSortCreatedAt, nil
+	case ListSortCreatedAt, ListSortUsername, ListSortEmail:
+		return s, nil
+	default:
+		return "", ErrInvalidListSort
+	}
+}
+
+// ParseRole parses a role filter. An empty value matches every role.
+func ParseRole(value string) (Role, error) {
+	switch r := Role(value); r {
+	case "", RoleAdmin, RoleUser:
+		return r, nil
+	default:
+		return "", ErrInvalidRole
+	}
+}
+
+// ListFilter narrows, orders and pages a user listing. Zero values match everything.
+type ListFilter struct {
+	Role        Role
+	Search      string     // Case-insensitive substring of the username or email
+	CreatedFrom *time.Time // Inclusive
+	CreatedTo   *time.Time // Exclusive
+	Banned      *bool      // Whether the user's player profile is banned; users without a profile are not banned
+	Sort        ListSort
+	Descending  bool
+	Limit       int64
+	Offset      int64
+}
+
+// Validate checks the filter's date range.
+func (f ListFilter) Validate() error {
+	if f.CreatedFrom != nil && f.CreatedTo != nil && f.CreatedTo.Before(*f.CreatedFrom) {
+		return ErrInvalidDateRange
+	}
+	return nil
+}
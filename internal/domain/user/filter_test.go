@@ -0,0 +1,33 @@
+package user
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseListSort(t *testing.T) {
+	t.Parallel()
+
+	sort, err := ParseListSort("")
+	require.NoError(t, err)
+	require.Equal(t, ListSortCreatedAt, sort)
+
+	sort, err = ParseListSort("email")
+	require.NoError(t, err)
+	require.Equal(t, ListSortEmail, sort)
+
+	_, err = ParseListSort("password_hash")
+	require.ErrorIs(t, err, ErrInvalidListSort)
+}
+
+func TestListFilterValidate(t *testing.T) {
+	t.Parallel()
+
+	from := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, -1)
+
+	require.NoError(t, ListFilter{CreatedFrom: &from}.Validate())
+	require.ErrorIs(t, ListFilter{CreatedFrom: &from, CreatedTo: &to}.Validate(), ErrInvalidDateRange)
+}
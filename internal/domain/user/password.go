@@ -0,0 +1,105 @@
+package user
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// MinPasswordLength is the shortest password any policy accepts.
+const MinPasswordLength = 8
+
+var (
+	// ErrWeakPassword is returned when a password does not meet the password policy.
+	ErrWeakPassword = errors.New("password does not meet requirements")
+
+	// ErrBreachedPassword is returned when a password appears in a known data breach.
+	ErrBreachedPassword = errors.New("password has appeared in a data breach, choose a different one")
+)
+
+// PasswordPolicy is the set of complexity rules new passwords must satisfy.
+type PasswordPolicy struct {
+	MinLength     int
+	RequireUpper  bool
+	RequireLower  bool
+	RequireDigit  bool
+	RequireSymbol bool
+}
+
+// DefaultPasswordPolicy only enforces the minimum length.
+var DefaultPasswordPolicy = PasswordPolicy{MinLength: MinPasswordLength}
+
+// PasswordPolicyError lists every rule a password broke. It matches ErrWeakPassword.
+type PasswordPolicyError struct {
+	Violations []string
+}
+
+func (e *PasswordPolicyError) Error() string {
+	return "password must contain " + strings.Join(e.Violations, ", ")
+}
+
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrWeakPassword
+}
+
+// Validate checks password against the policy, reporting all broken rules at once.
+func (p PasswordPolicy) Validate(password string) error {
+	minLength := max(p.MinLength, MinPasswordLength)
+
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r):
+			symbol = true
+		}
+	}
+
+	var violations []string
+	if utf8.RuneCountInString(password) < minLength {
+		violations = append(violations, fmt.Sprintf("at least %d characters", minLength))
+	}
+	if p.RequireUpper && !upper {
+		violations = append(violations, "an uppercase letter")
+	}
+	if p.RequireLower && !lower {
+		violations = append(violations, "a lowercase letter")
+	}
+	if p.RequireDigit && !digit {
+		violations = append(violations, "a digit")
+	}
+	if p.RequireSymbol && !symbol {
+		violations = append(violations, "a symbol")
+	}
+
+	if len(violations) > 0 {
+		return &PasswordPolicyError{Violations: violations}
+	}
+	return nil
+}
+
+// SetPassword replaces the user's password hash.
+func (u *User) SetPassword(password string) error {
+	if err := DefaultPasswordPolicy.Validate(password); err != nil {
+		return err
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return fmt.Errorf("hashing password: %w", err)
+	}
+
+	u.PasswordHash = string(hash)
+	u.UpdatedAt = time.Now().UTC()
+	return nil
+}
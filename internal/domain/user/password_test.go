@@ -0,0 +1,63 @@
+package user
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasswordPolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	strict := PasswordPolicy{MinLength: 12, RequireUpper: true, RequireLower: true, RequireDigit: true, RequireSymbol: true}
+
+	tests := []struct {
+		name       string
+		policy     PasswordPolicy
+		password   string
+		violations []string
+	}{
+		{name: "default accepts eight characters", policy: DefaultPasswordPolicy, password: "abcdefgh"},
+		{name: "default rejects short", policy: DefaultPasswordPolicy, password: "abc", violations: []string{"at least 8 characters"}},
+		{name: "minimum never below floor", policy: PasswordPolicy{MinLength: 4}, password: "abcde", violations: []string{"at least 8 characters"}},
+		{name: "strict accepts complex", policy: strict, password: "Correct-Horse-9"},
+		{name: "length counts runes", policy: PasswordPolicy{MinLength: 8}, password: "ñññññññ", violations: []string{"at least 8 characters"}},
+		{
+			name:       "strict reports every violation",
+			policy:     strict,
+			password:   "password",
+			violations: []string{"at least 12 characters", "an uppercase letter", "a digit", "a symbol"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.policy.Validate(tc.password)
+			if tc.violations == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, ErrWeakPassword)
+			var policyErr *PasswordPolicyError
+			require.True(t, errors.As(err, &policyErr))
+			require.Equal(t, tc.violations, policyErr.Violations)
+		})
+	}
+}
+
+func TestSetPassword(t *testing.T) {
+	t.Parallel()
+
+	u, err := NewUser("player", "player@example.com", "first-password")
+	require.NoError(t, err)
+
+	require.ErrorIs(t, u.SetPassword("short"), ErrWeakPassword)
+	require.True(t, u.CheckPassword("first-password"))
+
+	require.NoError(t, u.SetPassword("second-password"))
+	require.False(t, u.CheckPassword("first-password"))
+	require.True(t, u.CheckPassword("second-password"))
+}
@@ -1,6 +1,9 @@
 package user
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // Repository defines the contract for User persistence.
 type Repository interface {
@@ -8,8 +11,13 @@ type Repository interface {
 	GetByID(ctx context.Context, id string) (*User, error)
 	GetByEmail(ctx context.Context, email string) (*User, error)
 	GetByUsername(ctx context.Context, username string) (*User, error)
+	UpdateEmail(ctx context.Context, id string, email string) error
+	UpdatePassword(ctx context.Context, id string, passwordHash string) error
+	UpdateDeactivation(ctx context.Context, id string, deactivatedAt *time.Time) error
 	// Admin operations
 	GetAll(ctx context.Context) ([]*User, error)
+	List(ctx context.Context, filter ListFilter) ([]*User, error)
+	Count(ctx context.Context, filter ListFilter) (int64, error)
 	Delete(ctx context.Context, id string) error
 	UpdateRole(ctx context.Context, id string, role Role) error
 }
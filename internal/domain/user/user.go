@@ -22,13 +22,14 @@ const (
 
 // User represents a registered user in the system.
 type User struct {
-	ID           uuid.UUID `bson:"_id" json:"id"`
-	Username     string    `bson:"username" json:"username"`
-	Email        string    `bson:"email" json:"email"`
-	PasswordHash string    `bson:"password_hash" json:"-"`
-	Role         Role      `bson:"role" json:"role"`
-	CreatedAt    time.Time `bson:"created_at" json:"created_at"`
-	UpdatedAt    time.Time `bson:"updated_at" json:"updated_at"`
+	ID            uuid.UUID  `bson:"_id" json:"id"`
+	Username      string     `bson:"username" json:"username"`
+	Email         string     `bson:"email" json:"email"`
+	PasswordHash  string     `bson:"password_hash" json:"-"`
+	Role          Role       `bson:"role" json:"role"`
+	DeactivatedAt *time.Time `bson:"deactivated_at,omitempty" json:"deactivated_at,omitempty"`
+	CreatedAt     time.Time  `bson:"created_at" json:"created_at"`
+	UpdatedAt     time.Time  `bson:"updated_at" json:"updated_at"`
 }
 
 // NewUser creates a new user with hashed password.
@@ -39,8 +40,8 @@ func NewUser(username, email, password string) (*User, error) {
 	if email == "" {
 		return nil, errors.New("email is required")
 	}
-	if len(password) < 8 {
-		return nil, errors.New("password must be at least 8 characters")
+	if err := DefaultPasswordPolicy.Validate(password); err != nil {
+		return nil, err
 	}
 
 	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
@@ -0,0 +1,56 @@
+// Package chaos simulates database failures by decorating repositories, so
+// the API's error handling and the frontend's retries can be tested against
+// a misbehaving store. It must never be wired in production.
+package chaos
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+// ErrInjectedFault is returned by decorated repositories in place of a
+// real database error.
+var ErrInjectedFault = errors.New("injected database fault")
+
+// Injector decides which repository calls are slowed down or failed.
+type Injector struct {
+	latency   time.Duration
+	errorRate float64
+	chance    func() float64
+}
+
+// NewInjector creates an injector that delays every call by latency and
+// fails errorRate of them, from 0 to 1.
+func NewInjector(latency time.Duration, errorRate float64) *Injector {
+	return &Injector{
+		latency:   latency,
+		errorRate: errorRate,
+		chance:    rand.Float64,
+	}
+}
+
+// Enabled reports whether the injector does anything.
+func (i *Injector) Enabled() bool {
+	return i != nil && (i.latency > 0 || i.errorRate > 0)
+}
+
+// fault waits out the configured latency and then returns the error the
+// call should fail with, or nil to let it through. A cancelled context ends
+// the wait early with the context's error.
+func (i *Injector) fault(ctx context.Context) error {
+	if i.latency > 0 {
+		timer := time.NewTimer(i.latency)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	if i.errorRate > 0 && i.chance() < i.errorRate {
+		return ErrInjectedFault
+	}
+	return nil
+}
@@ -0,0 +1,597 @@
+package chaos
+
+import (
+	"context"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+)
+
+// GameStore is a game repository along with the listing and activation the
+// game API serves from.
+type GameStore interface {
+	game.Repository
+	List(ctx context.Context, activeOnly bool) ([]*game.Game, error)
+	SetActive(ctx context.Context, id uuid.UUID, active bool) error
+}
+
+// GameRepository injects faults into a game store.
+type GameRepository struct {
+	next     GameStore
+	injector *Injector
+}
+
+// NewGameRepository decorates next with the injector's faults.
+func NewGameRepository(next GameStore, injector *Injector) *GameRepository {
+	return &GameRepository{next: next, injector: injector}
+}
+
+var _ GameStore = (*GameRepository)(nil)
+
+func (r *GameRepository) Create(ctx context.Context, g *game.Game) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Create(ctx, g)
+}
+
+func (r *GameRepository) GetByID(ctx context.Context, id string) (*game.Game, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByID(ctx, id)
+}
+
+func (r *GameRepository) GetByIDs(ctx context.Context, ids []string) ([]*game.Game, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByIDs(ctx, ids)
+}
+
+func (r *GameRepository) GetBySlug(ctx context.Context, slug string) (*game.Game, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetBySlug(ctx, slug)
+}
+
+func (r *GameRepository) GetAll(ctx context.Context) ([]*game.Game, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetAll(ctx)
+}
+
+func (r *GameRepository) List(ctx context.Context, activeOnly bool) ([]*game.Game, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.List(ctx, activeOnly)
+}
+
+func (r *GameRepository) SetActive(ctx context.Context, id uuid.UUID, active bool) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.SetActive(ctx, id, active)
+}
+
+func (r *GameRepository) Update(ctx context.Context, g *game.Game) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Update(ctx, g)
+}
+
+func (r *GameRepository) Delete(ctx context.Context, id string) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Delete(ctx, id)
+}
+
+// TournamentRepository injects faults into a tournament repository.
+type TournamentRepository struct {
+	next     tournament.Repository
+	injector *Injector
+}
+
+// NewTournamentRepository decorates next with the injector's faults.
+func NewTournamentRepository(next tournament.Repository, injector *Injector) *TournamentRepository {
+	return &TournamentRepository{next: next, injector: injector}
+}
+
+var _ tournament.Repository = (*TournamentRepository)(nil)
+
+func (r *TournamentRepository) Create(ctx context.Context, t *tournament.Tournament) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Create(ctx, t)
+}
+
+func (r *TournamentRepository) GetByID(ctx context.Context, id uuid.UUID) (*tournament.Tournament, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByID(ctx, id)
+}
+
+func (r *TournamentRepository) GetByIDFields(ctx context.Context, id uuid.UUID, fields []string) (*tournament.Tournament, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByIDFields(ctx, id, fields)
+}
+
+func (r *TournamentRepository) Update(ctx context.Context, t *tournament.Tournament) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Update(ctx, t)
+}
+
+func (r *TournamentRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Delete(ctx, id)
+}
+
+func (r *TournamentRepository) List(ctx context.Context, filter tournament.ListFilter) ([]*tournament.Tournament, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.List(ctx, filter)
+}
+
+func (r *TournamentRepository) Count(ctx context.Context, filter tournament.ListFilter) (int64, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return 0, err
+	}
+	return r.next.Count(ctx, filter)
+}
+
+func (r *TournamentRepository) GetByGameID(ctx context.Context, gameID uuid.UUID) ([]*tournament.Tournament, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByGameID(ctx, gameID)
+}
+
+func (r *TournamentRepository) GetByStatus(ctx context.Context, status tournament.Status) ([]*tournament.Tournament, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByStatus(ctx, status)
+}
+
+func (r *TournamentRepository) GetActiveTournaments(ctx context.Context) ([]*tournament.Tournament, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetActiveTournaments(ctx)
+}
+
+func (r *TournamentRepository) CountByGameID(ctx context.Context, gameID uuid.UUID) (int64, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return 0, err
+	}
+	return r.next.CountByGameID(ctx, gameID)
+}
+
+// TeamStore is a team repository along with the orphan lookup the
+// integrity checks use.
+type TeamStore interface {
+	team.Repository
+	FindWithMissingTournament(ctx context.Context, limit int64) ([]*team.Team, error)
+}
+
+// TeamRepository injects faults into a team store.
+type TeamRepository struct {
+	next     TeamStore
+	injector *Injector
+}
+
+// NewTeamRepository decorates next with the injector's faults.
+func NewTeamRepository(next TeamStore, injector *Injector) *TeamRepository {
+	return &TeamRepository{next: next, injector: injector}
+}
+
+var _ TeamStore = (*TeamRepository)(nil)
+
+func (r *TeamRepository) Create(ctx context.Context, t *team.Team) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Create(ctx, t)
+}
+
+func (r *TeamRepository) GetByID(ctx context.Context, id uuid.UUID) (*team.Team, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByID(ctx, id)
+}
+
+func (r *TeamRepository) GetByIDFields(ctx context.Context, id uuid.UUID, fields []string) (*team.Team, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByIDFields(ctx, id, fields)
+}
+
+func (r *TeamRepository) GetByInviteCode(ctx context.Context, inviteCode string) (*team.Team, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByInviteCode(ctx, inviteCode)
+}
+
+func (r *TeamRepository) Update(ctx context.Context, t *team.Team) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Update(ctx, t)
+}
+
+func (r *TeamRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Delete(ctx, id)
+}
+
+func (r *TeamRepository) GetByTournamentID(ctx context.Context, tournamentID uuid.UUID) ([]*team.Team, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByTournamentID(ctx, tournamentID)
+}
+
+func (r *TeamRepository) GetByPlayerID(ctx context.Context, playerID uuid.UUID) ([]*team.Team, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByPlayerID(ctx, playerID)
+}
+
+func (r *TeamRepository) GetPlayerTeamInTournament(ctx context.Context, playerID, tournamentID uuid.UUID) (*team.Team, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetPlayerTeamInTournament(ctx, playerID, tournamentID)
+}
+
+func (r *TeamRepository) CountByTournamentID(ctx context.Context, tournamentID uuid.UUID) (int64, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return 0, err
+	}
+	return r.next.CountByTournamentID(ctx, tournamentID)
+}
+
+func (r *TeamRepository) List(ctx context.Context, filter team.ListFilter) ([]*team.Team, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.List(ctx, filter)
+}
+
+func (r *TeamRepository) FindWithMissingTournament(ctx context.Context, limit int64) ([]*team.Team, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.FindWithMissingTournament(ctx, limit)
+}
+
+// MatchStore is a match repository along with the orphan lookup the
+// integrity checks use.
+type MatchStore interface {
+	match.Repository
+	FindWithMissingTeam(ctx context.Context, limit int64) ([]match.Match, error)
+}
+
+// MatchRepository injects faults into a match store.
+type MatchRepository struct {
+	next     MatchStore
+	injector *Injector
+}
+
+// NewMatchRepository decorates next with the injector's faults.
+func NewMatchRepository(next MatchStore, injector *Injector) *MatchRepository {
+	return &MatchRepository{next: next, injector: injector}
+}
+
+var _ MatchStore = (*MatchRepository)(nil)
+
+func (r *MatchRepository) Create(ctx context.Context, m *match.Match) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Create(ctx, m)
+}
+
+func (r *MatchRepository) GetByID(ctx context.Context, id string) (*match.Match, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByID(ctx, id)
+}
+
+func (r *MatchRepository) GetDetail(ctx context.Context, id string) (*match.Detail, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetDetail(ctx, id)
+}
+
+func (r *MatchRepository) GetByTournament(ctx context.Context, tournamentID string, status match.Status, limit, offset int) ([]match.Match, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByTournament(ctx, tournamentID, status, limit, offset)
+}
+
+func (r *MatchRepository) GetByTeam(ctx context.Context, teamID string, status match.Status, limit, offset int) ([]match.Match, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByTeam(ctx, teamID, status, limit, offset)
+}
+
+func (r *MatchRepository) CountByTeam(ctx context.Context, teamID string, status match.Status) (int, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return 0, err
+	}
+	return r.next.CountByTeam(ctx, teamID, status)
+}
+
+func (r *MatchRepository) GetByPlayer(ctx context.Context, playerID string, status match.Status, limit, offset int) ([]match.Match, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByPlayer(ctx, playerID, status, limit, offset)
+}
+
+func (r *MatchRepository) CountByPlayer(ctx context.Context, playerID string, status match.Status) (int, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return 0, err
+	}
+	return r.next.CountByPlayer(ctx, playerID, status)
+}
+
+func (r *MatchRepository) GetVerifiedByPlayerAndGame(ctx context.Context, playerID, gameID, mode string, limit int) ([]match.Match, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetVerifiedByPlayerAndGame(ctx, playerID, gameID, mode, limit)
+}
+
+func (r *MatchRepository) GetVerifiedForCorrection(ctx context.Context, filter match.CorrectionFilter, limit int) ([]match.Match, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetVerifiedForCorrection(ctx, filter, limit)
+}
+
+func (r *MatchRepository) GetUnverified(ctx context.Context, limit, offset int) ([]match.Match, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetUnverified(ctx, limit, offset)
+}
+
+func (r *MatchRepository) GetTournamentUnverified(ctx context.Context, tournamentID string, limit, offset int) ([]match.Match, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetTournamentUnverified(ctx, tournamentID, limit, offset)
+}
+
+func (r *MatchRepository) Update(ctx context.Context, m *match.Match) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Update(ctx, m)
+}
+
+func (r *MatchRepository) Review(ctx context.Context, m *match.Match) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Review(ctx, m)
+}
+
+func (r *MatchRepository) Amend(ctx context.Context, m *match.Match, amendment *match.Amendment) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Amend(ctx, m, amendment)
+}
+
+func (r *MatchRepository) Unverify(ctx context.Context, m *match.Match, reversal *match.Reversal) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Unverify(ctx, m, reversal)
+}
+
+func (r *MatchRepository) Resubmit(ctx context.Context, m *match.Match, revision *match.Revision) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Resubmit(ctx, m, revision)
+}
+
+func (r *MatchRepository) CountByTournament(ctx context.Context, tournamentID string, status match.Status) (int, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return 0, err
+	}
+	return r.next.CountByTournament(ctx, tournamentID, status)
+}
+
+func (r *MatchRepository) AggregateTournament(ctx context.Context, tournamentID string, unit match.BucketUnit) (*match.TournamentAggregates, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.AggregateTournament(ctx, tournamentID, unit)
+}
+
+func (r *MatchRepository) CountVerifiedSince(ctx context.Context, since time.Time) (int, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return 0, err
+	}
+	return r.next.CountVerifiedSince(ctx, since)
+}
+
+func (r *MatchRepository) GetPlayerActiveWeeks(ctx context.Context, since time.Time) ([]match.PlayerWeek, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetPlayerActiveWeeks(ctx, since)
+}
+
+func (r *MatchRepository) CountPlayerMatchesByDay(ctx context.Context, playerID, gameID string, since time.Time) ([]match.DayCount, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.CountPlayerMatchesByDay(ctx, playerID, gameID, since)
+}
+
+func (r *MatchRepository) CountUnverified(ctx context.Context) (int, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return 0, err
+	}
+	return r.next.CountUnverified(ctx)
+}
+
+func (r *MatchRepository) DeleteByID(ctx context.Context, id string) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.DeleteByID(ctx, id)
+}
+
+func (r *MatchRepository) FindWithMissingTeam(ctx context.Context, limit int64) ([]match.Match, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.FindWithMissingTeam(ctx, limit)
+}
+
+// PlayerRepository injects faults into a player repository.
+type PlayerRepository struct {
+	next     player.Repository
+	injector *Injector
+}
+
+// NewPlayerRepository decorates next with the injector's faults.
+func NewPlayerRepository(next player.Repository, injector *Injector) *PlayerRepository {
+	return &PlayerRepository{next: next, injector: injector}
+}
+
+var _ player.Repository = (*PlayerRepository)(nil)
+
+func (r *PlayerRepository) Create(ctx context.Context, p *player.Player) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Create(ctx, p)
+}
+
+func (r *PlayerRepository) GetByID(ctx context.Context, id string) (*player.Player, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByID(ctx, id)
+}
+
+func (r *PlayerRepository) GetByIDs(ctx context.Context, ids []string) ([]*player.Player, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByIDs(ctx, ids)
+}
+
+func (r *PlayerRepository) GetByUserID(ctx context.Context, userID string) (*player.Player, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByUserID(ctx, userID)
+}
+
+func (r *PlayerRepository) GetByIDFields(ctx context.Context, id string, fields []string) (*player.Player, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByIDFields(ctx, id, fields)
+}
+
+func (r *PlayerRepository) GetByUserIDFields(ctx context.Context, userID string, fields []string) (*player.Player, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByUserIDFields(ctx, userID, fields)
+}
+
+func (r *PlayerRepository) GetByPlatformID(ctx context.Context, platform, platformID string) (*player.Player, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetByPlatformID(ctx, platform, platformID)
+}
+
+func (r *PlayerRepository) GetAll(ctx context.Context) ([]*player.Player, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.GetAll(ctx)
+}
+
+func (r *PlayerRepository) Update(ctx context.Context, p *player.Player) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Update(ctx, p)
+}
+
+func (r *PlayerRepository) Delete(ctx context.Context, id string) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.Delete(ctx, id)
+}
+
+func (r *PlayerRepository) Count(ctx context.Context) (int64, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return 0, err
+	}
+	return r.next.Count(ctx)
+}
+
+func (r *PlayerRepository) AddHonors(ctx context.Context, id string, delta player.Honors) error {
+	if err := r.injector.fault(ctx); err != nil {
+		return err
+	}
+	return r.next.AddHonors(ctx, id, delta)
+}
+
+func (r *PlayerRepository) ListFiltered(ctx context.Context, filter player.ListFilter) ([]player.ListEntry, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return nil, err
+	}
+	return r.next.ListFiltered(ctx, filter)
+}
+
+func (r *PlayerRepository) CountFiltered(ctx context.Context, filter player.ListFilter) (int64, error) {
+	if err := r.injector.fault(ctx); err != nil {
+		return 0, err
+	}
+	return r.next.CountFiltered(ctx, filter)
+}
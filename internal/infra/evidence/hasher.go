@@ -0,0 +1,82 @@
+// Package evidence fetches match evidence files to fingerprint them.
+package evidence
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"syscall"
+	"time"
+)
+
+var errBlockedAddress = errors.New("evidence host resolves to a private address")
+
+// Hasher downloads screenshots and returns their SHA-256. Since the URLs are
+// supplied by users, connections to loopback, private and link-local
+// addresses are refused.
+type Hasher struct {
+	client   *http.Client
+	maxBytes int64
+}
+
+// NewHasher creates a hasher that refuses files larger than maxBytes.
+func NewHasher(maxBytes int64) *Hasher {
+	dialer := &net.Dialer{
+		Timeout: 5 * time.Second,
+		Control: func(_, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsUnspecified() || ip.IsMulticast() {
+				return errBlockedAddress
+			}
+			return nil
+		},
+	}
+
+	// A proxy would make the dialer check the proxy's address instead.
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &Hasher{
+		client:   &http.Client{Timeout: 15 * time.Second, Transport: transport},
+		maxBytes: maxBytes,
+	}
+}
+
+// HashScreenshot returns the hex SHA-256 of the file at url.
+func (h *Hasher) HashScreenshot(ctx context.Context, url string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", fmt.Errorf("building evidence request: %w", err)
+	}
+
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("fetching evidence: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fetching evidence: status %d", resp.StatusCode)
+	}
+
+	sum := sha256.New()
+	n, err := io.Copy(sum, io.LimitReader(resp.Body, h.maxBytes+1))
+	if err != nil {
+		return "", fmt.Errorf("reading evidence: %w", err)
+	}
+	if n > h.maxBytes {
+		return "", fmt.Errorf("evidence exceeds %d bytes", h.maxBytes)
+	}
+
+	return hex.EncodeToString(sum.Sum(nil)), nil
+}
@@ -0,0 +1,240 @@
+package http
+
+// Benchmarks for the request paths that dominate production traffic: the
+// public leaderboard, match submission and admin verification. They drive the
+// router built by newContractEnv, so each number covers routing, middleware,
+// JSON and the use case, over in-memory repositories. Database time is left to
+// the k6 profile in loadtest/.
+//
+// TestBenchmarkBaseline turns them into a regression gate:
+//
+//	go test ./internal/infra/http -run TestBenchmarkBaseline -perf
+//
+// and adding -update re-records testdata/bench/baseline.json after an intended
+// change. See docs/PERFORMANCE.md for the recorded numbers.
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+)
+
+var perf = flag.Bool("perf", false, "compare hot path benchmarks against the recorded baseline")
+
+const (
+	benchBaselineFile = "testdata/bench/baseline.json"
+
+	// benchLeaderboardSize is the number of ranked players behind the
+	// leaderboard benchmark, roughly a busy game's active population.
+	benchLeaderboardSize = 2000
+
+	// Regressions beyond these fractions of the baseline fail the gate. Time
+	// varies between runs far more than allocations do.
+	benchTimeTolerance  = 0.5
+	benchAllocTolerance = 0.1
+)
+
+// hotPaths are the benchmarks the regression gate compares.
+var hotPaths = []struct {
+	name  string
+	bench func(*testing.B)
+}{
+	{"GetLeaderboard", BenchmarkGetLeaderboard},
+	{"SubmitMatch", BenchmarkSubmitMatch},
+	{"AdminVerifyMatch", BenchmarkAdminVerifyMatch},
+}
+
+func BenchmarkGetLeaderboard(b *testing.B) {
+	env := newContractEnv(b)
+	seedLeaderboard(b, env, benchLeaderboardSize)
+	req := contractRequest{Method: http.MethodGet, Path: "/api/v1/leaderboard/{{game}}?limit=50"}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if status, _, body := env.do(b, req); status != http.StatusOK {
+			b.Fatalf("leaderboard: status %d: %s", status, body)
+		}
+	}
+}
+
+func BenchmarkSubmitMatch(b *testing.B) {
+	env := newContractEnv(b)
+	req := fixtureRequest(b, "matches", "report")
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if status, _, body := env.do(b, req); status != http.StatusCreated {
+			b.Fatalf("submit match: status %d: %s", status, body)
+		}
+	}
+}
+
+func BenchmarkAdminVerifyMatch(b *testing.B) {
+	env := newContractEnv(b)
+	id := seedDraftMatch(b, env)
+	draft := *env.matches.find(id)
+	req := contractRequest{
+		Method: http.MethodPatch,
+		Path:   "/api/v1/admin/matches/" + id + "/verify",
+		As:     "admin",
+		Body:   json.RawMessage(`{"approved":true}`),
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		// Verifying the same draft again keeps the match history, and the
+		// cost of scanning it, the same size on every iteration.
+		b.StopTimer()
+		*env.matches.find(id) = draft
+		b.StartTimer()
+
+		if status, _, body := env.do(b, req); status != http.StatusOK {
+			b.Fatalf("verify match: status %d: %s", status, body)
+		}
+	}
+}
+
+// benchResult is one benchmark's entry in the baseline file.
+type benchResult struct {
+	NsPerOp     int64 `json:"ns_per_op"`
+	AllocsPerOp int64 `json:"allocs_per_op"`
+	BytesPerOp  int64 `json:"bytes_per_op"`
+}
+
+// TestBenchmarkBaseline runs the hot path benchmarks and fails when one is
+// slower or allocates more than the baseline allows. It only runs with -perf,
+// since timings from a loaded machine would make the default suite flaky.
+func TestBenchmarkBaseline(t *testing.T) {
+	if !*perf {
+		t.Skip("run with -perf to compare hot paths against " + benchBaselineFile)
+	}
+
+	baseline := make(map[string]benchResult)
+	if !*update {
+		data, err := os.ReadFile(benchBaselineFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := json.Unmarshal(data, &baseline); err != nil {
+			t.Fatalf("%s: %v", benchBaselineFile, err)
+		}
+	}
+
+	measured := make(map[string]benchResult)
+	for _, hp := range hotPaths {
+		r := testing.Benchmark(hp.bench)
+		if r.N == 0 {
+			t.Fatalf("%s: benchmark failed", hp.name)
+		}
+		got := benchResult{NsPerOp: r.NsPerOp(), AllocsPerOp: r.AllocsPerOp(), BytesPerOp: r.AllocedBytesPerOp()}
+		measured[hp.name] = got
+		t.Logf("%s: %d ns/op, %d allocs/op, %d B/op", hp.name, got.NsPerOp, got.AllocsPerOp, got.BytesPerOp)
+
+		if *update {
+			continue
+		}
+		want, ok := baseline[hp.name]
+		if !ok {
+			t.Errorf("%s: no baseline recorded; run with -perf -update", hp.name)
+			continue
+		}
+		if limit := exceeds(want.NsPerOp, benchTimeTolerance); got.NsPerOp > limit {
+			t.Errorf("%s: %d ns/op, baseline %d allows up to %d", hp.name, got.NsPerOp, want.NsPerOp, limit)
+		}
+		if limit := exceeds(want.AllocsPerOp, benchAllocTolerance); got.AllocsPerOp > limit {
+			t.Errorf("%s: %d allocs/op, baseline %d allows up to %d", hp.name, got.AllocsPerOp, want.AllocsPerOp, limit)
+		}
+	}
+
+	if *update {
+		data, err := json.MarshalIndent(measured, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := os.MkdirAll(filepath.Dir(benchBaselineFile), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(benchBaselineFile, append(data, '\n'), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// exceeds returns the largest value within tolerance of base.
+func exceeds(base int64, tolerance float64) int64 {
+	return base + int64(float64(base)*tolerance)
+}
+
+// fixtureRequest returns the request of a named contract fixture, so the
+// benchmarks send exactly what the contract suite checks.
+func fixtureRequest(tb testing.TB, group, name string) contractRequest {
+	tb.Helper()
+	for _, f := range readFixtures(tb, filepath.Join(contractDir, group+".json")) {
+		if f.Name == name {
+			return f.Request
+		}
+	}
+	tb.Fatalf("no %q fixture in %s", name, group)
+	return contractRequest{}
+}
+
+// seedLeaderboard adds n ranked players to the seeded game.
+func seedLeaderboard(tb testing.TB, env *contractEnv, n int) {
+	tb.Helper()
+	ctx := context.Background()
+	gameID := uuid.MustParse(env.ids["game"])
+	for i := 0; i < n; i++ {
+		p, err := player.NewPlayer(uuid.New(), fmt.Sprintf("Player %04d", i))
+		if err != nil {
+			tb.Fatal(err)
+		}
+		if err := env.players.Create(ctx, p); err != nil {
+			tb.Fatal(err)
+		}
+		ps := player.NewPlayerStats(p.ID, gameID)
+		ps.Stats = map[string]interface{}{"kills": i % 60, "deaths": 10 + i%25}
+		ps.MatchesPlayed = 10 + i%40
+		ps.RankingScore = float64(500 + i%1500)
+		ps.Tier = player.TierIntermediate
+		if err := env.stats.Create(ctx, ps); err != nil {
+			tb.Fatal(err)
+		}
+	}
+}
+
+// seedDraftMatch stores a draft match for Alice's team and returns its ID.
+func seedDraftMatch(tb testing.TB, env *contractEnv) string {
+	tb.Helper()
+	alice, bob := uuid.MustParse(env.ids["alice"]), uuid.MustParse(env.ids["bob"])
+	m, err := match.NewMatch(
+		uuid.MustParse(env.ids["tournament"]),
+		uuid.MustParse(env.ids["team"]),
+		uuid.MustParse(env.ids["game"]),
+		3, 9,
+		[]match.PlayerMatchStats{
+			{PlayerID: alice, Kills: 5, Damage: 1600, Assists: 1, Deaths: 1, Downs: 4},
+			{PlayerID: bob, Kills: 4, Damage: 1300, Assists: 2, Deaths: 1, Downs: 3},
+		},
+		"https://example.com/shot.png", alice,
+	)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	if err := env.matches.Create(context.Background(), m); err != nil {
+		tb.Fatal(err)
+	}
+	return m.ID.String()
+}
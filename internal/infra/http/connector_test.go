@@ -0,0 +1,67 @@
+package http
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/infra/http/handlers"
+)
+
+// TestConnectorRevokedDuringSubmission revokes a connector after a signed
+// submission has read it and checks that recording the connector's use does
+// not bring it back.
+func TestConnectorRevokedDuringSubmission(t *testing.T) {
+	env := newContractEnv(t)
+	connector := env.connectors.connectors[0]
+	secret := connector.Secret
+
+	revokedAt := time.Now().UTC()
+	env.connectors.afterGet = func(stored *match.Connector) {
+		if stored.ID == connector.ID {
+			stored.Revoke(revokedAt)
+		}
+	}
+
+	body := env.expand(`{
+		"tournament_id": "{{tournament}}",
+		"team_id": "{{team}}",
+		"game_id": "{{game}}",
+		"team_placement": 2,
+		"team_kills": 6,
+		"player_stats": [
+			{"player_id": "{{alice}}", "kills": 4, "damage": 1400},
+			{"player_id": "{{bob}}", "kills": 2, "damage": 900}
+		],
+		"screenshot_url": "https://example.com/lobby.png"
+	}`)
+	now := time.Now()
+	nonce := uuid.NewString()
+	status, _, resp := env.do(t, contractRequest{
+		Method: http.MethodPost,
+		Path:   "/api/v1/connectors/matches",
+		Headers: map[string]string{
+			handlers.ConnectorIDHeader:        connector.ID.String(),
+			handlers.ConnectorTimestampHeader: strconv.FormatInt(now.Unix(), 10),
+			handlers.ConnectorNonceHeader:     nonce,
+			handlers.ConnectorSignatureHeader: match.SignConnectorSubmission(secret, now, nonce, []byte(body)),
+		},
+		Body: json.RawMessage(body),
+	})
+	if status != http.StatusCreated {
+		t.Fatalf("submission: status %d: %s", status, resp)
+	}
+
+	stored := env.connectors.connectors[0]
+	if !stored.Revoked() {
+		t.Fatal("connector revoked during the submission is no longer revoked")
+	}
+	if stored.LastUsedAt == nil {
+		t.Fatal("connector use was not recorded")
+	}
+}
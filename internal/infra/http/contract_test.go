@@ -0,0 +1,995 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+
+	"github.com/alejaam/tourney-rank/internal/domain/capture"
+	"github.com/alejaam/tourney-rank/internal/domain/developer"
+	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/matchmaking"
+	"github.com/alejaam/tourney-rank/internal/domain/note"
+	"github.com/alejaam/tourney-rank/internal/domain/organization"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/ranking"
+	"github.com/alejaam/tourney-rank/internal/domain/season"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/domain/user"
+	"github.com/alejaam/tourney-rank/internal/infra/http/handlers"
+	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
+	"github.com/alejaam/tourney-rank/internal/infra/mail"
+	"github.com/alejaam/tourney-rank/internal/infra/websocket"
+	activityusecase "github.com/alejaam/tourney-rank/internal/usecase/activity"
+	"github.com/alejaam/tourney-rank/internal/usecase/admin"
+	anomalyusecase "github.com/alejaam/tourney-rank/internal/usecase/anomaly"
+	"github.com/alejaam/tourney-rank/internal/usecase/auth"
+	captureusecase "github.com/alejaam/tourney-rank/internal/usecase/capture"
+	conductusecase "github.com/alejaam/tourney-rank/internal/usecase/conduct"
+	developerusecase "github.com/alejaam/tourney-rank/internal/usecase/developer"
+	integrityusecase "github.com/alejaam/tourney-rank/internal/usecase/integrity"
+	ladderusecase "github.com/alejaam/tourney-rank/internal/usecase/ladder"
+	leaderboardusecase "github.com/alejaam/tourney-rank/internal/usecase/leaderboard"
+	matchusecase "github.com/alejaam/tourney-rank/internal/usecase/match"
+	matchmakingusecase "github.com/alejaam/tourney-rank/internal/usecase/matchmaking"
+	organizationusecase "github.com/alejaam/tourney-rank/internal/usecase/organization"
+	playerusecase "github.com/alejaam/tourney-rank/internal/usecase/player"
+	seasonusecase "github.com/alejaam/tourney-rank/internal/usecase/season"
+	statsusecase "github.com/alejaam/tourney-rank/internal/usecase/stats"
+	teamusecase "github.com/alejaam/tourney-rank/internal/usecase/team"
+	tierusecase "github.com/alejaam/tourney-rank/internal/usecase/tier"
+	tournamentusecase "github.com/alejaam/tourney-rank/internal/usecase/tournament"
+	userusecase "github.com/alejaam/tourney-rank/internal/usecase/user"
+)
+
+// The contract tests run golden request/response fixtures against the full
+// router, backed by in-memory repositories, and fail when a response breaks
+// its recorded shape: a status code changes, a field disappears or a field
+// changes JSON type. New fields and different values are not breaking.
+//
+// Fixtures live in testdata/contract, one file per endpoint group. After an
+// intended breaking change, rewrite the affected goldens with
+//
+//	go test ./internal/infra/http -run TestContract -update
+//
+// and review the diff: every changed fixture is a change API consumers see.
+
+var update = flag.Bool("update", false, "rewrite contract fixtures whose responses no longer match")
+
+const (
+	contractDir      = "testdata/contract"
+	contractSecret   = "contract-test-secret"
+	contractPassword = "Contract-Pass-123"
+
+	// contractDailyQuota is each API key's daily quota, small enough to
+	// exhaust while seeding.
+	contractDailyQuota = 3
+)
+
+// contractFixture is one golden request and the response it must produce.
+type contractFixture struct {
+	Name        string          `json:"name"`
+	Request     contractRequest `json:"request"`
+	Status      int             `json:"status"`
+	ContentType string          `json:"content_type,omitempty"`
+	Response    json.RawMessage `json:"response,omitempty"`
+}
+
+// contractRequest is sent as the seeded user named in As, or anonymously when
+// As is empty. {{name}} in the path or body is replaced by the seeded ID of
+// that name.
+type contractRequest struct {
+	Method  string            `json:"method"`
+	Path    string            `json:"path"`
+	As      string            `json:"as,omitempty"`
+	Headers map[string]string `json:"headers,omitempty"`
+	Body    json.RawMessage   `json:"body,omitempty"`
+}
+
+func TestContract(t *testing.T) {
+	files, err := filepath.Glob(filepath.Join(contractDir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no contract fixtures found")
+	}
+
+	for _, file := range files {
+		group := strings.TrimSuffix(filepath.Base(file), ".json")
+		t.Run(group, func(t *testing.T) {
+			fixtures := readFixtures(t, file)
+			changed := false
+
+			for i := range fixtures {
+				f := &fixtures[i]
+				t.Run(f.Name, func(t *testing.T) {
+					env := newContractEnv(t)
+					status, contentType, body := env.do(t, f.Request)
+					// Seeded IDs also appear as object keys, so compare
+					// the response the way the golden was written.
+					body = []byte(env.placeholders.Replace(string(body)))
+
+					problems := f.check(status, contentType, body)
+					if len(problems) == 0 {
+						return
+					}
+					if *update {
+						f.record(status, contentType, body)
+						changed = true
+						return
+					}
+					t.Errorf("%s %s breaks its contract:\n  %s", f.Request.Method, f.Request.Path, strings.Join(problems, "\n  "))
+				})
+			}
+
+			if changed {
+				writeFixtures(t, file, fixtures)
+			}
+		})
+	}
+}
+
+// TestContractCoverage fails when a registered route has no fixture, so new
+// endpoints get a contract along with their first release.
+func TestContractCoverage(t *testing.T) {
+	src, err := os.ReadFile("routes.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	routes := regexp.MustCompile(`"((?:GET|POST|PUT|PATCH|DELETE) /[^"]*)"`).FindAllStringSubmatch(string(src), -1)
+
+	env := newContractEnv(t)
+	covered := make(map[string]bool)
+	files, _ := filepath.Glob(filepath.Join(contractDir, "*.json"))
+	for _, file := range files {
+		for _, f := range readFixtures(t, file) {
+			req := httptest.NewRequest(f.Request.Method, env.expand(f.Request.Path), nil)
+			_, pattern := env.router.mux.Handler(req)
+			covered[pattern] = true
+		}
+	}
+
+	for _, route := range routes {
+		if !covered[route[1]] {
+			t.Errorf("route %q has no contract fixture", route[1])
+		}
+	}
+}
+
+func readFixtures(t testing.TB, file string) []contractFixture {
+	t.Helper()
+	data, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fixtures []contractFixture
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		t.Fatalf("%s: %v", file, err)
+	}
+	return fixtures
+}
+
+func writeFixtures(t *testing.T, file string, fixtures []contractFixture) {
+	t.Helper()
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(fixtures); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(file, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// check lists the ways a response breaks the fixture's contract.
+func (f *contractFixture) check(status int, contentType string, body []byte) []string {
+	var problems []string
+	if status != f.Status {
+		problems = append(problems, fmt.Sprintf("status: want %d, got %d", f.Status, status))
+	}
+	if contentType != f.ContentType {
+		problems = append(problems, fmt.Sprintf("content type: want %q, got %q", f.ContentType, contentType))
+	}
+	if contentType != "application/json" || len(f.Response) == 0 {
+		return problems
+	}
+
+	var golden, actual interface{}
+	if err := json.Unmarshal(f.Response, &golden); err != nil {
+		return append(problems, fmt.Sprintf("golden response: %v", err))
+	}
+	if err := json.Unmarshal(body, &actual); err != nil {
+		return append(problems, fmt.Sprintf("response: %v", err))
+	}
+	return append(problems, compareShape("$", golden, actual)...)
+}
+
+// record replaces the fixture's expectations with the given response, whose
+// seeded IDs have already been swapped for placeholders.
+func (f *contractFixture) record(status int, contentType string, body []byte) {
+	f.Status = status
+	f.ContentType = contentType
+	f.Response = nil
+	if contentType != "application/json" || len(bytes.TrimSpace(body)) == 0 {
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := json.Indent(&buf, body, "    ", "  "); err == nil {
+		f.Response = json.RawMessage(bytes.TrimSpace(buf.Bytes()))
+	}
+}
+
+// compareShape reports where actual breaks the shape of golden. A golden null
+// accepts any value; array elements beyond the golden's are checked against
+// its last element.
+func compareShape(path string, golden, actual interface{}) []string {
+	if golden == nil {
+		return nil
+	}
+	if jsonType(golden) != jsonType(actual) {
+		return []string{fmt.Sprintf("%s: want %s, got %s", path, jsonType(golden), jsonType(actual))}
+	}
+
+	var problems []string
+	switch g := golden.(type) {
+	case map[string]interface{}:
+		a := actual.(map[string]interface{})
+		keys := make([]string, 0, len(g))
+		for key := range g {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value, ok := a[key]
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s.%s: missing", path, key))
+				continue
+			}
+			problems = append(problems, compareShape(path+"."+key, g[key], value)...)
+		}
+	case []interface{}:
+		if len(g) == 0 {
+			return nil
+		}
+		for i, value := range actual.([]interface{}) {
+			problems = append(problems, compareShape(fmt.Sprintf("%s[%d]", path, i), g[min(i, len(g)-1)], value)...)
+		}
+	}
+	return problems
+}
+
+func jsonType(v interface{}) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case map[string]interface{}:
+		return "object"
+	case []interface{}:
+		return "array"
+	case string:
+		return "string"
+	case float64:
+		return "number"
+	case bool:
+		return "boolean"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// contractEnv is a router wired like cmd/service, over seeded in-memory
+// repositories.
+type contractEnv struct {
+	router       *Router
+	ids          map[string]string
+	tokens       map[string]string
+	expand       func(string) string
+	placeholders *strings.Replacer
+
+	players    *memPlayers
+	stats      *memStats
+	matches    *memMatches
+	games      *memGames
+	connectors *memConnectors
+	seasons    *memSeasons
+}
+
+func (env *contractEnv) do(t testing.TB, r contractRequest) (int, string, []byte) {
+	t.Helper()
+	var body io.Reader
+	if len(r.Body) > 0 {
+		body = strings.NewReader(env.expand(string(r.Body)))
+	}
+
+	req := httptest.NewRequest(r.Method, env.expand(r.Path), body)
+	req.RemoteAddr = "192.0.2.1:1234"
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for name, value := range r.Headers {
+		req.Header.Set(name, env.expand(value))
+	}
+	if r.As != "" {
+		token, ok := env.tokens[r.As]
+		if !ok {
+			t.Fatalf("unknown user %q", r.As)
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	env.router.ServeHTTP(rec, req)
+
+	contentType, _, _ := mime.ParseMediaType(rec.Header().Get("Content-Type"))
+	return rec.Code, contentType, rec.Body.Bytes()
+}
+
+// seedUsers are the accounts every environment starts with. Alice captains
+// the tournament team Bob plays on; Carol has asked to join it; Dave has no
+// tournament team. Each captains a team on the ladder.
+var seedUsers = []struct {
+	name string
+	role user.Role
+}{
+	{"admin", user.RoleAdmin},
+	{"alice", user.RoleUser},
+	{"bob", user.RoleUser},
+	{"carol", user.RoleUser},
+	{"dave", user.RoleUser},
+}
+
+var (
+	seedHashOnce sync.Once
+	seedHash     string
+)
+
+// passwordHash hashes the seed password once; bcrypt is too slow to run for
+// every user of every fixture.
+func passwordHash(t testing.TB) string {
+	seedHashOnce.Do(func() {
+		u, err := user.NewUser("seed", "seed@example.com", contractPassword)
+		if err != nil {
+			t.Fatal(err)
+		}
+		seedHash = u.PasswordHash
+	})
+	return seedHash
+}
+
+type nopPublisher struct{}
+
+func (nopPublisher) Publish(context.Context, *player.LeaderboardSync, *player.LeaderboardSnapshot) error {
+	return nil
+}
+
+func newContractEnv(t testing.TB) *contractEnv {
+	t.Helper()
+	ctx := context.Background()
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	now := time.Now().UTC()
+	ids := make(map[string]string)
+
+	// Repositories
+	players := newMemPlayers()
+	stats := &memStats{players: players}
+	players.stats = stats
+	users := newMemUsers(players)
+	games := &memGames{games: make(map[string]*game.Game)}
+	consents := &memConsents{}
+	emailChanges := &memEmailChanges{}
+	developerKeys := &memDeveloperKeys{}
+	developerUsage := &memDeveloperUsage{}
+	tournaments := &memTournaments{}
+	exports := &memExports{}
+	backups := &memBackups{}
+	rulebooks := &memRulebooks{}
+	rosterOverlaps := &memRosterOverlaps{}
+	objects := &memObjectStore{objects: make(map[string][]byte)}
+	teams := &memTeams{tournaments: tournaments}
+	teamHistory := &memTeamHistory{}
+	broadcasts := &memBroadcasts{}
+	joinRequests := &memJoinRequests{}
+	registrations := &memRegistrationQueue{}
+	matches := &memMatches{games: games, players: players, teams: teams}
+	comments := &memComments{}
+	honorVotes := &memHonorVotes{}
+	connectors := &memConnectors{nonces: make(map[string]bool)}
+	notes := &memNotes{}
+	queue := &memQueue{}
+	lobbies := &memLobbies{}
+	ladders := &memLadders{}
+	challenges := &memChallenges{}
+	snapshots := &memLadderSnapshots{}
+	seasons := &memSeasons{}
+	boundaries := &memBoundaries{}
+	ratingHistory := &memRatingHistory{}
+	rewards := &memRewards{}
+	cohorts := &memCohorts{}
+	integrityReports := &memIntegrityReports{}
+	syncs := &memLeaderboardSyncs{}
+	activities := &memActivity{}
+	organizations := &memOrganizations{}
+	siteFeed := &memSiteFeed{}
+	captures := &memCaptures{}
+	corrections := &memCorrections{}
+
+	// Services and handlers, as in cmd/service
+	mailer := mail.NewLogMailer(logger)
+	policyVersions := user.PolicyVersions{}
+	authService := auth.NewService(users, contractSecret, 24*time.Hour).
+		WithEmailChange(emailChanges, mailer, "http://localhost:3000").
+		WithPasswordPolicy(user.DefaultPasswordPolicy, nil).
+		WithConsent(consents, policyVersions).
+		WithPlayers(players, stats).
+		WithLogger(logger)
+	userService := userusecase.NewService(users)
+	playerService := playerusecase.NewService(players)
+	leaderboardService := leaderboardusecase.NewService(stats, games, players).
+		WithOrphanRepair(stats).
+		WithSeasons(seasons)
+	leaderboardSyncService := leaderboardusecase.NewSyncService(syncs, games, leaderboardService, nopPublisher{}, logger)
+	anomalyService := anomalyusecase.NewService(players, stats, player.DefaultNewAccountAge)
+	conductRecords := &memConduct{}
+	conductService := conductusecase.NewService(conductRecords, players, player.ConductPolicy{MVPWeight: 2})
+	organizationService := organizationusecase.NewService(organizations, users, tournaments).
+		WithCircuit(teams, matches)
+	tournamentService := tournamentusecase.NewService(tournaments, teams, games, stats, matches).
+		WithActivityLog(activities).
+		WithSiteFeed(siteFeed).
+		WithExports(exports, []byte(contractSecret)).
+		WithImports(players, users).
+		WithBackups(&memSnapshotter{tournaments: tournaments, teams: teams, matches: matches}, backups, objects).
+		WithRulebooks(rulebooks).
+		WithPlayerProfiles(players).
+		WithRosterOverlaps(rosterOverlaps).
+		WithConduct(conductService).
+		WithOrganizations(organizationService)
+	teamService := teamusecase.NewService(teams, tournaments, players, stats, joinRequests).
+		WithActivityLog(activities).
+		WithHistory(teamHistory).
+		WithRegistrationQueue(registrations).
+		WithBroadcasts(broadcasts).
+		WithAnomalies(anomalyService).
+		WithSportsmanship(2).
+		WithConduct(conductService).
+		WithOrganizations(organizationService).
+		WithTeamCleanup(matches)
+	rankingService := ranking.NewService(ranking.NewWarzoneCalculator(), ranking.NewDefaultCalculator()).
+		WithTierBoundaries(boundaries)
+	matchService := matchusecase.NewService(matches, comments, teams, tournaments, games, players, stats, playerService, rankingService).
+		WithActivityLog(activities).
+		WithSiteFeed(siteFeed).
+		WithNotes(notes).
+		WithConnectors(connectors, connectors).
+		WithRosterOverlaps(rosterOverlaps).
+		WithAnomalies(anomalyService).
+		WithHonors(honorVotes).
+		WithConduct(conductService).
+		WithSeasons(seasons).
+		WithCorrections(corrections)
+	activityService := activityusecase.NewService(activities, siteFeed, players)
+	ladderService := ladderusecase.NewService(ladders, challenges, snapshots, tournaments, teams, matches, games).
+		WithActivityLog(activities).
+		WithConduct(conductService)
+	seasonService := seasonusecase.NewService(seasons, games, leaderboardService)
+	matchmakingService := matchmakingusecase.NewService(
+		queue, lobbies, games, teams, stats, rankingService, websocket.NewHub(logger),
+		matchmaking.MatcherConfig{LobbySize: 2, MaxRatingSpread: 500},
+	).WithActivityLog(activities).WithSiteFeed(siteFeed).WithTeammateSuggestions(players)
+	tierService := tierusecase.NewService(boundaries, ratingHistory, stats, games, rankingService).
+		WithActivityLog(activities).
+		WithSiteFeed(siteFeed).
+		WithRewards(rewards)
+	statsService := statsusecase.NewService(players, stats, matches, tournaments, games)
+	cohortService := statsusecase.NewCohortService(players, matches, cohorts)
+	integrityService := integrityusecase.NewService(leaderboardService, stats, matches, teams, integrityReports)
+	adminUserService := admin.NewUserService(users).WithConsent(consents, policyVersions).WithCaptainSuccession(teamService)
+	adminGameService := admin.NewGameService(games, stats, rankingService)
+	developerService := developerusecase.NewService(developerKeys, developerUsage, developer.Quota{PerDay: contractDailyQuota})
+	adminPlayerService := admin.NewPlayerService(players).WithCaptainSuccession(teamService)
+	adminNoteService := admin.NewNoteService(notes, matches, players, teams)
+
+	router := NewRouter(logger,
+		WithAuthHandler(handlers.NewAuthHandler(authService, userService, logger)),
+		WithAdminHandler(handlers.NewAdminHandler(adminUserService, adminGameService, adminPlayerService, adminNoteService, logger)),
+		WithPlayerHandler(handlers.NewPlayerHandler(playerService, stats, games, matches, logger)),
+		WithJWTSecret(contractSecret),
+		WithVersion("contract"),
+		WithMongoDBChecker(func(context.Context) error { return nil }),
+		WithDataLoader(games, players),
+		WithGameHandler(handlers.NewGameHandler(games, logger)),
+		WithLeaderboardHandler(handlers.NewLeaderboardHandler(leaderboardService, leaderboardSyncService, logger)),
+		WithTournamentHandler(handlers.NewTournamentHandler(tournamentService, logger)),
+		WithTeamHandler(handlers.NewTeamHandler(teamService, logger)),
+		WithMatchHandler(handlers.NewMatchHandler(logger, matchService)),
+		WithMatchmakingHandler(handlers.NewMatchmakingHandler(matchmakingService, logger)),
+		WithTierHandler(handlers.NewTierHandler(tierService, logger)),
+		WithStatsHandler(handlers.NewStatsHandler(statsService, cohortService, logger)),
+		WithIntegrityHandler(handlers.NewIntegrityHandler(integrityService, logger)),
+		WithActivityHandler(handlers.NewActivityHandler(activityService, logger)),
+		WithLadderHandler(handlers.NewLadderHandler(ladderService, logger)),
+		WithSeasonHandler(handlers.NewSeasonHandler(seasonService, logger)),
+		WithCaptureHandler(handlers.NewCaptureHandler(captureusecase.NewService(captures, matchService), logger)),
+		WithOrganizationHandler(handlers.NewOrganizationHandler(organizationService, logger)),
+		WithDeveloperHandler(handlers.NewDeveloperHandler(developerService, logger)),
+		WithAPIKeyQuota(developerService),
+		WithActiveAccountGate(authService),
+		WithCompression(middleware.DefaultCompressMinSize),
+		WithMetrics(),
+	)
+
+	must := func(err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatalf("seeding: %v", err)
+		}
+	}
+
+	// Game
+	g, err := game.NewGame("Warzone", "warzone", "Battle royale", "activision_id",
+		game.StatSchema{
+			"kills":  game.StatField{Type: "integer", Min: 0, Label: "Kills"},
+			"deaths": game.StatField{Type: "integer", Min: 0, Label: "Deaths"},
+		},
+		game.RankingWeights{"kd_ratio": 0.5, "avg_kills": 0.5},
+	)
+	must(err)
+	g.Maps = []string{"Verdansk"}
+	must(games.Create(ctx, g))
+	ids["game"] = g.ID.String()
+
+	// Users, their player profiles and stats
+	tokens := make(map[string]string)
+	profiles := make(map[string]*player.Player)
+	for i, seed := range seedUsers {
+		u := &user.User{
+			ID:           uuid.New(),
+			Username:     seed.name,
+			Email:        seed.name + "@example.com",
+			PasswordHash: passwordHash(t),
+			Role:         seed.role,
+			CreatedAt:    now.Add(-30 * 24 * time.Hour),
+			UpdatedAt:    now.Add(-30 * 24 * time.Hour),
+		}
+		must(users.Create(ctx, u))
+
+		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+			"sub":  u.ID.String(),
+			"role": u.Role,
+			"exp":  now.Add(time.Hour).Unix(),
+		}).SignedString([]byte(contractSecret))
+		must(err)
+		tokens[seed.name] = token
+
+		if seed.role == user.RoleAdmin {
+			ids[seed.name+"_user"] = u.ID.String()
+			continue
+		}
+		// Some handlers resolve the caller's player by user ID and others use the
+		// token subject directly, so profiles share their user's ID.
+		p, err := player.NewPlayer(u.ID, strings.ToUpper(seed.name[:1])+seed.name[1:])
+		must(err)
+		p.ID = u.ID
+		must(players.Create(ctx, p))
+		profiles[seed.name] = p
+		ids[seed.name] = p.ID.String()
+
+		ps := player.NewPlayerStats(p.ID, g.ID)
+		ps.Stats = map[string]interface{}{"kills": 40 - 5*i, "deaths": 10 + i}
+		ps.MatchesPlayed = 10
+		ps.RankingScore = 1500 - 100*float64(i)
+		ps.Tier = player.TierAdvanced
+		must(stats.Create(ctx, ps))
+	}
+	alice, bob, carol, dave := profiles["alice"], profiles["bob"], profiles["carol"], profiles["dave"]
+	must(alice.UpdateExtendedProfile(0, "", "", "Spanish"))
+	must(players.Update(ctx, alice))
+
+	// An active trios tournament with Alice's team
+	cup, err := tournament.NewTournament(g.ID, uuid.MustParse(ids["admin_user"]), "Open Cup", tournament.TeamSizeTrios, now.Add(-24*time.Hour), now.Add(7*24*time.Hour))
+	must(err)
+	cup.Status = tournament.StatusActive
+	cup.Rules.MaxTeams = 16
+	must(cup.SetLanguages([]string{"en", "es"}))
+	must(tournaments.Create(ctx, cup))
+	ids["tournament"] = cup.ID.String()
+	rulebook := tournament.NewRulebook(cup.ID)
+	must(rulebook.SetSections([]*tournament.Section{
+		{Kind: tournament.SectionRules, Title: "Scoring", Content: "Placement points plus **1 point per kill**."},
+		{Kind: tournament.SectionFAQ, Title: "Can I swap players?", Content: "Rosters lock once the tournament starts."},
+	}, cup.CreatedBy, now.Add(-12*time.Hour)))
+	must(rulebooks.Save(ctx, rulebook))
+	ids["rules_section"] = rulebook.Sections[0].ID.String()
+
+	draft, err := tournament.NewTournament(g.ID, uuid.MustParse(ids["admin_user"]), "Weekend Draft", tournament.TeamSizeDuos, now.Add(7*24*time.Hour), now.Add(9*24*time.Hour))
+	must(err)
+	must(tournaments.Create(ctx, draft))
+	ids["draft_tournament"] = draft.ID.String()
+
+	// An open duos tournament that forbids competing in overlapping events
+	night, err := tournament.NewTournament(g.ID, uuid.MustParse(ids["admin_user"]), "Night Series", tournament.TeamSizeDuos, now.Add(-time.Hour), now.Add(3*24*time.Hour))
+	must(err)
+	night.Status = tournament.StatusOpen
+	night.Rules.MaxConcurrentTournaments = 1
+	night.CreatedAt = now.Add(-30 * 24 * time.Hour)
+	must(tournaments.Create(ctx, night))
+	ids["exclusive_tournament"] = night.ID.String()
+
+	// An open invitational with one slot: Carol holds it and Dave is the reserve
+	invitational, err := tournament.NewTournament(g.ID, uuid.MustParse(ids["admin_user"]), "Invitational", tournament.TeamSizeDuos, now.Add(24*time.Hour), now.Add(2*24*time.Hour))
+	must(err)
+	invitational.Status = tournament.StatusOpen
+	invitational.Rules.InviteOnly = true
+	invitational.Rules.MaxTeams = 1
+	_, err = invitational.Invite(carol.ID, "Carol's Crew", now)
+	must(err)
+	_, err = invitational.Invite(dave.ID, "", now)
+	must(err)
+	must(tournaments.Create(ctx, invitational))
+	ids["invite_only_tournament"] = invitational.ID.String()
+
+	// Dave's organization runs a weekly series that Carol organizes with him;
+	// next week's cup is not in the series yet
+	fne, err := organization.New("Friday Night Esports", dave.ID)
+	must(err)
+	must(fne.AddStaff(carol.ID, organization.RoleOrganizer, now))
+	series, err := fne.AddSeries("Friday Cup", now)
+	must(err)
+	must(organizations.Create(ctx, fne))
+	ids["organization"] = fne.ID.String()
+	ids["series"] = series.ID.String()
+	friday, err := tournament.NewTournament(g.ID, dave.ID, "Friday Cup #12", tournament.TeamSizeDuos, now.Add(2*24*time.Hour), now.Add(2*24*time.Hour+6*time.Hour))
+	must(err)
+	friday.SeriesID = &series.ID
+	must(tournaments.Create(ctx, friday))
+	ids["series_tournament"] = friday.ID.String()
+	nextFriday, err := tournament.NewTournament(g.ID, dave.ID, "Friday Cup #13", tournament.TeamSizeDuos, now.Add(9*24*time.Hour), now.Add(9*24*time.Hour+6*time.Hour))
+	must(err)
+	must(tournaments.Create(ctx, nextFriday))
+	ids["next_series_tournament"] = nextFriday.ID.String()
+
+	// Last week's cup is finished, and this week's is the circuit's finals
+	lastFriday, err := tournament.NewTournament(g.ID, dave.ID, "Friday Cup #11", tournament.TeamSizeDuos, now.Add(-5*24*time.Hour), now.Add(-5*24*time.Hour+6*time.Hour))
+	must(err)
+	lastFriday.SeriesID = &series.ID
+	lastFriday.Status = tournament.StatusFinished
+	must(tournaments.Create(ctx, lastFriday))
+	var circuitTeams []*team.Team
+	for i, name := range []string{"Night Owls", "Early Birds"} {
+		captainID := uuid.New()
+		entry, err := team.NewTeam(lastFriday.ID, captainID, name)
+		must(err)
+		entry.Status = team.StatusActive
+		must(teams.Create(ctx, entry))
+		circuitTeams = append(circuitTeams, entry)
+		result, err := match.NewMatch(lastFriday.ID, entry.ID, g.ID, 1+2*i, 9-4*i, []match.PlayerMatchStats{{PlayerID: captainID, Kills: 9 - 4*i}}, "https://example.com/shot.png", captainID)
+		must(err)
+		must(result.VerifyMatch(dave.ID))
+		must(matches.Create(ctx, result))
+	}
+	ids["source_tournament"] = lastFriday.ID.String()
+	ids["source_team"] = circuitTeams[0].ID.String()
+	must(series.SetCircuit([]int{10, 6, 4, 2}, &friday.ID, 8))
+
+	// This week's cup is seeded from last week's: the Night Owls kept their
+	// roster, and the Early Birds' captain now plays with Carol
+	owls, err := team.NewTeam(friday.ID, circuitTeams[0].CaptainID, "Night Owls")
+	must(err)
+	must(teams.Create(ctx, owls))
+	risers, err := team.NewTeam(friday.ID, circuitTeams[1].CaptainID, "Early Risers")
+	must(err)
+	must(risers.AddMember(carol.ID))
+	must(teams.Create(ctx, risers))
+	ids["seeded_team"] = risers.ID.String()
+	_, err = tournamentService.ImportSeeding(ctx, friday.ID, tournamentusecase.ImportSeedingRequest{SourceTournamentID: lastFriday.ID}, dave.ID, false)
+	must(err)
+
+	alpha, err := team.NewTeam(cup.ID, alice.ID, "Alpha")
+	must(err)
+	must(alpha.AddMember(bob.ID))
+	alpha.Status = team.StatusActive
+	alpha.JoinMode = team.JoinModeApproval
+	must(teams.Create(ctx, alpha))
+	ids["team"] = alpha.ID.String()
+	ids["invite_code"] = alpha.InviteCode
+	must(teamHistory.Create(ctx, team.NewHistoryEntry(alpha.ID, team.HistoryMemberJoined, bob.ID, bob.ID)))
+	broadcast, err := team.NewBroadcast(alpha, alice.ID, "Scrims at 9pm", now.Add(-2*time.Hour))
+	must(err)
+	must(broadcasts.Create(ctx, broadcast))
+
+	// Bob's platform ID also turned up on another team in the cup
+	bravo, err := team.NewTeam(cup.ID, dave.ID, "Bravo")
+	must(err)
+	overlaps := team.DetectRosterOverlaps(alpha, []*team.Team{bravo}, map[uuid.UUID]map[string]string{
+		bob.ID:  {"activision_id": "Bobby#1234"},
+		dave.ID: {"activision_id": "bobby#1234"},
+	}, uuid.New(), now.Add(-3*time.Hour))
+	must(rosterOverlaps.Create(ctx, overlaps[0]))
+	ids["roster_overlap"] = overlaps[0].ID.String()
+
+	request := team.NewJoinRequest(alpha, carol.ID, "Room for one more?")
+	must(joinRequests.Create(ctx, request))
+	ids["join_request"] = request.ID.String()
+
+	ticket, err := team.NewRegistrationTicket(cup.ID, dave.ID, "Delta", "DLT", "")
+	must(err)
+	must(registrations.Create(ctx, ticket))
+	ids["ticket"] = ticket.ID.String()
+
+	export := tournament.NewExport(cup.ID, uuid.MustParse(ids["admin_user"]))
+	must(exports.Create(ctx, export))
+	ids["export"] = export.ID.String()
+
+	// A verified, a draft and a rejected match for Alpha
+	lines := []match.PlayerMatchStats{
+		{PlayerID: alice.ID, Kills: 7, Damage: 2100, Assists: 2, Deaths: 1, Downs: 6},
+		{PlayerID: bob.ID, Kills: 4, Damage: 1500, Assists: 3, Deaths: 1, Downs: 3},
+	}
+	verified, err := match.NewMatch(cup.ID, alpha.ID, g.ID, 2, 11, lines, "https://example.com/shot.png", alice.ID)
+	must(err)
+	verified.Map = "Verdansk"
+	verified.CreatedAt = now.Add(-2 * time.Hour)
+	must(verified.VerifyMatch(uuid.MustParse(ids["admin_user"])))
+	must(matches.Create(ctx, verified))
+	ids["match"] = verified.ID.String()
+
+	// Last week's deaths were keyed in twice over and halved in bulk
+	halved, err := match.NewStatCorrection(match.CorrectionFilter{GameID: g.ID, TournamentID: &lastFriday.ID},
+		match.Transform{Op: match.OpScale, Field: match.FieldDeaths, Factor: 0.5}, "Deaths double counted by the stats import", uuid.MustParse(ids["admin_user"]), now.Add(-time.Hour))
+	must(err)
+	must(corrections.Create(ctx, halved))
+	ids["stat_correction"] = halved.ID.String()
+
+	pending, err := match.NewMatch(cup.ID, alpha.ID, g.ID, 5, 6, []match.PlayerMatchStats{
+		{PlayerID: alice.ID, Kills: 4, Damage: 1200, Deaths: 1},
+		{PlayerID: bob.ID, Kills: 2, Damage: 800, Deaths: 1},
+	}, "https://example.com/shot2.png", alice.ID)
+	must(err)
+	pending.Map = "Verdansk"
+	must(matches.Create(ctx, pending))
+	ids["draft_match"] = pending.ID.String()
+
+	rejected, err := match.NewMatch(cup.ID, alpha.ID, g.ID, 9, 1, []match.PlayerMatchStats{
+		{PlayerID: alice.ID, Kills: 1, Damage: 400, Deaths: 1},
+		{PlayerID: bob.ID, Kills: 0, Damage: 150, Deaths: 1},
+	}, "https://example.com/blurry.png", alice.ID)
+	must(err)
+	must(rejected.RejectMatch(uuid.MustParse(ids["admin_user"]), "Screenshot unreadable"))
+	must(matches.Create(ctx, rejected))
+	ids["rejected_match"] = rejected.ID.String()
+
+	comment, err := match.NewComment(verified.ID, alice.ID, "GG")
+	must(err)
+	must(comments.Create(ctx, comment))
+
+	vote, err := match.NewHonorVote(verified, bob.ID, &alice.ID, nil, now)
+	must(err)
+	must(honorVotes.Create(ctx, vote))
+
+	noShow, err := player.NewConductRecord(bob.ID, player.ConductNoShow, "Missed the second lobby", uuid.MustParse(ids["admin_user"]), now.Add(-24*time.Hour))
+	must(err)
+	noShow.TournamentID = &cup.ID
+	must(conductRecords.Create(ctx, noShow))
+
+	connector, err := match.NewConnector("Lobby bot", uuid.MustParse(ids["admin_user"]))
+	must(err)
+	must(connectors.Create(ctx, connector))
+	ids["connector"] = connector.ID.String()
+
+	for subject, id := range map[note.Subject]uuid.UUID{note.SubjectPlayer: alice.ID, note.SubjectMatch: verified.ID, note.SubjectTeam: alpha.ID} {
+		n, err := note.New(subject, id, uuid.MustParse(ids["admin_user"]), "Checked by staff")
+		must(err)
+		must(notes.Create(ctx, n))
+	}
+
+	// A scrim lobby matched from Alice's and Bob's queue entries
+	var entries []*matchmaking.QueueEntry
+	for _, p := range []*player.Player{alice, bob} {
+		e, err := matchmaking.NewQueueEntry(g.ID, p.ID, nil, []uuid.UUID{p.ID}, player.TierAdvanced, 1450)
+		must(err)
+		entries = append(entries, e)
+	}
+	lobby, err := matchmaking.NewLobby(g.ID, player.TierAdvanced, entries)
+	must(err)
+	for _, e := range entries {
+		must(e.AssignLobby(lobby.ID))
+		must(queue.Create(ctx, e))
+	}
+	must(lobbies.Create(ctx, lobby))
+	ids["lobby"] = lobby.ID.String()
+	ids["alice_entry"] = entries[0].ID.String()
+	ids["bob_entry"] = entries[1].ID.String()
+
+	// Dave waiting solo, free on the same evenings as Carol
+	for name, timezone := range map[string]string{"carol": "America/Mexico_City", "dave": "America/Bogota"} {
+		must(profiles[name].SetAvailability(&player.Availability{Timezone: timezone, Windows: []player.AvailabilityWindow{
+			{Day: time.Friday, Start: "19:00", End: "23:00"},
+		}}))
+		must(players.Update(ctx, profiles[name]))
+	}
+	daveEntry, err := matchmaking.NewQueueEntry(g.ID, dave.ID, nil, []uuid.UUID{dave.ID}, player.TierAdvanced, 1200)
+	must(err)
+	must(queue.Create(ctx, daveEntry))
+
+	// A ladder with every player's team on it and Bob's team challenging Alice's
+	l, err := ladderService.CreateLadder(ctx, ladderusecase.CreateLadderRequest{
+		GameID:   g.ID,
+		Name:     "Warzone Ladder",
+		TeamSize: tournament.TeamSizeDuos,
+	}, uuid.MustParse(ids["admin_user"]))
+	must(err)
+	ids["ladder"] = l.ID.String()
+	for _, name := range []string{"alice", "bob", "dave", "carol"} {
+		p := profiles[name]
+		tm, err := team.NewTeam(l.TournamentID, p.ID, "Ladder "+p.DisplayName)
+		must(err)
+		tm.Status = team.StatusActive
+		must(teams.Create(ctx, tm))
+		ids[name+"_ladder_team"] = tm.ID.String()
+		_, err = ladderService.JoinLadder(ctx, l.ID, tm.ID, p.ID)
+		must(err)
+	}
+	challenge, err := ladderService.CreateChallenge(ctx, l.ID, ladderusecase.ChallengeRequest{
+		TeamID:     uuid.MustParse(ids["bob_ladder_team"]),
+		OpponentID: uuid.MustParse(ids["alice_ladder_team"]),
+	}, bob.ID)
+	must(err)
+	ids["challenge"] = challenge.ID.String()
+
+	// A second game's ladder where Alice's team accepted Bob's team's challenge
+	rebirth, err := game.NewGame("Warzone Rebirth", "warzone-rebirth", "Battle royale", "activision_id", g.StatSchema, g.RankingWeights)
+	must(err)
+	rebirth.Maps = []string{"Rebirth Island"}
+	must(games.Create(ctx, rebirth))
+	rebirthLadder, err := ladderService.CreateLadder(ctx, ladderusecase.CreateLadderRequest{
+		GameID:         rebirth.ID,
+		Name:           "Rebirth Ladder",
+		TeamSize:       tournament.TeamSizeDuos,
+		ForfeitPenalty: 2,
+	}, uuid.MustParse(ids["admin_user"]))
+	must(err)
+	ids["rebirth_ladder"] = rebirthLadder.ID.String()
+	for _, p := range []*player.Player{alice, bob} {
+		tm, err := team.NewTeam(rebirthLadder.TournamentID, p.ID, "Rebirth "+p.DisplayName)
+		must(err)
+		tm.Status = team.StatusActive
+		must(teams.Create(ctx, tm))
+		ids[strings.ToLower(p.DisplayName)+"_rebirth_team"] = tm.ID.String()
+		_, err = ladderService.JoinLadder(ctx, rebirthLadder.ID, tm.ID, p.ID)
+		must(err)
+	}
+	accepted, err := ladderService.CreateChallenge(ctx, rebirthLadder.ID, ladderusecase.ChallengeRequest{
+		TeamID:     uuid.MustParse(ids["bob_rebirth_team"]),
+		OpponentID: uuid.MustParse(ids["alice_rebirth_team"]),
+	}, bob.ID)
+	must(err)
+	accepted, err = ladderService.RespondToChallenge(ctx, accepted.ID, alice.ID, true)
+	must(err)
+	ids["accepted_challenge"] = accepted.ID.String()
+	ids["later_play_by"] = accepted.PlayBy.Add(24 * time.Hour).Format(time.RFC3339)
+
+	leaderboardSync, err := leaderboardSyncService.CreateSync(ctx, g.ID, leaderboardusecase.SyncRequest{
+		Name:            "Community sheet",
+		Provider:        player.SyncJSONBucket,
+		Endpoint:        "https://example.com/hook",
+		Limit:           10,
+		IntervalMinutes: 60,
+	})
+	must(err)
+	ids["sync"] = leaderboardSync.ID.String()
+
+	backup, err := tournamentService.CreateBackup(ctx, cup.ID, uuid.MustParse(ids["admin_user"]), "before reseeding")
+	must(err)
+	ids["backup"] = backup.ID.String()
+
+	// API keys: Carol's has been used today; Dave's has spent its daily quota
+	statsBot, err := developerService.CreateKey(ctx, carol.ID, developerusecase.CreateKeyRequest{Name: "Stats bot"})
+	must(err)
+	ids["developer_key"] = statsBot.ID.String()
+	ids["developer_key_secret"] = statsBot.Key
+	for range 2 {
+		_, err := developerService.Authorize(ctx, statsBot.Key)
+		must(err)
+	}
+	scraper, err := developerService.CreateKey(ctx, dave.ID, developerusecase.CreateKeyRequest{Name: "Scraper"})
+	must(err)
+	ids["exhausted_key_secret"] = scraper.Key
+	for range contractDailyQuota {
+		_, err := developerService.Authorize(ctx, scraper.Key)
+		must(err)
+	}
+
+	// A season that ended an hour ago and awaits its rollover, with Alice
+	// ahead of Bob on its leaderboard
+	opening, err := season.NewSeason(g.ID, uuid.MustParse(ids["admin_user"]), 1, "Opening Season", now.Add(-30*24*time.Hour), now.Add(-time.Hour))
+	must(err)
+	must(seasons.Create(ctx, opening))
+	ids["season"] = opening.ID.String()
+	for i, p := range []*player.Player{alice, bob} {
+		ps, err := stats.GetOrCreateForScope(ctx, p.ID, g.ID, player.StatsScope{SeasonID: opening.ID})
+		must(err)
+		ps.MatchesPlayed = 4 - i
+		ps.RankingScore = 1300 - 150*float64(i)
+		ps.Tier = player.TierIntermediate
+	}
+
+	// A captured report Alice's team had rejected while the cup was paused,
+	// and a captured verification, which cannot be replayed
+	report := fmt.Sprintf(`{"tournament_id":%q,"team_id":%q,"game_id":%q,"map":"Verdansk","team_placement":3,"team_kills":6,`+
+		`"player_stats":[{"player_id":%q,"kills":4},{"player_id":%q,"kills":2}],"screenshot_url":"https://example.com/third.png"}`,
+		cup.ID, alpha.ID, g.ID, alice.ID, bob.ID)
+	paused := capture.NewCapture(capture.Exchange{
+		Kind:     capture.KindSubmission,
+		Method:   "POST",
+		Path:     "/api/v1/matches/report",
+		UserID:   alice.ID.String(),
+		Request:  []byte(report),
+		Status:   422,
+		Response: []byte(`{"error":"tournament is not active"}`),
+		Duration: 12 * time.Millisecond,
+	}, 72*time.Hour, now.Add(-2*time.Hour))
+	must(captures.Create(ctx, paused))
+	ids["request_capture"] = paused.ID.String()
+	verification := capture.NewCapture(capture.Exchange{
+		Kind:     capture.KindVerification,
+		Method:   "PATCH",
+		Path:     "/api/v1/admin/matches/" + ids["match"] + "/verify",
+		UserID:   ids["admin_user"],
+		Request:  []byte(`{"status":"verified"}`),
+		Status:   200,
+		Response: []byte(`{"status":"verified"}`),
+		Duration: 8 * time.Millisecond,
+	}, 72*time.Hour, now.Add(-time.Hour))
+	must(captures.Create(ctx, verification))
+	ids["verification_capture"] = verification.ID.String()
+
+	// Placeholders, longest names first so no name replaces part of another
+	names := make([]string, 0, len(ids))
+	for name := range ids {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool { return len(names[i]) > len(names[j]) })
+	var expand, placeholders []string
+	for _, name := range names {
+		expand = append(expand, "{{"+name+"}}", ids[name])
+		if _, err := uuid.Parse(ids[name]); err == nil {
+			placeholders = append(placeholders, ids[name], "{{"+name+"}}")
+		}
+	}
+
+	return &contractEnv{
+		router:       router,
+		ids:          ids,
+		tokens:       tokens,
+		expand:       strings.NewReplacer(expand...).Replace,
+		placeholders: strings.NewReplacer(placeholders...),
+		players:      players,
+		stats:        stats,
+		matches:      matches,
+		games:        games,
+		connectors:   connectors,
+		seasons:      seasons,
+	}
+}
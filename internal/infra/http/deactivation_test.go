@@ -0,0 +1,40 @@
+package http
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+)
+
+// TestDeactivatedAccountToken deactivates Carol's account and checks the
+// token she was issued before is refused everywhere, while Alice's still works.
+func TestDeactivatedAccountToken(t *testing.T) {
+	env := newContractEnv(t)
+
+	status, _, body := env.do(t, contractRequest{
+		Method: http.MethodPost,
+		Path:   "/api/v1/users/me/deactivate",
+		As:     "carol",
+		Body:   json.RawMessage(fmt.Sprintf(`{"password":%q}`, contractPassword)),
+	})
+	if status != http.StatusOK && status != http.StatusNoContent {
+		t.Fatalf("deactivate: status %d: %s", status, body)
+	}
+
+	tests := []struct {
+		as   string
+		path string
+		want int
+	}{
+		{as: "carol", path: "/api/v1/users/me", want: http.StatusUnauthorized},
+		{as: "carol", path: "/api/v1/users/me/consent", want: http.StatusUnauthorized},
+		{as: "alice", path: "/api/v1/users/me", want: http.StatusOK},
+	}
+	for _, tc := range tests {
+		status, _, body := env.do(t, contractRequest{Method: http.MethodGet, Path: tc.path, As: tc.as})
+		if status != tc.want {
+			t.Errorf("%s %s: got %d, want %d: %s", tc.as, tc.path, status, tc.want, body)
+		}
+	}
+}
@@ -0,0 +1,109 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
+	activityusecase "github.com/alejaam/tourney-rank/internal/usecase/activity"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
+	"github.com/google/uuid"
+)
+
+// recentActivityCacheControl lets clients and CDNs briefly cache the public ticker.
+const recentActivityCacheControl = "public, max-age=15"
+
+// ActivityHandler handles HTTP requests for player and site-wide activity feeds.
+type ActivityHandler struct {
+	service *activityusecase.Service
+	logger  *slog.Logger
+}
+
+// NewActivityHandler creates a new activity handler.
+func NewActivityHandler(service *activityusecase.Service, logger *slog.Logger) *ActivityHandler {
+	return &ActivityHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// GetMyActivity handles GET /api/v1/players/me/activity
+// Returns the authenticated player's activity timeline, newest first.
+// Accepts ?limit= and ?offset= for pagination.
+func (h *ActivityHandler) GetMyActivity(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	playerID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	req := activityusecase.FeedRequest{}
+	for param, dst := range map[string]*int{"limit": &req.Limit, "offset": &req.Offset} {
+		v := r.URL.Query().Get(param)
+		if v == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid "+param)
+			return
+		}
+		*dst = n
+	}
+
+	feed, err := h.service.GetFeed(r.Context(), playerID, req)
+	if err != nil {
+		h.logger.Error("failed to get activity feed", "player_id", playerID, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "failed to get activity feed")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, feed)
+}
+
+// GetRecent handles GET /api/v1/activity/recent
+// Public endpoint. Returns the newest site-wide events (verified matches,
+// finished tournaments, tier promotions). Accepts ?limit=.
+func (h *ActivityHandler) GetRecent(w http.ResponseWriter, r *http.Request) {
+	limit := 0
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+		limit = n
+	}
+
+	events, err := h.service.GetRecent(r.Context(), limit)
+	if err != nil {
+		h.logger.Error("failed to get recent activity", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "failed to get recent activity")
+		return
+	}
+
+	w.Header().Set("Cache-Control", recentActivityCacheControl)
+	h.jsonResponse(w, http.StatusOK, pagination.All(events))
+}
+
+// jsonResponse writes a JSON response.
+func (h *ActivityHandler) jsonResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("failed to encode response", "error", err)
+	}
+}
+
+// errorResponse writes an error response.
+func (h *ActivityHandler) errorResponse(w http.ResponseWriter, status int, message string) {
+	h.jsonResponse(w, status, map[string]string{"error": message})
+}
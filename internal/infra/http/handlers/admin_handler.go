@@ -5,9 +5,19 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
-
+	"strconv"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/note"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
 	"github.com/alejaam/tourney-rank/internal/domain/user"
+	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
 	"github.com/alejaam/tourney-rank/internal/usecase/admin"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
+	"github.com/google/uuid"
 )
 
 // AdminHandler handles HTTP requests for admin operations.
@@ -15,6 +25,7 @@ type AdminHandler struct {
 	userService   *admin.UserService
 	gameService   *admin.GameService
 	playerService *admin.PlayerService
+	noteService   *admin.NoteService
 	logger        *slog.Logger
 }
 
@@ -23,12 +34,14 @@ func NewAdminHandler(
 	userService *admin.UserService,
 	gameService *admin.GameService,
 	playerService *admin.PlayerService,
+	noteService *admin.NoteService,
 	logger *slog.Logger,
 ) *AdminHandler {
 	return &AdminHandler{
 		userService:   userService,
 		gameService:   gameService,
 		playerService: playerService,
+		noteService:   noteService,
 		logger:        logger,
 	}
 }
@@ -36,17 +49,79 @@ func NewAdminHandler(
 // ============= USER MANAGEMENT =============
 
 // ListUsers handles GET /api/admin/users
+// Accepts ?role=, ?q= (username or email), ?created_from= and ?created_to=
+// (RFC 3339 or YYYY-MM-DD), ?banned=, ?sort=created_at|username|email,
+// ?order=asc|desc, ?limit= and ?offset=.
 func (h *AdminHandler) ListUsers(w http.ResponseWriter, r *http.Request) {
-	res, err := h.userService.ListUsers(r.Context())
+	q := r.URL.Query()
+	req := admin.ListUsersRequest{
+		Role:       q.Get("role"),
+		Search:     q.Get("q"),
+		Sort:       q.Get("sort"),
+		Descending: q.Get("order") == "desc",
+	}
+
+	var err error
+	if req.CreatedFrom, err = parseDateParam(q.Get("created_from")); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid created_from")
+		return
+	}
+	if req.CreatedTo, err = parseDateParam(q.Get("created_to")); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid created_to")
+		return
+	}
+	if v := q.Get("banned"); v != "" {
+		banned, err := strconv.ParseBool(v)
+		if err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid banned")
+			return
+		}
+		req.Banned = &banned
+	}
+	if v := q.Get("order"); v != "" && v != "asc" && v != "desc" {
+		h.errorResponse(w, http.StatusBadRequest, "order must be asc or desc")
+		return
+	}
+	if v := q.Get("limit"); v != "" {
+		if req.Limit, err = strconv.ParseInt(v, 10, 64); err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+	}
+	if v := q.Get("offset"); v != "" {
+		if req.Offset, err = strconv.ParseInt(v, 10, 64); err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+	}
+
+	res, err := h.userService.ListUsers(r.Context(), req)
 	if err != nil {
-		h.logger.Error("failed to list users", "error", err)
-		h.errorResponse(w, http.StatusInternalServerError, "failed to list users")
+		switch {
+		case errors.Is(err, user.ErrInvalidRole), errors.Is(err, user.ErrInvalidListSort), errors.Is(err, user.ErrInvalidDateRange):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		default:
+			h.logger.Error("failed to list users", "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "failed to list users")
+		}
 		return
 	}
 
 	h.jsonResponse(w, http.StatusOK, res)
 }
 
+// GetConsentReport handles GET /api/admin/consent/report
+func (h *AdminHandler) GetConsentReport(w http.ResponseWriter, r *http.Request) {
+	report, err := h.userService.GetConsentReport(r.Context())
+	if err != nil {
+		h.logger.Error("failed to build consent report", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "failed to build consent report")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, report)
+}
+
 // GetUser handles GET /api/admin/users/:id
 func (h *AdminHandler) GetUser(w http.ResponseWriter, r *http.Request) {
 	id := r.PathValue("id")
@@ -191,6 +266,41 @@ func (h *AdminHandler) UpdateGame(w http.ResponseWriter, r *http.Request) {
 	h.jsonResponse(w, http.StatusOK, g)
 }
 
+// SimulateRanking handles POST /api/admin/games/:id/ranking/simulate
+func (h *AdminHandler) SimulateRanking(w http.ResponseWriter, r *http.Request) {
+	id := r.PathValue("id")
+	if id == "" {
+		h.errorResponse(w, http.StatusBadRequest, "game id is required")
+		return
+	}
+
+	var req admin.SimulateRankingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	report, err := h.gameService.SimulateRanking(r.Context(), id, req)
+	if err != nil {
+		if errors.Is(err, game.ErrNotFound) {
+			h.errorResponse(w, http.StatusNotFound, "game not found")
+			return
+		}
+		if errors.Is(err, admin.ErrNoCandidateConfig) ||
+			errors.Is(err, admin.ErrInvalidSampleSize) ||
+			errors.Is(err, game.ErrInvalidRankingWeights) ||
+			errors.Is(err, game.ErrInvalidRankingFormula) {
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		h.logger.Error("failed to simulate ranking", "id", id, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "failed to simulate ranking")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, report)
+}
+
 // DeleteGame handles DELETE /api/admin/games/:id
 func (h *AdminHandler) DeleteGame(w http.ResponseWriter, r *http.Request) {
 	id := r.PathValue("id")
@@ -211,11 +321,68 @@ func (h *AdminHandler) DeleteGame(w http.ResponseWriter, r *http.Request) {
 // ============= PLAYER MANAGEMENT =============
 
 // ListPlayers handles GET /api/admin/players
+// Accepts ?banned=, ?region=, ?platform=, ?game_id=, ?has_stats=,
+// ?include_stats=, ?sort=display_name|created_at|matches_played|ranking_score,
+// ?order=asc|desc, ?limit= and ?offset=. Sorting by a stats field requires
+// ?game_id= and includes the stats summary.
 func (h *AdminHandler) ListPlayers(w http.ResponseWriter, r *http.Request) {
-	res, err := h.playerService.ListPlayers(r.Context())
+	q := r.URL.Query()
+	req := admin.ListPlayersRequest{
+		Region:     q.Get("region"),
+		Platform:   q.Get("platform"),
+		GameID:     q.Get("game_id"),
+		Sort:       q.Get("sort"),
+		Descending: q.Get("order") == "desc",
+	}
+
+	var err error
+	if v := q.Get("banned"); v != "" {
+		banned, err := strconv.ParseBool(v)
+		if err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid banned")
+			return
+		}
+		req.Banned = &banned
+	}
+	if v := q.Get("has_stats"); v != "" {
+		if req.HasStats, err = strconv.ParseBool(v); err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid has_stats")
+			return
+		}
+	}
+	if v := q.Get("include_stats"); v != "" {
+		if req.IncludeStats, err = strconv.ParseBool(v); err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid include_stats")
+			return
+		}
+	}
+	if v := q.Get("order"); v != "" && v != "asc" && v != "desc" {
+		h.errorResponse(w, http.StatusBadRequest, "order must be asc or desc")
+		return
+	}
+	if v := q.Get("limit"); v != "" {
+		if req.Limit, err = strconv.ParseInt(v, 10, 64); err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid limit")
+			return
+		}
+	}
+	if v := q.Get("offset"); v != "" {
+		if req.Offset, err = strconv.ParseInt(v, 10, 64); err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid offset")
+			return
+		}
+	}
+
+	res, err := h.playerService.ListPlayers(r.Context(), req)
 	if err != nil {
-		h.logger.Error("failed to list players", "error", err)
-		h.errorResponse(w, http.StatusInternalServerError, "failed to list players")
+		switch {
+		case errors.Is(err, player.ErrInvalidListSort), errors.Is(err, player.ErrInvalidPlatform),
+			errors.Is(err, admin.ErrInvalidGameID), errors.Is(err, admin.ErrStatsSortRequiresGame):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		default:
+			h.logger.Error("failed to list players", "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "failed to list players")
+		}
 		return
 	}
 
@@ -237,7 +404,17 @@ func (h *AdminHandler) GetPlayer(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	h.jsonResponse(w, http.StatusOK, p)
+	notes, err := h.noteService.ListNotes(r.Context(), note.SubjectPlayer, p.ID)
+	if err != nil {
+		h.logger.Error("failed to list player notes", "id", id, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "failed to list notes")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, struct {
+		*player.Player
+		Notes []*note.Note `json:"notes"`
+	}{p, notes})
 }
 
 // CreatePlayer handles POST /api/admin/players
@@ -335,6 +512,72 @@ func (h *AdminHandler) DeletePlayer(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
+// ============= NOTES =============
+
+// ListNotes returns a handler for GET /api/v1/admin/{matches,players,teams}/{id}/notes
+func (h *AdminHandler) ListNotes(subject note.Subject) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		subjectID, err := uuid.Parse(r.PathValue("id"))
+		if err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid "+string(subject)+" id")
+			return
+		}
+
+		notes, err := h.noteService.ListNotes(r.Context(), subject, subjectID)
+		if err != nil {
+			h.logger.Error("failed to list notes", "subject", subject, "id", subjectID, "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "failed to list notes")
+			return
+		}
+
+		h.jsonResponse(w, http.StatusOK, pagination.All(notes))
+	}
+}
+
+// AddNote returns a handler for POST /api/v1/admin/{matches,players,teams}/{id}/notes
+func (h *AdminHandler) AddNote(subject note.Subject) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		subjectID, err := uuid.Parse(r.PathValue("id"))
+		if err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid "+string(subject)+" id")
+			return
+		}
+
+		userInfo, ok := middleware.GetUserInfo(r.Context())
+		if !ok {
+			h.errorResponse(w, http.StatusUnauthorized, "authentication required")
+			return
+		}
+		authorID, err := uuid.Parse(userInfo.ID)
+		if err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid user id")
+			return
+		}
+
+		var req admin.AddNoteRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+			return
+		}
+
+		n, err := h.noteService.AddNote(r.Context(), subject, subjectID, authorID, req)
+		if err != nil {
+			switch {
+			case errors.Is(err, note.ErrEmptyBody), errors.Is(err, note.ErrBodyTooLong):
+				h.errorResponse(w, http.StatusBadRequest, err.Error())
+			case errors.Is(err, match.ErrNotFound), errors.Is(err, player.ErrNotFound), errors.Is(err, team.ErrNotFound):
+				h.errorResponse(w, http.StatusNotFound, string(subject)+" not found")
+			default:
+				h.logger.Error("failed to add note", "subject", subject, "id", subjectID, "error", err)
+				h.errorResponse(w, http.StatusInternalServerError, "failed to add note")
+			}
+			return
+		}
+
+		h.jsonResponse(w, http.StatusCreated, n)
+	}
+}
+
 // ============= HELPER METHODS =============
 
 // jsonResponse writes a JSON response.
@@ -350,3 +593,17 @@ func (h *AdminHandler) jsonResponse(w http.ResponseWriter, status int, data inte
 func (h *AdminHandler) errorResponse(w http.ResponseWriter, status int, message string) {
 	h.jsonResponse(w, status, map[string]string{"error": message})
 }
+
+// parseDateParam parses an optional RFC 3339 timestamp or YYYY-MM-DD date.
+func parseDateParam(value string) (*time.Time, error) {
+	if value == "" {
+		return nil, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		if t, err = time.Parse(time.DateOnly, value); err != nil {
+			return nil, err
+		}
+	}
+	return &t, nil
+}
@@ -4,8 +4,10 @@ import (
 	"encoding/json"
 	"errors"
 	"log/slog"
+	"net"
 	"net/http"
 
+	"github.com/alejaam/tourney-rank/internal/domain/user"
 	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
 	"github.com/alejaam/tourney-rank/internal/usecase/auth"
 	userusecase "github.com/alejaam/tourney-rank/internal/usecase/user"
@@ -36,8 +38,17 @@ func (h *AuthHandler) Register(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	req.RemoteIP = remoteIP(r)
+
 	res, err := h.service.Register(r.Context(), req)
 	if err != nil {
+		if h.passwordError(w, err) || h.captchaError(w, err) {
+			return
+		}
+		if errors.Is(err, user.ErrConsentRequired) || errors.Is(err, user.ErrStaleConsentVersion) {
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		h.logger.Error("failed to register user", "error", err)
 		h.errorResponse(w, http.StatusConflict, err.Error()) // Assumption: error is duplicate logic
 		return
@@ -54,8 +65,17 @@ func (h *AuthHandler) Login(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	req.RemoteIP = remoteIP(r)
+
 	res, err := h.service.Login(r.Context(), req)
 	if err != nil {
+		if h.captchaError(w, err) {
+			return
+		}
+		if errors.Is(err, user.ErrAccountDeactivated) || errors.Is(err, user.ErrReactivationExpired) {
+			h.errorResponse(w, http.StatusForbidden, err.Error())
+			return
+		}
 		if errors.Is(err, auth.ErrInvalidCredentials) {
 			h.errorResponse(w, http.StatusUnauthorized, "invalid credentials")
 			return
@@ -100,6 +120,250 @@ func (h *AuthHandler) GetMe(w http.ResponseWriter, r *http.Request) {
 	h.jsonResponse(w, http.StatusOK, user)
 }
 
+// ChangeEmail starts switching the current user to a new email address.
+// POST /api/v1/users/me/email
+func (h *AuthHandler) ChangeEmail(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	var req auth.ChangeEmailRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.service.RequestEmailChange(r.Context(), userID, req); err != nil {
+		h.emailChangeError(w, err)
+		return
+	}
+
+	h.jsonResponse(w, http.StatusAccepted, map[string]string{"status": "verification_sent"})
+}
+
+// ConfirmEmailChange switches a user to the new address a verification token was sent to.
+// POST /api/v1/auth/email/confirm
+func (h *AuthHandler) ConfirmEmailChange(w http.ResponseWriter, r *http.Request) {
+	var req struct {
+		Token string `json:"token"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Token == "" {
+		h.errorResponse(w, http.StatusBadRequest, "token is required")
+		return
+	}
+
+	u, err := h.service.ConfirmEmailChange(r.Context(), req.Token)
+	if err != nil {
+		h.emailChangeError(w, err)
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, u)
+}
+
+// ChangePassword replaces the current user's password.
+// POST /api/v1/users/me/password
+func (h *AuthHandler) ChangePassword(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	var req auth.ChangePasswordRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.service.ChangePassword(r.Context(), userID, req); err != nil {
+		if h.passwordError(w, err) {
+			return
+		}
+		if errors.Is(err, auth.ErrInvalidCredentials) {
+			h.errorResponse(w, http.StatusUnauthorized, "invalid password")
+			return
+		}
+		h.logger.Error("failed to change password", "user_id", userID, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// passwordError writes a 400 for password policy and breach errors, listing
+// each broken rule under "details". It reports whether err was handled.
+func (h *AuthHandler) passwordError(w http.ResponseWriter, err error) bool {
+	var policyErr *user.PasswordPolicyError
+	switch {
+	case errors.As(err, &policyErr):
+		h.jsonResponse(w, http.StatusBadRequest, map[string]interface{}{
+			"error":   policyErr.Error(),
+			"details": policyErr.Violations,
+		})
+	case errors.Is(err, user.ErrBreachedPassword):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		return false
+	}
+	return true
+}
+
+// DeactivateAccount deactivates the current user's account.
+// POST /api/v1/users/me/deactivate
+func (h *AuthHandler) DeactivateAccount(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	var req auth.DeactivateAccountRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	if err := h.service.DeactivateAccount(r.Context(), userID, req); err != nil {
+		switch {
+		case errors.Is(err, auth.ErrInvalidCredentials):
+			h.errorResponse(w, http.StatusUnauthorized, "invalid password")
+		case errors.Is(err, user.ErrAlreadyDeactivated):
+			h.errorResponse(w, http.StatusConflict, err.Error())
+		default:
+			h.logger.Error("failed to deactivate account", "user_id", userID, "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "internal server error")
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetConsent returns the current user's standing on each policy.
+// GET /api/v1/users/me/consent
+func (h *AuthHandler) GetConsent(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	res, err := h.service.GetConsent(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to get consent", "user_id", userID, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, res)
+}
+
+// AcceptPolicies records the current user accepting the current policy versions.
+// POST /api/v1/users/me/consent
+func (h *AuthHandler) AcceptPolicies(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	var req auth.AcceptPoliciesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+	req.RemoteIP = remoteIP(r)
+
+	res, err := h.service.AcceptPolicies(r.Context(), userID, req)
+	if err != nil {
+		if errors.Is(err, user.ErrConsentRequired) || errors.Is(err, user.ErrStaleConsentVersion) {
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		h.logger.Error("failed to accept policies", "user_id", userID, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "internal server error")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, res)
+}
+
+// captchaError writes the response for CAPTCHA errors. It reports whether err was handled.
+func (h *AuthHandler) captchaError(w http.ResponseWriter, err error) bool {
+	switch {
+	case errors.Is(err, auth.ErrCaptchaRequired), errors.Is(err, auth.ErrCaptchaFailed):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, auth.ErrCaptchaUnavailable):
+		h.logger.Error("captcha verification unavailable", "error", err)
+		h.errorResponse(w, http.StatusServiceUnavailable, auth.ErrCaptchaUnavailable.Error())
+	default:
+		return false
+	}
+	return true
+}
+
+// remoteIP returns the client address of a request, without the port.
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// emailChangeError maps email change errors to HTTP responses.
+func (h *AuthHandler) emailChangeError(w http.ResponseWriter, err error) {
+	switch {
+	case errors.Is(err, auth.ErrInvalidCredentials):
+		h.errorResponse(w, http.StatusUnauthorized, "invalid password")
+	case errors.Is(err, user.ErrInvalidEmailAddress), errors.Is(err, user.ErrEmailUnchanged):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, user.ErrEmailTaken):
+		h.errorResponse(w, http.StatusConflict, err.Error())
+	case errors.Is(err, user.ErrEmailChangeNotFound), errors.Is(err, user.ErrEmailChangeExpired):
+		h.errorResponse(w, http.StatusBadRequest, "invalid or expired token")
+	case errors.Is(err, auth.ErrEmailChangeUnavailable):
+		h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+	default:
+		h.logger.Error("failed to change email", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "internal server error")
+	}
+}
+
 // jsonResponse writes a JSON response.
 func (h *AuthHandler) jsonResponse(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
@@ -0,0 +1,122 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	capturedomain "github.com/alejaam/tourney-rank/internal/domain/capture"
+	captureusecase "github.com/alejaam/tourney-rank/internal/usecase/capture"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
+	"github.com/google/uuid"
+)
+
+// CaptureHandler handles HTTP requests for captured API requests.
+type CaptureHandler struct {
+	service *captureusecase.Service
+	logger  *slog.Logger
+}
+
+// NewCaptureHandler creates a new request capture handler.
+func NewCaptureHandler(service *captureusecase.Service, logger *slog.Logger) *CaptureHandler {
+	return &CaptureHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// ListCaptures handles GET /api/v1/admin/request-captures?kind=&limit=
+func (h *CaptureHandler) ListCaptures(w http.ResponseWriter, r *http.Request) {
+	var limit int64
+	if value := r.URL.Query().Get("limit"); value != "" {
+		parsed, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || parsed < 1 {
+			h.errorResponse(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+		limit = parsed
+	}
+
+	captures, err := h.service.List(r.Context(), capturedomain.Kind(r.URL.Query().Get("kind")), limit)
+	if err != nil {
+		h.captureError(w, err, "Failed to list request captures")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(captures))
+}
+
+// GetCapture handles GET /api/v1/admin/request-captures/{id}
+func (h *CaptureHandler) GetCapture(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.pathID(w, r)
+	if !ok {
+		return
+	}
+
+	c, err := h.service.Get(r.Context(), id)
+	if err != nil {
+		h.captureError(w, err, "Failed to get request capture")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, c)
+}
+
+// ReplayCapture handles POST /api/v1/admin/request-captures/{id}/replay
+// The captured submission is checked again without storing anything.
+func (h *CaptureHandler) ReplayCapture(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.pathID(w, r)
+	if !ok {
+		return
+	}
+
+	result, err := h.service.Replay(r.Context(), id)
+	if err != nil {
+		h.captureError(w, err, "Failed to replay request capture")
+		return
+	}
+
+	h.logger.Info("request capture replayed", "id", id, "accepted", result.Accepted)
+	h.jsonResponse(w, http.StatusOK, result)
+}
+
+// captureError maps request capture errors to responses, logging unexpected ones.
+func (h *CaptureHandler) captureError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, capturedomain.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Request capture not found")
+	case errors.Is(err, capturedomain.ErrInvalidKind):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, capturedomain.ErrNotReplayable):
+		h.errorResponse(w, http.StatusUnprocessableEntity, err.Error())
+	default:
+		h.logger.Error(message, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, message)
+	}
+}
+
+// pathID parses the id path value, writing an error response on failure.
+func (h *CaptureHandler) pathID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request capture ID")
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// jsonResponse writes a JSON response.
+func (h *CaptureHandler) jsonResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("failed to encode response", "error", err)
+	}
+}
+
+// errorResponse writes an error response.
+func (h *CaptureHandler) errorResponse(w http.ResponseWriter, status int, message string) {
+	h.jsonResponse(w, status, map[string]string{"error": message})
+}
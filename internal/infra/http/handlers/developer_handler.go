@@ -0,0 +1,151 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/alejaam/tourney-rank/internal/domain/developer"
+	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
+	developerusecase "github.com/alejaam/tourney-rank/internal/usecase/developer"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
+	"github.com/google/uuid"
+)
+
+// DeveloperHandler handles HTTP requests for public API keys and their usage.
+type DeveloperHandler struct {
+	service *developerusecase.Service
+	logger  *slog.Logger
+}
+
+// NewDeveloperHandler creates a new developer handler.
+func NewDeveloperHandler(service *developerusecase.Service, logger *slog.Logger) *DeveloperHandler {
+	return &DeveloperHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// CreateKey handles POST /api/v1/developers/me/keys
+// Issues the authenticated user an API key. The key is only shown in this
+// response.
+func (h *DeveloperHandler) CreateKey(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	var req developerusecase.CreateKeyRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	key, err := h.service.CreateKey(r.Context(), userID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, developer.ErrInvalidKeyName):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, developer.ErrTooManyKeys):
+			h.errorResponse(w, http.StatusConflict, err.Error())
+		default:
+			h.logger.Error("failed to create API key", "user_id", userID, "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "failed to create API key")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, key)
+}
+
+// ListKeys handles GET /api/v1/developers/me/keys
+// Returns the authenticated user's API keys, revoked ones included.
+func (h *DeveloperHandler) ListKeys(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	keys, err := h.service.ListKeys(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to list API keys", "user_id", userID, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "failed to list API keys")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(keys))
+}
+
+// RevokeKey handles DELETE /api/v1/developers/me/keys/{id}
+func (h *DeveloperHandler) RevokeKey(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	keyID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid key id")
+		return
+	}
+
+	if err := h.service.RevokeKey(r.Context(), userID, keyID); err != nil {
+		if errors.Is(err, developer.ErrKeyNotFound) {
+			h.errorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		h.logger.Error("failed to revoke API key", "key_id", keyID, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "failed to revoke API key")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetUsage handles GET /api/v1/developers/me/usage
+// Returns the quota every key gets and each active key's daily usage.
+func (h *DeveloperHandler) GetUsage(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	usage, err := h.service.Usage(r.Context(), userID)
+	if err != nil {
+		h.logger.Error("failed to get API usage", "user_id", userID, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "failed to get API usage")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, usage)
+}
+
+func (h *DeveloperHandler) userID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "authentication required")
+		return uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid user id")
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
+// jsonResponse writes a JSON response.
+func (h *DeveloperHandler) jsonResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("failed to encode response", "error", err)
+	}
+}
+
+// errorResponse writes an error response.
+func (h *DeveloperHandler) errorResponse(w http.ResponseWriter, status int, message string) {
+	h.jsonResponse(w, status, map[string]string{"error": message})
+}
@@ -2,6 +2,7 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log/slog"
@@ -12,16 +13,27 @@ import (
 
 	"github.com/alejaam/tourney-rank/internal/domain/game"
 	"github.com/alejaam/tourney-rank/internal/infra/mongodb"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
 )
 
+// GameStore is the game persistence the GameHandler needs.
+type GameStore interface {
+	Create(ctx context.Context, g *game.Game) error
+	GetByID(ctx context.Context, id string) (*game.Game, error)
+	GetBySlug(ctx context.Context, slug string) (*game.Game, error)
+	List(ctx context.Context, activeOnly bool) ([]*game.Game, error)
+	SetActive(ctx context.Context, id uuid.UUID, active bool) error
+	Delete(ctx context.Context, id string) error
+}
+
 // GameHandler handles HTTP requests for game resources.
 type GameHandler struct {
-	repo   *mongodb.GameRepository
+	repo   GameStore
 	logger *slog.Logger
 }
 
 // NewGameHandler creates a new GameHandler.
-func NewGameHandler(repo *mongodb.GameRepository, logger *slog.Logger) *GameHandler {
+func NewGameHandler(repo GameStore, logger *slog.Logger) *GameHandler {
 	return &GameHandler{
 		repo:   repo,
 		logger: logger,
@@ -46,6 +58,10 @@ type GameResponse struct {
 	Description      string                 `json:"description"`
 	StatSchema       map[string]interface{} `json:"stat_schema"`
 	RankingWeights   map[string]float64     `json:"ranking_weights"`
+	RankingFormula   *game.RankingFormula   `json:"ranking_formula,omitempty"`
+	Modes            []game.Mode            `json:"modes,omitempty"`
+	Maps             []string               `json:"maps,omitempty"`
+	Playlists        []string               `json:"playlists,omitempty"`
 	PlatformIDFormat string                 `json:"platform_id_format"`
 	IsActive         bool                   `json:"is_active"`
 	CreatedAt        string                 `json:"created_at"`
@@ -53,10 +69,7 @@ type GameResponse struct {
 }
 
 // ListGamesResponse represents the response for listing games.
-type ListGamesResponse struct {
-	Games []GameResponse `json:"games"`
-	Total int            `json:"total"`
-}
+type ListGamesResponse = pagination.Page[GameResponse]
 
 // List handles GET /api/v1/games
 func (h *GameHandler) List(w http.ResponseWriter, r *http.Request) {
@@ -72,16 +85,12 @@ func (h *GameHandler) List(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	response := ListGamesResponse{
-		Games: make([]GameResponse, 0, len(games)),
-		Total: len(games),
-	}
-
+	items := make([]GameResponse, 0, len(games))
 	for _, g := range games {
-		response.Games = append(response.Games, toGameResponse(g))
+		items = append(items, toGameResponse(g))
 	}
 
-	h.jsonResponse(w, http.StatusOK, response)
+	h.jsonResponse(w, http.StatusOK, pagination.All(items))
 }
 
 // GetByID handles GET /api/v1/games/{id}
@@ -148,10 +157,13 @@ func (h *GameHandler) Create(w http.ResponseWriter, r *http.Request) {
 	for key, val := range req.StatSchema {
 		if field, ok := val.(map[string]interface{}); ok {
 			statSchema[key] = game.StatField{
-				Type:  getString(field, "type"),
-				Min:   field["min"],
-				Max:   field["max"],
-				Label: getString(field, "label"),
+				Type:    getString(field, "type"),
+				Min:     field["min"],
+				Max:     field["max"],
+				Label:   getString(field, "label"),
+				Cap:     getFloat(field, "cap"),
+				Scale:   getFloat(field, "scale"),
+				Formula: getString(field, "formula"),
 			}
 		}
 	}
@@ -288,12 +300,18 @@ func (h *GameHandler) errorResponse(w http.ResponseWriter, status int, message s
 func toGameResponse(g *game.Game) GameResponse {
 	statSchema := make(map[string]interface{})
 	for key, field := range g.StatSchema {
-		statSchema[key] = map[string]interface{}{
+		schemaField := map[string]interface{}{
 			"type":  field.Type,
 			"min":   field.Min,
 			"max":   field.Max,
 			"label": field.Label,
+			"cap":   field.Cap,
+			"scale": field.Scale,
+		}
+		if field.IsDerived() {
+			schemaField["formula"] = field.Formula
 		}
+		statSchema[key] = schemaField
 	}
 
 	return GameResponse{
@@ -303,6 +321,10 @@ func toGameResponse(g *game.Game) GameResponse {
 		Description:      g.Description,
 		StatSchema:       statSchema,
 		RankingWeights:   g.RankingWeights,
+		RankingFormula:   g.RankingFormula,
+		Modes:            g.Modes,
+		Maps:             g.Maps,
+		Playlists:        g.Playlists,
 		PlatformIDFormat: g.PlatformIDFormat,
 		IsActive:         g.IsActive,
 		CreatedAt:        g.CreatedAt.Format("2006-01-02T15:04:05Z"),
@@ -319,3 +341,13 @@ func getString(m map[string]interface{}, key string) string {
 	}
 	return ""
 }
+
+// getFloat safely extracts a number from a map.
+func getFloat(m map[string]interface{}, key string) float64 {
+	if val, ok := m[key]; ok {
+		if f, ok := val.(float64); ok {
+			return f
+		}
+	}
+	return 0
+}
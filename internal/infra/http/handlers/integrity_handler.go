@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	integritydomain "github.com/alejaam/tourney-rank/internal/domain/integrity"
+	integrityusecase "github.com/alejaam/tourney-rank/internal/usecase/integrity"
+)
+
+// IntegrityHandler handles HTTP requests for data integrity reports.
+type IntegrityHandler struct {
+	service *integrityusecase.Service
+	logger  *slog.Logger
+}
+
+// NewIntegrityHandler creates a new integrity handler.
+func NewIntegrityHandler(service *integrityusecase.Service, logger *slog.Logger) *IntegrityHandler {
+	return &IntegrityHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// GetReport handles GET /api/v1/admin/integrity
+// Returns the latest integrity report.
+func (h *IntegrityHandler) GetReport(w http.ResponseWriter, r *http.Request) {
+	report, err := h.service.Latest(r.Context())
+	if err != nil {
+		if errors.Is(err, integritydomain.ErrReportNotFound) {
+			h.errorResponse(w, http.StatusNotFound, err.Error())
+			return
+		}
+		h.logger.Error("failed to get integrity report", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "failed to get integrity report")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, report)
+}
+
+// RunCheck handles POST /api/v1/admin/integrity
+// Runs the integrity checks immediately. Accepts ?repair=true to also remove
+// the dangling records found.
+func (h *IntegrityHandler) RunCheck(w http.ResponseWriter, r *http.Request) {
+	repair := false
+	if v := r.URL.Query().Get("repair"); v != "" {
+		b, err := strconv.ParseBool(v)
+		if err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid repair")
+			return
+		}
+		repair = b
+	}
+
+	report, err := h.service.Run(r.Context(), repair)
+	if err != nil {
+		h.logger.Error("failed to run integrity check", "error", err, "repair", repair)
+		h.errorResponse(w, http.StatusInternalServerError, "failed to run integrity check")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, report)
+}
+
+// jsonResponse writes a JSON response.
+func (h *IntegrityHandler) jsonResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("failed to encode response", "error", err)
+	}
+}
+
+// errorResponse writes an error response.
+func (h *IntegrityHandler) errorResponse(w http.ResponseWriter, status int, message string) {
+	h.jsonResponse(w, status, map[string]string{"error": message})
+}
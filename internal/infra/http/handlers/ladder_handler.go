@@ -0,0 +1,505 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	gamedomain "github.com/alejaam/tourney-rank/internal/domain/game"
+	ladderdomain "github.com/alejaam/tourney-rank/internal/domain/ladder"
+	teamdomain "github.com/alejaam/tourney-rank/internal/domain/team"
+	tournamentdomain "github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
+	ladderusecase "github.com/alejaam/tourney-rank/internal/usecase/ladder"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
+	"github.com/google/uuid"
+)
+
+// LadderHandler handles HTTP requests for ladder competition.
+type LadderHandler struct {
+	service *ladderusecase.Service
+	logger  *slog.Logger
+}
+
+// NewLadderHandler creates a new ladder handler.
+func NewLadderHandler(service *ladderusecase.Service, logger *slog.Logger) *LadderHandler {
+	return &LadderHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// respondToChallengeRequest is the body of a challenge response.
+type respondToChallengeRequest struct {
+	Accept bool `json:"accept"`
+}
+
+// CreateLadder handles POST /api/v1/admin/ladders
+func (h *LadderHandler) CreateLadder(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+
+	var req ladderusecase.CreateLadderRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	l, err := h.service.CreateLadder(r.Context(), req, adminID)
+	if err != nil {
+		switch {
+		case errors.Is(err, gamedomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Game not found")
+		case errors.Is(err, ladderdomain.ErrLadderExists):
+			h.errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, ladderdomain.ErrInvalidGame),
+			errors.Is(err, ladderdomain.ErrInvalidName),
+			errors.Is(err, ladderdomain.ErrInvalidSeasonLength),
+			errors.Is(err, ladderdomain.ErrInvalidRange),
+			errors.Is(err, ladderdomain.ErrInvalidForfeitPenalty),
+			errors.Is(err, tournamentdomain.ErrInvalidTeamSize):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		default:
+			h.logger.Error("Failed to create ladder", "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to create ladder")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, l)
+}
+
+// ListLadders handles GET /api/v1/ladders
+func (h *LadderHandler) ListLadders(w http.ResponseWriter, r *http.Request) {
+	ladders, err := h.service.ListLadders(r.Context())
+	if err != nil {
+		h.logger.Error("Failed to list ladders", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "Failed to list ladders")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(ladders))
+}
+
+// GetLadder handles GET /api/v1/ladders/{id}
+func (h *LadderHandler) GetLadder(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.pathID(w, r, "id", "Invalid ladder ID")
+	if !ok {
+		return
+	}
+
+	l, err := h.service.GetLadder(r.Context(), id)
+	if err != nil {
+		h.ladderError(w, err, "Failed to get ladder")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, l)
+}
+
+// JoinLadder handles POST /api/v1/ladders/{id}/teams/{teamId}
+func (h *LadderHandler) JoinLadder(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+	id, ok := h.pathID(w, r, "id", "Invalid ladder ID")
+	if !ok {
+		return
+	}
+	teamID, ok := h.pathID(w, r, "teamId", "Invalid team ID")
+	if !ok {
+		return
+	}
+
+	l, err := h.service.JoinLadder(r.Context(), id, teamID, playerID)
+	if err != nil {
+		h.ladderError(w, err, "Failed to join ladder")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, l)
+}
+
+// LeaveLadder handles DELETE /api/v1/ladders/{id}/teams/{teamId}
+func (h *LadderHandler) LeaveLadder(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+	id, ok := h.pathID(w, r, "id", "Invalid ladder ID")
+	if !ok {
+		return
+	}
+	teamID, ok := h.pathID(w, r, "teamId", "Invalid team ID")
+	if !ok {
+		return
+	}
+
+	if _, err := h.service.LeaveLadder(r.Context(), id, teamID, playerID); err != nil {
+		h.ladderError(w, err, "Failed to leave ladder")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CreateChallenge handles POST /api/v1/ladders/{id}/challenges
+func (h *LadderHandler) CreateChallenge(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+	id, ok := h.pathID(w, r, "id", "Invalid ladder ID")
+	if !ok {
+		return
+	}
+
+	var req ladderusecase.ChallengeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	c, err := h.service.CreateChallenge(r.Context(), id, req, playerID)
+	if err != nil {
+		h.ladderError(w, err, "Failed to create challenge")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, c)
+}
+
+// RespondToChallenge handles POST /api/v1/ladders/{id}/challenges/{challengeId}/respond
+func (h *LadderHandler) RespondToChallenge(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+	challengeID, ok := h.pathID(w, r, "challengeId", "Invalid challenge ID")
+	if !ok {
+		return
+	}
+
+	var req respondToChallengeRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	c, err := h.service.RespondToChallenge(r.Context(), challengeID, playerID, req.Accept)
+	if err != nil {
+		h.ladderError(w, err, "Failed to respond to challenge")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, c)
+}
+
+// ReportForfeit handles POST /api/v1/ladders/{id}/challenges/{challengeId}/forfeit
+func (h *LadderHandler) ReportForfeit(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+	challengeID, ok := h.pathID(w, r, "challengeId", "Invalid challenge ID")
+	if !ok {
+		return
+	}
+
+	var req ladderusecase.ForfeitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	c, err := h.service.ReportForfeit(r.Context(), challengeID, req, playerID)
+	if err != nil {
+		h.ladderError(w, err, "Failed to report forfeit")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, c)
+}
+
+// ContestForfeit handles POST /api/v1/ladders/{id}/challenges/{challengeId}/forfeit/contest
+func (h *LadderHandler) ContestForfeit(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+	challengeID, ok := h.pathID(w, r, "challengeId", "Invalid challenge ID")
+	if !ok {
+		return
+	}
+
+	var req ladderusecase.ContestForfeitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	c, err := h.service.ContestForfeit(r.Context(), challengeID, req, playerID)
+	if err != nil {
+		h.ladderError(w, err, "Failed to contest forfeit")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, c)
+}
+
+// DecideForfeit handles POST /api/v1/admin/ladders/{id}/challenges/{challengeId}/forfeit/decision
+func (h *LadderHandler) DecideForfeit(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+	challengeID, ok := h.pathID(w, r, "challengeId", "Invalid challenge ID")
+	if !ok {
+		return
+	}
+
+	var req ladderusecase.DecideForfeitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	c, err := h.service.DecideForfeit(r.Context(), challengeID, req, adminID)
+	if err != nil {
+		h.ladderError(w, err, "Failed to decide forfeit")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, c)
+}
+
+// ProposeReschedule handles POST /api/v1/ladders/{id}/challenges/{challengeId}/reschedule
+func (h *LadderHandler) ProposeReschedule(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+	challengeID, ok := h.pathID(w, r, "challengeId", "Invalid challenge ID")
+	if !ok {
+		return
+	}
+
+	var req ladderusecase.RescheduleRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	c, err := h.service.ProposeReschedule(r.Context(), challengeID, req, playerID)
+	if err != nil {
+		h.ladderError(w, err, "Failed to propose reschedule")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, c)
+}
+
+// RespondToReschedule handles POST /api/v1/ladders/{id}/challenges/{challengeId}/reschedule/respond
+func (h *LadderHandler) RespondToReschedule(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+	challengeID, ok := h.pathID(w, r, "challengeId", "Invalid challenge ID")
+	if !ok {
+		return
+	}
+
+	var req ladderusecase.RescheduleResponse
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	c, err := h.service.RespondToReschedule(r.Context(), challengeID, req, playerID)
+	if err != nil {
+		h.ladderError(w, err, "Failed to respond to reschedule")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, c)
+}
+
+// OverrideSchedule handles POST /api/v1/admin/ladders/{id}/challenges/{challengeId}/schedule
+func (h *LadderHandler) OverrideSchedule(w http.ResponseWriter, r *http.Request) {
+	adminID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+	challengeID, ok := h.pathID(w, r, "challengeId", "Invalid challenge ID")
+	if !ok {
+		return
+	}
+
+	var req ladderusecase.ScheduleOverrideRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	c, err := h.service.OverrideSchedule(r.Context(), challengeID, req, adminID)
+	if err != nil {
+		h.ladderError(w, err, "Failed to override schedule")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, c)
+}
+
+// ListChallenges handles GET /api/v1/ladders/{id}/challenges?season=
+func (h *LadderHandler) ListChallenges(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.pathID(w, r, "id", "Invalid ladder ID")
+	if !ok {
+		return
+	}
+	season, ok := h.season(w, r)
+	if !ok {
+		return
+	}
+
+	challenges, err := h.service.ListChallenges(r.Context(), id, season)
+	if err != nil {
+		h.ladderError(w, err, "Failed to list challenges")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(challenges))
+}
+
+// ListSnapshots handles GET /api/v1/ladders/{id}/snapshots?season=
+func (h *LadderHandler) ListSnapshots(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.pathID(w, r, "id", "Invalid ladder ID")
+	if !ok {
+		return
+	}
+	season, ok := h.season(w, r)
+	if !ok {
+		return
+	}
+
+	snapshots, err := h.service.ListSnapshots(r.Context(), id, season)
+	if err != nil {
+		h.ladderError(w, err, "Failed to list snapshots")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(snapshots))
+}
+
+// ladderError maps ladder errors to responses, logging unexpected ones.
+func (h *LadderHandler) ladderError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, ladderdomain.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Ladder not found")
+	case errors.Is(err, ladderdomain.ErrChallengeNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Challenge not found")
+	case errors.Is(err, ladderdomain.ErrForfeitNotFound),
+		errors.Is(err, ladderdomain.ErrRescheduleNotFound):
+		h.errorResponse(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, teamdomain.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Team not found")
+	case errors.Is(err, teamdomain.ErrNotCaptain),
+		errors.Is(err, ladderdomain.ErrNotChallengedTeam),
+		errors.Is(err, ladderdomain.ErrNotChallengeTeam),
+		errors.Is(err, ladderdomain.ErrNotForfeitedTeam),
+		errors.Is(err, ladderdomain.ErrNotRescheduleResponder):
+		h.errorResponse(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, ladderdomain.ErrAlreadyOnLadder),
+		errors.Is(err, ladderdomain.ErrChallengeInProgress),
+		errors.Is(err, ladderdomain.ErrChallengeNotPending),
+		errors.Is(err, ladderdomain.ErrChallengeNotResolving),
+		errors.Is(err, ladderdomain.ErrForfeitOpen),
+		errors.Is(err, ladderdomain.ErrForfeitNotPending),
+		errors.Is(err, ladderdomain.ErrRescheduleOpen),
+		errors.Is(err, ladderdomain.ErrChallengeDeadlinePassed),
+		errors.Is(err, ladderdomain.ErrConflict):
+		h.errorResponse(w, http.StatusConflict, err.Error())
+	case errors.Is(err, ladderdomain.ErrNotOnLadder),
+		errors.Is(err, ladderdomain.ErrNotSeasonTeam),
+		errors.Is(err, ladderdomain.ErrTeamInactive),
+		errors.Is(err, ladderdomain.ErrChallengeOutOfRange),
+		errors.Is(err, ladderdomain.ErrSameTeam),
+		errors.Is(err, ladderdomain.ErrForfeitNoteTooLong),
+		errors.Is(err, ladderdomain.ErrInvalidPlayBy),
+		errors.Is(err, ladderdomain.ErrRescheduleReasonTooLong):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		h.logger.Error(message, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, message)
+	}
+}
+
+// pathID parses a UUID path value, writing an error response on failure.
+func (h *LadderHandler) pathID(w http.ResponseWriter, r *http.Request, name, message string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(r.PathValue(name))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, message)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// season parses the optional season query parameter, writing an error response on failure.
+func (h *LadderHandler) season(w http.ResponseWriter, r *http.Request) (int, bool) {
+	value := r.URL.Query().Get("season")
+	if value == "" {
+		return 0, true
+	}
+
+	season, err := strconv.Atoi(value)
+	if err != nil || season < 1 {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid season")
+		return 0, false
+	}
+	return season, true
+}
+
+// playerID extracts the authenticated player ID, writing an error response on failure.
+func (h *LadderHandler) playerID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return uuid.Nil, false
+	}
+
+	playerID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return playerID, true
+}
+
+// jsonResponse writes a JSON response.
+func (h *LadderHandler) jsonResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("failed to encode response", "error", err)
+	}
+}
+
+// errorResponse writes an error response.
+func (h *LadderHandler) errorResponse(w http.ResponseWriter, status int, message string) {
+	h.jsonResponse(w, status, map[string]string{"error": message})
+}
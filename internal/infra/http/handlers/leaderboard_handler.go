@@ -7,26 +7,33 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/alejaam/tourney-rank/internal/domain/game"
 	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/season"
 	"github.com/alejaam/tourney-rank/internal/usecase/leaderboard"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
 	"github.com/google/uuid"
 )
 
 // LeaderboardHandler handles HTTP requests for leaderboard resources.
 type LeaderboardHandler struct {
 	service *leaderboard.Service
+	syncs   *leaderboard.SyncService
 	logger  *slog.Logger
 }
 
 // NewLeaderboardHandler creates a new LeaderboardHandler.
-func NewLeaderboardHandler(service *leaderboard.Service, logger *slog.Logger) *LeaderboardHandler {
+func NewLeaderboardHandler(service *leaderboard.Service, syncs *leaderboard.SyncService, logger *slog.Logger) *LeaderboardHandler {
 	return &LeaderboardHandler{
 		service: service,
+		syncs:   syncs,
 		logger:  logger,
 	}
 }
 
 // GetLeaderboard handles GET /api/v1/leaderboard/{gameId}
+// ?ranking=competition gives tied ratings the same rank (1, 2, 2, 4), and
+// ?season_id= ranks a season's stats instead of all-time stats.
 func (h *LeaderboardHandler) GetLeaderboard(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -45,7 +52,7 @@ func (h *LeaderboardHandler) GetLeaderboard(w http.ResponseWriter, r *http.Reque
 	}
 
 	// Parse pagination params
-	limit := parseIntParam(r, "limit", 50)
+	limit := parseIntParam(r, "limit", leaderboard.DefaultPageSize)
 	offset := parseIntParam(r, "offset", 0)
 
 	// Clamp limit
@@ -53,30 +60,55 @@ func (h *LeaderboardHandler) GetLeaderboard(w http.ResponseWriter, r *http.Reque
 		limit = 100
 	}
 	if limit < 1 {
-		limit = 50
+		limit = leaderboard.DefaultPageSize
 	}
 	if offset < 0 {
 		offset = 0
 	}
 
+	sortBy, err := player.ParseLeaderboardSort(r.URL.Query().Get("sort"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "sort must be score or conservative")
+		return
+	}
+
+	ranking, err := player.ParseRankingMode(r.URL.Query().Get("ranking"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "ranking must be ordinal or competition")
+		return
+	}
+
+	scope, err := statsScope(r)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid season id format")
+		return
+	}
+
 	// Get leaderboard
-	entries, gameName, total, err := h.service.GetLeaderboard(ctx, gameID, limit, offset)
+	entries, gameName, total, err := h.service.GetLeaderboard(ctx, gameID, scope, sortBy, ranking, limit, offset)
 	if err != nil {
+		if errors.Is(err, game.ErrInvalidMode) {
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, season.ErrNotFound) {
+			h.errorResponse(w, http.StatusNotFound, "season not found")
+			return
+		}
 		h.logger.Error("failed to get leaderboard", "game_id", gameID, "error", err)
 		h.errorResponse(w, http.StatusInternalServerError, "failed to get leaderboard")
 		return
 	}
 
-	response := map[string]interface{}{
-		"game_id":   gameID.String(),
-		"game_name": gameName,
-		"entries":   entries,
-		"total":     total,
-		"limit":     limit,
-		"offset":    offset,
-	}
-
-	h.jsonResponse(w, http.StatusOK, response)
+	h.jsonResponse(w, http.StatusOK, leaderboard.Page{
+		Page:     pagination.New(entries, total, limit, offset),
+		GameID:   gameID.String(),
+		GameName: gameName,
+		Sort:     sortBy,
+		Ranking:  ranking,
+		Mode:     scope.Mode,
+		SeasonID: leaderboard.SeasonRef(scope),
+	})
 }
 
 // GetLeaderboardByTier handles GET /api/v1/leaderboard/{gameId}/tier/{tier}
@@ -101,22 +133,54 @@ func (h *LeaderboardHandler) GetLeaderboardByTier(w http.ResponseWriter, r *http
 	// Parse pagination
 	limit := parseIntParam(r, "limit", 50)
 
+	sortBy, err := player.ParseLeaderboardSort(r.URL.Query().Get("sort"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "sort must be score or conservative")
+		return
+	}
+
+	ranking, err := player.ParseRankingMode(r.URL.Query().Get("ranking"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "ranking must be ordinal or competition")
+		return
+	}
+
+	scope, err := statsScope(r)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid season id format")
+		return
+	}
+
 	// Get leaderboard by tier
-	entries, err := h.service.GetLeaderboardByTier(ctx, gameID, tierStr, limit)
+	entries, total, err := h.service.GetLeaderboardByTier(ctx, gameID, scope, tierStr, sortBy, ranking, limit)
+	if errors.Is(err, season.ErrNotFound) {
+		h.errorResponse(w, http.StatusNotFound, "season not found")
+		return
+	}
 	if err != nil {
 		h.logger.Error("failed to get leaderboard by tier", "game_id", gameID, "tier", tierStr, "error", err)
 		h.errorResponse(w, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	response := map[string]interface{}{
-		"game_id": gameID.String(),
-		"tier":    tierStr,
-		"entries": entries,
-		"limit":   limit,
-	}
-
-	h.jsonResponse(w, http.StatusOK, response)
+	// The tier's top entries, out of every player in the tier; it is not paged further.
+	h.jsonResponse(w, http.StatusOK, struct {
+		pagination.Page[leaderboard.LeaderboardEntry]
+		GameID   string                 `json:"game_id"`
+		Tier     string                 `json:"tier"`
+		Sort     player.LeaderboardSort `json:"sort"`
+		Ranking  player.RankingMode     `json:"ranking"`
+		Mode     string                 `json:"mode"`
+		SeasonID *uuid.UUID             `json:"season_id,omitempty"`
+	}{
+		Page:     pagination.New(entries, total, limit, 0),
+		GameID:   gameID.String(),
+		Tier:     tierStr,
+		Sort:     sortBy,
+		Ranking:  ranking,
+		Mode:     scope.Mode,
+		SeasonID: leaderboard.SeasonRef(scope),
+	})
 }
 
 // GetPlayerRank handles GET /api/v1/leaderboard/{gameId}/player/{playerId}
@@ -144,11 +208,21 @@ func (h *LeaderboardHandler) GetPlayerRank(w http.ResponseWriter, r *http.Reques
 		return
 	}
 
+	scope, err := statsScope(r)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid season id format")
+		return
+	}
+
 	// Get player rank
-	rankResp, err := h.service.GetPlayerRank(ctx, playerID, gameID)
+	rankResp, err := h.service.GetPlayerRank(ctx, playerID, gameID, scope)
 	if err != nil {
 		h.logger.Error("failed to get player rank", "game_id", gameID, "player_id", playerID, "error", err)
-		if errors.Is(err, player.ErrStatsNotFound) {
+		if errors.Is(err, game.ErrInvalidMode) {
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		} else if errors.Is(err, season.ErrNotFound) {
+			h.errorResponse(w, http.StatusNotFound, "season not found")
+		} else if errors.Is(err, player.ErrStatsNotFound) {
 			h.errorResponse(w, http.StatusNotFound, "player has no stats for this game")
 		} else {
 			h.errorResponse(w, http.StatusInternalServerError, "failed to get player rank")
@@ -176,9 +250,23 @@ func (h *LeaderboardHandler) GetTierDistribution(w http.ResponseWriter, r *http.
 		return
 	}
 
+	scope, err := statsScope(r)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid season id format")
+		return
+	}
+
 	// Get tier distribution
-	distribution, total, err := h.service.GetTierDistribution(ctx, gameID)
+	distribution, total, err := h.service.GetTierDistribution(ctx, gameID, scope)
 	if err != nil {
+		if errors.Is(err, game.ErrInvalidMode) {
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if errors.Is(err, season.ErrNotFound) {
+			h.errorResponse(w, http.StatusNotFound, "season not found")
+			return
+		}
 		h.logger.Error("failed to get tier distribution", "game_id", gameID, "error", err)
 		h.errorResponse(w, http.StatusInternalServerError, "failed to get tier distribution")
 		return
@@ -188,11 +276,129 @@ func (h *LeaderboardHandler) GetTierDistribution(w http.ResponseWriter, r *http.
 		"game_id":       gameID.String(),
 		"distribution":  distribution,
 		"total_players": total,
+		"mode":          scope.Mode,
+	}
+	if scope.SeasonID != uuid.Nil {
+		response["season_id"] = scope.SeasonID
 	}
 
 	h.jsonResponse(w, http.StatusOK, response)
 }
 
+// ListSyncs handles GET /api/v1/admin/games/{id}/leaderboard-syncs
+func (h *LeaderboardHandler) ListSyncs(w http.ResponseWriter, r *http.Request) {
+	gameID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid game id format")
+		return
+	}
+
+	syncs, err := h.syncs.ListSyncs(r.Context(), gameID)
+	if err != nil {
+		h.logger.Error("failed to list leaderboard syncs", "game_id", gameID, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "failed to list leaderboard syncs")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(syncs))
+}
+
+// CreateSync handles POST /api/v1/admin/games/{id}/leaderboard-syncs
+func (h *LeaderboardHandler) CreateSync(w http.ResponseWriter, r *http.Request) {
+	gameID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid game id format")
+		return
+	}
+
+	var req leaderboard.SyncRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	sync, err := h.syncs.CreateSync(r.Context(), gameID, req)
+	if err != nil {
+		h.syncError(w, err, "failed to create leaderboard sync")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, sync)
+}
+
+// UpdateSync handles PUT /api/v1/admin/leaderboard-syncs/{id}
+// An empty credential keeps the stored one.
+func (h *LeaderboardHandler) UpdateSync(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid sync id format")
+		return
+	}
+
+	var req leaderboard.SyncRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	sync, err := h.syncs.UpdateSync(r.Context(), id, req)
+	if err != nil {
+		h.syncError(w, err, "failed to update leaderboard sync")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, sync)
+}
+
+// DeleteSync handles DELETE /api/v1/admin/leaderboard-syncs/{id}
+func (h *LeaderboardHandler) DeleteSync(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid sync id format")
+		return
+	}
+
+	if err := h.syncs.DeleteSync(r.Context(), id); err != nil {
+		h.syncError(w, err, "failed to delete leaderboard sync")
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// RunSync handles POST /api/v1/admin/leaderboard-syncs/{id}/run
+// Pushes the snapshot immediately; the outcome is recorded on the returned sync.
+func (h *LeaderboardHandler) RunSync(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid sync id format")
+		return
+	}
+
+	sync, err := h.syncs.RunSync(r.Context(), id)
+	if err != nil {
+		h.syncError(w, err, "failed to run leaderboard sync")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, sync)
+}
+
+// syncError maps leaderboard sync errors to HTTP responses.
+func (h *LeaderboardHandler) syncError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, player.ErrLeaderboardSyncNotFound):
+		h.errorResponse(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, game.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "game not found")
+	case errors.Is(err, player.ErrInvalidLeaderboardSync), errors.Is(err, game.ErrInvalidMode):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		h.logger.Error(message, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, message)
+	}
+}
+
 // jsonResponse writes a JSON response.
 func (h *LeaderboardHandler) jsonResponse(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
@@ -207,6 +413,20 @@ func (h *LeaderboardHandler) errorResponse(w http.ResponseWriter, status int, me
 	h.jsonResponse(w, status, map[string]string{"error": message})
 }
 
+// statsScope reads the ?mode= and ?season_id= query parameters. Without them
+// the leaderboards rank all-time stats across all modes.
+func statsScope(r *http.Request) (player.StatsScope, error) {
+	scope := player.StatsScope{Mode: r.URL.Query().Get("mode")}
+	if raw := r.URL.Query().Get("season_id"); raw != "" {
+		id, err := uuid.Parse(raw)
+		if err != nil {
+			return player.StatsScope{}, err
+		}
+		scope.SeasonID = id
+	}
+	return scope, nil
+}
+
 // parseIntParam parses an integer query parameter with a default value.
 func parseIntParam(r *http.Request, name string, defaultVal int64) int64 {
 	val := r.URL.Query().Get(name)
@@ -4,15 +4,23 @@ package handlers
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/alejaam/tourney-rank/internal/domain/game"
 	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/domain/user"
 	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
 	usecasematch "github.com/alejaam/tourney-rank/internal/usecase/match"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
 )
 
 // MatchHandler handles HTTP requests for match resources.
@@ -30,7 +38,8 @@ func NewMatchHandler(logger *slog.Logger, service *usecasematch.Service) *MatchH
 }
 
 // HandleSubmitMatch handles POST /api/v1/matches
-// Requires authentication. Team captain submits match report.
+// Requires authentication. The team captain or a member granted the
+// can_submit permission submits the match report.
 func (h *MatchHandler) HandleSubmitMatch(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -40,7 +49,7 @@ func (h *MatchHandler) HandleSubmitMatch(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	captainID, err := uuid.Parse(userInfo.ID)
+	submitterID, err := uuid.Parse(userInfo.ID)
 	if err != nil {
 		h.errorResponse(w, http.StatusBadRequest, "invalid user id")
 		return
@@ -52,7 +61,7 @@ func (h *MatchHandler) HandleSubmitMatch(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	resp, err := h.service.SubmitMatch(ctx, req, captainID)
+	resp, err := h.service.SubmitMatch(ctx, req, submitterID)
 	if err != nil {
 		h.handleMatchError(w, err)
 		return
@@ -62,6 +71,46 @@ func (h *MatchHandler) HandleSubmitMatch(w http.ResponseWriter, r *http.Request)
 	h.jsonResponse(w, http.StatusCreated, resp)
 }
 
+// HandleResubmitMatch handles PUT /api/v1/matches/{id}
+// Requires authentication. The team captain or a designated submitter
+// corrects a rejected match, which goes back to review as a draft.
+func (h *MatchHandler) HandleResubmitMatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userInfo, ok := middleware.GetUserInfo(ctx)
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	submitterID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	matchID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	var req usecasematch.ResubmitMatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.service.ResubmitMatch(ctx, matchID, req, submitterID)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.logger.Info("match resubmitted", "id", resp.ID, "revisions", len(resp.Revisions))
+	h.jsonResponse(w, http.StatusOK, resp)
+}
+
 // HandleGetTournamentMatches handles GET /api/v1/tournaments/{tournament_id}/matches
 // Public endpoint. Returns verified matches for a tournament.
 func (h *MatchHandler) HandleGetTournamentMatches(w http.ResponseWriter, r *http.Request) {
@@ -97,6 +146,8 @@ func (h *MatchHandler) HandleGetTournamentMatches(w http.ResponseWriter, r *http
 
 // HandleGetPlayerMatches handles GET /api/v1/players/me/matches
 // Requires authentication. Returns match history for the authenticated player.
+// Only verified matches are returned unless ?include_unverified=true is set;
+// each match carries its submission status.
 func (h *MatchHandler) HandleGetPlayerMatches(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -114,10 +165,12 @@ func (h *MatchHandler) HandleGetPlayerMatches(w http.ResponseWriter, r *http.Req
 
 	limit := h.parseIntQueryParam(r, "limit", 10)
 	offset := h.parseIntQueryParam(r, "offset", 0)
+	includeUnverified, _ := strconv.ParseBool(r.URL.Query().Get("include_unverified"))
 
 	resp, err := h.service.GetMatchHistory(ctx, playerID, usecasematch.MatchHistoryRequest{
-		Limit:  limit,
-		Offset: offset,
+		Limit:             limit,
+		Offset:            offset,
+		IncludeUnverified: includeUnverified,
 	})
 	if err != nil {
 		h.logger.Error("failed to get player matches", "error", err)
@@ -128,16 +181,245 @@ func (h *MatchHandler) HandleGetPlayerMatches(w http.ResponseWriter, r *http.Req
 	h.jsonResponse(w, http.StatusOK, resp)
 }
 
+// HandleGetTeamMatches handles GET /api/v1/teams/{id}/matches
+// Requires authentication. Returns a team's matches to its members, including
+// pending drafts. Accepts ?status=draft|verified|rejected.
+func (h *MatchHandler) HandleGetTeamMatches(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	teamID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid team id")
+		return
+	}
+
+	status, err := match.ParseStatus(r.URL.Query().Get("status"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid status")
+		return
+	}
+
+	viewerID, isAdmin := viewer(r)
+	resp, err := h.service.GetTeamMatches(ctx, teamID, viewerID, isAdmin, status, usecasematch.MatchHistoryRequest{
+		Limit:  h.parseIntQueryParam(r, "limit", 20),
+		Offset: h.parseIntQueryParam(r, "offset", 0),
+	})
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, resp)
+}
+
+// HandleScoutTeam handles GET /api/v1/teams/{id}/scout
+// Requires authentication. Returns opponent intel on a team from its verified
+// matches, with the head-to-head record against the requester's team.
+func (h *MatchHandler) HandleScoutTeam(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	teamID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid team id")
+		return
+	}
+
+	requesterID, _ := viewer(r)
+	resp, err := h.service.ScoutTeam(ctx, teamID, requesterID)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, resp)
+}
+
 // HandleGetMatch handles GET /api/v1/matches/{id}
-// Public endpoint. Returns a single match by ID.
+// Public endpoint. Returns a single match with team, tournament, game and
+// player names. Unverified matches are only returned to admins and members
+// of the submitting team.
 func (h *MatchHandler) HandleGetMatch(w http.ResponseWriter, r *http.Request) {
-	// This would be implemented if you need direct match lookup
-	// For now, this is a placeholder
-	h.errorResponse(w, http.StatusNotImplemented, "not implemented")
+	ctx := r.Context()
+
+	matchID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	viewerID, isAdmin := viewer(r)
+	resp, err := h.service.GetMatch(ctx, matchID, viewerID, isAdmin)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, resp)
+}
+
+// HandleGetMatchPlayer handles GET /api/v1/matches/{id}/players/{playerId}
+// Public endpoint. Returns a player's line from a match, their share of the
+// team's kills and their per-match averages in the game for comparison.
+// Follows the same visibility rules as HandleGetMatch.
+func (h *MatchHandler) HandleGetMatchPlayer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	matchID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	playerID, err := uuid.Parse(r.PathValue("playerId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid player id")
+		return
+	}
+
+	viewerID, isAdmin := viewer(r)
+	resp, err := h.service.GetMatchPlayer(ctx, matchID, playerID, viewerID, isAdmin)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, resp)
+}
+
+// playerHeatmapCacheControl lets browsers and CDNs cache profile heatmaps as
+// long as the service does.
+const playerHeatmapCacheControl = "public, max-age=600"
+
+// HandleGetPlayerHeatmap handles GET /api/v1/leaderboard/{gameId}/player/{playerId}/heatmap
+// Public endpoint. Returns the verified matches a player played each day of the
+// last ?weeks= weeks (default 52) in a game, for profile contribution graphs.
+func (h *MatchHandler) HandleGetPlayerHeatmap(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	gameID, err := uuid.Parse(r.PathValue("gameId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid game id")
+		return
+	}
+
+	playerID, err := uuid.Parse(r.PathValue("playerId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid player id")
+		return
+	}
+
+	weeks := h.parseIntQueryParam(r, "weeks", match.DefaultHeatmapWeeks)
+	resp, err := h.service.GetPlayerHeatmap(ctx, playerID, gameID, weeks)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	w.Header().Set("Cache-Control", playerHeatmapCacheControl)
+	h.jsonResponse(w, http.StatusOK, resp)
+}
+
+// HandleGetMatchComments handles GET /api/v1/matches/{id}/comments
+// Requires authentication. Returns the match's comment thread, oldest first,
+// to members of the submitting team and admins.
+func (h *MatchHandler) HandleGetMatchComments(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	matchID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	viewerID, isAdmin := viewer(r)
+	comments, err := h.service.GetComments(ctx, matchID, viewerID, isAdmin)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(comments))
+}
+
+// HandleAddMatchComment handles POST /api/v1/matches/{id}/comments
+// Requires authentication. Members of the submitting team and admins can
+// post, e.g. to clarify a report during verification.
+func (h *MatchHandler) HandleAddMatchComment(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	matchID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	var req usecasematch.AddCommentRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	authorID, isAdmin := viewer(r)
+	comment, err := h.service.AddComment(ctx, matchID, authorID, isAdmin, req)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, comment)
+}
+
+// HandleGetMatchHonors handles GET /api/v1/matches/{id}/honors
+// Returns the match's MVP votes and commends.
+func (h *MatchHandler) HandleGetMatchHonors(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	matchID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	summary, err := h.service.GetMatchHonors(ctx, matchID)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, summary)
+}
+
+// HandleVoteMatchHonors handles POST /api/v1/matches/{id}/honors
+// Requires authentication. Players of a verified match vote a teammate MVP
+// and commend teammates, once per match.
+func (h *MatchHandler) HandleVoteMatchHonors(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	matchID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	var req usecasematch.HonorVoteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	voterID, _ := viewer(r)
+	summary, err := h.service.VoteHonors(ctx, matchID, voterID, req)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, summary)
 }
 
 // HandleGetUnverifiedMatches handles GET /api/v1/admin/matches/unverified
-// Requires admin authentication. Returns unverified matches for review.
+// Requires admin authentication. Returns unverified matches for review,
+// with each match's comment thread.
 func (h *MatchHandler) HandleGetUnverifiedMatches(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
@@ -207,6 +489,339 @@ func (h *MatchHandler) HandleVerifyMatch(w http.ResponseWriter, r *http.Request)
 	h.jsonResponse(w, http.StatusOK, resp)
 }
 
+// HandleAmendMatch handles POST /api/v1/admin/matches/{id}/amendments
+// Requires admin authentication. Corrects the result of a verified match.
+func (h *MatchHandler) HandleAmendMatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userInfo, ok := middleware.GetUserInfo(ctx)
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	adminID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	matchID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	var req usecasematch.AmendMatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.service.AmendMatch(ctx, matchID, req, adminID)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.logger.Info("match amended", "id", resp.ID, "admin_id", adminID)
+	h.jsonResponse(w, http.StatusOK, resp)
+}
+
+// HandleUnverifyMatch handles POST /api/v1/admin/matches/{id}/unverify
+// Requires admin authentication. Reverts a match approved by mistake to draft.
+func (h *MatchHandler) HandleUnverifyMatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userInfo, ok := middleware.GetUserInfo(ctx)
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	adminID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	matchID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	var req usecasematch.UnverifyMatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.service.UnverifyMatch(ctx, matchID, req, adminID)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.logger.Info("match unverified", "id", resp.ID, "admin_id", adminID)
+	h.jsonResponse(w, http.StatusOK, resp)
+}
+
+// HandleVoidMatch handles PATCH /api/v1/admin/matches/{id}/void
+// Requires admin authentication. Voids a verified match and reverses its
+// contribution to the players' stats.
+func (h *MatchHandler) HandleVoidMatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userInfo, ok := middleware.GetUserInfo(ctx)
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	adminID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	matchID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid match id")
+		return
+	}
+
+	var req usecasematch.VoidMatchRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.service.VoidMatch(ctx, matchID, req, adminID)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.logger.Info("match voided", "id", resp.ID, "admin_id", adminID)
+	h.jsonResponse(w, http.StatusOK, resp)
+}
+
+// Headers carried by signed connector submissions.
+const (
+	ConnectorIDHeader        = "X-TourneyRank-Connector"
+	ConnectorTimestampHeader = "X-TourneyRank-Timestamp" // Unix seconds
+	ConnectorNonceHeader     = "X-TourneyRank-Nonce"
+	ConnectorSignatureHeader = "X-TourneyRank-Signature" // Hex HMAC-SHA256 of "<timestamp>.<nonce>.<body>"
+)
+
+// maxConnectorBodyBytes bounds signed connector submissions.
+const maxConnectorBodyBytes = 1 << 20
+
+// HandleConnectorSubmitMatch handles POST /api/v1/connectors/matches
+// Authenticated by the connector signature headers instead of a user token.
+// The body is a match report, stored as a draft for admin verification.
+func (h *MatchHandler) HandleConnectorSubmitMatch(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	connectorID, err := uuid.Parse(r.Header.Get(ConnectorIDHeader))
+	if err != nil {
+		h.errorResponse(w, http.StatusUnauthorized, "invalid connector signature")
+		return
+	}
+
+	unix, err := strconv.ParseInt(r.Header.Get(ConnectorTimestampHeader), 10, 64)
+	if err != nil {
+		h.errorResponse(w, http.StatusUnauthorized, "invalid connector signature")
+		return
+	}
+
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxConnectorBodyBytes))
+	if err != nil {
+		h.errorResponse(w, http.StatusRequestEntityTooLarge, "request body too large")
+		return
+	}
+
+	resp, err := h.service.SubmitConnectorMatch(ctx, usecasematch.ConnectorSubmission{
+		ConnectorID: connectorID,
+		Timestamp:   time.Unix(unix, 0),
+		Nonce:       r.Header.Get(ConnectorNonceHeader),
+		Signature:   r.Header.Get(ConnectorSignatureHeader),
+		Body:        body,
+	})
+	if err != nil {
+		if !errors.Is(err, match.ErrInvalidConnectorSignature) {
+			h.logger.Warn("connector submission rejected", "connector_id", connectorID, "error", err)
+		}
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.logger.Info("connector match submitted", "id", resp.ID, "connector_id", connectorID, "tournament_id", resp.TournamentID)
+	h.jsonResponse(w, http.StatusCreated, resp)
+}
+
+// HandleListConnectors handles GET /api/v1/admin/connectors
+// Requires admin authentication. Secrets are never included.
+func (h *MatchHandler) HandleListConnectors(w http.ResponseWriter, r *http.Request) {
+	connectors, err := h.service.ListConnectors(r.Context())
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(connectors))
+}
+
+// HandleCreateConnector handles POST /api/v1/admin/connectors
+// Requires admin authentication. The response is the only time the new
+// connector's secret is shown.
+func (h *MatchHandler) HandleCreateConnector(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userInfo, ok := middleware.GetUserInfo(ctx)
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	adminID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	var req struct {
+		Name string `json:"name"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.service.CreateConnector(ctx, req.Name, adminID)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.logger.Info("connector created", "id", resp.ID, "name", resp.Name, "admin_id", adminID)
+	h.jsonResponse(w, http.StatusCreated, resp)
+}
+
+// HandleRotateConnector handles POST /api/v1/admin/connectors/{id}/rotate
+// Requires admin authentication. Returns the connector with its new secret.
+func (h *MatchHandler) HandleRotateConnector(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid connector id")
+		return
+	}
+
+	resp, err := h.service.RotateConnectorSecret(r.Context(), id)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.logger.Info("connector secret rotated", "id", id)
+	h.jsonResponse(w, http.StatusOK, resp)
+}
+
+// HandleRevokeConnector handles DELETE /api/v1/admin/connectors/{id}
+// Requires admin authentication. Revoked connectors can no longer submit.
+func (h *MatchHandler) HandleRevokeConnector(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid connector id")
+		return
+	}
+
+	resp, err := h.service.RevokeConnector(r.Context(), id)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.logger.Info("connector revoked", "id", id)
+	h.jsonResponse(w, http.StatusOK, resp)
+}
+
+// HandleCorrectStats handles POST /api/v1/admin/stat-corrections
+// Requires admin authentication. Applies a stat transform to every verified
+// match the filter selects, or with dry_run reports what it would change.
+func (h *MatchHandler) HandleCorrectStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	userInfo, ok := middleware.GetUserInfo(ctx)
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "authentication required")
+		return
+	}
+
+	adminID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid user id")
+		return
+	}
+
+	var req usecasematch.CorrectStatsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	resp, err := h.service.CorrectStats(ctx, req, adminID)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	if req.DryRun {
+		h.jsonResponse(w, http.StatusOK, resp)
+		return
+	}
+
+	h.logger.Info("stats corrected", "id", resp.ID, "game_id", resp.Filter.GameID, "matches", len(resp.Matches), "admin_id", adminID)
+	h.jsonResponse(w, http.StatusCreated, resp)
+}
+
+// HandleListStatCorrections handles GET /api/v1/admin/games/{id}/stat-corrections
+// Requires admin authentication.
+func (h *MatchHandler) HandleListStatCorrections(w http.ResponseWriter, r *http.Request) {
+	gameID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid game id")
+		return
+	}
+
+	corrections, err := h.service.ListStatCorrections(r.Context(), gameID)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(corrections))
+}
+
+// HandleGetStatCorrection handles GET /api/v1/admin/stat-corrections/{id}
+// Requires admin authentication.
+func (h *MatchHandler) HandleGetStatCorrection(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid stat correction id")
+		return
+	}
+
+	resp, err := h.service.GetStatCorrection(r.Context(), id)
+	if err != nil {
+		h.handleMatchError(w, err)
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, resp)
+}
+
 // Helper functions
 
 // jsonResponse marshals data to JSON and writes the response.
@@ -245,17 +860,59 @@ func (h *MatchHandler) parseIntQueryParam(r *http.Request, param string, default
 	return intValue
 }
 
+// viewer returns the ID and admin status of the optionally authenticated
+// caller. Anonymous callers get uuid.Nil.
+func viewer(r *http.Request) (uuid.UUID, bool) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		return uuid.Nil, false
+	}
+
+	id, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		id = uuid.Nil
+	}
+	return id, userInfo.Role == user.RoleAdmin
+}
+
 // handleMatchError converts domain errors to appropriate HTTP status codes.
 func (h *MatchHandler) handleMatchError(w http.ResponseWriter, err error) {
 	switch {
 	case errors.Is(err, match.ErrNotFound):
 		h.errorResponse(w, http.StatusNotFound, "match not found")
 
+	case errors.Is(err, team.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "team not found")
+
+	case errors.Is(err, player.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "player not found")
+
+	case errors.Is(err, game.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "game not found")
+
+	case errors.Is(err, match.ErrInvalidHeatmapWeeks):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+
+	case errors.Is(err, match.ErrNotTeamMember):
+		h.errorResponse(w, http.StatusForbidden, "only team members can view the team's matches")
+
+	case errors.Is(err, match.ErrCommentForbidden):
+		h.errorResponse(w, http.StatusForbidden, "only team members and admins can comment on this match")
+
+	case errors.Is(err, match.ErrEmptyComment):
+		h.errorResponse(w, http.StatusBadRequest, "comment cannot be empty")
+
+	case errors.Is(err, match.ErrCommentTooLong):
+		h.errorResponse(w, http.StatusBadRequest, fmt.Sprintf("comment cannot exceed %d characters", match.MaxCommentLength))
+
+	case errors.Is(err, match.ErrPlayerNotInMatch):
+		h.errorResponse(w, http.StatusNotFound, "player did not play in this match")
+
 	case errors.Is(err, match.ErrTournamentNotActive):
 		h.errorResponse(w, http.StatusBadRequest, "tournament is not active")
 
 	case errors.Is(err, match.ErrNotCaptain):
-		h.errorResponse(w, http.StatusForbidden, "only team captain can submit matches")
+		h.errorResponse(w, http.StatusForbidden, "only the team captain or designated submitters can submit matches")
 
 	case errors.Is(err, match.ErrPlayerNotInTeam):
 		h.errorResponse(w, http.StatusBadRequest, "player is not in the team")
@@ -269,12 +926,86 @@ func (h *MatchHandler) handleMatchError(w http.ResponseWriter, err error) {
 	case errors.Is(err, match.ErrInvalidKills):
 		h.errorResponse(w, http.StatusBadRequest, "kills cannot be negative")
 
+	case errors.Is(err, match.ErrInvalidAttachment):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+
+	case errors.Is(err, match.ErrTooManyAttachments):
+		h.errorResponse(w, http.StatusBadRequest, fmt.Sprintf("a match can have at most %d attachments", match.MaxAttachments))
+
 	case errors.Is(err, match.ErrInvalidPlayerStats):
 		h.errorResponse(w, http.StatusBadRequest, "invalid player statistics")
 
 	case errors.Is(err, match.ErrMatchNotDraft):
 		h.errorResponse(w, http.StatusBadRequest, "only draft matches can be verified")
 
+	case errors.Is(err, match.ErrMatchNotVerified):
+		h.errorResponse(w, http.StatusConflict, "match is not verified")
+
+	case errors.Is(err, match.ErrMatchNotRejected):
+		h.errorResponse(w, http.StatusConflict, "only rejected matches can be resubmitted")
+
+	case errors.Is(err, match.ErrInvalidAmendmentReason),
+		errors.Is(err, match.ErrAmendmentChangesRoster),
+		errors.Is(err, match.ErrAmendmentWithoutChanges),
+		errors.Is(err, match.ErrInvalidUnverifyReason),
+		errors.Is(err, match.ErrInvalidVoidReason):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+
+	case errors.Is(err, match.ErrHonorForbidden):
+		h.errorResponse(w, http.StatusForbidden, err.Error())
+
+	case errors.Is(err, match.ErrHonorVotingClosed),
+		errors.Is(err, match.ErrInvalidHonorVote):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+
+	case errors.Is(err, match.ErrAlreadyVoted):
+		h.errorResponse(w, http.StatusConflict, err.Error())
+
+	case errors.Is(err, usecasematch.ErrHonorsUnavailable):
+		h.errorResponse(w, http.StatusServiceUnavailable, "match honors are not available")
+
+	case errors.Is(err, match.ErrConnectorNotFound):
+		h.errorResponse(w, http.StatusNotFound, "connector not found")
+
+	case errors.Is(err, match.ErrInvalidConnectorName):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+
+	case errors.Is(err, match.ErrInvalidConnectorSignature),
+		errors.Is(err, match.ErrConnectorRevoked):
+		h.errorResponse(w, http.StatusUnauthorized, "invalid connector signature")
+
+	case errors.Is(err, match.ErrStaleConnectorSubmission),
+		errors.Is(err, match.ErrInvalidNonce):
+		h.errorResponse(w, http.StatusUnauthorized, err.Error())
+
+	case errors.Is(err, match.ErrReplayedConnectorSubmission):
+		h.errorResponse(w, http.StatusConflict, "submission has already been received")
+
+	case errors.Is(err, usecasematch.ErrInvalidConnectorPayload):
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+
+	case errors.Is(err, usecasematch.ErrConnectorsUnavailable):
+		h.errorResponse(w, http.StatusServiceUnavailable, "match connectors are not available")
+
+	case errors.Is(err, match.ErrCorrectionNotFound):
+		h.errorResponse(w, http.StatusNotFound, "stat correction not found")
+
+	case errors.Is(err, match.ErrInvalidCorrectionFilter),
+		errors.Is(err, match.ErrInvalidCorrectionField),
+		errors.Is(err, match.ErrInvalidCorrectionOp),
+		errors.Is(err, match.ErrInvalidCorrectionFactor),
+		errors.Is(err, match.ErrInvalidCorrectionReason),
+		errors.Is(err, match.ErrTooManyCorrectionMatches):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+
+	case errors.Is(err, usecasematch.ErrCorrectionsUnavailable):
+		h.errorResponse(w, http.StatusServiceUnavailable, "stat corrections are not available")
+
+	case errors.Is(err, game.ErrInvalidMode),
+		errors.Is(err, game.ErrInvalidMap),
+		errors.Is(err, game.ErrInvalidPlaylist):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+
 	default:
 		h.logger.Error("failed to process match", "error", err)
 		h.errorResponse(w, http.StatusInternalServerError, "internal server error")
@@ -0,0 +1,245 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	gamedomain "github.com/alejaam/tourney-rank/internal/domain/game"
+	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
+	matchmakingdomain "github.com/alejaam/tourney-rank/internal/domain/matchmaking"
+	playerdomain "github.com/alejaam/tourney-rank/internal/domain/player"
+	teamdomain "github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
+	matchmakingusecase "github.com/alejaam/tourney-rank/internal/usecase/matchmaking"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
+	"github.com/google/uuid"
+)
+
+// MatchmakingHandler handles HTTP requests for scrim matchmaking.
+type MatchmakingHandler struct {
+	service *matchmakingusecase.Service
+	logger  *slog.Logger
+}
+
+// NewMatchmakingHandler creates a new matchmaking handler.
+func NewMatchmakingHandler(service *matchmakingusecase.Service, logger *slog.Logger) *MatchmakingHandler {
+	return &MatchmakingHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// JoinQueue handles POST /api/v1/matchmaking/queue
+func (h *MatchmakingHandler) JoinQueue(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+
+	var req matchmakingusecase.JoinQueueRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	entry, err := h.service.JoinQueue(r.Context(), playerID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, gamedomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Game not found")
+		case errors.Is(err, teamdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Team not found")
+		case errors.Is(err, teamdomain.ErrNotCaptain):
+			h.errorResponse(w, http.StatusForbidden, err.Error())
+		case errors.Is(err, matchmakingdomain.ErrAlreadyQueued):
+			h.errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, matchmakingdomain.ErrGameNotActive),
+			errors.Is(err, matchmakingdomain.ErrInvalidGame):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		default:
+			h.logger.Error("Failed to join matchmaking queue", "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to join matchmaking queue")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, entry)
+}
+
+// LeaveQueue handles DELETE /api/v1/matchmaking/queue
+func (h *MatchmakingHandler) LeaveQueue(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.service.LeaveQueue(r.Context(), playerID); err != nil {
+		switch {
+		case errors.Is(err, matchmakingdomain.ErrEntryNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Not in a matchmaking queue")
+		case errors.Is(err, matchmakingdomain.ErrNotEntryOwner):
+			h.errorResponse(w, http.StatusForbidden, err.Error())
+		case errors.Is(err, matchmakingdomain.ErrEntryNotWaiting):
+			h.errorResponse(w, http.StatusConflict, err.Error())
+		default:
+			h.logger.Error("Failed to leave matchmaking queue", "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to leave matchmaking queue")
+		}
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// GetQueueStatus handles GET /api/v1/matchmaking/queue
+func (h *MatchmakingHandler) GetQueueStatus(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+
+	entry, err := h.service.GetQueueStatus(r.Context(), playerID)
+	if err != nil {
+		if errors.Is(err, matchmakingdomain.ErrEntryNotFound) {
+			h.errorResponse(w, http.StatusNotFound, "Not in a matchmaking queue")
+			return
+		}
+		h.logger.Error("Failed to get matchmaking queue status", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "Failed to get matchmaking queue status")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, entry)
+}
+
+// SuggestTeammates handles GET /api/v1/matchmaking/teammates?game_id=
+func (h *MatchmakingHandler) SuggestTeammates(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+
+	gameID, err := uuid.Parse(r.URL.Query().Get("game_id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid game ID")
+		return
+	}
+
+	suggestions, err := h.service.SuggestTeammates(r.Context(), playerID, gameID)
+	if err != nil {
+		switch {
+		case errors.Is(err, gamedomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Game not found")
+		case errors.Is(err, playerdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Player not found")
+		case errors.Is(err, matchmakingusecase.ErrSuggestionsUnavailable):
+			h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+		default:
+			h.logger.Error("Failed to suggest teammates", "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to suggest teammates")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(suggestions))
+}
+
+// GetLobby handles GET /api/v1/matchmaking/lobbies/{id}
+func (h *MatchmakingHandler) GetLobby(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid lobby ID")
+		return
+	}
+
+	lobby, err := h.service.GetLobby(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, matchmakingdomain.ErrLobbyNotFound) {
+			h.errorResponse(w, http.StatusNotFound, "Lobby not found")
+			return
+		}
+		h.logger.Error("Failed to get lobby", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "Failed to get lobby")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, lobby)
+}
+
+// ReportLobbyResult handles POST /api/v1/matchmaking/lobbies/{id}/results
+func (h *MatchmakingHandler) ReportLobbyResult(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.playerID(w, r)
+	if !ok {
+		return
+	}
+
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid lobby ID")
+		return
+	}
+
+	var req matchmakingusecase.ReportLobbyResultRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	lobby, err := h.service.ReportLobbyResult(r.Context(), id, playerID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, matchmakingdomain.ErrLobbyNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Lobby not found")
+		case errors.Is(err, matchmakingdomain.ErrNotInLobby):
+			h.errorResponse(w, http.StatusForbidden, err.Error())
+		case errors.Is(err, matchmakingdomain.ErrLobbyNotOpen):
+			h.errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, matchmakingdomain.ErrInvalidResults),
+			errors.Is(err, matchmakingdomain.ErrInvalidPlacement),
+			errors.Is(err, matchmakingdomain.ErrPlayerNotInResult),
+			errors.Is(err, matchdomain.ErrInvalidPlayerStats):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		default:
+			h.logger.Error("Failed to report lobby result", "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to report lobby result")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, lobby)
+}
+
+// playerID extracts the authenticated player ID, writing an error response on failure.
+func (h *MatchmakingHandler) playerID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return uuid.Nil, false
+	}
+
+	playerID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return playerID, true
+}
+
+// jsonResponse writes a JSON response.
+func (h *MatchmakingHandler) jsonResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("failed to encode response", "error", err)
+	}
+}
+
+// errorResponse writes an error response.
+func (h *MatchmakingHandler) errorResponse(w http.ResponseWriter, status int, message string) {
+	h.jsonResponse(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,319 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	organizationdomain "github.com/alejaam/tourney-rank/internal/domain/organization"
+	tournamentdomain "github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/domain/user"
+	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
+	organizationusecase "github.com/alejaam/tourney-rank/internal/usecase/organization"
+	"github.com/google/uuid"
+)
+
+// OrganizationHandler handles HTTP requests for organizations and their series.
+type OrganizationHandler struct {
+	service *organizationusecase.Service
+	logger  *slog.Logger
+}
+
+// NewOrganizationHandler creates a new organization handler.
+func NewOrganizationHandler(service *organizationusecase.Service, logger *slog.Logger) *OrganizationHandler {
+	return &OrganizationHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// CreateOrganization handles POST /api/v1/organizations
+// The requester becomes the organization's owner.
+func (h *OrganizationHandler) CreateOrganization(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	var req organizationusecase.CreateOrganizationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	o, err := h.service.CreateOrganization(r.Context(), req, userID)
+	if err != nil {
+		h.handleError(w, err, "Failed to create organization")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, o)
+}
+
+// GetOrganization handles GET /api/v1/organizations/{id}
+func (h *OrganizationHandler) GetOrganization(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid organization ID")
+		return
+	}
+
+	o, err := h.service.GetOrganization(r.Context(), id)
+	if err != nil {
+		h.handleError(w, err, "Failed to get organization")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, o)
+}
+
+// AddStaff handles POST /api/v1/organizations/{id}/staff
+// Owner and admins only.
+func (h *OrganizationHandler) AddStaff(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid organization ID")
+		return
+	}
+
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	var req organizationusecase.AddStaffRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	o, err := h.service.AddStaff(r.Context(), id, req, userID)
+	if err != nil {
+		h.handleError(w, err, "Failed to add staff")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, o)
+}
+
+// RemoveStaff handles DELETE /api/v1/organizations/{id}/staff/{userId}
+// The owner and admins can remove anyone; staff can remove themselves.
+func (h *OrganizationHandler) RemoveStaff(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid organization ID")
+		return
+	}
+
+	staffID, err := uuid.Parse(r.PathValue("userId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	o, err := h.service.RemoveStaff(r.Context(), id, staffID, userID)
+	if err != nil {
+		h.handleError(w, err, "Failed to remove staff")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, o)
+}
+
+// CreateSeries handles POST /api/v1/organizations/{id}/series
+// Owner and admins only.
+func (h *OrganizationHandler) CreateSeries(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid organization ID")
+		return
+	}
+
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	var req organizationusecase.CreateSeriesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	series, err := h.service.CreateSeries(r.Context(), id, req, userID)
+	if err != nil {
+		h.handleError(w, err, "Failed to create series")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, series)
+}
+
+// AddTournament handles PUT /api/v1/organizations/{id}/series/{seriesId}/tournaments/{tournamentId}
+// The requester must manage the organization and organize the tournament.
+func (h *OrganizationHandler) AddTournament(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid organization ID")
+		return
+	}
+
+	seriesID, err := uuid.Parse(r.PathValue("seriesId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid series ID")
+		return
+	}
+
+	tournamentID, err := uuid.Parse(r.PathValue("tournamentId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+	userInfo, _ := middleware.GetUserInfo(r.Context())
+
+	t, err := h.service.AddTournament(r.Context(), id, seriesID, tournamentID, userID, userInfo.Role == user.RoleAdmin)
+	if err != nil {
+		h.handleError(w, err, "Failed to add tournament to series")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, t)
+}
+
+// SetCircuit handles PUT /api/v1/organizations/{id}/series/{seriesId}/circuit
+// Owner and admins only. Sets the series' points table and finals.
+func (h *OrganizationHandler) SetCircuit(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid organization ID")
+		return
+	}
+
+	seriesID, err := uuid.Parse(r.PathValue("seriesId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid series ID")
+		return
+	}
+
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	var req organizationusecase.CircuitRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	series, err := h.service.SetCircuit(r.Context(), id, seriesID, req, userID)
+	if err != nil {
+		h.handleError(w, err, "Failed to set series circuit")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, series)
+}
+
+// GetSeriesStandings handles GET /api/v1/organizations/{id}/series/{seriesId}/standings
+func (h *OrganizationHandler) GetSeriesStandings(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid organization ID")
+		return
+	}
+
+	seriesID, err := uuid.Parse(r.PathValue("seriesId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid series ID")
+		return
+	}
+
+	standings, err := h.service.GetSeriesStandings(r.Context(), id, seriesID)
+	if err != nil {
+		h.handleError(w, err, "Failed to get series standings")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, standings)
+}
+
+func (h *OrganizationHandler) handleError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, organizationdomain.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Organization not found")
+	case errors.Is(err, organizationdomain.ErrSeriesNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Series not found")
+	case errors.Is(err, tournamentdomain.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Tournament not found")
+	case errors.Is(err, user.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "User not found")
+	case errors.Is(err, organizationdomain.ErrNotStaff):
+		h.errorResponse(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, organizationdomain.ErrNotAdmin):
+		h.errorResponse(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, organizationdomain.ErrInvalidName),
+		errors.Is(err, organizationdomain.ErrInvalidRole),
+		errors.Is(err, organizationdomain.ErrTooManyStaff),
+		errors.Is(err, organizationdomain.ErrOwnerIsStaff),
+		errors.Is(err, organizationdomain.ErrInvalidPoints),
+		errors.Is(err, organizationdomain.ErrInvalidFinalsSpots),
+		errors.Is(err, organizationdomain.ErrFinalsNotInSeries):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, organizationdomain.ErrAlreadyStaff),
+		errors.Is(err, organizationdomain.ErrTournamentInSeries),
+		errors.Is(err, organizationdomain.ErrFinalsQualified):
+		h.errorResponse(w, http.StatusConflict, err.Error())
+	case errors.Is(err, organizationusecase.ErrCircuitUnavailable):
+		h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+	default:
+		h.logger.Error(message, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, message)
+	}
+}
+
+// userID extracts the authenticated user ID, writing an error response on failure.
+func (h *OrganizationHandler) userID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return userID, true
+}
+
+// jsonResponse writes a JSON response.
+func (h *OrganizationHandler) jsonResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("failed to encode response", "error", err)
+	}
+}
+
+// errorResponse writes an error response.
+func (h *OrganizationHandler) errorResponse(w http.ResponseWriter, status int, message string) {
+	h.jsonResponse(w, status, map[string]string{"error": message})
+}
@@ -1,15 +1,20 @@
 package handlers
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log/slog"
 	"net/http"
 	"time"
 
+	gamedomain "github.com/alejaam/tourney-rank/internal/domain/game"
+	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
 	playerdomain "github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/infra/http/loader"
 	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
-	"github.com/alejaam/tourney-rank/internal/infra/mongodb"
+	"github.com/alejaam/tourney-rank/internal/usecase/fieldset"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
 	playerusecase "github.com/alejaam/tourney-rank/internal/usecase/player"
 	"github.com/google/uuid"
 )
@@ -17,22 +22,25 @@ import (
 // PlayerHandler handles HTTP requests for player operations.
 type PlayerHandler struct {
 	service   *playerusecase.Service
-	statsRepo *mongodb.PlayerStatsRepository
-	gameRepo  *mongodb.GameRepository
+	statsRepo playerdomain.StatsRepository
+	gameRepo  gamedomain.Repository
+	matchRepo matchdomain.Repository
 	logger    *slog.Logger
 }
 
 // NewPlayerHandler creates a new PlayerHandler.
 func NewPlayerHandler(
 	service *playerusecase.Service,
-	statsRepo *mongodb.PlayerStatsRepository,
-	gameRepo *mongodb.GameRepository,
+	statsRepo playerdomain.StatsRepository,
+	gameRepo gamedomain.Repository,
+	matchRepo matchdomain.Repository,
 	logger *slog.Logger,
 ) *PlayerHandler {
 	return &PlayerHandler{
 		service:   service,
 		statsRepo: statsRepo,
 		gameRepo:  gameRepo,
+		matchRepo: matchRepo,
 		logger:    logger,
 	}
 }
@@ -53,11 +61,20 @@ func (h *PlayerHandler) GetMyProfile(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	player, err := h.service.GetMyProfile(r.Context(), userID)
+	fields, err := fieldset.Parse(r.URL.Query().Get("fields"), playerdomain.Player{})
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	profile, err := h.service.GetMyProfileFields(r.Context(), userID, fields)
 	if err != nil {
 		h.logger.Debug("player not found, attempting auto-create", "user_id", userID)
 		// Auto-create player if not found
-		player, err = h.service.GetOrCreateByUserID(r.Context(), userID, "Player")
+		created, err := h.service.GetOrCreateByUserID(r.Context(), userID, "Player")
+		if err == nil {
+			profile, err = fields.Project(created)
+		}
 		if err != nil {
 			h.logger.Error("failed to get or create player", "user_id", userID, "error", err)
 			h.errorResponse(w, http.StatusInternalServerError, "failed to get player profile")
@@ -65,7 +82,7 @@ func (h *PlayerHandler) GetMyProfile(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	h.jsonResponse(w, http.StatusOK, player)
+	h.jsonResponse(w, http.StatusOK, profile)
 }
 
 // UpdateMyProfile updates the player profile for the authenticated user.
@@ -114,6 +131,92 @@ func (h *PlayerHandler) UpdateMyProfile(w http.ResponseWriter, r *http.Request)
 	h.jsonResponse(w, http.StatusOK, player)
 }
 
+// SetMyAvailability replaces the authenticated user's weekly availability.
+// PUT /api/v1/players/me/availability
+func (h *PlayerHandler) SetMyAvailability(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	var req playerdomain.Availability
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "invalid request body")
+		return
+	}
+
+	h.setAvailability(w, r, userID, &req)
+}
+
+// ClearMyAvailability removes the authenticated user's availability.
+// DELETE /api/v1/players/me/availability
+func (h *PlayerHandler) ClearMyAvailability(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	h.setAvailability(w, r, userID, nil)
+}
+
+func (h *PlayerHandler) setAvailability(w http.ResponseWriter, r *http.Request, userID uuid.UUID, availability *playerdomain.Availability) {
+	player, err := h.service.SetMyAvailability(r.Context(), userID, availability)
+	if err != nil {
+		switch {
+		case errors.Is(err, playerdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "player profile not found")
+		case errors.Is(err, playerdomain.ErrInvalidTimezone),
+			errors.Is(err, playerdomain.ErrInvalidAvailabilityWindow),
+			errors.Is(err, playerdomain.ErrTooManyAvailabilityWindows):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		default:
+			h.logger.Error("failed to set player availability", "user_id", userID, "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "failed to set player availability")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, player)
+}
+
+// GetAvailabilityOverlap compares the authenticated user's availability with
+// other players', given as repeated player_id query parameters.
+// GET /api/v1/players/me/availability/overlap?player_id=
+func (h *PlayerHandler) GetAvailabilityOverlap(w http.ResponseWriter, r *http.Request) {
+	userID, ok := h.userID(w, r)
+	if !ok {
+		return
+	}
+
+	values := r.URL.Query()["player_id"]
+	if len(values) == 0 {
+		h.errorResponse(w, http.StatusBadRequest, "player_id is required")
+		return
+	}
+	playerIDs := make([]uuid.UUID, 0, len(values))
+	for _, value := range values {
+		id, err := uuid.Parse(value)
+		if err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid player_id")
+			return
+		}
+		playerIDs = append(playerIDs, id)
+	}
+
+	overlaps, err := h.service.GetAvailabilityOverlap(r.Context(), userID, playerIDs)
+	if err != nil {
+		if errors.Is(err, playerdomain.ErrNotFound) {
+			h.errorResponse(w, http.StatusNotFound, "player profile not found")
+			return
+		}
+		h.logger.Error("failed to compare availability", "user_id", userID, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "failed to compare availability")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(overlaps))
+}
+
 // CreateMyProfile creates a player profile for the authenticated user.
 // POST /api/v1/players/me
 func (h *PlayerHandler) CreateMyProfile(w http.ResponseWriter, r *http.Request) {
@@ -221,13 +324,21 @@ func (h *PlayerHandler) GetMyStats(w http.ResponseWriter, r *http.Request) {
 		LastMatchAt   *string                `json:"last_match_at"`
 	}
 
+	// Fetch every game in one batch
+	gameIDs := make([]string, len(allStats))
+	for i, ps := range allStats {
+		gameIDs[i] = ps.GameID.String()
+	}
+	gamesByID, err := h.loader(r.Context()).Games(r.Context(), gameIDs)
+	if err != nil {
+		h.logger.Warn("failed to load games", "user_id", userID, "error", err)
+	}
+
 	games := make([]GameStats, 0, len(allStats))
 	for _, ps := range allStats {
 		gameID := ps.GameID.String()
 		gameName := gameID // fallback
-
-		// Try to get game name from repo
-		if game, err := h.gameRepo.GetByID(r.Context(), ps.GameID.String()); err == nil {
+		if game, ok := gamesByID[gameID]; ok {
 			gameName = game.Name
 		}
 
@@ -305,19 +416,20 @@ func (h *PlayerHandler) GetMyGameStats(w http.ResponseWriter, r *http.Request) {
 
 	// Get game name
 	gameName := gameIDStr
-	if game, err := h.gameRepo.GetByID(r.Context(), gameID.String()); err == nil {
+	game, err := h.loader(r.Context()).Game(r.Context(), gameID.String())
+	if err == nil {
 		gameName = game.Name
 	}
 
 	// Get player rank and total count for percentile calculation
-	rankInfo, err := h.statsRepo.GetPlayerRank(r.Context(), player.ID, gameID)
+	rankInfo, err := h.statsRepo.GetPlayerRank(r.Context(), player.ID, gameID, playerdomain.StatsScope{})
 	if err != nil {
 		h.logger.Error("failed to get player rank", "player_id", player.ID, "game_id", gameID, "error", err)
 		h.errorResponse(w, http.StatusInternalServerError, "failed to get player rank")
 		return
 	}
 
-	totalCount, err := h.statsRepo.CountByGame(r.Context(), gameID)
+	totalCount, err := h.statsRepo.CountByGame(r.Context(), gameID, playerdomain.StatsScope{})
 	if err != nil {
 		totalCount = 1 // fallback
 	}
@@ -331,25 +443,93 @@ func (h *PlayerHandler) GetMyGameStats(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
+	confidenceLow, confidenceHigh := playerdomain.ConfidenceInterval(ps.RankingScore, ps.RatingDeviation)
+
 	response := map[string]interface{}{
-		"id":             ps.ID.String(),
-		"player_id":      ps.PlayerID.String(),
-		"game_id":        ps.GameID.String(),
-		"game_name":      gameName,
-		"stats":          ps.Stats,
-		"ranking_score":  ps.RankingScore,
-		"tier":           string(ps.Tier),
-		"matches_played": ps.MatchesPlayed,
-		"last_match_at":  lastMatchAtString(ps.LastMatchAt),
-		"rank":           rankInfo.Rank,
-		"percentile":     percentile,
-		"created_at":     ps.CreatedAt,
-		"updated_at":     ps.UpdatedAt,
+		"id":                  ps.ID.String(),
+		"player_id":           ps.PlayerID.String(),
+		"game_id":             ps.GameID.String(),
+		"game_name":           gameName,
+		"stats":               ps.Stats,
+		"ranking_score":       ps.RankingScore,
+		"rating_deviation":    ps.RatingDeviation,
+		"confidence_low":      confidenceLow,
+		"confidence_high":     confidenceHigh,
+		"conservative_rating": playerdomain.ConservativeRating(ps.RankingScore, ps.RatingDeviation),
+		"tier":                string(ps.Tier),
+		"consistency":         ps.Consistency(),
+		"personal_records":    ps.Records,
+		"matches_played":      ps.MatchesPlayed,
+		"last_match_at":       lastMatchAtString(ps.LastMatchAt),
+		"rank":                rankInfo.Rank,
+		"percentile":          percentile,
+		"stat_percentiles":    h.statPercentiles(r.Context(), ps, game),
+		"map_breakdown":       h.mapBreakdown(r.Context(), ps),
+		"created_at":          ps.CreatedAt,
+		"updated_at":          ps.UpdatedAt,
 	}
 
 	h.jsonResponse(w, http.StatusOK, response)
 }
 
+// loader returns the request's data loader, or a fresh one if the router did not attach one.
+func (h *PlayerHandler) loader(ctx context.Context) *loader.Loader {
+	if l, ok := loader.FromContext(ctx); ok {
+		return l
+	}
+	return loader.New(h.gameRepo, nil)
+}
+
+// mapBreakdown aggregates the player's recent verified matches by map.
+// A lookup failure is logged and yields an empty breakdown.
+func (h *PlayerHandler) mapBreakdown(ctx context.Context, ps *playerdomain.PlayerStats) []matchdomain.MapStats {
+	matches, err := h.matchRepo.GetVerifiedByPlayerAndGame(ctx, ps.PlayerID.String(), ps.GameID.String(), "", matchdomain.MapBreakdownWindow)
+	if err != nil {
+		h.logger.Warn("failed to get map breakdown", "player_id", ps.PlayerID, "game_id", ps.GameID, "error", err)
+		return []matchdomain.MapStats{}
+	}
+	return matchdomain.PlayerMapBreakdown(matches, ps.PlayerID)
+}
+
+// statPercentiles ranks each numeric stat's per-match average against the
+// rest of the game. Stats that fail to rank are omitted rather than failing
+// the request, and averages and bests, already per match, are left out.
+func (h *PlayerHandler) statPercentiles(ctx context.Context, ps *playerdomain.PlayerStats, game *gamedomain.Game) map[string]playerdomain.StatPercentile {
+	percentiles := make(map[string]playerdomain.StatPercentile)
+	if ps.MatchesPlayed == 0 {
+		return percentiles
+	}
+
+	var schema gamedomain.StatSchema
+	if game != nil {
+		schema = game.StatSchema
+	}
+	rollups := schema.Rollups()
+
+	for key, value := range ps.Stats {
+		if !playerdomain.IsNumericStat(value) {
+			continue
+		}
+		if r, ok := rollups[key]; ok && r.Aggregate != gamedomain.AggregateSum {
+			continue
+		}
+
+		perMatch := ps.GetStatAsFloat(key) / float64(ps.MatchesPlayed)
+		above, total, err := h.statsRepo.CountStatAbove(ctx, ps.GameID, key, perMatch)
+		if err != nil {
+			h.logger.Warn("failed to compute stat percentile", "stat", key, "game_id", ps.GameID, "error", err)
+			continue
+		}
+
+		percentiles[key] = playerdomain.StatPercentile{
+			PerMatch:   perMatch,
+			TopPercent: playerdomain.TopPercent(above, total),
+		}
+	}
+
+	return percentiles
+}
+
 // lastMatchAtString converts a pointer to time to ISO string or nil
 func lastMatchAtString(t *time.Time) *string {
 	if t == nil {
@@ -359,6 +539,23 @@ func lastMatchAtString(t *time.Time) *string {
 	return &s
 }
 
+// userID returns the authenticated user's ID, writing an error response on failure.
+func (h *PlayerHandler) userID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "unauthorized")
+		return uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.logger.Error("invalid user id", "error", err, "user_id", userInfo.ID)
+		h.errorResponse(w, http.StatusBadRequest, "invalid user id")
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
 // jsonResponse writes a JSON response.
 func (h *PlayerHandler) jsonResponse(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
@@ -0,0 +1,149 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	gamedomain "github.com/alejaam/tourney-rank/internal/domain/game"
+	seasondomain "github.com/alejaam/tourney-rank/internal/domain/season"
+	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
+	seasonusecase "github.com/alejaam/tourney-rank/internal/usecase/season"
+	"github.com/google/uuid"
+)
+
+// SeasonHandler handles HTTP requests for ranked seasons.
+type SeasonHandler struct {
+	service *seasonusecase.Service
+	logger  *slog.Logger
+}
+
+// NewSeasonHandler creates a new season handler.
+func NewSeasonHandler(service *seasonusecase.Service, logger *slog.Logger) *SeasonHandler {
+	return &SeasonHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// ListSeasons handles GET /api/v1/games/{id}/seasons
+func (h *SeasonHandler) ListSeasons(w http.ResponseWriter, r *http.Request) {
+	gameID, ok := h.pathID(w, r, "Invalid game ID")
+	if !ok {
+		return
+	}
+
+	seasons, err := h.service.ListSeasons(r.Context(), gameID)
+	if err != nil {
+		h.seasonError(w, err, "Failed to list seasons")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(seasons))
+}
+
+// GetSeason handles GET /api/v1/seasons/{id}
+func (h *SeasonHandler) GetSeason(w http.ResponseWriter, r *http.Request) {
+	id, ok := h.pathID(w, r, "Invalid season ID")
+	if !ok {
+		return
+	}
+
+	s, err := h.service.GetSeason(r.Context(), id)
+	if err != nil {
+		h.seasonError(w, err, "Failed to get season")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, s)
+}
+
+// CreateSeason handles POST /api/v1/admin/games/{id}/seasons
+func (h *SeasonHandler) CreateSeason(w http.ResponseWriter, r *http.Request) {
+	gameID, ok := h.pathID(w, r, "Invalid game ID")
+	if !ok {
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+	adminID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	var req seasonusecase.CreateSeasonRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	s, err := h.service.CreateSeason(r.Context(), gameID, adminID, req)
+	if err != nil {
+		h.seasonError(w, err, "Failed to create season")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, s)
+}
+
+// RollOver handles POST /api/v1/admin/seasons/rollover, closing ended
+// seasons without waiting for the worker.
+func (h *SeasonHandler) RollOver(w http.ResponseWriter, r *http.Request) {
+	result, err := h.service.RollOver(r.Context())
+	if err != nil {
+		h.logger.Error("Failed to roll over seasons", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "Failed to roll over seasons")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, result)
+}
+
+// seasonError maps season errors to responses, logging unexpected ones.
+func (h *SeasonHandler) seasonError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, gamedomain.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Game not found")
+	case errors.Is(err, seasondomain.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Season not found")
+	case errors.Is(err, seasondomain.ErrOverlap):
+		h.errorResponse(w, http.StatusConflict, err.Error())
+	case errors.Is(err, seasondomain.ErrInvalidName),
+		errors.Is(err, seasondomain.ErrInvalidWindow):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+	default:
+		h.logger.Error(message, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, message)
+	}
+}
+
+// pathID parses the id path value, writing an error response on failure.
+func (h *SeasonHandler) pathID(w http.ResponseWriter, r *http.Request, message string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, message)
+		return uuid.Nil, false
+	}
+	return id, true
+}
+
+// jsonResponse writes a JSON response.
+func (h *SeasonHandler) jsonResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("failed to encode response", "error", err)
+	}
+}
+
+// errorResponse writes an error response.
+func (h *SeasonHandler) errorResponse(w http.ResponseWriter, status int, message string) {
+	h.jsonResponse(w, status, map[string]string{"error": message})
+}
@@ -0,0 +1,103 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	playerdomain "github.com/alejaam/tourney-rank/internal/domain/player"
+	statsusecase "github.com/alejaam/tourney-rank/internal/usecase/stats"
+)
+
+// overviewCacheControl lets browsers and CDNs cache the public overview.
+const overviewCacheControl = "public, max-age=300, stale-while-revalidate=600"
+
+// StatsHandler handles HTTP requests for platform-wide statistics.
+type StatsHandler struct {
+	service *statsusecase.Service
+	cohorts *statsusecase.CohortService
+	logger  *slog.Logger
+}
+
+// NewStatsHandler creates a new stats handler.
+func NewStatsHandler(service *statsusecase.Service, cohorts *statsusecase.CohortService, logger *slog.Logger) *StatsHandler {
+	return &StatsHandler{
+		service: service,
+		cohorts: cohorts,
+		logger:  logger,
+	}
+}
+
+// GetOverview handles GET /api/v1/stats/overview
+// Public endpoint. Serves the snapshot maintained by the stats worker.
+func (h *StatsHandler) GetOverview(w http.ResponseWriter, r *http.Request) {
+	overview, err := h.service.Overview(r.Context())
+	if err != nil {
+		h.logger.Error("failed to get stats overview", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "failed to get stats overview")
+		return
+	}
+
+	w.Header().Set("Cache-Control", overviewCacheControl)
+	h.jsonResponse(w, http.StatusOK, overview)
+}
+
+// GetCohorts handles GET /api/v1/admin/analytics/cohorts
+// Returns the latest weekly retention cohort report. Accepts ?weeks=N to limit
+// the response to the newest N signup weeks.
+func (h *StatsHandler) GetCohorts(w http.ResponseWriter, r *http.Request) {
+	weeks := 0
+	if v := r.URL.Query().Get("weeks"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "invalid weeks")
+			return
+		}
+		weeks = n
+	}
+
+	report, err := h.cohorts.Latest(r.Context(), weeks)
+	if err != nil {
+		switch {
+		case errors.Is(err, statsusecase.ErrInvalidCohortWeeks):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, playerdomain.ErrCohortReportNotFound):
+			h.errorResponse(w, http.StatusNotFound, err.Error())
+		default:
+			h.logger.Error("failed to get cohort report", "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "failed to get cohort report")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, report)
+}
+
+// GenerateCohorts handles POST /api/v1/admin/analytics/cohorts
+// Regenerates the cohort report immediately instead of waiting for the worker.
+func (h *StatsHandler) GenerateCohorts(w http.ResponseWriter, r *http.Request) {
+	report, err := h.cohorts.Generate(r.Context())
+	if err != nil {
+		h.logger.Error("failed to generate cohort report", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "failed to generate cohort report")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, report)
+}
+
+// jsonResponse writes a JSON response.
+func (h *StatsHandler) jsonResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("failed to encode response", "error", err)
+	}
+}
+
+// errorResponse writes an error response.
+func (h *StatsHandler) errorResponse(w http.ResponseWriter, status int, message string) {
+	h.jsonResponse(w, status, map[string]string{"error": message})
+}
@@ -5,9 +5,15 @@ import (
 	"errors"
 	"log/slog"
 	"net/http"
+	"strconv"
+	"time"
 
+	playerdomain "github.com/alejaam/tourney-rank/internal/domain/player"
 	teamdomain "github.com/alejaam/tourney-rank/internal/domain/team"
+	tournamentdomain "github.com/alejaam/tourney-rank/internal/domain/tournament"
 	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
+	"github.com/alejaam/tourney-rank/internal/usecase/fieldset"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
 	teamusecase "github.com/alejaam/tourney-rank/internal/usecase/team"
 	"github.com/google/uuid"
 )
@@ -50,6 +56,9 @@ func (h *TeamHandler) CreateTeam(w http.ResponseWriter, r *http.Request) {
 
 	team, err := h.service.CreateTeam(r.Context(), req, playerID)
 	if err != nil {
+		if h.concurrentTournamentError(w, err) {
+			return
+		}
 		h.logger.Error("Failed to create team", "error", err)
 		status := http.StatusInternalServerError
 		message := "Failed to create team"
@@ -57,6 +66,15 @@ func (h *TeamHandler) CreateTeam(w http.ResponseWriter, r *http.Request) {
 		if errors.Is(err, teamdomain.ErrInvalidName) {
 			status = http.StatusBadRequest
 			message = err.Error()
+		} else if errors.Is(err, tournamentdomain.ErrPlayerNotEligible) ||
+			errors.Is(err, tournamentdomain.ErrConductNotEligible) ||
+			errors.Is(err, tournamentdomain.ErrNotInvited) {
+			status = http.StatusForbidden
+			message = err.Error()
+		} else if errors.Is(err, tournamentdomain.ErrTeamScoreCapExceeded) ||
+			errors.Is(err, tournamentdomain.ErrRegistrationQueued) {
+			status = http.StatusConflict
+			message = err.Error()
 		} else if err.Error() == "tournament not found" || err.Error() == "player not found" {
 			status = http.StatusBadRequest
 			message = err.Error()
@@ -73,6 +91,55 @@ func (h *TeamHandler) CreateTeam(w http.ResponseWriter, r *http.Request) {
 	h.jsonResponse(w, http.StatusCreated, team)
 }
 
+// EnqueueTeam handles POST /api/v1/registration-queue
+// Takes the same body as CreateTeam and returns 202 with a ticket; the team is
+// created by the registration worker in the order tickets were issued.
+func (h *TeamHandler) EnqueueTeam(w http.ResponseWriter, r *http.Request) {
+	var req teamusecase.CreateTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	playerID, ok := h.requestPlayerID(w, r)
+	if !ok {
+		return
+	}
+
+	ticket, err := h.service.EnqueueTeam(r.Context(), req, playerID)
+	if err != nil {
+		h.registrationQueueError(w, err, "Failed to join registration queue")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusAccepted, ticket)
+}
+
+// GetRegistrationTicket handles GET /api/v1/registration-queue/{id}
+// Returns the caller's ticket with its place in line, or the created team
+// once processed.
+func (h *TeamHandler) GetRegistrationTicket(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid ticket ID")
+		return
+	}
+
+	playerID, ok := h.requestPlayerID(w, r)
+	if !ok {
+		return
+	}
+
+	ticket, err := h.service.GetTicket(r.Context(), id, playerID)
+	if err != nil {
+		h.registrationQueueError(w, err, "Failed to get registration ticket")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, ticket)
+}
+
 // GetTeam handles GET /api/v1/teams/{id}
 func (h *TeamHandler) GetTeam(w http.ResponseWriter, r *http.Request) {
 	idStr := r.PathValue("id")
@@ -82,7 +149,13 @@ func (h *TeamHandler) GetTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	team, err := h.service.GetTeam(r.Context(), id)
+	fields, err := fieldset.Parse(r.URL.Query().Get("fields"), teamdomain.Team{})
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	team, err := h.service.GetTeamFields(r.Context(), id, fields)
 	if err != nil {
 		if errors.Is(err, teamdomain.ErrNotFound) {
 			h.errorResponse(w, http.StatusNotFound, "Team not found")
@@ -97,6 +170,7 @@ func (h *TeamHandler) GetTeam(w http.ResponseWriter, r *http.Request) {
 }
 
 // GetTeamWithMembers handles GET /api/v1/teams/{id}/members
+// Signed-in organizers and admins also see new-account flags.
 func (h *TeamHandler) GetTeamWithMembers(w http.ResponseWriter, r *http.Request) {
 	idStr := r.PathValue("id")
 	id, err := uuid.Parse(idStr)
@@ -105,7 +179,8 @@ func (h *TeamHandler) GetTeamWithMembers(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	teamWithMembers, err := h.service.GetTeamWithMembers(r.Context(), id)
+	viewerID, isAdmin := viewer(r)
+	teamWithMembers, err := h.service.GetTeamWithMembers(r.Context(), id, viewerID, isAdmin)
 	if err != nil {
 		if errors.Is(err, teamdomain.ErrNotFound) {
 			h.errorResponse(w, http.StatusNotFound, "Team not found")
@@ -143,6 +218,9 @@ func (h *TeamHandler) JoinTeam(w http.ResponseWriter, r *http.Request) {
 
 	team, err := h.service.JoinTeam(r.Context(), req, playerID)
 	if err != nil {
+		if h.concurrentTournamentError(w, err) {
+			return
+		}
 		h.logger.Error("Failed to join team", "error", err, "player_id", playerID)
 		status := http.StatusInternalServerError
 		message := "Failed to join team"
@@ -150,9 +228,18 @@ func (h *TeamHandler) JoinTeam(w http.ResponseWriter, r *http.Request) {
 		if errors.Is(err, teamdomain.ErrInvalidInviteCode) || errors.Is(err, teamdomain.ErrNotFound) {
 			status = http.StatusNotFound
 			message = "Invalid invite code"
-		} else if errors.Is(err, teamdomain.ErrPlayerAlreadyInTeam) || errors.Is(err, teamdomain.ErrTeamFull) {
+		} else if errors.Is(err, teamdomain.ErrPlayerAlreadyInTeam) ||
+			errors.Is(err, teamdomain.ErrAlreadyStaff) ||
+			errors.Is(err, teamdomain.ErrTeamFull) ||
+			errors.Is(err, teamdomain.ErrApprovalRequired) ||
+			errors.Is(err, tournamentdomain.ErrRegistrationClosed) ||
+			errors.Is(err, tournamentdomain.ErrTeamScoreCapExceeded) {
 			status = http.StatusConflict
 			message = err.Error()
+		} else if errors.Is(err, tournamentdomain.ErrPlayerNotEligible) ||
+			errors.Is(err, tournamentdomain.ErrConductNotEligible) {
+			status = http.StatusForbidden
+			message = err.Error()
 		}
 
 		h.errorResponse(w, status, message)
@@ -295,6 +382,557 @@ func (h *TeamHandler) TransferCaptaincy(w http.ResponseWriter, r *http.Request)
 	h.jsonResponse(w, http.StatusOK, team)
 }
 
+// AppointCaptain handles PUT /api/v1/teams/{id}/captain
+// Tournament organizers and admins only. Overrides the team's captain, e.g.
+// after an automatic succession.
+func (h *TeamHandler) AppointCaptain(w http.ResponseWriter, r *http.Request) {
+	teamID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid team ID")
+		return
+	}
+
+	var req teamusecase.AppointCaptainRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	requesterID, ok := h.requestPlayerID(w, r)
+	if !ok {
+		return
+	}
+	_, isAdmin := viewer(r)
+
+	team, err := h.service.AppointCaptain(r.Context(), teamID, req, requesterID, isAdmin)
+	if err != nil {
+		switch {
+		case errors.Is(err, teamdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Team not found")
+		case errors.Is(err, teamusecase.ErrNotOrganizer):
+			h.errorResponse(w, http.StatusForbidden, err.Error())
+		case errors.Is(err, teamdomain.ErrPlayerNotInTeam):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, teamdomain.ErrAlreadyCaptain):
+			h.errorResponse(w, http.StatusConflict, err.Error())
+		default:
+			h.logger.Error("Failed to appoint captain", "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to appoint captain")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, team)
+}
+
+// SetSubmitPermission handles PUT /api/v1/teams/{id}/members/{playerId}/permissions
+// Captain-only. Grants or revokes a member's permission to submit match reports.
+func (h *TeamHandler) SetSubmitPermission(w http.ResponseWriter, r *http.Request) {
+	teamID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid team ID")
+		return
+	}
+
+	playerID, err := uuid.Parse(r.PathValue("playerId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid player ID")
+		return
+	}
+
+	var req teamusecase.SubmitPermissionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	// Get player ID from context (set by auth middleware)
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	requesterID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	team, err := h.service.SetSubmitPermission(r.Context(), teamID, playerID, req, requesterID)
+	if err != nil {
+		switch {
+		case errors.Is(err, teamdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Team not found")
+		case errors.Is(err, teamdomain.ErrNotCaptain):
+			h.errorResponse(w, http.StatusForbidden, "Only captain can change permissions")
+		case errors.Is(err, teamdomain.ErrPlayerNotInTeam),
+			errors.Is(err, teamdomain.ErrCaptainPermission):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, teamdomain.ErrAlreadySubmitter),
+			errors.Is(err, teamdomain.ErrNotSubmitter):
+			h.errorResponse(w, http.StatusConflict, err.Error())
+		default:
+			h.logger.Error("Failed to change submit permission", "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to change permission")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, team)
+}
+
+// AddStaff handles POST /api/v1/teams/{id}/staff
+// Captain only. Adds a non-playing coach or manager to the team.
+func (h *TeamHandler) AddStaff(w http.ResponseWriter, r *http.Request) {
+	teamID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid team ID")
+		return
+	}
+
+	var req teamusecase.AddStaffRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	requesterID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	team, err := h.service.AddStaff(r.Context(), teamID, req, requesterID)
+	if err != nil {
+		h.staffError(w, err, "Failed to add staff")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, team)
+}
+
+// RemoveStaff handles DELETE /api/v1/teams/{id}/staff/{playerId}
+// The captain can remove any staff member; staff can remove themselves.
+func (h *TeamHandler) RemoveStaff(w http.ResponseWriter, r *http.Request) {
+	teamID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid team ID")
+		return
+	}
+
+	playerID, err := uuid.Parse(r.PathValue("playerId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid player ID")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	requesterID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	team, err := h.service.RemoveStaff(r.Context(), teamID, playerID, requesterID)
+	if err != nil {
+		h.staffError(w, err, "Failed to remove staff")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, team)
+}
+
+func (h *TeamHandler) staffError(w http.ResponseWriter, err error, message string) {
+	switch {
+	case errors.Is(err, teamdomain.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Team not found")
+	case errors.Is(err, playerdomain.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Player not found")
+	case errors.Is(err, teamdomain.ErrNotCaptain):
+		h.errorResponse(w, http.StatusForbidden, "Only captain can manage staff")
+	case errors.Is(err, teamdomain.ErrInvalidStaffRole),
+		errors.Is(err, teamdomain.ErrTooManyStaff):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, teamdomain.ErrPlayerAlreadyInTeam),
+		errors.Is(err, teamdomain.ErrAlreadyStaff):
+		h.errorResponse(w, http.StatusConflict, err.Error())
+	case errors.Is(err, teamdomain.ErrNotStaff):
+		h.errorResponse(w, http.StatusNotFound, err.Error())
+	default:
+		h.logger.Error(message, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, message)
+	}
+}
+
+// Broadcast handles POST /api/v1/teams/{id}/broadcasts
+// Captain only. Sends a message to the rest of the team's activity feeds.
+func (h *TeamHandler) Broadcast(w http.ResponseWriter, r *http.Request) {
+	teamID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid team ID")
+		return
+	}
+
+	var req teamusecase.BroadcastRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	captainID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	broadcast, err := h.service.Broadcast(r.Context(), teamID, req, captainID)
+	if err != nil {
+		switch {
+		case errors.Is(err, teamdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Team not found")
+		case errors.Is(err, teamdomain.ErrNotCaptain):
+			h.errorResponse(w, http.StatusForbidden, "Only captain can broadcast to the team")
+		case errors.Is(err, teamdomain.ErrInvalidBroadcast):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, teamdomain.ErrBroadcastRateLimited):
+			w.Header().Set("Retry-After", strconv.Itoa(int(teamdomain.BroadcastWindow.Seconds())))
+			h.errorResponse(w, http.StatusTooManyRequests, err.Error())
+		case errors.Is(err, teamusecase.ErrBroadcastsUnavailable):
+			h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+		default:
+			h.logger.Error("Failed to broadcast to team", "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to broadcast to team")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, broadcast)
+}
+
+// GetHistory handles GET /api/v1/teams/{id}/history
+// Public. Returns the team's history, newest first. Accepts ?limit=.
+func (h *TeamHandler) GetHistory(w http.ResponseWriter, r *http.Request) {
+	teamID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid team ID")
+		return
+	}
+
+	var limit int64 = 50
+	if v := r.URL.Query().Get("limit"); v != "" {
+		limit, err = strconv.ParseInt(v, 10, 64)
+		if err != nil || limit < 1 {
+			h.errorResponse(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+	}
+
+	entries, err := h.service.GetHistory(r.Context(), teamID, limit)
+	if err != nil {
+		if errors.Is(err, teamdomain.ErrNotFound) {
+			h.errorResponse(w, http.StatusNotFound, "Team not found")
+			return
+		}
+		h.logger.Error("Failed to get team history", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "Failed to get team history")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(entries))
+}
+
+// DisqualifyTeam handles POST /api/v1/admin/teams/{id}/disqualify
+func (h *TeamHandler) DisqualifyTeam(w http.ResponseWriter, r *http.Request) {
+	teamID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid team ID")
+		return
+	}
+
+	var req teamusecase.DisqualifyTeamRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+	if req.Reason == "" {
+		h.errorResponse(w, http.StatusBadRequest, "Reason is required")
+		return
+	}
+
+	adminID, ok := h.requestPlayerID(w, r)
+	if !ok {
+		return
+	}
+
+	team, err := h.service.DisqualifyTeam(r.Context(), teamID, req, adminID)
+	if err != nil {
+		switch {
+		case errors.Is(err, teamdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Team not found")
+		case errors.Is(err, teamdomain.ErrAlreadyDisqualified):
+			h.errorResponse(w, http.StatusConflict, err.Error())
+		default:
+			h.logger.Error("Failed to disqualify team", "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to disqualify team")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, team)
+}
+
+// ApplyToTeam handles POST /api/v1/teams/{id}/join-requests
+func (h *TeamHandler) ApplyToTeam(w http.ResponseWriter, r *http.Request) {
+	teamID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid team ID")
+		return
+	}
+
+	var req teamusecase.ApplyToTeamRequest
+	if r.ContentLength > 0 {
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			h.logger.Error("Failed to decode request", "error", err)
+			h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+			return
+		}
+	}
+
+	playerID, ok := h.requestPlayerID(w, r)
+	if !ok {
+		return
+	}
+
+	jr, err := h.service.ApplyToTeam(r.Context(), teamID, req, playerID)
+	if err != nil {
+		h.joinRequestError(w, err, "Failed to apply to team")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, jr)
+}
+
+// ListTeamJoinRequests handles GET /api/v1/teams/{id}/join-requests
+func (h *TeamHandler) ListTeamJoinRequests(w http.ResponseWriter, r *http.Request) {
+	teamID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid team ID")
+		return
+	}
+
+	captainID, ok := h.requestPlayerID(w, r)
+	if !ok {
+		return
+	}
+
+	requests, err := h.service.ListTeamJoinRequests(r.Context(), teamID, captainID)
+	if err != nil {
+		h.joinRequestError(w, err, "Failed to list join requests")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(requests))
+}
+
+// ApproveJoinRequest handles POST /api/v1/teams/{id}/join-requests/{requestId}/approve
+func (h *TeamHandler) ApproveJoinRequest(w http.ResponseWriter, r *http.Request) {
+	teamID, requestID, ok := h.joinRequestIDs(w, r)
+	if !ok {
+		return
+	}
+
+	captainID, ok := h.requestPlayerID(w, r)
+	if !ok {
+		return
+	}
+
+	team, err := h.service.ApproveJoinRequest(r.Context(), teamID, requestID, captainID)
+	if err != nil {
+		h.joinRequestError(w, err, "Failed to approve join request")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, team)
+}
+
+// DeclineJoinRequest handles POST /api/v1/teams/{id}/join-requests/{requestId}/decline
+func (h *TeamHandler) DeclineJoinRequest(w http.ResponseWriter, r *http.Request) {
+	teamID, requestID, ok := h.joinRequestIDs(w, r)
+	if !ok {
+		return
+	}
+
+	captainID, ok := h.requestPlayerID(w, r)
+	if !ok {
+		return
+	}
+
+	jr, err := h.service.DeclineJoinRequest(r.Context(), teamID, requestID, captainID)
+	if err != nil {
+		h.joinRequestError(w, err, "Failed to decline join request")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, jr)
+}
+
+// GetPlayerJoinRequests handles GET /api/v1/players/me/join-requests
+func (h *TeamHandler) GetPlayerJoinRequests(w http.ResponseWriter, r *http.Request) {
+	playerID, ok := h.requestPlayerID(w, r)
+	if !ok {
+		return
+	}
+
+	requests, err := h.service.ListPlayerJoinRequests(r.Context(), playerID)
+	if err != nil {
+		h.logger.Error("Failed to list player join requests", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "Failed to list join requests")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(requests))
+}
+
+// joinRequestIDs parses the team and join request IDs from the path.
+func (h *TeamHandler) joinRequestIDs(w http.ResponseWriter, r *http.Request) (teamID, requestID uuid.UUID, ok bool) {
+	teamID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid team ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	requestID, err = uuid.Parse(r.PathValue("requestId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid join request ID")
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	return teamID, requestID, true
+}
+
+// requestPlayerID returns the authenticated player's ID, writing an error response if there is none.
+func (h *TeamHandler) requestPlayerID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return uuid.Nil, false
+	}
+
+	playerID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return uuid.Nil, false
+	}
+
+	return playerID, true
+}
+
+// joinRequestError maps join request errors to HTTP responses.
+func (h *TeamHandler) joinRequestError(w http.ResponseWriter, err error, fallback string) {
+	if h.concurrentTournamentError(w, err) {
+		return
+	}
+
+	switch {
+	case errors.Is(err, teamdomain.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Team not found")
+	case errors.Is(err, teamdomain.ErrJoinRequestNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Join request not found")
+	case errors.Is(err, teamdomain.ErrNotCaptain):
+		h.errorResponse(w, http.StatusForbidden, "Only captain can manage join requests")
+	case errors.Is(err, tournamentdomain.ErrPlayerNotEligible),
+		errors.Is(err, tournamentdomain.ErrConductNotEligible):
+		h.errorResponse(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, teamdomain.ErrInvalidJoinMode):
+		h.errorResponse(w, http.StatusBadRequest, "Team does not accept join requests")
+	case errors.Is(err, teamdomain.ErrJoinRequestExists),
+		errors.Is(err, teamdomain.ErrJoinRequestNotPending),
+		errors.Is(err, teamdomain.ErrPlayerAlreadyInTeam),
+		errors.Is(err, teamdomain.ErrAlreadyStaff),
+		errors.Is(err, teamdomain.ErrTeamFull),
+		errors.Is(err, tournamentdomain.ErrRegistrationClosed),
+		errors.Is(err, tournamentdomain.ErrTeamScoreCapExceeded):
+		h.errorResponse(w, http.StatusConflict, err.Error())
+	default:
+		h.logger.Error(fallback, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// conflictingTournament is the clashing tournament in a concurrent tournament limit response.
+type conflictingTournament struct {
+	ID        uuid.UUID `json:"id"`
+	Name      string    `json:"name"`
+	StartDate time.Time `json:"start_date"`
+	EndDate   time.Time `json:"end_date"`
+}
+
+// concurrentTournamentError writes a 409 for a player over the concurrent
+// tournament limit, naming the clashing tournament under
+// "conflicting_tournament". It reports whether err was handled.
+func (h *TeamHandler) concurrentTournamentError(w http.ResponseWriter, err error) bool {
+	var limitErr *tournamentdomain.ConcurrentTournamentError
+	if !errors.As(err, &limitErr) {
+		return false
+	}
+
+	h.jsonResponse(w, http.StatusConflict, map[string]interface{}{
+		"error": limitErr.Error(),
+		"limit": limitErr.Limit,
+		"conflicting_tournament": conflictingTournament{
+			ID:        limitErr.Conflict.ID,
+			Name:      limitErr.Conflict.Name,
+			StartDate: limitErr.Conflict.StartDate,
+			EndDate:   limitErr.Conflict.EndDate,
+		},
+	})
+	return true
+}
+
+// registrationQueueError maps registration queue errors to HTTP responses.
+func (h *TeamHandler) registrationQueueError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, tournamentdomain.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Tournament not found")
+	case errors.Is(err, teamdomain.ErrTicketNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Registration ticket not found")
+	case errors.Is(err, teamdomain.ErrInvalidName):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, tournamentdomain.ErrNotInvited):
+		h.errorResponse(w, http.StatusForbidden, err.Error())
+	case errors.Is(err, teamdomain.ErrAlreadyQueued),
+		errors.Is(err, tournamentdomain.ErrRegistrationClosed):
+		h.errorResponse(w, http.StatusConflict, err.Error())
+	case errors.Is(err, teamusecase.ErrQueueUnavailable):
+		h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+	default:
+		h.logger.Error(fallback, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, fallback)
+	}
+}
+
 // ListTeamsByTournament handles GET /api/v1/tournaments/{tournamentId}/teams
 func (h *TeamHandler) ListTeamsByTournament(w http.ResponseWriter, r *http.Request) {
 	tournamentIDStr := r.PathValue("tournamentId")
@@ -311,7 +949,7 @@ func (h *TeamHandler) ListTeamsByTournament(w http.ResponseWriter, r *http.Reque
 		return
 	}
 
-	h.jsonResponse(w, http.StatusOK, teams)
+	h.jsonResponse(w, http.StatusOK, pagination.All(teams))
 }
 
 // GetPlayerTeamInTournament handles GET /api/v1/tournaments/{tournamentId}/my-team
@@ -372,7 +1010,7 @@ func (h *TeamHandler) GetPlayerTeams(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	h.jsonResponse(w, http.StatusOK, teams)
+	h.jsonResponse(w, http.StatusOK, pagination.All(teams))
 }
 
 // UpdateTeam handles PATCH /api/v1/teams/{id}
@@ -414,6 +1052,10 @@ func (h *TeamHandler) UpdateTeam(w http.ResponseWriter, r *http.Request) {
 			h.errorResponse(w, http.StatusForbidden, "Only captain can update team")
 			return
 		}
+		if errors.Is(err, teamdomain.ErrInvalidJoinMode) {
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		h.logger.Error("Failed to update team", "error", err)
 		h.errorResponse(w, http.StatusInternalServerError, "Failed to update team")
 		return
@@ -0,0 +1,201 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	gamedomain "github.com/alejaam/tourney-rank/internal/domain/game"
+	rankingdomain "github.com/alejaam/tourney-rank/internal/domain/ranking"
+	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
+	tierusecase "github.com/alejaam/tourney-rank/internal/usecase/tier"
+	"github.com/google/uuid"
+)
+
+// TierHandler handles HTTP requests for tier boundaries and rating history.
+type TierHandler struct {
+	service *tierusecase.Service
+	logger  *slog.Logger
+}
+
+// NewTierHandler creates a new tier handler.
+func NewTierHandler(service *tierusecase.Service, logger *slog.Logger) *TierHandler {
+	return &TierHandler{
+		service: service,
+		logger:  logger,
+	}
+}
+
+// CreateBoundaries handles POST /api/v1/admin/games/{id}/tier-boundaries
+func (h *TierHandler) CreateBoundaries(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	adminID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	gameID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid game ID")
+		return
+	}
+
+	var req tierusecase.CreateBoundariesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.logger.Error("Failed to decode request", "error", err)
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	b, err := h.service.CreateBoundaries(r.Context(), gameID, adminID, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, gamedomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Game not found")
+		case errors.Is(err, rankingdomain.ErrInvalidBoundaries),
+			errors.Is(err, rankingdomain.ErrInvalidGrandfatherPolicy):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		default:
+			h.logger.Error("Failed to create tier boundaries", "error", err)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to create tier boundaries")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, b)
+}
+
+// ListBoundaries handles GET /api/v1/admin/games/{id}/tier-boundaries
+func (h *TierHandler) ListBoundaries(w http.ResponseWriter, r *http.Request) {
+	gameID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid game ID")
+		return
+	}
+
+	boundaries, err := h.service.ListBoundaries(r.Context(), gameID)
+	if err != nil {
+		h.logger.Error("Failed to list tier boundaries", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "Failed to list tier boundaries")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(boundaries))
+}
+
+// Recalibrate handles POST /api/v1/admin/games/{id}/tier-boundaries/recalibrate
+func (h *TierHandler) Recalibrate(w http.ResponseWriter, r *http.Request) {
+	gameID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid game ID")
+		return
+	}
+
+	result, err := h.service.Recalibrate(r.Context(), gameID)
+	if err != nil {
+		h.logger.Error("Failed to recalibrate tiers", "game_id", gameID, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "Failed to recalibrate tiers")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, result)
+}
+
+// GetRatingHistory handles GET /api/v1/leaderboard/{gameId}/player/{playerId}/history
+func (h *TierHandler) GetRatingHistory(w http.ResponseWriter, r *http.Request) {
+	gameID, err := uuid.Parse(r.PathValue("gameId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid game ID")
+		return
+	}
+
+	playerID, err := uuid.Parse(r.PathValue("playerId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid player ID")
+		return
+	}
+
+	var limit int64
+	if v := r.URL.Query().Get("limit"); v != "" {
+		limit, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			h.errorResponse(w, http.StatusBadRequest, "Invalid limit")
+			return
+		}
+	}
+
+	entries, err := h.service.GetRatingHistory(r.Context(), playerID, gameID, limit)
+	if err != nil {
+		h.logger.Error("Failed to get rating history", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "Failed to get rating history")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(entries))
+}
+
+// DistributeSeasonRewards handles POST /api/v1/admin/games/{id}/season-rewards/distribute
+// Runs the season reward job for one game now; players already rewarded are skipped.
+func (h *TierHandler) DistributeSeasonRewards(w http.ResponseWriter, r *http.Request) {
+	gameID, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid game ID")
+		return
+	}
+
+	result, err := h.service.DistributeSeasonRewards(r.Context(), gameID)
+	if err != nil {
+		h.logger.Error("Failed to distribute season rewards", "game_id", gameID, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "Failed to distribute season rewards")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, result)
+}
+
+// GetPlayerRewards handles GET /api/v1/leaderboard/{gameId}/player/{playerId}/rewards
+func (h *TierHandler) GetPlayerRewards(w http.ResponseWriter, r *http.Request) {
+	gameID, err := uuid.Parse(r.PathValue("gameId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid game ID")
+		return
+	}
+
+	playerID, err := uuid.Parse(r.PathValue("playerId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid player ID")
+		return
+	}
+
+	rewards, err := h.service.ListPlayerRewards(r.Context(), playerID, gameID)
+	if err != nil {
+		h.logger.Error("Failed to get season rewards", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "Failed to get season rewards")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(rewards))
+}
+
+// jsonResponse writes a JSON response.
+func (h *TierHandler) jsonResponse(w http.ResponseWriter, status int, data interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(data); err != nil {
+		h.logger.Error("failed to encode response", "error", err)
+	}
+}
+
+// errorResponse writes an error response.
+func (h *TierHandler) errorResponse(w http.ResponseWriter, status int, message string) {
+	h.jsonResponse(w, status, map[string]string{"error": message})
+}
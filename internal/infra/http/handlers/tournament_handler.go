@@ -3,12 +3,22 @@ package handlers
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"strconv"
+	"time"
 
+	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
 	tournamentdomain "github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/domain/user"
 	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
+	"github.com/alejaam/tourney-rank/internal/usecase/fieldset"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
 	tournamentusecase "github.com/alejaam/tourney-rank/internal/usecase/tournament"
 	"github.com/google/uuid"
 )
@@ -57,7 +67,16 @@ func (h *TournamentHandler) CreateTournament(w http.ResponseWriter, r *http.Requ
 
 		if errors.Is(err, tournamentdomain.ErrInvalidName) ||
 			errors.Is(err, tournamentdomain.ErrInvalidTeamSize) ||
-			errors.Is(err, tournamentdomain.ErrInvalidDates) {
+			errors.Is(err, tournamentdomain.ErrInvalidRosterSize) ||
+			errors.Is(err, game.ErrTeamSizeNotSupported) ||
+			errors.Is(err, tournamentdomain.ErrInvalidDates) ||
+			errors.Is(err, tournamentdomain.ErrInvalidTierRange) ||
+			errors.Is(err, tournamentdomain.ErrInvalidScoreCap) ||
+			errors.Is(err, tournamentdomain.ErrInvalidConcurrentLimit) ||
+			errors.Is(err, tournamentdomain.ErrInvalidCleanupRound) ||
+			errors.Is(err, player.ErrInvalidConductScore) ||
+			errors.Is(err, tournamentdomain.ErrInvalidLanguage) ||
+			errors.Is(err, tournamentdomain.ErrTooManyLanguages) {
 			status = http.StatusBadRequest
 			message = err.Error()
 		}
@@ -70,6 +89,7 @@ func (h *TournamentHandler) CreateTournament(w http.ResponseWriter, r *http.Requ
 }
 
 // GetTournament handles GET /api/v1/tournaments/{id}
+// The response includes the tournament's rules and FAQ sections.
 func (h *TournamentHandler) GetTournament(w http.ResponseWriter, r *http.Request) {
 	idStr := r.PathValue("id")
 	id, err := uuid.Parse(idStr)
@@ -78,7 +98,13 @@ func (h *TournamentHandler) GetTournament(w http.ResponseWriter, r *http.Request
 		return
 	}
 
-	tournament, err := h.service.GetTournament(r.Context(), id)
+	fields, err := fieldset.Parse(r.URL.Query().Get("fields"), tournamentusecase.TournamentOverview{})
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	tournament, err := h.service.GetTournamentOverview(r.Context(), id, fields)
 	if err != nil {
 		if errors.Is(err, tournamentdomain.ErrNotFound) {
 			h.errorResponse(w, http.StatusNotFound, "Tournament not found")
@@ -93,6 +119,8 @@ func (h *TournamentHandler) GetTournament(w http.ResponseWriter, r *http.Request
 }
 
 // ListTournaments handles GET /api/v1/tournaments
+// Signed-in players see tournaments in their profile language unless a
+// language is given; language=any lists every language.
 func (h *TournamentHandler) ListTournaments(w http.ResponseWriter, r *http.Request) {
 	var req tournamentusecase.ListTournamentsRequest
 
@@ -116,12 +144,23 @@ func (h *TournamentHandler) ListTournaments(w http.ResponseWriter, r *http.Reque
 		}
 	}
 
+	req.Language = r.URL.Query().Get("language")
+	if userInfo, ok := middleware.GetUserInfo(r.Context()); ok {
+		if viewerID, err := uuid.Parse(userInfo.ID); err == nil {
+			req.ViewerID = &viewerID
+		}
+	}
+
 	// Pagination
 	req.Limit = parseIntQueryParam(r, "limit", 20)
 	req.Offset = parseIntQueryParam(r, "offset", 0)
 
 	response, err := h.service.ListTournaments(r.Context(), req)
 	if err != nil {
+		if errors.Is(err, tournamentdomain.ErrInvalidLanguage) {
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		h.logger.Error("Failed to list tournaments", "error", err)
 		h.errorResponse(w, http.StatusInternalServerError, "Failed to list tournaments")
 		return
@@ -130,6 +169,11 @@ func (h *TournamentHandler) ListTournaments(w http.ResponseWriter, r *http.Reque
 	h.jsonResponse(w, http.StatusOK, response)
 }
 
+// ListTournamentLanguages handles GET /api/v1/tournaments/languages
+func (h *TournamentHandler) ListTournamentLanguages(w http.ResponseWriter, r *http.Request) {
+	h.jsonResponse(w, http.StatusOK, pagination.All(tournamentdomain.SupportedLanguages()))
+}
+
 // UpdateTournament handles PATCH /api/v1/tournaments/{id}
 func (h *TournamentHandler) UpdateTournament(w http.ResponseWriter, r *http.Request) {
 	idStr := r.PathValue("id")
@@ -152,6 +196,17 @@ func (h *TournamentHandler) UpdateTournament(w http.ResponseWriter, r *http.Requ
 			h.errorResponse(w, http.StatusNotFound, "Tournament not found")
 			return
 		}
+		if errors.Is(err, tournamentdomain.ErrInvalidTierRange) ||
+			errors.Is(err, tournamentdomain.ErrInvalidRosterSize) ||
+			errors.Is(err, tournamentdomain.ErrInvalidScoreCap) ||
+			errors.Is(err, tournamentdomain.ErrInvalidConcurrentLimit) ||
+			errors.Is(err, tournamentdomain.ErrInvalidCleanupRound) ||
+			errors.Is(err, player.ErrInvalidConductScore) ||
+			errors.Is(err, tournamentdomain.ErrInvalidLanguage) ||
+			errors.Is(err, tournamentdomain.ErrTooManyLanguages) {
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 		h.logger.Error("Failed to update tournament", "error", err)
 		h.errorResponse(w, http.StatusInternalServerError, err.Error())
 		return
@@ -186,6 +241,10 @@ func (h *TournamentHandler) UpdateTournamentStatus(w http.ResponseWriter, r *htt
 			h.errorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
+		if errors.Is(err, tournamentdomain.ErrTeamScoreCapExceeded) {
+			h.errorResponse(w, http.StatusConflict, err.Error())
+			return
+		}
 		h.logger.Error("Failed to update tournament status", "error", err)
 		h.errorResponse(w, http.StatusInternalServerError, "Failed to update tournament status")
 		return
@@ -225,7 +284,7 @@ func (h *TournamentHandler) GetActiveTournaments(w http.ResponseWriter, r *http.
 		return
 	}
 
-	h.jsonResponse(w, http.StatusOK, tournaments)
+	h.jsonResponse(w, http.StatusOK, pagination.All(tournaments))
 }
 
 // GetPlayerActiveTournament handles GET /api/v1/players/me/active-tournament
@@ -276,6 +335,916 @@ func (h *TournamentHandler) GetTournamentStats(w http.ResponseWriter, r *http.Re
 	h.jsonResponse(w, http.StatusOK, stats)
 }
 
+// GetTournamentStandings handles GET /api/v1/tournaments/{id}/standings
+func (h *TournamentHandler) GetTournamentStandings(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	standings, err := h.service.GetStandings(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, tournamentdomain.ErrNotFound) {
+			h.errorResponse(w, http.StatusNotFound, "Tournament not found")
+			return
+		}
+		h.logger.Error("Failed to get tournament standings", "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, "Failed to get tournament standings")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, standings)
+}
+
+// GetTournamentAnalytics handles GET /api/v1/tournaments/{id}/analytics
+// Restricted to the tournament creator and admins. Accepts ?bucket=hour|day.
+func (h *TournamentHandler) GetTournamentAnalytics(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	unit := match.BucketUnit(r.URL.Query().Get("bucket"))
+	analytics, err := h.service.GetTournamentAnalytics(r.Context(), id, unit, userID, userInfo.Role == user.RoleAdmin)
+	if err != nil {
+		switch {
+		case errors.Is(err, tournamentdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Tournament not found")
+		case errors.Is(err, tournamentusecase.ErrNotOrganizer):
+			h.errorResponse(w, http.StatusForbidden, err.Error())
+		case errors.Is(err, match.ErrInvalidBucketUnit):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		default:
+			h.logger.Error("Failed to get tournament analytics", "error", err, "tournament_id", id)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to get tournament analytics")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, analytics)
+}
+
+// GetTournamentFillProjection handles GET /api/v1/tournaments/{id}/fill-projection
+// Restricted to the tournament creator and admins. Projects whether the team
+// slots fill before registration closes, to help decide on extending it.
+func (h *TournamentHandler) GetTournamentFillProjection(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	projection, err := h.service.GetFillProjection(r.Context(), id, userID, userInfo.Role == user.RoleAdmin)
+	if err != nil {
+		switch {
+		case errors.Is(err, tournamentdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Tournament not found")
+		case errors.Is(err, tournamentusecase.ErrNotOrganizer):
+			h.errorResponse(w, http.StatusForbidden, err.Error())
+		default:
+			h.logger.Error("Failed to project tournament fill", "error", err, "tournament_id", id)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to project tournament fill")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, projection)
+}
+
+// RequestTournamentExport handles GET /api/v1/tournaments/{id}/export
+// Restricted to the tournament creator and admins. Returns 202 while the
+// bundle is being generated and 200 with a signed download link once ready.
+func (h *TournamentHandler) RequestTournamentExport(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	export, _, err := h.service.RequestExport(r.Context(), id, userID, userInfo.Role == user.RoleAdmin)
+	if err != nil {
+		switch {
+		case errors.Is(err, tournamentdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Tournament not found")
+		case errors.Is(err, tournamentusecase.ErrNotOrganizer):
+			h.errorResponse(w, http.StatusForbidden, "only the tournament organizer can export tournament data")
+		case errors.Is(err, tournamentusecase.ErrExportUnavailable):
+			h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+		default:
+			h.logger.Error("Failed to request tournament export", "error", err, "tournament_id", id)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to request tournament export")
+		}
+		return
+	}
+
+	status := http.StatusOK
+	if export.Status != tournamentdomain.ExportDone {
+		status = http.StatusAccepted
+	}
+	h.jsonResponse(w, status, export)
+}
+
+// DownloadTournamentExport handles GET /api/v1/tournaments/{id}/export/{exportId}
+// Authorized by the signed expires and signature query parameters rather than a session.
+func (h *TournamentHandler) DownloadTournamentExport(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	exportID, err := uuid.Parse(r.PathValue("exportId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid export ID")
+		return
+	}
+
+	expiresUnix, err := strconv.ParseInt(r.URL.Query().Get("expires"), 10, 64)
+	if err != nil {
+		h.errorResponse(w, http.StatusForbidden, tournamentdomain.ErrInvalidExportSignature.Error())
+		return
+	}
+
+	export, err := h.service.DownloadExport(r.Context(), id, exportID, time.Unix(expiresUnix, 0), r.URL.Query().Get("signature"))
+	if err != nil {
+		switch {
+		case errors.Is(err, tournamentdomain.ErrInvalidExportSignature):
+			h.errorResponse(w, http.StatusForbidden, err.Error())
+		case errors.Is(err, tournamentdomain.ErrExportNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Export not found")
+		case errors.Is(err, tournamentdomain.ErrExportNotReady):
+			h.errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, tournamentusecase.ErrExportUnavailable):
+			h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+		default:
+			h.logger.Error("Failed to download tournament export", "error", err, "export_id", exportID)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to download tournament export")
+		}
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="tournament-%s.zip"`, id))
+	w.Header().Set("Content-Length", strconv.Itoa(len(export.Bundle)))
+	w.WriteHeader(http.StatusOK)
+	if _, err := w.Write(export.Bundle); err != nil {
+		h.logger.Error("failed to write export bundle", "error", err)
+	}
+}
+
+// ImportTournament handles POST /api/v1/tournaments/{id}/import
+// Restricted to the tournament creator and admins. Returns the reconciliation
+// report; nothing is written when dry_run is set.
+func (h *TournamentHandler) ImportTournament(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	var req tournamentusecase.ImportRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	report, err := h.service.ImportTournament(r.Context(), id, req, userID, userInfo.Role == user.RoleAdmin)
+	if err != nil {
+		switch {
+		case errors.Is(err, tournamentdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Tournament not found")
+		case errors.Is(err, tournamentusecase.ErrNotOrganizer):
+			h.errorResponse(w, http.StatusForbidden, "only the tournament organizer can import tournament data")
+		case errors.Is(err, tournamentdomain.ErrInvalidImportFormat),
+			errors.Is(err, tournamentdomain.ErrInvalidImportData):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, tournamentusecase.ErrImportUnavailable):
+			h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+		default:
+			h.logger.Error("Failed to import tournament", "error", err, "tournament_id", id)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to import tournament")
+		}
+		return
+	}
+
+	status := http.StatusCreated
+	if report.DryRun {
+		status = http.StatusOK
+	}
+	h.jsonResponse(w, status, report)
+}
+
+// GetTournamentRulebook handles GET /api/v1/tournaments/{id}/rulebook
+func (h *TournamentHandler) GetTournamentRulebook(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	rulebook, err := h.service.GetRulebook(r.Context(), id)
+	if err != nil {
+		switch {
+		case errors.Is(err, tournamentdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Tournament not found")
+		case errors.Is(err, tournamentusecase.ErrRulebookUnavailable):
+			h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+		default:
+			h.logger.Error("Failed to get tournament rulebook", "error", err, "tournament_id", id)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to get tournament rulebook")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, rulebook)
+}
+
+// UpdateTournamentRulebook handles PUT /api/v1/tournaments/{id}/rulebook
+// Restricted to the tournament creator and admins. Replaces all sections;
+// their order in the request is the display order.
+func (h *TournamentHandler) UpdateTournamentRulebook(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	var req tournamentusecase.UpdateRulebookRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	rulebook, err := h.service.UpdateRulebook(r.Context(), id, req, userID, userInfo.Role == user.RoleAdmin)
+	if err != nil {
+		switch {
+		case errors.Is(err, tournamentdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Tournament not found")
+		case errors.Is(err, tournamentusecase.ErrNotOrganizer):
+			h.errorResponse(w, http.StatusForbidden, "only the tournament organizer can edit the rulebook")
+		case errors.Is(err, tournamentdomain.ErrTooManySections),
+			errors.Is(err, tournamentdomain.ErrInvalidSectionKind),
+			errors.Is(err, tournamentdomain.ErrInvalidSection),
+			errors.Is(err, tournamentdomain.ErrDuplicateSection):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, tournamentusecase.ErrRulebookUnavailable):
+			h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+		default:
+			h.logger.Error("Failed to update tournament rulebook", "error", err, "tournament_id", id)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to update tournament rulebook")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, rulebook)
+}
+
+// ListRosterOverlaps handles GET /api/v1/tournaments/{id}/roster-overlaps
+// Restricted to the tournament creator and admins.
+func (h *TournamentHandler) ListRosterOverlaps(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	overlaps, err := h.service.ListRosterOverlaps(r.Context(), id, userID, userInfo.Role == user.RoleAdmin)
+	if err != nil {
+		switch {
+		case errors.Is(err, tournamentdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Tournament not found")
+		case errors.Is(err, tournamentusecase.ErrNotOrganizer):
+			h.errorResponse(w, http.StatusForbidden, "only the tournament organizer can review roster overlaps")
+		case errors.Is(err, tournamentusecase.ErrRosterOverlapsUnavailable):
+			h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+		default:
+			h.logger.Error("Failed to list roster overlaps", "error", err, "tournament_id", id)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to list roster overlaps")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(overlaps))
+}
+
+// ReviewRosterOverlap handles POST /api/v1/tournaments/{id}/roster-overlaps/{overlapId}/review
+// Restricted to the tournament creator and admins.
+func (h *TournamentHandler) ReviewRosterOverlap(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	overlapID, err := uuid.Parse(r.PathValue("overlapId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid overlap ID")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	var req tournamentusecase.ReviewRosterOverlapRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	overlap, err := h.service.ReviewRosterOverlap(r.Context(), id, overlapID, req, userID, userInfo.Role == user.RoleAdmin)
+	if err != nil {
+		switch {
+		case errors.Is(err, tournamentdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Tournament not found")
+		case errors.Is(err, team.ErrRosterOverlapNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Roster overlap not found")
+		case errors.Is(err, tournamentusecase.ErrNotOrganizer):
+			h.errorResponse(w, http.StatusForbidden, "only the tournament organizer can review roster overlaps")
+		case errors.Is(err, team.ErrInvalidOverlapReview):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, team.ErrRosterOverlapReviewed):
+			h.errorResponse(w, http.StatusConflict, err.Error())
+		case errors.Is(err, tournamentusecase.ErrRosterOverlapsUnavailable):
+			h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+		default:
+			h.logger.Error("Failed to review roster overlap", "error", err, "tournament_id", id, "overlap_id", overlapID)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to review roster overlap")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, overlap)
+}
+
+// ListTournamentInvites handles GET /api/v1/tournaments/{id}/invites
+// Public list of the teams invited to the tournament, in the order they were
+// invited, with the slots they hold.
+func (h *TournamentHandler) ListTournamentInvites(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	invites, err := h.service.ListInvites(r.Context(), id)
+	if err != nil {
+		h.inviteError(w, err, "Failed to list tournament invitations")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, invites)
+}
+
+// InviteToTournament handles POST /api/v1/tournaments/{id}/invites
+// Restricted to the tournament creator and admins. The invitation holds a
+// slot if one is free and waits as a reserve otherwise.
+func (h *TournamentHandler) InviteToTournament(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	var req tournamentusecase.InviteRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	invites, err := h.service.InvitePlayer(r.Context(), id, req, userID, userInfo.Role == user.RoleAdmin)
+	if err != nil {
+		h.inviteError(w, err, "Failed to invite player")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, invites)
+}
+
+// RevokeTournamentInvite handles DELETE /api/v1/tournaments/{id}/invites/{playerId}
+// Restricted to the tournament creator and admins. The freed slot goes to
+// the first reserve.
+func (h *TournamentHandler) RevokeTournamentInvite(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	playerID, err := uuid.Parse(r.PathValue("playerId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid player ID")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	invites, err := h.service.RevokeInvite(r.Context(), id, playerID, userID, userInfo.Role == user.RoleAdmin)
+	if err != nil {
+		h.inviteError(w, err, "Failed to revoke invitation")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, invites)
+}
+
+// DeclineTournamentInvite handles POST /api/v1/tournaments/{id}/invites/decline
+// Gives up the caller's invitation; the freed slot goes to the first reserve.
+func (h *TournamentHandler) DeclineTournamentInvite(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	invites, err := h.service.DeclineInvite(r.Context(), id, userID)
+	if err != nil {
+		h.inviteError(w, err, "Failed to decline invitation")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, invites)
+}
+
+// inviteError maps tournament invitation errors to HTTP responses.
+func (h *TournamentHandler) inviteError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, tournamentdomain.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Tournament not found")
+	case errors.Is(err, tournamentdomain.ErrInviteNotFound):
+		h.errorResponse(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, tournamentusecase.ErrNotOrganizer):
+		h.errorResponse(w, http.StatusForbidden, "only the tournament organizer can manage invitations")
+	case errors.Is(err, tournamentdomain.ErrInvalidInviteName),
+		errors.Is(err, tournamentdomain.ErrTooManyInvites):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, tournamentdomain.ErrAlreadyInvited),
+		errors.Is(err, tournamentdomain.ErrInviteAccepted),
+		errors.Is(err, tournamentdomain.ErrInviteNotOpen):
+		h.errorResponse(w, http.StatusConflict, err.Error())
+	default:
+		h.logger.Error(fallback, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// GetTournamentSeeding handles GET /api/v1/tournaments/{id}/seeding
+func (h *TournamentHandler) GetTournamentSeeding(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	seeding, err := h.service.GetSeeding(r.Context(), id)
+	if err != nil {
+		h.seedingError(w, err, "Failed to get tournament seeding")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, seeding)
+}
+
+// ImportTournamentSeeding handles POST /api/v1/tournaments/{id}/seeding/import
+// Restricted to the tournament creator and admins. Seeds the registered teams
+// by a finished tournament's final standings, matching them by roster.
+func (h *TournamentHandler) ImportTournamentSeeding(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	var req tournamentusecase.ImportSeedingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	seeding, err := h.service.ImportSeeding(r.Context(), id, req, userID, userInfo.Role == user.RoleAdmin)
+	if err != nil {
+		h.seedingError(w, err, "Failed to import tournament seeding")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, seeding)
+}
+
+// ResolveTournamentSeed handles PUT /api/v1/tournaments/{id}/seeding/{teamId}
+// Restricted to the tournament creator and admins. Confirms or corrects the
+// source team a team with a changed roster is seeded from.
+func (h *TournamentHandler) ResolveTournamentSeed(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	teamID, err := uuid.Parse(r.PathValue("teamId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid team ID")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	var req tournamentusecase.ResolveSeedRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	seeding, err := h.service.ResolveSeed(r.Context(), id, teamID, req, userID, userInfo.Role == user.RoleAdmin)
+	if err != nil {
+		h.seedingError(w, err, "Failed to resolve seed")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, seeding)
+}
+
+// seedingError maps tournament seeding errors to HTTP responses.
+func (h *TournamentHandler) seedingError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, tournamentdomain.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Tournament not found")
+	case errors.Is(err, team.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Team not found")
+	case errors.Is(err, tournamentdomain.ErrSeedingNotFound),
+		errors.Is(err, tournamentdomain.ErrSeedTeamNotFound):
+		h.errorResponse(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, tournamentusecase.ErrNotOrganizer):
+		h.errorResponse(w, http.StatusForbidden, "only the tournament organizer can seed the tournament")
+	case errors.Is(err, tournamentdomain.ErrInvalidSeedingSource),
+		errors.Is(err, tournamentdomain.ErrSourceTeamNotFound):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, tournamentdomain.ErrSeedingLocked),
+		errors.Is(err, tournamentdomain.ErrSourceTeamTaken):
+		h.errorResponse(w, http.StatusConflict, err.Error())
+	default:
+		h.logger.Error(fallback, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// GetPlayerConduct handles GET /api/v1/tournaments/{id}/players/{playerId}/conduct
+// Restricted to the tournament creator and admins. Returns the player's
+// conduct score and the records behind it.
+func (h *TournamentHandler) GetPlayerConduct(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	playerID, err := uuid.Parse(r.PathValue("playerId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid player ID")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	conduct, err := h.service.GetPlayerConduct(r.Context(), id, playerID, userID, userInfo.Role == user.RoleAdmin)
+	if err != nil {
+		h.conductError(w, err, "Failed to get player conduct")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, conduct)
+}
+
+// RecordConduct handles POST /api/v1/tournaments/{id}/conduct
+// Restricted to the tournament creator and admins. Reports a no-show, a lost
+// dispute or other misconduct of a player on one of the tournament's teams.
+func (h *TournamentHandler) RecordConduct(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return
+	}
+
+	var req tournamentusecase.RecordConductRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	record, err := h.service.RecordConduct(r.Context(), id, req, userID, userInfo.Role == user.RoleAdmin)
+	if err != nil {
+		h.conductError(w, err, "Failed to record conduct")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, record)
+}
+
+// conductError maps player conduct errors to HTTP responses.
+func (h *TournamentHandler) conductError(w http.ResponseWriter, err error, fallback string) {
+	switch {
+	case errors.Is(err, tournamentdomain.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Tournament not found")
+	case errors.Is(err, match.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Match not found")
+	case errors.Is(err, player.ErrNotFound):
+		h.errorResponse(w, http.StatusNotFound, "Player not found")
+	case errors.Is(err, tournamentusecase.ErrPlayerNotInTournament):
+		h.errorResponse(w, http.StatusNotFound, err.Error())
+	case errors.Is(err, tournamentusecase.ErrNotOrganizer):
+		h.errorResponse(w, http.StatusForbidden, "only the tournament organizer can manage player conduct")
+	case errors.Is(err, player.ErrInvalidConductKind),
+		errors.Is(err, player.ErrConductNoteTooLong):
+		h.errorResponse(w, http.StatusBadRequest, err.Error())
+	case errors.Is(err, tournamentusecase.ErrConductUnavailable):
+		h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+	default:
+		h.logger.Error(fallback, "error", err)
+		h.errorResponse(w, http.StatusInternalServerError, fallback)
+	}
+}
+
+// createBackupRequest is the optional body of a backup request.
+type createBackupRequest struct {
+	Reason string `json:"reason"`
+}
+
+// CreateTournamentBackup handles POST /api/v1/admin/tournaments/{id}/backups
+// Snapshots the tournament, its teams and its matches to object storage.
+func (h *TournamentHandler) CreateTournamentBackup(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	userID, ok := h.adminID(w, r)
+	if !ok {
+		return
+	}
+
+	var req createBackupRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil && !errors.Is(err, io.EOF) {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid request body")
+		return
+	}
+
+	backup, err := h.service.CreateBackup(r.Context(), id, userID, req.Reason)
+	if err != nil {
+		switch {
+		case errors.Is(err, tournamentdomain.ErrNotFound):
+			h.errorResponse(w, http.StatusNotFound, "Tournament not found")
+		case errors.Is(err, tournamentdomain.ErrInvalidBackupReason):
+			h.errorResponse(w, http.StatusBadRequest, err.Error())
+		case errors.Is(err, tournamentusecase.ErrBackupUnavailable):
+			h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+		default:
+			h.logger.Error("Failed to back up tournament", "error", err, "tournament_id", id)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to back up tournament")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusCreated, backup)
+}
+
+// ListTournamentBackups handles GET /api/v1/admin/tournaments/{id}/backups
+// Lists backups newest first, including those of a deleted tournament.
+func (h *TournamentHandler) ListTournamentBackups(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+
+	backups, err := h.service.ListBackups(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, tournamentusecase.ErrBackupUnavailable) {
+			h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+			return
+		}
+		h.logger.Error("Failed to list tournament backups", "error", err, "tournament_id", id)
+		h.errorResponse(w, http.StatusInternalServerError, "Failed to list tournament backups")
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, pagination.All(backups))
+}
+
+// RestoreTournamentBackup handles POST /api/v1/admin/tournaments/{id}/backups/{backupId}/restore
+// Rolls the tournament back to the backup after backing up its current state.
+func (h *TournamentHandler) RestoreTournamentBackup(w http.ResponseWriter, r *http.Request) {
+	id, err := uuid.Parse(r.PathValue("id"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid tournament ID")
+		return
+	}
+	backupID, err := uuid.Parse(r.PathValue("backupId"))
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid backup ID")
+		return
+	}
+
+	userID, ok := h.adminID(w, r)
+	if !ok {
+		return
+	}
+
+	resp, err := h.service.RestoreBackup(r.Context(), id, backupID, userID)
+	if err != nil {
+		switch {
+		case errors.Is(err, tournamentdomain.ErrBackupNotFound):
+			h.errorResponse(w, http.StatusNotFound, err.Error())
+		case errors.Is(err, tournamentdomain.ErrBackupMismatch),
+			errors.Is(err, tournamentusecase.ErrUnsupportedBackup):
+			h.errorResponse(w, http.StatusUnprocessableEntity, err.Error())
+		case errors.Is(err, tournamentusecase.ErrBackupUnavailable):
+			h.errorResponse(w, http.StatusServiceUnavailable, err.Error())
+		default:
+			h.logger.Error("Failed to restore tournament backup", "error", err, "tournament_id", id, "backup_id", backupID)
+			h.errorResponse(w, http.StatusInternalServerError, "Failed to restore tournament backup")
+		}
+		return
+	}
+
+	h.jsonResponse(w, http.StatusOK, resp)
+}
+
+// adminID returns the authenticated admin's user ID, writing an error response when it is missing.
+func (h *TournamentHandler) adminID(w http.ResponseWriter, r *http.Request) (uuid.UUID, bool) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		h.errorResponse(w, http.StatusUnauthorized, "Unauthorized")
+		return uuid.Nil, false
+	}
+
+	userID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		h.errorResponse(w, http.StatusBadRequest, "Invalid user ID")
+		return uuid.Nil, false
+	}
+	return userID, true
+}
+
 // jsonResponse writes a JSON response.
 func (h *TournamentHandler) jsonResponse(w http.ResponseWriter, status int, data interface{}) {
 	w.Header().Set("Content-Type", "application/json")
@@ -0,0 +1,153 @@
+package http
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+// maxLatencySamples bounds how many check latencies a dependency keeps, for
+// readiness probed far more often than usual.
+const maxLatencySamples = 1000
+
+// LatencyBudget is how slow a dependency's readiness checks may get before
+// the instance reports itself not ready. Percentiles are computed over the
+// checks of the last Window, so the checks that pushed p99 over budget age
+// out after one window once the dependency recovers.
+type LatencyBudget struct {
+	P99    time.Duration // Highest acceptable p99 check latency
+	Window time.Duration // How long p99 must stay above P99 before readiness fails
+}
+
+// LatencyReport is a dependency's recent check latency, as reported by the
+// readiness endpoint.
+type LatencyReport struct {
+	Samples         int        `json:"samples"`
+	P50             string     `json:"p50"`
+	P95             string     `json:"p95"`
+	P99             string     `json:"p99"`
+	BudgetP99       string     `json:"budget_p99"`
+	OverBudgetSince *time.Time `json:"over_budget_since,omitempty"`
+	Degraded        bool       `json:"degraded"` // p99 has been over budget for the whole window
+}
+
+// latencyTracker keeps a rolling window of a dependency's check latencies
+// and tracks how long their p99 has been over budget.
+type latencyTracker struct {
+	budget LatencyBudget
+
+	mu        sync.Mutex
+	samples   []latencySample // Oldest first
+	overSince time.Time       // Zero while p99 is within budget
+}
+
+type latencySample struct {
+	at      time.Time
+	latency time.Duration
+}
+
+func newLatencyTracker(budget LatencyBudget) *latencyTracker {
+	return &latencyTracker{budget: budget}
+}
+
+// observe records a check's latency, taken at now. Failed checks count too:
+// a check that timed out was as slow as its timeout.
+func (t *latencyTracker) observe(latency time.Duration, now time.Time) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.samples = append(t.samples, latencySample{at: now, latency: latency})
+	drop := max(len(t.samples)-maxLatencySamples, 0)
+	for drop < len(t.samples) && now.Sub(t.samples[drop].at) >= t.budget.Window {
+		drop++
+	}
+	t.samples = append(t.samples[:0], t.samples[drop:]...)
+
+	if t.percentile(0.99) > t.budget.P99 {
+		if t.overSince.IsZero() {
+			t.overSince = now
+		}
+	} else {
+		t.overSince = time.Time{}
+	}
+}
+
+// report returns the dependency's latency percentiles and whether it is
+// degraded at now.
+func (t *latencyTracker) report(now time.Time) LatencyReport {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	r := LatencyReport{
+		Samples:   len(t.samples),
+		P50:       t.percentile(0.50).String(),
+		P95:       t.percentile(0.95).String(),
+		P99:       t.percentile(0.99).String(),
+		BudgetP99: t.budget.P99.String(),
+	}
+	if !t.overSince.IsZero() {
+		since := t.overSince
+		r.OverBudgetSince = &since
+		r.Degraded = now.Sub(since) >= t.budget.Window
+	}
+	return r
+}
+
+// percentile returns the nearest-rank percentile p of the samples. The
+// caller holds t.mu.
+func (t *latencyTracker) percentile(p float64) time.Duration {
+	if len(t.samples) == 0 {
+		return 0
+	}
+	sorted := make([]time.Duration, len(t.samples))
+	for i, s := range t.samples {
+		sorted[i] = s.latency
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	rank := int(math.Ceil(p*float64(len(sorted)))) - 1
+	return sorted[max(rank, 0)]
+}
+
+// writeLatencyMetrics writes the latency percentiles and budget state of
+// dependencies with a latency budget in the Prometheus text format.
+func writeLatencyMetrics(w io.Writer, trackers map[string]*latencyTracker) {
+	if len(trackers) == 0 {
+		return
+	}
+
+	dependencies := make([]string, 0, len(trackers))
+	for dependency := range trackers {
+		dependencies = append(dependencies, dependency)
+	}
+	sort.Strings(dependencies)
+
+	percentiles := make([][3]time.Duration, len(dependencies))
+	overBudget := make([]int, len(dependencies))
+	for i, dependency := range dependencies {
+		t := trackers[dependency]
+		t.mu.Lock()
+		percentiles[i] = [3]time.Duration{t.percentile(0.50), t.percentile(0.95), t.percentile(0.99)}
+		if !t.overSince.IsZero() {
+			overBudget[i] = 1
+		}
+		t.mu.Unlock()
+	}
+
+	fmt.Fprintln(w, "# HELP tourneyrank_dependency_latency_seconds Readiness check latency of a dependency over its recent checks.")
+	fmt.Fprintln(w, "# TYPE tourneyrank_dependency_latency_seconds gauge")
+	for i, dependency := range dependencies {
+		for j, quantile := range []string{"0.5", "0.95", "0.99"} {
+			fmt.Fprintf(w, "tourneyrank_dependency_latency_seconds{dependency=%q,quantile=%q} %g\n", dependency, quantile, percentiles[i][j].Seconds())
+		}
+	}
+
+	fmt.Fprintln(w, "# HELP tourneyrank_dependency_over_budget Whether a dependency's p99 check latency is over its budget.")
+	fmt.Fprintln(w, "# TYPE tourneyrank_dependency_over_budget gauge")
+	for i, dependency := range dependencies {
+		fmt.Fprintf(w, "tourneyrank_dependency_over_budget{dependency=%q} %d\n", dependency, overBudget[i])
+	}
+}
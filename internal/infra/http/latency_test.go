@@ -0,0 +1,156 @@
+package http
+
+import (
+	"testing"
+	"time"
+)
+
+// TestLatencyTracker feeds check latencies taken at set times to a tracker
+// with a 100ms p99 budget over a one-minute window, and checks its report.
+func TestLatencyTracker(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	budget := LatencyBudget{P99: 100 * time.Millisecond, Window: time.Minute}
+
+	type check struct {
+		at      time.Duration // Since start
+		latency time.Duration
+	}
+	ms := time.Millisecond
+
+	tests := []struct {
+		name      string
+		checks    []check
+		reportAt  time.Duration
+		samples   int
+		p50       string
+		p95       string
+		p99       string
+		overSince time.Duration // Since start; -1 when within budget
+		degraded  bool
+	}{
+		{
+			name:      "empty window",
+			samples:   0,
+			p50:       "0s",
+			p95:       "0s",
+			p99:       "0s",
+			overSince: -1,
+		},
+		{
+			name:      "single sample",
+			checks:    []check{{0, 40 * ms}},
+			samples:   1,
+			p50:       "40ms",
+			p95:       "40ms",
+			p99:       "40ms",
+			overSince: -1,
+		},
+		{
+			name:      "nearest rank on three samples",
+			checks:    []check{{0, 30 * ms}, {time.Second, 10 * ms}, {2 * time.Second, 20 * ms}},
+			reportAt:  2 * time.Second,
+			samples:   3,
+			p50:       "20ms",
+			p95:       "30ms",
+			p99:       "30ms",
+			overSince: -1,
+		},
+		{
+			name:      "samples a window old are evicted",
+			checks:    []check{{0, 90 * ms}, {30 * time.Second, 50 * ms}, {time.Minute, 10 * ms}},
+			reportAt:  time.Minute,
+			samples:   2,
+			p50:       "10ms",
+			p95:       "50ms",
+			p99:       "50ms",
+			overSince: -1,
+		},
+		{
+			name:      "over budget within the grace period",
+			checks:    []check{{0, 200 * ms}},
+			reportAt:  59 * time.Second,
+			samples:   1,
+			p50:       "200ms",
+			p95:       "200ms",
+			p99:       "200ms",
+			overSince: 0,
+		},
+		{
+			name:      "over budget for a whole window",
+			checks:    []check{{0, 200 * ms}, {30 * time.Second, 150 * ms}},
+			reportAt:  time.Minute,
+			samples:   2,
+			p50:       "150ms",
+			p95:       "200ms",
+			p99:       "200ms",
+			overSince: 0,
+			degraded:  true,
+		},
+		{
+			name:      "grace period restarts after recovery",
+			checks:    []check{{0, 200 * ms}, {time.Minute, 10 * ms}, {90 * time.Second, 300 * ms}},
+			reportAt:  2 * time.Minute,
+			samples:   2,
+			p50:       "10ms",
+			p95:       "300ms",
+			p99:       "300ms",
+			overSince: 90 * time.Second,
+		},
+		{
+			name:      "recovers below budget once slow checks age out",
+			checks:    []check{{0, 200 * ms}, {30 * time.Second, 20 * ms}, {time.Minute, 20 * ms}},
+			reportAt:  2 * time.Minute,
+			samples:   2,
+			p50:       "20ms",
+			p95:       "20ms",
+			p99:       "20ms",
+			overSince: -1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tracker := newLatencyTracker(budget)
+			for _, c := range tc.checks {
+				tracker.observe(c.latency, start.Add(c.at))
+			}
+
+			r := tracker.report(start.Add(tc.reportAt))
+			if r.Samples != tc.samples {
+				t.Errorf("samples: got %d, want %d", r.Samples, tc.samples)
+			}
+			if r.P50 != tc.p50 || r.P95 != tc.p95 || r.P99 != tc.p99 {
+				t.Errorf("percentiles: got %s/%s/%s, want %s/%s/%s", r.P50, r.P95, r.P99, tc.p50, tc.p95, tc.p99)
+			}
+			switch {
+			case tc.overSince < 0 && r.OverBudgetSince != nil:
+				t.Errorf("over budget since %v, want within budget", r.OverBudgetSince)
+			case tc.overSince >= 0 && (r.OverBudgetSince == nil || !r.OverBudgetSince.Equal(start.Add(tc.overSince))):
+				t.Errorf("over budget since %v, want %v", r.OverBudgetSince, start.Add(tc.overSince))
+			}
+			if r.Degraded != tc.degraded {
+				t.Errorf("degraded: got %v, want %v", r.Degraded, tc.degraded)
+			}
+		})
+	}
+}
+
+// TestLatencyTrackerSampleCap checks a tracker probed faster than its window
+// drains keeps only the newest maxLatencySamples checks.
+func TestLatencyTrackerSampleCap(t *testing.T) {
+	start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tracker := newLatencyTracker(LatencyBudget{P99: time.Second, Window: time.Hour})
+
+	tracker.observe(5*time.Second, start)
+	for i := range maxLatencySamples {
+		tracker.observe(time.Millisecond, start.Add(time.Duration(i+1)*time.Millisecond))
+	}
+
+	r := tracker.report(start.Add(time.Second))
+	if r.Samples != maxLatencySamples {
+		t.Errorf("samples: got %d, want %d", r.Samples, maxLatencySamples)
+	}
+	if r.P99 != "1ms" || r.OverBudgetSince != nil {
+		t.Errorf("oldest slow check kept: p99 %s, over budget since %v", r.P99, r.OverBudgetSince)
+	}
+}
@@ -0,0 +1,177 @@
+// Package loader provides request-scoped, batching caches for documents that
+// composite endpoints look up repeatedly while building a response.
+package loader
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+)
+
+// ErrNoSource is returned when a lookup needs a source the loader was built without.
+var ErrNoSource = errors.New("loader source not configured")
+
+// GameSource fetches games, individually or in bulk.
+type GameSource interface {
+	GetByIDs(ctx context.Context, ids []string) ([]*game.Game, error)
+}
+
+// PlayerSource fetches players in bulk.
+type PlayerSource interface {
+	GetByIDs(ctx context.Context, ids []string) ([]*player.Player, error)
+}
+
+// Loader caches game and player lookups for the lifetime of a single request.
+// Lookups for IDs that were not found are cached too, so a missing document
+// costs one round trip per request. A Loader is safe for concurrent use.
+type Loader struct {
+	games   GameSource
+	players PlayerSource
+
+	mu          sync.Mutex
+	gameCache   map[string]*game.Game
+	playerCache map[string]*player.Player
+}
+
+// New creates an empty loader. Either source may be nil if the caller never
+// looks up that kind of document.
+func New(games GameSource, players PlayerSource) *Loader {
+	return &Loader{
+		games:       games,
+		players:     players,
+		gameCache:   make(map[string]*game.Game),
+		playerCache: make(map[string]*player.Player),
+	}
+}
+
+// Game returns a single game, fetching it if it is not cached yet.
+func (l *Loader) Game(ctx context.Context, id string) (*game.Game, error) {
+	games, err := l.Games(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	g, ok := games[id]
+	if !ok {
+		return nil, game.ErrNotFound
+	}
+	return g, nil
+}
+
+// Games returns the requested games keyed by ID, fetching every uncached ID
+// in one batch. IDs with no matching game are absent from the result.
+func (l *Loader) Games(ctx context.Context, ids []string) (map[string]*game.Game, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var missing []string
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if _, cached := l.gameCache[id]; !cached && !seen[id] {
+			missing = append(missing, id)
+			seen[id] = true
+		}
+	}
+
+	if len(missing) > 0 {
+		if l.games == nil {
+			return nil, ErrNoSource
+		}
+		fetched, err := l.games.GetByIDs(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range missing {
+			l.gameCache[id] = nil
+		}
+		for _, g := range fetched {
+			l.gameCache[g.ID.String()] = g
+		}
+	}
+
+	result := make(map[string]*game.Game, len(ids))
+	for _, id := range ids {
+		if g := l.gameCache[id]; g != nil {
+			result[id] = g
+		}
+	}
+	return result, nil
+}
+
+// Player returns a single player, fetching it if it is not cached yet.
+func (l *Loader) Player(ctx context.Context, id string) (*player.Player, error) {
+	players, err := l.Players(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+	p, ok := players[id]
+	if !ok {
+		return nil, player.ErrNotFound
+	}
+	return p, nil
+}
+
+// Players returns the requested players keyed by ID, fetching every uncached
+// ID in one batch. IDs with no matching player are absent from the result.
+func (l *Loader) Players(ctx context.Context, ids []string) (map[string]*player.Player, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var missing []string
+	seen := make(map[string]bool, len(ids))
+	for _, id := range ids {
+		if _, cached := l.playerCache[id]; !cached && !seen[id] {
+			missing = append(missing, id)
+			seen[id] = true
+		}
+	}
+
+	if len(missing) > 0 {
+		if l.players == nil {
+			return nil, ErrNoSource
+		}
+		fetched, err := l.players.GetByIDs(ctx, missing)
+		if err != nil {
+			return nil, err
+		}
+		for _, id := range missing {
+			l.playerCache[id] = nil
+		}
+		for _, p := range fetched {
+			l.playerCache[p.ID.String()] = p
+		}
+	}
+
+	result := make(map[string]*player.Player, len(ids))
+	for _, id := range ids {
+		if p := l.playerCache[id]; p != nil {
+			result[id] = p
+		}
+	}
+	return result, nil
+}
+
+type contextKey struct{}
+
+// WithLoader returns a copy of ctx carrying l.
+func WithLoader(ctx context.Context, l *Loader) context.Context {
+	return context.WithValue(ctx, contextKey{}, l)
+}
+
+// FromContext returns the loader attached to ctx, if any.
+func FromContext(ctx context.Context) (*Loader, bool) {
+	l, ok := ctx.Value(contextKey{}).(*Loader)
+	return l, ok
+}
+
+// Middleware attaches a fresh loader to every request.
+func Middleware(games GameSource, players PlayerSource) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(WithLoader(r.Context(), New(games, players))))
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package http
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
+)
+
+// TestLoadShedderRouteGroups sends requests to the router's routes and to
+// made-up paths, and checks only the router's route groups and configured
+// ones get their own metric series.
+func TestLoadShedderRouteGroups(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	shedder := middleware.NewLoadShedder(10, map[string]int{"admin": 2}, logger)
+	router := NewRouter(logger, WithLoadShedding(shedder))
+
+	for _, path := range []string{
+		"/api/v1/ping",
+		"/api/v1/admin/unknown",
+		"/api/v1/made-up-1/x",
+		"/api/v1/made-up-2",
+		"/api/made-up-3",
+	} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, path, nil))
+	}
+
+	var metrics bytes.Buffer
+	shedder.WriteMetrics(&metrics)
+	var groups []string
+	for _, line := range strings.Split(metrics.String(), "\n") {
+		if name, ok := strings.CutPrefix(line, `tourneyrank_http_requests_served_total{group="`); ok {
+			group, _, _ := strings.Cut(name, `"`)
+			groups = append(groups, group+" "+line[strings.LastIndex(line, " ")+1:])
+		}
+	}
+
+	want := []string{"admin 1", "other 3", "ping 1"}
+	if strings.Join(groups, ",") != strings.Join(want, ",") {
+		t.Errorf("served by group: got %v, want %v", groups, want)
+	}
+}
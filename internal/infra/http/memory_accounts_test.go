@@ -0,0 +1,1013 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alejaam/tourney-rank/internal/domain/developer"
+	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/integrity"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/user"
+	"github.com/alejaam/tourney-rank/internal/infra/mongodb"
+)
+
+// The in-memory repositories below back the contract tests. They follow the
+// MongoDB repositories' observable behavior (not-found and conflict errors,
+// ordering, filters the handlers rely on) closely enough for every endpoint to
+// produce its real response shape, without a database.
+
+type memUsers struct {
+	users   map[string]*user.User
+	players *memPlayers
+}
+
+func newMemUsers(players *memPlayers) *memUsers {
+	return &memUsers{users: make(map[string]*user.User), players: players}
+}
+
+func (r *memUsers) Create(_ context.Context, u *user.User) error {
+	for _, existing := range r.users {
+		if strings.EqualFold(existing.Email, u.Email) || existing.Username == u.Username {
+			return fmt.Errorf("user already exists")
+		}
+	}
+	r.users[u.ID.String()] = u
+	return nil
+}
+
+func (r *memUsers) GetByID(_ context.Context, id string) (*user.User, error) {
+	if u, ok := r.users[id]; ok {
+		return u, nil
+	}
+	return nil, user.ErrNotFound
+}
+
+func (r *memUsers) GetByEmail(_ context.Context, email string) (*user.User, error) {
+	for _, u := range r.users {
+		if u.Email == email {
+			return u, nil
+		}
+	}
+	return nil, user.ErrNotFound
+}
+
+func (r *memUsers) GetByUsername(_ context.Context, username string) (*user.User, error) {
+	for _, u := range r.users {
+		if u.Username == username {
+			return u, nil
+		}
+	}
+	return nil, user.ErrNotFound
+}
+
+func (r *memUsers) UpdateEmail(_ context.Context, id string, email string) error {
+	u, ok := r.users[id]
+	if !ok {
+		return user.ErrNotFound
+	}
+	for _, other := range r.users {
+		if other != u && other.Email == email {
+			return user.ErrEmailTaken
+		}
+	}
+	u.Email = email
+	u.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func (r *memUsers) UpdatePassword(_ context.Context, id string, passwordHash string) error {
+	u, ok := r.users[id]
+	if !ok {
+		return user.ErrNotFound
+	}
+	u.PasswordHash = passwordHash
+	u.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func (r *memUsers) UpdateDeactivation(_ context.Context, id string, deactivatedAt *time.Time) error {
+	u, ok := r.users[id]
+	if !ok {
+		return user.ErrNotFound
+	}
+	u.DeactivatedAt = deactivatedAt
+	u.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func (r *memUsers) GetAll(_ context.Context) ([]*user.User, error) {
+	return r.sorted(), nil
+}
+
+func (r *memUsers) List(_ context.Context, filter user.ListFilter) ([]*user.User, error) {
+	matched := r.filter(filter)
+	return page(matched, filter.Offset, filter.Limit), nil
+}
+
+func (r *memUsers) Count(_ context.Context, filter user.ListFilter) (int64, error) {
+	return int64(len(r.filter(filter))), nil
+}
+
+func (r *memUsers) Delete(_ context.Context, id string) error {
+	if _, ok := r.users[id]; !ok {
+		return user.ErrNotFound
+	}
+	delete(r.users, id)
+	return nil
+}
+
+func (r *memUsers) UpdateRole(_ context.Context, id string, role user.Role) error {
+	u, ok := r.users[id]
+	if !ok {
+		return user.ErrNotFound
+	}
+	u.Role = role
+	u.UpdatedAt = time.Now().UTC()
+	return nil
+}
+
+func (r *memUsers) sorted() []*user.User {
+	users := make([]*user.User, 0, len(r.users))
+	for _, u := range r.users {
+		users = append(users, u)
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].Username < users[j].Username })
+	return users
+}
+
+func (r *memUsers) filter(filter user.ListFilter) []*user.User {
+	matched := []*user.User{}
+	for _, u := range r.sorted() {
+		if filter.Role != "" && u.Role != filter.Role {
+			continue
+		}
+		if filter.Search != "" {
+			search := strings.ToLower(filter.Search)
+			if !strings.Contains(strings.ToLower(u.Username), search) && !strings.Contains(strings.ToLower(u.Email), search) {
+				continue
+			}
+		}
+		if filter.CreatedFrom != nil && u.CreatedAt.Before(*filter.CreatedFrom) {
+			continue
+		}
+		if filter.CreatedTo != nil && !u.CreatedAt.Before(*filter.CreatedTo) {
+			continue
+		}
+		if filter.Banned != nil {
+			p, _ := r.players.GetByUserID(context.Background(), u.ID.String())
+			if (p != nil && p.IsBanned) != *filter.Banned {
+				continue
+			}
+		}
+		matched = append(matched, u)
+	}
+	return matched
+}
+
+type memConsents struct {
+	consents []*user.Consent
+}
+
+func (r *memConsents) Create(_ context.Context, consents []*user.Consent) error {
+	r.consents = append(r.consents, consents...)
+	return nil
+}
+
+func (r *memConsents) Latest(_ context.Context, userID uuid.UUID) (map[user.Policy]*user.Consent, error) {
+	latest := make(map[user.Policy]*user.Consent)
+	for _, c := range r.consents {
+		if c.UserID == userID {
+			latest[c.Policy] = c
+		}
+	}
+	return latest, nil
+}
+
+func (r *memConsents) CountLatestVersions(ctx context.Context) ([]user.ConsentVersionCount, error) {
+	users := make(map[uuid.UUID]bool)
+	for _, c := range r.consents {
+		users[c.UserID] = true
+	}
+
+	counts := make(map[user.ConsentVersionCount]int64)
+	for userID := range users {
+		latest, _ := r.Latest(ctx, userID)
+		for policy, c := range latest {
+			counts[user.ConsentVersionCount{Policy: policy, Version: c.Version}]++
+		}
+	}
+
+	result := []user.ConsentVersionCount{}
+	for key, n := range counts {
+		key.Users = n
+		result = append(result, key)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Policy != result[j].Policy {
+			return result[i].Policy < result[j].Policy
+		}
+		return result[i].Version < result[j].Version
+	})
+	return result, nil
+}
+
+type memEmailChanges struct {
+	changes []*user.EmailChange
+}
+
+func (r *memEmailChanges) Create(_ context.Context, change *user.EmailChange) error {
+	r.changes = append(r.changes, change)
+	return nil
+}
+
+func (r *memEmailChanges) GetByTokenHash(_ context.Context, tokenHash string) (*user.EmailChange, error) {
+	for _, c := range r.changes {
+		if c.TokenHash == tokenHash {
+			return c, nil
+		}
+	}
+	return nil, user.ErrEmailChangeNotFound
+}
+
+func (r *memEmailChanges) DeleteByUser(_ context.Context, userID uuid.UUID) error {
+	kept := r.changes[:0]
+	for _, c := range r.changes {
+		if c.UserID != userID {
+			kept = append(kept, c)
+		}
+	}
+	r.changes = kept
+	return nil
+}
+
+type memPlayers struct {
+	players map[string]*player.Player
+	stats   *memStats
+}
+
+func newMemPlayers() *memPlayers {
+	return &memPlayers{players: make(map[string]*player.Player)}
+}
+
+func (r *memPlayers) Create(_ context.Context, p *player.Player) error {
+	r.players[p.ID.String()] = p
+	return nil
+}
+
+func (r *memPlayers) GetByID(_ context.Context, id string) (*player.Player, error) {
+	if p, ok := r.players[id]; ok {
+		return p, nil
+	}
+	return nil, player.ErrNotFound
+}
+
+func (r *memPlayers) GetByIDs(_ context.Context, ids []string) ([]*player.Player, error) {
+	var found []*player.Player
+	for _, id := range ids {
+		if p, ok := r.players[id]; ok {
+			found = append(found, p)
+		}
+	}
+	return found, nil
+}
+
+func (r *memPlayers) GetByUserID(_ context.Context, userID string) (*player.Player, error) {
+	for _, p := range r.players {
+		if p.UserID.String() == userID {
+			return p, nil
+		}
+	}
+	return nil, player.ErrNotFound
+}
+
+func (r *memPlayers) GetByIDFields(ctx context.Context, id string, _ []string) (*player.Player, error) {
+	return r.GetByID(ctx, id)
+}
+
+func (r *memPlayers) GetByUserIDFields(ctx context.Context, userID string, _ []string) (*player.Player, error) {
+	return r.GetByUserID(ctx, userID)
+}
+
+func (r *memPlayers) GetByPlatformID(_ context.Context, platform, platformID string) (*player.Player, error) {
+	for _, p := range r.players {
+		if p.PlatformIDs[platform] == platformID {
+			return p, nil
+		}
+	}
+	return nil, player.ErrNotFound
+}
+
+func (r *memPlayers) GetAll(_ context.Context) ([]*player.Player, error) {
+	return r.sorted(), nil
+}
+
+func (r *memPlayers) Update(_ context.Context, p *player.Player) error {
+	if _, ok := r.players[p.ID.String()]; !ok {
+		return player.ErrNotFound
+	}
+	r.players[p.ID.String()] = p
+	return nil
+}
+
+func (r *memPlayers) AddHonors(_ context.Context, id string, delta player.Honors) error {
+	p, ok := r.players[id]
+	if !ok {
+		return player.ErrNotFound
+	}
+	p.Honors.MVPVotes += delta.MVPVotes
+	p.Honors.Commends += delta.Commends
+	return nil
+}
+
+func (r *memPlayers) Delete(_ context.Context, id string) error {
+	if _, ok := r.players[id]; !ok {
+		return player.ErrNotFound
+	}
+	delete(r.players, id)
+	return nil
+}
+
+func (r *memPlayers) Count(_ context.Context) (int64, error) {
+	return int64(len(r.players)), nil
+}
+
+func (r *memPlayers) ListFiltered(_ context.Context, filter player.ListFilter) ([]player.ListEntry, error) {
+	return page(r.filter(filter), filter.Offset, filter.Limit), nil
+}
+
+func (r *memPlayers) CountFiltered(_ context.Context, filter player.ListFilter) (int64, error) {
+	return int64(len(r.filter(filter))), nil
+}
+
+func (r *memPlayers) sorted() []*player.Player {
+	players := make([]*player.Player, 0, len(r.players))
+	for _, p := range r.players {
+		players = append(players, p)
+	}
+	sort.Slice(players, func(i, j int) bool { return players[i].DisplayName < players[j].DisplayName })
+	return players
+}
+
+func (r *memPlayers) filter(filter player.ListFilter) []player.ListEntry {
+	entries := []player.ListEntry{}
+	for _, p := range r.sorted() {
+		if filter.Banned != nil && p.IsBanned != *filter.Banned {
+			continue
+		}
+		if filter.Region != "" && p.Region != filter.Region {
+			continue
+		}
+		if filter.Platform != "" && p.PreferredPlatform != filter.Platform {
+			continue
+		}
+
+		var summary *player.StatsSummary
+		if filter.HasStats || filter.IncludeStats {
+			summary = &player.StatsSummary{}
+			for _, ps := range r.stats.stats {
+				if ps.PlayerID != p.ID || ps.Mode != "" || ps.SeasonID != uuid.Nil || (filter.GameID != uuid.Nil && ps.GameID != filter.GameID) {
+					continue
+				}
+				summary.Games++
+				summary.MatchesPlayed += ps.MatchesPlayed
+				if ps.RankingScore > summary.RankingScore {
+					summary.RankingScore = ps.RankingScore
+				}
+			}
+			if filter.HasStats && summary.Games == 0 {
+				continue
+			}
+			if !filter.IncludeStats {
+				summary = nil
+			}
+		}
+		entries = append(entries, player.ListEntry{Player: p, Stats: summary})
+	}
+	return entries
+}
+
+type memConduct struct {
+	records []*player.ConductRecord
+}
+
+func (r *memConduct) Create(_ context.Context, record *player.ConductRecord) error {
+	r.records = append(r.records, record)
+	return nil
+}
+
+func (r *memConduct) ListByPlayers(_ context.Context, playerIDs []uuid.UUID) ([]*player.ConductRecord, error) {
+	found := []*player.ConductRecord{}
+	for i := len(r.records) - 1; i >= 0; i-- {
+		for _, id := range playerIDs {
+			if r.records[i].PlayerID == id {
+				found = append(found, r.records[i])
+				break
+			}
+		}
+	}
+	return found, nil
+}
+
+type memStats struct {
+	stats   []*player.PlayerStats
+	players *memPlayers
+}
+
+func (r *memStats) Create(_ context.Context, ps *player.PlayerStats) error {
+	r.stats = append(r.stats, ps)
+	return nil
+}
+
+func (r *memStats) GetByID(_ context.Context, id uuid.UUID) (*player.PlayerStats, error) {
+	for _, ps := range r.stats {
+		if ps.ID == id {
+			return ps, nil
+		}
+	}
+	return nil, player.ErrStatsNotFound
+}
+
+func (r *memStats) GetByPlayerAndGame(ctx context.Context, playerID, gameID uuid.UUID) (*player.PlayerStats, error) {
+	return r.getForScope(playerID, gameID, player.StatsScope{})
+}
+
+func (r *memStats) getForScope(playerID, gameID uuid.UUID, scope player.StatsScope) (*player.PlayerStats, error) {
+	for _, ps := range r.stats {
+		if ps.PlayerID == playerID && ps.GameID == gameID && ps.Mode == scope.Mode && ps.SeasonID == scope.SeasonID {
+			return ps, nil
+		}
+	}
+	return nil, player.ErrStatsNotFound
+}
+
+func (r *memStats) GetByPlayer(_ context.Context, playerID uuid.UUID) ([]*player.PlayerStats, error) {
+	var found []*player.PlayerStats
+	for _, ps := range r.stats {
+		if ps.PlayerID == playerID && ps.Mode == "" && ps.SeasonID == uuid.Nil {
+			found = append(found, ps)
+		}
+	}
+	return found, nil
+}
+
+func (r *memStats) GetByGame(_ context.Context, gameID uuid.UUID, limit int64) ([]*player.PlayerStats, error) {
+	found := r.ranked(gameID, player.StatsScope{}, player.LeaderboardSortScore)
+	if limit > 0 && int64(len(found)) > limit {
+		found = found[:limit]
+	}
+	return found, nil
+}
+
+func (r *memStats) GetOrCreate(ctx context.Context, playerID, gameID uuid.UUID) (*player.PlayerStats, error) {
+	return r.GetOrCreateForScope(ctx, playerID, gameID, player.StatsScope{})
+}
+
+func (r *memStats) GetOrCreateForScope(ctx context.Context, playerID, gameID uuid.UUID, scope player.StatsScope) (*player.PlayerStats, error) {
+	if ps, err := r.getForScope(playerID, gameID, scope); err == nil {
+		return ps, nil
+	}
+	ps := player.NewPlayerStats(playerID, gameID)
+	ps.Mode = scope.Mode
+	ps.SeasonID = scope.SeasonID
+	return ps, r.Create(ctx, ps)
+}
+
+func (r *memStats) Update(ctx context.Context, ps *player.PlayerStats) error {
+	for i, existing := range r.stats {
+		if existing.ID == ps.ID {
+			r.stats[i] = ps
+			return nil
+		}
+	}
+	return player.ErrStatsNotFound
+}
+
+func (r *memStats) UpdateRanking(ctx context.Context, id uuid.UUID, score, deviation float64, tier player.Tier) error {
+	ps, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	ps.RankingScore = score
+	ps.RatingDeviation = deviation
+	ps.Tier = tier
+	return nil
+}
+
+func (r *memStats) UpdateConsistency(ctx context.Context, id uuid.UUID, score float64, samples int) error {
+	ps, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	ps.ConsistencyScore = score
+	ps.ConsistencySamples = samples
+	return nil
+}
+
+func (r *memStats) UpdateRecords(ctx context.Context, id uuid.UUID, records player.PersonalRecords) error {
+	ps, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	ps.Records = records
+	return nil
+}
+
+func (r *memStats) SetDerivedStats(ctx context.Context, id uuid.UUID, derived map[string]float64) error {
+	ps, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	for k, v := range derived {
+		ps.Stats[k] = v
+	}
+	return nil
+}
+
+func (r *memStats) SetRollups(ctx context.Context, id uuid.UUID, rolled map[string]float64, samples map[string]int) error {
+	ps, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	for k, v := range rolled {
+		ps.Stats[k] = v
+	}
+	if ps.RollupSamples == nil {
+		ps.RollupSamples = make(map[string]int)
+	}
+	for k, n := range samples {
+		ps.RollupSamples[k] = n
+	}
+	return nil
+}
+
+func (r *memStats) IncrementStats(ctx context.Context, id uuid.UUID, statsToAdd map[string]interface{}) error {
+	ps, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	for k, v := range statsToAdd {
+		ps.Stats[k] = toFloat(ps.Stats[k]) + toFloat(v)
+	}
+	now := time.Now()
+	ps.MatchesPlayed++
+	ps.RatingDeviation = player.RatingDeviationAfter(ps.MatchesPlayed, player.DefaultScoreRange)
+	ps.LastMatchAt = &now
+	return nil
+}
+
+func (r *memStats) AdjustStats(ctx context.Context, id uuid.UUID, deltas map[string]int, matchesPlayed int) error {
+	ps, err := r.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	for k, v := range deltas {
+		ps.Stats[k] = toFloat(ps.Stats[k]) + float64(v)
+	}
+	ps.MatchesPlayed += matchesPlayed
+	if matchesPlayed != 0 {
+		ps.RatingDeviation = player.RatingDeviationAfter(ps.MatchesPlayed, player.DefaultScoreRange)
+	}
+	return nil
+}
+
+func (r *memStats) GetLeaderboard(_ context.Context, gameID uuid.UUID, scope player.StatsScope, sortBy player.LeaderboardSort, ranking player.RankingMode, limit, offset int64) ([]player.LeaderboardEntry, error) {
+	ranked := r.ranked(gameID, scope, sortBy)
+	return r.entries(page(ranked, offset, limit), int(offset)), nil
+}
+
+func (r *memStats) GetLeaderboardByTier(_ context.Context, gameID uuid.UUID, scope player.StatsScope, tier player.Tier, sortBy player.LeaderboardSort, ranking player.RankingMode, limit int64) ([]player.LeaderboardEntry, error) {
+	var inTier []*player.PlayerStats
+	for _, ps := range r.ranked(gameID, scope, sortBy) {
+		if ps.Tier == tier {
+			inTier = append(inTier, ps)
+		}
+	}
+	return r.entries(page(inTier, 0, limit), 0), nil
+}
+
+func (r *memStats) GetPlayerRank(_ context.Context, playerID, gameID uuid.UUID, scope player.StatsScope) (*player.PlayerRankInfo, error) {
+	ps, err := r.getForScope(playerID, gameID, scope)
+	if err != nil {
+		return nil, err
+	}
+	var above int64
+	for _, other := range r.ranked(gameID, scope, player.LeaderboardSortScore) {
+		if other.RankingScore > ps.RankingScore {
+			above++
+		}
+	}
+	return &player.PlayerRankInfo{
+		Rank:            above + 1,
+		RankingScore:    ps.RankingScore,
+		RatingDeviation: ps.RatingDeviation,
+		Tier:            ps.Tier,
+	}, nil
+}
+
+func (r *memStats) CountByGame(_ context.Context, gameID uuid.UUID, scope player.StatsScope) (int64, error) {
+	return int64(len(r.ranked(gameID, scope, player.LeaderboardSortScore))), nil
+}
+
+func (r *memStats) CountStatAbove(_ context.Context, gameID uuid.UUID, statName string, perMatch float64) (int64, int64, error) {
+	var above, total int64
+	for _, ps := range r.ranked(gameID, player.StatsScope{}, player.LeaderboardSortScore) {
+		if ps.MatchesPlayed == 0 {
+			continue
+		}
+		total++
+		if toFloat(ps.Stats[statName])/float64(ps.MatchesPlayed) > perMatch {
+			above++
+		}
+	}
+	return above, total, nil
+}
+
+func (r *memStats) GetTierDistribution(_ context.Context, gameID uuid.UUID, scope player.StatsScope) (map[player.Tier]int64, error) {
+	distribution := make(map[player.Tier]int64)
+	for _, ps := range r.ranked(gameID, scope, player.LeaderboardSortScore) {
+		distribution[ps.Tier]++
+	}
+	return distribution, nil
+}
+
+func (r *memStats) SnapshotRanks(context.Context, uuid.UUID, string, time.Time) error {
+	return nil
+}
+
+func (r *memStats) SetPlayerDeactivated(_ context.Context, playerID uuid.UUID, deactivated bool) error {
+	for _, ps := range r.stats {
+		if ps.PlayerID == playerID {
+			ps.PlayerDeactivated = deactivated
+		}
+	}
+	return nil
+}
+
+// ranked returns the stats shown on a game mode's leaderboard, best first.
+func (r *memStats) FindOrphaned(_ context.Context, limit int64) ([]player.OrphanedStats, error) {
+	var orphans []player.OrphanedStats
+	for _, ps := range r.stats {
+		if _, ok := r.players.players[ps.PlayerID.String()]; ok {
+			continue
+		}
+		if int64(len(orphans)) == limit {
+			break
+		}
+		orphans = append(orphans, player.OrphanedStats{
+			ID:       ps.ID.String(),
+			PlayerID: ps.PlayerID.String(),
+			GameID:   ps.GameID.String(),
+			Mode:     ps.Mode,
+		})
+	}
+	return orphans, nil
+}
+
+func (r *memStats) RelinkPlayer(_ context.Context, statsID string, playerID uuid.UUID) error {
+	for _, ps := range r.stats {
+		if ps.ID.String() == statsID {
+			ps.PlayerID = playerID
+			return nil
+		}
+	}
+	return player.ErrStatsNotFound
+}
+
+func (r *memStats) DeleteOrphaned(_ context.Context, statsID string) error {
+	for i, ps := range r.stats {
+		if ps.ID.String() == statsID {
+			r.stats = append(r.stats[:i], r.stats[i+1:]...)
+			return nil
+		}
+	}
+	return player.ErrStatsNotFound
+}
+
+func (r *memStats) ranked(gameID uuid.UUID, scope player.StatsScope, sortBy player.LeaderboardSort) []*player.PlayerStats {
+	var found []*player.PlayerStats
+	for _, ps := range r.stats {
+		if ps.GameID == gameID && ps.Mode == scope.Mode && ps.SeasonID == scope.SeasonID && !ps.PlayerDeactivated {
+			found = append(found, ps)
+		}
+	}
+	score := func(ps *player.PlayerStats) float64 {
+		if sortBy == player.LeaderboardSortConservative {
+			return player.ConservativeRating(ps.RankingScore, ps.RatingDeviation)
+		}
+		return ps.RankingScore
+	}
+	sort.SliceStable(found, func(i, j int) bool { return score(found[i]) > score(found[j]) })
+	return found
+}
+
+func (r *memStats) entries(stats []*player.PlayerStats, offset int) []player.LeaderboardEntry {
+	var entries []player.LeaderboardEntry
+	for i, ps := range stats {
+		entry := player.LeaderboardEntry{
+			Rank:            offset + i + 1,
+			PlayerID:        ps.PlayerID,
+			RankingScore:    ps.RankingScore,
+			RatingDeviation: ps.RatingDeviation,
+			Tier:            ps.Tier,
+			MatchesPlayed:   ps.MatchesPlayed,
+			Stats:           ps.Stats,
+			LastMatchAt:     ps.LastMatchAt,
+		}
+		if p, ok := r.players.players[ps.PlayerID.String()]; ok {
+			entry.DisplayName = p.DisplayName
+			entry.AvatarURL = p.AvatarURL
+			entry.Anonymous = p.HideFromBoards
+		}
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+type memGames struct {
+	games map[string]*game.Game
+}
+
+func (r *memGames) Create(_ context.Context, g *game.Game) error {
+	for _, existing := range r.games {
+		if existing.Slug == g.Slug {
+			return mongodb.ErrGameAlreadyExists
+		}
+	}
+	r.games[g.ID.String()] = g
+	return nil
+}
+
+func (r *memGames) GetByID(_ context.Context, id string) (*game.Game, error) {
+	if g, ok := r.games[id]; ok {
+		return g, nil
+	}
+	return nil, game.ErrNotFound
+}
+
+func (r *memGames) GetByIDs(_ context.Context, ids []string) ([]*game.Game, error) {
+	var found []*game.Game
+	for _, id := range ids {
+		if g, ok := r.games[id]; ok {
+			found = append(found, g)
+		}
+	}
+	return found, nil
+}
+
+func (r *memGames) GetBySlug(_ context.Context, slug string) (*game.Game, error) {
+	for _, g := range r.games {
+		if g.Slug == slug {
+			return g, nil
+		}
+	}
+	return nil, game.ErrNotFound
+}
+
+func (r *memGames) GetAll(ctx context.Context) ([]*game.Game, error) {
+	return r.List(ctx, false)
+}
+
+func (r *memGames) List(_ context.Context, activeOnly bool) ([]*game.Game, error) {
+	var games []*game.Game
+	for _, g := range r.games {
+		if !activeOnly || g.IsActive {
+			games = append(games, g)
+		}
+	}
+	sort.Slice(games, func(i, j int) bool { return games[i].Name < games[j].Name })
+	return games, nil
+}
+
+func (r *memGames) Update(_ context.Context, g *game.Game) error {
+	if _, ok := r.games[g.ID.String()]; !ok {
+		return game.ErrNotFound
+	}
+	r.games[g.ID.String()] = g
+	return nil
+}
+
+func (r *memGames) SetActive(_ context.Context, id uuid.UUID, active bool) error {
+	g, ok := r.games[id.String()]
+	if !ok {
+		return game.ErrNotFound
+	}
+	g.IsActive = active
+	return nil
+}
+
+func (r *memGames) Delete(_ context.Context, id string) error {
+	if _, ok := r.games[id]; !ok {
+		return game.ErrNotFound
+	}
+	delete(r.games, id)
+	return nil
+}
+
+type memCohorts struct {
+	latest *player.CohortReport
+}
+
+func (r *memCohorts) Save(_ context.Context, report *player.CohortReport) error {
+	r.latest = report
+	return nil
+}
+
+func (r *memCohorts) GetLatest(context.Context) (*player.CohortReport, error) {
+	if r.latest == nil {
+		return nil, player.ErrCohortReportNotFound
+	}
+	return r.latest, nil
+}
+
+type memIntegrityReports struct {
+	latest *integrity.Report
+}
+
+func (r *memIntegrityReports) Save(_ context.Context, report *integrity.Report) error {
+	r.latest = report
+	return nil
+}
+
+func (r *memIntegrityReports) GetLatest(context.Context) (*integrity.Report, error) {
+	if r.latest == nil {
+		return nil, integrity.ErrReportNotFound
+	}
+	return r.latest, nil
+}
+
+type memLeaderboardSyncs struct {
+	syncs []*player.LeaderboardSync
+}
+
+func (r *memLeaderboardSyncs) Create(_ context.Context, sync *player.LeaderboardSync) error {
+	r.syncs = append(r.syncs, sync)
+	return nil
+}
+
+func (r *memLeaderboardSyncs) GetByID(_ context.Context, id uuid.UUID) (*player.LeaderboardSync, error) {
+	for _, s := range r.syncs {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return nil, player.ErrLeaderboardSyncNotFound
+}
+
+func (r *memLeaderboardSyncs) ListByGame(_ context.Context, gameID uuid.UUID) ([]*player.LeaderboardSync, error) {
+	var found []*player.LeaderboardSync
+	for _, s := range r.syncs {
+		if s.GameID == gameID {
+			found = append(found, s)
+		}
+	}
+	return found, nil
+}
+
+func (r *memLeaderboardSyncs) ListEnabled(context.Context) ([]*player.LeaderboardSync, error) {
+	var found []*player.LeaderboardSync
+	for _, s := range r.syncs {
+		if s.Enabled {
+			found = append(found, s)
+		}
+	}
+	return found, nil
+}
+
+func (r *memLeaderboardSyncs) Update(_ context.Context, sync *player.LeaderboardSync) error {
+	for i, s := range r.syncs {
+		if s.ID == sync.ID {
+			r.syncs[i] = sync
+			return nil
+		}
+	}
+	return player.ErrLeaderboardSyncNotFound
+}
+
+func (r *memLeaderboardSyncs) Delete(_ context.Context, id uuid.UUID) error {
+	for i, s := range r.syncs {
+		if s.ID == id {
+			r.syncs = append(r.syncs[:i], r.syncs[i+1:]...)
+			return nil
+		}
+	}
+	return player.ErrLeaderboardSyncNotFound
+}
+
+// page returns the items from offset on, at most limit of them when limit is positive.
+func page[T any, N int | int64](items []T, offset, limit N) []T {
+	if offset < 0 || int(offset) >= len(items) {
+		return items[:0]
+	}
+	items = items[offset:]
+	if limit > 0 && int(limit) < len(items) {
+		items = items[:limit]
+	}
+	return items
+}
+
+// toFloat reads a numeric stat value stored under any numeric type.
+func toFloat(v interface{}) float64 {
+	switch n := v.(type) {
+	case int:
+		return float64(n)
+	case int32:
+		return float64(n)
+	case int64:
+		return float64(n)
+	case float64:
+		return n
+	case float32:
+		return float64(n)
+	}
+	return 0
+}
+
+type memDeveloperKeys struct {
+	keys []*developer.APIKey
+}
+
+func (r *memDeveloperKeys) Create(_ context.Context, key *developer.APIKey) error {
+	r.keys = append(r.keys, key)
+	return nil
+}
+
+func (r *memDeveloperKeys) Update(_ context.Context, key *developer.APIKey) error {
+	for i, k := range r.keys {
+		if k.ID == key.ID {
+			r.keys[i] = key
+			return nil
+		}
+	}
+	return developer.ErrKeyNotFound
+}
+
+func (r *memDeveloperKeys) GetByID(_ context.Context, id uuid.UUID) (*developer.APIKey, error) {
+	for _, k := range r.keys {
+		if k.ID == id {
+			return k, nil
+		}
+	}
+	return nil, developer.ErrKeyNotFound
+}
+
+func (r *memDeveloperKeys) GetByHash(_ context.Context, keyHash string) (*developer.APIKey, error) {
+	for _, k := range r.keys {
+		if k.KeyHash == keyHash {
+			return k, nil
+		}
+	}
+	return nil, developer.ErrKeyNotFound
+}
+
+func (r *memDeveloperKeys) ListByUser(_ context.Context, userID uuid.UUID) ([]*developer.APIKey, error) {
+	found := []*developer.APIKey{}
+	for _, k := range r.keys {
+		if k.UserID == userID {
+			found = append(found, k)
+		}
+	}
+	return found, nil
+}
+
+type memDeveloperUsage struct {
+	usage []*developer.Usage
+}
+
+func (r *memDeveloperUsage) window(keyID uuid.UUID, window developer.Window, start time.Time) *developer.Usage {
+	for _, u := range r.usage {
+		if u.KeyID == keyID && u.Window == window && u.Start.Equal(start) {
+			return u
+		}
+	}
+	u := &developer.Usage{KeyID: keyID, Window: window, Start: start}
+	r.usage = append(r.usage, u)
+	return u
+}
+
+func (r *memDeveloperUsage) Count(_ context.Context, keyID uuid.UUID, window developer.Window, start time.Time) (int64, error) {
+	u := r.window(keyID, window, start)
+	u.Requests++
+	return u.Requests, nil
+}
+
+func (r *memDeveloperUsage) Reject(_ context.Context, keyID uuid.UUID, window developer.Window, start time.Time) error {
+	r.window(keyID, window, start).Rejected++
+	return nil
+}
+
+func (r *memDeveloperUsage) List(_ context.Context, keyID uuid.UUID, window developer.Window, from time.Time) ([]*developer.Usage, error) {
+	found := []*developer.Usage{}
+	for _, u := range r.usage {
+		if u.KeyID == keyID && u.Window == window && !u.Start.Before(from) {
+			found = append(found, u)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return found[i].Start.Before(found[j].Start) })
+	return found, nil
+}
@@ -0,0 +1,873 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alejaam/tourney-rank/internal/domain/ladder"
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/organization"
+	"github.com/alejaam/tourney-rank/internal/domain/season"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+)
+
+type memTournaments struct {
+	tournaments []*tournament.Tournament
+}
+
+func (r *memTournaments) Create(_ context.Context, t *tournament.Tournament) error {
+	r.tournaments = append(r.tournaments, t)
+	return nil
+}
+
+func (r *memTournaments) GetByID(_ context.Context, id uuid.UUID) (*tournament.Tournament, error) {
+	for _, t := range r.tournaments {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return nil, tournament.ErrNotFound
+}
+
+// GetByIDFields loads every field; the use case trims the response to the fieldset.
+func (r *memTournaments) GetByIDFields(ctx context.Context, id uuid.UUID, _ []string) (*tournament.Tournament, error) {
+	return r.GetByID(ctx, id)
+}
+
+func (r *memTournaments) Update(_ context.Context, t *tournament.Tournament) error {
+	for i, existing := range r.tournaments {
+		if existing.ID == t.ID {
+			r.tournaments[i] = t
+			return nil
+		}
+	}
+	return tournament.ErrNotFound
+}
+
+func (r *memTournaments) Delete(_ context.Context, id uuid.UUID) error {
+	for i, t := range r.tournaments {
+		if t.ID == id {
+			r.tournaments = append(r.tournaments[:i], r.tournaments[i+1:]...)
+			return nil
+		}
+	}
+	return tournament.ErrNotFound
+}
+
+func (r *memTournaments) List(_ context.Context, filter tournament.ListFilter) ([]*tournament.Tournament, error) {
+	var found []*tournament.Tournament
+	for _, t := range r.newestFirst() {
+		if filter.GameID != nil && t.GameID != *filter.GameID {
+			continue
+		}
+		if filter.Status != nil && t.Status != *filter.Status {
+			continue
+		}
+		if filter.CreatedBy != nil && t.CreatedBy != *filter.CreatedBy {
+			continue
+		}
+		if filter.SeriesID != nil && (t.SeriesID == nil || *t.SeriesID != *filter.SeriesID) {
+			continue
+		}
+		if filter.Language != "" && !containsLanguage(t.Languages, filter.Language) {
+			continue
+		}
+		found = append(found, t)
+	}
+	return page(found, filter.Offset, filter.Limit), nil
+}
+
+func (r *memTournaments) Count(ctx context.Context, filter tournament.ListFilter) (int64, error) {
+	filter.Limit, filter.Offset = 0, 0
+	found, err := r.List(ctx, filter)
+	return int64(len(found)), err
+}
+
+func containsLanguage(languages []string, code string) bool {
+	for _, l := range languages {
+		if l == code {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *memTournaments) GetByGameID(ctx context.Context, gameID uuid.UUID) ([]*tournament.Tournament, error) {
+	return r.List(ctx, tournament.ListFilter{GameID: &gameID})
+}
+
+func (r *memTournaments) GetByStatus(_ context.Context, status tournament.Status) ([]*tournament.Tournament, error) {
+	var found []*tournament.Tournament
+	for _, t := range r.tournaments {
+		if t.Status == status {
+			found = append(found, t)
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].StartDate.Before(found[j].StartDate) })
+	return found, nil
+}
+
+func (r *memTournaments) GetActiveTournaments(ctx context.Context) ([]*tournament.Tournament, error) {
+	return r.GetByStatus(ctx, tournament.StatusActive)
+}
+
+func (r *memTournaments) CountByGameID(_ context.Context, gameID uuid.UUID) (int64, error) {
+	var n int64
+	for _, t := range r.tournaments {
+		if t.GameID == gameID {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *memTournaments) newestFirst() []*tournament.Tournament {
+	sorted := append([]*tournament.Tournament(nil), r.tournaments...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].CreatedAt.After(sorted[j].CreatedAt) })
+	return sorted
+}
+
+type memExports struct {
+	exports []*tournament.Export
+}
+
+func (r *memExports) Create(_ context.Context, export *tournament.Export) error {
+	r.exports = append(r.exports, export)
+	return nil
+}
+
+func (r *memExports) GetByID(_ context.Context, id uuid.UUID) (*tournament.Export, error) {
+	for _, e := range r.exports {
+		if e.ID == id {
+			return e, nil
+		}
+	}
+	return nil, tournament.ErrExportNotFound
+}
+
+func (r *memExports) GetLatestByTournament(_ context.Context, tournamentID uuid.UUID) (*tournament.Export, error) {
+	for i := len(r.exports) - 1; i >= 0; i-- {
+		if r.exports[i].TournamentID == tournamentID {
+			return r.exports[i], nil
+		}
+	}
+	return nil, tournament.ErrExportNotFound
+}
+
+func (r *memExports) ClaimPending(context.Context) (*tournament.Export, error) {
+	for _, e := range r.exports {
+		if e.Status == tournament.ExportPending {
+			e.Status = tournament.ExportRunning
+			return e, nil
+		}
+	}
+	return nil, tournament.ErrExportNotFound
+}
+
+func (r *memExports) Update(_ context.Context, export *tournament.Export) error {
+	for i, e := range r.exports {
+		if e.ID == export.ID {
+			r.exports[i] = export
+			return nil
+		}
+	}
+	return tournament.ErrExportNotFound
+}
+
+type memBackups struct {
+	backups []*tournament.Backup
+}
+
+func (r *memBackups) Create(_ context.Context, backup *tournament.Backup) error {
+	r.backups = append(r.backups, backup)
+	return nil
+}
+
+func (r *memBackups) GetByID(_ context.Context, id uuid.UUID) (*tournament.Backup, error) {
+	for _, b := range r.backups {
+		if b.ID == id {
+			return b, nil
+		}
+	}
+	return nil, tournament.ErrBackupNotFound
+}
+
+func (r *memBackups) ListByTournament(_ context.Context, tournamentID uuid.UUID) ([]*tournament.Backup, error) {
+	backups := []*tournament.Backup{}
+	for i := len(r.backups) - 1; i >= 0; i-- {
+		if r.backups[i].TournamentID == tournamentID {
+			backups = append(backups, r.backups[i])
+		}
+	}
+	return backups, nil
+}
+
+func (r *memBackups) Update(_ context.Context, backup *tournament.Backup) error {
+	for i, b := range r.backups {
+		if b.ID == backup.ID {
+			r.backups[i] = backup
+			return nil
+		}
+	}
+	return tournament.ErrBackupNotFound
+}
+
+// memSnapshotter snapshots the in-memory repositories as plain JSON.
+type memSnapshotter struct {
+	tournaments *memTournaments
+	teams       *memTeams
+	matches     *memMatches
+}
+
+func (s *memSnapshotter) Snapshot(ctx context.Context, id uuid.UUID) (*tournament.Snapshot, error) {
+	t, err := s.tournaments.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &tournament.Snapshot{Teams: []json.RawMessage{}, Matches: []json.RawMessage{}}
+	if snapshot.Tournament, err = json.Marshal(t); err != nil {
+		return nil, err
+	}
+	for _, tm := range s.teams.teams {
+		if tm.TournamentID == id {
+			doc, err := json.Marshal(tm)
+			if err != nil {
+				return nil, err
+			}
+			snapshot.Teams = append(snapshot.Teams, doc)
+		}
+	}
+	for _, m := range s.matches.matches {
+		if m.TournamentID == id {
+			doc, err := json.Marshal(m)
+			if err != nil {
+				return nil, err
+			}
+			snapshot.Matches = append(snapshot.Matches, doc)
+		}
+	}
+	return snapshot, nil
+}
+
+func (s *memSnapshotter) Restore(_ context.Context, id uuid.UUID, snapshot *tournament.Snapshot) error {
+	var t tournament.Tournament
+	if err := json.Unmarshal(snapshot.Tournament, &t); err != nil {
+		return err
+	}
+	tournaments := []*tournament.Tournament{&t}
+	for _, existing := range s.tournaments.tournaments {
+		if existing.ID != id {
+			tournaments = append(tournaments, existing)
+		}
+	}
+	s.tournaments.tournaments = tournaments
+
+	var teams []*team.Team
+	for _, tm := range s.teams.teams {
+		if tm.TournamentID != id {
+			teams = append(teams, tm)
+		}
+	}
+	for _, doc := range snapshot.Teams {
+		var tm team.Team
+		if err := json.Unmarshal(doc, &tm); err != nil {
+			return err
+		}
+		teams = append(teams, &tm)
+	}
+	s.teams.teams = teams
+
+	var matches []match.Match
+	for _, m := range s.matches.matches {
+		if m.TournamentID != id {
+			matches = append(matches, m)
+		}
+	}
+	for _, doc := range snapshot.Matches {
+		var m match.Match
+		if err := json.Unmarshal(doc, &m); err != nil {
+			return err
+		}
+		matches = append(matches, m)
+	}
+	s.matches.matches = matches
+	return nil
+}
+
+type memObjectStore struct {
+	objects map[string][]byte
+}
+
+func (s *memObjectStore) Put(_ context.Context, key string, data []byte) error {
+	s.objects[key] = data
+	return nil
+}
+
+func (s *memObjectStore) Get(_ context.Context, key string) ([]byte, error) {
+	data, ok := s.objects[key]
+	if !ok {
+		return nil, fmt.Errorf("object %s not found", key)
+	}
+	return data, nil
+}
+
+type memRulebooks struct {
+	rulebooks []*tournament.Rulebook
+}
+
+func (r *memRulebooks) GetByTournament(_ context.Context, tournamentID uuid.UUID) (*tournament.Rulebook, error) {
+	for _, rb := range r.rulebooks {
+		if rb.TournamentID == tournamentID {
+			return rb, nil
+		}
+	}
+	return nil, tournament.ErrRulebookNotFound
+}
+
+func (r *memRulebooks) Save(_ context.Context, rulebook *tournament.Rulebook) error {
+	for i, rb := range r.rulebooks {
+		if rb.TournamentID == rulebook.TournamentID {
+			r.rulebooks[i] = rulebook
+			return nil
+		}
+	}
+	r.rulebooks = append(r.rulebooks, rulebook)
+	return nil
+}
+
+type memRosterOverlaps struct {
+	overlaps []*team.RosterOverlap
+}
+
+func (r *memRosterOverlaps) Create(_ context.Context, overlap *team.RosterOverlap) error {
+	for _, o := range r.overlaps {
+		if o.TournamentID == overlap.TournamentID && o.Key == overlap.Key {
+			return team.ErrRosterOverlapExists
+		}
+	}
+	r.overlaps = append(r.overlaps, overlap)
+	return nil
+}
+
+func (r *memRosterOverlaps) GetByID(_ context.Context, id uuid.UUID) (*team.RosterOverlap, error) {
+	for _, o := range r.overlaps {
+		if o.ID == id {
+			return o, nil
+		}
+	}
+	return nil, team.ErrRosterOverlapNotFound
+}
+
+func (r *memRosterOverlaps) Update(_ context.Context, overlap *team.RosterOverlap) error {
+	for i, o := range r.overlaps {
+		if o.ID == overlap.ID {
+			r.overlaps[i] = overlap
+			return nil
+		}
+	}
+	return team.ErrRosterOverlapNotFound
+}
+
+func (r *memRosterOverlaps) ListByTournament(_ context.Context, tournamentID uuid.UUID) ([]*team.RosterOverlap, error) {
+	result := []*team.RosterOverlap{}
+	for i := len(r.overlaps) - 1; i >= 0; i-- {
+		if r.overlaps[i].TournamentID == tournamentID {
+			result = append(result, r.overlaps[i])
+		}
+	}
+	return result, nil
+}
+
+type memTeams struct {
+	teams       []*team.Team
+	tournaments *memTournaments
+}
+
+func (r *memTeams) Create(_ context.Context, t *team.Team) error {
+	r.teams = append(r.teams, t)
+	return nil
+}
+
+func (r *memTeams) GetByID(_ context.Context, id uuid.UUID) (*team.Team, error) {
+	for _, t := range r.teams {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return nil, team.ErrNotFound
+}
+
+func (r *memTeams) GetByIDFields(ctx context.Context, id uuid.UUID, _ []string) (*team.Team, error) {
+	return r.GetByID(ctx, id)
+}
+
+func (r *memTeams) GetByInviteCode(_ context.Context, inviteCode string) (*team.Team, error) {
+	for _, t := range r.teams {
+		if t.InviteCode == inviteCode {
+			return t, nil
+		}
+	}
+	return nil, team.ErrNotFound
+}
+
+func (r *memTeams) Update(_ context.Context, t *team.Team) error {
+	for i, existing := range r.teams {
+		if existing.ID == t.ID {
+			r.teams[i] = t
+			return nil
+		}
+	}
+	return team.ErrNotFound
+}
+
+func (r *memTeams) Delete(_ context.Context, id uuid.UUID) error {
+	for i, t := range r.teams {
+		if t.ID == id {
+			r.teams = append(r.teams[:i], r.teams[i+1:]...)
+			return nil
+		}
+	}
+	return team.ErrNotFound
+}
+
+func (r *memTeams) GetByTournamentID(ctx context.Context, tournamentID uuid.UUID) ([]*team.Team, error) {
+	return r.List(ctx, team.ListFilter{TournamentID: &tournamentID})
+}
+
+func (r *memTeams) GetByPlayerID(ctx context.Context, playerID uuid.UUID) ([]*team.Team, error) {
+	return r.List(ctx, team.ListFilter{PlayerID: &playerID})
+}
+
+func (r *memTeams) GetPlayerTeamInTournament(_ context.Context, playerID, tournamentID uuid.UUID) (*team.Team, error) {
+	for _, t := range r.teams {
+		if t.TournamentID == tournamentID && t.HasMember(playerID) {
+			return t, nil
+		}
+	}
+	return nil, team.ErrNotFound
+}
+
+func (r *memTeams) CountByTournamentID(_ context.Context, tournamentID uuid.UUID) (int64, error) {
+	var n int64
+	for _, t := range r.teams {
+		if t.TournamentID == tournamentID {
+			n++
+		}
+	}
+	return n, nil
+}
+
+func (r *memTeams) List(_ context.Context, filter team.ListFilter) ([]*team.Team, error) {
+	var found []*team.Team
+	for _, t := range r.teams {
+		if filter.TournamentID != nil && t.TournamentID != *filter.TournamentID {
+			continue
+		}
+		if filter.PlayerID != nil && !t.HasMember(*filter.PlayerID) {
+			continue
+		}
+		if filter.Status != nil && t.Status != *filter.Status {
+			continue
+		}
+		found = append(found, t)
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].CreatedAt.After(found[j].CreatedAt) })
+	return page(found, filter.Offset, filter.Limit), nil
+}
+
+func (r *memTeams) FindWithMissingTournament(_ context.Context, limit int64) ([]*team.Team, error) {
+	var orphans []*team.Team
+	for _, t := range r.teams {
+		if int64(len(orphans)) == limit {
+			break
+		}
+		if _, err := r.tournaments.GetByID(context.Background(), t.TournamentID); err != nil {
+			orphans = append(orphans, t)
+		}
+	}
+	return orphans, nil
+}
+
+type memJoinRequests struct {
+	requests []*team.JoinRequest
+}
+
+func (r *memJoinRequests) Create(_ context.Context, req *team.JoinRequest) error {
+	for _, existing := range r.requests {
+		if existing.TeamID == req.TeamID && existing.PlayerID == req.PlayerID && existing.Status == team.JoinRequestPending {
+			return team.ErrJoinRequestExists
+		}
+	}
+	r.requests = append(r.requests, req)
+	return nil
+}
+
+func (r *memJoinRequests) GetByID(_ context.Context, id uuid.UUID) (*team.JoinRequest, error) {
+	for _, req := range r.requests {
+		if req.ID == id {
+			return req, nil
+		}
+	}
+	return nil, team.ErrJoinRequestNotFound
+}
+
+func (r *memJoinRequests) Update(_ context.Context, req *team.JoinRequest) error {
+	for i, existing := range r.requests {
+		if existing.ID == req.ID {
+			r.requests[i] = req
+			return nil
+		}
+	}
+	return team.ErrJoinRequestNotFound
+}
+
+func (r *memJoinRequests) ListPendingByTeam(_ context.Context, teamID uuid.UUID) ([]*team.JoinRequest, error) {
+	var found []*team.JoinRequest
+	for _, req := range r.requests {
+		if req.TeamID == teamID && req.Status == team.JoinRequestPending {
+			found = append(found, req)
+		}
+	}
+	return found, nil
+}
+
+func (r *memJoinRequests) ListPendingByPlayer(_ context.Context, playerID uuid.UUID) ([]*team.JoinRequest, error) {
+	var found []*team.JoinRequest
+	for i := len(r.requests) - 1; i >= 0; i-- {
+		if req := r.requests[i]; req.PlayerID == playerID && req.Status == team.JoinRequestPending {
+			found = append(found, req)
+		}
+	}
+	return found, nil
+}
+
+type memTeamHistory struct {
+	entries []*team.HistoryEntry
+}
+
+func (r *memTeamHistory) Create(_ context.Context, entry *team.HistoryEntry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *memTeamHistory) ListByTeam(_ context.Context, teamID uuid.UUID, limit int64) ([]*team.HistoryEntry, error) {
+	var found []*team.HistoryEntry
+	for i := len(r.entries) - 1; i >= 0; i-- {
+		if r.entries[i].TeamID == teamID {
+			found = append(found, r.entries[i])
+		}
+	}
+	return page(found, 0, limit), nil
+}
+
+type memBroadcasts struct {
+	broadcasts []*team.Broadcast
+}
+
+func (r *memBroadcasts) Create(_ context.Context, b *team.Broadcast) error {
+	r.broadcasts = append(r.broadcasts, b)
+	return nil
+}
+
+func (r *memBroadcasts) ListByTeam(_ context.Context, teamID uuid.UUID, limit int64) ([]*team.Broadcast, error) {
+	found := []*team.Broadcast{}
+	for i := len(r.broadcasts) - 1; i >= 0; i-- {
+		if r.broadcasts[i].TeamID == teamID {
+			found = append(found, r.broadcasts[i])
+		}
+	}
+	return page(found, 0, limit), nil
+}
+
+func (r *memBroadcasts) CountSince(_ context.Context, teamID uuid.UUID, since time.Time) (int64, error) {
+	var count int64
+	for _, b := range r.broadcasts {
+		if b.TeamID == teamID && !b.CreatedAt.Before(since) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+type memRegistrationQueue struct {
+	tickets []*team.RegistrationTicket
+}
+
+func (r *memRegistrationQueue) Create(_ context.Context, ticket *team.RegistrationTicket) error {
+	for _, t := range r.tickets {
+		if t.TournamentID == ticket.TournamentID && t.PlayerID == ticket.PlayerID && t.Status == team.TicketWaiting {
+			return team.ErrAlreadyQueued
+		}
+	}
+	r.tickets = append(r.tickets, ticket)
+	return nil
+}
+
+func (r *memRegistrationQueue) GetByID(_ context.Context, id uuid.UUID) (*team.RegistrationTicket, error) {
+	for _, t := range r.tickets {
+		if t.ID == id {
+			return t, nil
+		}
+	}
+	return nil, team.ErrTicketNotFound
+}
+
+func (r *memRegistrationQueue) ClaimNext(context.Context) (*team.RegistrationTicket, error) {
+	for _, t := range r.tickets {
+		if t.Status == team.TicketWaiting {
+			t.Status = team.TicketProcessing
+			return t, nil
+		}
+	}
+	return nil, team.ErrTicketNotFound
+}
+
+func (r *memRegistrationQueue) Update(_ context.Context, ticket *team.RegistrationTicket) error {
+	for i, t := range r.tickets {
+		if t.ID == ticket.ID {
+			r.tickets[i] = ticket
+			return nil
+		}
+	}
+	return team.ErrTicketNotFound
+}
+
+func (r *memRegistrationQueue) CountAhead(_ context.Context, ticket *team.RegistrationTicket) (int64, error) {
+	var n int64
+	for _, t := range r.tickets {
+		if t.TournamentID == ticket.TournamentID && t.Status == team.TicketWaiting && t.CreatedAt.Before(ticket.CreatedAt) {
+			n++
+		}
+	}
+	return n, nil
+}
+
+type memOrganizations struct {
+	organizations []*organization.Organization
+}
+
+func (r *memOrganizations) Create(_ context.Context, o *organization.Organization) error {
+	r.organizations = append(r.organizations, o)
+	return nil
+}
+
+func (r *memOrganizations) GetByID(_ context.Context, id uuid.UUID) (*organization.Organization, error) {
+	for _, o := range r.organizations {
+		if o.ID == id {
+			return o, nil
+		}
+	}
+	return nil, organization.ErrNotFound
+}
+
+func (r *memOrganizations) GetBySeries(_ context.Context, seriesID uuid.UUID) (*organization.Organization, error) {
+	for _, o := range r.organizations {
+		if o.HasSeries(seriesID) {
+			return o, nil
+		}
+	}
+	return nil, organization.ErrNotFound
+}
+
+func (r *memOrganizations) Update(_ context.Context, o *organization.Organization) error {
+	for i, existing := range r.organizations {
+		if existing.ID == o.ID {
+			r.organizations[i] = o
+			return nil
+		}
+	}
+	return organization.ErrNotFound
+}
+
+type memLadders struct {
+	ladders []*ladder.Ladder
+}
+
+func (r *memLadders) Create(_ context.Context, l *ladder.Ladder) error {
+	for _, existing := range r.ladders {
+		if existing.GameID == l.GameID {
+			return ladder.ErrLadderExists
+		}
+	}
+	r.ladders = append(r.ladders, l)
+	return nil
+}
+
+func (r *memLadders) GetByID(_ context.Context, id uuid.UUID) (*ladder.Ladder, error) {
+	for _, l := range r.ladders {
+		if l.ID == id {
+			return l, nil
+		}
+	}
+	return nil, ladder.ErrNotFound
+}
+
+func (r *memLadders) List(context.Context) ([]*ladder.Ladder, error) {
+	sorted := append([]*ladder.Ladder(nil), r.ladders...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	return sorted, nil
+}
+
+func (r *memLadders) Update(_ context.Context, l *ladder.Ladder) error {
+	for i, existing := range r.ladders {
+		if existing.ID == l.ID {
+			l.Version++
+			r.ladders[i] = l
+			return nil
+		}
+	}
+	return ladder.ErrNotFound
+}
+
+type memChallenges struct {
+	challenges []*ladder.Challenge
+}
+
+func (r *memChallenges) Create(_ context.Context, c *ladder.Challenge) error {
+	r.challenges = append(r.challenges, c)
+	return nil
+}
+
+func (r *memChallenges) GetByID(_ context.Context, id uuid.UUID) (*ladder.Challenge, error) {
+	for _, c := range r.challenges {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return nil, ladder.ErrChallengeNotFound
+}
+
+func (r *memChallenges) Update(_ context.Context, c *ladder.Challenge) error {
+	for i, existing := range r.challenges {
+		if existing.ID == c.ID {
+			r.challenges[i] = c
+			return nil
+		}
+	}
+	return ladder.ErrChallengeNotFound
+}
+
+func (r *memChallenges) ListByLadder(_ context.Context, ladderID uuid.UUID, season int) ([]*ladder.Challenge, error) {
+	var found []*ladder.Challenge
+	for i := len(r.challenges) - 1; i >= 0; i-- {
+		c := r.challenges[i]
+		if c.LadderID == ladderID && (season == 0 || c.Season == season) {
+			found = append(found, c)
+		}
+	}
+	return found, nil
+}
+
+func (r *memChallenges) ListOpen(context.Context) ([]*ladder.Challenge, error) {
+	var found []*ladder.Challenge
+	for _, c := range r.challenges {
+		if c.Open() {
+			found = append(found, c)
+		}
+	}
+	return found, nil
+}
+
+func (r *memChallenges) HasOpen(_ context.Context, ladderID, teamID uuid.UUID) (bool, error) {
+	for _, c := range r.challenges {
+		if c.LadderID == ladderID && c.Open() && (c.ChallengerTeamID == teamID || c.DefenderTeamID == teamID) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+type memLadderSnapshots struct {
+	snapshots []*ladder.Snapshot
+}
+
+func (r *memLadderSnapshots) Create(_ context.Context, s *ladder.Snapshot) error {
+	for _, existing := range r.snapshots {
+		if existing.LadderID == s.LadderID && existing.Season == s.Season && existing.Week.Equal(s.Week) && existing.Final == s.Final {
+			return ladder.ErrSnapshotExists
+		}
+	}
+	r.snapshots = append(r.snapshots, s)
+	return nil
+}
+
+func (r *memLadderSnapshots) ListByLadder(_ context.Context, ladderID uuid.UUID, season int) ([]*ladder.Snapshot, error) {
+	var found []*ladder.Snapshot
+	for i := len(r.snapshots) - 1; i >= 0; i-- {
+		s := r.snapshots[i]
+		if s.LadderID == ladderID && (season == 0 || s.Season == season) {
+			found = append(found, s)
+		}
+	}
+	return found, nil
+}
+
+type memSeasons struct {
+	seasons []*season.Season
+}
+
+func (r *memSeasons) Create(_ context.Context, s *season.Season) error {
+	for _, existing := range r.seasons {
+		if existing.GameID == s.GameID && (existing.Number == s.Number || existing.Overlaps(s)) {
+			return season.ErrOverlap
+		}
+	}
+	r.seasons = append(r.seasons, s)
+	return nil
+}
+
+func (r *memSeasons) GetByID(_ context.Context, id uuid.UUID) (*season.Season, error) {
+	for _, s := range r.seasons {
+		if s.ID == id {
+			return s, nil
+		}
+	}
+	return nil, season.ErrNotFound
+}
+
+func (r *memSeasons) ListByGame(_ context.Context, gameID uuid.UUID) ([]*season.Season, error) {
+	var found []*season.Season
+	for _, s := range r.seasons {
+		if s.GameID == gameID {
+			found = append(found, s)
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].StartsAt.After(found[j].StartsAt) })
+	return found, nil
+}
+
+func (r *memSeasons) GetCurrent(_ context.Context, gameID uuid.UUID, t time.Time) (*season.Season, error) {
+	for _, s := range r.seasons {
+		if s.GameID == gameID && s.ArchivedAt == nil && s.Contains(t) {
+			return s, nil
+		}
+	}
+	return nil, season.ErrNotFound
+}
+
+func (r *memSeasons) ListEnded(_ context.Context, now time.Time) ([]*season.Season, error) {
+	var found []*season.Season
+	for _, s := range r.seasons {
+		if s.ArchivedAt == nil && !now.Before(s.EndsAt) {
+			found = append(found, s)
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].EndsAt.Before(found[j].EndsAt) })
+	return found, nil
+}
+
+func (r *memSeasons) Update(_ context.Context, s *season.Season) error {
+	for i, existing := range r.seasons {
+		if existing.ID == s.ID {
+			r.seasons[i] = s
+			return nil
+		}
+	}
+	return season.ErrNotFound
+}
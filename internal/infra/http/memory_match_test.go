@@ -0,0 +1,883 @@
+package http
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alejaam/tourney-rank/internal/domain/activity"
+	"github.com/alejaam/tourney-rank/internal/domain/capture"
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/matchmaking"
+	"github.com/alejaam/tourney-rank/internal/domain/note"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/ranking"
+)
+
+// memMatches stores copies of matches, like a database would, so the status
+// guards on Update, Review, Amend, Unverify and Resubmit see the stored status
+// rather than the caller's modified one.
+// memMatches hands out copies of its matches. afterGet, when set, runs after
+// every read to simulate a concurrent change.
+type memMatches struct {
+	matches  []match.Match
+	games    *memGames
+	players  *memPlayers
+	teams    *memTeams
+	afterGet func(stored *match.Match)
+}
+
+func (r *memMatches) Create(_ context.Context, m *match.Match) error {
+	r.matches = append(r.matches, *m)
+	return nil
+}
+
+func (r *memMatches) find(id string) *match.Match {
+	for i := range r.matches {
+		if r.matches[i].ID.String() == id {
+			return &r.matches[i]
+		}
+	}
+	return nil
+}
+
+func (r *memMatches) GetByID(_ context.Context, id string) (*match.Match, error) {
+	m := r.find(id)
+	if m == nil {
+		return nil, match.ErrNotFound
+	}
+	found := *m
+	if r.afterGet != nil {
+		r.afterGet(m)
+	}
+	return &found, nil
+}
+
+func (r *memMatches) GetDetail(ctx context.Context, id string) (*match.Detail, error) {
+	m, err := r.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := &match.Detail{Match: *m, PlayerNames: make(map[uuid.UUID]string)}
+	if g, ok := r.games.games[m.GameID.String()]; ok {
+		detail.GameName = g.Name
+	}
+	for _, ps := range m.PlayerStats {
+		if p, ok := r.players.players[ps.PlayerID.String()]; ok {
+			detail.PlayerNames[ps.PlayerID] = p.DisplayName
+		}
+	}
+	return detail, nil
+}
+
+// filter returns the matches accepted by keep, newest first.
+func (r *memMatches) filter(keep func(m *match.Match) bool) []match.Match {
+	var found []match.Match
+	for i := range r.matches {
+		if keep(&r.matches[i]) {
+			found = append(found, r.matches[i])
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].CreatedAt.After(found[j].CreatedAt) })
+	return found
+}
+
+func (r *memMatches) GetByTournament(_ context.Context, tournamentID string, status match.Status, limit int, offset int) ([]match.Match, error) {
+	found := r.filter(func(m *match.Match) bool {
+		return m.TournamentID.String() == tournamentID && (status == "" || m.Status == status)
+	})
+	return page(found, offset, limit), nil
+}
+
+func (r *memMatches) GetByTeam(_ context.Context, teamID string, status match.Status, limit int, offset int) ([]match.Match, error) {
+	found := r.filter(func(m *match.Match) bool {
+		return m.TeamID.String() == teamID && (status == "" || m.Status == status)
+	})
+	return page(found, offset, limit), nil
+}
+
+func (r *memMatches) CountByTeam(ctx context.Context, teamID string, status match.Status) (int, error) {
+	found, err := r.GetByTeam(ctx, teamID, status, 0, 0)
+	return len(found), err
+}
+
+func (r *memMatches) GetByPlayer(_ context.Context, playerID string, status match.Status, limit int, offset int) ([]match.Match, error) {
+	found := r.filter(func(m *match.Match) bool {
+		return playedIn(m, playerID) && (status == "" || m.Status == status)
+	})
+	return page(found, offset, limit), nil
+}
+
+func (r *memMatches) CountByPlayer(ctx context.Context, playerID string, status match.Status) (int, error) {
+	found, err := r.GetByPlayer(ctx, playerID, status, 0, 0)
+	return len(found), err
+}
+
+func (r *memMatches) GetVerifiedByPlayerAndGame(_ context.Context, playerID string, gameID string, mode string, limit int) ([]match.Match, error) {
+	found := r.filter(func(m *match.Match) bool {
+		return m.Status == match.StatusVerified && m.GameID.String() == gameID &&
+			(mode == "" || m.Mode == mode) && playedIn(m, playerID)
+	})
+	sort.SliceStable(found, func(i, j int) bool { return found[i].VerifiedAt.After(*found[j].VerifiedAt) })
+	return page(found, 0, limit), nil
+}
+
+func (r *memMatches) GetVerifiedForCorrection(_ context.Context, filter match.CorrectionFilter, limit int) ([]match.Match, error) {
+	found := r.filter(func(m *match.Match) bool {
+		return m.Status == match.StatusVerified && m.GameID == filter.GameID &&
+			(filter.TournamentID == nil || m.TournamentID == *filter.TournamentID) &&
+			(filter.PlayerID == nil || playedIn(m, filter.PlayerID.String())) &&
+			(filter.VerifiedFrom == nil || !m.VerifiedAt.Before(*filter.VerifiedFrom)) &&
+			(filter.VerifiedTo == nil || m.VerifiedAt.Before(*filter.VerifiedTo))
+	})
+	sort.SliceStable(found, func(i, j int) bool { return found[i].VerifiedAt.Before(*found[j].VerifiedAt) })
+	return page(found, 0, limit), nil
+}
+
+func (r *memMatches) GetUnverified(_ context.Context, limit int, offset int) ([]match.Match, error) {
+	found := r.filter(func(m *match.Match) bool { return m.Status == match.StatusDraft })
+	return page(found, offset, limit), nil
+}
+
+func (r *memMatches) GetTournamentUnverified(_ context.Context, tournamentID string, limit int, offset int) ([]match.Match, error) {
+	found := r.filter(func(m *match.Match) bool {
+		return m.Status == match.StatusDraft && m.TournamentID.String() == tournamentID
+	})
+	return page(found, offset, limit), nil
+}
+
+func (r *memMatches) Update(_ context.Context, m *match.Match) error {
+	stored, err := r.guarded(m.ID, match.StatusDraft, match.ErrMatchNotDraft)
+	if err != nil {
+		return err
+	}
+	*stored = *m
+	return nil
+}
+
+func (r *memMatches) Review(_ context.Context, m *match.Match) error {
+	stored, err := r.guarded(m.ID, match.StatusDraft, match.ErrMatchNotDraft)
+	if err != nil {
+		return err
+	}
+	stored.Status = m.Status
+	stored.RejectionReason = m.RejectionReason
+	stored.VerifiedAt = m.VerifiedAt
+	stored.VerifiedBy = m.VerifiedBy
+	stored.UpdatedAt = m.UpdatedAt
+	if m.VerifiedSnapshot != nil {
+		stored.VerifiedSnapshot = m.VerifiedSnapshot
+	}
+	return nil
+}
+
+func (r *memMatches) Amend(_ context.Context, m *match.Match, amendment *match.Amendment) error {
+	stored, err := r.guarded(m.ID, match.StatusVerified, match.ErrMatchNotVerified)
+	if err != nil {
+		return err
+	}
+	stored.TeamPlacement = m.TeamPlacement
+	stored.TeamKills = m.TeamKills
+	stored.PlayerStats = m.PlayerStats
+	stored.UpdatedAt = m.UpdatedAt
+	stored.Amendments = append(stored.Amendments, *amendment)
+	return nil
+}
+
+func (r *memMatches) Unverify(_ context.Context, m *match.Match, reversal *match.Reversal) error {
+	stored, err := r.guarded(m.ID, match.StatusVerified, match.ErrMatchNotVerified)
+	if err != nil {
+		return err
+	}
+	stored.Status = m.Status
+	stored.UpdatedAt = m.UpdatedAt
+	stored.VerifiedAt = nil
+	stored.VerifiedBy = nil
+	stored.VerifiedSnapshot = nil
+	stored.Reversals = append(stored.Reversals, *reversal)
+	return nil
+}
+
+func (r *memMatches) Resubmit(_ context.Context, m *match.Match, revision *match.Revision) error {
+	stored, err := r.guarded(m.ID, match.StatusRejected, match.ErrMatchNotRejected)
+	if err != nil {
+		return err
+	}
+	stored.Status = m.Status
+	stored.TeamPlacement = m.TeamPlacement
+	stored.TeamKills = m.TeamKills
+	stored.PlayerStats = m.PlayerStats
+	stored.ScreenshotURL = m.ScreenshotURL
+	stored.Attachments = m.Attachments
+	stored.SubmittedBy = m.SubmittedBy
+	stored.Custody = m.Custody
+	stored.RejectionReason = ""
+	stored.VerifiedAt = nil
+	stored.VerifiedBy = nil
+	stored.UpdatedAt = m.UpdatedAt
+	stored.Revisions = append(stored.Revisions, *revision)
+	return nil
+}
+
+// guarded returns the stored match if it has the status a write requires.
+func (r *memMatches) guarded(id uuid.UUID, status match.Status, statusErr error) (*match.Match, error) {
+	stored := r.find(id.String())
+	if stored == nil {
+		return nil, match.ErrNotFound
+	}
+	if stored.Status != status {
+		return nil, statusErr
+	}
+	return stored, nil
+}
+
+func (r *memMatches) CountByTournament(ctx context.Context, tournamentID string, status match.Status) (int, error) {
+	found, err := r.GetByTournament(ctx, tournamentID, status, 0, 0)
+	return len(found), err
+}
+
+func (r *memMatches) AggregateTournament(_ context.Context, tournamentID string, unit match.BucketUnit) (*match.TournamentAggregates, error) {
+	agg := &match.TournamentAggregates{
+		Submissions: []match.SubmissionBucket{},
+		KillCounts:  make(map[int]int),
+		Placements:  []match.PlacementCount{},
+	}
+
+	width := 24 * time.Hour
+	if unit == match.BucketHour {
+		width = time.Hour
+	}
+	buckets := make(map[time.Time]*match.SubmissionBucket)
+	placements := make(map[match.PlacementCount]int)
+	teams := make(map[uuid.UUID]bool)
+	var minutes []float64
+
+	for i := range r.matches {
+		m := &r.matches[i]
+		if m.TournamentID.String() != tournamentID {
+			continue
+		}
+		teams[m.TeamID] = true
+
+		start := m.CreatedAt.UTC().Truncate(width)
+		b, ok := buckets[start]
+		if !ok {
+			b = &match.SubmissionBucket{Start: start}
+			buckets[start] = b
+		}
+		b.Submitted++
+		switch m.Status {
+		case match.StatusVerified:
+			b.Verified++
+		case match.StatusRejected:
+			b.Rejected++
+		}
+
+		if m.VerifiedAt != nil {
+			minutes = append(minutes, m.VerifiedAt.Sub(m.CreatedAt).Minutes())
+		}
+		if m.Status != match.StatusVerified {
+			continue
+		}
+		for _, ps := range m.PlayerStats {
+			agg.KillCounts[ps.Kills]++
+		}
+		placements[match.PlacementCount{Map: m.Map, Placement: m.TeamPlacement}]++
+	}
+
+	for _, b := range buckets {
+		agg.Submissions = append(agg.Submissions, *b)
+	}
+	sort.Slice(agg.Submissions, func(i, j int) bool { return agg.Submissions[i].Start.Before(agg.Submissions[j].Start) })
+
+	for key, count := range placements {
+		key.Count = count
+		agg.Placements = append(agg.Placements, key)
+	}
+	sort.Slice(agg.Placements, func(i, j int) bool {
+		if agg.Placements[i].Map != agg.Placements[j].Map {
+			return agg.Placements[i].Map < agg.Placements[j].Map
+		}
+		return agg.Placements[i].Placement < agg.Placements[j].Placement
+	})
+
+	if len(minutes) > 0 {
+		t := &agg.Turnaround
+		t.Reviewed = len(minutes)
+		t.MinMinutes, t.MaxMinutes = minutes[0], minutes[0]
+		var total float64
+		for _, m := range minutes {
+			total += m
+			t.MinMinutes = min(t.MinMinutes, m)
+			t.MaxMinutes = max(t.MaxMinutes, m)
+		}
+		t.AvgMinutes = total / float64(len(minutes))
+	}
+	agg.ActiveTeams = len(teams)
+
+	return agg, nil
+}
+
+func (r *memMatches) CountVerifiedSince(_ context.Context, since time.Time) (int, error) {
+	found := r.filter(func(m *match.Match) bool {
+		return m.Status == match.StatusVerified && m.VerifiedAt != nil && !m.VerifiedAt.Before(since)
+	})
+	return len(found), nil
+}
+
+func (r *memMatches) GetPlayerActiveWeeks(_ context.Context, since time.Time) ([]match.PlayerWeek, error) {
+	seen := make(map[match.PlayerWeek]bool)
+	var weeks []match.PlayerWeek
+	for i := range r.matches {
+		m := &r.matches[i]
+		if m.Status != match.StatusVerified || m.CreatedAt.Before(since) {
+			continue
+		}
+		day := m.CreatedAt.UTC().Truncate(24 * time.Hour)
+		monday := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		for _, ps := range m.PlayerStats {
+			week := match.PlayerWeek{PlayerID: ps.PlayerID, Week: monday}
+			if !seen[week] {
+				seen[week] = true
+				weeks = append(weeks, week)
+			}
+		}
+	}
+	return weeks, nil
+}
+
+func (r *memMatches) CountPlayerMatchesByDay(_ context.Context, playerID string, gameID string, since time.Time) ([]match.DayCount, error) {
+	counts := make(map[time.Time]int)
+	for i := range r.matches {
+		m := &r.matches[i]
+		if m.Status != match.StatusVerified || m.GameID.String() != gameID || m.CreatedAt.Before(since) || !playedIn(m, playerID) {
+			continue
+		}
+		counts[m.CreatedAt.UTC().Truncate(24*time.Hour)]++
+	}
+
+	days := make([]match.DayCount, 0, len(counts))
+	for day, n := range counts {
+		days = append(days, match.DayCount{Day: day, Matches: n})
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i].Day.Before(days[j].Day) })
+	return days, nil
+}
+
+func (r *memMatches) CountUnverified(context.Context) (int, error) {
+	found := r.filter(func(m *match.Match) bool { return m.Status == match.StatusDraft })
+	return len(found), nil
+}
+
+func (r *memMatches) DeleteByID(_ context.Context, id string) error {
+	for i := range r.matches {
+		if r.matches[i].ID.String() == id {
+			r.matches = append(r.matches[:i], r.matches[i+1:]...)
+			return nil
+		}
+	}
+	return match.ErrNotFound
+}
+
+func (r *memMatches) FindWithMissingTeam(_ context.Context, limit int64) ([]match.Match, error) {
+	var orphans []match.Match
+	for _, m := range r.matches {
+		if int64(len(orphans)) == limit {
+			break
+		}
+		if _, err := r.teams.GetByID(context.Background(), m.TeamID); err != nil {
+			orphans = append(orphans, m)
+		}
+	}
+	return orphans, nil
+}
+
+func playedIn(m *match.Match, playerID string) bool {
+	for _, ps := range m.PlayerStats {
+		if ps.PlayerID.String() == playerID {
+			return true
+		}
+	}
+	return false
+}
+
+type memComments struct {
+	comments []match.Comment
+}
+
+func (r *memComments) Create(_ context.Context, c *match.Comment) error {
+	r.comments = append(r.comments, *c)
+	return nil
+}
+
+func (r *memComments) ListByMatch(ctx context.Context, matchID string) ([]match.Comment, error) {
+	return r.ListByMatches(ctx, []string{matchID})
+}
+
+func (r *memComments) ListByMatches(_ context.Context, matchIDs []string) ([]match.Comment, error) {
+	found := []match.Comment{}
+	for _, c := range r.comments {
+		for _, id := range matchIDs {
+			if c.MatchID.String() == id {
+				found = append(found, c)
+				break
+			}
+		}
+	}
+	return found, nil
+}
+
+type memHonorVotes struct {
+	votes []*match.HonorVote
+}
+
+func (r *memHonorVotes) Create(_ context.Context, vote *match.HonorVote) error {
+	for _, v := range r.votes {
+		if v.MatchID == vote.MatchID && v.VoterID == vote.VoterID {
+			return match.ErrAlreadyVoted
+		}
+	}
+	r.votes = append(r.votes, vote)
+	return nil
+}
+
+func (r *memHonorVotes) ListByMatch(_ context.Context, matchID uuid.UUID) ([]*match.HonorVote, error) {
+	found := []*match.HonorVote{}
+	for _, v := range r.votes {
+		if v.MatchID == matchID {
+			found = append(found, v)
+		}
+	}
+	return found, nil
+}
+
+// memConnectors hands out copies of its connectors, as a database would, so a
+// write-back of a stale read is visible. afterGet, when set, runs after every
+// read to simulate a concurrent change.
+type memConnectors struct {
+	connectors []*match.Connector
+	nonces     map[string]bool
+	afterGet   func(stored *match.Connector)
+}
+
+func (r *memConnectors) Create(_ context.Context, c *match.Connector) error {
+	r.connectors = append(r.connectors, c)
+	return nil
+}
+
+func (r *memConnectors) GetByID(_ context.Context, id uuid.UUID) (*match.Connector, error) {
+	for _, c := range r.connectors {
+		if c.ID == id {
+			read := *c
+			if r.afterGet != nil {
+				r.afterGet(c)
+			}
+			return &read, nil
+		}
+	}
+	return nil, match.ErrConnectorNotFound
+}
+
+func (r *memConnectors) List(context.Context) ([]*match.Connector, error) {
+	found := []*match.Connector{}
+	for i := len(r.connectors) - 1; i >= 0; i-- {
+		found = append(found, r.connectors[i])
+	}
+	return found, nil
+}
+
+func (r *memConnectors) Update(_ context.Context, c *match.Connector) error {
+	for i, existing := range r.connectors {
+		if existing.ID == c.ID {
+			stored := *c
+			r.connectors[i] = &stored
+			return nil
+		}
+	}
+	return match.ErrConnectorNotFound
+}
+
+func (r *memConnectors) TouchLastUsed(_ context.Context, id uuid.UUID, at time.Time) error {
+	for _, c := range r.connectors {
+		if c.ID == id {
+			c.LastUsedAt = &at
+			return nil
+		}
+	}
+	return match.ErrConnectorNotFound
+}
+
+func (r *memConnectors) Claim(_ context.Context, connectorID uuid.UUID, nonce string, _ time.Time) error {
+	key := connectorID.String() + "/" + nonce
+	if r.nonces[key] {
+		return match.ErrReplayedConnectorSubmission
+	}
+	r.nonces[key] = true
+	return nil
+}
+
+type memNotes struct {
+	notes []*note.Note
+}
+
+func (r *memNotes) Create(_ context.Context, n *note.Note) error {
+	r.notes = append(r.notes, n)
+	return nil
+}
+
+func (r *memNotes) ListBySubject(ctx context.Context, subject note.Subject, subjectID uuid.UUID) ([]*note.Note, error) {
+	return r.ListBySubjects(ctx, subject, []uuid.UUID{subjectID})
+}
+
+func (r *memNotes) ListBySubjects(_ context.Context, subject note.Subject, subjectIDs []uuid.UUID) ([]*note.Note, error) {
+	found := []*note.Note{}
+	for i := len(r.notes) - 1; i >= 0; i-- {
+		n := r.notes[i]
+		if n.Subject != subject {
+			continue
+		}
+		for _, id := range subjectIDs {
+			if n.SubjectID == id {
+				found = append(found, n)
+				break
+			}
+		}
+	}
+	return found, nil
+}
+
+type memQueue struct {
+	entries []*matchmaking.QueueEntry
+}
+
+func (r *memQueue) Create(_ context.Context, e *matchmaking.QueueEntry) error {
+	r.entries = append(r.entries, e)
+	return nil
+}
+
+func (r *memQueue) GetByID(_ context.Context, id uuid.UUID) (*matchmaking.QueueEntry, error) {
+	for _, e := range r.entries {
+		if e.ID == id {
+			return e, nil
+		}
+	}
+	return nil, matchmaking.ErrEntryNotFound
+}
+
+func (r *memQueue) Update(_ context.Context, e *matchmaking.QueueEntry) error {
+	for i, existing := range r.entries {
+		if existing.ID == e.ID {
+			r.entries[i] = e
+			return nil
+		}
+	}
+	return matchmaking.ErrEntryNotFound
+}
+
+func (r *memQueue) GetActiveByPlayer(_ context.Context, playerID uuid.UUID) (*matchmaking.QueueEntry, error) {
+	for i := len(r.entries) - 1; i >= 0; i-- {
+		e := r.entries[i]
+		if e.Status != matchmaking.EntryStatusWaiting && e.Status != matchmaking.EntryStatusMatched {
+			continue
+		}
+		for _, id := range e.MemberIDs {
+			if id == playerID {
+				return e, nil
+			}
+		}
+	}
+	return nil, matchmaking.ErrEntryNotFound
+}
+
+func (r *memQueue) GetWaiting(_ context.Context, gameID uuid.UUID, tier player.Tier) ([]*matchmaking.QueueEntry, error) {
+	var found []*matchmaking.QueueEntry
+	for _, e := range r.entries {
+		if e.Status == matchmaking.EntryStatusWaiting && e.GameID == gameID && e.Tier == tier {
+			found = append(found, e)
+		}
+	}
+	return found, nil
+}
+
+func (r *memQueue) GetWaitingBrackets(context.Context) ([]matchmaking.Bracket, error) {
+	seen := make(map[matchmaking.Bracket]bool)
+	var brackets []matchmaking.Bracket
+	for _, e := range r.entries {
+		b := matchmaking.Bracket{GameID: e.GameID, Tier: e.Tier}
+		if e.Status == matchmaking.EntryStatusWaiting && !seen[b] {
+			seen[b] = true
+			brackets = append(brackets, b)
+		}
+	}
+	return brackets, nil
+}
+
+func (r *memQueue) GetByLobbyID(_ context.Context, lobbyID uuid.UUID) ([]*matchmaking.QueueEntry, error) {
+	var found []*matchmaking.QueueEntry
+	for _, e := range r.entries {
+		if e.LobbyID != nil && *e.LobbyID == lobbyID {
+			found = append(found, e)
+		}
+	}
+	return found, nil
+}
+
+type memLobbies struct {
+	lobbies []*matchmaking.Lobby
+}
+
+func (r *memLobbies) Create(_ context.Context, l *matchmaking.Lobby) error {
+	r.lobbies = append(r.lobbies, l)
+	return nil
+}
+
+func (r *memLobbies) GetByID(_ context.Context, id uuid.UUID) (*matchmaking.Lobby, error) {
+	for _, l := range r.lobbies {
+		if l.ID == id {
+			return l, nil
+		}
+	}
+	return nil, matchmaking.ErrLobbyNotFound
+}
+
+func (r *memLobbies) Update(_ context.Context, l *matchmaking.Lobby) error {
+	for i, existing := range r.lobbies {
+		if existing.ID == l.ID {
+			r.lobbies[i] = l
+			return nil
+		}
+	}
+	return matchmaking.ErrLobbyNotFound
+}
+
+type memActivity struct {
+	events []*activity.Event
+}
+
+func (r *memActivity) Create(_ context.Context, events ...*activity.Event) error {
+	r.events = append(r.events, events...)
+	return nil
+}
+
+func (r *memActivity) ListByPlayer(_ context.Context, playerID uuid.UUID, limit, offset int) ([]*activity.Event, error) {
+	found := []*activity.Event{}
+	for i := len(r.events) - 1; i >= 0; i-- {
+		if r.events[i].PlayerID == playerID {
+			found = append(found, r.events[i])
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].OccurredAt.After(found[j].OccurredAt) })
+	return page(found, offset, limit), nil
+}
+
+func (r *memActivity) CountByPlayer(_ context.Context, playerID uuid.UUID) (int64, error) {
+	var n int64
+	for _, e := range r.events {
+		if e.PlayerID == playerID {
+			n++
+		}
+	}
+	return n, nil
+}
+
+type memSiteFeed struct {
+	events []*activity.SiteEvent
+}
+
+func (r *memSiteFeed) Append(_ context.Context, event *activity.SiteEvent) error {
+	r.events = append(r.events, event)
+	return nil
+}
+
+func (r *memSiteFeed) ListRecent(_ context.Context, limit int) ([]*activity.SiteEvent, error) {
+	found := []*activity.SiteEvent{}
+	for i := len(r.events) - 1; i >= 0 && len(found) < limit; i-- {
+		found = append(found, r.events[i])
+	}
+	return found, nil
+}
+
+type memBoundaries struct {
+	boundaries []*ranking.TierBoundaries
+}
+
+func (r *memBoundaries) Create(_ context.Context, b *ranking.TierBoundaries) error {
+	r.boundaries = append(r.boundaries, b)
+	return nil
+}
+
+func (r *memBoundaries) GetEffective(_ context.Context, gameID uuid.UUID, at time.Time) (*ranking.TierBoundaries, error) {
+	var effective *ranking.TierBoundaries
+	for _, b := range r.boundaries {
+		if b.GameID != gameID || b.EffectiveFrom.After(at) {
+			continue
+		}
+		if effective == nil || b.EffectiveFrom.After(effective.EffectiveFrom) ||
+			(b.EffectiveFrom.Equal(effective.EffectiveFrom) && b.Version > effective.Version) {
+			effective = b
+		}
+	}
+	if effective == nil {
+		return nil, ranking.ErrBoundariesNotFound
+	}
+	return effective, nil
+}
+
+func (r *memBoundaries) GetByVersion(_ context.Context, gameID uuid.UUID, version int) (*ranking.TierBoundaries, error) {
+	for _, b := range r.boundaries {
+		if b.GameID == gameID && b.Version == version {
+			return b, nil
+		}
+	}
+	return nil, ranking.ErrBoundariesNotFound
+}
+
+func (r *memBoundaries) GetLatest(ctx context.Context, gameID uuid.UUID) (*ranking.TierBoundaries, error) {
+	versions, _ := r.ListByGame(ctx, gameID)
+	if len(versions) == 0 {
+		return nil, ranking.ErrBoundariesNotFound
+	}
+	return versions[0], nil
+}
+
+func (r *memBoundaries) ListByGame(_ context.Context, gameID uuid.UUID) ([]*ranking.TierBoundaries, error) {
+	found := []*ranking.TierBoundaries{}
+	for _, b := range r.boundaries {
+		if b.GameID == gameID {
+			found = append(found, b)
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool { return found[i].Version > found[j].Version })
+	return found, nil
+}
+
+type memRatingHistory struct {
+	entries []*ranking.HistoryEntry
+}
+
+func (r *memRatingHistory) Create(_ context.Context, entry *ranking.HistoryEntry) error {
+	r.entries = append(r.entries, entry)
+	return nil
+}
+
+func (r *memRatingHistory) ListByPlayer(_ context.Context, playerID, gameID uuid.UUID, limit int64) ([]*ranking.HistoryEntry, error) {
+	found := []*ranking.HistoryEntry{}
+	for i := len(r.entries) - 1; i >= 0; i-- {
+		if e := r.entries[i]; e.PlayerID == playerID && e.GameID == gameID {
+			found = append(found, e)
+		}
+	}
+	return page(found, 0, limit), nil
+}
+
+func (r *memRatingHistory) ListByBoundaryVersion(_ context.Context, gameID uuid.UUID, version int, reason ranking.HistoryReason) ([]*ranking.HistoryEntry, error) {
+	var found []*ranking.HistoryEntry
+	for _, e := range r.entries {
+		if e.GameID == gameID && e.BoundaryVersion == version && e.Reason == reason {
+			found = append(found, e)
+		}
+	}
+	return found, nil
+}
+
+type memRewards struct {
+	rewards []*ranking.RewardDistribution
+}
+
+func (r *memRewards) Create(_ context.Context, d *ranking.RewardDistribution) error {
+	for _, existing := range r.rewards {
+		if existing.PlayerID == d.PlayerID && existing.GameID == d.GameID && existing.Season == d.Season {
+			return ranking.ErrRewardAlreadyDistributed
+		}
+	}
+	r.rewards = append(r.rewards, d)
+	return nil
+}
+
+func (r *memRewards) ListUnnotified(_ context.Context, gameID uuid.UUID, season string) ([]*ranking.RewardDistribution, error) {
+	var found []*ranking.RewardDistribution
+	for _, d := range r.rewards {
+		if d.GameID == gameID && d.Season == season && d.NotifiedAt == nil {
+			found = append(found, d)
+		}
+	}
+	return found, nil
+}
+
+func (r *memRewards) MarkNotified(_ context.Context, id uuid.UUID, at time.Time) error {
+	for _, d := range r.rewards {
+		if d.ID == id {
+			d.NotifiedAt = &at
+		}
+	}
+	return nil
+}
+
+func (r *memRewards) ListByPlayer(_ context.Context, playerID, gameID uuid.UUID) ([]*ranking.RewardDistribution, error) {
+	found := []*ranking.RewardDistribution{}
+	for i := len(r.rewards) - 1; i >= 0; i-- {
+		if d := r.rewards[i]; d.PlayerID == playerID && d.GameID == gameID {
+			found = append(found, d)
+		}
+	}
+	return found, nil
+}
+
+type memCorrections struct {
+	corrections []*match.StatCorrection
+}
+
+func (r *memCorrections) Create(_ context.Context, c *match.StatCorrection) error {
+	r.corrections = append(r.corrections, c)
+	return nil
+}
+
+func (r *memCorrections) GetByID(_ context.Context, id uuid.UUID) (*match.StatCorrection, error) {
+	for _, c := range r.corrections {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return nil, match.ErrCorrectionNotFound
+}
+
+func (r *memCorrections) ListByGame(_ context.Context, gameID uuid.UUID) ([]*match.StatCorrection, error) {
+	found := []*match.StatCorrection{}
+	for i := len(r.corrections) - 1; i >= 0; i-- {
+		if c := r.corrections[i]; c.Filter.GameID == gameID {
+			found = append(found, c)
+		}
+	}
+	return found, nil
+}
+
+type memCaptures struct {
+	captures []*capture.Capture
+}
+
+func (r *memCaptures) Create(_ context.Context, c *capture.Capture) error {
+	r.captures = append(r.captures, c)
+	return nil
+}
+
+func (r *memCaptures) GetByID(_ context.Context, id uuid.UUID) (*capture.Capture, error) {
+	for _, c := range r.captures {
+		if c.ID == id {
+			return c, nil
+		}
+	}
+	return nil, capture.ErrNotFound
+}
+
+func (r *memCaptures) List(_ context.Context, kind capture.Kind, limit int64) ([]*capture.Capture, error) {
+	found := []*capture.Capture{}
+	for i := len(r.captures) - 1; i >= 0; i-- {
+		if c := r.captures[i]; kind == "" || c.Kind == kind {
+			found = append(found, c)
+		}
+	}
+	return page(found, 0, limit), nil
+}
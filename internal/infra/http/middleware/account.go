@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+)
+
+// AccountChecker reports whether a user's account may still use the tokens
+// issued to it.
+type AccountChecker interface {
+	IsAccountActive(ctx context.Context, userID string) (bool, error)
+}
+
+// RequireActiveAccount rejects requests authenticated with a token of an
+// account deactivated since the token was issued, as if the token were
+// invalid. Anonymous requests pass. It must run after Auth or OptionalAuth.
+func RequireActiveAccount(checker AccountChecker, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			userInfo, ok := GetUserInfo(r.Context())
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			active, err := checker.IsAccountActive(r.Context(), userInfo.ID)
+			if err != nil {
+				logger.Error("failed to check account", "user_id", userInfo.ID, "error", err)
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+				return
+			}
+			if !active {
+				logger.Debug("authentication failed", "user_id", userInfo.ID, "error", "account deactivated")
+				http.Error(w, "unauthorized", http.StatusUnauthorized)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
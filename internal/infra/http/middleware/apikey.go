@@ -0,0 +1,95 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/developer"
+)
+
+// APIKeyHeader carries a developer's API key.
+const APIKeyHeader = "X-API-Key"
+
+// KeyAuthorizer counts a request made with an API key against its quota, and
+// a request made without one against the anonymous quota of its address.
+type KeyAuthorizer interface {
+	Authorize(ctx context.Context, key string) (*developer.Decision, error)
+	AuthorizeAnonymous(ctx context.Context, address string) (*developer.Decision, error)
+}
+
+// APIKeyQuota enforces the quota of requests carrying an API key and reports
+// it in the X-RateLimit-Limit, X-RateLimit-Remaining and X-RateLimit-Reset
+// headers. API requests without a key are held to the anonymous quota of
+// their client address the same way; other requests without a key, such as
+// health checks, pass through unchanged. Requests over a quota are rejected
+// with 429 and the time the quota resets.
+func APIKeyQuota(authorizer KeyAuthorizer, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			key := r.Header.Get(APIKeyHeader)
+			if key == "" && !strings.HasPrefix(r.URL.Path, "/api/") {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			var d *developer.Decision
+			var err error
+			if key == "" {
+				d, err = authorizer.AuthorizeAnonymous(r.Context(), remoteIP(r))
+			} else {
+				d, err = authorizer.Authorize(r.Context(), key)
+			}
+			if errors.Is(err, developer.ErrInvalidKey) {
+				writeJSONError(w, http.StatusUnauthorized, map[string]string{"error": err.Error()})
+				return
+			}
+			if err != nil {
+				logger.Error("failed to authorize API key", "error", err)
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+				return
+			}
+
+			if d.Limit > 0 {
+				w.Header().Set("X-RateLimit-Limit", strconv.FormatInt(d.Limit, 10))
+				w.Header().Set("X-RateLimit-Remaining", strconv.FormatInt(d.Remaining, 10))
+				w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(d.ResetAt.Unix(), 10))
+			}
+			if !d.Allowed {
+				retryAfter := int(math.Ceil(time.Until(d.ResetAt).Seconds()))
+				w.Header().Set("Retry-After", strconv.Itoa(max(retryAfter, 1)))
+				writeJSONError(w, http.StatusTooManyRequests, map[string]any{
+					"error":    "quota_exceeded",
+					"window":   d.Window,
+					"limit":    d.Limit,
+					"reset_at": d.ResetAt,
+				})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// remoteIP returns the client address of a request, without the port.
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+func writeJSONError(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
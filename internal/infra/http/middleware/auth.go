@@ -3,6 +3,7 @@ package middleware
 import (
 	"context"
 	"errors"
+	"fmt"
 	"log/slog"
 	"net/http"
 	"strings"
@@ -27,67 +28,89 @@ type UserInfo struct {
 func Auth(jwtSecret string, logger *slog.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			authHeader := r.Header.Get("Authorization")
-			if authHeader == "" {
-				logger.Debug("missing authorization header")
+			userInfo, err := parseUserInfo(r, jwtSecret)
+			if err != nil {
+				logger.Debug("authentication failed", "error", err)
 				http.Error(w, "unauthorized", http.StatusUnauthorized)
 				return
 			}
 
-			parts := strings.Split(authHeader, " ")
-			if len(parts) != 2 || parts[0] != "Bearer" {
-				logger.Debug("invalid authorization header format")
-				http.Error(w, "unauthorized", http.StatusUnauthorized)
-				return
-			}
-
-			tokenString := parts[1]
-
-			token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-				if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-					return nil, errors.New("invalid signing method")
-				}
-				return []byte(jwtSecret), nil
-			})
-
-			if err != nil || !token.Valid {
-				logger.Debug("invalid token", "error", err)
-				http.Error(w, "unauthorized", http.StatusUnauthorized)
-				return
-			}
-
-			claims, ok := token.Claims.(jwt.MapClaims)
-			if !ok {
-				logger.Debug("invalid claims")
-				http.Error(w, "unauthorized", http.StatusUnauthorized)
-				return
-			}
+			ctx := context.WithValue(r.Context(), UserContextKey, userInfo)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
 
-			userID, ok := claims["sub"].(string)
-			if !ok {
-				logger.Debug("missing user id in claims")
-				http.Error(w, "unauthorized", http.StatusUnauthorized)
+// OptionalAuth adds user info to context when the request carries a valid JWT
+// and passes anonymous requests through unchanged. An invalid token is still
+// rejected, so clients notice expired sessions.
+func OptionalAuth(jwtSecret string, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") == "" {
+				next.ServeHTTP(w, r)
 				return
 			}
 
-			roleStr, ok := claims["role"].(string)
-			if !ok {
-				logger.Debug("missing role in claims")
+			userInfo, err := parseUserInfo(r, jwtSecret)
+			if err != nil {
+				logger.Debug("authentication failed", "error", err)
 				http.Error(w, "unauthorized", http.StatusUnauthorized)
 				return
 			}
 
-			userInfo := &UserInfo{
-				ID:   userID,
-				Role: user.Role(roleStr),
-			}
-
 			ctx := context.WithValue(r.Context(), UserContextKey, userInfo)
 			next.ServeHTTP(w, r.WithContext(ctx))
 		})
 	}
 }
 
+// parseUserInfo validates the request's bearer token and extracts its user info.
+func parseUserInfo(r *http.Request, jwtSecret string) (*UserInfo, error) {
+	authHeader := r.Header.Get("Authorization")
+	if authHeader == "" {
+		return nil, errors.New("missing authorization header")
+	}
+
+	parts := strings.Split(authHeader, " ")
+	if len(parts) != 2 || parts[0] != "Bearer" {
+		return nil, errors.New("invalid authorization header format")
+	}
+
+	token, err := jwt.Parse(parts[1], func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, errors.New("invalid signing method")
+		}
+		return []byte(jwtSecret), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	if !token.Valid {
+		return nil, errors.New("invalid token")
+	}
+
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok {
+		return nil, errors.New("invalid claims")
+	}
+
+	userID, ok := claims["sub"].(string)
+	if !ok {
+		return nil, errors.New("missing user id in claims")
+	}
+
+	roleStr, ok := claims["role"].(string)
+	if !ok {
+		return nil, errors.New("missing role in claims")
+	}
+
+	return &UserInfo{
+		ID:   userID,
+		Role: user.Role(roleStr),
+	}, nil
+}
+
 // AdminOnly ensures the user has admin role.
 func AdminOnly(logger *slog.Logger) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
@@ -0,0 +1,131 @@
+package middleware
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/capture"
+)
+
+// captureWriteTimeout bounds how long storing a capture may hold up the
+// request that produced it.
+const captureWriteTimeout = 2 * time.Second
+
+// CaptureRecorder stores request captures.
+type CaptureRecorder interface {
+	Create(ctx context.Context, c *capture.Capture) error
+}
+
+// RequestCapture records sanitized request/response pairs of the endpoints
+// it wraps, so match submission and verification issues can be inspected and
+// replayed later. Bodies are copied as the handler reads and writes them, up
+// to capture.MaxBodyBytes; a capture that cannot be stored is logged and the
+// response is unaffected.
+type RequestCapture struct {
+	recorder CaptureRecorder
+	ttl      time.Duration
+	logger   *slog.Logger
+}
+
+// NewRequestCapture creates a request capture keeping captures for ttl.
+func NewRequestCapture(recorder CaptureRecorder, ttl time.Duration, logger *slog.Logger) *RequestCapture {
+	return &RequestCapture{
+		recorder: recorder,
+		ttl:      ttl,
+		logger:   logger,
+	}
+}
+
+// Wrap captures next's exchanges as kind. It must run after authentication
+// so captures can name the user who made the request.
+func (c *RequestCapture) Wrap(kind capture.Kind, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := &captureBuffer{}
+		if r.Body != nil {
+			r.Body = &teeBody{ReadCloser: r.Body, copy: request}
+		}
+		cw := &captureWriter{ResponseWriter: w, status: http.StatusOK}
+
+		start := time.Now()
+		next.ServeHTTP(cw, r)
+
+		ex := capture.Exchange{
+			Kind:              kind,
+			Method:            r.Method,
+			Path:              r.URL.Path,
+			Request:           request.data,
+			RequestTruncated:  request.truncated,
+			Status:            cw.status,
+			Response:          cw.body.data,
+			ResponseTruncated: cw.body.truncated,
+			Duration:          time.Since(start),
+		}
+		if userInfo, ok := GetUserInfo(r.Context()); ok {
+			ex.UserID = userInfo.ID
+		}
+
+		// The client may be gone by now; the capture is kept regardless.
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(r.Context()), captureWriteTimeout)
+		defer cancel()
+		if err := c.recorder.Create(ctx, capture.NewCapture(ex, c.ttl, time.Now().UTC())); err != nil {
+			c.logger.Warn("failed to store request capture", "kind", kind, "path", r.URL.Path, "error", err)
+		}
+	})
+}
+
+// captureBuffer keeps the first capture.MaxBodyBytes written to it and
+// notes whether anything was left out.
+type captureBuffer struct {
+	data      []byte
+	truncated bool
+}
+
+func (b *captureBuffer) Write(p []byte) (int, error) {
+	room := capture.MaxBodyBytes - len(b.data)
+	if len(p) > room {
+		b.data = append(b.data, p[:max(room, 0)]...)
+		b.truncated = true
+		return len(p), nil
+	}
+	b.data = append(b.data, p...)
+	return len(p), nil
+}
+
+// teeBody copies a request body into a capture buffer as the handler reads it.
+type teeBody struct {
+	io.ReadCloser
+	copy *captureBuffer
+}
+
+func (t *teeBody) Read(p []byte) (int, error) {
+	n, err := t.ReadCloser.Read(p)
+	if n > 0 {
+		_, _ = t.copy.Write(p[:n])
+	}
+	return n, err
+}
+
+// captureWriter copies a response into a capture buffer as it is written.
+type captureWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+	body        captureBuffer
+}
+
+func (cw *captureWriter) WriteHeader(status int) {
+	if !cw.wroteHeader {
+		cw.status = status
+		cw.wroteHeader = true
+	}
+	cw.ResponseWriter.WriteHeader(status)
+}
+
+func (cw *captureWriter) Write(p []byte) (int, error) {
+	cw.wroteHeader = true
+	_, _ = cw.body.Write(p)
+	return cw.ResponseWriter.Write(p)
+}
@@ -0,0 +1,51 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+)
+
+// ConsentChecker reports whether a user has accepted the current policies.
+type ConsentChecker interface {
+	HasCurrentConsent(ctx context.Context, userID string) (bool, error)
+}
+
+// RequireConsent rejects write requests from users who have not accepted the
+// current terms of service and privacy policy. Reads always pass, so users
+// can still see what changed. It must run after Auth.
+func RequireConsent(checker ConsentChecker, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.Method {
+			case http.MethodGet, http.MethodHead, http.MethodOptions:
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			userInfo, ok := GetUserInfo(r.Context())
+			if !ok {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			current, err := checker.HasCurrentConsent(r.Context(), userInfo.ID)
+			if err != nil {
+				logger.Error("failed to check consent", "user_id", userInfo.ID, "error", err)
+				http.Error(w, "internal server error", http.StatusInternalServerError)
+				return
+			}
+			if !current {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusForbidden)
+				_ = json.NewEncoder(w).Encode(map[string]string{
+					"error": "consent_required",
+				})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package middleware
+
+import (
+	"log/slog"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+// FaultInjectedHeader marks responses whose error was injected rather than
+// produced by a handler, so client logs can tell the two apart.
+const FaultInjectedHeader = "X-Fault-Injected"
+
+// FaultRule is the failure injected into a route group's requests.
+type FaultRule struct {
+	Latency   time.Duration // Added before the request is handled
+	ErrorRate float64       // Share of requests answered with an error, from 0 to 1
+}
+
+// FaultInjector delays API requests and fails a share of them, so the
+// frontend and client retry logic can be exercised against a misbehaving
+// backend. It is meant for development and staging only.
+type FaultInjector struct {
+	defaults   FaultRule
+	latencies  map[string]time.Duration
+	errorRates map[string]float64
+	status     int
+	logger     *slog.Logger
+	chance     func() float64
+}
+
+// NewFaultInjector creates a fault injector. Groups without an entry in
+// latencies or errorRates fall back to the matching field of defaults.
+// Injected errors are answered with status; a 503 also carries a
+// Retry-After header.
+func NewFaultInjector(defaults FaultRule, latencies map[string]time.Duration, errorRates map[string]float64, status int, logger *slog.Logger) *FaultInjector {
+	return &FaultInjector{
+		defaults:   defaults,
+		latencies:  latencies,
+		errorRates: errorRates,
+		status:     status,
+		logger:     logger,
+		chance:     rand.Float64,
+	}
+}
+
+// Rule returns the rule applied to a route group.
+func (f *FaultInjector) Rule(group string) FaultRule {
+	rule := f.defaults
+	if latency, ok := f.latencies[group]; ok {
+		rule.Latency = latency
+	}
+	if rate, ok := f.errorRates[group]; ok {
+		rule.ErrorRate = rate
+	}
+	return rule
+}
+
+// Middleware applies the route group's rule to API requests. Paths outside
+// the API are left alone so health checks keep reporting the real state.
+func (f *FaultInjector) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		group := RouteGroup(r.URL.Path)
+		if group == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		rule := f.Rule(group)
+		if rule.Latency > 0 {
+			timer := time.NewTimer(rule.Latency)
+			select {
+			case <-timer.C:
+			case <-r.Context().Done():
+				timer.Stop()
+				return
+			}
+		}
+
+		if rule.ErrorRate > 0 && f.chance() < rule.ErrorRate {
+			f.logger.Debug("injected fault",
+				slog.String("group", group),
+				slog.String("method", r.Method),
+				slog.String("path", r.URL.Path),
+				slog.Int("status", f.status),
+			)
+			w.Header().Set(FaultInjectedHeader, "true")
+			if f.status == http.StatusServiceUnavailable {
+				serviceUnavailable(w, ShedRetryAfter, "injected fault")
+				return
+			}
+			http.Error(w, "injected fault", f.status)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
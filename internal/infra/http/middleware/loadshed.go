@@ -0,0 +1,214 @@
+package middleware
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ShedRetryAfter is the Retry-After sent when a route group is saturated.
+const ShedRetryAfter = time.Second
+
+// OtherRouteGroup is the group shared by API requests that match no route
+// group, such as paths no route serves, so made-up paths cannot add groups
+// or metric series.
+const OtherRouteGroup = "other"
+
+// LoadShedder caps the number of concurrent API requests per route group,
+// so a spike on one group (say, leaderboard reads on finals night) cannot
+// starve the others. Requests over a group's limit are rejected with 503
+// instead of queueing behind a slow database.
+type LoadShedder struct {
+	defaultLimit int
+	limits       map[string]int
+	logger       *slog.Logger
+	routes       *http.ServeMux
+
+	mu     sync.Mutex
+	groups map[string]*routeGroup
+}
+
+type routeGroup struct {
+	slots    chan struct{}
+	inFlight atomic.Int64
+	served   atomic.Int64
+	shed     atomic.Int64
+}
+
+// NewLoadShedder creates a load shedder. Groups without an entry in limits
+// use defaultLimit; a limit of 0 leaves the group unlimited.
+func NewLoadShedder(defaultLimit int, limits map[string]int, logger *slog.Logger) *LoadShedder {
+	return &LoadShedder{
+		defaultLimit: defaultLimit,
+		limits:       limits,
+		logger:       logger,
+		groups:       make(map[string]*routeGroup),
+	}
+}
+
+// Routes sets the mux whose routes define the route groups: a request takes
+// the group of the route it matches. Without routes, only groups with a
+// configured limit are kept apart.
+func (s *LoadShedder) Routes(mux *http.ServeMux) {
+	s.routes = mux
+}
+
+// RouteGroup returns the group an API path belongs to: the first segment
+// after the API version, e.g. "leaderboard" for /api/v1/leaderboard/{id}.
+// Paths outside the API have no group.
+func RouteGroup(path string) string {
+	rest, ok := strings.CutPrefix(path, "/api/")
+	if !ok {
+		return ""
+	}
+	rest = strings.TrimPrefix(rest, "v1/")
+	group, _, _ := strings.Cut(rest, "/")
+	return group
+}
+
+// Middleware rejects API requests once their route group is at its limit.
+func (s *LoadShedder) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := RouteGroup(r.URL.Path)
+		if name == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		name = s.groupOf(r, name)
+		g := s.group(name)
+		if g.slots != nil {
+			select {
+			case g.slots <- struct{}{}:
+				defer func() { <-g.slots }()
+			default:
+				g.shed.Add(1)
+				s.logger.Warn("shedding request", "group", name, "path", r.URL.Path, "limit", cap(g.slots))
+				serviceUnavailable(w, ShedRetryAfter, "server is busy, please retry")
+				return
+			}
+		}
+
+		g.inFlight.Add(1)
+		defer g.inFlight.Add(-1)
+		g.served.Add(1)
+		next.ServeHTTP(w, r)
+	})
+}
+
+// groupOf returns the group an API request is counted under: the group of
+// its path when it has a configured limit or the request matches a route in
+// it, otherwise OtherRouteGroup.
+func (s *LoadShedder) groupOf(r *http.Request, name string) string {
+	if _, set := s.limits[name]; set {
+		return name
+	}
+	if s.routes != nil {
+		if _, pattern := s.routes.Handler(r); patternGroup(pattern) == name {
+			return name
+		}
+	}
+	return OtherRouteGroup
+}
+
+// patternGroup returns the route group of a mux pattern such as
+// "GET /api/v1/leaderboard/{id}".
+func patternGroup(pattern string) string {
+	if _, path, ok := strings.Cut(pattern, " "); ok {
+		pattern = path
+	}
+	if i := strings.Index(pattern, "/"); i > 0 {
+		pattern = pattern[i:] // Drop the host
+	}
+	return RouteGroup(pattern)
+}
+
+// group returns the named route group, creating it on first use.
+func (s *LoadShedder) group(name string) *routeGroup {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	g, ok := s.groups[name]
+	if !ok {
+		limit, set := s.limits[name]
+		if !set {
+			limit = s.defaultLimit
+		}
+		g = &routeGroup{}
+		if limit > 0 {
+			g.slots = make(chan struct{}, limit)
+		}
+		s.groups[name] = g
+	}
+	return g
+}
+
+// WriteMetrics writes per-group request counters in the Prometheus text format.
+func (s *LoadShedder) WriteMetrics(w io.Writer) {
+	s.mu.Lock()
+	groups := make(map[string]*routeGroup, len(s.groups))
+	names := make([]string, 0, len(s.groups))
+	for name, g := range s.groups {
+		groups[name] = g
+		names = append(names, name)
+	}
+	s.mu.Unlock()
+	sort.Strings(names)
+
+	metrics := []struct {
+		name, help, kind string
+		value            func(*routeGroup) int64
+	}{
+		{"tourneyrank_http_in_flight_requests", "API requests currently being served, by route group.", "gauge", func(g *routeGroup) int64 { return g.inFlight.Load() }},
+		{"tourneyrank_http_requests_served_total", "API requests admitted, by route group.", "counter", func(g *routeGroup) int64 { return g.served.Load() }},
+		{"tourneyrank_http_requests_shed_total", "API requests rejected because their route group was saturated.", "counter", func(g *routeGroup) int64 { return g.shed.Load() }},
+	}
+	for _, m := range metrics {
+		fmt.Fprintf(w, "# HELP %s %s\n", m.name, m.help)
+		fmt.Fprintf(w, "# TYPE %s %s\n", m.name, m.kind)
+		for _, name := range names {
+			fmt.Fprintf(w, "%s{group=%q} %d\n", m.name, name, m.value(groups[name]))
+		}
+	}
+}
+
+// CircuitGate reports whether a downstream dependency is accepting work and,
+// when it is not, how long callers should wait before retrying.
+type CircuitGate interface {
+	Allow() (time.Duration, bool)
+}
+
+// CircuitBreaker fails API requests fast with 503 while gate is open, rather
+// than letting them pile up on a dependency that is timing out.
+func CircuitBreaker(gate CircuitGate, logger *slog.Logger) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if RouteGroup(r.URL.Path) != "" {
+				if wait, ok := gate.Allow(); !ok {
+					logger.Debug("circuit open, rejecting request", "path", r.URL.Path, "retry_after", wait)
+					serviceUnavailable(w, wait, "service temporarily unavailable, please retry")
+					return
+				}
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// serviceUnavailable writes a 503 with Retry-After rounded up to whole seconds.
+func serviceUnavailable(w http.ResponseWriter, retryAfter time.Duration, msg string) {
+	seconds := int(math.Ceil(retryAfter.Seconds()))
+	if seconds < 1 {
+		seconds = 1
+	}
+	w.Header().Set("Retry-After", strconv.Itoa(seconds))
+	http.Error(w, msg, http.StatusServiceUnavailable)
+}
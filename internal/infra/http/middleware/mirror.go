@@ -0,0 +1,38 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"sync/atomic"
+)
+
+// MirrorLocator maps an API path to its published read-only mirror.
+type MirrorLocator interface {
+	MirrorURL(path string) (string, bool)
+}
+
+// MirrorRedirect sends read traffic to the static mirror while more than
+// maxInFlight requests are being served. Only plain GET and HEAD requests
+// for paths with a fresh mirror are redirected; anything with a query string
+// is served normally since the mirror only holds default pages.
+func MirrorRedirect(locator MirrorLocator, maxInFlight int64, logger *slog.Logger) func(http.Handler) http.Handler {
+	var inFlight atomic.Int64
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+
+			if current > maxInFlight && (r.Method == http.MethodGet || r.Method == http.MethodHead) && r.URL.RawQuery == "" {
+				if target, ok := locator.MirrorURL(r.URL.Path); ok {
+					logger.Debug("redirecting to mirror", "path", r.URL.Path, "in_flight", current)
+					w.Header().Set("Cache-Control", "no-store")
+					http.Redirect(w, r, target, http.StatusTemporaryRedirect)
+					return
+				}
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
@@ -0,0 +1,17 @@
+package middleware
+
+import "net/http"
+
+// ServedRegionHeader names the region of the instance that served a request.
+const ServedRegionHeader = "X-Served-Region"
+
+// ServedRegion sets the ServedRegionHeader on every response, so clients and
+// edge routers can tell which regional deployment answered.
+func ServedRegion(region string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(ServedRegionHeader, region)
+			next.ServeHTTP(w, r)
+		})
+	}
+}
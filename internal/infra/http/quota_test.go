@@ -0,0 +1,70 @@
+package http
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/alejaam/tourney-rank/internal/domain/developer"
+	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
+	developerusecase "github.com/alejaam/tourney-rank/internal/usecase/developer"
+	"github.com/google/uuid"
+)
+
+// TestAnonymousQuota sends API requests without a key until one address
+// spends its anonymous quota, and checks only that address's keyless API
+// requests are refused.
+func TestAnonymousQuota(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	service := developerusecase.NewService(&memDeveloperKeys{}, &memDeveloperUsage{}, developer.Quota{PerDay: 100}).
+		WithAnonymousQuota(developer.Quota{PerDay: 2})
+	router := NewRouter(logger, WithAPIKeyQuota(service))
+
+	key, err := service.CreateKey(context.Background(), uuid.New(), developerusecase.CreateKeyRequest{Name: "Bot"})
+	if err != nil {
+		t.Fatalf("create key: %v", err)
+	}
+
+	send := func(path, address, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.RemoteAddr = address + ":1234"
+		if key != "" {
+			req.Header.Set(middleware.APIKeyHeader, key)
+		}
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+		return rec
+	}
+
+	for i, want := range []int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests} {
+		rec := send("/api/v1/ping", "192.0.2.1", "")
+		if rec.Code != want {
+			t.Fatalf("keyless request %d: got %d, want %d", i+1, rec.Code, want)
+		}
+		if got := rec.Header().Get("X-RateLimit-Limit"); got != "2" {
+			t.Errorf("keyless request %d: X-RateLimit-Limit %q, want 2", i+1, got)
+		}
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		address string
+		key     string
+		want    int
+	}{
+		{name: "other address", path: "/api/v1/ping", address: "192.0.2.2", want: http.StatusOK},
+		{name: "with a key", path: "/api/v1/ping", address: "192.0.2.1", key: key.Key, want: http.StatusOK},
+		{name: "outside the API", path: "/healthz", address: "192.0.2.1", want: http.StatusOK},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if rec := send(tc.path, tc.address, tc.key); rec.Code != tc.want {
+				t.Errorf("got %d, want %d", rec.Code, tc.want)
+			}
+		})
+	}
+}
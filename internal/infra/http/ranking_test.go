@@ -0,0 +1,293 @@
+package http
+
+// Ranking tests drive match verification through the router built by
+// newContractEnv and read the results back from the public leaderboard, so
+// they cover the whole path from an admin's review to a player's position.
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/ranking"
+)
+
+// TestVerificationUpdatesLeaderboard verifies a match in which Bob outplays
+// Alice and checks that he passes her on the leaderboard with the score and
+// tier the ranking service gives his new totals, then voids the match and
+// checks that the positions are restored.
+func TestVerificationUpdatesLeaderboard(t *testing.T) {
+	env := newContractEnv(t)
+	alice, bob := env.ids["alice"], env.ids["bob"]
+
+	// Alice has the better record going in
+	setTotals(t, env, alice, 10, 80, 20, 25000)
+	setTotals(t, env, bob, 10, 60, 20, 20000)
+	before := leaderboard(t, env)
+	if before[alice].Rank >= before[bob].Rank {
+		t.Fatalf("before verification: Alice ranked %d, Bob %d; want Alice ahead", before[alice].Rank, before[bob].Rank)
+	}
+
+	id := seedMatch(t, env, []match.PlayerMatchStats{
+		{PlayerID: uuid.MustParse(alice), Kills: 0, Damage: 200, Deaths: 1},
+		{PlayerID: uuid.MustParse(bob), Kills: 30, Damage: 6000, Deaths: 0},
+	})
+	review(t, env, http.MethodPatch, "/api/v1/admin/matches/"+id+"/verify", `{"approved":true}`)
+
+	after := leaderboard(t, env)
+	if after[bob].Rank >= after[alice].Rank {
+		t.Fatalf("after verification: Alice ranked %d, Bob %d; want Bob ahead", after[alice].Rank, after[bob].Rank)
+	}
+	for _, name := range []string{"alice", "bob"} {
+		entry := after[env.ids[name]]
+		wantScore, wantTier := expectedRanking(t, env, env.ids[name])
+		if entry.RankingScore != wantScore || entry.Tier != wantTier {
+			t.Errorf("%s after verification: score %v, tier %s; want %v, %s", name, entry.RankingScore, entry.Tier, wantScore, wantTier)
+		}
+		if entry.RankingScore == before[env.ids[name]].RankingScore {
+			t.Errorf("%s after verification: score unchanged at %v", name, entry.RankingScore)
+		}
+		if entry.MatchesPlayed != 11 {
+			t.Errorf("%s after verification: %d matches played, want 11", name, entry.MatchesPlayed)
+		}
+	}
+
+	review(t, env, http.MethodPatch, "/api/v1/admin/matches/"+id+"/void", `{"reason":"played on a smurf account"}`)
+
+	voided := leaderboard(t, env)
+	if voided[alice].Rank >= voided[bob].Rank {
+		t.Fatalf("after void: Alice ranked %d, Bob %d; want Alice ahead again", voided[alice].Rank, voided[bob].Rank)
+	}
+	for _, name := range []string{"alice", "bob"} {
+		entry := voided[env.ids[name]]
+		wantScore, _ := expectedRanking(t, env, env.ids[name])
+		if entry.RankingScore != wantScore {
+			t.Errorf("%s after void: score %v, want %v", name, entry.RankingScore, wantScore)
+		}
+	}
+}
+
+// TestAmendmentUpdatesLeaderboard corrects a verified match that credited
+// Bob's kills to Alice and checks that the amendment swaps their positions.
+func TestAmendmentUpdatesLeaderboard(t *testing.T) {
+	env := newContractEnv(t)
+	alice, bob := env.ids["alice"], env.ids["bob"]
+
+	setTotals(t, env, alice, 10, 60, 20, 20000)
+	setTotals(t, env, bob, 10, 60, 20, 20000)
+	id := seedMatch(t, env, []match.PlayerMatchStats{
+		{PlayerID: uuid.MustParse(alice), Kills: 25, Damage: 5000, Deaths: 1},
+		{PlayerID: uuid.MustParse(bob), Kills: 0, Damage: 300, Deaths: 1},
+	})
+	review(t, env, http.MethodPatch, "/api/v1/admin/matches/"+id+"/verify", `{"approved":true}`)
+
+	verified := leaderboard(t, env)
+	if verified[alice].Rank >= verified[bob].Rank {
+		t.Fatalf("after verification: Alice ranked %d, Bob %d; want Alice ahead", verified[alice].Rank, verified[bob].Rank)
+	}
+
+	review(t, env, http.MethodPost, "/api/v1/admin/matches/"+id+"/amendments", `{
+		"reason": "kills credited to the wrong player",
+		"team_placement": 1,
+		"team_kills": 25,
+		"player_stats": [
+			{"player_id": "`+alice+`", "kills": 0, "damage": 300, "deaths": 1},
+			{"player_id": "`+bob+`", "kills": 25, "damage": 5000, "deaths": 1}
+		]
+	}`)
+
+	amended := leaderboard(t, env)
+	if amended[bob].Rank >= amended[alice].Rank {
+		t.Fatalf("after amendment: Alice ranked %d, Bob %d; want Bob ahead", amended[alice].Rank, amended[bob].Rank)
+	}
+	for _, name := range []string{"alice", "bob"} {
+		entry := amended[env.ids[name]]
+		wantScore, wantTier := expectedRanking(t, env, env.ids[name])
+		if entry.RankingScore != wantScore || entry.Tier != wantTier {
+			t.Errorf("%s after amendment: score %v, tier %s; want %v, %s", name, entry.RankingScore, entry.Tier, wantScore, wantTier)
+		}
+	}
+}
+
+// setTotals replaces a player's seeded all-time stats with consistent totals.
+func setTotals(t *testing.T, env *contractEnv, playerID string, matches, kills, deaths, damage int) {
+	t.Helper()
+	ps, err := env.stats.GetByPlayerAndGame(context.Background(), uuid.MustParse(playerID), uuid.MustParse(env.ids["game"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps.Stats = map[string]interface{}{
+		"total_kills":  float64(kills),
+		"total_deaths": float64(deaths),
+		"total_damage": float64(damage),
+	}
+	ps.MatchesPlayed = matches
+}
+
+// seedMatch stores a draft match for Alice's team and returns its ID.
+func seedMatch(t *testing.T, env *contractEnv, lines []match.PlayerMatchStats) string {
+	t.Helper()
+	m, err := match.NewMatch(
+		uuid.MustParse(env.ids["tournament"]),
+		uuid.MustParse(env.ids["team"]),
+		uuid.MustParse(env.ids["game"]),
+		1, 30, lines, "https://example.com/shot.png", uuid.MustParse(env.ids["alice"]),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := env.matches.Create(context.Background(), m); err != nil {
+		t.Fatal(err)
+	}
+	return m.ID.String()
+}
+
+// review sends an admin request and fails the test unless it succeeds.
+func review(t *testing.T, env *contractEnv, method, path, body string) {
+	t.Helper()
+	status, _, resp := env.do(t, contractRequest{Method: method, Path: path, As: "admin", Body: json.RawMessage(body)})
+	if status != http.StatusOK {
+		t.Fatalf("%s %s: status %d: %s", method, path, status, resp)
+	}
+}
+
+// leaderboard returns the game's public leaderboard keyed by player ID.
+func leaderboard(t *testing.T, env *contractEnv) map[string]player.LeaderboardEntry {
+	t.Helper()
+	status, _, body := env.do(t, contractRequest{Method: http.MethodGet, Path: "/api/v1/leaderboard/{{game}}"})
+	if status != http.StatusOK {
+		t.Fatalf("leaderboard: status %d: %s", status, body)
+	}
+
+	var page struct {
+		Items []player.LeaderboardEntry `json:"items"`
+	}
+	if err := json.Unmarshal(body, &page); err != nil {
+		t.Fatal(err)
+	}
+	entries := make(map[string]player.LeaderboardEntry, len(page.Items))
+	for _, e := range page.Items {
+		entries[e.PlayerID.String()] = e
+	}
+	return entries
+}
+
+// expectedRanking scores a player's stored stats with a ranking service set
+// up like the router's.
+func expectedRanking(t *testing.T, env *contractEnv, playerID string) (float64, player.Tier) {
+	t.Helper()
+	ctx := context.Background()
+	ps, err := env.stats.GetByPlayerAndGame(ctx, uuid.MustParse(playerID), uuid.MustParse(env.ids["game"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	g, err := env.games.GetByID(ctx, env.ids["game"])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	score, tier, err := ranking.NewService(ranking.NewWarzoneCalculator(), ranking.NewDefaultCalculator()).CalculateRanking(ctx, ps, g)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return score, tier
+}
+
+// TestApprovalCountsOnce approves a match twice, once after the first approval
+// and once as if another admin approved it between the read and the review,
+// and checks that neither second approval changes the player's stats.
+func TestApprovalCountsOnce(t *testing.T) {
+	env := newContractEnv(t)
+	alice := env.ids["alice"]
+
+	setTotals(t, env, alice, 2, 10, 2, 1000)
+	ps, err := env.stats.GetByPlayerAndGame(context.Background(), uuid.MustParse(alice), uuid.MustParse(env.ids["game"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	approve := func(id string) int {
+		t.Helper()
+		status, _, _ := env.do(t, contractRequest{Method: http.MethodPatch, Path: "/api/v1/admin/matches/" + id + "/verify", As: "admin", Body: json.RawMessage(`{"approved":true}`)})
+		return status
+	}
+	line := []match.PlayerMatchStats{{PlayerID: uuid.MustParse(alice), Kills: 5, Damage: 800, Deaths: 1}}
+
+	id := seedMatch(t, env, line)
+	if status := approve(id); status != http.StatusOK {
+		t.Fatalf("first approval: status %d", status)
+	}
+	if status := approve(id); status == http.StatusOK {
+		t.Fatal("second approval succeeded")
+	}
+	expectStats(t, ps.Stats, map[string]float64{"total_kills": 15, "total_damage": 1800})
+	if ps.MatchesPlayed != 3 {
+		t.Errorf("matches played: got %d, want 3", ps.MatchesPlayed)
+	}
+
+	raced := seedMatch(t, env, line)
+	env.matches.afterGet = func(stored *match.Match) {
+		if stored.ID.String() == raced {
+			stored.Status = match.StatusVerified
+		}
+	}
+	if status := approve(raced); status == http.StatusOK {
+		t.Fatal("approval of a match reviewed meanwhile succeeded")
+	}
+	expectStats(t, ps.Stats, map[string]float64{"total_kills": 15, "total_damage": 1800})
+	if ps.MatchesPlayed != 3 {
+		t.Errorf("matches played: got %d, want 3", ps.MatchesPlayed)
+	}
+}
+
+// TestMatchHistoryPages pages through Alice's verified matches with a newer
+// draft in front of them and checks every page is full and reports the whole
+// history's length.
+func TestMatchHistoryPages(t *testing.T) {
+	env := newContractEnv(t)
+	alice := env.ids["alice"]
+
+	history := func(query string) (int, int64) {
+		t.Helper()
+		status, _, body := env.do(t, contractRequest{Method: http.MethodGet, Path: "/api/v1/players/me/matches" + query, As: "alice"})
+		if status != http.StatusOK {
+			t.Fatalf("history: status %d: %s", status, body)
+		}
+		var page struct {
+			Items []json.RawMessage `json:"items"`
+			Total int64             `json:"total"`
+		}
+		if err := json.Unmarshal(body, &page); err != nil {
+			t.Fatal(err)
+		}
+		return len(page.Items), page.Total
+	}
+
+	line := []match.PlayerMatchStats{{PlayerID: uuid.MustParse(alice), Kills: 3}}
+	for range 2 {
+		review(t, env, http.MethodPatch, "/api/v1/admin/matches/"+seedMatch(t, env, line)+"/verify", `{"approved":true}`)
+	}
+	seedMatch(t, env, line)
+
+	all, total := history("?limit=100")
+	if all < 2 || total != int64(all) {
+		t.Fatalf("whole history: %d items, total %d", all, total)
+	}
+	for offset := 0; offset < all; offset++ {
+		items, total := history(fmt.Sprintf("?limit=1&offset=%d", offset))
+		if items != 1 || total != int64(all) {
+			t.Errorf("page at %d: %d items, total %d; want 1 of %d", offset, items, total, all)
+		}
+	}
+
+	withDrafts, total := history("?limit=100&include_unverified=true")
+	if withDrafts <= all || total != int64(withDrafts) {
+		t.Errorf("history with drafts: %d items, total %d; want more than %d", withDrafts, total, all)
+	}
+}
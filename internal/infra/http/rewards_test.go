@@ -0,0 +1,58 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/ranking"
+)
+
+// TestSeasonRewardsFromSeasonEnd closes a season with Alice in the advanced
+// tier, raises her score into elite before the rewards go out and checks she
+// is rewarded for the tier she finished the season in.
+func TestSeasonRewardsFromSeasonEnd(t *testing.T) {
+	env := newContractEnv(t)
+	alice := env.ids["alice"]
+
+	ps, err := env.stats.GetByPlayerAndGame(context.Background(), uuid.MustParse(alice), uuid.MustParse(env.ids["game"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps.RankingScore = 1400
+
+	boundaries := func(body string) {
+		t.Helper()
+		status, _, resp := env.do(t, contractRequest{Method: http.MethodPost, Path: "/api/v1/admin/games/{{game}}/tier-boundaries", As: "admin", Body: json.RawMessage(body)})
+		if status != http.StatusCreated {
+			t.Fatalf("set boundaries: status %d: %s", status, resp)
+		}
+	}
+	boundaries(`{"season":"2026-S3","intermediate":1000,"advanced":1300,"elite":1600,"effective_from":"2026-07-01T00:00:00Z"}`)
+	boundaries(`{"season":"2026-S4","intermediate":1000,"advanced":1300,"elite":1600}`)
+
+	ps.RankingScore = 1700
+
+	status, _, body := env.do(t, contractRequest{Method: http.MethodPost, Path: "/api/v1/admin/games/{{game}}/season-rewards/distribute", As: "admin"})
+	if status != http.StatusOK {
+		t.Fatalf("distribute: status %d: %s", status, body)
+	}
+
+	status, _, body = env.do(t, contractRequest{Method: http.MethodGet, Path: "/api/v1/leaderboard/{{game}}/player/{{alice}}/rewards"})
+	if status != http.StatusOK {
+		t.Fatalf("rewards: status %d: %s", status, body)
+	}
+	var page struct {
+		Items []ranking.RewardDistribution `json:"items"`
+	}
+	if err := json.Unmarshal(body, &page); err != nil {
+		t.Fatal(err)
+	}
+	if len(page.Items) != 1 || page.Items[0].Season != "2026-S3" || page.Items[0].Tier != player.TierAdvanced {
+		t.Errorf("rewards: got %+v, want one advanced reward for 2026-S3", page.Items)
+	}
+}
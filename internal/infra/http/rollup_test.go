@@ -0,0 +1,118 @@
+package http
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+)
+
+// TestGameRollups configures a game whose kills are counted from a custom
+// stat and which keeps a best and an average stat, then verifies and voids a
+// match and checks how each rollup follows.
+func TestGameRollups(t *testing.T) {
+	env := newContractEnv(t)
+	ctx := context.Background()
+	alice := env.ids["alice"]
+
+	g, err := env.games.GetByID(ctx, env.ids["game"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	g.StatSchema["total_kills"] = game.StatField{Type: "integer", Rollup: &game.Rollup{Source: "eliminations", Aggregate: game.AggregateSum}}
+	g.StatSchema["best_kills"] = game.StatField{Type: "integer", Rollup: &game.Rollup{Source: "kills", Aggregate: game.AggregateMax}}
+	g.StatSchema["avg_damage"] = game.StatField{Type: "float", Rollup: &game.Rollup{Source: "damage", Aggregate: game.AggregateAvg}}
+	if err := g.StatSchema.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	setTotals(t, env, alice, 2, 10, 2, 1000)
+	ps, err := env.stats.GetByPlayerAndGame(ctx, uuid.MustParse(alice), g.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ps.Stats["best_kills"] = 6.0
+	ps.Stats["avg_damage"] = 500.0
+
+	id := seedMatch(t, env, []match.PlayerMatchStats{
+		{PlayerID: uuid.MustParse(alice), Kills: 9, Damage: 2000, Deaths: 1, CustomStats: map[string]interface{}{"eliminations": 12}},
+	})
+
+	review(t, env, http.MethodPatch, "/api/v1/admin/matches/"+id+"/verify", `{"approved":true}`)
+	expectStats(t, ps.Stats, map[string]float64{
+		"total_kills":  22,
+		"total_damage": 3000,
+		"best_kills":   9,
+		"avg_damage":   1000,
+		"eliminations": 12,
+	})
+
+	review(t, env, http.MethodPatch, "/api/v1/admin/matches/"+id+"/void", `{"reason":"lobby was a private match"}`)
+	expectStats(t, ps.Stats, map[string]float64{
+		"total_kills":  10,
+		"total_damage": 1000,
+		"best_kills":   9, // A best is not taken back
+		"avg_damage":   500,
+		"eliminations": 0,
+	})
+}
+
+// expectStats compares stored stats with the values expected for them.
+func expectStats(t *testing.T, stats map[string]interface{}, want map[string]float64) {
+	t.Helper()
+	for key, value := range want {
+		if got := toFloat(stats[key]); math.Abs(got-value) > 1e-9 {
+			t.Errorf("%s: got %v, want %v", key, got, value)
+		}
+	}
+}
+
+// TestAveragesFollowMatches checks the averages and win rate every game keeps
+// through a verification, an amendment that drops the team from first place
+// and a void. The player's totals predate the averages, which start from
+// them; the win rate has no total to start from and covers only the match.
+func TestAveragesFollowMatches(t *testing.T) {
+	env := newContractEnv(t)
+	alice := env.ids["alice"]
+
+	setTotals(t, env, alice, 2, 10, 2, 1000)
+	ps, err := env.stats.GetByPlayerAndGame(context.Background(), uuid.MustParse(alice), uuid.MustParse(env.ids["game"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	id := seedMatch(t, env, []match.PlayerMatchStats{
+		{PlayerID: uuid.MustParse(alice), Kills: 11, Damage: 2000, Deaths: 1},
+	})
+
+	review(t, env, http.MethodPatch, "/api/v1/admin/matches/"+id+"/verify", `{"approved":true}`)
+	expectStats(t, ps.Stats, map[string]float64{
+		"avg_kills":  7,
+		"avg_damage": 1000,
+		"win_rate":   1,
+	})
+
+	review(t, env, http.MethodPost, "/api/v1/admin/matches/"+id+"/amendments", `{
+		"reason": "placement was misread from the scoreboard",
+		"team_placement": 4,
+		"team_kills": 30,
+		"player_stats": [{"player_id": "`+alice+`", "kills": 8, "damage": 2000, "deaths": 1}]
+	}`)
+	expectStats(t, ps.Stats, map[string]float64{
+		"avg_kills":  6,
+		"avg_damage": 1000,
+		"win_rate":   0,
+	})
+
+	review(t, env, http.MethodPatch, "/api/v1/admin/matches/"+id+"/void", `{"reason":"lobby was a private match"}`)
+	expectStats(t, ps.Stats, map[string]float64{
+		"avg_kills":  5,
+		"avg_damage": 500,
+		"win_rate":   0,
+	})
+}
@@ -3,12 +3,17 @@ package http
 import (
 	"context"
 	"encoding/json"
+	"fmt"
+	"io"
 	"log/slog"
 	"net/http"
 	"runtime"
 	"time"
 
+	"github.com/alejaam/tourney-rank/internal/domain/capture"
+	"github.com/alejaam/tourney-rank/internal/domain/note"
 	"github.com/alejaam/tourney-rank/internal/infra/http/handlers"
+	"github.com/alejaam/tourney-rank/internal/infra/http/loader"
 	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
 )
 
@@ -22,10 +27,11 @@ type HealthStatus struct {
 
 // ReadyStatus represents the readiness check response.
 type ReadyStatus struct {
-	Status   string            `json:"status"`
-	Checks   map[string]string `json:"checks,omitempty"`
-	Database string            `json:"database,omitempty"`
-	Redis    string            `json:"redis,omitempty"`
+	Status   string                   `json:"status"`
+	Checks   map[string]string        `json:"checks,omitempty"`
+	Database string                   `json:"database,omitempty"`
+	Redis    string                   `json:"redis,omitempty"`
+	Latency  map[string]LatencyReport `json:"latency,omitempty"` // Check latency of dependencies with a latency budget
 }
 
 // SystemInfo represents system information for debug endpoints.
@@ -48,19 +54,68 @@ type Router struct {
 	// Dependencies for readiness checks (optional)
 	mongoChecker func() error
 	redisChecker func() error
+	latency      map[string]*latencyTracker // By dependency, for those with a latency budget
 
 	// API handlers
-	gameHandler        *handlers.GameHandler
-	leaderboardHandler *handlers.LeaderboardHandler
-	authHandler        *handlers.AuthHandler
-	adminHandler       *handlers.AdminHandler
-	playerHandler      *handlers.PlayerHandler
-	tournamentHandler  *handlers.TournamentHandler
-	teamHandler        *handlers.TeamHandler
-	matchHandler       *handlers.MatchHandler
+	gameHandler         *handlers.GameHandler
+	leaderboardHandler  *handlers.LeaderboardHandler
+	authHandler         *handlers.AuthHandler
+	adminHandler        *handlers.AdminHandler
+	playerHandler       *handlers.PlayerHandler
+	tournamentHandler   *handlers.TournamentHandler
+	teamHandler         *handlers.TeamHandler
+	matchHandler        *handlers.MatchHandler
+	matchmakingHandler  *handlers.MatchmakingHandler
+	tierHandler         *handlers.TierHandler
+	statsHandler        *handlers.StatsHandler
+	activityHandler     *handlers.ActivityHandler
+	ladderHandler       *handlers.LadderHandler
+	seasonHandler       *handlers.SeasonHandler
+	captureHandler      *handlers.CaptureHandler
+	integrityHandler    *handlers.IntegrityHandler
+	organizationHandler *handlers.OrganizationHandler
+	developerHandler    *handlers.DeveloperHandler
 
 	// JWT secret for auth middleware
 	jwtSecret string
+
+	// Blocks writes from users with outdated policy consent (optional)
+	consentChecker middleware.ConsentChecker
+	accountChecker middleware.AccountChecker
+
+	// Sources for the request-scoped data loader (optional)
+	loaderGames   loader.GameSource
+	loaderPlayers loader.PlayerSource
+
+	// Redirects reads to the static mirror under load (optional)
+	mirror            middleware.MirrorLocator
+	mirrorMaxInFlight int64
+
+	// Enforces the quota of requests carrying an API key (optional)
+	keyAuthorizer middleware.KeyAuthorizer
+
+	// Rejects API requests with 503 under saturation (optional)
+	shedder *middleware.LoadShedder
+	breaker middleware.CircuitGate
+
+	// Delays and fails API requests outside production (optional)
+	faults *middleware.FaultInjector
+
+	// Records match submission and verification exchanges (optional)
+	capture *middleware.RequestCapture
+
+	// Reported in the X-Served-Region header (optional)
+	region string
+
+	// Gzips response bodies of at least this many bytes (optional)
+	compressMinSize int
+	compress        bool
+
+	// Serves /metrics in the Prometheus text format
+	metricsEnabled bool
+	metrics        []MetricsWriter
+
+	handler http.Handler
 }
 
 // RouterOption configures the router.
@@ -91,6 +146,18 @@ func WithRedisChecker(checker func() error) RouterOption {
 	}
 }
 
+// WithLatencyBudget fails readiness once the p99 latency of a dependency's
+// checks, "mongodb" or "redis", stays over budget.P99 for budget.Window.
+// Latencies are sampled each time readiness is probed.
+func WithLatencyBudget(dependency string, budget LatencyBudget) RouterOption {
+	return func(r *Router) {
+		if r.latency == nil {
+			r.latency = make(map[string]*latencyTracker)
+		}
+		r.latency[dependency] = newLatencyTracker(budget)
+	}
+}
+
 // WithGameHandler sets the game handler.
 func WithGameHandler(h *handlers.GameHandler) RouterOption {
 	return func(r *Router) {
@@ -126,6 +193,22 @@ func WithJWTSecret(secret string) RouterOption {
 	}
 }
 
+// WithActiveAccountGate rejects requests authenticated with a token of a
+// deactivated account.
+func WithActiveAccountGate(checker middleware.AccountChecker) RouterOption {
+	return func(r *Router) {
+		r.accountChecker = checker
+	}
+}
+
+// WithConsentGate rejects write requests from users who have not accepted
+// the current policy versions.
+func WithConsentGate(checker middleware.ConsentChecker) RouterOption {
+	return func(r *Router) {
+		r.consentChecker = checker
+	}
+}
+
 // WithPlayerHandler sets the player handler.
 func WithPlayerHandler(h *handlers.PlayerHandler) RouterOption {
 	return func(r *Router) {
@@ -154,6 +237,159 @@ func WithMatchHandler(h *handlers.MatchHandler) RouterOption {
 	}
 }
 
+// WithMatchmakingHandler sets the matchmaking handler.
+func WithMatchmakingHandler(h *handlers.MatchmakingHandler) RouterOption {
+	return func(r *Router) {
+		r.matchmakingHandler = h
+	}
+}
+
+// WithLadderHandler sets the ladder handler.
+func WithLadderHandler(h *handlers.LadderHandler) RouterOption {
+	return func(r *Router) {
+		r.ladderHandler = h
+	}
+}
+
+// WithSeasonHandler sets the season handler.
+func WithSeasonHandler(h *handlers.SeasonHandler) RouterOption {
+	return func(r *Router) {
+		r.seasonHandler = h
+	}
+}
+
+// WithCaptureHandler sets the request capture handler.
+func WithCaptureHandler(h *handlers.CaptureHandler) RouterOption {
+	return func(r *Router) {
+		r.captureHandler = h
+	}
+}
+
+// WithTierHandler sets the tier handler.
+func WithTierHandler(h *handlers.TierHandler) RouterOption {
+	return func(r *Router) {
+		r.tierHandler = h
+	}
+}
+
+// WithStatsHandler sets the platform stats handler.
+func WithStatsHandler(h *handlers.StatsHandler) RouterOption {
+	return func(r *Router) {
+		r.statsHandler = h
+	}
+}
+
+// WithIntegrityHandler sets the data integrity report handler.
+func WithIntegrityHandler(h *handlers.IntegrityHandler) RouterOption {
+	return func(r *Router) {
+		r.integrityHandler = h
+	}
+}
+
+// WithOrganizationHandler sets the organization and series handler.
+func WithOrganizationHandler(h *handlers.OrganizationHandler) RouterOption {
+	return func(r *Router) {
+		r.organizationHandler = h
+	}
+}
+
+// WithDeveloperHandler sets the public API key and usage handler.
+func WithDeveloperHandler(h *handlers.DeveloperHandler) RouterOption {
+	return func(r *Router) {
+		r.developerHandler = h
+	}
+}
+
+// WithActivityHandler sets the player activity feed handler.
+func WithActivityHandler(h *handlers.ActivityHandler) RouterOption {
+	return func(r *Router) {
+		r.activityHandler = h
+	}
+}
+
+// WithDataLoader attaches a request-scoped game and player loader to every API request.
+func WithDataLoader(games loader.GameSource, players loader.PlayerSource) RouterOption {
+	return func(r *Router) {
+		r.loaderGames = games
+		r.loaderPlayers = players
+	}
+}
+
+// WithMirrorRedirect redirects reads that have a published mirror to it
+// while more than maxInFlight requests are in progress.
+func WithMirrorRedirect(locator middleware.MirrorLocator, maxInFlight int64) RouterOption {
+	return func(r *Router) {
+		r.mirror = locator
+		r.mirrorMaxInFlight = maxInFlight
+	}
+}
+
+// WithAPIKeyQuota enforces the quota of requests carrying an API key, and the
+// anonymous quota of API requests without one.
+func WithAPIKeyQuota(authorizer middleware.KeyAuthorizer) RouterOption {
+	return func(r *Router) {
+		r.keyAuthorizer = authorizer
+	}
+}
+
+// WithLoadShedding caps concurrent API requests per route group. The router's
+// routes define the groups.
+func WithLoadShedding(shedder *middleware.LoadShedder) RouterOption {
+	return func(r *Router) {
+		shedder.Routes(r.mux)
+		r.shedder = shedder
+	}
+}
+
+// WithFaultInjection delays and fails API requests by route group. It is
+// for testing clients and must not be used in production.
+func WithFaultInjection(faults *middleware.FaultInjector) RouterOption {
+	return func(r *Router) {
+		r.faults = faults
+	}
+}
+
+// WithRequestCapture records sanitized request/response pairs of the match
+// submission and verification endpoints.
+func WithRequestCapture(capture *middleware.RequestCapture) RouterOption {
+	return func(r *Router) {
+		r.capture = capture
+	}
+}
+
+// WithCircuitBreaker rejects API requests while gate is open.
+func WithCircuitBreaker(gate middleware.CircuitGate) RouterOption {
+	return func(r *Router) {
+		r.breaker = gate
+	}
+}
+
+// WithRegion reports the region serving each response in the
+// X-Served-Region header.
+func WithRegion(region string) RouterOption {
+	return func(r *Router) {
+		r.region = region
+	}
+}
+
+// WithCompression gzips response bodies of at least minSize bytes for
+// clients that accept gzip.
+func WithCompression(minSize int) RouterOption {
+	return func(r *Router) {
+		r.compress = true
+		r.compressMinSize = minSize
+	}
+}
+
+// WithMetrics exposes load shedding and circuit breaker metrics on /metrics,
+// along with those of any additional sources.
+func WithMetrics(sources ...MetricsWriter) RouterOption {
+	return func(r *Router) {
+		r.metricsEnabled = true
+		r.metrics = append(r.metrics, sources...)
+	}
+}
+
 // NewRouter creates a new HTTP router with all routes configured.
 func NewRouter(logger *slog.Logger, opts ...RouterOption) *Router {
 	r := &Router{
@@ -168,12 +404,35 @@ func NewRouter(logger *slog.Logger, opts ...RouterOption) *Router {
 	}
 
 	r.setupRoutes()
+
+	r.handler = r.mux
+	if r.faults != nil {
+		r.handler = r.faults.Middleware(r.handler)
+	}
+	if r.keyAuthorizer != nil {
+		r.handler = middleware.APIKeyQuota(r.keyAuthorizer, logger)(r.handler)
+	}
+	if r.shedder != nil {
+		r.handler = r.shedder.Middleware(r.handler)
+	}
+	if r.breaker != nil {
+		r.handler = middleware.CircuitBreaker(r.breaker, logger)(r.handler)
+	}
+	if r.mirror != nil {
+		r.handler = middleware.MirrorRedirect(r.mirror, r.mirrorMaxInFlight, logger)(r.handler)
+	}
+	if r.region != "" {
+		r.handler = middleware.ServedRegion(r.region)(r.handler)
+	}
+	if r.compress {
+		r.handler = middleware.Compress(r.compressMinSize)(r.handler)
+	}
 	return r
 }
 
 // ServeHTTP implements http.Handler.
 func (r *Router) ServeHTTP(w http.ResponseWriter, req *http.Request) {
-	r.mux.ServeHTTP(w, req)
+	r.handler.ServeHTTP(w, req)
 }
 
 // setupRoutes configures all HTTP routes.
@@ -183,6 +442,10 @@ func (r *Router) setupRoutes() {
 	r.mux.HandleFunc("GET /readyz", r.handleReady)
 	r.mux.HandleFunc("GET /livez", r.handleHealth) // Alias for Kubernetes
 
+	if r.metricsEnabled {
+		r.mux.HandleFunc("GET /metrics", r.handleMetrics)
+	}
+
 	// System info (development only in production)
 	r.mux.HandleFunc("GET /debug/info", r.handleSystemInfo)
 
@@ -194,12 +457,22 @@ func (r *Router) setupRoutes() {
 	if r.authHandler != nil {
 		r.mux.HandleFunc("POST /api/v1/auth/register", r.withMiddleware(r.authHandler.Register))
 		r.mux.HandleFunc("POST /api/v1/auth/login", r.withMiddleware(r.authHandler.Login))
+		r.mux.HandleFunc("POST /api/v1/auth/email/confirm", r.withMiddleware(r.authHandler.ConfirmEmailChange))
 
 		// User info endpoint (protected)
 		if r.jwtSecret != "" {
 			authMw := r.createAuthMiddleware()
-			r.mux.Handle("POST /api/v1/auth/logout", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.authHandler.Logout))))
+
+			// Reachable without current consent, so users can log out, accept updated policies or leave
+			authOnlyMw := r.createAuthOnlyMiddleware()
+			r.mux.Handle("POST /api/v1/auth/logout", r.withMiddlewareHandler(authOnlyMw(http.HandlerFunc(r.authHandler.Logout))))
+			r.mux.Handle("GET /api/v1/users/me/consent", r.withMiddlewareHandler(authOnlyMw(http.HandlerFunc(r.authHandler.GetConsent))))
+			r.mux.Handle("POST /api/v1/users/me/consent", r.withMiddlewareHandler(authOnlyMw(http.HandlerFunc(r.authHandler.AcceptPolicies))))
+			r.mux.Handle("POST /api/v1/users/me/deactivate", r.withMiddlewareHandler(authOnlyMw(http.HandlerFunc(r.authHandler.DeactivateAccount))))
+
 			r.mux.Handle("GET /api/v1/users/me", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.authHandler.GetMe))))
+			r.mux.Handle("POST /api/v1/users/me/email", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.authHandler.ChangeEmail))))
+			r.mux.Handle("POST /api/v1/users/me/password", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.authHandler.ChangePassword))))
 		}
 	}
 
@@ -218,6 +491,16 @@ func (r *Router) setupRoutes() {
 		r.mux.HandleFunc("GET /api/v1/leaderboard/{gameId}/tier/{tier}", r.withMiddleware(r.leaderboardHandler.GetLeaderboardByTier))
 		r.mux.HandleFunc("GET /api/v1/leaderboard/{gameId}/player/{playerId}", r.withMiddleware(r.leaderboardHandler.GetPlayerRank))
 		r.mux.HandleFunc("GET /api/v1/leaderboard/{gameId}/tiers", r.withMiddleware(r.leaderboardHandler.GetTierDistribution))
+
+		// Admin leaderboard sync management (require auth + admin)
+		if r.jwtSecret != "" {
+			mw := r.getMiddleware()
+			r.mux.Handle("GET /api/v1/admin/games/{id}/leaderboard-syncs", mw(http.HandlerFunc(r.leaderboardHandler.ListSyncs)))
+			r.mux.Handle("POST /api/v1/admin/games/{id}/leaderboard-syncs", mw(http.HandlerFunc(r.leaderboardHandler.CreateSync)))
+			r.mux.Handle("PUT /api/v1/admin/leaderboard-syncs/{id}", mw(http.HandlerFunc(r.leaderboardHandler.UpdateSync)))
+			r.mux.Handle("DELETE /api/v1/admin/leaderboard-syncs/{id}", mw(http.HandlerFunc(r.leaderboardHandler.DeleteSync)))
+			r.mux.Handle("POST /api/v1/admin/leaderboard-syncs/{id}/run", mw(http.HandlerFunc(r.leaderboardHandler.RunSync)))
+		}
 	}
 
 	// Player API routes (protected by auth middleware only)
@@ -238,6 +521,56 @@ func (r *Router) setupRoutes() {
 		r.setupMatchRoutes()
 	}
 
+	// Matchmaking API routes (protected by auth middleware)
+	if r.matchmakingHandler != nil && r.jwtSecret != "" {
+		r.setupMatchmakingRoutes()
+	}
+
+	// Ladder standings, challenges and admin ladder management
+	if r.ladderHandler != nil {
+		r.setupLadderRoutes()
+	}
+
+	// Captured match submission and verification requests and their replay
+	if r.captureHandler != nil && r.jwtSecret != "" {
+		r.setupCaptureRoutes()
+	}
+
+	// Ranked seasons and admin season management
+	if r.seasonHandler != nil {
+		r.setupSeasonRoutes()
+	}
+
+	// Organizations, their staff and tournament series
+	if r.organizationHandler != nil {
+		r.setupOrganizationRoutes()
+	}
+
+	// Tier boundary and rating history routes
+	if r.tierHandler != nil {
+		r.setupTierRoutes()
+	}
+
+	// Platform stats and admin analytics
+	if r.statsHandler != nil {
+		r.setupStatsRoutes()
+	}
+
+	// Admin data integrity reports (require auth + admin)
+	if r.integrityHandler != nil && r.jwtSecret != "" {
+		r.setupIntegrityRoutes()
+	}
+
+	// Player and site-wide activity feeds
+	if r.activityHandler != nil {
+		r.setupActivityRoutes()
+	}
+
+	// Public API keys and their usage
+	if r.developerHandler != nil && r.jwtSecret != "" {
+		r.setupDeveloperRoutes()
+	}
+
 	// Admin API routes (protected by auth + admin middleware)
 	if r.adminHandler != nil && r.jwtSecret != "" {
 		r.setupAdminRoutes()
@@ -256,6 +589,11 @@ func (r *Router) setupPlayerRoutes() {
 	r.mux.Handle("POST /api/v1/players/me", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.playerHandler.CreateMyProfile))))
 	r.mux.Handle("PUT /api/v1/players/me", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.playerHandler.UpdateMyProfile))))
 
+	// Player availability endpoints
+	r.mux.Handle("PUT /api/v1/players/me/availability", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.playerHandler.SetMyAvailability))))
+	r.mux.Handle("DELETE /api/v1/players/me/availability", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.playerHandler.ClearMyAvailability))))
+	r.mux.Handle("GET /api/v1/players/me/availability/overlap", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.playerHandler.GetAvailabilityOverlap))))
+
 	// Player stats endpoints
 	r.mux.Handle("GET /api/v1/players/me/stats", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.playerHandler.GetMyStats))))
 	r.mux.Handle("GET /api/v1/players/me/stats/{gameId}", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.playerHandler.GetMyGameStats))))
@@ -263,11 +601,19 @@ func (r *Router) setupPlayerRoutes() {
 
 // setupTournamentRoutes configures tournament routes.
 func (r *Router) setupTournamentRoutes() {
-	// Public tournament endpoints (no auth required)
-	r.mux.HandleFunc("GET /api/v1/tournaments", r.withMiddleware(r.tournamentHandler.ListTournaments))
+	// Public tournament endpoints (no auth required). Signed-in players get
+	// discovery in their profile language.
+	optionalAuthMw := r.createOptionalAuthMiddleware()
+	r.mux.Handle("GET /api/v1/tournaments", r.withMiddlewareHandler(optionalAuthMw(http.HandlerFunc(r.tournamentHandler.ListTournaments))))
 	r.mux.HandleFunc("GET /api/v1/tournaments/active", r.withMiddleware(r.tournamentHandler.GetActiveTournaments))
+	r.mux.HandleFunc("GET /api/v1/tournaments/languages", r.withMiddleware(r.tournamentHandler.ListTournamentLanguages))
 	r.mux.HandleFunc("GET /api/v1/tournaments/{id}", r.withMiddleware(r.tournamentHandler.GetTournament))
 	r.mux.HandleFunc("GET /api/v1/tournaments/{id}/stats", r.withMiddleware(r.tournamentHandler.GetTournamentStats))
+	r.mux.HandleFunc("GET /api/v1/tournaments/{id}/standings", r.withMiddleware(r.tournamentHandler.GetTournamentStandings))
+	r.mux.HandleFunc("GET /api/v1/tournaments/{id}/rulebook", r.withMiddleware(r.tournamentHandler.GetTournamentRulebook))
+	r.mux.HandleFunc("GET /api/v1/tournaments/{id}/invites", r.withMiddleware(r.tournamentHandler.ListTournamentInvites))
+	r.mux.HandleFunc("GET /api/v1/tournaments/{id}/seeding", r.withMiddleware(r.tournamentHandler.GetTournamentSeeding))
+	r.mux.HandleFunc("GET /api/v1/tournaments/{id}/export/{exportId}", r.withMiddleware(r.tournamentHandler.DownloadTournamentExport))
 
 	// Protected tournament endpoints (require auth)
 	if r.jwtSecret != "" {
@@ -276,29 +622,67 @@ func (r *Router) setupTournamentRoutes() {
 		r.mux.Handle("PATCH /api/v1/tournaments/{id}", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.UpdateTournament))))
 		r.mux.Handle("PATCH /api/v1/tournaments/{id}/status", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.UpdateTournamentStatus))))
 		r.mux.Handle("DELETE /api/v1/tournaments/{id}", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.DeleteTournament))))
+		r.mux.Handle("GET /api/v1/tournaments/{id}/analytics", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.GetTournamentAnalytics))))
+		r.mux.Handle("GET /api/v1/tournaments/{id}/fill-projection", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.GetTournamentFillProjection))))
+		r.mux.Handle("GET /api/v1/tournaments/{id}/export", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.RequestTournamentExport))))
+		r.mux.Handle("POST /api/v1/tournaments/{id}/import", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.ImportTournament))))
+		r.mux.Handle("PUT /api/v1/tournaments/{id}/rulebook", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.UpdateTournamentRulebook))))
+		r.mux.Handle("GET /api/v1/tournaments/{id}/roster-overlaps", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.ListRosterOverlaps))))
+		r.mux.Handle("POST /api/v1/tournaments/{id}/roster-overlaps/{overlapId}/review", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.ReviewRosterOverlap))))
+		r.mux.Handle("POST /api/v1/tournaments/{id}/invites", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.InviteToTournament))))
+		r.mux.Handle("DELETE /api/v1/tournaments/{id}/invites/{playerId}", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.RevokeTournamentInvite))))
+		r.mux.Handle("POST /api/v1/tournaments/{id}/invites/decline", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.DeclineTournamentInvite))))
+		r.mux.Handle("POST /api/v1/tournaments/{id}/seeding/import", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.ImportTournamentSeeding))))
+		r.mux.Handle("PUT /api/v1/tournaments/{id}/seeding/{teamId}", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.ResolveTournamentSeed))))
+		r.mux.Handle("POST /api/v1/tournaments/{id}/conduct", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.RecordConduct))))
+		r.mux.Handle("GET /api/v1/tournaments/{id}/players/{playerId}/conduct", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.GetPlayerConduct))))
 		r.mux.Handle("GET /api/v1/players/me/active-tournament", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.tournamentHandler.GetPlayerActiveTournament))))
+
+		// Tournament backups (require auth + admin)
+		mw := r.getMiddleware()
+		r.mux.Handle("GET /api/v1/admin/tournaments/{id}/backups", mw(http.HandlerFunc(r.tournamentHandler.ListTournamentBackups)))
+		r.mux.Handle("POST /api/v1/admin/tournaments/{id}/backups", mw(http.HandlerFunc(r.tournamentHandler.CreateTournamentBackup)))
+		r.mux.Handle("POST /api/v1/admin/tournaments/{id}/backups/{backupId}/restore", mw(http.HandlerFunc(r.tournamentHandler.RestoreTournamentBackup)))
 	}
 }
 
 // setupTeamRoutes configures team routes.
 func (r *Router) setupTeamRoutes() {
-	// Public team endpoints
+	// Public team endpoints. Organizers see new-account flags on rosters.
+	optionalAuthMw := r.createOptionalAuthMiddleware()
 	r.mux.HandleFunc("GET /api/v1/teams/{id}", r.withMiddleware(r.teamHandler.GetTeam))
-	r.mux.HandleFunc("GET /api/v1/teams/{id}/members", r.withMiddleware(r.teamHandler.GetTeamWithMembers))
+	r.mux.Handle("GET /api/v1/teams/{id}/members", r.withMiddlewareHandler(optionalAuthMw(http.HandlerFunc(r.teamHandler.GetTeamWithMembers))))
+	r.mux.HandleFunc("GET /api/v1/teams/{id}/history", r.withMiddleware(r.teamHandler.GetHistory))
 	r.mux.HandleFunc("GET /api/v1/tournaments/{tournamentId}/teams", r.withMiddleware(r.teamHandler.ListTeamsByTournament))
 
 	// Protected team endpoints (require auth)
 	if r.jwtSecret != "" {
 		authMw := r.createAuthMiddleware()
 		r.mux.Handle("POST /api/v1/teams", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.CreateTeam))))
+		r.mux.Handle("POST /api/v1/registration-queue", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.EnqueueTeam))))
+		r.mux.Handle("GET /api/v1/registration-queue/{id}", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.GetRegistrationTicket))))
 		r.mux.Handle("POST /api/v1/teams/join", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.JoinTeam))))
 		r.mux.Handle("PATCH /api/v1/teams/{id}", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.UpdateTeam))))
 		r.mux.Handle("DELETE /api/v1/teams/{id}", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.DisbandTeam))))
 		r.mux.Handle("DELETE /api/v1/teams/{id}/members", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.RemoveMember))))
 		r.mux.Handle("POST /api/v1/teams/{id}/leave", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.LeaveTeam))))
 		r.mux.Handle("POST /api/v1/teams/{id}/transfer-captain", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.TransferCaptaincy))))
+		r.mux.Handle("PUT /api/v1/teams/{id}/captain", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.AppointCaptain))))
+		r.mux.Handle("PUT /api/v1/teams/{id}/members/{playerId}/permissions", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.SetSubmitPermission))))
+		r.mux.Handle("POST /api/v1/teams/{id}/staff", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.AddStaff))))
+		r.mux.Handle("DELETE /api/v1/teams/{id}/staff/{playerId}", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.RemoveStaff))))
+		r.mux.Handle("POST /api/v1/teams/{id}/broadcasts", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.Broadcast))))
+		r.mux.Handle("POST /api/v1/teams/{id}/join-requests", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.ApplyToTeam))))
+		r.mux.Handle("GET /api/v1/teams/{id}/join-requests", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.ListTeamJoinRequests))))
+		r.mux.Handle("POST /api/v1/teams/{id}/join-requests/{requestId}/approve", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.ApproveJoinRequest))))
+		r.mux.Handle("POST /api/v1/teams/{id}/join-requests/{requestId}/decline", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.DeclineJoinRequest))))
 		r.mux.Handle("GET /api/v1/tournaments/{tournamentId}/my-team", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.GetPlayerTeamInTournament))))
 		r.mux.Handle("GET /api/v1/players/me/teams", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.GetPlayerTeams))))
+		r.mux.Handle("GET /api/v1/players/me/join-requests", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.teamHandler.GetPlayerJoinRequests))))
+
+		// Admin team endpoints (require auth + admin)
+		mw := r.getMiddleware()
+		r.mux.Handle("POST /api/v1/admin/teams/{id}/disqualify", mw(http.HandlerFunc(r.teamHandler.DisqualifyTeam)))
 	}
 }
 
@@ -307,17 +691,188 @@ func (r *Router) setupMatchRoutes() {
 	authMw := r.createAuthMiddleware()
 
 	// Protected match endpoints (require auth)
-	r.mux.Handle("POST /api/v1/matches/report", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.matchHandler.HandleSubmitMatch))))
+	r.mux.Handle("POST /api/v1/matches/report", r.withMiddlewareHandler(authMw(r.captured(capture.KindSubmission, r.matchHandler.HandleSubmitMatch))))
+	r.mux.Handle("PUT /api/v1/matches/{id}", r.withMiddlewareHandler(authMw(r.captured(capture.KindResubmission, r.matchHandler.HandleResubmitMatch))))
 	r.mux.Handle("GET /api/v1/players/me/matches", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.matchHandler.HandleGetPlayerMatches))))
+	r.mux.Handle("GET /api/v1/teams/{id}/matches", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.matchHandler.HandleGetTeamMatches))))
+	r.mux.Handle("GET /api/v1/teams/{id}/scout", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.matchHandler.HandleScoutTeam))))
+	r.mux.Handle("GET /api/v1/matches/{id}/comments", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.matchHandler.HandleGetMatchComments))))
+	r.mux.Handle("POST /api/v1/matches/{id}/comments", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.matchHandler.HandleAddMatchComment))))
+	r.mux.Handle("POST /api/v1/matches/{id}/honors", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.matchHandler.HandleVoteMatchHonors))))
 
 	// Public match endpoints (read-only)
-	r.mux.HandleFunc("GET /api/v1/matches/tournament/{id}", r.withMiddleware(r.matchHandler.HandleGetTournamentMatches))
-	r.mux.HandleFunc("GET /api/v1/matches/{id}", r.withMiddleware(r.matchHandler.HandleGetMatch))
+	// GET /api/v1/matches/tournament/{id} overlaps the comments route above,
+	// which ServeMux refuses to register, so the tournament segment is matched here.
+	r.mux.HandleFunc("GET /api/v1/matches/{scope}/{tournament_id}", r.withMiddleware(func(w http.ResponseWriter, req *http.Request) {
+		if req.PathValue("scope") != "tournament" {
+			http.NotFound(w, req)
+			return
+		}
+		r.matchHandler.HandleGetTournamentMatches(w, req)
+	}))
+	optionalAuthMw := r.createOptionalAuthMiddleware()
+	r.mux.Handle("GET /api/v1/matches/{id}", r.withMiddlewareHandler(optionalAuthMw(http.HandlerFunc(r.matchHandler.HandleGetMatch))))
+	r.mux.Handle("GET /api/v1/matches/{id}/players/{playerId}", r.withMiddlewareHandler(optionalAuthMw(http.HandlerFunc(r.matchHandler.HandleGetMatchPlayer))))
+	r.mux.HandleFunc("GET /api/v1/matches/{id}/honors", r.withMiddleware(r.matchHandler.HandleGetMatchHonors))
+	r.mux.HandleFunc("GET /api/v1/leaderboard/{gameId}/player/{playerId}/heatmap", r.withMiddleware(r.matchHandler.HandleGetPlayerHeatmap))
 
 	// Admin match endpoints (require auth + admin)
 	mw := r.getMiddleware()
 	r.mux.Handle("GET /api/v1/admin/matches/unverified", mw(http.HandlerFunc(r.matchHandler.HandleGetUnverifiedMatches)))
-	r.mux.Handle("PATCH /api/v1/admin/matches/{id}/verify", mw(http.HandlerFunc(r.matchHandler.HandleVerifyMatch)))
+	r.mux.Handle("PATCH /api/v1/admin/matches/{id}/verify", mw(r.captured(capture.KindVerification, r.matchHandler.HandleVerifyMatch)))
+	r.mux.Handle("POST /api/v1/admin/matches/{id}/amendments", mw(r.captured(capture.KindAmendment, r.matchHandler.HandleAmendMatch)))
+	r.mux.Handle("POST /api/v1/admin/matches/{id}/unverify", mw(r.captured(capture.KindUnverification, r.matchHandler.HandleUnverifyMatch)))
+	r.mux.Handle("PATCH /api/v1/admin/matches/{id}/void", mw(r.captured(capture.KindVoid, r.matchHandler.HandleVoidMatch)))
+
+	// Signed submissions from stats connectors (no user auth)
+	r.mux.Handle("POST /api/v1/connectors/matches", r.withMiddlewareHandler(r.captured(capture.KindConnectorSubmission, r.matchHandler.HandleConnectorSubmitMatch)))
+
+	// Admin connector key management
+	r.mux.Handle("GET /api/v1/admin/connectors", mw(http.HandlerFunc(r.matchHandler.HandleListConnectors)))
+	r.mux.Handle("POST /api/v1/admin/connectors", mw(http.HandlerFunc(r.matchHandler.HandleCreateConnector)))
+	r.mux.Handle("POST /api/v1/admin/connectors/{id}/rotate", mw(http.HandlerFunc(r.matchHandler.HandleRotateConnector)))
+	r.mux.Handle("DELETE /api/v1/admin/connectors/{id}", mw(http.HandlerFunc(r.matchHandler.HandleRevokeConnector)))
+
+	// Admin bulk stat corrections
+	r.mux.Handle("POST /api/v1/admin/stat-corrections", mw(http.HandlerFunc(r.matchHandler.HandleCorrectStats)))
+	r.mux.Handle("GET /api/v1/admin/stat-corrections/{id}", mw(http.HandlerFunc(r.matchHandler.HandleGetStatCorrection)))
+	r.mux.Handle("GET /api/v1/admin/games/{id}/stat-corrections", mw(http.HandlerFunc(r.matchHandler.HandleListStatCorrections)))
+}
+
+// setupCaptureRoutes configures admin routes for inspecting and replaying
+// captured requests.
+func (r *Router) setupCaptureRoutes() {
+	mw := r.getMiddleware()
+	r.mux.Handle("GET /api/v1/admin/request-captures", mw(http.HandlerFunc(r.captureHandler.ListCaptures)))
+	r.mux.Handle("GET /api/v1/admin/request-captures/{id}", mw(http.HandlerFunc(r.captureHandler.GetCapture)))
+	r.mux.Handle("POST /api/v1/admin/request-captures/{id}/replay", mw(http.HandlerFunc(r.captureHandler.ReplayCapture)))
+}
+
+// captured wraps a handler with request capture, when it is enabled.
+func (r *Router) captured(kind capture.Kind, next http.HandlerFunc) http.Handler {
+	if r.capture == nil {
+		return next
+	}
+	return r.capture.Wrap(kind, next)
+}
+
+// setupMatchmakingRoutes configures scrim matchmaking routes.
+func (r *Router) setupMatchmakingRoutes() {
+	authMw := r.createAuthMiddleware()
+
+	r.mux.Handle("POST /api/v1/matchmaking/queue", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.matchmakingHandler.JoinQueue))))
+	r.mux.Handle("GET /api/v1/matchmaking/queue", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.matchmakingHandler.GetQueueStatus))))
+	r.mux.Handle("DELETE /api/v1/matchmaking/queue", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.matchmakingHandler.LeaveQueue))))
+	r.mux.Handle("GET /api/v1/matchmaking/teammates", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.matchmakingHandler.SuggestTeammates))))
+	r.mux.Handle("GET /api/v1/matchmaking/lobbies/{id}", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.matchmakingHandler.GetLobby))))
+	r.mux.Handle("POST /api/v1/matchmaking/lobbies/{id}/results", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.matchmakingHandler.ReportLobbyResult))))
+}
+
+// setupLadderRoutes configures public ladder routes, captain ladder actions and admin ladder management.
+func (r *Router) setupLadderRoutes() {
+	r.mux.HandleFunc("GET /api/v1/ladders", r.withMiddleware(r.ladderHandler.ListLadders))
+	r.mux.HandleFunc("GET /api/v1/ladders/{id}", r.withMiddleware(r.ladderHandler.GetLadder))
+	r.mux.HandleFunc("GET /api/v1/ladders/{id}/snapshots", r.withMiddleware(r.ladderHandler.ListSnapshots))
+	r.mux.HandleFunc("GET /api/v1/ladders/{id}/challenges", r.withMiddleware(r.ladderHandler.ListChallenges))
+
+	if r.jwtSecret != "" {
+		authMw := r.createAuthMiddleware()
+		r.mux.Handle("POST /api/v1/ladders/{id}/teams/{teamId}", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.ladderHandler.JoinLadder))))
+		r.mux.Handle("DELETE /api/v1/ladders/{id}/teams/{teamId}", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.ladderHandler.LeaveLadder))))
+		r.mux.Handle("POST /api/v1/ladders/{id}/challenges", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.ladderHandler.CreateChallenge))))
+		r.mux.Handle("POST /api/v1/ladders/{id}/challenges/{challengeId}/respond", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.ladderHandler.RespondToChallenge))))
+		r.mux.Handle("POST /api/v1/ladders/{id}/challenges/{challengeId}/forfeit", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.ladderHandler.ReportForfeit))))
+		r.mux.Handle("POST /api/v1/ladders/{id}/challenges/{challengeId}/forfeit/contest", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.ladderHandler.ContestForfeit))))
+		r.mux.Handle("POST /api/v1/ladders/{id}/challenges/{challengeId}/reschedule", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.ladderHandler.ProposeReschedule))))
+		r.mux.Handle("POST /api/v1/ladders/{id}/challenges/{challengeId}/reschedule/respond", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.ladderHandler.RespondToReschedule))))
+
+		mw := r.getMiddleware()
+		r.mux.Handle("POST /api/v1/admin/ladders", mw(http.HandlerFunc(r.ladderHandler.CreateLadder)))
+		r.mux.Handle("POST /api/v1/admin/ladders/{id}/challenges/{challengeId}/forfeit/decision", mw(http.HandlerFunc(r.ladderHandler.DecideForfeit)))
+		r.mux.Handle("POST /api/v1/admin/ladders/{id}/challenges/{challengeId}/schedule", mw(http.HandlerFunc(r.ladderHandler.OverrideSchedule)))
+	}
+}
+
+// setupSeasonRoutes configures public season routes and admin season management.
+func (r *Router) setupSeasonRoutes() {
+	r.mux.HandleFunc("GET /api/v1/games/{id}/seasons", r.withMiddleware(r.seasonHandler.ListSeasons))
+	r.mux.HandleFunc("GET /api/v1/seasons/{id}", r.withMiddleware(r.seasonHandler.GetSeason))
+
+	if r.jwtSecret != "" {
+		mw := r.getMiddleware()
+		r.mux.Handle("POST /api/v1/admin/games/{id}/seasons", mw(http.HandlerFunc(r.seasonHandler.CreateSeason)))
+		r.mux.Handle("POST /api/v1/admin/seasons/rollover", mw(http.HandlerFunc(r.seasonHandler.RollOver)))
+	}
+}
+
+// setupOrganizationRoutes configures public organization and series standings
+// routes and organization staff and series management.
+func (r *Router) setupOrganizationRoutes() {
+	r.mux.HandleFunc("GET /api/v1/organizations/{id}", r.withMiddleware(r.organizationHandler.GetOrganization))
+	r.mux.HandleFunc("GET /api/v1/organizations/{id}/series/{seriesId}/standings", r.withMiddleware(r.organizationHandler.GetSeriesStandings))
+
+	if r.jwtSecret != "" {
+		authMw := r.createAuthMiddleware()
+		r.mux.Handle("POST /api/v1/organizations", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.organizationHandler.CreateOrganization))))
+		r.mux.Handle("POST /api/v1/organizations/{id}/staff", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.organizationHandler.AddStaff))))
+		r.mux.Handle("DELETE /api/v1/organizations/{id}/staff/{userId}", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.organizationHandler.RemoveStaff))))
+		r.mux.Handle("POST /api/v1/organizations/{id}/series", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.organizationHandler.CreateSeries))))
+		r.mux.Handle("PUT /api/v1/organizations/{id}/series/{seriesId}/tournaments/{tournamentId}", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.organizationHandler.AddTournament))))
+		r.mux.Handle("PUT /api/v1/organizations/{id}/series/{seriesId}/circuit", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.organizationHandler.SetCircuit))))
+	}
+}
+
+// setupTierRoutes configures tier boundary and rating history routes.
+func (r *Router) setupTierRoutes() {
+	// Public rating history and season rewards
+	r.mux.HandleFunc("GET /api/v1/leaderboard/{gameId}/player/{playerId}/history", r.withMiddleware(r.tierHandler.GetRatingHistory))
+	r.mux.HandleFunc("GET /api/v1/leaderboard/{gameId}/player/{playerId}/rewards", r.withMiddleware(r.tierHandler.GetPlayerRewards))
+
+	// Admin tier boundary management (require auth + admin)
+	if r.jwtSecret != "" {
+		mw := r.getMiddleware()
+		r.mux.Handle("GET /api/v1/admin/games/{id}/tier-boundaries", mw(http.HandlerFunc(r.tierHandler.ListBoundaries)))
+		r.mux.Handle("POST /api/v1/admin/games/{id}/tier-boundaries", mw(http.HandlerFunc(r.tierHandler.CreateBoundaries)))
+		r.mux.Handle("POST /api/v1/admin/games/{id}/tier-boundaries/recalibrate", mw(http.HandlerFunc(r.tierHandler.Recalibrate)))
+		r.mux.Handle("POST /api/v1/admin/games/{id}/season-rewards/distribute", mw(http.HandlerFunc(r.tierHandler.DistributeSeasonRewards)))
+	}
+}
+
+// setupStatsRoutes configures public platform stats and admin analytics routes.
+func (r *Router) setupStatsRoutes() {
+	r.mux.HandleFunc("GET /api/v1/stats/overview", r.withMiddleware(r.statsHandler.GetOverview))
+
+	if r.jwtSecret != "" {
+		mw := r.getMiddleware()
+		r.mux.Handle("GET /api/v1/admin/analytics/cohorts", mw(http.HandlerFunc(r.statsHandler.GetCohorts)))
+		r.mux.Handle("POST /api/v1/admin/analytics/cohorts", mw(http.HandlerFunc(r.statsHandler.GenerateCohorts)))
+	}
+}
+
+// setupIntegrityRoutes configures the admin data integrity report routes.
+func (r *Router) setupIntegrityRoutes() {
+	mw := r.getMiddleware()
+	r.mux.Handle("GET /api/v1/admin/integrity", mw(http.HandlerFunc(r.integrityHandler.GetReport)))
+	r.mux.Handle("POST /api/v1/admin/integrity", mw(http.HandlerFunc(r.integrityHandler.RunCheck)))
+}
+
+// setupActivityRoutes configures the public site-wide feed and the player activity feed.
+func (r *Router) setupActivityRoutes() {
+	r.mux.HandleFunc("GET /api/v1/activity/recent", r.withMiddleware(r.activityHandler.GetRecent))
+
+	if r.jwtSecret != "" {
+		authMw := r.createAuthMiddleware()
+		r.mux.Handle("GET /api/v1/players/me/activity", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.activityHandler.GetMyActivity))))
+	}
+}
+
+// setupDeveloperRoutes configures API key signup and usage for developers.
+func (r *Router) setupDeveloperRoutes() {
+	authMw := r.createAuthMiddleware()
+	r.mux.Handle("POST /api/v1/developers/me/keys", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.developerHandler.CreateKey))))
+	r.mux.Handle("GET /api/v1/developers/me/keys", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.developerHandler.ListKeys))))
+	r.mux.Handle("DELETE /api/v1/developers/me/keys/{id}", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.developerHandler.RevokeKey))))
+	r.mux.Handle("GET /api/v1/developers/me/usage", r.withMiddlewareHandler(authMw(http.HandlerFunc(r.developerHandler.GetUsage))))
 }
 
 // setupAdminRoutes configures admin-only routes with authentication.
@@ -330,6 +885,7 @@ func (r *Router) setupAdminRoutes() {
 	r.mux.Handle("GET /api/v1/admin/users/{id}", mw(http.HandlerFunc(r.adminHandler.GetUser)))
 	r.mux.Handle("DELETE /api/v1/admin/users/{id}", mw(http.HandlerFunc(r.adminHandler.DeleteUser)))
 	r.mux.Handle("PATCH /api/v1/admin/users/{id}/role", mw(http.HandlerFunc(r.adminHandler.UpdateUserRole)))
+	r.mux.Handle("GET /api/v1/admin/consent/report", mw(http.HandlerFunc(r.adminHandler.GetConsentReport)))
 
 	// Game management
 	r.mux.Handle("GET /api/v1/admin/games", mw(http.HandlerFunc(r.adminHandler.ListGames)))
@@ -337,6 +893,7 @@ func (r *Router) setupAdminRoutes() {
 	r.mux.Handle("POST /api/v1/admin/games", mw(http.HandlerFunc(r.adminHandler.CreateGame)))
 	r.mux.Handle("PUT /api/v1/admin/games/{id}", mw(http.HandlerFunc(r.adminHandler.UpdateGame)))
 	r.mux.Handle("DELETE /api/v1/admin/games/{id}", mw(http.HandlerFunc(r.adminHandler.DeleteGame)))
+	r.mux.Handle("POST /api/v1/admin/games/{id}/ranking/simulate", mw(http.HandlerFunc(r.adminHandler.SimulateRanking)))
 
 	// Player management
 	r.mux.Handle("GET /api/v1/admin/players", mw(http.HandlerFunc(r.adminHandler.ListPlayers)))
@@ -346,6 +903,14 @@ func (r *Router) setupAdminRoutes() {
 	r.mux.Handle("PATCH /api/v1/admin/players/{id}/unban", mw(http.HandlerFunc(r.adminHandler.UnbanPlayer)))
 	r.mux.Handle("PUT /api/v1/admin/players/{id}", mw(http.HandlerFunc(r.adminHandler.UpdatePlayer)))
 	r.mux.Handle("DELETE /api/v1/admin/players/{id}", mw(http.HandlerFunc(r.adminHandler.DeletePlayer)))
+
+	// Private admin notes
+	r.mux.Handle("GET /api/v1/admin/players/{id}/notes", mw(r.adminHandler.ListNotes(note.SubjectPlayer)))
+	r.mux.Handle("POST /api/v1/admin/players/{id}/notes", mw(r.adminHandler.AddNote(note.SubjectPlayer)))
+	r.mux.Handle("GET /api/v1/admin/matches/{id}/notes", mw(r.adminHandler.ListNotes(note.SubjectMatch)))
+	r.mux.Handle("POST /api/v1/admin/matches/{id}/notes", mw(r.adminHandler.AddNote(note.SubjectMatch)))
+	r.mux.Handle("GET /api/v1/admin/teams/{id}/notes", mw(r.adminHandler.ListNotes(note.SubjectTeam)))
+	r.mux.Handle("POST /api/v1/admin/teams/{id}/notes", mw(r.adminHandler.AddNote(note.SubjectTeam)))
 }
 
 // getMiddleware returns a middleware chain that applies auth + admin + logging.
@@ -371,6 +936,8 @@ func (r *Router) withMiddleware(next http.HandlerFunc) http.HandlerFunc {
 			}
 		}()
 
+		req = r.withLoader(req)
+
 		// Logging
 		start := time.Now()
 		wrapped := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -396,6 +963,8 @@ func (r *Router) withMiddlewareHandler(next http.Handler) http.Handler {
 			}
 		}()
 
+		req = r.withLoader(req)
+
 		// Logging
 		start := time.Now()
 		wrapped := &responseWriter{ResponseWriter: w, statusCode: http.StatusOK}
@@ -410,9 +979,50 @@ func (r *Router) withMiddlewareHandler(next http.Handler) http.Handler {
 	})
 }
 
-// createAuthMiddleware creates the auth middleware.
+// withLoader attaches a fresh data loader to the request if loader sources are configured.
+func (r *Router) withLoader(req *http.Request) *http.Request {
+	if r.loaderGames == nil && r.loaderPlayers == nil {
+		return req
+	}
+	return req.WithContext(loader.WithLoader(req.Context(), loader.New(r.loaderGames, r.loaderPlayers)))
+}
+
+// createAuthMiddleware creates the auth middleware, followed by the
+// active-account check and the consent gate when they are configured.
 func (r *Router) createAuthMiddleware() func(http.Handler) http.Handler {
-	return middleware.Auth(r.jwtSecret, r.logger)
+	authMw := r.createAuthOnlyMiddleware()
+	if r.consentChecker == nil {
+		return authMw
+	}
+
+	consentMw := middleware.RequireConsent(r.consentChecker, r.logger)
+	return func(next http.Handler) http.Handler {
+		return authMw(consentMw(next))
+	}
+}
+
+// createAuthOnlyMiddleware creates the auth middleware without the consent
+// gate, followed by the active-account check when one is configured.
+func (r *Router) createAuthOnlyMiddleware() func(http.Handler) http.Handler {
+	return r.withAccountCheck(middleware.Auth(r.jwtSecret, r.logger))
+}
+
+// createOptionalAuthMiddleware creates the optional auth middleware, followed
+// by the active-account check when one is configured.
+func (r *Router) createOptionalAuthMiddleware() func(http.Handler) http.Handler {
+	return r.withAccountCheck(middleware.OptionalAuth(r.jwtSecret, r.logger))
+}
+
+// withAccountCheck runs the active-account check after authMw.
+func (r *Router) withAccountCheck(authMw func(http.Handler) http.Handler) func(http.Handler) http.Handler {
+	if r.accountChecker == nil {
+		return authMw
+	}
+
+	activeMw := middleware.RequireActiveAccount(r.accountChecker, r.logger)
+	return func(next http.Handler) http.Handler {
+		return authMw(activeMw(next))
+	}
 }
 
 // createAdminMiddleware creates the admin-only middleware.
@@ -460,6 +1070,8 @@ func (r *Router) handleHealth(w http.ResponseWriter, req *http.Request) {
 
 // handleReady handles the readiness check endpoint.
 // This is a readiness probe - returns 200 if the service can accept traffic.
+// A dependency whose check latency has stayed over its budget fails the
+// probe even though its checks still pass.
 func (r *Router) handleReady(w http.ResponseWriter, req *http.Request) {
 	status := ReadyStatus{
 		Status: "ok",
@@ -469,10 +1081,14 @@ func (r *Router) handleReady(w http.ResponseWriter, req *http.Request) {
 
 	// Check MongoDB if checker is configured
 	if r.mongoChecker != nil {
-		if err := r.mongoChecker(); err != nil {
+		if err := r.timedCheck("mongodb", r.mongoChecker); err != nil {
 			status.Database = "unhealthy: " + err.Error()
 			status.Checks["mongodb"] = "fail"
 			allHealthy = false
+		} else if report := r.latencyReport(&status, "mongodb"); report.Degraded {
+			status.Database = fmt.Sprintf("degraded: p99 latency %s over budget %s", report.P99, report.BudgetP99)
+			status.Checks["mongodb"] = "fail"
+			allHealthy = false
 		} else {
 			status.Database = "healthy"
 			status.Checks["mongodb"] = "pass"
@@ -484,10 +1100,14 @@ func (r *Router) handleReady(w http.ResponseWriter, req *http.Request) {
 
 	// Check Redis if checker is configured
 	if r.redisChecker != nil {
-		if err := r.redisChecker(); err != nil {
+		if err := r.timedCheck("redis", r.redisChecker); err != nil {
 			status.Redis = "unhealthy: " + err.Error()
 			status.Checks["redis"] = "fail"
 			allHealthy = false
+		} else if report := r.latencyReport(&status, "redis"); report.Degraded {
+			status.Redis = fmt.Sprintf("degraded: p99 latency %s over budget %s", report.P99, report.BudgetP99)
+			status.Checks["redis"] = "fail"
+			allHealthy = false
 		} else {
 			status.Redis = "healthy"
 			status.Checks["redis"] = "pass"
@@ -506,6 +1126,32 @@ func (r *Router) handleReady(w http.ResponseWriter, req *http.Request) {
 	r.jsonResponse(w, http.StatusOK, status)
 }
 
+// timedCheck runs a dependency's check, recording its latency when the
+// dependency has a latency budget.
+func (r *Router) timedCheck(dependency string, check func() error) error {
+	start := time.Now()
+	err := check()
+	if t, ok := r.latency[dependency]; ok {
+		t.observe(time.Since(start), time.Now())
+	}
+	return err
+}
+
+// latencyReport adds a dependency's latency report to status and returns it.
+// Dependencies without a latency budget are never degraded.
+func (r *Router) latencyReport(status *ReadyStatus, dependency string) LatencyReport {
+	t, ok := r.latency[dependency]
+	if !ok {
+		return LatencyReport{}
+	}
+	report := t.report(time.Now())
+	if status.Latency == nil {
+		status.Latency = make(map[string]LatencyReport)
+	}
+	status.Latency[dependency] = report
+	return report
+}
+
 // handleSystemInfo returns system information.
 func (r *Router) handleSystemInfo(w http.ResponseWriter, req *http.Request) {
 	info := SystemInfo{
@@ -520,6 +1166,34 @@ func (r *Router) handleSystemInfo(w http.ResponseWriter, req *http.Request) {
 	r.jsonResponse(w, http.StatusOK, info)
 }
 
+// MetricsWriter writes metrics in the Prometheus text format.
+type MetricsWriter interface {
+	WriteMetrics(w io.Writer)
+}
+
+// handleMetrics serves process, load shedding, circuit breaker and source metrics.
+func (r *Router) handleMetrics(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+
+	fmt.Fprintln(w, "# HELP tourneyrank_goroutines Number of goroutines.")
+	fmt.Fprintln(w, "# TYPE tourneyrank_goroutines gauge")
+	fmt.Fprintf(w, "tourneyrank_goroutines %d\n", runtime.NumGoroutine())
+	fmt.Fprintln(w, "# HELP tourneyrank_uptime_seconds Seconds since the server started.")
+	fmt.Fprintln(w, "# TYPE tourneyrank_uptime_seconds gauge")
+	fmt.Fprintf(w, "tourneyrank_uptime_seconds %.0f\n", time.Since(r.startTime).Seconds())
+
+	writeLatencyMetrics(w, r.latency)
+	if r.shedder != nil {
+		r.shedder.WriteMetrics(w)
+	}
+	if m, ok := r.breaker.(MetricsWriter); ok {
+		m.WriteMetrics(w)
+	}
+	for _, m := range r.metrics {
+		m.WriteMetrics(w)
+	}
+}
+
 // handlePing is a simple ping endpoint.
 func (r *Router) handlePing(w http.ResponseWriter, req *http.Request) {
 	r.jsonResponse(w, http.StatusOK, map[string]string{
@@ -0,0 +1,90 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"github.com/alejaam/tourney-rank/internal/domain/season"
+)
+
+// TestSeasonRolloverArchive rolls over the ended season while Bob hides from
+// public leaderboards. The archive keeps who Bob is, so the season can still
+// be read back once he is shown again, and all-time rankings carry on.
+func TestSeasonRolloverArchive(t *testing.T) {
+	env := newContractEnv(t)
+	ctx := context.Background()
+	alice, bob := env.ids["alice"], env.ids["bob"]
+
+	allTime, err := env.stats.GetByPlayerAndGame(ctx, uuid.MustParse(alice), uuid.MustParse(env.ids["game"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	score, tier := allTime.RankingScore, allTime.Tier
+
+	p, err := env.players.GetByID(ctx, bob)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.HideFromBoards = true
+
+	status, _, body := env.do(t, contractRequest{Method: http.MethodPost, Path: "/api/v1/admin/seasons/rollover", As: "admin"})
+	if status != http.StatusOK {
+		t.Fatalf("rollover: status %d: %s", status, body)
+	}
+
+	if allTime.RankingScore != score || allTime.Tier != tier {
+		t.Errorf("all-time ranking: got %v, %s; want %v, %s", allTime.RankingScore, allTime.Tier, score, tier)
+	}
+
+	archived, err := env.seasons.GetByID(ctx, uuid.MustParse(env.ids["season"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := standingIDs(archived.Standings); len(got) != 2 || got[0] != alice || got[1] != bob {
+		t.Fatalf("archived standings: got %v, want [%s %s]", got, alice, bob)
+	}
+
+	served := servedStandings(t, env)
+	if len(served) != 2 || served[0].PlayerID.String() != alice {
+		t.Fatalf("served standings: got %+v", served)
+	}
+	if served[1].PlayerID != uuid.Nil || !served[1].Anonymous {
+		t.Errorf("hidden player served as %+v", served[1])
+	}
+
+	p.HideFromBoards = false
+	served = servedStandings(t, env)
+	if len(served) != 2 || served[1].PlayerID.String() != bob || served[1].Anonymous {
+		t.Errorf("player shown again served as %+v", served)
+	}
+}
+
+// standingIDs returns the player IDs of standings in order.
+func standingIDs(standings []season.Standing) []string {
+	ids := make([]string, len(standings))
+	for i, st := range standings {
+		ids[i] = st.PlayerID.String()
+	}
+	return ids
+}
+
+// servedStandings returns the ended season's standings as served publicly.
+func servedStandings(t *testing.T, env *contractEnv) []season.Standing {
+	t.Helper()
+	status, _, body := env.do(t, contractRequest{Method: http.MethodGet, Path: "/api/v1/seasons/{{season}}"})
+	if status != http.StatusOK {
+		t.Fatalf("get season: status %d: %s", status, body)
+	}
+
+	var resp struct {
+		Standings []season.Standing `json:"standings"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		t.Fatal(err)
+	}
+	return resp.Standings
+}
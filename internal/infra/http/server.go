@@ -3,6 +3,7 @@ package http
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"log/slog"
@@ -12,30 +13,65 @@ import (
 
 // Server wraps the HTTP server with graceful shutdown support.
 type Server struct {
-	server *http.Server
-	logger *slog.Logger
+	server   *http.Server
+	logger   *slog.Logger
+	certFile string
+	keyFile  string
+}
+
+// ServerOption configures the server.
+type ServerOption func(*Server)
+
+// WithTLS serves HTTPS with the given certificate and key instead of plain
+// HTTP. Clients that support it are offered HTTP/2.
+func WithTLS(certFile, keyFile string) ServerOption {
+	return func(s *Server) {
+		s.certFile = certFile
+		s.keyFile = keyFile
+	}
+}
+
+// WithoutHTTP2 restricts TLS clients to HTTP/1.1.
+func WithoutHTTP2() ServerOption {
+	return func(s *Server) {
+		s.server.TLSNextProto = map[string]func(*http.Server, *tls.Conn, http.Handler){}
+	}
 }
 
 // NewServer creates a new HTTP server with the provided configuration.
-func NewServer(addr string, handler http.Handler, logger *slog.Logger) *Server {
-	return &Server{
+func NewServer(addr string, handler http.Handler, logger *slog.Logger, opts ...ServerOption) *Server {
+	s := &Server{
 		server: &http.Server{
 			Addr:         addr,
 			Handler:      handler,
 			ReadTimeout:  15 * time.Second,
 			WriteTimeout: 15 * time.Second,
 			IdleTimeout:  60 * time.Second,
+			TLSConfig:    &tls.Config{MinVersion: tls.VersionTLS12},
 		},
 		logger: logger,
 	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
 }
 
-// Start begins listening for HTTP requests.
-// This method blocks until the server is shut down.
+// Start begins listening for HTTP requests, or HTTPS ones when TLS is
+// configured. This method blocks until the server is shut down.
 func (s *Server) Start() error {
-	s.logger.Info("HTTP server starting", "addr", s.server.Addr)
+	var err error
+	if s.certFile != "" {
+		s.logger.Info("HTTPS server starting", "addr", s.server.Addr, "http2", s.server.TLSNextProto == nil)
+		err = s.server.ListenAndServeTLS(s.certFile, s.keyFile)
+	} else {
+		s.logger.Info("HTTP server starting", "addr", s.server.Addr)
+		err = s.server.ListenAndServe()
+	}
 
-	if err := s.server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+	if err != nil && !errors.Is(err, http.ErrServerClosed) {
 		return fmt.Errorf("http server: %w", err)
 	}
 
@@ -0,0 +1,113 @@
+// Package leaderboardsync pushes leaderboard snapshots to external systems.
+package leaderboardsync
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+)
+
+// SignatureHeader carries the hex HMAC-SHA256 of the request body sent to partner APIs.
+const SignatureHeader = "X-TourneyRank-Signature"
+
+// TimestampHeader carries the Unix time the partner API request was signed at.
+const TimestampHeader = "X-TourneyRank-Timestamp"
+
+// Publisher pushes snapshots to the provider configured on each sync.
+type Publisher struct {
+	client *http.Client
+	sheets *sheetsClient
+}
+
+// NewPublisher creates a publisher for every supported provider.
+func NewPublisher() *Publisher {
+	client := &http.Client{Timeout: 15 * time.Second}
+	return &Publisher{
+		client: client,
+		sheets: newSheetsClient(client),
+	}
+}
+
+// Publish pushes a snapshot to the sync's destination.
+func (p *Publisher) Publish(ctx context.Context, sync *player.LeaderboardSync, snapshot *player.LeaderboardSnapshot) error {
+	switch sync.Provider {
+	case player.SyncGoogleSheets:
+		return p.sheets.write(ctx, sync.Credential, sync.Endpoint, sync.Sheet, snapshot.Rows())
+	case player.SyncJSONBucket:
+		return p.putBucket(ctx, sync, snapshot)
+	case player.SyncPartnerAPI:
+		return p.postPartner(ctx, sync, snapshot)
+	default:
+		return fmt.Errorf("unsupported leaderboard sync provider %q", sync.Provider)
+	}
+}
+
+// putBucket uploads the snapshot as a JSON object. The credential, when set,
+// is sent as a bearer token; pre-signed URLs need none.
+func (p *Publisher) putBucket(ctx context.Context, sync *player.LeaderboardSync, snapshot *player.LeaderboardSnapshot) error {
+	body, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("encoding snapshot: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, sync.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building bucket request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Cache-Control", "public, max-age=60")
+	if sync.Credential != "" {
+		req.Header.Set("Authorization", "Bearer "+sync.Credential)
+	}
+
+	return p.do(req, "bucket")
+}
+
+// postPartner sends the snapshot to a partner API, signing the timestamp and
+// body with the shared secret so the partner can authenticate the push.
+func (p *Publisher) postPartner(ctx context.Context, sync *player.LeaderboardSync, snapshot *player.LeaderboardSnapshot) error {
+	body, err := json.Marshal(snapshot)
+	if err != nil {
+		return fmt.Errorf("encoding snapshot: %w", err)
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	mac := hmac.New(sha256.New, []byte(sync.Credential))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sync.Endpoint, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("building partner request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, timestamp)
+	req.Header.Set(SignatureHeader, hex.EncodeToString(mac.Sum(nil)))
+
+	return p.do(req, "partner API")
+}
+
+// do sends req and treats any non-2xx response as an error.
+func (p *Publisher) do(req *http.Request, destination string) error {
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("pushing to %s: %w", destination, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("%s returned status %d: %s", destination, resp.StatusCode, bytes.TrimSpace(detail))
+	}
+	return nil
+}
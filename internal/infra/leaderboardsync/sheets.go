@@ -0,0 +1,159 @@
+package leaderboardsync
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	sheetsScope   = "https://www.googleapis.com/auth/spreadsheets"
+	sheetsAPIBase = "https://sheets.googleapis.com/v4/spreadsheets/"
+)
+
+// serviceAccountKey is the subset of a Google service account JSON key used
+// to obtain access tokens.
+type serviceAccountKey struct {
+	ClientEmail string `json:"client_email"`
+	PrivateKey  string `json:"private_key"`
+	TokenURI    string `json:"token_uri"`
+}
+
+type cachedToken struct {
+	value   string
+	expires time.Time
+}
+
+// sheetsClient writes rows to Google Sheets with service account credentials.
+// Access tokens are cached per service account until shortly before expiry.
+type sheetsClient struct {
+	client *http.Client
+
+	mu     sync.Mutex
+	tokens map[string]cachedToken
+}
+
+func newSheetsClient(client *http.Client) *sheetsClient {
+	return &sheetsClient{client: client, tokens: make(map[string]cachedToken)}
+}
+
+// write replaces the contents of the spreadsheet tab with rows.
+func (c *sheetsClient) write(ctx context.Context, credential, spreadsheetID, sheet string, rows [][]any) error {
+	token, err := c.accessToken(ctx, credential)
+	if err != nil {
+		return err
+	}
+
+	base := sheetsAPIBase + url.PathEscape(spreadsheetID) + "/values/"
+	if err := c.call(ctx, token, http.MethodPost, base+url.PathEscape(sheet)+":clear", struct{}{}); err != nil {
+		return fmt.Errorf("clearing sheet: %w", err)
+	}
+
+	body := map[string]any{"values": rows}
+	if err := c.call(ctx, token, http.MethodPut, base+url.PathEscape(sheet+"!A1")+"?valueInputOption=RAW", body); err != nil {
+		return fmt.Errorf("writing sheet: %w", err)
+	}
+	return nil
+}
+
+func (c *sheetsClient) call(ctx context.Context, token, method, endpoint string, payload any) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("google sheets returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// accessToken exchanges a signed service account assertion for an OAuth access token.
+func (c *sheetsClient) accessToken(ctx context.Context, credential string) (string, error) {
+	var key serviceAccountKey
+	if err := json.Unmarshal([]byte(credential), &key); err != nil || key.ClientEmail == "" || key.PrivateKey == "" || key.TokenURI == "" {
+		return "", fmt.Errorf("invalid google service account key")
+	}
+
+	c.mu.Lock()
+	cached, ok := c.tokens[key.ClientEmail]
+	c.mu.Unlock()
+	if ok && time.Now().Before(cached.expires) {
+		return cached.value, nil
+	}
+
+	signingKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(key.PrivateKey))
+	if err != nil {
+		return "", fmt.Errorf("parsing service account private key: %w", err)
+	}
+
+	now := time.Now()
+	assertion, err := jwt.NewWithClaims(jwt.SigningMethodRS256, jwt.MapClaims{
+		"iss":   key.ClientEmail,
+		"scope": sheetsScope,
+		"aud":   key.TokenURI,
+		"iat":   now.Unix(),
+		"exp":   now.Add(time.Hour).Unix(),
+	}).SignedString(signingKey)
+	if err != nil {
+		return "", fmt.Errorf("signing service account assertion: %w", err)
+	}
+
+	form := url.Values{
+		"grant_type": {"urn:ietf:params:oauth:grant-type:jwt-bearer"},
+		"assertion":  {assertion},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, key.TokenURI, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", fmt.Errorf("building token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("requesting google access token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("google token endpoint returned status %d", resp.StatusCode)
+	}
+
+	var token struct {
+		AccessToken string `json:"access_token"`
+		ExpiresIn   int    `json:"expires_in"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&token); err != nil {
+		return "", fmt.Errorf("decoding google access token: %w", err)
+	}
+
+	c.mu.Lock()
+	c.tokens[key.ClientEmail] = cachedToken{
+		value:   token.AccessToken,
+		expires: now.Add(time.Duration(token.ExpiresIn)*time.Second - time.Minute),
+	}
+	c.mu.Unlock()
+
+	return token.AccessToken, nil
+}
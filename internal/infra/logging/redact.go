@@ -0,0 +1,197 @@
+// Package logging scrubs personal data and secrets from structured logs
+// before they are written.
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"regexp"
+	"strings"
+)
+
+// Redaction categories accepted by Options.
+const (
+	RedactEmails = "email"
+	RedactTokens = "token"
+	RedactIPs    = "ip"
+)
+
+// Placeholders that replace redacted values.
+const (
+	emailPlaceholder  = "[email]"
+	tokenPlaceholder  = "[token]"
+	ipPlaceholder     = "[ip]"
+	termPlaceholder   = "***"
+	secretPlaceholder = "[redacted]"
+)
+
+var (
+	emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}`)
+
+	// JWTs, bearer credentials and secrets passed as query parameters.
+	jwtPattern         = regexp.MustCompile(`eyJ[A-Za-z0-9_-]+\.[A-Za-z0-9_-]+\.[A-Za-z0-9_-]*`)
+	bearerPattern      = regexp.MustCompile(`(?i)\b(bearer|basic)\s+[A-Za-z0-9._~+/=-]+`)
+	querySecretPattern = regexp.MustCompile(`(?i)\b((?:access_|refresh_|reset_)?token|secret|password|api_key|code)=[^&\s"]+`)
+
+	// Candidates only; a match is redacted if it parses as an address.
+	ipv4Pattern = regexp.MustCompile(`\b\d{1,3}(?:\.\d{1,3}){3}\b`)
+	ipv6Pattern = regexp.MustCompile(`(?:[0-9A-Fa-f]{0,4}:){2,7}[0-9A-Fa-f.]*`)
+)
+
+// sensitiveKeys are attribute key fragments whose values are dropped
+// entirely when tokens are redacted.
+var sensitiveKeys = []string{"password", "token", "secret", "authorization", "cookie", "api_key"}
+
+// Options selects what is redacted. Terms are additional words, such as
+// profanity, replaced wherever they appear as whole words, ignoring case.
+type Options struct {
+	Emails bool
+	Tokens bool
+	IPs    bool
+	Terms  []string
+}
+
+// ParseCategories builds options from a list of category names. "none"
+// and unknown names select nothing.
+func ParseCategories(categories []string, terms []string) Options {
+	opts := Options{Terms: terms}
+	for _, c := range categories {
+		switch strings.ToLower(strings.TrimSpace(c)) {
+		case RedactEmails:
+			opts.Emails = true
+		case RedactTokens:
+			opts.Tokens = true
+		case RedactIPs:
+			opts.IPs = true
+		}
+	}
+	return opts
+}
+
+// Enabled reports whether the options redact anything.
+func (o Options) Enabled() bool {
+	return o.Emails || o.Tokens || o.IPs || len(o.Terms) > 0
+}
+
+// Handler is a slog.Handler that redacts the message and string, error and
+// Stringer attribute values of every record before passing it on.
+type Handler struct {
+	next  slog.Handler
+	opts  Options
+	terms *regexp.Regexp
+}
+
+// NewHandler wraps next with redaction.
+func NewHandler(next slog.Handler, opts Options) *Handler {
+	h := &Handler{next: next, opts: opts}
+
+	quoted := make([]string, 0, len(opts.Terms))
+	for _, term := range opts.Terms {
+		if term = strings.TrimSpace(term); term != "" {
+			quoted = append(quoted, regexp.QuoteMeta(term))
+		}
+	}
+	if len(quoted) > 0 {
+		h.terms = regexp.MustCompile(`(?i)\b(?:` + strings.Join(quoted, "|") + `)\b`)
+	}
+
+	return h
+}
+
+// Enabled reports whether the wrapped handler handles records at level.
+func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+// Handle redacts the record and passes it to the wrapped handler.
+func (h *Handler) Handle(ctx context.Context, record slog.Record) error {
+	redacted := slog.NewRecord(record.Time, record.Level, h.redact(record.Message), record.PC)
+	record.Attrs(func(a slog.Attr) bool {
+		redacted.AddAttrs(h.redactAttr(a))
+		return true
+	})
+	return h.next.Handle(ctx, redacted)
+}
+
+// WithAttrs returns a handler whose preset attributes are redacted once, up front.
+func (h *Handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = h.redactAttr(a)
+	}
+	return &Handler{next: h.next.WithAttrs(redacted), opts: h.opts, terms: h.terms}
+}
+
+// WithGroup returns a handler that nests later attributes under name.
+func (h *Handler) WithGroup(name string) slog.Handler {
+	return &Handler{next: h.next.WithGroup(name), opts: h.opts, terms: h.terms}
+}
+
+// redactAttr redacts an attribute's value, descending into groups.
+func (h *Handler) redactAttr(a slog.Attr) slog.Attr {
+	value := a.Value.Resolve()
+
+	if h.opts.Tokens && isSensitiveKey(a.Key) && value.Kind() != slog.KindGroup {
+		return slog.String(a.Key, secretPlaceholder)
+	}
+
+	switch value.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, h.redact(value.String()))
+	case slog.KindGroup:
+		group := value.Group()
+		redacted := make([]slog.Attr, len(group))
+		for i, ga := range group {
+			redacted[i] = h.redactAttr(ga)
+		}
+		return slog.Attr{Key: a.Key, Value: slog.GroupValue(redacted...)}
+	case slog.KindAny:
+		switch v := value.Any().(type) {
+		case error:
+			return slog.String(a.Key, h.redact(v.Error()))
+		case interface{ String() string }:
+			return slog.String(a.Key, h.redact(v.String()))
+		}
+	}
+
+	return slog.Attr{Key: a.Key, Value: value}
+}
+
+// redact applies the enabled redactions to s.
+func (h *Handler) redact(s string) string {
+	if h.opts.Tokens {
+		s = jwtPattern.ReplaceAllString(s, tokenPlaceholder)
+		s = bearerPattern.ReplaceAllString(s, "$1 "+tokenPlaceholder)
+		s = querySecretPattern.ReplaceAllString(s, "$1="+tokenPlaceholder)
+	}
+	if h.opts.Emails {
+		s = emailPattern.ReplaceAllString(s, emailPlaceholder)
+	}
+	if h.opts.IPs {
+		s = ipv4Pattern.ReplaceAllStringFunc(s, replaceIP)
+		s = ipv6Pattern.ReplaceAllStringFunc(s, replaceIP)
+	}
+	if h.terms != nil {
+		s = h.terms.ReplaceAllString(s, termPlaceholder)
+	}
+	return s
+}
+
+// replaceIP replaces candidate with the IP placeholder if it is an address.
+func replaceIP(candidate string) string {
+	if net.ParseIP(candidate) == nil {
+		return candidate
+	}
+	return ipPlaceholder
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	for _, fragment := range sensitiveKeys {
+		if strings.Contains(key, fragment) {
+			return true
+		}
+	}
+	return false
+}
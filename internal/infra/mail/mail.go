@@ -0,0 +1,62 @@
+// Package mail delivers transactional email.
+package mail
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+// SMTPMailer sends plain-text email through an SMTP server.
+type SMTPMailer struct {
+	addr string
+	from string
+	auth smtp.Auth
+}
+
+// NewSMTPMailer creates a mailer for the SMTP server at addr (host:port).
+// Credentials are optional; without a username the server is used unauthenticated.
+func NewSMTPMailer(addr, username, password, from string) *SMTPMailer {
+	m := &SMTPMailer{addr: addr, from: from}
+	if username != "" {
+		host, _, _ := net.SplitHostPort(addr)
+		m.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return m
+}
+
+// SendMail sends a plain-text message to a single recipient.
+func (m *SMTPMailer) SendMail(_ context.Context, to, subject, body string) error {
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", m.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", subject)
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	if err := smtp.SendMail(m.addr, m.auth, m.from, []string{to}, []byte(msg.String())); err != nil {
+		return fmt.Errorf("sending mail: %w", err)
+	}
+	return nil
+}
+
+// LogMailer writes messages to the log instead of sending them. It is used
+// when no SMTP server is configured, e.g. in development.
+type LogMailer struct {
+	logger *slog.Logger
+}
+
+// NewLogMailer creates a mailer that logs every message.
+func NewLogMailer(logger *slog.Logger) *LogMailer {
+	return &LogMailer{logger: logger}
+}
+
+// SendMail logs the message.
+func (m *LogMailer) SendMail(_ context.Context, to, subject, body string) error {
+	m.logger.Info("mail not sent, no SMTP server configured", "to", to, "subject", subject, "body", body)
+	return nil
+}
@@ -0,0 +1,90 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/activity"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ActivityRepository implements activity.Repository using MongoDB.
+type ActivityRepository struct {
+	collection *mongo.Collection
+}
+
+// NewActivityRepository creates a new MongoDB activity event repository.
+func NewActivityRepository(db *mongo.Database) *ActivityRepository {
+	return &ActivityRepository{
+		collection: db.Collection("activity_events"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the activity events collection.
+func (r *ActivityRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "player_id", Value: 1},
+			{Key: "occurred_at", Value: -1},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("creating activity event indexes: %w", err)
+	}
+	return nil
+}
+
+// Create appends events to the activity log.
+func (r *ActivityRepository) Create(ctx context.Context, events ...*activity.Event) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(events))
+	for i, e := range events {
+		docs[i] = e
+	}
+
+	if _, err := r.collection.InsertMany(ctx, docs); err != nil {
+		return fmt.Errorf("inserting activity events: %w", err)
+	}
+	return nil
+}
+
+// ListByPlayer returns a player's events, newest first.
+func (r *ActivityRepository) ListByPlayer(ctx context.Context, playerID uuid.UUID, limit, offset int) ([]*activity.Event, error) {
+	opts := options.Find().SetSort(bson.D{
+		{Key: "occurred_at", Value: -1},
+		{Key: "_id", Value: -1},
+	})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+	if offset > 0 {
+		opts.SetSkip(int64(offset))
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"player_id": playerID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding activity events: %w", err)
+	}
+
+	events := []*activity.Event{}
+	if err := decodeAll(ctx, cursor, &events); err != nil {
+		return nil, fmt.Errorf("decoding activity events: %w", err)
+	}
+
+	return events, nil
+}
+
+// CountByPlayer returns the number of events logged for a player.
+func (r *ActivityRepository) CountByPlayer(ctx context.Context, playerID uuid.UUID) (int64, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"player_id": playerID})
+	if err != nil {
+		return 0, fmt.Errorf("counting activity events: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,322 @@
+package mongodb
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"unicode"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// anonymizeBatchSize is how many documents are inserted into the target at once.
+const anonymizeBatchSize = 500
+
+// fieldRule replaces a field's value; returning false drops the field.
+type fieldRule func(a *Anonymizer, v interface{}) (interface{}, bool)
+
+// anonymizePolicy lists the collections copied by the Anonymizer and, for
+// each, the rules applied to its personal fields, keyed by dotted path. Paths
+// descend into arrays of documents. Collections without rules are copied as
+// they are: stats, matches and ratings carry no personal data and are what
+// keep staging's distributions realistic. Collections missing here are never
+// copied, so a new collection stays out of staging until it is reviewed;
+// email changes, match connectors, tournament exports, leaderboard syncs and
+// request captures are left out on purpose since they hold pending tokens,
+// secrets, bundles of raw data or third-party endpoints.
+var anonymizePolicy = map[string]map[string]fieldRule{
+	UsersCollection: {
+		"email":         anonymizeEmail,
+		"username":      anonymizeUsername,
+		"password_hash": replacePasswordHash,
+	},
+	PlayersCollection: {
+		"display_name": scrambleText,
+		"avatar_url":   anonymizeURL,
+		"bio":          scrambleText,
+		"platform_ids": scrambleValues,
+	},
+	PlayerStatsCollection:   nil,
+	RankSnapshotsCollection: nil,
+	MatchesCollection: {
+		"screenshot_url":          anonymizeURL,
+		"attachments.url":         anonymizeURL,
+		"attachments.caption":     scrambleText,
+		"custody.screenshots.url": anonymizeURL,
+		"rejection_reason":        scrambleText,
+		"amendments.reason":       scrambleText,
+		"reversals.reason":        scrambleText,
+	},
+	MatchCommentsCollection: {"body": scrambleText},
+	GamesCollection:         nil,
+	TournamentsCollection:   nil,
+	TeamsCollection: {
+		"name":        scrambleText,
+		"tag":         scrambleText,
+		"invite_code": scrambleText,
+		"logo_url":    anonymizeURL,
+	},
+	"team_history": {
+		"from":   scrambleText,
+		"to":     scrambleText,
+		"reason": scrambleText,
+	},
+	"team_join_requests":      {"message": scrambleText},
+	"team_registration_queue": nil,
+	"admin_notes":             {"body": scrambleText},
+	"consents":                {"ip": dropField},
+	"activity_events":         {"team_name": scrambleText},
+	siteActivityCollection:    {"team_name": scrambleText},
+	"rating_history":          nil,
+	"tier_boundaries":         nil,
+	"season_rewards":          nil,
+	"cohort_reports":          nil,
+	"matchmaking_queue":       nil,
+	"matchmaking_lobbies":     nil,
+	"ladders":                 nil,
+	"ladder_challenges":       nil,
+	"ladder_snapshots":        nil,
+	"seasons":                 {"standings.display_name": scrambleText},
+	"stat_corrections":        {"reason": scrambleText},
+}
+
+// AnonymizeResult reports what an anonymized copy contains.
+type AnonymizeResult struct {
+	Copied  map[string]int64 // Documents copied per collection
+	Skipped []string         // Source collections not copied
+}
+
+// Anonymizer copies a database into another with personal data replaced, to
+// load production data into staging. Replacements are keyed with an HMAC so
+// the same value always maps to the same replacement within a run: a team's
+// scrambled name matches the one in the activity feed, and unique fields stay
+// unique. Scrambled text keeps its length and character classes, so search and
+// index behavior stay close to production. Chain-of-custody hashes covering
+// rewritten evidence URLs no longer verify in the copy.
+type Anonymizer struct {
+	source       *mongo.Database
+	target       *mongo.Database
+	key          []byte
+	passwordHash string
+	logger       *slog.Logger
+}
+
+// NewAnonymizer creates an anonymizer that copies source into target. Every
+// user's password hash is replaced with passwordHash, so staging accounts
+// share one known password, or none if it is not a valid hash.
+func NewAnonymizer(source, target *mongo.Database, key []byte, passwordHash string, logger *slog.Logger) *Anonymizer {
+	return &Anonymizer{
+		source:       source,
+		target:       target,
+		key:          key,
+		passwordHash: passwordHash,
+		logger:       logger,
+	}
+}
+
+// Run replaces the target's copy of every collection in the policy with an
+// anonymized copy of the source's. Indexes are not copied; the service creates
+// them when it starts against the target.
+func (a *Anonymizer) Run(ctx context.Context) (*AnonymizeResult, error) {
+	names, err := a.source.ListCollectionNames(ctx, bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list collections: %w", err)
+	}
+	sort.Strings(names)
+
+	result := &AnonymizeResult{Copied: make(map[string]int64)}
+	for _, name := range names {
+		rules, ok := anonymizePolicy[name]
+		if !ok {
+			result.Skipped = append(result.Skipped, name)
+			a.logger.Warn("collection not copied: no anonymization policy", "collection", name)
+			continue
+		}
+
+		n, err := a.copyCollection(ctx, name, rules)
+		if err != nil {
+			return nil, fmt.Errorf("failed to copy %s: %w", name, err)
+		}
+		result.Copied[name] = n
+		a.logger.Info("collection copied", "collection", name, "documents", n)
+	}
+
+	return result, nil
+}
+
+func (a *Anonymizer) copyCollection(ctx context.Context, name string, rules map[string]fieldRule) (int64, error) {
+	target := a.target.Collection(name)
+	if err := target.Drop(ctx); err != nil {
+		return 0, err
+	}
+
+	cursor, err := a.source.Collection(name).Find(ctx, bson.M{})
+	if err != nil {
+		return 0, err
+	}
+	defer closeCursor(cursor)
+
+	var copied int64
+	batch := make([]interface{}, 0, anonymizeBatchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+		if _, err := target.InsertMany(ctx, batch); err != nil {
+			return err
+		}
+		copied += int64(len(batch))
+		batch = batch[:0]
+		return nil
+	}
+
+	for cursor.Next(ctx) {
+		var doc bson.M
+		if err := cursor.Decode(&doc); err != nil {
+			return copied, err
+		}
+		for path, rule := range rules {
+			a.apply(doc, strings.Split(path, "."), rule)
+		}
+
+		batch = append(batch, doc)
+		if len(batch) == anonymizeBatchSize {
+			if err := flush(); err != nil {
+				return copied, err
+			}
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return copied, err
+	}
+
+	return copied, flush()
+}
+
+// apply runs rule on the field at path, descending into embedded documents
+// and arrays of them.
+func (a *Anonymizer) apply(doc bson.M, path []string, rule fieldRule) {
+	v, ok := doc[path[0]]
+	if !ok || v == nil {
+		return
+	}
+
+	if len(path) == 1 {
+		if replaced, keep := rule(a, v); keep {
+			doc[path[0]] = replaced
+		} else {
+			delete(doc, path[0])
+		}
+		return
+	}
+
+	switch nested := v.(type) {
+	case bson.M:
+		a.apply(nested, path[1:], rule)
+	case bson.A:
+		for _, item := range nested {
+			if m, ok := item.(bson.M); ok {
+				a.apply(m, path[1:], rule)
+			}
+		}
+	}
+}
+
+// digest returns the hex HMAC of a value, truncated to n characters.
+func (a *Anonymizer) digest(value string, n int) string {
+	mac := hmac.New(sha256.New, a.key)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))[:n]
+}
+
+// keystream returns n bytes derived from a value with the HMAC key.
+func (a *Anonymizer) keystream(value string, n int) []byte {
+	out := make([]byte, 0, n+sha256.Size)
+	var counter [4]byte
+	for i := uint32(0); len(out) < n; i++ {
+		binary.BigEndian.PutUint32(counter[:], i)
+		mac := hmac.New(sha256.New, a.key)
+		mac.Write(counter[:])
+		mac.Write([]byte(value))
+		out = mac.Sum(out)
+	}
+	return out[:n]
+}
+
+// scramble replaces every letter and digit of s with a pseudorandom one of the
+// same class, keeping spaces and punctuation, so the result has the shape of
+// the original without its content.
+func (a *Anonymizer) scramble(s string) string {
+	runes := []rune(s)
+	stream := a.keystream(s, len(runes))
+	for i, r := range runes {
+		b := rune(stream[i])
+		switch {
+		case unicode.IsUpper(r):
+			runes[i] = 'A' + b%26
+		case unicode.IsLetter(r):
+			runes[i] = 'a' + b%26
+		case unicode.IsDigit(r):
+			runes[i] = '0' + b%10
+		}
+	}
+	return string(runes)
+}
+
+func scrambleText(a *Anonymizer, v interface{}) (interface{}, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return v, true
+	}
+	return a.scramble(s), true
+}
+
+func scrambleValues(a *Anonymizer, v interface{}) (interface{}, bool) {
+	m, ok := v.(bson.M)
+	if !ok {
+		return v, true
+	}
+	for k, value := range m {
+		m[k], _ = scrambleText(a, value)
+	}
+	return m, true
+}
+
+func anonymizeEmail(a *Anonymizer, v interface{}) (interface{}, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return v, true
+	}
+	return "user-" + a.digest(strings.ToLower(s), 16) + "@example.invalid", true
+}
+
+func anonymizeUsername(a *Anonymizer, v interface{}) (interface{}, bool) {
+	s, ok := v.(string)
+	if !ok {
+		return v, true
+	}
+	return "user_" + a.digest(s, 12), true
+}
+
+func anonymizeURL(a *Anonymizer, v interface{}) (interface{}, bool) {
+	s, ok := v.(string)
+	if !ok || s == "" {
+		return v, true
+	}
+	return "https://example.invalid/" + a.digest(s, 24), true
+}
+
+func replacePasswordHash(a *Anonymizer, _ interface{}) (interface{}, bool) {
+	return a.passwordHash, true
+}
+
+func dropField(*Anonymizer, interface{}) (interface{}, bool) {
+	return nil, false
+}
@@ -0,0 +1,164 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// BreakerState is the state of a circuit breaker.
+type BreakerState int
+
+const (
+	// BreakerClosed lets every call through.
+	BreakerClosed BreakerState = iota
+	// BreakerOpen rejects calls until the cooldown has passed.
+	BreakerOpen
+	// BreakerHalfOpen lets a single probe through to test recovery.
+	BreakerHalfOpen
+)
+
+// String returns the state name.
+func (s BreakerState) String() string {
+	switch s {
+	case BreakerOpen:
+		return "open"
+	case BreakerHalfOpen:
+		return "half_open"
+	default:
+		return "closed"
+	}
+}
+
+// Breaker is a circuit breaker around database calls. It watches every
+// command through the driver's command monitor: failed commands and commands
+// slower than slowCommand count as failures. After threshold consecutive
+// failures it opens and Allow rejects work for cooldown, after which a
+// single probe is let through; its first command closes the breaker again or
+// reopens it.
+type Breaker struct {
+	threshold   int
+	cooldown    time.Duration
+	slowCommand time.Duration
+
+	mu          sync.Mutex
+	state       BreakerState
+	failures    int
+	openedAt    time.Time
+	probeAt     time.Time
+	trips       int64
+	rejected    int64
+	failedCalls int64
+	slowCalls   int64
+}
+
+// NewBreaker creates a closed circuit breaker. A zero slowCommand only counts
+// failed commands.
+func NewBreaker(threshold int, cooldown, slowCommand time.Duration) *Breaker {
+	return &Breaker{
+		threshold:   threshold,
+		cooldown:    cooldown,
+		slowCommand: slowCommand,
+	}
+}
+
+// Allow reports whether work that reads or writes the database may proceed.
+// When it may not, it returns how long until the breaker lets a probe through.
+func (b *Breaker) Allow() (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := time.Now()
+	switch b.state {
+	case BreakerOpen:
+		if wait := b.openedAt.Add(b.cooldown).Sub(now); wait > 0 {
+			b.rejected++
+			return wait, false
+		}
+		b.state = BreakerHalfOpen
+		b.probeAt = now
+		return 0, true
+	case BreakerHalfOpen:
+		// A probe that never reached the database must not block recovery forever.
+		if now.Sub(b.probeAt) < b.cooldown {
+			b.rejected++
+			return b.probeAt.Add(b.cooldown).Sub(now), false
+		}
+		b.probeAt = now
+		return 0, true
+	default:
+		return 0, true
+	}
+}
+
+// State returns the breaker's current state.
+func (b *Breaker) State() BreakerState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// Monitor returns a command monitor that feeds command outcomes to the breaker.
+func (b *Breaker) Monitor() *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			b.record(e.Duration, true)
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			b.record(e.Duration, false)
+		},
+	}
+}
+
+func (b *Breaker) record(duration time.Duration, succeeded bool) {
+	slow := b.slowCommand > 0 && duration > b.slowCommand
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !succeeded {
+		b.failedCalls++
+	}
+	if slow {
+		b.slowCalls++
+	}
+
+	if succeeded && !slow {
+		b.failures = 0
+		b.state = BreakerClosed
+		return
+	}
+
+	b.failures++
+	if b.state == BreakerHalfOpen || (b.state == BreakerClosed && b.failures >= b.threshold) {
+		b.state = BreakerOpen
+		b.openedAt = time.Now()
+		b.trips++
+	}
+}
+
+// WriteMetrics writes the breaker's state and counters in the Prometheus text format.
+func (b *Breaker) WriteMetrics(w io.Writer) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	fmt.Fprintln(w, "# HELP tourneyrank_mongodb_breaker_state MongoDB circuit breaker state (0 closed, 1 open, 2 half-open).")
+	fmt.Fprintln(w, "# TYPE tourneyrank_mongodb_breaker_state gauge")
+	fmt.Fprintf(w, "tourneyrank_mongodb_breaker_state %d\n", b.state)
+	fmt.Fprintln(w, "# HELP tourneyrank_mongodb_breaker_trips_total Times the MongoDB circuit breaker has opened.")
+	fmt.Fprintln(w, "# TYPE tourneyrank_mongodb_breaker_trips_total counter")
+	fmt.Fprintf(w, "tourneyrank_mongodb_breaker_trips_total %d\n", b.trips)
+	fmt.Fprintln(w, "# HELP tourneyrank_mongodb_breaker_rejected_total Requests rejected while the MongoDB circuit breaker was open.")
+	fmt.Fprintln(w, "# TYPE tourneyrank_mongodb_breaker_rejected_total counter")
+	fmt.Fprintf(w, "tourneyrank_mongodb_breaker_rejected_total %d\n", b.rejected)
+	fmt.Fprintln(w, "# HELP tourneyrank_mongodb_commands_failed_total MongoDB commands that failed.")
+	fmt.Fprintln(w, "# TYPE tourneyrank_mongodb_commands_failed_total counter")
+	fmt.Fprintf(w, "tourneyrank_mongodb_commands_failed_total %d\n", b.failedCalls)
+	fmt.Fprintln(w, "# HELP tourneyrank_mongodb_commands_slow_total MongoDB commands slower than the slow command threshold.")
+	fmt.Fprintln(w, "# TYPE tourneyrank_mongodb_commands_slow_total counter")
+	fmt.Fprintf(w, "tourneyrank_mongodb_commands_slow_total %d\n", b.slowCalls)
+}
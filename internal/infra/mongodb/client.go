@@ -7,9 +7,11 @@ import (
 	"log/slog"
 	"time"
 
+	"go.mongodb.org/mongo-driver/event"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
 	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"go.mongodb.org/mongo-driver/tag"
 )
 
 const (
@@ -24,6 +26,9 @@ const (
 
 	// DefaultRetryDelay is the default delay between retry attempts.
 	DefaultRetryDelay = 2 * time.Second
+
+	// DefaultMaxPoolSize is the driver's default maximum connections per server.
+	DefaultMaxPoolSize = 100
 )
 
 // Client wraps the MongoDB client with additional functionality.
@@ -33,6 +38,9 @@ type Client struct {
 	logger   *slog.Logger
 	uri      string
 	dbName   string
+	pool     *PoolStats
+	region   string
+	regional *readpref.ReadPref
 }
 
 // Config holds the MongoDB connection configuration.
@@ -42,6 +50,27 @@ type Config struct {
 	ConnectTimeout time.Duration
 	MaxRetries     int
 	RetryDelay     time.Duration
+	Breaker        *Breaker // Fed every command outcome when set
+
+	// OperationTimeout bounds each operation whose context has no deadline
+	// of its own; 0 leaves operations unbounded.
+	OperationTimeout time.Duration
+	// SlowQueryThreshold logs queries that take at least this long; 0 disables it.
+	SlowQueryThreshold time.Duration
+
+	// Connection pool tuning, per server. MaxPoolSize defaults to
+	// DefaultMaxPoolSize; a zero MaxConnIdleTime keeps idle connections open.
+	MinPoolSize     uint64
+	MaxPoolSize     uint64
+	MaxConnIdleTime time.Duration
+
+	// Region names the region this instance serves; repositories stamp it on
+	// the documents they write. ReadTags selects the replica set members
+	// serving regional reads, falling back to any member when none matches.
+	// ReadMaxStaleness skips members lagging further behind; 0 disables it.
+	Region           string
+	ReadTags         map[string]string
+	ReadMaxStaleness time.Duration
 }
 
 // NewClient creates a new MongoDB client with the provided configuration.
@@ -55,17 +84,42 @@ func NewClient(ctx context.Context, cfg Config, logger *slog.Logger) (*Client, e
 	if cfg.RetryDelay == 0 {
 		cfg.RetryDelay = DefaultRetryDelay
 	}
+	if cfg.MaxPoolSize == 0 {
+		cfg.MaxPoolSize = DefaultMaxPoolSize
+	}
 
 	logger.Info("connecting to MongoDB",
 		"database", cfg.DatabaseName,
 		"timeout", cfg.ConnectTimeout,
+		"operation_timeout", cfg.OperationTimeout,
+		"min_pool_size", cfg.MinPoolSize,
+		"max_pool_size", cfg.MaxPoolSize,
 	)
 
 	// Configure client options
 	clientOpts := options.Client().
 		ApplyURI(cfg.URI).
 		SetServerSelectionTimeout(cfg.ConnectTimeout).
-		SetConnectTimeout(cfg.ConnectTimeout)
+		SetConnectTimeout(cfg.ConnectTimeout).
+		SetMinPoolSize(cfg.MinPoolSize).
+		SetMaxPoolSize(cfg.MaxPoolSize).
+		SetMaxConnIdleTime(cfg.MaxConnIdleTime)
+	pool := newPoolStats(cfg.MaxPoolSize)
+	clientOpts.SetPoolMonitor(pool.Monitor())
+	if cfg.OperationTimeout > 0 {
+		clientOpts.SetTimeout(cfg.OperationTimeout)
+	}
+
+	var monitors []*event.CommandMonitor
+	if cfg.Breaker != nil {
+		monitors = append(monitors, cfg.Breaker.Monitor())
+	}
+	if cfg.SlowQueryThreshold > 0 {
+		monitors = append(monitors, newSlowQueryLogger(cfg.SlowQueryThreshold, logger).monitor())
+	}
+	if len(monitors) > 0 {
+		clientOpts.SetMonitor(combineMonitors(monitors...))
+	}
 
 	// Connect with retry logic
 	var client *mongo.Client
@@ -107,15 +161,49 @@ func NewClient(ctx context.Context, cfg Config, logger *slog.Logger) (*Client, e
 		return nil, fmt.Errorf("failed to connect to MongoDB after %d attempts: %w", cfg.MaxRetries, err)
 	}
 
+	regional, err := regionalReadPref(cfg.ReadTags, cfg.ReadMaxStaleness)
+	if err != nil {
+		return nil, err
+	}
+	if regional != nil {
+		logger.Info("serving regional reads from tagged members",
+			"region", cfg.Region,
+			"read_tags", cfg.ReadTags,
+			"max_staleness", cfg.ReadMaxStaleness,
+		)
+	}
+
 	return &Client{
 		client:   client,
 		database: client.Database(cfg.DatabaseName),
 		logger:   logger,
 		uri:      cfg.URI,
 		dbName:   cfg.DatabaseName,
+		pool:     pool,
+		region:   cfg.Region,
+		regional: regional,
 	}, nil
 }
 
+// regionalReadPref builds the read preference for regional reads, or returns
+// nil when no tags are configured. The trailing empty tag set lets reads fall
+// back to the nearest member when no tagged member is available.
+func regionalReadPref(tags map[string]string, maxStaleness time.Duration) (*readpref.ReadPref, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	opts := []readpref.Option{readpref.WithTagSets(tag.NewTagSetFromMap(tags), tag.Set{})}
+	if maxStaleness > 0 {
+		opts = append(opts, readpref.WithMaxStaleness(maxStaleness))
+	}
+	rp, err := readpref.New(readpref.NearestMode, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("regional read preference: %w", err)
+	}
+	return rp, nil
+}
+
 // Database returns the configured database instance.
 func (c *Client) Database() *mongo.Database {
 	return c.database
@@ -126,6 +214,27 @@ func (c *Client) Collection(name string) *mongo.Collection {
 	return c.database.Collection(name)
 }
 
+// RegionalCollection returns a collection whose reads go to the nearest
+// replica set member in this instance's region. Reads may be slightly stale,
+// so it suits latency-sensitive listings rather than read-after-write paths.
+// It is the same as Collection when no read tags are configured.
+func (c *Client) RegionalCollection(name string) *mongo.Collection {
+	if c.regional == nil {
+		return c.Collection(name)
+	}
+	return c.database.Collection(name, options.Collection().SetReadPreference(c.regional))
+}
+
+// Region returns the region this instance serves, or "" when unset.
+func (c *Client) Region() string {
+	return c.region
+}
+
+// PoolStats returns connection pool usage statistics.
+func (c *Client) PoolStats() *PoolStats {
+	return c.pool
+}
+
 // Ping checks if the MongoDB connection is healthy.
 func (c *Client) Ping(ctx context.Context) error {
 	pingCtx, cancel := context.WithTimeout(ctx, DefaultPingTimeout)
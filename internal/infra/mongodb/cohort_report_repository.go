@@ -0,0 +1,57 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CohortReportRepository implements player.CohortRepository using MongoDB.
+type CohortReportRepository struct {
+	collection *mongo.Collection
+}
+
+// NewCohortReportRepository creates a new MongoDB cohort report repository.
+func NewCohortReportRepository(db *mongo.Database) *CohortReportRepository {
+	return &CohortReportRepository{
+		collection: db.Collection("cohort_reports"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the cohort reports collection.
+func (r *CohortReportRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "generated_at", Value: -1}},
+	})
+	if err != nil {
+		return fmt.Errorf("creating cohort report indexes: %w", err)
+	}
+	return nil
+}
+
+// Save stores a generated cohort report.
+func (r *CohortReportRepository) Save(ctx context.Context, report *player.CohortReport) error {
+	if _, err := r.collection.InsertOne(ctx, report); err != nil {
+		return fmt.Errorf("inserting cohort report: %w", err)
+	}
+	return nil
+}
+
+// GetLatest returns the most recently generated cohort report.
+func (r *CohortReportRepository) GetLatest(ctx context.Context) (*player.CohortReport, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "generated_at", Value: -1}})
+
+	var report player.CohortReport
+	if err := r.collection.FindOne(ctx, bson.M{}, opts).Decode(&report); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, player.ErrCohortReportNotFound
+		}
+		return nil, fmt.Errorf("finding latest cohort report: %w", err)
+	}
+	return &report, nil
+}
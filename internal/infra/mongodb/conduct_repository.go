@@ -0,0 +1,70 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ConductRepository implements player.ConductRepository using MongoDB.
+type ConductRepository struct {
+	collection *mongo.Collection
+}
+
+// NewConductRepository creates a new MongoDB conduct record repository.
+func NewConductRepository(db *mongo.Database) *ConductRepository {
+	return &ConductRepository{
+		collection: db.Collection("conduct_records"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the conduct records collection.
+func (r *ConductRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "player_id", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating conduct record indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new conduct record.
+func (r *ConductRepository) Create(ctx context.Context, record *player.ConductRecord) error {
+	if _, err := r.collection.InsertOne(ctx, record); err != nil {
+		return fmt.Errorf("inserting conduct record: %w", err)
+	}
+	return nil
+}
+
+// ListByPlayers retrieves the records of the given players, newest first.
+func (r *ConductRepository) ListByPlayers(ctx context.Context, playerIDs []uuid.UUID) ([]*player.ConductRecord, error) {
+	records := []*player.ConductRecord{}
+	if len(playerIDs) == 0 {
+		return records, nil
+	}
+
+	cursor, err := r.collection.Find(ctx,
+		bson.M{"player_id": bson.M{"$in": playerIDs}},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("finding conduct records: %w", err)
+	}
+
+	if err := decodeAll(ctx, cursor, &records); err != nil {
+		return nil, fmt.Errorf("decoding conduct records: %w", err)
+	}
+
+	return records, nil
+}
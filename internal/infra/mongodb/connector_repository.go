@@ -0,0 +1,132 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ConnectorRepository implements match.ConnectorRepository and
+// match.NonceRepository using MongoDB.
+type ConnectorRepository struct {
+	collection *mongo.Collection
+	nonces     *mongo.Collection
+}
+
+// NewConnectorRepository creates a new MongoDB match connector repository.
+func NewConnectorRepository(db *mongo.Database) *ConnectorRepository {
+	return &ConnectorRepository{
+		collection: db.Collection("match_connectors"),
+		nonces:     db.Collection("match_connector_nonces"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the connector and nonce collections.
+func (r *ConnectorRepository) EnsureIndexes(ctx context.Context) error {
+	if _, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "created_at", Value: -1}},
+	}); err != nil {
+		return fmt.Errorf("creating connector indexes: %w", err)
+	}
+
+	nonceIndexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "connector_id", Value: 1}, {Key: "nonce", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+	if _, err := r.nonces.Indexes().CreateMany(ctx, nonceIndexes); err != nil {
+		return fmt.Errorf("creating connector nonce indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new connector.
+func (r *ConnectorRepository) Create(ctx context.Context, connector *match.Connector) error {
+	if _, err := r.collection.InsertOne(ctx, connector); err != nil {
+		return fmt.Errorf("inserting connector: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a connector by its ID.
+func (r *ConnectorRepository) GetByID(ctx context.Context, id uuid.UUID) (*match.Connector, error) {
+	var connector match.Connector
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&connector); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, match.ErrConnectorNotFound
+		}
+		return nil, fmt.Errorf("finding connector: %w", err)
+	}
+	return &connector, nil
+}
+
+// List returns every connector, newest first.
+func (r *ConnectorRepository) List(ctx context.Context) ([]*match.Connector, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding connectors: %w", err)
+	}
+
+	connectors := []*match.Connector{}
+	if err := decodeAll(ctx, cursor, &connectors); err != nil {
+		return nil, fmt.Errorf("decoding connectors: %w", err)
+	}
+	return connectors, nil
+}
+
+// Update saves a connector's secret, revocation and last use.
+func (r *ConnectorRepository) Update(ctx context.Context, connector *match.Connector) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": connector.ID}, connector)
+	if err != nil {
+		return fmt.Errorf("updating connector: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return match.ErrConnectorNotFound
+	}
+	return nil
+}
+
+// TouchLastUsed records when a connector last submitted. Only last_used_at
+// is written, so a revocation or rotation saved since the connector was read
+// is kept.
+func (r *ConnectorRepository) TouchLastUsed(ctx context.Context, id uuid.UUID, at time.Time) error {
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"last_used_at": at}})
+	if err != nil {
+		return fmt.Errorf("recording connector use: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return match.ErrConnectorNotFound
+	}
+	return nil
+}
+
+// Claim records a connector's nonce until expiresAt. The unique index on
+// (connector_id, nonce) makes a second claim of the same nonce fail.
+func (r *ConnectorRepository) Claim(ctx context.Context, connectorID uuid.UUID, nonce string, expiresAt time.Time) error {
+	_, err := r.nonces.InsertOne(ctx, bson.M{
+		"connector_id": connectorID,
+		"nonce":        nonce,
+		"expires_at":   expiresAt,
+	})
+	if mongo.IsDuplicateKeyError(err) {
+		return match.ErrReplayedConnectorSubmission
+	}
+	if err != nil {
+		return fmt.Errorf("claiming connector nonce: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/user"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ConsentRepository implements user.ConsentRepository using MongoDB.
+type ConsentRepository struct {
+	collection *mongo.Collection
+}
+
+// NewConsentRepository creates a new MongoDB consent repository.
+func NewConsentRepository(db *mongo.Database) *ConsentRepository {
+	return &ConsentRepository{
+		collection: db.Collection("consents"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the consents collection.
+func (r *ConsentRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "policy", Value: 1},
+				{Key: "accepted_at", Value: -1},
+			},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating consent indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores consent records.
+func (r *ConsentRepository) Create(ctx context.Context, consents []*user.Consent) error {
+	if len(consents) == 0 {
+		return nil
+	}
+
+	docs := make([]interface{}, len(consents))
+	for i, c := range consents {
+		docs[i] = c
+	}
+
+	if _, err := r.collection.InsertMany(ctx, docs); err != nil {
+		return fmt.Errorf("inserting consents: %w", err)
+	}
+	return nil
+}
+
+// Latest returns a user's most recent consent to each policy.
+func (r *ConsentRepository) Latest(ctx context.Context, userID uuid.UUID) (map[user.Policy]*user.Consent, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"user_id": userID}}},
+		{{Key: "$sort", Value: bson.D{{Key: "accepted_at", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":    "$policy",
+			"latest": bson.M{"$first": "$$ROOT"},
+		}}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$latest"}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("aggregating latest consents: %w", err)
+	}
+
+	var consents []*user.Consent
+	if err := decodeAll(ctx, cursor, &consents); err != nil {
+		return nil, fmt.Errorf("decoding consents: %w", err)
+	}
+
+	latest := make(map[user.Policy]*user.Consent, len(consents))
+	for _, c := range consents {
+		latest[c.Policy] = c
+	}
+	return latest, nil
+}
+
+// CountLatestVersions counts users by the version of their most recent
+// consent to each policy.
+func (r *ConsentRepository) CountLatestVersions(ctx context.Context) ([]user.ConsentVersionCount, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$sort", Value: bson.D{{Key: "accepted_at", Value: -1}}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":     bson.M{"user_id": "$user_id", "policy": "$policy"},
+			"version": bson.M{"$first": "$version"},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":   bson.M{"policy": "$_id.policy", "version": "$version"},
+			"users": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":     0,
+			"policy":  "$_id.policy",
+			"version": "$_id.version",
+			"users":   1,
+		}}},
+		{{Key: "$sort", Value: bson.D{{Key: "policy", Value: 1}, {Key: "version", Value: -1}}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("aggregating consent versions: %w", err)
+	}
+
+	counts := []user.ConsentVersionCount{}
+	if err := decodeAll(ctx, cursor, &counts); err != nil {
+		return nil, fmt.Errorf("decoding consent versions: %w", err)
+	}
+	return counts, nil
+}
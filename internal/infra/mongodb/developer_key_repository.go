@@ -0,0 +1,104 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/developer"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// DeveloperKeyRepository implements developer.KeyRepository using MongoDB.
+type DeveloperKeyRepository struct {
+	collection *mongo.Collection
+}
+
+// NewDeveloperKeyRepository creates a new MongoDB API key repository.
+func NewDeveloperKeyRepository(db *mongo.Database) *DeveloperKeyRepository {
+	return &DeveloperKeyRepository{
+		collection: db.Collection("developer_keys"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the API keys collection.
+func (r *DeveloperKeyRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "key_hash", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "user_id", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating developer key indexes: %w", err)
+	}
+	return nil
+}
+
+// Create stores an API key.
+func (r *DeveloperKeyRepository) Create(ctx context.Context, key *developer.APIKey) error {
+	if _, err := r.collection.InsertOne(ctx, key); err != nil {
+		return fmt.Errorf("inserting developer key: %w", err)
+	}
+	return nil
+}
+
+// Update replaces an API key.
+func (r *DeveloperKeyRepository) Update(ctx context.Context, key *developer.APIKey) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": key.ID}, key)
+	if err != nil {
+		return fmt.Errorf("updating developer key: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return developer.ErrKeyNotFound
+	}
+	return nil
+}
+
+// GetByID retrieves an API key by its ID.
+func (r *DeveloperKeyRepository) GetByID(ctx context.Context, id uuid.UUID) (*developer.APIKey, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// GetByHash retrieves the API key with a key hash.
+func (r *DeveloperKeyRepository) GetByHash(ctx context.Context, keyHash string) (*developer.APIKey, error) {
+	return r.findOne(ctx, bson.M{"key_hash": keyHash})
+}
+
+// ListByUser returns a user's API keys, oldest first.
+func (r *DeveloperKeyRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]*developer.APIKey, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"user_id": userID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding developer keys: %w", err)
+	}
+
+	keys := []*developer.APIKey{}
+	if err := decodeAll(ctx, cursor, &keys); err != nil {
+		return nil, fmt.Errorf("decoding developer keys: %w", err)
+	}
+	return keys, nil
+}
+
+func (r *DeveloperKeyRepository) findOne(ctx context.Context, filter bson.M) (*developer.APIKey, error) {
+	var key developer.APIKey
+	err := r.collection.FindOne(ctx, filter).Decode(&key)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, developer.ErrKeyNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("finding developer key: %w", err)
+	}
+	return &key, nil
+}
@@ -0,0 +1,116 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/developer"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Usage counters are kept long enough to report on, then removed by a TTL
+// index.
+const (
+	minuteUsageRetention = time.Hour
+	dayUsageRetention    = 90 * 24 * time.Hour
+)
+
+// DeveloperUsageRepository implements developer.UsageRepository using
+// MongoDB, with one counter document per key and window.
+type DeveloperUsageRepository struct {
+	collection *mongo.Collection
+}
+
+// NewDeveloperUsageRepository creates a new MongoDB API usage repository.
+func NewDeveloperUsageRepository(db *mongo.Database) *DeveloperUsageRepository {
+	return &DeveloperUsageRepository{
+		collection: db.Collection("developer_usage"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the API usage collection.
+func (r *DeveloperUsageRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "key_id", Value: 1},
+				{Key: "window", Value: 1},
+				{Key: "start", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating developer usage indexes: %w", err)
+	}
+	return nil
+}
+
+// Count adds a request to a key's window and returns the window's count.
+func (r *DeveloperUsageRepository) Count(ctx context.Context, keyID uuid.UUID, window developer.Window, start time.Time) (int64, error) {
+	var usage developer.Usage
+	err := r.collection.FindOneAndUpdate(ctx,
+		bson.M{"key_id": keyID, "window": window, "start": start},
+		bson.M{
+			"$inc":         bson.M{"requests": 1},
+			"$setOnInsert": bson.M{"rejected": 0, "expires_at": start.Add(usageRetention(window))},
+		},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&usage)
+	if err != nil {
+		return 0, fmt.Errorf("counting developer usage: %w", err)
+	}
+	return usage.Requests, nil
+}
+
+// Reject records a rejected request in a key's window.
+func (r *DeveloperUsageRepository) Reject(ctx context.Context, keyID uuid.UUID, window developer.Window, start time.Time) error {
+	_, err := r.collection.UpdateOne(ctx,
+		bson.M{"key_id": keyID, "window": window, "start": start},
+		bson.M{
+			"$inc":         bson.M{"rejected": 1},
+			"$setOnInsert": bson.M{"requests": 0, "expires_at": start.Add(usageRetention(window))},
+		},
+		options.Update().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("recording rejected developer request: %w", err)
+	}
+	return nil
+}
+
+// List returns a key's usage from a window on, oldest first.
+func (r *DeveloperUsageRepository) List(ctx context.Context, keyID uuid.UUID, window developer.Window, from time.Time) ([]*developer.Usage, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "start", Value: 1}})
+	cursor, err := r.collection.Find(ctx, bson.M{
+		"key_id": keyID,
+		"window": window,
+		"start":  bson.M{"$gte": from},
+	}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding developer usage: %w", err)
+	}
+
+	usage := []*developer.Usage{}
+	if err := decodeAll(ctx, cursor, &usage); err != nil {
+		return nil, fmt.Errorf("decoding developer usage: %w", err)
+	}
+	return usage, nil
+}
+
+func usageRetention(window developer.Window) time.Duration {
+	if window == developer.WindowDay {
+		return dayUsageRetention
+	}
+	return minuteUsageRetention
+}
@@ -0,0 +1,81 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/user"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// EmailChangeRepository implements user.EmailChangeRepository using MongoDB.
+type EmailChangeRepository struct {
+	collection *mongo.Collection
+}
+
+// NewEmailChangeRepository creates a new MongoDB email change repository.
+func NewEmailChangeRepository(db *mongo.Database) *EmailChangeRepository {
+	return &EmailChangeRepository{
+		collection: db.Collection("email_changes"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the email changes collection.
+// Lapsed changes are removed by a TTL index.
+func (r *EmailChangeRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "token_hash", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "user_id", Value: 1}},
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating email change indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a pending email change.
+func (r *EmailChangeRepository) Create(ctx context.Context, change *user.EmailChange) error {
+	_, err := r.collection.InsertOne(ctx, change)
+	if err != nil {
+		return fmt.Errorf("inserting email change: %w", err)
+	}
+	return nil
+}
+
+// GetByTokenHash retrieves the pending email change with a verification token hash.
+func (r *EmailChangeRepository) GetByTokenHash(ctx context.Context, tokenHash string) (*user.EmailChange, error) {
+	var change user.EmailChange
+	err := r.collection.FindOne(ctx, bson.M{"token_hash": tokenHash}).Decode(&change)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, user.ErrEmailChangeNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("finding email change: %w", err)
+	}
+	return &change, nil
+}
+
+// DeleteByUser removes every pending email change of a user.
+func (r *EmailChangeRepository) DeleteByUser(ctx context.Context, userID uuid.UUID) error {
+	_, err := r.collection.DeleteMany(ctx, bson.M{"user_id": userID})
+	if err != nil {
+		return fmt.Errorf("deleting email changes: %w", err)
+	}
+	return nil
+}
@@ -33,7 +33,13 @@ type gameDocument struct {
 	Description      string                 `bson:"description"`
 	StatSchema       map[string]interface{} `bson:"stat_schema"`
 	RankingWeights   map[string]float64     `bson:"ranking_weights"`
+	RankingFormula   *game.RankingFormula   `bson:"ranking_formula,omitempty"`
+	Modes            []game.Mode            `bson:"modes,omitempty"`
+	Maps             []string               `bson:"maps,omitempty"`
+	Playlists        []string               `bson:"playlists,omitempty"`
 	PlatformIDFormat string                 `bson:"platform_id_format"`
+	MinTeamSize      int                    `bson:"min_team_size,omitempty"`
+	MaxTeamSize      int                    `bson:"max_team_size,omitempty"`
 	IsActive         bool                   `bson:"is_active"`
 	CreatedAt        time.Time              `bson:"created_at"`
 	UpdatedAt        time.Time              `bson:"updated_at"`
@@ -81,6 +87,40 @@ func (r *GameRepository) GetByID(ctx context.Context, id string) (*game.Game, er
 	return toGameEntity(&doc)
 }
 
+// GetByIDs retrieves the games with the given IDs in a single query.
+// IDs with no matching game are skipped.
+func (r *GameRepository) GetByIDs(ctx context.Context, ids []string) ([]*game.Game, error) {
+	if len(ids) == 0 {
+		return []*game.Game{}, nil
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, fmt.Errorf("find games by ids: %w", err)
+	}
+	defer closeCursor(cursor)
+
+	games := make([]*game.Game, 0, len(ids))
+	for cursor.Next(ctx) {
+		var doc gameDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode game: %w", err)
+		}
+
+		g, err := toGameEntity(&doc)
+		if err != nil {
+			return nil, fmt.Errorf("convert game entity: %w", err)
+		}
+		games = append(games, g)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return games, nil
+}
+
 // GetBySlug retrieves a game by its slug.
 func (r *GameRepository) GetBySlug(ctx context.Context, slug string) (*game.Game, error) {
 	var doc gameDocument
@@ -104,7 +144,7 @@ func (r *GameRepository) GetAll(ctx context.Context) ([]*game.Game, error) {
 	if err != nil {
 		return nil, fmt.Errorf("find games: %w", err)
 	}
-	defer cursor.Close(ctx)
+	defer closeCursor(cursor)
 
 	var games []*game.Game
 	for cursor.Next(ctx) {
@@ -140,7 +180,7 @@ func (r *GameRepository) List(ctx context.Context, activeOnly bool) ([]*game.Gam
 	if err != nil {
 		return nil, fmt.Errorf("find games: %w", err)
 	}
-	defer cursor.Close(ctx)
+	defer closeCursor(cursor)
 
 	var games []*game.Game
 	for cursor.Next(ctx) {
@@ -247,12 +287,24 @@ func (r *GameRepository) EnsureIndexes(ctx context.Context) error {
 func toGameDocument(g *game.Game) *gameDocument {
 	statSchema := make(map[string]interface{})
 	for k, v := range g.StatSchema {
-		statSchema[k] = map[string]interface{}{
+		field := map[string]interface{}{
 			"type":  v.Type,
 			"min":   v.Min,
 			"max":   v.Max,
 			"label": v.Label,
+			"cap":   v.Cap,
+			"scale": v.Scale,
 		}
+		if v.Formula != "" {
+			field["formula"] = v.Formula
+		}
+		if v.Rollup != nil {
+			field["rollup"] = map[string]interface{}{
+				"source":    v.Rollup.Source,
+				"aggregate": string(v.Rollup.Aggregate),
+			}
+		}
+		statSchema[k] = field
 	}
 
 	return &gameDocument{
@@ -262,7 +314,13 @@ func toGameDocument(g *game.Game) *gameDocument {
 		Description:      g.Description,
 		StatSchema:       statSchema,
 		RankingWeights:   g.RankingWeights,
+		RankingFormula:   g.RankingFormula,
+		Modes:            g.Modes,
+		Maps:             g.Maps,
+		Playlists:        g.Playlists,
 		PlatformIDFormat: g.PlatformIDFormat,
+		MinTeamSize:      g.MinTeamSize,
+		MaxTeamSize:      g.MaxTeamSize,
 		IsActive:         g.IsActive,
 		CreatedAt:        g.CreatedAt,
 		UpdatedAt:        g.UpdatedAt,
@@ -286,8 +344,18 @@ func toGameEntity(doc *gameDocument) (*game.Game, error) {
 			if l, ok := m["label"].(string); ok {
 				field.Label = l
 			}
+			if f, ok := m["formula"].(string); ok {
+				field.Formula = f
+			}
+			if r, ok := m["rollup"].(map[string]interface{}); ok {
+				source, _ := r["source"].(string)
+				aggregate, _ := r["aggregate"].(string)
+				field.Rollup = &game.Rollup{Source: source, Aggregate: game.Aggregation(aggregate)}
+			}
 			field.Min = m["min"]
 			field.Max = m["max"]
+			field.Cap = toFloat64(m["cap"])
+			field.Scale = toFloat64(m["scale"])
 			statSchema[k] = field
 		}
 	}
@@ -299,9 +367,29 @@ func toGameEntity(doc *gameDocument) (*game.Game, error) {
 		Description:      doc.Description,
 		StatSchema:       statSchema,
 		RankingWeights:   doc.RankingWeights,
+		RankingFormula:   doc.RankingFormula,
+		Modes:            doc.Modes,
+		Maps:             doc.Maps,
+		Playlists:        doc.Playlists,
 		PlatformIDFormat: doc.PlatformIDFormat,
+		MinTeamSize:      doc.MinTeamSize,
+		MaxTeamSize:      doc.MaxTeamSize,
 		IsActive:         doc.IsActive,
 		CreatedAt:        doc.CreatedAt,
 		UpdatedAt:        doc.UpdatedAt,
 	}, nil
 }
+
+// toFloat64 converts a numeric BSON value to float64, returning 0 for anything else.
+func toFloat64(v interface{}) float64 {
+	switch n := v.(type) {
+	case float64:
+		return n
+	case int32:
+		return float64(n)
+	case int64:
+		return float64(n)
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,72 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// HonorVoteRepository implements match.HonorVoteRepository using MongoDB.
+type HonorVoteRepository struct {
+	collection *mongo.Collection
+}
+
+// NewHonorVoteRepository creates a new MongoDB honor vote repository.
+func NewHonorVoteRepository(db *mongo.Database) *HonorVoteRepository {
+	return &HonorVoteRepository{
+		collection: db.Collection("match_honor_votes"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the honor votes collection.
+func (r *HonorVoteRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			// One vote per teammate per match
+			Keys:    bson.D{{Key: "match_id", Value: 1}, {Key: "voter_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating honor vote indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new honor vote.
+func (r *HonorVoteRepository) Create(ctx context.Context, vote *match.HonorVote) error {
+	_, err := r.collection.InsertOne(ctx, vote)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return match.ErrAlreadyVoted
+		}
+		return fmt.Errorf("inserting honor vote: %w", err)
+	}
+	return nil
+}
+
+// ListByMatch retrieves a match's votes, oldest first.
+func (r *HonorVoteRepository) ListByMatch(ctx context.Context, matchID uuid.UUID) ([]*match.HonorVote, error) {
+	cursor, err := r.collection.Find(ctx,
+		bson.M{"match_id": matchID},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("finding honor votes: %w", err)
+	}
+
+	votes := []*match.HonorVote{}
+	if err := decodeAll(ctx, cursor, &votes); err != nil {
+		return nil, fmt.Errorf("decoding honor votes: %w", err)
+	}
+
+	return votes, nil
+}
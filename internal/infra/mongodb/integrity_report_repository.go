@@ -0,0 +1,57 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/integrity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// IntegrityReportRepository implements integrity.Repository using MongoDB.
+type IntegrityReportRepository struct {
+	collection *mongo.Collection
+}
+
+// NewIntegrityReportRepository creates a new MongoDB integrity report repository.
+func NewIntegrityReportRepository(db *mongo.Database) *IntegrityReportRepository {
+	return &IntegrityReportRepository{
+		collection: db.Collection("integrity_reports"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the integrity reports collection.
+func (r *IntegrityReportRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "generated_at", Value: -1}},
+	})
+	if err != nil {
+		return fmt.Errorf("creating integrity report indexes: %w", err)
+	}
+	return nil
+}
+
+// Save stores an integrity report.
+func (r *IntegrityReportRepository) Save(ctx context.Context, report *integrity.Report) error {
+	if _, err := r.collection.InsertOne(ctx, report); err != nil {
+		return fmt.Errorf("inserting integrity report: %w", err)
+	}
+	return nil
+}
+
+// GetLatest returns the most recently generated integrity report.
+func (r *IntegrityReportRepository) GetLatest(ctx context.Context) (*integrity.Report, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "generated_at", Value: -1}})
+
+	var report integrity.Report
+	if err := r.collection.FindOne(ctx, bson.M{}, opts).Decode(&report); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, integrity.ErrReportNotFound
+		}
+		return nil, fmt.Errorf("finding latest integrity report: %w", err)
+	}
+	return &report, nil
+}
@@ -0,0 +1,118 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// JoinRequestRepository implements team.JoinRequestRepository using MongoDB.
+type JoinRequestRepository struct {
+	collection *mongo.Collection
+}
+
+// NewJoinRequestRepository creates a new MongoDB join request repository.
+func NewJoinRequestRepository(db *mongo.Database) *JoinRequestRepository {
+	return &JoinRequestRepository{
+		collection: db.Collection("team_join_requests"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the join requests collection.
+func (r *JoinRequestRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			// A player can have only one pending request per team
+			Keys: bson.D{{Key: "team_id", Value: 1}, {Key: "player_id", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": team.JoinRequestPending}),
+		},
+		{
+			Keys: bson.D{{Key: "team_id", Value: 1}, {Key: "status", Value: 1}, {Key: "created_at", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "player_id", Value: 1}, {Key: "status", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating join request indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new join request.
+func (r *JoinRequestRepository) Create(ctx context.Context, req *team.JoinRequest) error {
+	_, err := r.collection.InsertOne(ctx, req)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return team.ErrJoinRequestExists
+		}
+		return fmt.Errorf("inserting join request: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a join request by its ID.
+func (r *JoinRequestRepository) GetByID(ctx context.Context, id uuid.UUID) (*team.JoinRequest, error) {
+	var req team.JoinRequest
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&req)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, team.ErrJoinRequestNotFound
+		}
+		return nil, fmt.Errorf("finding join request: %w", err)
+	}
+	return &req, nil
+}
+
+// Update updates an existing join request.
+func (r *JoinRequestRepository) Update(ctx context.Context, req *team.JoinRequest) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": req.ID}, req)
+	if err != nil {
+		return fmt.Errorf("updating join request: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return team.ErrJoinRequestNotFound
+	}
+	return nil
+}
+
+// ListPendingByTeam retrieves a team's pending requests, oldest first.
+func (r *JoinRequestRepository) ListPendingByTeam(ctx context.Context, teamID uuid.UUID) ([]*team.JoinRequest, error) {
+	return r.find(ctx,
+		bson.M{"team_id": teamID, "status": team.JoinRequestPending},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}),
+	)
+}
+
+// ListPendingByPlayer retrieves a player's pending requests, newest first.
+func (r *JoinRequestRepository) ListPendingByPlayer(ctx context.Context, playerID uuid.UUID) ([]*team.JoinRequest, error) {
+	return r.find(ctx,
+		bson.M{"player_id": playerID, "status": team.JoinRequestPending},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}),
+	)
+}
+
+func (r *JoinRequestRepository) find(ctx context.Context, filter bson.M, opts *options.FindOptions) ([]*team.JoinRequest, error) {
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding join requests: %w", err)
+	}
+
+	requests := []*team.JoinRequest{}
+	if err := decodeAll(ctx, cursor, &requests); err != nil {
+		return nil, fmt.Errorf("decoding join requests: %w", err)
+	}
+
+	return requests, nil
+}
@@ -0,0 +1,300 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/ladder"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// LadderRepository implements ladder.Repository using MongoDB.
+type LadderRepository struct {
+	collection *mongo.Collection
+}
+
+// NewLadderRepository creates a new MongoDB ladder repository.
+func NewLadderRepository(db *mongo.Database) *LadderRepository {
+	return &LadderRepository{
+		collection: db.Collection("ladders"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the ladders collection.
+func (r *LadderRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			// One ladder per game
+			Keys:    bson.D{{Key: "game_id", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating ladder indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new ladder.
+func (r *LadderRepository) Create(ctx context.Context, l *ladder.Ladder) error {
+	if _, err := r.collection.InsertOne(ctx, l); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ladder.ErrLadderExists
+		}
+		return fmt.Errorf("inserting ladder: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a ladder by its ID.
+func (r *LadderRepository) GetByID(ctx context.Context, id uuid.UUID) (*ladder.Ladder, error) {
+	var l ladder.Ladder
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&l); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ladder.ErrNotFound
+		}
+		return nil, fmt.Errorf("finding ladder: %w", err)
+	}
+	return &l, nil
+}
+
+// List returns every ladder, by name.
+func (r *LadderRepository) List(ctx context.Context) ([]*ladder.Ladder, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{}, options.Find().SetSort(bson.D{{Key: "name", Value: 1}}))
+	if err != nil {
+		return nil, fmt.Errorf("finding ladders: %w", err)
+	}
+
+	var ladders []*ladder.Ladder
+	if err := decodeAll(ctx, cursor, &ladders); err != nil {
+		return nil, fmt.Errorf("decoding ladders: %w", err)
+	}
+
+	return ladders, nil
+}
+
+// Update replaces the ladder if its stored version still matches the one it
+// was read at, and increments the version.
+func (r *LadderRepository) Update(ctx context.Context, l *ladder.Ladder) error {
+	read := l.Version
+	l.Version++
+
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": l.ID, "version": read}, l)
+	if err != nil {
+		l.Version = read
+		return fmt.Errorf("updating ladder: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		l.Version = read
+		if _, err := r.GetByID(ctx, l.ID); err != nil {
+			return err
+		}
+		return ladder.ErrConflict
+	}
+	return nil
+}
+
+// LadderChallengeRepository implements ladder.ChallengeRepository using MongoDB.
+type LadderChallengeRepository struct {
+	collection *mongo.Collection
+}
+
+// NewLadderChallengeRepository creates a new MongoDB ladder challenge repository.
+func NewLadderChallengeRepository(db *mongo.Database) *LadderChallengeRepository {
+	return &LadderChallengeRepository{
+		collection: db.Collection("ladder_challenges"),
+	}
+}
+
+// openChallengeStatuses are the statuses of undecided challenges.
+var openChallengeStatuses = []ladder.ChallengeStatus{
+	ladder.ChallengeStatusPending,
+	ladder.ChallengeStatusAccepted,
+}
+
+// EnsureIndexes creates necessary indexes for the ladder challenges collection.
+func (r *LadderChallengeRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "ladder_id", Value: 1}, {Key: "season", Value: 1}, {Key: "created_at", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "ladder_id", Value: 1}, {Key: "challenger_team_id", Value: 1}, {Key: "status", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "ladder_id", Value: 1}, {Key: "defender_team_id", Value: 1}, {Key: "status", Value: 1}},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating ladder challenge indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new challenge.
+func (r *LadderChallengeRepository) Create(ctx context.Context, c *ladder.Challenge) error {
+	if _, err := r.collection.InsertOne(ctx, c); err != nil {
+		return fmt.Errorf("inserting ladder challenge: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a challenge by its ID.
+func (r *LadderChallengeRepository) GetByID(ctx context.Context, id uuid.UUID) (*ladder.Challenge, error) {
+	var c ladder.Challenge
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&c); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ladder.ErrChallengeNotFound
+		}
+		return nil, fmt.Errorf("finding ladder challenge: %w", err)
+	}
+	return &c, nil
+}
+
+// Update saves a challenge's status and outcome.
+func (r *LadderChallengeRepository) Update(ctx context.Context, c *ladder.Challenge) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": c.ID}, c)
+	if err != nil {
+		return fmt.Errorf("updating ladder challenge: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return ladder.ErrChallengeNotFound
+	}
+	return nil
+}
+
+// ListByLadder returns a ladder season's challenges, newest first.
+func (r *LadderChallengeRepository) ListByLadder(ctx context.Context, ladderID uuid.UUID, season int) ([]*ladder.Challenge, error) {
+	cursor, err := r.collection.Find(
+		ctx,
+		bson.M{"ladder_id": ladderID, "season": season},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("finding ladder challenges: %w", err)
+	}
+
+	var challenges []*ladder.Challenge
+	if err := decodeAll(ctx, cursor, &challenges); err != nil {
+		return nil, fmt.Errorf("decoding ladder challenges: %w", err)
+	}
+
+	return challenges, nil
+}
+
+// ListOpen returns every pending or accepted challenge, oldest first.
+func (r *LadderChallengeRepository) ListOpen(ctx context.Context) ([]*ladder.Challenge, error) {
+	cursor, err := r.collection.Find(
+		ctx,
+		bson.M{"status": bson.M{"$in": openChallengeStatuses}},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("finding open ladder challenges: %w", err)
+	}
+
+	var challenges []*ladder.Challenge
+	if err := decodeAll(ctx, cursor, &challenges); err != nil {
+		return nil, fmt.Errorf("decoding ladder challenges: %w", err)
+	}
+
+	return challenges, nil
+}
+
+// HasOpen reports whether a team is in a pending or accepted challenge on the ladder.
+func (r *LadderChallengeRepository) HasOpen(ctx context.Context, ladderID, teamID uuid.UUID) (bool, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{
+		"ladder_id": ladderID,
+		"status":    bson.M{"$in": openChallengeStatuses},
+		"$or": []bson.M{
+			{"challenger_team_id": teamID},
+			{"defender_team_id": teamID},
+		},
+	}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, fmt.Errorf("counting open ladder challenges: %w", err)
+	}
+	return count > 0, nil
+}
+
+// LadderSnapshotRepository implements ladder.SnapshotRepository using MongoDB.
+type LadderSnapshotRepository struct {
+	collection *mongo.Collection
+}
+
+// NewLadderSnapshotRepository creates a new MongoDB ladder snapshot repository.
+func NewLadderSnapshotRepository(db *mongo.Database) *LadderSnapshotRepository {
+	return &LadderSnapshotRepository{
+		collection: db.Collection("ladder_snapshots"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the ladder snapshots collection.
+func (r *LadderSnapshotRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			// One weekly and one final snapshot per ladder season and week
+			Keys: bson.D{
+				{Key: "ladder_id", Value: 1},
+				{Key: "season", Value: 1},
+				{Key: "week", Value: 1},
+				{Key: "final", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "ladder_id", Value: 1}, {Key: "taken_at", Value: -1}},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating ladder snapshot indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new snapshot.
+func (r *LadderSnapshotRepository) Create(ctx context.Context, s *ladder.Snapshot) error {
+	if _, err := r.collection.InsertOne(ctx, s); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return ladder.ErrSnapshotExists
+		}
+		return fmt.Errorf("inserting ladder snapshot: %w", err)
+	}
+	return nil
+}
+
+// ListByLadder returns a ladder's snapshots, newest first. A zero season lists every season.
+func (r *LadderSnapshotRepository) ListByLadder(ctx context.Context, ladderID uuid.UUID, season int) ([]*ladder.Snapshot, error) {
+	filter := bson.M{"ladder_id": ladderID}
+	if season > 0 {
+		filter["season"] = season
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "taken_at", Value: -1}}))
+	if err != nil {
+		return nil, fmt.Errorf("finding ladder snapshots: %w", err)
+	}
+
+	var snapshots []*ladder.Snapshot
+	if err := decodeAll(ctx, cursor, &snapshots); err != nil {
+		return nil, fmt.Errorf("decoding ladder snapshots: %w", err)
+	}
+
+	return snapshots, nil
+}
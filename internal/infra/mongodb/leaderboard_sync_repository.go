@@ -0,0 +1,108 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// LeaderboardSyncRepository implements player.LeaderboardSyncRepository using MongoDB.
+type LeaderboardSyncRepository struct {
+	collection *mongo.Collection
+}
+
+// NewLeaderboardSyncRepository creates a new MongoDB leaderboard sync repository.
+func NewLeaderboardSyncRepository(db *mongo.Database) *LeaderboardSyncRepository {
+	return &LeaderboardSyncRepository{
+		collection: db.Collection("leaderboard_syncs"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the leaderboard syncs collection.
+func (r *LeaderboardSyncRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "game_id", Value: 1}, {Key: "created_at", Value: 1}}},
+		{Keys: bson.D{{Key: "enabled", Value: 1}}},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating leaderboard sync indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new leaderboard sync.
+func (r *LeaderboardSyncRepository) Create(ctx context.Context, sync *player.LeaderboardSync) error {
+	if _, err := r.collection.InsertOne(ctx, sync); err != nil {
+		return fmt.Errorf("inserting leaderboard sync: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a leaderboard sync by its ID.
+func (r *LeaderboardSyncRepository) GetByID(ctx context.Context, id uuid.UUID) (*player.LeaderboardSync, error) {
+	var sync player.LeaderboardSync
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&sync); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, player.ErrLeaderboardSyncNotFound
+		}
+		return nil, fmt.Errorf("finding leaderboard sync: %w", err)
+	}
+	return &sync, nil
+}
+
+// ListByGame returns a game's leaderboard syncs, oldest first.
+func (r *LeaderboardSyncRepository) ListByGame(ctx context.Context, gameID uuid.UUID) ([]*player.LeaderboardSync, error) {
+	return r.find(ctx, bson.M{"game_id": gameID})
+}
+
+// ListEnabled returns every enabled leaderboard sync.
+func (r *LeaderboardSyncRepository) ListEnabled(ctx context.Context) ([]*player.LeaderboardSync, error) {
+	return r.find(ctx, bson.M{"enabled": true})
+}
+
+// Update saves a leaderboard sync's settings and run status.
+func (r *LeaderboardSyncRepository) Update(ctx context.Context, sync *player.LeaderboardSync) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": sync.ID}, sync)
+	if err != nil {
+		return fmt.Errorf("updating leaderboard sync: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return player.ErrLeaderboardSyncNotFound
+	}
+	return nil
+}
+
+// Delete removes a leaderboard sync.
+func (r *LeaderboardSyncRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
+	if err != nil {
+		return fmt.Errorf("deleting leaderboard sync: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return player.ErrLeaderboardSyncNotFound
+	}
+	return nil
+}
+
+func (r *LeaderboardSyncRepository) find(ctx context.Context, filter bson.M) ([]*player.LeaderboardSync, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding leaderboard syncs: %w", err)
+	}
+
+	syncs := []*player.LeaderboardSync{}
+	if err := decodeAll(ctx, cursor, &syncs); err != nil {
+		return nil, fmt.Errorf("decoding leaderboard syncs: %w", err)
+	}
+	return syncs, nil
+}
@@ -0,0 +1,142 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+)
+
+const (
+	// MatchCommentsCollection is the MongoDB collection name for match comments.
+	MatchCommentsCollection = "match_comments"
+)
+
+// matchCommentDocument represents the MongoDB document structure for a match comment.
+type matchCommentDocument struct {
+	ID        string    `bson:"_id"`
+	MatchID   string    `bson:"match_id"`
+	AuthorID  string    `bson:"author_id"`
+	Body      string    `bson:"body"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+// MatchCommentRepository implements match comment persistence using MongoDB.
+type MatchCommentRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMatchCommentRepository creates a new MatchCommentRepository.
+func NewMatchCommentRepository(db *mongo.Database) *MatchCommentRepository {
+	return &MatchCommentRepository{
+		collection: db.Collection(MatchCommentsCollection),
+	}
+}
+
+// EnsureIndexes creates the necessary MongoDB indexes for match comments.
+func (r *MatchCommentRepository) EnsureIndexes(ctx context.Context) error {
+	indexModel := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "match_id", Value: 1}, {Key: "created_at", Value: 1}},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexModel)
+	if err != nil {
+		return fmt.Errorf("create indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create inserts a new comment into the database.
+func (r *MatchCommentRepository) Create(ctx context.Context, c *match.Comment) error {
+	doc := matchCommentDocument{
+		ID:        c.ID.String(),
+		MatchID:   c.MatchID.String(),
+		AuthorID:  c.AuthorID.String(),
+		Body:      c.Body,
+		CreatedAt: c.CreatedAt,
+	}
+
+	_, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		return fmt.Errorf("insert match comment: %w", err)
+	}
+
+	return nil
+}
+
+// ListByMatch retrieves a match's comments, oldest first.
+func (r *MatchCommentRepository) ListByMatch(ctx context.Context, matchID string) ([]match.Comment, error) {
+	return r.find(ctx, bson.M{"match_id": matchID})
+}
+
+// ListByMatches retrieves the comments on several matches, oldest first.
+func (r *MatchCommentRepository) ListByMatches(ctx context.Context, matchIDs []string) ([]match.Comment, error) {
+	if len(matchIDs) == 0 {
+		return []match.Comment{}, nil
+	}
+	return r.find(ctx, bson.M{"match_id": bson.M{"$in": matchIDs}})
+}
+
+func (r *MatchCommentRepository) find(ctx context.Context, filter bson.M) ([]match.Comment, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find match comments: %w", err)
+	}
+	defer closeCursor(cursor)
+
+	comments := []match.Comment{}
+	for cursor.Next(ctx) {
+		var doc matchCommentDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode match comment: %w", err)
+		}
+
+		c, err := toMatchCommentEntity(&doc)
+		if err != nil {
+			return nil, err
+		}
+		comments = append(comments, *c)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return comments, nil
+}
+
+func toMatchCommentEntity(doc *matchCommentDocument) (*match.Comment, error) {
+	id, err := uuid.Parse(doc.ID)
+	if err != nil {
+		return nil, fmt.Errorf("parse comment id: %w", err)
+	}
+
+	matchID, err := uuid.Parse(doc.MatchID)
+	if err != nil {
+		return nil, fmt.Errorf("parse match id: %w", err)
+	}
+
+	authorID, err := uuid.Parse(doc.AuthorID)
+	if err != nil {
+		return nil, fmt.Errorf("parse author id: %w", err)
+	}
+
+	return &match.Comment{
+		ID:        id,
+		MatchID:   matchID,
+		AuthorID:  authorID,
+		Body:      doc.Body,
+		CreatedAt: doc.CreatedAt,
+	}, nil
+}
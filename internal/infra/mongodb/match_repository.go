@@ -26,17 +26,72 @@ type matchDocument struct {
 	TournamentID    string                     `bson:"tournament_id"`
 	TeamID          string                     `bson:"team_id"`
 	GameID          string                     `bson:"game_id"`
+	Mode            string                     `bson:"mode,omitempty"`
+	Map             string                     `bson:"map,omitempty"`
+	Playlist        string                     `bson:"playlist,omitempty"`
 	Status          string                     `bson:"status"`
 	TeamPlacement   int                        `bson:"team_placement"`
 	TeamKills       int                        `bson:"team_kills"`
 	PlayerStats     []playerMatchStatsDocument `bson:"player_stats"`
 	ScreenshotURL   string                     `bson:"screenshot_url"`
+	Attachments     []match.Attachment         `bson:"attachments,omitempty"`
 	RejectionReason string                     `bson:"rejection_reason,omitempty"`
 	SubmittedBy     string                     `bson:"submitted_by"`
 	CreatedAt       time.Time                  `bson:"created_at"`
 	UpdatedAt       time.Time                  `bson:"updated_at"`
 	VerifiedAt      *time.Time                 `bson:"verified_at,omitempty"`
 	VerifiedBy      *string                    `bson:"verified_by,omitempty"`
+	Custody         *match.Custody             `bson:"custody,omitempty"`
+	Snapshot        *matchSnapshotDocument     `bson:"verified_snapshot,omitempty"`
+	Amendments      []matchAmendmentDocument   `bson:"amendments,omitempty"`
+	Reversals       []matchReversalDocument    `bson:"reversals,omitempty"`
+	Revisions       []matchRevisionDocument    `bson:"revisions,omitempty"`
+	WrittenRegion   string                     `bson:"written_region,omitempty"` // Region of the instance that last wrote the match
+}
+
+// matchSnapshotDocument represents a match's result as it was verified.
+type matchSnapshotDocument struct {
+	TeamPlacement int                        `bson:"team_placement"`
+	TeamKills     int                        `bson:"team_kills"`
+	PlayerStats   []playerMatchStatsDocument `bson:"player_stats"`
+	StatsSHA256   string                     `bson:"stats_sha256"`
+	VerifiedBy    string                     `bson:"verified_by"`
+	VerifiedAt    time.Time                  `bson:"verified_at"`
+}
+
+// matchReversalDocument represents a withdrawn verification.
+type matchReversalDocument struct {
+	Reason     string                 `bson:"reason"`
+	ReversedBy string                 `bson:"reversed_by"`
+	ReversedAt time.Time              `bson:"reversed_at"`
+	Snapshot   *matchSnapshotDocument `bson:"snapshot,omitempty"`
+}
+
+// matchRevisionDocument represents a rejected version of a match replaced by
+// a resubmission.
+type matchRevisionDocument struct {
+	TeamPlacement   int                        `bson:"team_placement"`
+	TeamKills       int                        `bson:"team_kills"`
+	PlayerStats     []playerMatchStatsDocument `bson:"player_stats"`
+	ScreenshotURL   string                     `bson:"screenshot_url"`
+	Attachments     []match.Attachment         `bson:"attachments,omitempty"`
+	SubmittedBy     string                     `bson:"submitted_by"`
+	Custody         *match.Custody             `bson:"custody,omitempty"`
+	RejectionReason string                     `bson:"rejection_reason"`
+	RejectedBy      string                     `bson:"rejected_by"`
+	RejectedAt      time.Time                  `bson:"rejected_at"`
+	ReplacedAt      time.Time                  `bson:"replaced_at"`
+}
+
+// matchAmendmentDocument represents a correction made to a verified match.
+type matchAmendmentDocument struct {
+	ID            string                     `bson:"id"`
+	TeamPlacement int                        `bson:"team_placement"`
+	TeamKills     int                        `bson:"team_kills"`
+	PlayerStats   []playerMatchStatsDocument `bson:"player_stats"`
+	Reason        string                     `bson:"reason"`
+	AmendedBy     string                     `bson:"amended_by"`
+	AmendedAt     time.Time                  `bson:"amended_at"`
 }
 
 // playerMatchStatsDocument represents player stats for a match.
@@ -53,6 +108,7 @@ type playerMatchStatsDocument struct {
 // MatchRepository implements match persistence using MongoDB.
 type MatchRepository struct {
 	collection *mongo.Collection
+	region     string
 }
 
 // NewMatchRepository creates a new MatchRepository.
@@ -62,6 +118,21 @@ func NewMatchRepository(db *mongo.Database) *MatchRepository {
 	}
 }
 
+// WithRegion stamps every match write with the region it was made in, so
+// conflicting edits from different regions can be traced.
+func (r *MatchRepository) WithRegion(region string) *MatchRepository {
+	r.region = region
+	return r
+}
+
+// stamp adds the writing region to a $set document.
+func (r *MatchRepository) stamp(set bson.M) bson.M {
+	if r.region != "" {
+		set["written_region"] = r.region
+	}
+	return set
+}
+
 // EnsureIndexes creates the necessary MongoDB indexes for matches.
 func (r *MatchRepository) EnsureIndexes(ctx context.Context) error {
 	indexModel := []mongo.IndexModel{
@@ -80,6 +151,14 @@ func (r *MatchRepository) EnsureIndexes(ctx context.Context) error {
 		{
 			Keys: bson.D{{Key: "player_stats.player_id", Value: 1}, {Key: "created_at", Value: -1}},
 		},
+		{
+			Keys: bson.D{
+				{Key: "player_stats.player_id", Value: 1},
+				{Key: "game_id", Value: 1},
+				{Key: "status", Value: 1},
+				{Key: "verified_at", Value: -1},
+			},
+		},
 	}
 
 	_, err := r.collection.Indexes().CreateMany(ctx, indexModel)
@@ -93,6 +172,7 @@ func (r *MatchRepository) EnsureIndexes(ctx context.Context) error {
 // Create inserts a new match into the database.
 func (r *MatchRepository) Create(ctx context.Context, m *match.Match) error {
 	doc := toMatchDocument(m)
+	doc.WrittenRegion = r.region
 
 	_, err := r.collection.InsertOne(ctx, doc)
 	if err != nil {
@@ -117,54 +197,210 @@ func (r *MatchRepository) GetByID(ctx context.Context, id string) (*match.Match,
 	return toMatchEntity(&doc)
 }
 
-// GetByTournament retrieves all matches in a tournament with pagination.
-func (r *MatchRepository) GetByTournament(ctx context.Context, tournamentID string, limit int, offset int) ([]match.Match, error) {
+// GetDetail retrieves a match by ID, joining the game and player display names in one aggregation.
+func (r *MatchRepository) GetDetail(ctx context.Context, id string) (*match.Detail, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"_id": id}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         GamesCollection,
+			"localField":   "game_id",
+			"foreignField": "_id",
+			"as":           "game_info",
+		}}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         PlayersCollection,
+			"localField":   "player_stats.player_id",
+			"foreignField": "_id",
+			"as":           "player_info",
+		}}},
+		{{Key: "$addFields", Value: bson.M{
+			"game_name": bson.M{"$first": "$game_info.name"},
+			"player_info": bson.M{"$map": bson.M{
+				"input": "$player_info",
+				"in":    bson.M{"_id": "$$this._id", "display_name": "$$this.display_name"},
+			}},
+		}}},
+		{{Key: "$project", Value: bson.M{"game_info": 0}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate match detail: %w", err)
+	}
+	defer closeCursor(cursor)
+
+	if !cursor.Next(ctx) {
+		if err := cursor.Err(); err != nil {
+			return nil, fmt.Errorf("cursor error: %w", err)
+		}
+		return nil, match.ErrNotFound
+	}
+
+	var doc struct {
+		matchDocument `bson:",inline"`
+		GameName      string `bson:"game_name"`
+		PlayerInfo    []struct {
+			ID          string `bson:"_id"`
+			DisplayName string `bson:"display_name"`
+		} `bson:"player_info"`
+	}
+	if err := cursor.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("decode match detail: %w", err)
+	}
+
+	m, err := toMatchEntity(&doc.matchDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[uuid.UUID]string, len(doc.PlayerInfo))
+	for _, p := range doc.PlayerInfo {
+		playerID, err := uuid.Parse(p.ID)
+		if err != nil {
+			return nil, fmt.Errorf("parse player id: %w", err)
+		}
+		names[playerID] = p.DisplayName
+	}
+
+	return &match.Detail{
+		Match:       *m,
+		GameName:    doc.GameName,
+		PlayerNames: names,
+	}, nil
+}
+
+// GetByTournament retrieves a tournament's matches, newest first. An empty status matches all statuses.
+func (r *MatchRepository) GetByTournament(ctx context.Context, tournamentID string, status match.Status, limit int, offset int) ([]match.Match, error) {
 	opts := options.Find().
 		SetSort(bson.D{{Key: "created_at", Value: -1}}).
 		SetLimit(int64(limit)).
 		SetSkip(int64(offset))
 
-	cursor, err := r.collection.Find(ctx, bson.M{"tournament_id": tournamentID}, opts)
+	cursor, err := r.collection.Find(ctx, withStatus(bson.M{"tournament_id": tournamentID}, status), opts)
 	if err != nil {
 		return nil, fmt.Errorf("find matches by tournament: %w", err)
 	}
-	defer cursor.Close(ctx)
+	defer closeCursor(cursor)
 
 	return decodeMatches(ctx, cursor)
 }
 
-// GetByTeam retrieves all matches for a specific team.
-func (r *MatchRepository) GetByTeam(ctx context.Context, teamID string, limit int, offset int) ([]match.Match, error) {
+// GetByTeam retrieves a team's matches, newest first. An empty status matches all statuses.
+func (r *MatchRepository) GetByTeam(ctx context.Context, teamID string, status match.Status, limit int, offset int) ([]match.Match, error) {
 	opts := options.Find().
 		SetSort(bson.D{{Key: "created_at", Value: -1}}).
 		SetLimit(int64(limit)).
 		SetSkip(int64(offset))
 
-	cursor, err := r.collection.Find(ctx, bson.M{"team_id": teamID}, opts)
+	cursor, err := r.collection.Find(ctx, withStatus(bson.M{"team_id": teamID}, status), opts)
 	if err != nil {
 		return nil, fmt.Errorf("find matches by team: %w", err)
 	}
-	defer cursor.Close(ctx)
+	defer closeCursor(cursor)
 
 	return decodeMatches(ctx, cursor)
 }
 
-// GetByPlayer retrieves all matches involving a specific player.
-func (r *MatchRepository) GetByPlayer(ctx context.Context, playerID string, limit int, offset int) ([]match.Match, error) {
+// CountByTeam returns the number of a team's matches. An empty status matches all statuses.
+func (r *MatchRepository) CountByTeam(ctx context.Context, teamID string, status match.Status) (int, error) {
+	count, err := r.collection.CountDocuments(ctx, withStatus(bson.M{"team_id": teamID}, status))
+	if err != nil {
+		return 0, fmt.Errorf("count matches by team: %w", err)
+	}
+	return int(count), nil
+}
+
+// GetByPlayer retrieves the matches a player played in, newest first. An empty status matches all statuses.
+func (r *MatchRepository) GetByPlayer(ctx context.Context, playerID string, status match.Status, limit int, offset int) ([]match.Match, error) {
 	opts := options.Find().
 		SetSort(bson.D{{Key: "created_at", Value: -1}}).
 		SetLimit(int64(limit)).
 		SetSkip(int64(offset))
 
+	cursor, err := r.collection.Find(ctx, withStatus(bson.M{"player_stats.player_id": playerID}, status), opts)
+	if err != nil {
+		return nil, fmt.Errorf("find matches by player: %w", err)
+	}
+	defer closeCursor(cursor)
+
+	return decodeMatches(ctx, cursor)
+}
+
+// CountByPlayer returns the number of matches a player played in. An empty status matches all statuses.
+func (r *MatchRepository) CountByPlayer(ctx context.Context, playerID string, status match.Status) (int, error) {
+	count, err := r.collection.CountDocuments(ctx, withStatus(bson.M{"player_stats.player_id": playerID}, status))
+	if err != nil {
+		return 0, fmt.Errorf("count matches by player: %w", err)
+	}
+	return int(count), nil
+}
+
+// withStatus narrows a match filter to a status; an empty status leaves it as it is.
+func withStatus(filter bson.M, status match.Status) bson.M {
+	if status != "" {
+		filter["status"] = string(status)
+	}
+	return filter
+}
+
+// GetVerifiedByPlayerAndGame retrieves a player's most recently verified matches in a game.
+// An empty mode matches all modes.
+func (r *MatchRepository) GetVerifiedByPlayerAndGame(ctx context.Context, playerID string, gameID string, mode string, limit int) ([]match.Match, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "verified_at", Value: -1}}).
+		SetLimit(int64(limit))
+
 	filter := bson.M{
 		"player_stats.player_id": playerID,
+		"game_id":                gameID,
+		"status":                 string(match.StatusVerified),
+	}
+	if mode != "" {
+		filter["mode"] = mode
 	}
 
 	cursor, err := r.collection.Find(ctx, filter, opts)
 	if err != nil {
-		return nil, fmt.Errorf("find matches by player: %w", err)
+		return nil, fmt.Errorf("find verified matches by player: %w", err)
 	}
-	defer cursor.Close(ctx)
+	defer closeCursor(cursor)
+
+	return decodeMatches(ctx, cursor)
+}
+
+// GetVerifiedForCorrection retrieves the verified matches a stat correction
+// filter selects, oldest verified first.
+func (r *MatchRepository) GetVerifiedForCorrection(ctx context.Context, f match.CorrectionFilter, limit int) ([]match.Match, error) {
+	opts := options.Find().
+		SetSort(bson.D{{Key: "verified_at", Value: 1}}).
+		SetLimit(int64(limit))
+
+	filter := bson.M{
+		"game_id": f.GameID.String(),
+		"status":  string(match.StatusVerified),
+	}
+	if f.TournamentID != nil {
+		filter["tournament_id"] = f.TournamentID.String()
+	}
+	if f.PlayerID != nil {
+		filter["player_stats.player_id"] = f.PlayerID.String()
+	}
+	if f.VerifiedFrom != nil || f.VerifiedTo != nil {
+		window := bson.M{}
+		if f.VerifiedFrom != nil {
+			window["$gte"] = *f.VerifiedFrom
+		}
+		if f.VerifiedTo != nil {
+			window["$lt"] = *f.VerifiedTo
+		}
+		filter["verified_at"] = window
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find verified matches for correction: %w", err)
+	}
+	defer closeCursor(cursor)
 
 	return decodeMatches(ctx, cursor)
 }
@@ -180,7 +416,7 @@ func (r *MatchRepository) GetUnverified(ctx context.Context, limit int, offset i
 	if err != nil {
 		return nil, fmt.Errorf("find unverified matches: %w", err)
 	}
-	defer cursor.Close(ctx)
+	defer closeCursor(cursor)
 
 	return decodeMatches(ctx, cursor)
 }
@@ -201,36 +437,408 @@ func (r *MatchRepository) GetTournamentUnverified(ctx context.Context, tournamen
 	if err != nil {
 		return nil, fmt.Errorf("find tournament unverified matches: %w", err)
 	}
-	defer cursor.Close(ctx)
+	defer closeCursor(cursor)
 
 	return decodeMatches(ctx, cursor)
 }
 
-// Update updates an existing match.
+// Update replaces a draft match. Reviewed matches can only change through
+// Review and Amend, so their verified result cannot be overwritten.
 func (r *MatchRepository) Update(ctx context.Context, m *match.Match) error {
 	doc := toMatchDocument(m)
+	doc.WrittenRegion = r.region
 
-	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": m.ID.String()}, doc)
+	filter := bson.M{"_id": m.ID.String(), "status": string(match.StatusDraft)}
+	result, err := r.collection.ReplaceOne(ctx, filter, doc)
 	if err != nil {
 		return fmt.Errorf("update match: %w", err)
 	}
 
 	if result.MatchedCount == 0 {
-		return match.ErrNotFound
+		return r.missed(ctx, m.ID, match.ErrMatchNotDraft)
+	}
+
+	return nil
+}
+
+// Review stores the verification or rejection of a draft match.
+func (r *MatchRepository) Review(ctx context.Context, m *match.Match) error {
+	doc := toMatchDocument(m)
+
+	set := bson.M{
+		"status":           doc.Status,
+		"rejection_reason": doc.RejectionReason,
+		"verified_at":      doc.VerifiedAt,
+		"verified_by":      doc.VerifiedBy,
+		"updated_at":       doc.UpdatedAt,
+	}
+	if doc.Snapshot != nil {
+		set["verified_snapshot"] = doc.Snapshot
+	}
+
+	filter := bson.M{"_id": doc.ID, "status": string(match.StatusDraft)}
+	result, err := r.collection.UpdateOne(ctx, filter, bson.M{"$set": r.stamp(set)})
+	if err != nil {
+		return fmt.Errorf("review match: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return r.missed(ctx, m.ID, match.ErrMatchNotDraft)
 	}
 
 	return nil
 }
 
-// CountByTournament returns the total number of matches in a tournament.
-func (r *MatchRepository) CountByTournament(ctx context.Context, tournamentID string) (int, error) {
-	count, err := r.collection.CountDocuments(ctx, bson.M{"tournament_id": tournamentID})
+// Amend stores a verified match's corrected result and appends the amendment.
+// The verified snapshot is never part of the update.
+func (r *MatchRepository) Amend(ctx context.Context, m *match.Match, amendment *match.Amendment) error {
+	update := bson.M{
+		"$set": r.stamp(bson.M{
+			"team_placement": m.TeamPlacement,
+			"team_kills":     m.TeamKills,
+			"player_stats":   toPlayerStatsDocuments(m.PlayerStats),
+			"updated_at":     m.UpdatedAt,
+		}),
+		"$push": bson.M{"amendments": toAmendmentDocument(amendment)},
+	}
+
+	filter := bson.M{"_id": m.ID.String(), "status": string(match.StatusVerified)}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("amend match: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return r.missed(ctx, m.ID, match.ErrMatchNotVerified)
+	}
+
+	return nil
+}
+
+// Unverify withdraws a verified match's verification, moving it to draft or
+// voided, and appends the reversal, which carries the verified snapshot away
+// from the match.
+func (r *MatchRepository) Unverify(ctx context.Context, m *match.Match, reversal *match.Reversal) error {
+	update := bson.M{
+		"$set": r.stamp(bson.M{
+			"status":     string(m.Status),
+			"updated_at": m.UpdatedAt,
+		}),
+		"$unset": bson.M{
+			"verified_at":       "",
+			"verified_by":       "",
+			"verified_snapshot": "",
+		},
+		"$push": bson.M{"reversals": toReversalDocument(reversal)},
+	}
+
+	filter := bson.M{"_id": m.ID.String(), "status": string(match.StatusVerified)}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("unverify match: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return r.missed(ctx, m.ID, match.ErrMatchNotVerified)
+	}
+
+	return nil
+}
+
+// Resubmit stores a rejected match's corrected result as a draft and appends
+// the revision it replaced.
+func (r *MatchRepository) Resubmit(ctx context.Context, m *match.Match, revision *match.Revision) error {
+	update := bson.M{
+		"$set": r.stamp(bson.M{
+			"status":         string(m.Status),
+			"team_placement": m.TeamPlacement,
+			"team_kills":     m.TeamKills,
+			"player_stats":   toPlayerStatsDocuments(m.PlayerStats),
+			"screenshot_url": m.ScreenshotURL,
+			"attachments":    m.Attachments,
+			"submitted_by":   m.SubmittedBy.String(),
+			"custody":        m.Custody,
+			"updated_at":     m.UpdatedAt,
+		}),
+		"$unset": bson.M{
+			"rejection_reason": "",
+			"verified_at":      "",
+			"verified_by":      "",
+		},
+		"$push": bson.M{"revisions": toRevisionDocument(revision)},
+	}
+
+	filter := bson.M{"_id": m.ID.String(), "status": string(match.StatusRejected)}
+	result, err := r.collection.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return fmt.Errorf("resubmit match: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return r.missed(ctx, m.ID, match.ErrMatchNotRejected)
+	}
+
+	return nil
+}
+
+// missed explains why a guarded write matched nothing: the match either does
+// not exist or is not in the status the write requires.
+func (r *MatchRepository) missed(ctx context.Context, id uuid.UUID, statusErr error) error {
+	count, err := r.collection.CountDocuments(ctx, bson.M{"_id": id.String()})
+	if err != nil {
+		return fmt.Errorf("check match: %w", err)
+	}
+	if count == 0 {
+		return match.ErrNotFound
+	}
+	return statusErr
+}
+
+// CountByTournament returns the number of matches in a tournament. An empty status matches all statuses.
+func (r *MatchRepository) CountByTournament(ctx context.Context, tournamentID string, status match.Status) (int, error) {
+	count, err := r.collection.CountDocuments(ctx, withStatus(bson.M{"tournament_id": tournamentID}, status))
 	if err != nil {
 		return 0, fmt.Errorf("count matches by tournament: %w", err)
 	}
 	return int(count), nil
 }
 
+// tournamentFacetDocument is the result of the tournament analytics aggregation.
+type tournamentFacetDocument struct {
+	Submissions []struct {
+		Start     time.Time `bson:"_id"`
+		Submitted int       `bson:"submitted"`
+		Verified  int       `bson:"verified"`
+		Rejected  int       `bson:"rejected"`
+	} `bson:"submissions"`
+	Turnaround []struct {
+		Reviewed int     `bson:"reviewed"`
+		Avg      float64 `bson:"avg"`
+		Min      float64 `bson:"min"`
+		Max      float64 `bson:"max"`
+	} `bson:"turnaround"`
+	Kills []struct {
+		Kills int `bson:"_id"`
+		Count int `bson:"count"`
+	} `bson:"kills"`
+	Placements []struct {
+		ID struct {
+			Map       string `bson:"map"`
+			Placement int    `bson:"placement"`
+		} `bson:"_id"`
+		Count int `bson:"count"`
+	} `bson:"placements"`
+	Teams []struct {
+		Count int `bson:"count"`
+	} `bson:"teams"`
+}
+
+// AggregateTournament computes submission, review and result aggregates for a
+// tournament in a single round trip. Review turnaround covers both verified and
+// rejected matches; kill and placement aggregates cover verified matches only.
+func (r *MatchRepository) AggregateTournament(ctx context.Context, tournamentID string, unit match.BucketUnit) (*match.TournamentAggregates, error) {
+	verified := string(match.StatusVerified)
+	countStatus := func(status match.Status) bson.M {
+		return bson.M{"$sum": bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$status", string(status)}}, 1, 0}}}
+	}
+
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"tournament_id": tournamentID}}},
+		{{Key: "$facet", Value: bson.M{
+			"submissions": bson.A{
+				bson.M{"$group": bson.M{
+					"_id":       bson.M{"$dateTrunc": bson.M{"date": "$created_at", "unit": string(unit)}},
+					"submitted": bson.M{"$sum": 1},
+					"verified":  countStatus(match.StatusVerified),
+					"rejected":  countStatus(match.StatusRejected),
+				}},
+				bson.M{"$sort": bson.M{"_id": 1}},
+			},
+			"turnaround": bson.A{
+				bson.M{"$match": bson.M{"verified_at": bson.M{"$ne": nil}}},
+				bson.M{"$project": bson.M{
+					"minutes": bson.M{"$divide": bson.A{bson.M{"$subtract": bson.A{"$verified_at", "$created_at"}}, 60000}},
+				}},
+				bson.M{"$group": bson.M{
+					"_id":      nil,
+					"reviewed": bson.M{"$sum": 1},
+					"avg":      bson.M{"$avg": "$minutes"},
+					"min":      bson.M{"$min": "$minutes"},
+					"max":      bson.M{"$max": "$minutes"},
+				}},
+			},
+			"kills": bson.A{
+				bson.M{"$match": bson.M{"status": verified}},
+				bson.M{"$unwind": "$player_stats"},
+				bson.M{"$group": bson.M{"_id": "$player_stats.kills", "count": bson.M{"$sum": 1}}},
+			},
+			"placements": bson.A{
+				bson.M{"$match": bson.M{"status": verified}},
+				bson.M{"$group": bson.M{
+					"_id":   bson.M{"map": "$map", "placement": "$team_placement"},
+					"count": bson.M{"$sum": 1},
+				}},
+			},
+			"teams": bson.A{
+				bson.M{"$group": bson.M{"_id": "$team_id"}},
+				bson.M{"$count": "count"},
+			},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate tournament matches: %w", err)
+	}
+	defer closeCursor(cursor)
+
+	var doc tournamentFacetDocument
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode tournament aggregates: %w", err)
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	agg := &match.TournamentAggregates{
+		Submissions: make([]match.SubmissionBucket, 0, len(doc.Submissions)),
+		KillCounts:  make(map[int]int, len(doc.Kills)),
+		Placements:  make([]match.PlacementCount, 0, len(doc.Placements)),
+	}
+	for _, s := range doc.Submissions {
+		agg.Submissions = append(agg.Submissions, match.SubmissionBucket{
+			Start:     s.Start.UTC(),
+			Submitted: s.Submitted,
+			Verified:  s.Verified,
+			Rejected:  s.Rejected,
+		})
+	}
+	if len(doc.Turnaround) > 0 {
+		t := doc.Turnaround[0]
+		agg.Turnaround = match.Turnaround{Reviewed: t.Reviewed, AvgMinutes: t.Avg, MinMinutes: t.Min, MaxMinutes: t.Max}
+	}
+	for _, k := range doc.Kills {
+		agg.KillCounts[k.Kills] += k.Count
+	}
+	for _, p := range doc.Placements {
+		agg.Placements = append(agg.Placements, match.PlacementCount{Map: p.ID.Map, Placement: p.ID.Placement, Count: p.Count})
+	}
+	if len(doc.Teams) > 0 {
+		agg.ActiveTeams = doc.Teams[0].Count
+	}
+
+	return agg, nil
+}
+
+// CountVerifiedSince returns the number of matches verified at or after since.
+func (r *MatchRepository) CountVerifiedSince(ctx context.Context, since time.Time) (int, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{
+		"status":      string(match.StatusVerified),
+		"verified_at": bson.M{"$gte": since},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("count verified matches: %w", err)
+	}
+	return int(count), nil
+}
+
+// GetPlayerActiveWeeks returns the distinct (player, week) pairs with a verified
+// match since the given time. Weeks start on Monday, UTC.
+func (r *MatchRepository) GetPlayerActiveWeeks(ctx context.Context, since time.Time) ([]match.PlayerWeek, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"status":     string(match.StatusVerified),
+			"created_at": bson.M{"$gte": since},
+		}}},
+		{{Key: "$unwind", Value: "$player_stats"}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{
+				"player_id": "$player_stats.player_id",
+				"week": bson.M{"$dateTrunc": bson.M{
+					"date":        "$created_at",
+					"unit":        "week",
+					"startOfWeek": "monday",
+				}},
+			},
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate player active weeks: %w", err)
+	}
+	defer closeCursor(cursor)
+
+	var weeks []match.PlayerWeek
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID struct {
+				PlayerID string    `bson:"player_id"`
+				Week     time.Time `bson:"week"`
+			} `bson:"_id"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode player active week: %w", err)
+		}
+
+		playerID, err := uuid.Parse(doc.ID.PlayerID)
+		if err != nil {
+			return nil, fmt.Errorf("parse player id: %w", err)
+		}
+		weeks = append(weeks, match.PlayerWeek{PlayerID: playerID, Week: doc.ID.Week.UTC()})
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return weeks, nil
+}
+
+// CountPlayerMatchesByDay returns how many verified matches a player played in
+// a game on each UTC day since the given time, oldest first.
+func (r *MatchRepository) CountPlayerMatchesByDay(ctx context.Context, playerID string, gameID string, since time.Time) ([]match.DayCount, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{
+			"status":                 string(match.StatusVerified),
+			"game_id":                gameID,
+			"player_stats.player_id": playerID,
+			"created_at":             bson.M{"$gte": since},
+		}}},
+		{{Key: "$group", Value: bson.M{
+			"_id":     bson.M{"$dateTrunc": bson.M{"date": "$created_at", "unit": "day"}},
+			"matches": bson.M{"$sum": 1},
+		}}},
+		{{Key: "$sort", Value: bson.M{"_id": 1}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate player matches by day: %w", err)
+	}
+	defer closeCursor(cursor)
+
+	var days []match.DayCount
+	for cursor.Next(ctx) {
+		var doc struct {
+			Day     time.Time `bson:"_id"`
+			Matches int       `bson:"matches"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode player day count: %w", err)
+		}
+		days = append(days, match.DayCount{Day: doc.Day.UTC(), Matches: doc.Matches})
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return days, nil
+}
+
 // CountUnverified returns total unverified matches.
 func (r *MatchRepository) CountUnverified(ctx context.Context) (int, error) {
 	count, err := r.collection.CountDocuments(ctx, bson.M{"status": string(match.StatusDraft)})
@@ -254,37 +862,76 @@ func (r *MatchRepository) DeleteByID(ctx context.Context, id string) error {
 	return nil
 }
 
-// Helper functions
+// FindWithMissingTeam returns up to limit matches whose team_id matches no
+// team. Matches store team IDs as strings while teams are keyed by UUID, so
+// the two ID sets are compared here rather than joined with $lookup.
+func (r *MatchRepository) FindWithMissingTeam(ctx context.Context, limit int64) ([]match.Match, error) {
+	teamIDs, err := r.collection.Distinct(ctx, "team_id", bson.M{})
+	if err != nil {
+		return nil, fmt.Errorf("distinct match team ids: %w", err)
+	}
 
-func toMatchDocument(m *match.Match) *matchDocument {
-	playerStats := make([]playerMatchStatsDocument, len(m.PlayerStats))
-	for i, ps := range m.PlayerStats {
-		playerStats[i] = playerMatchStatsDocument{
-			PlayerID:    ps.PlayerID.String(),
-			Kills:       ps.Kills,
-			Damage:      ps.Damage,
-			Assists:     ps.Assists,
-			Deaths:      ps.Deaths,
-			Downs:       ps.Downs,
-			CustomStats: ps.CustomStats,
+	cursor, err := r.collection.Database().Collection(TeamsCollection).Find(ctx, bson.M{},
+		options.Find().SetProjection(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("find team ids: %w", err)
+	}
+	var teams []struct {
+		ID uuid.UUID `bson:"_id"`
+	}
+	if err := decodeAll(ctx, cursor, &teams); err != nil {
+		return nil, fmt.Errorf("decode team ids: %w", err)
+	}
+	existing := make(map[string]bool, len(teams))
+	for _, t := range teams {
+		existing[t.ID.String()] = true
+	}
+
+	missing := bson.A{}
+	for _, v := range teamIDs {
+		if id, ok := v.(string); ok && !existing[id] {
+			missing = append(missing, id)
 		}
 	}
+	if len(missing) == 0 {
+		return nil, nil
+	}
+
+	cursor, err = r.collection.Find(ctx, bson.M{"team_id": bson.M{"$in": missing}},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: 1}}).SetLimit(limit))
+	if err != nil {
+		return nil, fmt.Errorf("find matches with missing team: %w", err)
+	}
+	defer closeCursor(cursor)
+
+	return decodeMatches(ctx, cursor)
+}
+
+// Helper functions
+
+func toMatchDocument(m *match.Match) *matchDocument {
+	playerStats := toPlayerStatsDocuments(m.PlayerStats)
 
 	doc := &matchDocument{
 		ID:              m.ID.String(),
 		TournamentID:    m.TournamentID.String(),
 		TeamID:          m.TeamID.String(),
 		GameID:          m.GameID.String(),
+		Mode:            m.Mode,
+		Map:             m.Map,
+		Playlist:        m.Playlist,
 		Status:          string(m.Status),
 		TeamPlacement:   m.TeamPlacement,
 		TeamKills:       m.TeamKills,
 		PlayerStats:     playerStats,
 		ScreenshotURL:   m.ScreenshotURL,
+		Attachments:     m.Attachments,
 		RejectionReason: m.RejectionReason,
 		SubmittedBy:     m.SubmittedBy.String(),
 		CreatedAt:       m.CreatedAt,
 		UpdatedAt:       m.UpdatedAt,
 		VerifiedAt:      m.VerifiedAt,
+		Custody:         m.Custody,
 	}
 
 	if m.VerifiedBy != nil {
@@ -292,17 +939,120 @@ func toMatchDocument(m *match.Match) *matchDocument {
 		doc.VerifiedBy = &verifiedByStr
 	}
 
+	doc.Snapshot = toSnapshotDocument(m.VerifiedSnapshot)
+
+	for i := range m.Amendments {
+		doc.Amendments = append(doc.Amendments, toAmendmentDocument(&m.Amendments[i]))
+	}
+
+	for i := range m.Reversals {
+		doc.Reversals = append(doc.Reversals, toReversalDocument(&m.Reversals[i]))
+	}
+
+	for i := range m.Revisions {
+		doc.Revisions = append(doc.Revisions, toRevisionDocument(&m.Revisions[i]))
+	}
+
 	return doc
 }
 
-func toMatchEntity(doc *matchDocument) (*match.Match, error) {
-	playerStats := make([]match.PlayerMatchStats, len(doc.PlayerStats))
-	for i, ps := range doc.PlayerStats {
+func toSnapshotDocument(snap *match.Snapshot) *matchSnapshotDocument {
+	if snap == nil {
+		return nil
+	}
+	return &matchSnapshotDocument{
+		TeamPlacement: snap.TeamPlacement,
+		TeamKills:     snap.TeamKills,
+		PlayerStats:   toPlayerStatsDocuments(snap.PlayerStats),
+		StatsSHA256:   snap.StatsSHA256,
+		VerifiedBy:    snap.VerifiedBy.String(),
+		VerifiedAt:    snap.VerifiedAt,
+	}
+}
+
+func toSnapshotEntity(doc *matchSnapshotDocument) (*match.Snapshot, error) {
+	if doc == nil {
+		return nil, nil
+	}
+	stats, err := toPlayerStatsEntities(doc.PlayerStats)
+	if err != nil {
+		return nil, fmt.Errorf("snapshot: %w", err)
+	}
+	verifiedBy, err := uuid.Parse(doc.VerifiedBy)
+	if err != nil {
+		return nil, fmt.Errorf("parse snapshot verified by: %w", err)
+	}
+	return &match.Snapshot{
+		TeamPlacement: doc.TeamPlacement,
+		TeamKills:     doc.TeamKills,
+		PlayerStats:   stats,
+		StatsSHA256:   doc.StatsSHA256,
+		VerifiedBy:    verifiedBy,
+		VerifiedAt:    doc.VerifiedAt,
+	}, nil
+}
+
+func toReversalDocument(r *match.Reversal) matchReversalDocument {
+	return matchReversalDocument{
+		Reason:     r.Reason,
+		ReversedBy: r.ReversedBy.String(),
+		ReversedAt: r.ReversedAt,
+		Snapshot:   toSnapshotDocument(r.Snapshot),
+	}
+}
+
+func toRevisionDocument(r *match.Revision) matchRevisionDocument {
+	return matchRevisionDocument{
+		TeamPlacement:   r.TeamPlacement,
+		TeamKills:       r.TeamKills,
+		PlayerStats:     toPlayerStatsDocuments(r.PlayerStats),
+		ScreenshotURL:   r.ScreenshotURL,
+		Attachments:     r.Attachments,
+		SubmittedBy:     r.SubmittedBy.String(),
+		Custody:         r.Custody,
+		RejectionReason: r.RejectionReason,
+		RejectedBy:      r.RejectedBy.String(),
+		RejectedAt:      r.RejectedAt,
+		ReplacedAt:      r.ReplacedAt,
+	}
+}
+
+func toAmendmentDocument(a *match.Amendment) matchAmendmentDocument {
+	return matchAmendmentDocument{
+		ID:            a.ID.String(),
+		TeamPlacement: a.TeamPlacement,
+		TeamKills:     a.TeamKills,
+		PlayerStats:   toPlayerStatsDocuments(a.PlayerStats),
+		Reason:        a.Reason,
+		AmendedBy:     a.AmendedBy.String(),
+		AmendedAt:     a.AmendedAt,
+	}
+}
+
+func toPlayerStatsDocuments(stats []match.PlayerMatchStats) []playerMatchStatsDocument {
+	docs := make([]playerMatchStatsDocument, len(stats))
+	for i, ps := range stats {
+		docs[i] = playerMatchStatsDocument{
+			PlayerID:    ps.PlayerID.String(),
+			Kills:       ps.Kills,
+			Damage:      ps.Damage,
+			Assists:     ps.Assists,
+			Deaths:      ps.Deaths,
+			Downs:       ps.Downs,
+			CustomStats: ps.CustomStats,
+		}
+	}
+	return docs
+}
+
+func toPlayerStatsEntities(docs []playerMatchStatsDocument) ([]match.PlayerMatchStats, error) {
+	stats := make([]match.PlayerMatchStats, len(docs))
+	for i, ps := range docs {
 		playerID, err := uuid.Parse(ps.PlayerID)
 		if err != nil {
 			return nil, fmt.Errorf("parse player id: %w", err)
 		}
-		playerStats[i] = match.PlayerMatchStats{
+		stats[i] = match.PlayerMatchStats{
 			PlayerID:    playerID,
 			Kills:       ps.Kills,
 			Damage:      ps.Damage,
@@ -312,6 +1062,14 @@ func toMatchEntity(doc *matchDocument) (*match.Match, error) {
 			CustomStats: ps.CustomStats,
 		}
 	}
+	return stats, nil
+}
+
+func toMatchEntity(doc *matchDocument) (*match.Match, error) {
+	playerStats, err := toPlayerStatsEntities(doc.PlayerStats)
+	if err != nil {
+		return nil, err
+	}
 
 	tournamentID, err := uuid.Parse(doc.TournamentID)
 	if err != nil {
@@ -338,16 +1096,21 @@ func toMatchEntity(doc *matchDocument) (*match.Match, error) {
 		TournamentID:    tournamentID,
 		TeamID:          teamID,
 		GameID:          gameID,
+		Mode:            doc.Mode,
+		Map:             doc.Map,
+		Playlist:        doc.Playlist,
 		Status:          match.Status(doc.Status),
 		TeamPlacement:   doc.TeamPlacement,
 		TeamKills:       doc.TeamKills,
 		PlayerStats:     playerStats,
 		ScreenshotURL:   doc.ScreenshotURL,
+		Attachments:     doc.Attachments,
 		RejectionReason: doc.RejectionReason,
 		SubmittedBy:     submittedBy,
 		CreatedAt:       doc.CreatedAt,
 		UpdatedAt:       doc.UpdatedAt,
 		VerifiedAt:      doc.VerifiedAt,
+		Custody:         doc.Custody,
 	}
 
 	if doc.VerifiedBy != nil {
@@ -358,6 +1121,79 @@ func toMatchEntity(doc *matchDocument) (*match.Match, error) {
 		m.VerifiedBy = &verifiedBy
 	}
 
+	if m.VerifiedSnapshot, err = toSnapshotEntity(doc.Snapshot); err != nil {
+		return nil, err
+	}
+
+	for _, a := range doc.Amendments {
+		stats, err := toPlayerStatsEntities(a.PlayerStats)
+		if err != nil {
+			return nil, fmt.Errorf("amendment: %w", err)
+		}
+		id, err := uuid.Parse(a.ID)
+		if err != nil {
+			return nil, fmt.Errorf("parse amendment id: %w", err)
+		}
+		amendedBy, err := uuid.Parse(a.AmendedBy)
+		if err != nil {
+			return nil, fmt.Errorf("parse amended by: %w", err)
+		}
+		m.Amendments = append(m.Amendments, match.Amendment{
+			ID:            id,
+			TeamPlacement: a.TeamPlacement,
+			TeamKills:     a.TeamKills,
+			PlayerStats:   stats,
+			Reason:        a.Reason,
+			AmendedBy:     amendedBy,
+			AmendedAt:     a.AmendedAt,
+		})
+	}
+
+	for _, rev := range doc.Reversals {
+		reversedBy, err := uuid.Parse(rev.ReversedBy)
+		if err != nil {
+			return nil, fmt.Errorf("parse reversed by: %w", err)
+		}
+		snap, err := toSnapshotEntity(rev.Snapshot)
+		if err != nil {
+			return nil, fmt.Errorf("reversal: %w", err)
+		}
+		m.Reversals = append(m.Reversals, match.Reversal{
+			Reason:     rev.Reason,
+			ReversedBy: reversedBy,
+			ReversedAt: rev.ReversedAt,
+			Snapshot:   snap,
+		})
+	}
+
+	for _, rev := range doc.Revisions {
+		stats, err := toPlayerStatsEntities(rev.PlayerStats)
+		if err != nil {
+			return nil, fmt.Errorf("revision: %w", err)
+		}
+		submittedBy, err := uuid.Parse(rev.SubmittedBy)
+		if err != nil {
+			return nil, fmt.Errorf("parse revision submitted by: %w", err)
+		}
+		rejectedBy, err := uuid.Parse(rev.RejectedBy)
+		if err != nil {
+			return nil, fmt.Errorf("parse rejected by: %w", err)
+		}
+		m.Revisions = append(m.Revisions, match.Revision{
+			TeamPlacement:   rev.TeamPlacement,
+			TeamKills:       rev.TeamKills,
+			PlayerStats:     stats,
+			ScreenshotURL:   rev.ScreenshotURL,
+			Attachments:     rev.Attachments,
+			SubmittedBy:     submittedBy,
+			Custody:         rev.Custody,
+			RejectionReason: rev.RejectionReason,
+			RejectedBy:      rejectedBy,
+			RejectedAt:      rev.RejectedAt,
+			ReplacedAt:      rev.ReplacedAt,
+		})
+	}
+
 	return m, nil
 }
 
@@ -0,0 +1,243 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/matchmaking"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// MatchmakingQueueRepository implements matchmaking.QueueRepository using MongoDB.
+type MatchmakingQueueRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMatchmakingQueueRepository creates a new MongoDB matchmaking queue repository.
+func NewMatchmakingQueueRepository(db *mongo.Database) *MatchmakingQueueRepository {
+	return &MatchmakingQueueRepository{
+		collection: db.Collection("matchmaking_queue"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the matchmaking queue collection.
+func (r *MatchmakingQueueRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "game_id", Value: 1},
+				{Key: "tier", Value: 1},
+				{Key: "joined_at", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "member_ids", Value: 1},
+				{Key: "status", Value: 1},
+			},
+		},
+		{
+			Keys: bson.D{{Key: "lobby_id", Value: 1}},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating matchmaking queue indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new queue entry.
+func (r *MatchmakingQueueRepository) Create(ctx context.Context, entry *matchmaking.QueueEntry) error {
+	_, err := r.collection.InsertOne(ctx, entry)
+	if err != nil {
+		return fmt.Errorf("inserting queue entry: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a queue entry by its ID.
+func (r *MatchmakingQueueRepository) GetByID(ctx context.Context, id uuid.UUID) (*matchmaking.QueueEntry, error) {
+	var e matchmaking.QueueEntry
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&e)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, matchmaking.ErrEntryNotFound
+		}
+		return nil, fmt.Errorf("finding queue entry: %w", err)
+	}
+	return &e, nil
+}
+
+// Update updates an existing queue entry.
+func (r *MatchmakingQueueRepository) Update(ctx context.Context, entry *matchmaking.QueueEntry) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": entry.ID}, entry)
+	if err != nil {
+		return fmt.Errorf("updating queue entry: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return matchmaking.ErrEntryNotFound
+	}
+	return nil
+}
+
+// GetActiveByPlayer retrieves the waiting or open-lobby entry that includes a player.
+// Matched entries stay active until their lobby is completed.
+func (r *MatchmakingQueueRepository) GetActiveByPlayer(ctx context.Context, playerID uuid.UUID) (*matchmaking.QueueEntry, error) {
+	var e matchmaking.QueueEntry
+	err := r.collection.FindOne(
+		ctx,
+		bson.M{
+			"member_ids": playerID,
+			"status": bson.M{"$in": []matchmaking.EntryStatus{
+				matchmaking.EntryStatusWaiting,
+				matchmaking.EntryStatusMatched,
+			}},
+		},
+		options.FindOne().SetSort(bson.D{{Key: "joined_at", Value: -1}}),
+	).Decode(&e)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, matchmaking.ErrEntryNotFound
+		}
+		return nil, fmt.Errorf("finding active queue entry: %w", err)
+	}
+	return &e, nil
+}
+
+// GetWaiting retrieves all waiting entries for a game and tier bracket, oldest first.
+func (r *MatchmakingQueueRepository) GetWaiting(ctx context.Context, gameID uuid.UUID, tier player.Tier) ([]*matchmaking.QueueEntry, error) {
+	cursor, err := r.collection.Find(
+		ctx,
+		bson.M{
+			"status":  matchmaking.EntryStatusWaiting,
+			"game_id": gameID,
+			"tier":    tier,
+		},
+		options.Find().SetSort(bson.D{{Key: "joined_at", Value: 1}}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("finding waiting queue entries: %w", err)
+	}
+
+	var entries []*matchmaking.QueueEntry
+	if err := decodeAll(ctx, cursor, &entries); err != nil {
+		return nil, fmt.Errorf("decoding queue entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// GetWaitingBrackets returns the distinct game and tier brackets that have waiting entries.
+func (r *MatchmakingQueueRepository) GetWaitingBrackets(ctx context.Context) ([]matchmaking.Bracket, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: bson.M{"status": matchmaking.EntryStatusWaiting}}},
+		{{Key: "$group", Value: bson.M{
+			"_id": bson.M{"game_id": "$game_id", "tier": "$tier"},
+		}}},
+		{{Key: "$replaceRoot", Value: bson.M{"newRoot": "$_id"}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("aggregating waiting brackets: %w", err)
+	}
+
+	var brackets []matchmaking.Bracket
+	if err := decodeAll(ctx, cursor, &brackets); err != nil {
+		return nil, fmt.Errorf("decoding waiting brackets: %w", err)
+	}
+
+	return brackets, nil
+}
+
+// GetByLobbyID retrieves all entries matched into a lobby.
+func (r *MatchmakingQueueRepository) GetByLobbyID(ctx context.Context, lobbyID uuid.UUID) ([]*matchmaking.QueueEntry, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{"lobby_id": lobbyID})
+	if err != nil {
+		return nil, fmt.Errorf("finding queue entries by lobby: %w", err)
+	}
+
+	var entries []*matchmaking.QueueEntry
+	if err := decodeAll(ctx, cursor, &entries); err != nil {
+		return nil, fmt.Errorf("decoding queue entries: %w", err)
+	}
+
+	return entries, nil
+}
+
+// MatchmakingLobbyRepository implements matchmaking.LobbyRepository using MongoDB.
+type MatchmakingLobbyRepository struct {
+	collection *mongo.Collection
+}
+
+// NewMatchmakingLobbyRepository creates a new MongoDB matchmaking lobby repository.
+func NewMatchmakingLobbyRepository(db *mongo.Database) *MatchmakingLobbyRepository {
+	return &MatchmakingLobbyRepository{
+		collection: db.Collection("matchmaking_lobbies"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the matchmaking lobbies collection.
+func (r *MatchmakingLobbyRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "player_ids", Value: 1}},
+		},
+		{
+			Keys: bson.D{
+				{Key: "game_id", Value: 1},
+				{Key: "status", Value: 1},
+			},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating matchmaking lobby indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new lobby.
+func (r *MatchmakingLobbyRepository) Create(ctx context.Context, lobby *matchmaking.Lobby) error {
+	_, err := r.collection.InsertOne(ctx, lobby)
+	if err != nil {
+		return fmt.Errorf("inserting lobby: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a lobby by its ID.
+func (r *MatchmakingLobbyRepository) GetByID(ctx context.Context, id uuid.UUID) (*matchmaking.Lobby, error) {
+	var l matchmaking.Lobby
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&l)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, matchmaking.ErrLobbyNotFound
+		}
+		return nil, fmt.Errorf("finding lobby: %w", err)
+	}
+	return &l, nil
+}
+
+// Update updates an existing lobby.
+func (r *MatchmakingLobbyRepository) Update(ctx context.Context, lobby *matchmaking.Lobby) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": lobby.ID}, lobby)
+	if err != nil {
+		return fmt.Errorf("updating lobby: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return matchmaking.ErrLobbyNotFound
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/note"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// NoteRepository implements note.Repository using MongoDB.
+type NoteRepository struct {
+	collection *mongo.Collection
+}
+
+// NewNoteRepository creates a new MongoDB admin note repository.
+func NewNoteRepository(db *mongo.Database) *NoteRepository {
+	return &NoteRepository{
+		collection: db.Collection("admin_notes"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the admin notes collection.
+func (r *NoteRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "subject", Value: 1},
+				{Key: "subject_id", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating admin note indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores an admin note.
+func (r *NoteRepository) Create(ctx context.Context, n *note.Note) error {
+	_, err := r.collection.InsertOne(ctx, n)
+	if err != nil {
+		return fmt.Errorf("inserting admin note: %w", err)
+	}
+	return nil
+}
+
+// ListBySubject returns the notes on a subject, newest first.
+func (r *NoteRepository) ListBySubject(ctx context.Context, subject note.Subject, subjectID uuid.UUID) ([]*note.Note, error) {
+	return r.find(ctx, bson.M{"subject": subject, "subject_id": subjectID})
+}
+
+// ListBySubjects returns the notes on several subjects of the same kind, newest first.
+func (r *NoteRepository) ListBySubjects(ctx context.Context, subject note.Subject, subjectIDs []uuid.UUID) ([]*note.Note, error) {
+	if len(subjectIDs) == 0 {
+		return []*note.Note{}, nil
+	}
+	return r.find(ctx, bson.M{"subject": subject, "subject_id": bson.M{"$in": subjectIDs}})
+}
+
+func (r *NoteRepository) find(ctx context.Context, filter bson.M) ([]*note.Note, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding admin notes: %w", err)
+	}
+
+	notes := []*note.Note{}
+	if err := decodeAll(ctx, cursor, &notes); err != nil {
+		return nil, fmt.Errorf("decoding admin notes: %w", err)
+	}
+
+	return notes, nil
+}
@@ -0,0 +1,80 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/organization"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// OrganizationRepository implements organization.Repository using MongoDB.
+type OrganizationRepository struct {
+	collection *mongo.Collection
+}
+
+// NewOrganizationRepository creates a new MongoDB organization repository.
+func NewOrganizationRepository(db *mongo.Database) *OrganizationRepository {
+	return &OrganizationRepository{
+		collection: db.Collection("organizations"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the organizations collection.
+func (r *OrganizationRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{Keys: bson.D{{Key: "series.id", Value: 1}}},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating organization indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new organization.
+func (r *OrganizationRepository) Create(ctx context.Context, o *organization.Organization) error {
+	_, err := r.collection.InsertOne(ctx, o)
+	if err != nil {
+		return fmt.Errorf("inserting organization: %w", err)
+	}
+	return nil
+}
+
+// GetByID returns an organization.
+func (r *OrganizationRepository) GetByID(ctx context.Context, id uuid.UUID) (*organization.Organization, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// GetBySeries returns the organization running a series.
+func (r *OrganizationRepository) GetBySeries(ctx context.Context, seriesID uuid.UUID) (*organization.Organization, error) {
+	return r.findOne(ctx, bson.M{"series.id": seriesID})
+}
+
+// Update replaces an existing organization.
+func (r *OrganizationRepository) Update(ctx context.Context, o *organization.Organization) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": o.ID}, o)
+	if err != nil {
+		return fmt.Errorf("updating organization: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return organization.ErrNotFound
+	}
+	return nil
+}
+
+func (r *OrganizationRepository) findOne(ctx context.Context, filter bson.M) (*organization.Organization, error) {
+	var o organization.Organization
+	if err := r.collection.FindOne(ctx, filter).Decode(&o); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, organization.ErrNotFound
+		}
+		return nil, fmt.Errorf("finding organization: %w", err)
+	}
+	return &o, nil
+}
@@ -27,20 +27,25 @@ var (
 
 // playerDocument represents the MongoDB document structure for a player.
 type playerDocument struct {
-	ID                string            `bson:"_id"`
-	UserID            string            `bson:"user_id"`
-	DisplayName       string            `bson:"display_name"`
-	AvatarURL         string            `bson:"avatar_url,omitempty"`
-	Bio               string            `bson:"bio,omitempty"`
-	PlatformIDs       map[string]string `bson:"platform_ids,omitempty"`
-	BirthYear         int               `bson:"birth_year,omitempty"`
-	Region            string            `bson:"region,omitempty"`
-	PreferredPlatform string            `bson:"preferred_platform,omitempty"`
-	Language          string            `bson:"language,omitempty"`
-	IsBanned          bool              `bson:"is_banned"`
-	BannedAt          *time.Time        `bson:"banned_at,omitempty"`
-	CreatedAt         time.Time         `bson:"created_at"`
-	UpdatedAt         time.Time         `bson:"updated_at"`
+	ID                string               `bson:"_id"`
+	UserID            string               `bson:"user_id"`
+	DisplayName       string               `bson:"display_name"`
+	AvatarURL         string               `bson:"avatar_url,omitempty"`
+	Bio               string               `bson:"bio,omitempty"`
+	PlatformIDs       map[string]string    `bson:"platform_ids,omitempty"`
+	BirthYear         int                  `bson:"birth_year,omitempty"`
+	Region            string               `bson:"region,omitempty"`
+	PreferredPlatform string               `bson:"preferred_platform,omitempty"`
+	Language          string               `bson:"language,omitempty"`
+	HideActivity      bool                 `bson:"hide_activity"`
+	HideFromBoards    bool                 `bson:"hide_from_leaderboards,omitempty"`
+	IsBanned          bool                 `bson:"is_banned"`
+	BannedAt          *time.Time           `bson:"banned_at,omitempty"`
+	IsDeactivated     bool                 `bson:"is_deactivated,omitempty"`
+	Honors            player.Honors        `bson:"honors"`
+	Availability      *player.Availability `bson:"availability,omitempty"`
+	CreatedAt         time.Time            `bson:"created_at"`
+	UpdatedAt         time.Time            `bson:"updated_at"`
 }
 
 // PlayerRepository implements player persistence using MongoDB.
@@ -85,6 +90,40 @@ func (r *PlayerRepository) GetByID(ctx context.Context, id string) (*player.Play
 	return toPlayerEntity(&doc)
 }
 
+// GetByIDs retrieves the players with the given IDs in a single query.
+// IDs with no matching player are skipped.
+func (r *PlayerRepository) GetByIDs(ctx context.Context, ids []string) ([]*player.Player, error) {
+	if len(ids) == 0 {
+		return []*player.Player{}, nil
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"_id": bson.M{"$in": ids}})
+	if err != nil {
+		return nil, fmt.Errorf("find players by ids: %w", err)
+	}
+	defer closeCursor(cursor)
+
+	players := make([]*player.Player, 0, len(ids))
+	for cursor.Next(ctx) {
+		var doc playerDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode player: %w", err)
+		}
+
+		p, err := toPlayerEntity(&doc)
+		if err != nil {
+			return nil, fmt.Errorf("convert player entity: %w", err)
+		}
+		players = append(players, p)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return players, nil
+}
+
 // GetByUserID retrieves a player by their user ID.
 func (r *PlayerRepository) GetByUserID(ctx context.Context, userID string) (*player.Player, error) {
 	var doc playerDocument
@@ -100,6 +139,32 @@ func (r *PlayerRepository) GetByUserID(ctx context.Context, userID string) (*pla
 	return toPlayerEntity(&doc)
 }
 
+// GetByIDFields retrieves a player by ID loading only the given fields.
+func (r *PlayerRepository) GetByIDFields(ctx context.Context, id string, fields []string) (*player.Player, error) {
+	return r.findFields(ctx, bson.M{"_id": id}, fields)
+}
+
+// GetByUserIDFields retrieves a player by user ID loading only the given fields.
+func (r *PlayerRepository) GetByUserIDFields(ctx context.Context, userID string, fields []string) (*player.Player, error) {
+	return r.findFields(ctx, bson.M{"user_id": userID}, fields)
+}
+
+// findFields finds one player loading only the given fields. The user ID is
+// always loaded, as every player entity has one.
+func (r *PlayerRepository) findFields(ctx context.Context, filter bson.M, fields []string) (*player.Player, error) {
+	var doc playerDocument
+
+	err := r.collection.FindOne(ctx, filter, findFields(player.Player{}, fields, "user_id")).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, player.ErrNotFound
+		}
+		return nil, fmt.Errorf("find player: %w", err)
+	}
+
+	return toPlayerEntity(&doc)
+}
+
 // GetByPlatformID retrieves a player by a platform-specific ID.
 func (r *PlayerRepository) GetByPlatformID(ctx context.Context, platform, platformID string) (*player.Player, error) {
 	var doc playerDocument
@@ -130,7 +195,7 @@ func (r *PlayerRepository) List(ctx context.Context, limit, offset int64) ([]*pl
 	if err != nil {
 		return nil, fmt.Errorf("find players: %w", err)
 	}
-	defer cursor.Close(ctx)
+	defer closeCursor(cursor)
 
 	var players []*player.Player
 	for cursor.Next(ctx) {
@@ -161,7 +226,7 @@ func (r *PlayerRepository) GetAll(ctx context.Context) ([]*player.Player, error)
 	if err != nil {
 		return nil, fmt.Errorf("find all players: %w", err)
 	}
-	defer cursor.Close(ctx)
+	defer closeCursor(cursor)
 
 	var players []*player.Player
 	for cursor.Next(ctx) {
@@ -184,13 +249,14 @@ func (r *PlayerRepository) GetAll(ctx context.Context) ([]*player.Player, error)
 	return players, nil
 }
 
-// Search searches players by display name.
+// Search searches players by display name, leaving out deactivated accounts.
 func (r *PlayerRepository) Search(ctx context.Context, query string, limit int64) ([]*player.Player, error) {
 	filter := bson.M{
 		"display_name": bson.M{
 			"$regex":   query,
 			"$options": "i", // case-insensitive
 		},
+		"is_deactivated": bson.M{"$ne": true},
 	}
 
 	opts := options.Find().
@@ -201,7 +267,7 @@ func (r *PlayerRepository) Search(ctx context.Context, query string, limit int64
 	if err != nil {
 		return nil, fmt.Errorf("search players: %w", err)
 	}
-	defer cursor.Close(ctx)
+	defer closeCursor(cursor)
 
 	var players []*player.Player
 	for cursor.Next(ctx) {
@@ -217,6 +283,10 @@ func (r *PlayerRepository) Search(ctx context.Context, query string, limit int64
 		players = append(players, p)
 	}
 
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
 	return players, nil
 }
 
@@ -240,6 +310,27 @@ func (r *PlayerRepository) Update(ctx context.Context, p *player.Player) error {
 	return nil
 }
 
+// AddHonors adds delta to a player's accumulated honors.
+func (r *PlayerRepository) AddHonors(ctx context.Context, id string, delta player.Honors) error {
+	result, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": id},
+		bson.M{"$inc": bson.M{
+			"honors.mvp_votes": delta.MVPVotes,
+			"honors.commends":  delta.Commends,
+		}},
+	)
+	if err != nil {
+		return fmt.Errorf("add player honors: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return player.ErrNotFound
+	}
+
+	return nil
+}
+
 // Delete removes a player from the database.
 func (r *PlayerRepository) Delete(ctx context.Context, id string) error {
 	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": id})
@@ -263,6 +354,156 @@ func (r *PlayerRepository) Count(ctx context.Context) (int64, error) {
 	return count, nil
 }
 
+// playerListDocument is a player document with its joined stats summary.
+type playerListDocument struct {
+	playerDocument `bson:",inline"`
+	StatsSummary   *playerStatsSummaryDocument `bson:"stats_summary,omitempty"`
+}
+
+type playerStatsSummaryDocument struct {
+	Games         int     `bson:"games"`
+	MatchesPlayed int     `bson:"matches_played"`
+	RankingScore  float64 `bson:"ranking_score"`
+}
+
+// ListFiltered returns a page of players matching a filter.
+func (r *PlayerRepository) ListFiltered(ctx context.Context, filter player.ListFilter) ([]player.ListEntry, error) {
+	sortField := string(filter.Sort)
+	if filter.Sort.UsesStats() {
+		sortField = "stats_summary." + sortField
+	} else if sortField == "" {
+		sortField = string(player.ListSortDisplayName)
+	}
+	order := 1
+	if filter.Descending {
+		order = -1
+	}
+
+	pipeline := append(playerFilterPipeline(filter),
+		bson.D{{Key: "$sort", Value: bson.D{{Key: sortField, Value: order}, {Key: "_id", Value: order}}}},
+		bson.D{{Key: "$skip", Value: filter.Offset}},
+	)
+	if filter.Limit > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: filter.Limit}})
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("list players: %w", err)
+	}
+	defer closeCursor(cursor)
+
+	entries := []player.ListEntry{}
+	for cursor.Next(ctx) {
+		var doc playerListDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode player: %w", err)
+		}
+
+		p, err := toPlayerEntity(&doc.playerDocument)
+		if err != nil {
+			return nil, fmt.Errorf("convert player entity: %w", err)
+		}
+
+		entry := player.ListEntry{Player: p}
+		if filter.IncludeStats {
+			entry.Stats = &player.StatsSummary{}
+			if doc.StatsSummary != nil {
+				entry.Stats.Games = doc.StatsSummary.Games
+				entry.Stats.MatchesPlayed = doc.StatsSummary.MatchesPlayed
+				entry.Stats.RankingScore = doc.StatsSummary.RankingScore
+			}
+		}
+		entries = append(entries, entry)
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return entries, nil
+}
+
+// CountFiltered returns the number of players matching a filter, ignoring its paging.
+func (r *PlayerRepository) CountFiltered(ctx context.Context, filter player.ListFilter) (int64, error) {
+	filter.IncludeStats = false
+	pipeline := append(playerFilterPipeline(filter), bson.D{{Key: "$count", Value: "total"}})
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, fmt.Errorf("count players: %w", err)
+	}
+	defer closeCursor(cursor)
+
+	var result struct {
+		Total int64 `bson:"total"`
+	}
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&result); err != nil {
+			return 0, fmt.Errorf("decode player count: %w", err)
+		}
+	}
+
+	if err := cursor.Err(); err != nil {
+		return 0, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return result.Total, nil
+}
+
+// playerFilterPipeline builds the aggregation stages selecting the players
+// that match a filter. The stats summary is joined from each player's
+// all-modes stats, restricted to the filter's game if one is set.
+func playerFilterPipeline(filter player.ListFilter) mongo.Pipeline {
+	match := bson.M{}
+	if filter.Banned != nil {
+		if *filter.Banned {
+			match["is_banned"] = true
+		} else {
+			match["is_banned"] = bson.M{"$ne": true}
+		}
+	}
+	if filter.Region != "" {
+		match["region"] = filter.Region
+	}
+	if filter.Platform != "" {
+		match["preferred_platform"] = filter.Platform
+	}
+
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: match}}}
+
+	if !filter.HasStats && !filter.IncludeStats && !filter.Sort.UsesStats() {
+		return pipeline
+	}
+
+	statsMatch := bson.M{
+		"$expr":     bson.M{"$eq": bson.A{"$player_id", "$$player_id"}},
+		"mode":      nil,
+		"season_id": nil,
+	}
+	if filter.GameID != uuid.Nil {
+		statsMatch["game_id"] = filter.GameID.String()
+	}
+
+	pipeline = append(pipeline, bson.D{{Key: "$lookup", Value: bson.M{
+		"from":     PlayerStatsCollection,
+		"let":      bson.M{"player_id": "$_id"},
+		"pipeline": bson.A{bson.M{"$match": statsMatch}},
+		"as":       "stats",
+	}}})
+	if filter.HasStats {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: bson.M{"stats.0": bson.M{"$exists": true}}}})
+	}
+	if filter.IncludeStats || filter.Sort.UsesStats() {
+		pipeline = append(pipeline, bson.D{{Key: "$addFields", Value: bson.M{"stats_summary": bson.M{
+			"games":          bson.M{"$size": "$stats"},
+			"matches_played": bson.M{"$sum": "$stats.matches_played"},
+			"ranking_score":  bson.M{"$ifNull": bson.A{bson.M{"$max": "$stats.ranking_score"}, 0}},
+		}}}})
+	}
+	return append(pipeline, bson.D{{Key: "$project", Value: bson.M{"stats": 0}}})
+}
+
 // EnsureIndexes creates necessary indexes for the players collection.
 func (r *PlayerRepository) EnsureIndexes(ctx context.Context) error {
 	indexes := []mongo.IndexModel{
@@ -276,6 +517,9 @@ func (r *PlayerRepository) EnsureIndexes(ctx context.Context) error {
 		{
 			Keys: bson.D{{Key: "display_name", Value: "text"}},
 		},
+		{
+			Keys: bson.D{{Key: "region", Value: 1}, {Key: "preferred_platform", Value: 1}},
+		},
 	}
 
 	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
@@ -299,8 +543,13 @@ func toPlayerDocument(p *player.Player) *playerDocument {
 		Region:            p.Region,
 		PreferredPlatform: p.PreferredPlatform,
 		Language:          p.Language,
+		HideActivity:      p.HideActivity,
+		HideFromBoards:    p.HideFromBoards,
 		IsBanned:          p.IsBanned,
 		BannedAt:          p.BannedAt,
+		IsDeactivated:     p.IsDeactivated,
+		Honors:            p.Honors,
+		Availability:      p.Availability,
 		CreatedAt:         p.CreatedAt,
 		UpdatedAt:         p.UpdatedAt,
 	}
@@ -334,8 +583,13 @@ func toPlayerEntity(doc *playerDocument) (*player.Player, error) {
 		Region:            doc.Region,
 		PreferredPlatform: doc.PreferredPlatform,
 		Language:          doc.Language,
+		HideActivity:      doc.HideActivity,
+		HideFromBoards:    doc.HideFromBoards,
 		IsBanned:          doc.IsBanned,
 		BannedAt:          doc.BannedAt,
+		IsDeactivated:     doc.IsDeactivated,
+		Honors:            doc.Honors,
+		Availability:      doc.Availability,
 		CreatedAt:         doc.CreatedAt,
 		UpdatedAt:         doc.UpdatedAt,
 	}, nil
@@ -18,33 +18,75 @@ import (
 const (
 	// PlayerStatsCollection is the MongoDB collection name for player stats.
 	PlayerStatsCollection = "player_stats"
+
+	// RankSnapshotsCollection is the MongoDB collection name for periodic leaderboard rank snapshots.
+	RankSnapshotsCollection = "rank_snapshots"
 )
 
 // playerStatsDocument represents the MongoDB document structure for player stats.
 type playerStatsDocument struct {
-	ID            string                 `bson:"_id"`
-	PlayerID      string                 `bson:"player_id"`
-	GameID        string                 `bson:"game_id"`
-	Stats         map[string]interface{} `bson:"stats"`
-	MatchesPlayed int                    `bson:"matches_played"`
-	RankingScore  float64                `bson:"ranking_score"`
-	Tier          string                 `bson:"tier"`
-	LastMatchAt   *time.Time             `bson:"last_match_at"`
-	CreatedAt     time.Time              `bson:"created_at"`
-	UpdatedAt     time.Time              `bson:"updated_at"`
+	ID                 string                 `bson:"_id"`
+	PlayerID           string                 `bson:"player_id"`
+	GameID             string                 `bson:"game_id"`
+	Mode               string                 `bson:"mode,omitempty"`
+	SeasonID           string                 `bson:"season_id,omitempty"`
+	Stats              map[string]interface{} `bson:"stats"`
+	RollupSamples      map[string]int         `bson:"rollup_samples,omitempty"`
+	MatchesPlayed      int                    `bson:"matches_played"`
+	RankingScore       float64                `bson:"ranking_score"`
+	RatingDeviation    float64                `bson:"rating_deviation"`
+	Tier               string                 `bson:"tier"`
+	ConsistencyScore   float64                `bson:"consistency_score"`
+	ConsistencySamples int                    `bson:"consistency_samples"`
+	Records            player.PersonalRecords `bson:"records"`
+	LastMatchAt        *time.Time             `bson:"last_match_at"`
+	PlayerDeactivated  bool                   `bson:"is_deactivated,omitempty"`
+	CreatedAt          time.Time              `bson:"created_at"`
+	UpdatedAt          time.Time              `bson:"updated_at"`
+}
+
+// leaderboardEntryDocument is the projected shape of a leaderboard aggregation result.
+type leaderboardEntryDocument struct {
+	PlayerID        string                 `bson:"player_id"`
+	RankingScore    float64                `bson:"ranking_score"`
+	RatingDeviation float64                `bson:"rating_deviation"`
+	Tier            string                 `bson:"tier"`
+	MatchesPlayed   int                    `bson:"matches_played"`
+	Stats           map[string]interface{} `bson:"stats"`
+	DisplayName     string                 `bson:"display_name"`
+	AvatarURL       string                 `bson:"avatar_url"`
+	Anonymous       bool                   `bson:"anonymous"`
+	LastMatchAt     *time.Time             `bson:"last_match_at"`
+	CompetitionRank int                    `bson:"competition_rank"`
+	RankSnapshot    *rankSnapshotDocument  `bson:"rank_snapshot"`
+}
+
+// rankSnapshotDocument records a stats document's leaderboard position at a
+// point in time, under both leaderboard sort orders and both ranking modes.
+type rankSnapshotDocument struct {
+	StatsID                     string    `bson:"stats_id"`
+	Rank                        int       `bson:"rank"`
+	ConservativeRank            int       `bson:"conservative_rank"`
+	CompetitionRank             int       `bson:"competition_rank"`
+	ConservativeCompetitionRank int       `bson:"conservative_competition_rank"`
+	TakenAt                     time.Time `bson:"taken_at"`
 }
 
 // PlayerStatsRepository implements player stats persistence using MongoDB.
 type PlayerStatsRepository struct {
-	collection       *mongo.Collection
-	playerCollection *mongo.Collection
+	collection         *mongo.Collection
+	reads              *mongo.Collection // Leaderboard listings, served by regional members when configured
+	playerCollection   *mongo.Collection
+	snapshotCollection *mongo.Collection
 }
 
 // NewPlayerStatsRepository creates a new PlayerStatsRepository.
 func NewPlayerStatsRepository(client *Client) *PlayerStatsRepository {
 	return &PlayerStatsRepository{
-		collection:       client.Collection(PlayerStatsCollection),
-		playerCollection: client.Collection(PlayersCollection),
+		collection:         client.Collection(PlayerStatsCollection),
+		reads:              client.RegionalCollection(PlayerStatsCollection),
+		playerCollection:   client.Collection(PlayersCollection),
+		snapshotCollection: client.Collection(RankSnapshotsCollection),
 	}
 }
 
@@ -77,12 +119,15 @@ func (r *PlayerStatsRepository) GetByID(ctx context.Context, id uuid.UUID) (*pla
 
 // GetByPlayerAndGame retrieves player stats for a specific player and game.
 func (r *PlayerStatsRepository) GetByPlayerAndGame(ctx context.Context, playerID, gameID uuid.UUID) (*player.PlayerStats, error) {
+	return r.getByPlayerGameAndScope(ctx, playerID, gameID, player.StatsScope{})
+}
+
+// getByPlayerGameAndScope retrieves player stats for a player, game and scope.
+func (r *PlayerStatsRepository) getByPlayerGameAndScope(ctx context.Context, playerID, gameID uuid.UUID, scope player.StatsScope) (*player.PlayerStats, error) {
 	var doc playerStatsDocument
 
-	filter := bson.M{
-		"player_id": playerID.String(),
-		"game_id":   gameID.String(),
-	}
+	filter := statsScopeFilter(gameID, scope)
+	filter["player_id"] = playerID.String()
 
 	err := r.collection.FindOne(ctx, filter).Decode(&doc)
 	if err != nil {
@@ -97,16 +142,46 @@ func (r *PlayerStatsRepository) GetByPlayerAndGame(ctx context.Context, playerID
 
 // GetByPlayer retrieves all player stats for a specific player across all games.
 func (r *PlayerStatsRepository) GetByPlayer(ctx context.Context, playerID uuid.UUID) ([]*player.PlayerStats, error) {
-	filter := bson.M{"player_id": playerID.String()}
+	filter := bson.M{"player_id": playerID.String(), "mode": nil, "season_id": nil}
 
 	cursor, err := r.collection.Find(ctx, filter)
 	if err != nil {
 		return nil, fmt.Errorf("find player stats: %w", err)
 	}
-	defer cursor.Close(ctx)
 
 	var docs []playerStatsDocument
-	if err := cursor.All(ctx, &docs); err != nil {
+	if err := decodeAll(ctx, cursor, &docs); err != nil {
+		return nil, fmt.Errorf("decode player stats: %w", err)
+	}
+
+	results := make([]*player.PlayerStats, 0, len(docs))
+	for i := range docs {
+		ps, err := toPlayerStatsEntity(&docs[i])
+		if err != nil {
+			return nil, fmt.Errorf("convert player stats: %w", err)
+		}
+		results = append(results, ps)
+	}
+
+	return results, nil
+}
+
+// GetByGame retrieves stats for a game ordered by ranking score.
+// A limit of zero returns every player.
+func (r *PlayerStatsRepository) GetByGame(ctx context.Context, gameID uuid.UUID, limit int64) ([]*player.PlayerStats, error) {
+	filter := statsScopeFilter(gameID, player.StatsScope{})
+	opts := options.Find().SetSort(bson.D{{Key: "ranking_score", Value: -1}, {Key: "_id", Value: 1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, opts)
+	if err != nil {
+		return nil, fmt.Errorf("find player stats by game: %w", err)
+	}
+
+	var docs []playerStatsDocument
+	if err := decodeAll(ctx, cursor, &docs); err != nil {
 		return nil, fmt.Errorf("decode player stats: %w", err)
 	}
 
@@ -124,7 +199,12 @@ func (r *PlayerStatsRepository) GetByPlayer(ctx context.Context, playerID uuid.U
 
 // GetOrCreate retrieves player stats or creates them if they don't exist.
 func (r *PlayerStatsRepository) GetOrCreate(ctx context.Context, playerID, gameID uuid.UUID) (*player.PlayerStats, error) {
-	ps, err := r.GetByPlayerAndGame(ctx, playerID, gameID)
+	return r.GetOrCreateForScope(ctx, playerID, gameID, player.StatsScope{})
+}
+
+// GetOrCreateForScope retrieves a player's stats for a game mode and season or creates them if they don't exist.
+func (r *PlayerStatsRepository) GetOrCreateForScope(ctx context.Context, playerID, gameID uuid.UUID, scope player.StatsScope) (*player.PlayerStats, error) {
+	ps, err := r.getByPlayerGameAndScope(ctx, playerID, gameID, scope)
 	if err == nil {
 		return ps, nil
 	}
@@ -135,6 +215,8 @@ func (r *PlayerStatsRepository) GetOrCreate(ctx context.Context, playerID, gameI
 
 	// Create new player stats
 	ps = player.NewPlayerStats(playerID, gameID)
+	ps.Mode = scope.Mode
+	ps.SeasonID = scope.SeasonID
 	if err := r.Create(ctx, ps); err != nil {
 		return nil, err
 	}
@@ -162,16 +244,17 @@ func (r *PlayerStatsRepository) Update(ctx context.Context, ps *player.PlayerSta
 	return nil
 }
 
-// UpdateRanking updates only the ranking score and tier.
-func (r *PlayerStatsRepository) UpdateRanking(ctx context.Context, id uuid.UUID, score float64, tier player.Tier) error {
+// UpdateRanking updates only the ranking score, rating deviation and tier.
+func (r *PlayerStatsRepository) UpdateRanking(ctx context.Context, id uuid.UUID, score, deviation float64, tier player.Tier) error {
 	result, err := r.collection.UpdateOne(
 		ctx,
 		bson.M{"_id": id.String()},
 		bson.M{
 			"$set": bson.M{
-				"ranking_score": score,
-				"tier":          string(tier),
-				"updated_at":    time.Now(),
+				"ranking_score":    score,
+				"rating_deviation": deviation,
+				"tier":             string(tier),
+				"updated_at":       time.Now(),
 			},
 		},
 	)
@@ -186,6 +269,95 @@ func (r *PlayerStatsRepository) UpdateRanking(ctx context.Context, id uuid.UUID,
 	return nil
 }
 
+// UpdateConsistency updates a player's consistency score and sample count.
+func (r *PlayerStatsRepository) UpdateConsistency(ctx context.Context, id uuid.UUID, score float64, samples int) error {
+	result, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": id.String()},
+		bson.M{
+			"$set": bson.M{
+				"consistency_score":   score,
+				"consistency_samples": samples,
+				"updated_at":          time.Now(),
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("update consistency: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return player.ErrStatsNotFound
+	}
+
+	return nil
+}
+
+// SetDerivedStats overwrites a player's derived stats with their computed values.
+func (r *PlayerStatsRepository) SetDerivedStats(ctx context.Context, id uuid.UUID, derived map[string]float64) error {
+	set := bson.M{"updated_at": time.Now()}
+	for k, v := range derived {
+		set["stats."+k] = v
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id.String()}, bson.M{"$set": set})
+	if err != nil {
+		return fmt.Errorf("set derived stats: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return player.ErrStatsNotFound
+	}
+
+	return nil
+}
+
+// SetRollups overwrites a player's max and average stats and the number of
+// matches each of the averages covers.
+func (r *PlayerStatsRepository) SetRollups(ctx context.Context, id uuid.UUID, rolled map[string]float64, samples map[string]int) error {
+	set := bson.M{"updated_at": time.Now()}
+	for k, v := range rolled {
+		set["stats."+k] = v
+	}
+	for k, n := range samples {
+		set["rollup_samples."+k] = n
+	}
+
+	result, err := r.collection.UpdateOne(ctx, bson.M{"_id": id.String()}, bson.M{"$set": set})
+	if err != nil {
+		return fmt.Errorf("set rolled up stats: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return player.ErrStatsNotFound
+	}
+
+	return nil
+}
+
+// UpdateRecords replaces a player's personal records.
+func (r *PlayerStatsRepository) UpdateRecords(ctx context.Context, id uuid.UUID, records player.PersonalRecords) error {
+	result, err := r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": id.String()},
+		bson.M{
+			"$set": bson.M{
+				"records":    records,
+				"updated_at": time.Now(),
+			},
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("update records: %w", err)
+	}
+
+	if result.MatchedCount == 0 {
+		return player.ErrStatsNotFound
+	}
+
+	return nil
+}
+
 // IncrementStats increments stats after a match.
 func (r *PlayerStatsRepository) IncrementStats(ctx context.Context, id uuid.UUID, statsToAdd map[string]interface{}) error {
 	inc := bson.M{
@@ -197,7 +369,8 @@ func (r *PlayerStatsRepository) IncrementStats(ctx context.Context, id uuid.UUID
 	}
 
 	now := time.Now()
-	result, err := r.collection.UpdateOne(
+	var doc playerStatsDocument
+	err := r.collection.FindOneAndUpdate(
 		ctx,
 		bson.M{"_id": id.String()},
 		bson.M{
@@ -207,28 +380,85 @@ func (r *PlayerStatsRepository) IncrementStats(ctx context.Context, id uuid.UUID
 				"updated_at":    now,
 			},
 		},
-	)
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&doc)
 	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return player.ErrStatsNotFound
+		}
 		return fmt.Errorf("increment stats: %w", err)
 	}
 
-	if result.MatchedCount == 0 {
-		return player.ErrStatsNotFound
+	// Each rated match narrows the rating uncertainty; UpdateRanking rescales
+	// it to the game's calculator when the ranking is recalculated
+	_, err = r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": id.String()},
+		bson.M{"$set": bson.M{"rating_deviation": player.RatingDeviationAfter(doc.MatchesPlayed, player.DefaultScoreRange)}},
+	)
+	if err != nil {
+		return fmt.Errorf("update rating deviation: %w", err)
+	}
+
+	return nil
+}
+
+// AdjustStats adds deltas to a player's stat totals and match count.
+func (r *PlayerStatsRepository) AdjustStats(ctx context.Context, id uuid.UUID, deltas map[string]int, matchesPlayed int) error {
+	inc := bson.M{"matches_played": matchesPlayed}
+	for k, v := range deltas {
+		inc["stats."+k] = v
+	}
+
+	var doc playerStatsDocument
+	err := r.collection.FindOneAndUpdate(
+		ctx,
+		bson.M{"_id": id.String()},
+		bson.M{
+			"$inc": inc,
+			"$set": bson.M{"updated_at": time.Now()},
+		},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&doc)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return player.ErrStatsNotFound
+		}
+		return fmt.Errorf("adjust stats: %w", err)
+	}
+
+	if matchesPlayed == 0 {
+		return nil
+	}
+
+	_, err = r.collection.UpdateOne(
+		ctx,
+		bson.M{"_id": id.String()},
+		bson.M{"$set": bson.M{"rating_deviation": player.RatingDeviationAfter(doc.MatchesPlayed, player.DefaultScoreRange)}},
+	)
+	if err != nil {
+		return fmt.Errorf("update rating deviation: %w", err)
 	}
 
 	return nil
 }
 
 // GetLeaderboard retrieves the top players for a game.
-func (r *PlayerStatsRepository) GetLeaderboard(ctx context.Context, gameID uuid.UUID, limit, offset int64) ([]player.LeaderboardEntry, error) {
+func (r *PlayerStatsRepository) GetLeaderboard(ctx context.Context, gameID uuid.UUID, scope player.StatsScope, sortBy player.LeaderboardSort, ranking player.RankingMode, limit, offset int64) ([]player.LeaderboardEntry, error) {
 	pipeline := mongo.Pipeline{
-		// Match by game
-		{{Key: "$match", Value: bson.M{"game_id": gameID.String()}}},
-		// Sort by ranking score descending
-		{{Key: "$sort", Value: bson.D{{Key: "ranking_score", Value: -1}}}},
+		// Match by game, mode and season
+		{{Key: "$match", Value: leaderboardFilter(gameID, scope)}},
+		// Derive rating uncertainty fields
+		ratingDeviationStage(),
+		// Number tied ratings before the page is cut
+		competitionRankStage(sortBy),
+		// Sort by ranking score (or conservative rating) descending, then by the tie-breakers
+		leaderboardSortStage(sortBy),
 		// Skip and limit for pagination
 		{{Key: "$skip", Value: offset}},
 		{{Key: "$limit", Value: limit}},
+		// Lookup the rank from a day ago
+		rankSnapshotLookupStage(time.Now().Add(-player.RankDeltaWindow)),
 		// Lookup player info
 		{{Key: "$lookup", Value: bson.M{
 			"from":         PlayersCollection,
@@ -243,66 +473,80 @@ func (r *PlayerStatsRepository) GetLeaderboard(ctx context.Context, gameID uuid.
 		}}},
 		// Project final fields
 		{{Key: "$project", Value: bson.M{
-			"player_id":      1,
-			"ranking_score":  1,
-			"tier":           1,
-			"matches_played": 1,
-			"stats":          1,
-			"display_name":   "$player_info.display_name",
-			"avatar_url":     "$player_info.avatar_url",
+			"player_id":        1,
+			"ranking_score":    1,
+			"rating_deviation": 1,
+			"tier":             1,
+			"matches_played":   1,
+			"stats":            1,
+			"last_match_at":    1,
+			"competition_rank": 1,
+			"display_name":     "$player_info.display_name",
+			"avatar_url":       "$player_info.avatar_url",
+			"anonymous":        "$player_info.hide_from_leaderboards",
+			"rank_snapshot":    bson.M{"$first": "$rank_snapshot"},
 		}}},
 	}
 
-	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	cursor, err := r.reads.Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, fmt.Errorf("aggregate leaderboard: %w", err)
 	}
-	defer cursor.Close(ctx)
+	defer closeCursor(cursor)
 
 	var entries []player.LeaderboardEntry
 	rank := int(offset) + 1
 
 	for cursor.Next(ctx) {
-		var result struct {
-			PlayerID      string                 `bson:"player_id"`
-			RankingScore  float64                `bson:"ranking_score"`
-			Tier          string                 `bson:"tier"`
-			MatchesPlayed int                    `bson:"matches_played"`
-			Stats         map[string]interface{} `bson:"stats"`
-			DisplayName   string                 `bson:"display_name"`
-			AvatarURL     string                 `bson:"avatar_url"`
-		}
+		var result leaderboardEntryDocument
 
 		if err := cursor.Decode(&result); err != nil {
 			return nil, fmt.Errorf("decode leaderboard entry: %w", err)
 		}
 
-		playerID, _ := uuid.Parse(result.PlayerID)
+		playerID, err := uuid.Parse(result.PlayerID)
+		incomplete := err != nil
+
+		entryRank := rank
+		if ranking == player.RankingCompetition {
+			entryRank = result.CompetitionRank
+		}
 
 		entries = append(entries, player.LeaderboardEntry{
-			Rank:          rank,
-			PlayerID:      playerID,
-			DisplayName:   result.DisplayName,
-			AvatarURL:     result.AvatarURL,
-			RankingScore:  result.RankingScore,
-			Tier:          player.Tier(result.Tier),
-			MatchesPlayed: result.MatchesPlayed,
-			Stats:         result.Stats,
+			Rank:            entryRank,
+			PlayerID:        playerID,
+			DisplayName:     result.DisplayName,
+			AvatarURL:       result.AvatarURL,
+			Anonymous:       result.Anonymous,
+			RankingScore:    result.RankingScore,
+			RatingDeviation: result.RatingDeviation,
+			Tier:            player.Tier(result.Tier),
+			MatchesPlayed:   result.MatchesPlayed,
+			Stats:           result.Stats,
+			LastMatchAt:     result.LastMatchAt,
+			PreviousRank:    result.RankSnapshot.rankFor(sortBy, ranking),
+			DataIncomplete:  incomplete,
 		})
 		rank++
 	}
 
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
 	return entries, nil
 }
 
 // GetLeaderboardByTier retrieves top players filtered by tier.
-func (r *PlayerStatsRepository) GetLeaderboardByTier(ctx context.Context, gameID uuid.UUID, tier player.Tier, limit int64) ([]player.LeaderboardEntry, error) {
+func (r *PlayerStatsRepository) GetLeaderboardByTier(ctx context.Context, gameID uuid.UUID, scope player.StatsScope, tier player.Tier, sortBy player.LeaderboardSort, ranking player.RankingMode, limit int64) ([]player.LeaderboardEntry, error) {
+	filter := leaderboardFilter(gameID, scope)
+	filter["tier"] = string(tier)
+
 	pipeline := mongo.Pipeline{
-		{{Key: "$match", Value: bson.M{
-			"game_id": gameID.String(),
-			"tier":    string(tier),
-		}}},
-		{{Key: "$sort", Value: bson.D{{Key: "ranking_score", Value: -1}}}},
+		{{Key: "$match", Value: filter}},
+		ratingDeviationStage(),
+		competitionRankStage(sortBy),
+		leaderboardSortStage(sortBy),
 		{{Key: "$limit", Value: limit}},
 		{{Key: "$lookup", Value: bson.M{
 			"from":         PlayersCollection,
@@ -315,106 +559,142 @@ func (r *PlayerStatsRepository) GetLeaderboardByTier(ctx context.Context, gameID
 			"preserveNullAndEmptyArrays": true,
 		}}},
 		{{Key: "$project", Value: bson.M{
-			"player_id":      1,
-			"ranking_score":  1,
-			"tier":           1,
-			"matches_played": 1,
-			"stats":          1,
-			"display_name":   "$player_info.display_name",
-			"avatar_url":     "$player_info.avatar_url",
+			"player_id":        1,
+			"ranking_score":    1,
+			"rating_deviation": 1,
+			"tier":             1,
+			"matches_played":   1,
+			"stats":            1,
+			"last_match_at":    1,
+			"competition_rank": 1,
+			"display_name":     "$player_info.display_name",
+			"avatar_url":       "$player_info.avatar_url",
+			"anonymous":        "$player_info.hide_from_leaderboards",
 		}}},
 	}
 
-	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	cursor, err := r.reads.Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, fmt.Errorf("aggregate leaderboard by tier: %w", err)
 	}
-	defer cursor.Close(ctx)
+	defer closeCursor(cursor)
 
 	var entries []player.LeaderboardEntry
 	rank := 1
 
 	for cursor.Next(ctx) {
-		var result struct {
-			PlayerID      string                 `bson:"player_id"`
-			RankingScore  float64                `bson:"ranking_score"`
-			Tier          string                 `bson:"tier"`
-			MatchesPlayed int                    `bson:"matches_played"`
-			Stats         map[string]interface{} `bson:"stats"`
-			DisplayName   string                 `bson:"display_name"`
-			AvatarURL     string                 `bson:"avatar_url"`
-		}
+		var result leaderboardEntryDocument
 
 		if err := cursor.Decode(&result); err != nil {
 			return nil, fmt.Errorf("decode leaderboard entry: %w", err)
 		}
 
-		playerID, _ := uuid.Parse(result.PlayerID)
+		playerID, err := uuid.Parse(result.PlayerID)
+		incomplete := err != nil
+
+		entryRank := rank
+		if ranking == player.RankingCompetition {
+			entryRank = result.CompetitionRank
+		}
 
 		entries = append(entries, player.LeaderboardEntry{
-			Rank:          rank,
-			PlayerID:      playerID,
-			DisplayName:   result.DisplayName,
-			AvatarURL:     result.AvatarURL,
-			RankingScore:  result.RankingScore,
-			Tier:          player.Tier(result.Tier),
-			MatchesPlayed: result.MatchesPlayed,
-			Stats:         result.Stats,
+			Rank:            entryRank,
+			PlayerID:        playerID,
+			DisplayName:     result.DisplayName,
+			AvatarURL:       result.AvatarURL,
+			Anonymous:       result.Anonymous,
+			RankingScore:    result.RankingScore,
+			RatingDeviation: result.RatingDeviation,
+			Tier:            player.Tier(result.Tier),
+			MatchesPlayed:   result.MatchesPlayed,
+			Stats:           result.Stats,
+			LastMatchAt:     result.LastMatchAt,
+			DataIncomplete:  incomplete,
 		})
 		rank++
 	}
 
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
 	return entries, nil
 }
 
 // GetPlayerRank retrieves a player's rank in a game.
-func (r *PlayerStatsRepository) GetPlayerRank(ctx context.Context, playerID, gameID uuid.UUID) (*player.PlayerRankInfo, error) {
+func (r *PlayerStatsRepository) GetPlayerRank(ctx context.Context, playerID, gameID uuid.UUID, scope player.StatsScope) (*player.PlayerRankInfo, error) {
 	// Get player's stats first
-	ps, err := r.GetByPlayerAndGame(ctx, playerID, gameID)
+	ps, err := r.getByPlayerGameAndScope(ctx, playerID, gameID, scope)
 	if err != nil {
 		return nil, err
 	}
 
 	// Count players with higher score
-	count, err := r.collection.CountDocuments(ctx, bson.M{
-		"game_id":       gameID.String(),
-		"ranking_score": bson.M{"$gt": ps.RankingScore},
-	})
+	filter := leaderboardFilter(gameID, scope)
+	filter["ranking_score"] = bson.M{"$gt": ps.RankingScore}
+	count, err := r.collection.CountDocuments(ctx, filter)
 	if err != nil {
 		return nil, fmt.Errorf("count higher ranked players: %w", err)
 	}
 
 	return &player.PlayerRankInfo{
-		Rank:         count + 1,
-		RankingScore: ps.RankingScore,
-		Tier:         ps.Tier,
+		Rank:            count + 1,
+		RankingScore:    ps.RankingScore,
+		RatingDeviation: ps.RatingDeviation,
+		Tier:            ps.Tier,
 	}, nil
 }
 
 // CountByGame returns the total number of players with stats for a game.
-func (r *PlayerStatsRepository) CountByGame(ctx context.Context, gameID uuid.UUID) (int64, error) {
-	count, err := r.collection.CountDocuments(ctx, bson.M{"game_id": gameID.String()})
+func (r *PlayerStatsRepository) CountByGame(ctx context.Context, gameID uuid.UUID, scope player.StatsScope) (int64, error) {
+	count, err := r.reads.CountDocuments(ctx, leaderboardFilter(gameID, scope))
 	if err != nil {
 		return 0, fmt.Errorf("count players by game: %w", err)
 	}
 	return count, nil
 }
 
+// CountStatAbove counts players in a game whose per-match average for a stat
+// exceeds perMatch, along with the number of players who have played a match.
+func (r *PlayerStatsRepository) CountStatAbove(ctx context.Context, gameID uuid.UUID, statName string, perMatch float64) (int64, int64, error) {
+	played := leaderboardFilter(gameID, player.StatsScope{})
+	played["matches_played"] = bson.M{"$gt": 0}
+
+	total, err := r.collection.CountDocuments(ctx, played)
+	if err != nil {
+		return 0, 0, fmt.Errorf("count players with matches: %w", err)
+	}
+
+	above := leaderboardFilter(gameID, player.StatsScope{})
+	above["matches_played"] = bson.M{"$gt": 0}
+	above["$expr"] = bson.M{"$gt": bson.A{
+		bson.M{"$divide": bson.A{bson.M{"$ifNull": bson.A{"$stats." + statName, 0}}, "$matches_played"}},
+		perMatch,
+	}}
+
+	count, err := r.collection.CountDocuments(ctx, above)
+	if err != nil {
+		return 0, 0, fmt.Errorf("count players above stat: %w", err)
+	}
+
+	return count, total, nil
+}
+
 // GetTierDistribution returns the count of players in each tier for a game.
-func (r *PlayerStatsRepository) GetTierDistribution(ctx context.Context, gameID uuid.UUID) (map[player.Tier]int64, error) {
+func (r *PlayerStatsRepository) GetTierDistribution(ctx context.Context, gameID uuid.UUID, scope player.StatsScope) (map[player.Tier]int64, error) {
 	pipeline := mongo.Pipeline{
-		{{Key: "$match", Value: bson.M{"game_id": gameID.String()}}},
+		{{Key: "$match", Value: leaderboardFilter(gameID, scope)}},
 		{{Key: "$group", Value: bson.M{
 			"_id":   "$tier",
 			"count": bson.M{"$sum": 1},
 		}}},
 	}
 
-	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	cursor, err := r.reads.Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, fmt.Errorf("aggregate tier distribution: %w", err)
 	}
-	defer cursor.Close(ctx)
+	defer closeCursor(cursor)
 
 	distribution := make(map[player.Tier]int64)
 
@@ -431,6 +711,10 @@ func (r *PlayerStatsRepository) GetTierDistribution(ctx context.Context, gameID
 		distribution[player.Tier(result.Tier)] = result.Count
 	}
 
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
 	return distribution, nil
 }
 
@@ -439,8 +723,14 @@ func (r *PlayerStatsRepository) GetTopStatsByGame(ctx context.Context, gameID uu
 	statField := "stats." + statName
 
 	pipeline := mongo.Pipeline{
-		{{Key: "$match", Value: bson.M{"game_id": gameID.String()}}},
-		{{Key: "$sort", Value: bson.D{{Key: statField, Value: -1}}}},
+		{{Key: "$match", Value: leaderboardFilter(gameID, player.StatsScope{})}},
+		ratingDeviationStage(),
+		{{Key: "$sort", Value: bson.D{
+			{Key: statField, Value: -1},
+			{Key: "matches_played", Value: -1},
+			{Key: "last_match_at", Value: 1},
+			{Key: "player_id", Value: 1},
+		}}},
 		{{Key: "$limit", Value: limit}},
 		{{Key: "$lookup", Value: bson.M{
 			"from":         PlayersCollection,
@@ -453,97 +743,274 @@ func (r *PlayerStatsRepository) GetTopStatsByGame(ctx context.Context, gameID uu
 			"preserveNullAndEmptyArrays": true,
 		}}},
 		{{Key: "$project", Value: bson.M{
-			"player_id":      1,
-			"ranking_score":  1,
-			"tier":           1,
-			"matches_played": 1,
-			"stats":          1,
-			"display_name":   "$player_info.display_name",
-			"avatar_url":     "$player_info.avatar_url",
+			"player_id":        1,
+			"ranking_score":    1,
+			"rating_deviation": 1,
+			"tier":             1,
+			"matches_played":   1,
+			"stats":            1,
+			"display_name":     "$player_info.display_name",
+			"avatar_url":       "$player_info.avatar_url",
+			"anonymous":        "$player_info.hide_from_leaderboards",
 		}}},
 	}
 
-	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	cursor, err := r.reads.Aggregate(ctx, pipeline)
 	if err != nil {
 		return nil, fmt.Errorf("aggregate top stats: %w", err)
 	}
-	defer cursor.Close(ctx)
+	defer closeCursor(cursor)
 
 	var entries []player.LeaderboardEntry
 	rank := 1
 
 	for cursor.Next(ctx) {
-		var result struct {
-			PlayerID      string                 `bson:"player_id"`
-			RankingScore  float64                `bson:"ranking_score"`
-			Tier          string                 `bson:"tier"`
-			MatchesPlayed int                    `bson:"matches_played"`
-			Stats         map[string]interface{} `bson:"stats"`
-			DisplayName   string                 `bson:"display_name"`
-			AvatarURL     string                 `bson:"avatar_url"`
-		}
+		var result leaderboardEntryDocument
 
 		if err := cursor.Decode(&result); err != nil {
 			return nil, fmt.Errorf("decode top stats entry: %w", err)
 		}
 
-		playerID, _ := uuid.Parse(result.PlayerID)
+		playerID, err := uuid.Parse(result.PlayerID)
+		incomplete := err != nil
 
 		entries = append(entries, player.LeaderboardEntry{
-			Rank:          rank,
-			PlayerID:      playerID,
-			DisplayName:   result.DisplayName,
-			AvatarURL:     result.AvatarURL,
-			RankingScore:  result.RankingScore,
-			Tier:          player.Tier(result.Tier),
-			MatchesPlayed: result.MatchesPlayed,
-			Stats:         result.Stats,
+			Rank:            rank,
+			PlayerID:        playerID,
+			DisplayName:     result.DisplayName,
+			AvatarURL:       result.AvatarURL,
+			Anonymous:       result.Anonymous,
+			RankingScore:    result.RankingScore,
+			RatingDeviation: result.RatingDeviation,
+			Tier:            player.Tier(result.Tier),
+			MatchesPlayed:   result.MatchesPlayed,
+			Stats:           result.Stats,
+			DataIncomplete:  incomplete,
 		})
 		rank++
 	}
 
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
 	return entries, nil
 }
 
+// SnapshotRanks records every player's current leaderboard rank for a game
+// and mode. Ranks are computed and written server-side; snapshots expire after
+// twice the rank delta window.
+func (r *PlayerStatsRepository) SnapshotRanks(ctx context.Context, gameID uuid.UUID, mode string, takenAt time.Time) error {
+	pipeline := mongo.Pipeline{
+		{{Key: "$match", Value: leaderboardFilter(gameID, player.StatsScope{Mode: mode})}},
+		ratingDeviationStage(),
+		{{Key: "$setWindowFields", Value: bson.M{
+			"sortBy": leaderboardSortKeys(player.LeaderboardSortScore),
+			"output": bson.M{"rank": bson.M{"$documentNumber": bson.M{}}},
+		}}},
+		{{Key: "$setWindowFields", Value: bson.M{
+			"sortBy": leaderboardSortKeys(player.LeaderboardSortConservative),
+			"output": bson.M{"conservative_rank": bson.M{"$documentNumber": bson.M{}}},
+		}}},
+		{{Key: "$setWindowFields", Value: bson.M{
+			"sortBy": bson.D{{Key: "ranking_score", Value: -1}},
+			"output": bson.M{"competition_rank": bson.M{"$rank": bson.M{}}},
+		}}},
+		{{Key: "$setWindowFields", Value: bson.M{
+			"sortBy": bson.D{{Key: "conservative_rating", Value: -1}},
+			"output": bson.M{"conservative_competition_rank": bson.M{"$rank": bson.M{}}},
+		}}},
+		{{Key: "$project", Value: bson.M{
+			"_id":                           bson.M{"stats_id": "$_id", "taken_at": bson.M{"$literal": takenAt}},
+			"stats_id":                      "$_id",
+			"rank":                          1,
+			"conservative_rank":             1,
+			"competition_rank":              1,
+			"conservative_competition_rank": 1,
+			"taken_at":                      bson.M{"$literal": takenAt},
+		}}},
+		{{Key: "$merge", Value: bson.M{
+			"into":           RankSnapshotsCollection,
+			"whenMatched":    "replace",
+			"whenNotMatched": "insert",
+		}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return fmt.Errorf("aggregate rank snapshot: %w", err)
+	}
+	closeCursor(cursor)
+	return nil
+}
+
+// SetPlayerDeactivated hides or restores all of a player's stats on leaderboards.
+func (r *PlayerStatsRepository) SetPlayerDeactivated(ctx context.Context, playerID uuid.UUID, deactivated bool) error {
+	update := bson.M{"$set": bson.M{"is_deactivated": deactivated, "updated_at": time.Now()}}
+	if _, err := r.collection.UpdateMany(ctx, bson.M{"player_id": playerID.String()}, update); err != nil {
+		return fmt.Errorf("set player stats deactivated: %w", err)
+	}
+	return nil
+}
+
+// FindOrphaned returns up to limit stats documents whose player_id matches no
+// player profile, whether the profile is gone or the ID is malformed.
+func (r *PlayerStatsRepository) FindOrphaned(ctx context.Context, limit int64) ([]player.OrphanedStats, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$lookup", Value: bson.M{
+			"from":         PlayersCollection,
+			"localField":   "player_id",
+			"foreignField": "_id",
+			"as":           "player_info",
+		}}},
+		{{Key: "$match", Value: bson.M{"player_info": bson.M{"$size": 0}}}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$project", Value: bson.M{"player_id": 1, "game_id": 1, "mode": 1}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate orphaned player stats: %w", err)
+	}
+	defer closeCursor(cursor)
+
+	var orphans []player.OrphanedStats
+	for cursor.Next(ctx) {
+		var doc struct {
+			ID       string `bson:"_id"`
+			PlayerID string `bson:"player_id"`
+			GameID   string `bson:"game_id"`
+			Mode     string `bson:"mode"`
+		}
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decode orphaned player stats: %w", err)
+		}
+		orphans = append(orphans, player.OrphanedStats{
+			ID:       doc.ID,
+			PlayerID: doc.PlayerID,
+			GameID:   doc.GameID,
+			Mode:     doc.Mode,
+		})
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return orphans, nil
+}
+
+// RelinkPlayer rewrites the player_id of a stats document.
+func (r *PlayerStatsRepository) RelinkPlayer(ctx context.Context, statsID string, playerID uuid.UUID) error {
+	result, err := r.collection.UpdateOne(ctx,
+		bson.M{"_id": statsID},
+		bson.M{"$set": bson.M{"player_id": playerID.String(), "updated_at": time.Now()}},
+	)
+	if err != nil {
+		return fmt.Errorf("relink player stats: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return player.ErrStatsNotFound
+	}
+	return nil
+}
+
+// DeleteOrphaned removes a stats document.
+func (r *PlayerStatsRepository) DeleteOrphaned(ctx context.Context, statsID string) error {
+	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": statsID})
+	if err != nil {
+		return fmt.Errorf("delete orphaned player stats: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return player.ErrStatsNotFound
+	}
+	return nil
+}
+
 // EnsureIndexes creates necessary indexes for the player_stats collection.
 func (r *PlayerStatsRepository) EnsureIndexes(ctx context.Context) error {
+	// The unique player/game index predates game modes and would reject per-mode
+	// stats, and the player/game/mode indexes predate seasons and would reject
+	// season stats or miss season leaderboards
+	legacy := []string{
+		"player_id_1_game_id_1",
+		"player_id_1_game_id_1_mode_1",
+		"game_id_1_mode_1_ranking_score_-1",
+		"game_id_1_mode_1_tier_1",
+	}
+	for _, name := range legacy {
+		if _, err := r.collection.Indexes().DropOne(ctx, name); err != nil && !isIndexNotFound(err) {
+			return fmt.Errorf("drop legacy player stats index: %w", err)
+		}
+	}
+
 	indexes := []mongo.IndexModel{
 		{
-			Keys:    bson.D{{Key: "player_id", Value: 1}, {Key: "game_id", Value: 1}},
+			Keys:    bson.D{{Key: "player_id", Value: 1}, {Key: "game_id", Value: 1}, {Key: "mode", Value: 1}, {Key: "season_id", Value: 1}},
 			Options: options.Index().SetUnique(true),
 		},
 		{
-			Keys: bson.D{{Key: "game_id", Value: 1}, {Key: "ranking_score", Value: -1}},
+			Keys: bson.D{{Key: "game_id", Value: 1}, {Key: "mode", Value: 1}, {Key: "season_id", Value: 1}, {Key: "ranking_score", Value: -1}},
 		},
 		{
-			Keys: bson.D{{Key: "game_id", Value: 1}, {Key: "tier", Value: 1}},
+			Keys: bson.D{{Key: "game_id", Value: 1}, {Key: "mode", Value: 1}, {Key: "season_id", Value: 1}, {Key: "tier", Value: 1}},
 		},
 		{
 			Keys: bson.D{{Key: "player_id", Value: 1}},
 		},
 	}
 
+	// The averages and win rate kept on every game's stats are the usual
+	// per-stat sort keys
+	for _, stat := range []string{"avg_kills", "avg_damage", "win_rate"} {
+		indexes = append(indexes, mongo.IndexModel{
+			Keys: bson.D{{Key: "game_id", Value: 1}, {Key: "mode", Value: 1}, {Key: "season_id", Value: 1}, {Key: "stats." + stat, Value: -1}},
+		})
+	}
+
 	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
 	if err != nil {
 		return fmt.Errorf("create player stats indexes: %w", err)
 	}
 
+	snapshotIndexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "stats_id", Value: 1}, {Key: "taken_at", Value: -1}},
+		},
+		{
+			Keys:    bson.D{{Key: "taken_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(int32((2 * player.RankDeltaWindow).Seconds())),
+		},
+	}
+
+	if _, err := r.snapshotCollection.Indexes().CreateMany(ctx, snapshotIndexes); err != nil {
+		return fmt.Errorf("create rank snapshot indexes: %w", err)
+	}
+
 	return nil
 }
 
 // toPlayerStatsDocument converts a domain PlayerStats to a MongoDB document.
 func toPlayerStatsDocument(ps *player.PlayerStats) *playerStatsDocument {
 	return &playerStatsDocument{
-		ID:            ps.ID.String(),
-		PlayerID:      ps.PlayerID.String(),
-		GameID:        ps.GameID.String(),
-		Stats:         ps.Stats,
-		MatchesPlayed: ps.MatchesPlayed,
-		RankingScore:  ps.RankingScore,
-		Tier:          string(ps.Tier),
-		LastMatchAt:   ps.LastMatchAt,
-		CreatedAt:     ps.CreatedAt,
-		UpdatedAt:     ps.UpdatedAt,
+		ID:                 ps.ID.String(),
+		PlayerID:           ps.PlayerID.String(),
+		GameID:             ps.GameID.String(),
+		Mode:               ps.Mode,
+		SeasonID:           seasonIDString(ps.SeasonID),
+		Stats:              ps.Stats,
+		RollupSamples:      ps.RollupSamples,
+		MatchesPlayed:      ps.MatchesPlayed,
+		RankingScore:       ps.RankingScore,
+		RatingDeviation:    ps.RatingDeviation,
+		Tier:               string(ps.Tier),
+		ConsistencyScore:   ps.ConsistencyScore,
+		ConsistencySamples: ps.ConsistencySamples,
+		Records:            ps.Records,
+		LastMatchAt:        ps.LastMatchAt,
+		PlayerDeactivated:  ps.PlayerDeactivated,
+		CreatedAt:          ps.CreatedAt,
+		UpdatedAt:          ps.UpdatedAt,
 	}
 }
 
@@ -564,21 +1031,171 @@ func toPlayerStatsEntity(doc *playerStatsDocument) (*player.PlayerStats, error)
 		return nil, fmt.Errorf("parse game id: %w", err)
 	}
 
+	var seasonID uuid.UUID
+	if doc.SeasonID != "" {
+		if seasonID, err = uuid.Parse(doc.SeasonID); err != nil {
+			return nil, fmt.Errorf("parse season id: %w", err)
+		}
+	}
+
 	stats := doc.Stats
 	if stats == nil {
 		stats = make(map[string]interface{})
 	}
 
+	// Documents written before rating deviation was tracked derive it from match count
+	deviation := doc.RatingDeviation
+	if deviation == 0 {
+		deviation = player.RatingDeviationAfter(doc.MatchesPlayed, player.DefaultScoreRange)
+	}
+
 	return &player.PlayerStats{
-		ID:            id,
-		PlayerID:      playerID,
-		GameID:        gameID,
-		Stats:         stats,
-		MatchesPlayed: doc.MatchesPlayed,
-		RankingScore:  doc.RankingScore,
-		Tier:          player.Tier(doc.Tier),
-		LastMatchAt:   doc.LastMatchAt,
-		CreatedAt:     doc.CreatedAt,
-		UpdatedAt:     doc.UpdatedAt,
+		ID:                 id,
+		PlayerID:           playerID,
+		GameID:             gameID,
+		Mode:               doc.Mode,
+		SeasonID:           seasonID,
+		Stats:              stats,
+		RollupSamples:      doc.RollupSamples,
+		MatchesPlayed:      doc.MatchesPlayed,
+		RankingScore:       doc.RankingScore,
+		RatingDeviation:    deviation,
+		Tier:               player.Tier(doc.Tier),
+		ConsistencyScore:   doc.ConsistencyScore,
+		ConsistencySamples: doc.ConsistencySamples,
+		Records:            doc.Records,
+		LastMatchAt:        doc.LastMatchAt,
+		PlayerDeactivated:  doc.PlayerDeactivated,
+		CreatedAt:          doc.CreatedAt,
+		UpdatedAt:          doc.UpdatedAt,
 	}, nil
 }
+
+// ratingDeviationStage fills in rating_deviation for documents written before it
+// was tracked and derives the conservative rating used for sorting.
+func ratingDeviationStage() bson.D {
+	deviation := bson.M{"$ifNull": bson.A{
+		"$rating_deviation",
+		bson.M{"$max": bson.A{
+			player.MinRatingDeviation * player.DefaultScoreRange,
+			bson.M{"$divide": bson.A{
+				player.InitialRatingDeviation * player.DefaultScoreRange,
+				bson.M{"$sqrt": bson.M{"$add": bson.A{1, bson.M{"$ifNull": bson.A{"$matches_played", 0}}}}},
+			}},
+		}},
+	}}
+
+	return bson.D{{Key: "$addFields", Value: bson.M{
+		"rating_deviation":    deviation,
+		"conservative_rating": bson.M{"$subtract": bson.A{"$ranking_score", deviation}},
+	}}}
+}
+
+// rankSnapshotLookupStage joins the newest rank snapshot taken at or before
+// cutoff as a one-element "rank_snapshot" array.
+func rankSnapshotLookupStage(cutoff time.Time) bson.D {
+	return bson.D{{Key: "$lookup", Value: bson.M{
+		"from": RankSnapshotsCollection,
+		"let":  bson.M{"statsId": "$_id"},
+		"pipeline": mongo.Pipeline{
+			{{Key: "$match", Value: bson.M{
+				"$expr":    bson.M{"$eq": bson.A{"$stats_id", "$$statsId"}},
+				"taken_at": bson.M{"$lte": cutoff},
+			}}},
+			{{Key: "$sort", Value: bson.D{{Key: "taken_at", Value: -1}}}},
+			{{Key: "$limit", Value: 1}},
+		},
+		"as": "rank_snapshot",
+	}}}
+}
+
+// rankFor returns the snapshot rank under the given sort and ranking mode, or
+// 0 for a missing snapshot. Snapshots taken before competition ranks were
+// recorded report 0 for them.
+func (d *rankSnapshotDocument) rankFor(sortBy player.LeaderboardSort, ranking player.RankingMode) int {
+	if d == nil {
+		return 0
+	}
+	conservative := sortBy == player.LeaderboardSortConservative
+	switch {
+	case ranking == player.RankingCompetition && conservative:
+		return d.ConservativeCompetitionRank
+	case ranking == player.RankingCompetition:
+		return d.CompetitionRank
+	case conservative:
+		return d.ConservativeRank
+	default:
+		return d.Rank
+	}
+}
+
+// leaderboardRatingField is the rating a leaderboard sort orders by.
+func leaderboardRatingField(sortBy player.LeaderboardSort) string {
+	if sortBy == player.LeaderboardSortConservative {
+		return "conservative_rating"
+	}
+	return "ranking_score"
+}
+
+// leaderboardSortKeys orders by the requested rating and breaks ties by more
+// matches played, the earlier last match and finally the player ID, so equal
+// ratings keep the same relative order between requests.
+func leaderboardSortKeys(sortBy player.LeaderboardSort) bson.D {
+	return bson.D{
+		{Key: leaderboardRatingField(sortBy), Value: -1},
+		{Key: "matches_played", Value: -1},
+		{Key: "last_match_at", Value: 1},
+		{Key: "player_id", Value: 1},
+	}
+}
+
+// leaderboardSortStage orders leaderboard results by the requested rating.
+func leaderboardSortStage(sortBy player.LeaderboardSort) bson.D {
+	return bson.D{{Key: "$sort", Value: leaderboardSortKeys(sortBy)}}
+}
+
+// competitionRankStage numbers entries by their rating alone, so tied ratings
+// share a rank and the following ranks are skipped (1, 2, 2, 4).
+func competitionRankStage(sortBy player.LeaderboardSort) bson.D {
+	return bson.D{{Key: "$setWindowFields", Value: bson.M{
+		"sortBy": bson.D{{Key: leaderboardRatingField(sortBy), Value: -1}},
+		"output": bson.M{"competition_rank": bson.M{"$rank": bson.M{}}},
+	}}}
+}
+
+// statsScopeFilter matches stats for a game, mode and season. The empty mode
+// matches the all-modes stats, which are stored without a mode field, and the
+// nil season the all-time stats, which are stored without a season field.
+func statsScopeFilter(gameID uuid.UUID, scope player.StatsScope) bson.M {
+	filter := bson.M{"game_id": gameID.String(), "mode": nil, "season_id": nil}
+	if scope.Mode != "" {
+		filter["mode"] = scope.Mode
+	}
+	if scope.SeasonID != uuid.Nil {
+		filter["season_id"] = scope.SeasonID.String()
+	}
+	return filter
+}
+
+// seasonIDString returns the stored form of a season ID: empty, and so
+// omitted, for all-time stats.
+func seasonIDString(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+	return id.String()
+}
+
+// leaderboardFilter matches the stats for a game, mode and season that are
+// ranked publicly, leaving out players who deactivated their account.
+func leaderboardFilter(gameID uuid.UUID, scope player.StatsScope) bson.M {
+	filter := statsScopeFilter(gameID, scope)
+	filter["is_deactivated"] = bson.M{"$ne": true}
+	return filter
+}
+
+// isIndexNotFound reports whether err is MongoDB's IndexNotFound error.
+func isIndexNotFound(err error) bool {
+	var cmdErr mongo.CommandError
+	return errors.As(err, &cmdErr) && (cmdErr.Code == 27 || cmdErr.HasErrorMessage("index not found"))
+}
@@ -0,0 +1,91 @@
+package mongodb
+
+import (
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	"go.mongodb.org/mongo-driver/event"
+)
+
+// PoolStats tracks connection pool usage across every server the client
+// talks to, fed by the driver's pool events.
+type PoolStats struct {
+	maxPoolSize uint64
+
+	open             atomic.Int64
+	inUse            atomic.Int64
+	waiting          atomic.Int64
+	checkouts        atomic.Int64
+	checkoutFailures atomic.Int64
+	waitNanos        atomic.Int64
+	maxWaitNanos     atomic.Int64
+	cleared          atomic.Int64
+}
+
+func newPoolStats(maxPoolSize uint64) *PoolStats {
+	return &PoolStats{maxPoolSize: maxPoolSize}
+}
+
+// Monitor returns a pool monitor that records pool events.
+func (s *PoolStats) Monitor() *event.PoolMonitor {
+	return &event.PoolMonitor{Event: s.record}
+}
+
+func (s *PoolStats) record(e *event.PoolEvent) {
+	switch e.Type {
+	case event.ConnectionCreated:
+		s.open.Add(1)
+	case event.ConnectionClosed:
+		s.open.Add(-1)
+	case event.GetStarted:
+		s.waiting.Add(1)
+	case event.GetSucceeded:
+		s.waiting.Add(-1)
+		s.inUse.Add(1)
+		s.checkouts.Add(1)
+		s.observeWait(e)
+	case event.GetFailed:
+		s.waiting.Add(-1)
+		s.checkoutFailures.Add(1)
+		s.observeWait(e)
+	case event.ConnectionReturned:
+		s.inUse.Add(-1)
+	case event.PoolCleared:
+		s.cleared.Add(1)
+	}
+}
+
+func (s *PoolStats) observeWait(e *event.PoolEvent) {
+	wait := e.Duration.Nanoseconds()
+	s.waitNanos.Add(wait)
+	for {
+		current := s.maxWaitNanos.Load()
+		if wait <= current || s.maxWaitNanos.CompareAndSwap(current, wait) {
+			return
+		}
+	}
+}
+
+// WriteMetrics writes pool gauges and counters in the Prometheus text format.
+func (s *PoolStats) WriteMetrics(w io.Writer) {
+	metrics := []struct {
+		name, help, kind string
+		value            string
+	}{
+		{"tourneyrank_mongodb_pool_max_size", "Maximum connections per MongoDB server pool.", "gauge", fmt.Sprint(s.maxPoolSize)},
+		{"tourneyrank_mongodb_pool_open_connections", "Open MongoDB connections.", "gauge", fmt.Sprint(s.open.Load())},
+		{"tourneyrank_mongodb_pool_in_use_connections", "MongoDB connections checked out by operations.", "gauge", fmt.Sprint(s.inUse.Load())},
+		{"tourneyrank_mongodb_pool_waiting_operations", "Operations waiting for a MongoDB connection.", "gauge", fmt.Sprint(s.waiting.Load())},
+		{"tourneyrank_mongodb_pool_checkouts_total", "Successful MongoDB connection checkouts.", "counter", fmt.Sprint(s.checkouts.Load())},
+		{"tourneyrank_mongodb_pool_checkout_failures_total", "Failed MongoDB connection checkouts, including wait timeouts.", "counter", fmt.Sprint(s.checkoutFailures.Load())},
+		{"tourneyrank_mongodb_pool_wait_seconds_total", "Total time spent waiting for MongoDB connections.", "counter", fmt.Sprintf("%.6f", float64(s.waitNanos.Load())/1e9)},
+		{"tourneyrank_mongodb_pool_max_wait_seconds", "Longest wait for a MongoDB connection since start.", "gauge", fmt.Sprintf("%.6f", float64(s.maxWaitNanos.Load())/1e9)},
+		{"tourneyrank_mongodb_pool_cleared_total", "Times a MongoDB connection pool was cleared after an error.", "counter", fmt.Sprint(s.cleared.Load())},
+	}
+	for _, m := range metrics {
+		fmt.Fprintf(w, "# HELP %s %s\n", m.name, m.help)
+		fmt.Fprintf(w, "# TYPE %s %s\n", m.name, m.kind)
+		fmt.Fprintf(w, "%s %s\n", m.name, m.value)
+	}
+}
@@ -0,0 +1,242 @@
+package mongodb
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsontype"
+	"go.mongodb.org/mongo-driver/event"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// cursorCloseTimeout bounds how long closing a cursor may take.
+const cursorCloseTimeout = 5 * time.Second
+
+// closeCursor releases a cursor on the server. It deliberately ignores the
+// request context: when a request is cancelled mid-iteration, closing with
+// its context would skip the killCursors command and leave the server-side
+// cursor open until it times out.
+func closeCursor(cursor *mongo.Cursor) {
+	ctx, cancel := context.WithTimeout(context.Background(), cursorCloseTimeout)
+	defer cancel()
+	_ = cursor.Close(ctx)
+}
+
+// decodeAll decodes every remaining document into results and closes the
+// cursor. Unlike Cursor.All, the cursor is closed even if ctx is cancelled.
+func decodeAll[T any](ctx context.Context, cursor *mongo.Cursor, results *[]T) error {
+	defer closeCursor(cursor)
+
+	for cursor.Next(ctx) {
+		var v T
+		if err := cursor.Decode(&v); err != nil {
+			return err
+		}
+		*results = append(*results, v)
+	}
+	return cursor.Err()
+}
+
+// findFields returns find options loading only the given fields of entity, a
+// struct whose fields carry both json and bson tags, named by their JSON
+// names, plus the always loaded document fields. The _id is always loaded;
+// nil fields loads every field and names entity does not have are skipped.
+func findFields(entity any, fields []string, always ...string) *options.FindOneOptions {
+	opts := options.FindOne()
+	if fields == nil {
+		return opts
+	}
+
+	t := reflect.TypeOf(entity)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	documentNames := make(map[string]string, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		jsonName, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		bsonName, _, _ := strings.Cut(t.Field(i).Tag.Get("bson"), ",")
+		if jsonName != "" && bsonName != "" {
+			documentNames[jsonName] = bsonName
+		}
+	}
+
+	projection := bson.M{"_id": 1}
+	for _, f := range fields {
+		if name, ok := documentNames[f]; ok {
+			projection[name] = 1
+		}
+	}
+	for _, name := range always {
+		projection[name] = 1
+	}
+	return opts.SetProjection(projection)
+}
+
+// filterFields names the field holding the filter of each command that has one.
+var filterFields = map[string]string{
+	"find":          "filter",
+	"aggregate":     "pipeline",
+	"count":         "query",
+	"distinct":      "query",
+	"findAndModify": "query",
+	"update":        "updates",
+	"delete":        "deletes",
+}
+
+type startedCommand struct {
+	collection string
+	filter     bson.RawValue
+}
+
+// slowQueryLogger logs commands slower than a threshold with their
+// collection and the shape of their filter. Filter values are replaced with
+// "?" so no user data ends up in the logs.
+type slowQueryLogger struct {
+	threshold time.Duration
+	logger    *slog.Logger
+
+	mu      sync.Mutex
+	started map[int64]startedCommand
+}
+
+func newSlowQueryLogger(threshold time.Duration, logger *slog.Logger) *slowQueryLogger {
+	return &slowQueryLogger{
+		threshold: threshold,
+		logger:    logger,
+		started:   make(map[int64]startedCommand),
+	}
+}
+
+func (l *slowQueryLogger) commandStarted(_ context.Context, e *event.CommandStartedEvent) {
+	field, ok := filterFields[e.CommandName]
+	if !ok {
+		return
+	}
+
+	// Database-level aggregations name no collection.
+	collection, _ := e.Command.Lookup(e.CommandName).StringValueOK()
+	cmd := startedCommand{collection: collection}
+	if filter, err := e.Command.LookupErr(field); err == nil {
+		// The event's buffer may be reused once the command is sent.
+		cmd.filter = bson.RawValue{Type: filter.Type, Value: append([]byte(nil), filter.Value...)}
+	}
+
+	l.mu.Lock()
+	l.started[e.RequestID] = cmd
+	l.mu.Unlock()
+}
+
+func (l *slowQueryLogger) commandFinished(e event.CommandFinishedEvent, err string) {
+	l.mu.Lock()
+	cmd, ok := l.started[e.RequestID]
+	delete(l.started, e.RequestID)
+	l.mu.Unlock()
+
+	if !ok || e.Duration < l.threshold {
+		return
+	}
+
+	attrs := []any{
+		"command", e.CommandName,
+		"collection", cmd.collection,
+		"filter", shape(cmd.filter),
+		"duration", e.Duration,
+	}
+	if err != "" {
+		attrs = append(attrs, "error", err)
+	}
+	l.logger.Warn("slow mongodb query", attrs...)
+}
+
+func (l *slowQueryLogger) monitor() *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: l.commandStarted,
+		Succeeded: func(_ context.Context, e *event.CommandSucceededEvent) {
+			l.commandFinished(e.CommandFinishedEvent, "")
+		},
+		Failed: func(_ context.Context, e *event.CommandFailedEvent) {
+			l.commandFinished(e.CommandFinishedEvent, e.Failure)
+		},
+	}
+}
+
+// shape renders a filter with every value replaced by "?", keeping field
+// names and operators, e.g. {game_id: ?, score: {$gt: ?}}.
+func shape(v bson.RawValue) string {
+	var b strings.Builder
+	writeShape(&b, v)
+	return b.String()
+}
+
+func writeShape(b *strings.Builder, v bson.RawValue) {
+	switch v.Type {
+	case bsontype.EmbeddedDocument:
+		elems, err := v.Document().Elements()
+		if err != nil {
+			b.WriteString("?")
+			return
+		}
+		b.WriteString("{")
+		for i, elem := range elems {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			b.WriteString(elem.Key())
+			b.WriteString(": ")
+			writeShape(b, elem.Value())
+		}
+		b.WriteString("}")
+	case bsontype.Array:
+		values, err := v.Array().Values()
+		if err != nil || len(values) == 0 || values[0].Type != bsontype.EmbeddedDocument {
+			// Arrays of plain values, as used by $in, only show as a placeholder.
+			b.WriteString("[?]")
+			return
+		}
+		b.WriteString("[")
+		for i, value := range values {
+			if i > 0 {
+				b.WriteString(", ")
+			}
+			writeShape(b, value)
+		}
+		b.WriteString("]")
+	case 0:
+		b.WriteString("{}")
+	default:
+		b.WriteString("?")
+	}
+}
+
+// combineMonitors fans command events out to every monitor.
+func combineMonitors(monitors ...*event.CommandMonitor) *event.CommandMonitor {
+	return &event.CommandMonitor{
+		Started: func(ctx context.Context, e *event.CommandStartedEvent) {
+			for _, m := range monitors {
+				if m.Started != nil {
+					m.Started(ctx, e)
+				}
+			}
+		},
+		Succeeded: func(ctx context.Context, e *event.CommandSucceededEvent) {
+			for _, m := range monitors {
+				if m.Succeeded != nil {
+					m.Succeeded(ctx, e)
+				}
+			}
+		},
+		Failed: func(ctx context.Context, e *event.CommandFailedEvent) {
+			for _, m := range monitors {
+				if m.Failed != nil {
+					m.Failed(ctx, e)
+				}
+			}
+		},
+	}
+}
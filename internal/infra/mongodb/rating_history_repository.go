@@ -0,0 +1,97 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/ranking"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RatingHistoryRepository implements ranking.HistoryRepository using MongoDB.
+type RatingHistoryRepository struct {
+	collection *mongo.Collection
+}
+
+// NewRatingHistoryRepository creates a new MongoDB rating history repository.
+func NewRatingHistoryRepository(db *mongo.Database) *RatingHistoryRepository {
+	return &RatingHistoryRepository{
+		collection: db.Collection("rating_history"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the rating history collection.
+func (r *RatingHistoryRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "player_id", Value: 1},
+				{Key: "game_id", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "game_id", Value: 1},
+				{Key: "boundary_version", Value: 1},
+				{Key: "reason", Value: 1},
+			},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating rating history indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a rating history entry.
+func (r *RatingHistoryRepository) Create(ctx context.Context, entry *ranking.HistoryEntry) error {
+	_, err := r.collection.InsertOne(ctx, entry)
+	if err != nil {
+		return fmt.Errorf("inserting rating history entry: %w", err)
+	}
+	return nil
+}
+
+// ListByPlayer returns a player's rating history for a game, newest first.
+func (r *RatingHistoryRepository) ListByPlayer(ctx context.Context, playerID, gameID uuid.UUID, limit int64) ([]*ranking.HistoryEntry, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"player_id": playerID, "game_id": gameID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding rating history: %w", err)
+	}
+
+	var entries []*ranking.HistoryEntry
+	if err := decodeAll(ctx, cursor, &entries); err != nil {
+		return nil, fmt.Errorf("decoding rating history: %w", err)
+	}
+
+	return entries, nil
+}
+
+// ListByBoundaryVersion returns a game's entries recorded for a reason under
+// a tier boundaries version.
+func (r *RatingHistoryRepository) ListByBoundaryVersion(ctx context.Context, gameID uuid.UUID, version int, reason ranking.HistoryReason) ([]*ranking.HistoryEntry, error) {
+	filter := bson.M{"game_id": gameID, "boundary_version": version, "reason": reason}
+	cursor, err := r.collection.Find(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("finding rating history: %w", err)
+	}
+
+	var entries []*ranking.HistoryEntry
+	if err := decodeAll(ctx, cursor, &entries); err != nil {
+		return nil, fmt.Errorf("decoding rating history: %w", err)
+	}
+
+	return entries, nil
+}
@@ -0,0 +1,125 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RegistrationQueueRepository implements team.RegistrationQueueRepository using MongoDB.
+type RegistrationQueueRepository struct {
+	collection *mongo.Collection
+}
+
+// NewRegistrationQueueRepository creates a new MongoDB registration queue repository.
+func NewRegistrationQueueRepository(db *mongo.Database) *RegistrationQueueRepository {
+	return &RegistrationQueueRepository{
+		collection: db.Collection("team_registration_queue"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the registration queue collection.
+// Processed tickets are removed by MongoDB once they expire.
+func (r *RegistrationQueueRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			// A player can wait only once per tournament
+			Keys: bson.D{{Key: "tournament_id", Value: 1}, {Key: "player_id", Value: 1}},
+			Options: options.Index().
+				SetUnique(true).
+				SetPartialFilterExpression(bson.M{"status": team.TicketWaiting}),
+		},
+		{
+			Keys: bson.D{{Key: "status", Value: 1}, {Key: "created_at", Value: 1}},
+		},
+		{
+			Keys: bson.D{{Key: "tournament_id", Value: 1}, {Key: "status", Value: 1}, {Key: "created_at", Value: 1}},
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating registration queue indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new ticket.
+func (r *RegistrationQueueRepository) Create(ctx context.Context, ticket *team.RegistrationTicket) error {
+	if _, err := r.collection.InsertOne(ctx, ticket); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return team.ErrAlreadyQueued
+		}
+		return fmt.Errorf("inserting registration ticket: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a ticket by its ID.
+func (r *RegistrationQueueRepository) GetByID(ctx context.Context, id uuid.UUID) (*team.RegistrationTicket, error) {
+	var ticket team.RegistrationTicket
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&ticket); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, team.ErrTicketNotFound
+		}
+		return nil, fmt.Errorf("finding registration ticket: %w", err)
+	}
+	return &ticket, nil
+}
+
+// ClaimNext marks the oldest waiting ticket as processing and returns it.
+func (r *RegistrationQueueRepository) ClaimNext(ctx context.Context) (*team.RegistrationTicket, error) {
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var ticket team.RegistrationTicket
+	err := r.collection.FindOneAndUpdate(ctx,
+		bson.M{"status": team.TicketWaiting},
+		bson.M{"$set": bson.M{"status": team.TicketProcessing}},
+		opts,
+	).Decode(&ticket)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, team.ErrTicketNotFound
+		}
+		return nil, fmt.Errorf("claiming registration ticket: %w", err)
+	}
+	return &ticket, nil
+}
+
+// Update saves a ticket's status and outcome.
+func (r *RegistrationQueueRepository) Update(ctx context.Context, ticket *team.RegistrationTicket) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": ticket.ID}, ticket)
+	if err != nil {
+		return fmt.Errorf("updating registration ticket: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return team.ErrTicketNotFound
+	}
+	return nil
+}
+
+// CountAhead returns how many tickets of the same tournament are waiting in front of the given one.
+func (r *RegistrationQueueRepository) CountAhead(ctx context.Context, ticket *team.RegistrationTicket) (int64, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{
+		"tournament_id": ticket.TournamentID,
+		"status":        team.TicketWaiting,
+		"created_at":    bson.M{"$lt": ticket.CreatedAt},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("counting registration tickets ahead: %w", err)
+	}
+	return count, nil
+}
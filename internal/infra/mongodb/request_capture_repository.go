@@ -0,0 +1,91 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/capture"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RequestCaptureRepository implements capture.Repository using MongoDB.
+type RequestCaptureRepository struct {
+	collection *mongo.Collection
+}
+
+// NewRequestCaptureRepository creates a new MongoDB request capture repository.
+func NewRequestCaptureRepository(db *mongo.Database) *RequestCaptureRepository {
+	return &RequestCaptureRepository{
+		collection: db.Collection("request_captures"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the request captures
+// collection. Expired captures are removed by a TTL index.
+func (r *RequestCaptureRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{{Key: "kind", Value: 1}, {Key: "captured_at", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "captured_at", Value: -1}},
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating request capture indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a capture.
+func (r *RequestCaptureRepository) Create(ctx context.Context, c *capture.Capture) error {
+	if _, err := r.collection.InsertOne(ctx, c); err != nil {
+		return fmt.Errorf("inserting request capture: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a capture by its ID.
+func (r *RequestCaptureRepository) GetByID(ctx context.Context, id uuid.UUID) (*capture.Capture, error) {
+	var c capture.Capture
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&c); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, capture.ErrNotFound
+		}
+		return nil, fmt.Errorf("finding request capture: %w", err)
+	}
+	return &c, nil
+}
+
+// List returns the newest captures, of one kind or of every kind.
+func (r *RequestCaptureRepository) List(ctx context.Context, kind capture.Kind, limit int64) ([]*capture.Capture, error) {
+	filter := bson.M{}
+	if kind != "" {
+		filter["kind"] = kind
+	}
+
+	cursor, err := r.collection.Find(ctx, filter, options.Find().
+		SetSort(bson.D{{Key: "captured_at", Value: -1}}).
+		SetLimit(limit))
+	if err != nil {
+		return nil, fmt.Errorf("finding request captures: %w", err)
+	}
+
+	var captures []*capture.Capture
+	if err := decodeAll(ctx, cursor, &captures); err != nil {
+		return nil, fmt.Errorf("decoding request captures: %w", err)
+	}
+
+	return captures, nil
+}
@@ -0,0 +1,101 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RosterOverlapRepository implements team.RosterOverlapRepository using MongoDB.
+type RosterOverlapRepository struct {
+	collection *mongo.Collection
+}
+
+// NewRosterOverlapRepository creates a new MongoDB roster overlap repository.
+func NewRosterOverlapRepository(db *mongo.Database) *RosterOverlapRepository {
+	return &RosterOverlapRepository{
+		collection: db.Collection("team_roster_overlaps"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the roster overlaps collection.
+func (r *RosterOverlapRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			// A platform ID is flagged once per team pair, whatever the review outcome
+			Keys:    bson.D{{Key: "tournament_id", Value: 1}, {Key: "key", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "tournament_id", Value: 1}, {Key: "detected_at", Value: -1}},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating roster overlap indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new roster overlap.
+func (r *RosterOverlapRepository) Create(ctx context.Context, overlap *team.RosterOverlap) error {
+	_, err := r.collection.InsertOne(ctx, overlap)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return team.ErrRosterOverlapExists
+		}
+		return fmt.Errorf("inserting roster overlap: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a roster overlap by its ID.
+func (r *RosterOverlapRepository) GetByID(ctx context.Context, id uuid.UUID) (*team.RosterOverlap, error) {
+	var overlap team.RosterOverlap
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&overlap)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, team.ErrRosterOverlapNotFound
+		}
+		return nil, fmt.Errorf("finding roster overlap: %w", err)
+	}
+	return &overlap, nil
+}
+
+// Update updates an existing roster overlap.
+func (r *RosterOverlapRepository) Update(ctx context.Context, overlap *team.RosterOverlap) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": overlap.ID}, overlap)
+	if err != nil {
+		return fmt.Errorf("updating roster overlap: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return team.ErrRosterOverlapNotFound
+	}
+	return nil
+}
+
+// ListByTournament retrieves a tournament's roster overlaps, newest first.
+func (r *RosterOverlapRepository) ListByTournament(ctx context.Context, tournamentID uuid.UUID) ([]*team.RosterOverlap, error) {
+	cursor, err := r.collection.Find(ctx,
+		bson.M{"tournament_id": tournamentID},
+		options.Find().SetSort(bson.D{{Key: "detected_at", Value: -1}}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("finding roster overlaps: %w", err)
+	}
+
+	overlaps := []*team.RosterOverlap{}
+	if err := decodeAll(ctx, cursor, &overlaps); err != nil {
+		return nil, fmt.Errorf("decoding roster overlaps: %w", err)
+	}
+
+	return overlaps, nil
+}
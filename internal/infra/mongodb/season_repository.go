@@ -0,0 +1,155 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/season"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SeasonRepository implements season.Repository using MongoDB.
+type SeasonRepository struct {
+	collection *mongo.Collection
+}
+
+// NewSeasonRepository creates a new MongoDB season repository.
+func NewSeasonRepository(db *mongo.Database) *SeasonRepository {
+	return &SeasonRepository{
+		collection: db.Collection("seasons"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the seasons collection.
+func (r *SeasonRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			// One season per game and number
+			Keys:    bson.D{{Key: "game_id", Value: 1}, {Key: "number", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "game_id", Value: 1}, {Key: "starts_at", Value: -1}},
+		},
+		{
+			Keys: bson.D{{Key: "archived_at", Value: 1}, {Key: "ends_at", Value: 1}},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating season indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new season. The overlap check and the insert are not
+// atomic; seasons are created by admins and the rollover, rarely enough that
+// the unique number index is the only guard needed against a race.
+func (r *SeasonRepository) Create(ctx context.Context, s *season.Season) error {
+	count, err := r.collection.CountDocuments(ctx, bson.M{
+		"game_id":   s.GameID,
+		"starts_at": bson.M{"$lt": s.EndsAt},
+		"ends_at":   bson.M{"$gt": s.StartsAt},
+	}, options.Count().SetLimit(1))
+	if err != nil {
+		return fmt.Errorf("counting overlapping seasons: %w", err)
+	}
+	if count > 0 {
+		return season.ErrOverlap
+	}
+
+	if _, err := r.collection.InsertOne(ctx, s); err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return season.ErrOverlap
+		}
+		return fmt.Errorf("inserting season: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a season by its ID.
+func (r *SeasonRepository) GetByID(ctx context.Context, id uuid.UUID) (*season.Season, error) {
+	var s season.Season
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&s); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, season.ErrNotFound
+		}
+		return nil, fmt.Errorf("finding season: %w", err)
+	}
+	return &s, nil
+}
+
+// ListByGame returns a game's seasons, newest first.
+func (r *SeasonRepository) ListByGame(ctx context.Context, gameID uuid.UUID) ([]*season.Season, error) {
+	cursor, err := r.collection.Find(
+		ctx,
+		bson.M{"game_id": gameID},
+		options.Find().SetSort(bson.D{{Key: "starts_at", Value: -1}}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("finding seasons: %w", err)
+	}
+
+	var seasons []*season.Season
+	if err := decodeAll(ctx, cursor, &seasons); err != nil {
+		return nil, fmt.Errorf("decoding seasons: %w", err)
+	}
+
+	return seasons, nil
+}
+
+// GetCurrent returns the game's unarchived season running at t.
+func (r *SeasonRepository) GetCurrent(ctx context.Context, gameID uuid.UUID, t time.Time) (*season.Season, error) {
+	var s season.Season
+	err := r.collection.FindOne(ctx, bson.M{
+		"game_id":     gameID,
+		"archived_at": nil,
+		"starts_at":   bson.M{"$lte": t},
+		"ends_at":     bson.M{"$gt": t},
+	}).Decode(&s)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, season.ErrNotFound
+		}
+		return nil, fmt.Errorf("finding current season: %w", err)
+	}
+	return &s, nil
+}
+
+// ListEnded returns the unarchived seasons that ended by now, oldest first.
+func (r *SeasonRepository) ListEnded(ctx context.Context, now time.Time) ([]*season.Season, error) {
+	cursor, err := r.collection.Find(
+		ctx,
+		bson.M{"archived_at": nil, "ends_at": bson.M{"$lte": now}},
+		options.Find().SetSort(bson.D{{Key: "ends_at", Value: 1}}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("finding ended seasons: %w", err)
+	}
+
+	var seasons []*season.Season
+	if err := decodeAll(ctx, cursor, &seasons); err != nil {
+		return nil, fmt.Errorf("decoding seasons: %w", err)
+	}
+
+	return seasons, nil
+}
+
+// Update saves a season.
+func (r *SeasonRepository) Update(ctx context.Context, s *season.Season) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": s.ID}, s)
+	if err != nil {
+		return fmt.Errorf("updating season: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return season.ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/ranking"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SeasonRewardRepository implements ranking.RewardRepository using MongoDB.
+type SeasonRewardRepository struct {
+	collection *mongo.Collection
+}
+
+// NewSeasonRewardRepository creates a new MongoDB season reward repository.
+func NewSeasonRewardRepository(db *mongo.Database) *SeasonRewardRepository {
+	return &SeasonRewardRepository{
+		collection: db.Collection("season_rewards"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the season rewards collection.
+func (r *SeasonRewardRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "game_id", Value: 1},
+				{Key: "season", Value: 1},
+				{Key: "player_id", Value: 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{Key: "player_id", Value: 1},
+				{Key: "game_id", Value: 1},
+				{Key: "distributed_at", Value: -1},
+			},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating season reward indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a distribution. The unique index on (game_id, season,
+// player_id) rejects a second reward for the same season.
+func (r *SeasonRewardRepository) Create(ctx context.Context, d *ranking.RewardDistribution) error {
+	_, err := r.collection.InsertOne(ctx, d)
+	if mongo.IsDuplicateKeyError(err) {
+		return ranking.ErrRewardAlreadyDistributed
+	}
+	if err != nil {
+		return fmt.Errorf("inserting season reward: %w", err)
+	}
+	return nil
+}
+
+// ListUnnotified returns a game season's distributions not yet notified.
+func (r *SeasonRewardRepository) ListUnnotified(ctx context.Context, gameID uuid.UUID, season string) ([]*ranking.RewardDistribution, error) {
+	cursor, err := r.collection.Find(ctx, bson.M{
+		"game_id":     gameID,
+		"season":      season,
+		"notified_at": bson.M{"$exists": false},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("finding unnotified season rewards: %w", err)
+	}
+
+	var rewards []*ranking.RewardDistribution
+	if err := decodeAll(ctx, cursor, &rewards); err != nil {
+		return nil, fmt.Errorf("decoding season rewards: %w", err)
+	}
+
+	return rewards, nil
+}
+
+// MarkNotified records that the player was notified of a distribution.
+func (r *SeasonRewardRepository) MarkNotified(ctx context.Context, id uuid.UUID, at time.Time) error {
+	_, err := r.collection.UpdateOne(ctx, bson.M{"_id": id}, bson.M{"$set": bson.M{"notified_at": at}})
+	if err != nil {
+		return fmt.Errorf("marking season reward notified: %w", err)
+	}
+	return nil
+}
+
+// ListByPlayer returns a player's rewards in a game, newest first.
+func (r *SeasonRewardRepository) ListByPlayer(ctx context.Context, playerID, gameID uuid.UUID) ([]*ranking.RewardDistribution, error) {
+	cursor, err := r.collection.Find(
+		ctx,
+		bson.M{"player_id": playerID, "game_id": gameID},
+		options.Find().SetSort(bson.D{{Key: "distributed_at", Value: -1}}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("finding season rewards: %w", err)
+	}
+
+	rewards := []*ranking.RewardDistribution{}
+	if err := decodeAll(ctx, cursor, &rewards); err != nil {
+		return nil, fmt.Errorf("decoding season rewards: %w", err)
+	}
+
+	return rewards, nil
+}
@@ -0,0 +1,80 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/activity"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	siteActivityCollection = "site_activity"
+
+	// siteActivityMaxBytes bounds the capped collection's size on disk.
+	siteActivityMaxBytes = 4 << 20
+
+	// namespaceExistsCode is the server error code for creating an existing collection.
+	namespaceExistsCode = 48
+)
+
+// SiteActivityRepository implements activity.SiteFeedRepository using a capped MongoDB collection.
+type SiteActivityRepository struct {
+	db         *mongo.Database
+	collection *mongo.Collection
+}
+
+// NewSiteActivityRepository creates a new MongoDB site activity repository.
+func NewSiteActivityRepository(db *mongo.Database) *SiteActivityRepository {
+	return &SiteActivityRepository{
+		db:         db,
+		collection: db.Collection(siteActivityCollection),
+	}
+}
+
+// EnsureIndexes creates the capped site activity collection if it does not exist.
+// Capped collections keep insertion order, so no index is needed for recency.
+func (r *SiteActivityRepository) EnsureIndexes(ctx context.Context) error {
+	opts := options.CreateCollection().
+		SetCapped(true).
+		SetSizeInBytes(siteActivityMaxBytes).
+		SetMaxDocuments(activity.SiteFeedSize)
+
+	err := r.db.CreateCollection(ctx, siteActivityCollection, opts)
+	var cmdErr mongo.CommandError
+	if err != nil && !(errors.As(err, &cmdErr) && cmdErr.Code == namespaceExistsCode) {
+		return fmt.Errorf("creating site activity collection: %w", err)
+	}
+	return nil
+}
+
+// Append adds an event to the site activity feed.
+func (r *SiteActivityRepository) Append(ctx context.Context, event *activity.SiteEvent) error {
+	if _, err := r.collection.InsertOne(ctx, event); err != nil {
+		return fmt.Errorf("inserting site activity event: %w", err)
+	}
+	return nil
+}
+
+// ListRecent returns the newest site activity events, newest first.
+func (r *SiteActivityRepository) ListRecent(ctx context.Context, limit int) ([]*activity.SiteEvent, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "$natural", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(int64(limit))
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding site activity events: %w", err)
+	}
+
+	events := []*activity.SiteEvent{}
+	if err := decodeAll(ctx, cursor, &events); err != nil {
+		return nil, fmt.Errorf("decoding site activity events: %w", err)
+	}
+
+	return events, nil
+}
@@ -0,0 +1,70 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// StatCorrectionRepository implements match.CorrectionRepository using MongoDB.
+type StatCorrectionRepository struct {
+	collection *mongo.Collection
+}
+
+// NewStatCorrectionRepository creates a new MongoDB stat correction repository.
+func NewStatCorrectionRepository(db *mongo.Database) *StatCorrectionRepository {
+	return &StatCorrectionRepository{
+		collection: db.Collection("stat_corrections"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the stat corrections collection.
+func (r *StatCorrectionRepository) EnsureIndexes(ctx context.Context) error {
+	if _, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{{Key: "filter.game_id", Value: 1}, {Key: "created_at", Value: -1}},
+	}); err != nil {
+		return fmt.Errorf("creating stat correction indexes: %w", err)
+	}
+	return nil
+}
+
+// Create stores a stat correction.
+func (r *StatCorrectionRepository) Create(ctx context.Context, correction *match.StatCorrection) error {
+	if _, err := r.collection.InsertOne(ctx, correction); err != nil {
+		return fmt.Errorf("inserting stat correction: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a stat correction by its ID.
+func (r *StatCorrectionRepository) GetByID(ctx context.Context, id uuid.UUID) (*match.StatCorrection, error) {
+	var correction match.StatCorrection
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&correction); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, match.ErrCorrectionNotFound
+		}
+		return nil, fmt.Errorf("finding stat correction: %w", err)
+	}
+	return &correction, nil
+}
+
+// ListByGame returns a game's stat corrections, newest first.
+func (r *StatCorrectionRepository) ListByGame(ctx context.Context, gameID uuid.UUID) ([]*match.StatCorrection, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	cursor, err := r.collection.Find(ctx, bson.M{"filter.game_id": gameID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding stat corrections: %w", err)
+	}
+
+	var corrections []*match.StatCorrection
+	if err := decodeAll(ctx, cursor, &corrections); err != nil {
+		return nil, fmt.Errorf("decoding stat corrections: %w", err)
+	}
+	return corrections, nil
+}
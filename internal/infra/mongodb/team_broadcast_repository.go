@@ -0,0 +1,79 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TeamBroadcastRepository implements team.BroadcastRepository using MongoDB.
+type TeamBroadcastRepository struct {
+	collection *mongo.Collection
+}
+
+// NewTeamBroadcastRepository creates a new MongoDB team broadcast repository.
+func NewTeamBroadcastRepository(db *mongo.Database) *TeamBroadcastRepository {
+	return &TeamBroadcastRepository{
+		collection: db.Collection("team_broadcasts"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the team broadcasts collection.
+func (r *TeamBroadcastRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "team_id", Value: 1},
+			{Key: "created_at", Value: -1},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("creating team broadcast indexes: %w", err)
+	}
+	return nil
+}
+
+// Create stores a team broadcast.
+func (r *TeamBroadcastRepository) Create(ctx context.Context, b *team.Broadcast) error {
+	if _, err := r.collection.InsertOne(ctx, b); err != nil {
+		return fmt.Errorf("inserting team broadcast: %w", err)
+	}
+	return nil
+}
+
+// ListByTeam returns a team's broadcasts, newest first.
+func (r *TeamBroadcastRepository) ListByTeam(ctx context.Context, teamID uuid.UUID, limit int64) ([]*team.Broadcast, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"team_id": teamID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding team broadcasts: %w", err)
+	}
+
+	broadcasts := []*team.Broadcast{}
+	if err := decodeAll(ctx, cursor, &broadcasts); err != nil {
+		return nil, fmt.Errorf("decoding team broadcasts: %w", err)
+	}
+
+	return broadcasts, nil
+}
+
+// CountSince returns the number of broadcasts a team sent at or after since.
+func (r *TeamBroadcastRepository) CountSince(ctx context.Context, teamID uuid.UUID, since time.Time) (int64, error) {
+	count, err := r.collection.CountDocuments(ctx, bson.M{
+		"team_id":    teamID,
+		"created_at": bson.M{"$gte": since},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("counting team broadcasts: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,72 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TeamHistoryRepository implements team.HistoryRepository using MongoDB.
+type TeamHistoryRepository struct {
+	collection *mongo.Collection
+}
+
+// NewTeamHistoryRepository creates a new MongoDB team history repository.
+func NewTeamHistoryRepository(db *mongo.Database) *TeamHistoryRepository {
+	return &TeamHistoryRepository{
+		collection: db.Collection("team_history"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the team history collection.
+func (r *TeamHistoryRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "team_id", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating team history indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a team history entry.
+func (r *TeamHistoryRepository) Create(ctx context.Context, entry *team.HistoryEntry) error {
+	_, err := r.collection.InsertOne(ctx, entry)
+	if err != nil {
+		return fmt.Errorf("inserting team history entry: %w", err)
+	}
+	return nil
+}
+
+// ListByTeam returns a team's history, newest first.
+func (r *TeamHistoryRepository) ListByTeam(ctx context.Context, teamID uuid.UUID, limit int64) ([]*team.HistoryEntry, error) {
+	opts := options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+
+	cursor, err := r.collection.Find(ctx, bson.M{"team_id": teamID}, opts)
+	if err != nil {
+		return nil, fmt.Errorf("finding team history: %w", err)
+	}
+
+	var entries []*team.HistoryEntry
+	if err := decodeAll(ctx, cursor, &entries); err != nil {
+		return nil, fmt.Errorf("decoding team history: %w", err)
+	}
+
+	return entries, nil
+}
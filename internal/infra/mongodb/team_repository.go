@@ -12,6 +12,11 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+const (
+	// TeamsCollection is the MongoDB collection name for teams.
+	TeamsCollection = "teams"
+)
+
 // TeamRepository implements team.Repository using MongoDB.
 type TeamRepository struct {
 	collection *mongo.Collection
@@ -20,7 +25,7 @@ type TeamRepository struct {
 // NewTeamRepository creates a new MongoDB team repository.
 func NewTeamRepository(db *mongo.Database) *TeamRepository {
 	return &TeamRepository{
-		collection: db.Collection("teams"),
+		collection: db.Collection(TeamsCollection),
 	}
 }
 
@@ -87,6 +92,19 @@ func (r *TeamRepository) GetByID(ctx context.Context, id uuid.UUID) (*team.Team,
 	return &t, nil
 }
 
+// GetByIDFields retrieves a team loading only the given fields.
+func (r *TeamRepository) GetByIDFields(ctx context.Context, id uuid.UUID, fields []string) (*team.Team, error) {
+	var t team.Team
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}, findFields(&t, fields)).Decode(&t)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, team.ErrNotFound
+		}
+		return nil, fmt.Errorf("finding team: %w", err)
+	}
+	return &t, nil
+}
+
 // GetByInviteCode retrieves a team by its invite code.
 func (r *TeamRepository) GetByInviteCode(ctx context.Context, inviteCode string) (*team.Team, error) {
 	var t team.Team
@@ -138,10 +156,9 @@ func (r *TeamRepository) GetByTournamentID(ctx context.Context, tournamentID uui
 	if err != nil {
 		return nil, fmt.Errorf("finding teams by tournament: %w", err)
 	}
-	defer cursor.Close(ctx)
 
 	var teams []*team.Team
-	if err := cursor.All(ctx, &teams); err != nil {
+	if err := decodeAll(ctx, cursor, &teams); err != nil {
 		return nil, fmt.Errorf("decoding teams: %w", err)
 	}
 
@@ -158,10 +175,9 @@ func (r *TeamRepository) GetByPlayerID(ctx context.Context, playerID uuid.UUID)
 	if err != nil {
 		return nil, fmt.Errorf("finding teams by player: %w", err)
 	}
-	defer cursor.Close(ctx)
 
 	var teams []*team.Team
-	if err := cursor.All(ctx, &teams); err != nil {
+	if err := decodeAll(ctx, cursor, &teams); err != nil {
 		return nil, fmt.Errorf("decoding teams: %w", err)
 	}
 
@@ -230,11 +246,38 @@ func (r *TeamRepository) List(ctx context.Context, filter team.ListFilter) ([]*t
 	if err != nil {
 		return nil, fmt.Errorf("listing teams: %w", err)
 	}
-	defer cursor.Close(ctx)
 
 	// Decode results
 	var teams []*team.Team
-	if err := cursor.All(ctx, &teams); err != nil {
+	if err := decodeAll(ctx, cursor, &teams); err != nil {
+		return nil, fmt.Errorf("decoding teams: %w", err)
+	}
+
+	return teams, nil
+}
+
+// FindWithMissingTournament returns up to limit teams whose tournament_id
+// matches no tournament.
+func (r *TeamRepository) FindWithMissingTournament(ctx context.Context, limit int64) ([]*team.Team, error) {
+	pipeline := mongo.Pipeline{
+		{{Key: "$lookup", Value: bson.M{
+			"from":         TournamentsCollection,
+			"localField":   "tournament_id",
+			"foreignField": "_id",
+			"as":           "tournament_info",
+		}}},
+		{{Key: "$match", Value: bson.M{"tournament_info": bson.M{"$size": 0}}}},
+		{{Key: "$limit", Value: limit}},
+		{{Key: "$project", Value: bson.M{"tournament_info": 0}}},
+	}
+
+	cursor, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("aggregating teams with missing tournament: %w", err)
+	}
+
+	var teams []*team.Team
+	if err := decodeAll(ctx, cursor, &teams); err != nil {
 		return nil, fmt.Errorf("decoding teams: %w", err)
 	}
 
@@ -0,0 +1,109 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/ranking"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TierBoundaryRepository implements ranking.BoundaryRepository using MongoDB.
+type TierBoundaryRepository struct {
+	collection *mongo.Collection
+}
+
+// NewTierBoundaryRepository creates a new MongoDB tier boundary repository.
+func NewTierBoundaryRepository(db *mongo.Database) *TierBoundaryRepository {
+	return &TierBoundaryRepository{
+		collection: db.Collection("tier_boundaries"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the tier boundaries collection.
+func (r *TierBoundaryRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "game_id", Value: 1}, {Key: "version", Value: -1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{{Key: "game_id", Value: 1}, {Key: "effective_from", Value: -1}},
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating tier boundary indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new tier boundaries version.
+func (r *TierBoundaryRepository) Create(ctx context.Context, b *ranking.TierBoundaries) error {
+	_, err := r.collection.InsertOne(ctx, b)
+	if err != nil {
+		return fmt.Errorf("inserting tier boundaries: %w", err)
+	}
+	return nil
+}
+
+// GetEffective returns the latest version in effect at the given time.
+func (r *TierBoundaryRepository) GetEffective(ctx context.Context, gameID uuid.UUID, at time.Time) (*ranking.TierBoundaries, error) {
+	return r.findOne(
+		ctx,
+		bson.M{"game_id": gameID, "effective_from": bson.M{"$lte": at}},
+		options.FindOne().SetSort(bson.D{{Key: "effective_from", Value: -1}, {Key: "version", Value: -1}}),
+	)
+}
+
+// GetByVersion returns a specific tier boundaries version.
+func (r *TierBoundaryRepository) GetByVersion(ctx context.Context, gameID uuid.UUID, version int) (*ranking.TierBoundaries, error) {
+	return r.findOne(ctx, bson.M{"game_id": gameID, "version": version}, options.FindOne())
+}
+
+// GetLatest returns the highest version regardless of effective date.
+func (r *TierBoundaryRepository) GetLatest(ctx context.Context, gameID uuid.UUID) (*ranking.TierBoundaries, error) {
+	return r.findOne(
+		ctx,
+		bson.M{"game_id": gameID},
+		options.FindOne().SetSort(bson.D{{Key: "version", Value: -1}}),
+	)
+}
+
+// ListByGame returns all versions for a game, newest first.
+func (r *TierBoundaryRepository) ListByGame(ctx context.Context, gameID uuid.UUID) ([]*ranking.TierBoundaries, error) {
+	cursor, err := r.collection.Find(
+		ctx,
+		bson.M{"game_id": gameID},
+		options.Find().SetSort(bson.D{{Key: "version", Value: -1}}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("finding tier boundaries: %w", err)
+	}
+
+	var boundaries []*ranking.TierBoundaries
+	if err := decodeAll(ctx, cursor, &boundaries); err != nil {
+		return nil, fmt.Errorf("decoding tier boundaries: %w", err)
+	}
+
+	return boundaries, nil
+}
+
+func (r *TierBoundaryRepository) findOne(ctx context.Context, filter bson.M, opts *options.FindOneOptions) (*ranking.TierBoundaries, error) {
+	var b ranking.TierBoundaries
+	err := r.collection.FindOne(ctx, filter, opts).Decode(&b)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, ranking.ErrBoundariesNotFound
+		}
+		return nil, fmt.Errorf("finding tier boundaries: %w", err)
+	}
+	return &b, nil
+}
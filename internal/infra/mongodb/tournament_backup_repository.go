@@ -0,0 +1,214 @@
+package mongodb
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TournamentBackupRepository implements tournament.BackupRepository using MongoDB.
+type TournamentBackupRepository struct {
+	collection *mongo.Collection
+}
+
+// NewTournamentBackupRepository creates a new MongoDB tournament backup repository.
+func NewTournamentBackupRepository(db *mongo.Database) *TournamentBackupRepository {
+	return &TournamentBackupRepository{
+		collection: db.Collection("tournament_backups"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the tournament backups collection.
+func (r *TournamentBackupRepository) EnsureIndexes(ctx context.Context) error {
+	_, err := r.collection.Indexes().CreateOne(ctx, mongo.IndexModel{
+		Keys: bson.D{
+			{Key: "tournament_id", Value: 1},
+			{Key: "created_at", Value: -1},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("creating tournament backup indexes: %w", err)
+	}
+	return nil
+}
+
+// Create stores a new backup record.
+func (r *TournamentBackupRepository) Create(ctx context.Context, backup *tournament.Backup) error {
+	if _, err := r.collection.InsertOne(ctx, backup); err != nil {
+		return fmt.Errorf("inserting tournament backup: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves a backup record by its ID.
+func (r *TournamentBackupRepository) GetByID(ctx context.Context, id uuid.UUID) (*tournament.Backup, error) {
+	var backup tournament.Backup
+	if err := r.collection.FindOne(ctx, bson.M{"_id": id}).Decode(&backup); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, tournament.ErrBackupNotFound
+		}
+		return nil, fmt.Errorf("finding tournament backup: %w", err)
+	}
+	return &backup, nil
+}
+
+// ListByTournament retrieves a tournament's backups, newest first.
+func (r *TournamentBackupRepository) ListByTournament(ctx context.Context, tournamentID uuid.UUID) ([]*tournament.Backup, error) {
+	cursor, err := r.collection.Find(ctx,
+		bson.M{"tournament_id": tournamentID},
+		options.Find().SetSort(bson.D{{Key: "created_at", Value: -1}}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("finding tournament backups: %w", err)
+	}
+
+	backups := []*tournament.Backup{}
+	if err := decodeAll(ctx, cursor, &backups); err != nil {
+		return nil, fmt.Errorf("decoding tournament backups: %w", err)
+	}
+	return backups, nil
+}
+
+// Update replaces an existing backup record.
+func (r *TournamentBackupRepository) Update(ctx context.Context, backup *tournament.Backup) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": backup.ID}, backup)
+	if err != nil {
+		return fmt.Errorf("updating tournament backup: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return tournament.ErrBackupNotFound
+	}
+	return nil
+}
+
+// TournamentSnapshotter implements tournament.Snapshotter over the raw
+// tournament, team and match documents. Snapshots hold canonical Extended
+// JSON, which keeps BSON types such as binary IDs and dates intact.
+type TournamentSnapshotter struct {
+	tournaments *mongo.Collection
+	teams       *mongo.Collection
+	matches     *mongo.Collection
+}
+
+// NewTournamentSnapshotter creates a new MongoDB tournament snapshotter.
+func NewTournamentSnapshotter(db *mongo.Database) *TournamentSnapshotter {
+	return &TournamentSnapshotter{
+		tournaments: db.Collection(TournamentsCollection),
+		teams:       db.Collection(TeamsCollection),
+		matches:     db.Collection(MatchesCollection),
+	}
+}
+
+// Snapshot returns the tournament document with its teams and matches.
+// Matches store the tournament ID as a string.
+func (s *TournamentSnapshotter) Snapshot(ctx context.Context, id uuid.UUID) (*tournament.Snapshot, error) {
+	raw, err := s.tournaments.FindOne(ctx, bson.M{"_id": id}).Raw()
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, tournament.ErrNotFound
+		}
+		return nil, fmt.Errorf("finding tournament: %w", err)
+	}
+	doc, err := bson.MarshalExtJSON(raw, true, false)
+	if err != nil {
+		return nil, fmt.Errorf("encoding tournament: %w", err)
+	}
+
+	teams, err := snapshotDocuments(ctx, s.teams, bson.M{"tournament_id": id})
+	if err != nil {
+		return nil, fmt.Errorf("snapshotting teams: %w", err)
+	}
+	matches, err := snapshotDocuments(ctx, s.matches, bson.M{"tournament_id": id.String()})
+	if err != nil {
+		return nil, fmt.Errorf("snapshotting matches: %w", err)
+	}
+
+	return &tournament.Snapshot{Tournament: doc, Teams: teams, Matches: matches}, nil
+}
+
+// Restore writes the snapshot back, replacing the documents it holds and
+// removing the tournament's teams and matches it does not. The collections
+// are restored one after another, not in a transaction; a failed restore
+// can be retried with the same snapshot.
+func (s *TournamentSnapshotter) Restore(ctx context.Context, id uuid.UUID, snapshot *tournament.Snapshot) error {
+	var doc bson.D
+	if err := bson.UnmarshalExtJSON(snapshot.Tournament, true, &doc); err != nil {
+		return fmt.Errorf("decoding tournament: %w", err)
+	}
+	if _, err := s.tournaments.ReplaceOne(ctx, bson.M{"_id": id}, doc, options.Replace().SetUpsert(true)); err != nil {
+		return fmt.Errorf("restoring tournament: %w", err)
+	}
+
+	if err := restoreDocuments(ctx, s.teams, bson.M{"tournament_id": id}, snapshot.Teams); err != nil {
+		return fmt.Errorf("restoring teams: %w", err)
+	}
+	if err := restoreDocuments(ctx, s.matches, bson.M{"tournament_id": id.String()}, snapshot.Matches); err != nil {
+		return fmt.Errorf("restoring matches: %w", err)
+	}
+	return nil
+}
+
+func snapshotDocuments(ctx context.Context, collection *mongo.Collection, filter bson.M) ([]json.RawMessage, error) {
+	cursor, err := collection.Find(ctx, filter, options.Find().SetSort(bson.D{{Key: "_id", Value: 1}}))
+	if err != nil {
+		return nil, err
+	}
+	defer closeCursor(cursor)
+
+	docs := []json.RawMessage{}
+	for cursor.Next(ctx) {
+		doc, err := bson.MarshalExtJSON(cursor.Current, true, false)
+		if err != nil {
+			return nil, err
+		}
+		docs = append(docs, doc)
+	}
+	return docs, cursor.Err()
+}
+
+// restoreDocuments makes the documents matching filter exactly docs.
+func restoreDocuments(ctx context.Context, collection *mongo.Collection, filter bson.M, docs []json.RawMessage) error {
+	decoded := make([]bson.D, len(docs))
+	ids := bson.A{}
+	for i, data := range docs {
+		if err := bson.UnmarshalExtJSON(data, true, &decoded[i]); err != nil {
+			return err
+		}
+		id, ok := documentID(decoded[i])
+		if !ok {
+			return errors.New("document without _id")
+		}
+		ids = append(ids, id)
+	}
+
+	stale := bson.M{"_id": bson.M{"$nin": ids}}
+	for k, v := range filter {
+		stale[k] = v
+	}
+	if _, err := collection.DeleteMany(ctx, stale); err != nil {
+		return err
+	}
+
+	for i, doc := range decoded {
+		if _, err := collection.ReplaceOne(ctx, bson.M{"_id": ids[i]}, doc, options.Replace().SetUpsert(true)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func documentID(doc bson.D) (interface{}, bool) {
+	for _, e := range doc {
+		if e.Key == "_id" {
+			return e.Value, true
+		}
+	}
+	return nil, false
+}
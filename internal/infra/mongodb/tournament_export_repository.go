@@ -0,0 +1,118 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TournamentExportRepository implements tournament.ExportRepository using MongoDB.
+type TournamentExportRepository struct {
+	collection *mongo.Collection
+}
+
+// NewTournamentExportRepository creates a new MongoDB tournament export repository.
+func NewTournamentExportRepository(db *mongo.Database) *TournamentExportRepository {
+	return &TournamentExportRepository{
+		collection: db.Collection("tournament_exports"),
+	}
+}
+
+// EnsureIndexes creates necessary indexes for the tournament exports collection.
+// Finished bundles are removed by MongoDB once they expire.
+func (r *TournamentExportRepository) EnsureIndexes(ctx context.Context) error {
+	indexes := []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{Key: "tournament_id", Value: 1},
+				{Key: "created_at", Value: -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{Key: "status", Value: 1},
+				{Key: "created_at", Value: 1},
+			},
+		},
+		{
+			Keys:    bson.D{{Key: "expires_at", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	}
+
+	_, err := r.collection.Indexes().CreateMany(ctx, indexes)
+	if err != nil {
+		return fmt.Errorf("creating tournament export indexes: %w", err)
+	}
+
+	return nil
+}
+
+// Create stores a new export.
+func (r *TournamentExportRepository) Create(ctx context.Context, export *tournament.Export) error {
+	if _, err := r.collection.InsertOne(ctx, export); err != nil {
+		return fmt.Errorf("inserting tournament export: %w", err)
+	}
+	return nil
+}
+
+// GetByID retrieves an export by its ID.
+func (r *TournamentExportRepository) GetByID(ctx context.Context, id uuid.UUID) (*tournament.Export, error) {
+	return r.findOne(ctx, bson.M{"_id": id})
+}
+
+// GetLatestByTournament returns the most recently requested export of a tournament.
+func (r *TournamentExportRepository) GetLatestByTournament(ctx context.Context, tournamentID uuid.UUID) (*tournament.Export, error) {
+	opts := options.FindOne().SetSort(bson.D{{Key: "created_at", Value: -1}})
+	return r.findOne(ctx, bson.M{"tournament_id": tournamentID}, opts)
+}
+
+// ClaimPending marks the oldest pending export as running and returns it.
+func (r *TournamentExportRepository) ClaimPending(ctx context.Context) (*tournament.Export, error) {
+	opts := options.FindOneAndUpdate().
+		SetSort(bson.D{{Key: "created_at", Value: 1}}).
+		SetReturnDocument(options.After)
+
+	var export tournament.Export
+	err := r.collection.FindOneAndUpdate(ctx,
+		bson.M{"status": tournament.ExportPending},
+		bson.M{"$set": bson.M{"status": tournament.ExportRunning}},
+		opts,
+	).Decode(&export)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, tournament.ErrExportNotFound
+		}
+		return nil, fmt.Errorf("claiming pending tournament export: %w", err)
+	}
+	return &export, nil
+}
+
+// Update saves an export's status and bundle.
+func (r *TournamentExportRepository) Update(ctx context.Context, export *tournament.Export) error {
+	result, err := r.collection.ReplaceOne(ctx, bson.M{"_id": export.ID}, export)
+	if err != nil {
+		return fmt.Errorf("updating tournament export: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return tournament.ErrExportNotFound
+	}
+	return nil
+}
+
+func (r *TournamentExportRepository) findOne(ctx context.Context, filter bson.M, opts ...*options.FindOneOptions) (*tournament.Export, error) {
+	var export tournament.Export
+	if err := r.collection.FindOne(ctx, filter, opts...).Decode(&export); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, tournament.ErrExportNotFound
+		}
+		return nil, fmt.Errorf("finding tournament export: %w", err)
+	}
+	return &export, nil
+}
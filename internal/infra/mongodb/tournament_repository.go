@@ -12,6 +12,11 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+const (
+	// TournamentsCollection is the MongoDB collection name for tournaments.
+	TournamentsCollection = "tournaments"
+)
+
 // TournamentRepository implements tournament.Repository using MongoDB.
 type TournamentRepository struct {
 	collection *mongo.Collection
@@ -20,7 +25,7 @@ type TournamentRepository struct {
 // NewTournamentRepository creates a new MongoDB tournament repository.
 func NewTournamentRepository(db *mongo.Database) *TournamentRepository {
 	return &TournamentRepository{
-		collection: db.Collection("tournaments"),
+		collection: db.Collection(TournamentsCollection),
 	}
 }
 
@@ -36,6 +41,9 @@ func (r *TournamentRepository) EnsureIndexes(ctx context.Context) error {
 		{
 			Keys: bson.D{{Key: "created_by", Value: 1}},
 		},
+		{
+			Keys: bson.D{{Key: "languages", Value: 1}},
+		},
 		{
 			Keys: bson.D{
 				{Key: "start_date", Value: 1},
@@ -83,6 +91,19 @@ func (r *TournamentRepository) GetByID(ctx context.Context, id uuid.UUID) (*tour
 	return &t, nil
 }
 
+// GetByIDFields retrieves a tournament loading only the given fields.
+func (r *TournamentRepository) GetByIDFields(ctx context.Context, id uuid.UUID, fields []string) (*tournament.Tournament, error) {
+	var t tournament.Tournament
+	err := r.collection.FindOne(ctx, bson.M{"_id": id}, findFields(&t, fields)).Decode(&t)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, tournament.ErrNotFound
+		}
+		return nil, fmt.Errorf("finding tournament: %w", err)
+	}
+	return &t, nil
+}
+
 // Update updates an existing tournament.
 func (r *TournamentRepository) Update(ctx context.Context, t *tournament.Tournament) error {
 	result, err := r.collection.ReplaceOne(
@@ -113,21 +134,6 @@ func (r *TournamentRepository) Delete(ctx context.Context, id uuid.UUID) error {
 
 // List retrieves tournaments with optional filtering.
 func (r *TournamentRepository) List(ctx context.Context, filter tournament.ListFilter) ([]*tournament.Tournament, error) {
-	// Build query filter
-	query := bson.M{}
-
-	if filter.GameID != nil {
-		query["game_id"] = *filter.GameID
-	}
-
-	if filter.Status != nil {
-		query["status"] = *filter.Status
-	}
-
-	if filter.CreatedBy != nil {
-		query["created_by"] = *filter.CreatedBy
-	}
-
 	// Set options
 	opts := options.Find().
 		SetSort(bson.D{{Key: "created_at", Value: -1}})
@@ -141,21 +147,56 @@ func (r *TournamentRepository) List(ctx context.Context, filter tournament.ListF
 	}
 
 	// Execute query
-	cursor, err := r.collection.Find(ctx, query, opts)
+	cursor, err := r.collection.Find(ctx, listQuery(filter), opts)
 	if err != nil {
 		return nil, fmt.Errorf("listing tournaments: %w", err)
 	}
-	defer cursor.Close(ctx)
 
 	// Decode results
 	var tournaments []*tournament.Tournament
-	if err := cursor.All(ctx, &tournaments); err != nil {
+	if err := decodeAll(ctx, cursor, &tournaments); err != nil {
 		return nil, fmt.Errorf("decoding tournaments: %w", err)
 	}
 
 	return tournaments, nil
 }
 
+// Count returns the number of tournaments matching a filter, ignoring its limit and offset.
+func (r *TournamentRepository) Count(ctx context.Context, filter tournament.ListFilter) (int64, error) {
+	count, err := r.collection.CountDocuments(ctx, listQuery(filter))
+	if err != nil {
+		return 0, fmt.Errorf("counting tournaments: %w", err)
+	}
+	return count, nil
+}
+
+// listQuery builds the query for a tournament list filter.
+func listQuery(filter tournament.ListFilter) bson.M {
+	query := bson.M{}
+
+	if filter.GameID != nil {
+		query["game_id"] = *filter.GameID
+	}
+
+	if filter.Status != nil {
+		query["status"] = *filter.Status
+	}
+
+	if filter.CreatedBy != nil {
+		query["created_by"] = *filter.CreatedBy
+	}
+
+	if filter.SeriesID != nil {
+		query["series_id"] = *filter.SeriesID
+	}
+
+	if filter.Language != "" {
+		query["languages"] = filter.Language
+	}
+
+	return query
+}
+
 // GetByGameID retrieves all tournaments for a specific game.
 func (r *TournamentRepository) GetByGameID(ctx context.Context, gameID uuid.UUID) ([]*tournament.Tournament, error) {
 	cursor, err := r.collection.Find(
@@ -166,10 +207,9 @@ func (r *TournamentRepository) GetByGameID(ctx context.Context, gameID uuid.UUID
 	if err != nil {
 		return nil, fmt.Errorf("finding tournaments by game: %w", err)
 	}
-	defer cursor.Close(ctx)
 
 	var tournaments []*tournament.Tournament
-	if err := cursor.All(ctx, &tournaments); err != nil {
+	if err := decodeAll(ctx, cursor, &tournaments); err != nil {
 		return nil, fmt.Errorf("decoding tournaments: %w", err)
 	}
 
@@ -186,10 +226,9 @@ func (r *TournamentRepository) GetByStatus(ctx context.Context, status tournamen
 	if err != nil {
 		return nil, fmt.Errorf("finding tournaments by status: %w", err)
 	}
-	defer cursor.Close(ctx)
 
 	var tournaments []*tournament.Tournament
-	if err := cursor.All(ctx, &tournaments); err != nil {
+	if err := decodeAll(ctx, cursor, &tournaments); err != nil {
 		return nil, fmt.Errorf("decoding tournaments: %w", err)
 	}
 
@@ -0,0 +1,55 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// TournamentRulebookRepository implements tournament.RulebookRepository using
+// MongoDB. Each rulebook is a single document keyed by its tournament ID, so
+// a save replaces all sections at once.
+type TournamentRulebookRepository struct {
+	collection *mongo.Collection
+}
+
+// NewTournamentRulebookRepository creates a new MongoDB tournament rulebook repository.
+func NewTournamentRulebookRepository(db *mongo.Database) *TournamentRulebookRepository {
+	return &TournamentRulebookRepository{
+		collection: db.Collection("tournament_rulebooks"),
+	}
+}
+
+// GetByTournament retrieves a tournament's rulebook.
+func (r *TournamentRulebookRepository) GetByTournament(ctx context.Context, tournamentID uuid.UUID) (*tournament.Rulebook, error) {
+	var rulebook tournament.Rulebook
+	if err := r.collection.FindOne(ctx, bson.M{"_id": tournamentID}).Decode(&rulebook); err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, tournament.ErrRulebookNotFound
+		}
+		return nil, fmt.Errorf("finding tournament rulebook: %w", err)
+	}
+	if rulebook.Sections == nil {
+		rulebook.Sections = []*tournament.Section{}
+	}
+	return &rulebook, nil
+}
+
+// Save creates or replaces a tournament's rulebook.
+func (r *TournamentRulebookRepository) Save(ctx context.Context, rulebook *tournament.Rulebook) error {
+	_, err := r.collection.ReplaceOne(ctx,
+		bson.M{"_id": rulebook.TournamentID},
+		rulebook,
+		options.Replace().SetUpsert(true),
+	)
+	if err != nil {
+		return fmt.Errorf("saving tournament rulebook: %w", err)
+	}
+	return nil
+}
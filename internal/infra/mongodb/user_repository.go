@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/google/uuid"
@@ -21,37 +22,40 @@ const (
 
 // userDocument represents the MongoDB document structure for a user.
 type userDocument struct {
-	ID           string    `bson:"_id"`
-	Username     string    `bson:"username"`
-	Email        string    `bson:"email"`
-	PasswordHash string    `bson:"password_hash"`
-	Role         string    `bson:"role"`
-	CreatedAt    time.Time `bson:"created_at"`
-	UpdatedAt    time.Time `bson:"updated_at"`
+	ID            string     `bson:"_id"`
+	Username      string     `bson:"username"`
+	Email         string     `bson:"email"`
+	PasswordHash  string     `bson:"password_hash"`
+	Role          string     `bson:"role"`
+	DeactivatedAt *time.Time `bson:"deactivated_at,omitempty"`
+	CreatedAt     time.Time  `bson:"created_at"`
+	UpdatedAt     time.Time  `bson:"updated_at"`
 }
 
 func (d *userDocument) toDomain() *user.User {
 	id, _ := uuid.Parse(d.ID)
 	return &user.User{
-		ID:           id,
-		Username:     d.Username,
-		Email:        d.Email,
-		PasswordHash: d.PasswordHash,
-		Role:         user.Role(d.Role),
-		CreatedAt:    d.CreatedAt,
-		UpdatedAt:    d.UpdatedAt,
+		ID:            id,
+		Username:      d.Username,
+		Email:         d.Email,
+		PasswordHash:  d.PasswordHash,
+		Role:          user.Role(d.Role),
+		DeactivatedAt: d.DeactivatedAt,
+		CreatedAt:     d.CreatedAt,
+		UpdatedAt:     d.UpdatedAt,
 	}
 }
 
 func fromDomainUser(u *user.User) *userDocument {
 	return &userDocument{
-		ID:           u.ID.String(),
-		Username:     u.Username,
-		Email:        u.Email,
-		PasswordHash: u.PasswordHash,
-		Role:         string(u.Role),
-		CreatedAt:    u.CreatedAt,
-		UpdatedAt:    u.UpdatedAt,
+		ID:            u.ID.String(),
+		Username:      u.Username,
+		Email:         u.Email,
+		PasswordHash:  u.PasswordHash,
+		Role:          string(u.Role),
+		DeactivatedAt: u.DeactivatedAt,
+		CreatedAt:     u.CreatedAt,
+		UpdatedAt:     u.UpdatedAt,
 	}
 }
 
@@ -78,6 +82,9 @@ func (r *UserRepository) EnsureIndexes(ctx context.Context) error {
 			Keys:    bson.D{{Key: "username", Value: 1}},
 			Options: options.Index().SetUnique(true),
 		},
+		{
+			Keys: bson.D{{Key: "created_at", Value: -1}},
+		},
 	}
 
 	_, err := r.coll.Indexes().CreateMany(ctx, models)
@@ -145,7 +152,7 @@ func (r *UserRepository) GetAll(ctx context.Context) ([]*user.User, error) {
 	if err != nil {
 		return nil, fmt.Errorf("finding all users: %w", err)
 	}
-	defer cursor.Close(ctx)
+	defer closeCursor(cursor)
 
 	var users []*user.User
 	for cursor.Next(ctx) {
@@ -163,6 +170,117 @@ func (r *UserRepository) GetAll(ctx context.Context) ([]*user.User, error) {
 	return users, nil
 }
 
+// List retrieves the users matching a filter, sorted and paged.
+func (r *UserRepository) List(ctx context.Context, filter user.ListFilter) ([]*user.User, error) {
+	order := 1
+	if filter.Descending {
+		order = -1
+	}
+	sortField := string(filter.Sort)
+	if sortField == "" {
+		sortField = string(user.ListSortCreatedAt)
+	}
+
+	pipeline := append(userFilterPipeline(filter),
+		bson.D{{Key: "$sort", Value: bson.D{{Key: sortField, Value: order}, {Key: "_id", Value: order}}}},
+		bson.D{{Key: "$skip", Value: filter.Offset}},
+	)
+	if filter.Limit > 0 {
+		pipeline = append(pipeline, bson.D{{Key: "$limit", Value: filter.Limit}})
+	}
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return nil, fmt.Errorf("listing users: %w", err)
+	}
+	defer closeCursor(cursor)
+
+	users := []*user.User{}
+	for cursor.Next(ctx) {
+		var doc userDocument
+		if err := cursor.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("decoding user document: %w", err)
+		}
+		users = append(users, doc.toDomain())
+	}
+
+	if err := cursor.Err(); err != nil {
+		return nil, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return users, nil
+}
+
+// Count returns the number of users matching a filter, ignoring its paging.
+func (r *UserRepository) Count(ctx context.Context, filter user.ListFilter) (int64, error) {
+	pipeline := append(userFilterPipeline(filter), bson.D{{Key: "$count", Value: "total"}})
+
+	cursor, err := r.coll.Aggregate(ctx, pipeline)
+	if err != nil {
+		return 0, fmt.Errorf("counting users: %w", err)
+	}
+	defer closeCursor(cursor)
+
+	var result struct {
+		Total int64 `bson:"total"`
+	}
+	if cursor.Next(ctx) {
+		if err := cursor.Decode(&result); err != nil {
+			return 0, fmt.Errorf("decoding user count: %w", err)
+		}
+	}
+
+	if err := cursor.Err(); err != nil {
+		return 0, fmt.Errorf("cursor error: %w", err)
+	}
+
+	return result.Total, nil
+}
+
+// userFilterPipeline builds the aggregation stages selecting the users that
+// match a filter. The banned filter joins each user's player profile.
+func userFilterPipeline(filter user.ListFilter) mongo.Pipeline {
+	match := bson.M{}
+	if filter.Role != "" {
+		match["role"] = string(filter.Role)
+	}
+	if filter.Search != "" {
+		pattern := bson.M{"$regex": regexp.QuoteMeta(filter.Search), "$options": "i"}
+		match["$or"] = bson.A{bson.M{"username": pattern}, bson.M{"email": pattern}}
+	}
+	if filter.CreatedFrom != nil || filter.CreatedTo != nil {
+		created := bson.M{}
+		if filter.CreatedFrom != nil {
+			created["$gte"] = *filter.CreatedFrom
+		}
+		if filter.CreatedTo != nil {
+			created["$lt"] = *filter.CreatedTo
+		}
+		match["created_at"] = created
+	}
+
+	pipeline := mongo.Pipeline{{{Key: "$match", Value: match}}}
+
+	if filter.Banned != nil {
+		banned := bson.M{"player.is_banned": true}
+		if !*filter.Banned {
+			banned = bson.M{"player.is_banned": bson.M{"$ne": true}}
+		}
+		pipeline = append(pipeline,
+			bson.D{{Key: "$lookup", Value: bson.M{
+				"from":         PlayersCollection,
+				"localField":   "_id",
+				"foreignField": "user_id",
+				"as":           "player",
+			}}},
+			bson.D{{Key: "$match", Value: banned}},
+			bson.D{{Key: "$project", Value: bson.M{"player": 0}}},
+		)
+	}
+
+	return pipeline
+}
+
 // Delete removes a user by ID.
 func (r *UserRepository) Delete(ctx context.Context, id string) error {
 	result, err := r.coll.DeleteOne(ctx, bson.M{"_id": id})
@@ -175,6 +293,65 @@ func (r *UserRepository) Delete(ctx context.Context, id string) error {
 	return nil
 }
 
+// UpdateEmail updates a user's email, failing if another user already has it.
+func (r *UserRepository) UpdateEmail(ctx context.Context, id string, email string) error {
+	update := bson.M{
+		"$set": bson.M{
+			"email":      email,
+			"updated_at": time.Now().UTC(),
+		},
+	}
+	result, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if mongo.IsDuplicateKeyError(err) {
+		return user.ErrEmailTaken
+	}
+	if err != nil {
+		return fmt.Errorf("updating user email: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return user.ErrNotFound
+	}
+	return nil
+}
+
+// UpdatePassword replaces a user's password hash.
+func (r *UserRepository) UpdatePassword(ctx context.Context, id string, passwordHash string) error {
+	update := bson.M{
+		"$set": bson.M{
+			"password_hash": passwordHash,
+			"updated_at":    time.Now().UTC(),
+		},
+	}
+	result, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return fmt.Errorf("updating user password: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return user.ErrNotFound
+	}
+	return nil
+}
+
+// UpdateDeactivation sets when a user deactivated their account, or clears it on reactivation.
+func (r *UserRepository) UpdateDeactivation(ctx context.Context, id string, deactivatedAt *time.Time) error {
+	set := bson.M{"updated_at": time.Now().UTC()}
+	update := bson.M{"$set": set}
+	if deactivatedAt != nil {
+		set["deactivated_at"] = *deactivatedAt
+	} else {
+		update["$unset"] = bson.M{"deactivated_at": ""}
+	}
+
+	result, err := r.coll.UpdateOne(ctx, bson.M{"_id": id}, update)
+	if err != nil {
+		return fmt.Errorf("updating user deactivation: %w", err)
+	}
+	if result.MatchedCount == 0 {
+		return user.ErrNotFound
+	}
+	return nil
+}
+
 // UpdateRole updates a user's role.
 func (r *UserRepository) UpdateRole(ctx context.Context, id string, role user.Role) error {
 	update := bson.M{
@@ -0,0 +1,120 @@
+// Package objectstore uploads objects to HTTP object storage such as S3- or
+// GCS-compatible buckets fronted by a CDN.
+package objectstore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// HTTPStore uploads gzipped JSON objects with PUT requests under a base URL
+// and reads them back with GET.
+type HTTPStore struct {
+	baseURL string
+	token   string
+	maxAge  time.Duration
+	client  *http.Client
+}
+
+// NewHTTPStore creates a store that PUTs objects to baseURL/key. The token,
+// when set, is sent as a bearer token. maxAge sets the Cache-Control max-age
+// the CDN and browsers may cache objects for; zero marks objects private and
+// uncacheable.
+func NewHTTPStore(baseURL, token string, maxAge time.Duration) *HTTPStore {
+	return &HTTPStore{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		token:   token,
+		maxAge:  maxAge,
+		client:  &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Put gzips data and uploads it as a JSON object at key.
+func (s *HTTPStore) Put(ctx context.Context, key string, data []byte) error {
+	var body bytes.Buffer
+	zw := gzip.NewWriter(&body)
+	if _, err := zw.Write(data); err != nil {
+		return fmt.Errorf("compressing %s: %w", key, err)
+	}
+	if err := zw.Close(); err != nil {
+		return fmt.Errorf("compressing %s: %w", key, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.baseURL+"/"+key, &body)
+	if err != nil {
+		return fmt.Errorf("building upload request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Content-Encoding", "gzip")
+	if s.maxAge > 0 {
+		req.Header.Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(s.maxAge.Seconds())))
+	} else {
+		req.Header.Set("Cache-Control", "private, no-store")
+	}
+	s.authorize(req)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("uploading %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("uploading %s: status %d: %s", key, resp.StatusCode, bytes.TrimSpace(detail))
+	}
+	return nil
+}
+
+// Get downloads the object at key and returns its uncompressed contents.
+func (s *HTTPStore) Get(ctx context.Context, key string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.baseURL+"/"+key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building download request: %w", err)
+	}
+	s.authorize(req)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("downloading %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		detail, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return nil, fmt.Errorf("downloading %s: status %d: %s", key, resp.StatusCode, bytes.TrimSpace(detail))
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("downloading %s: %w", key, err)
+	}
+
+	// The transport decompresses objects served with Content-Encoding: gzip.
+	// Stores that return the uploaded bytes as they are need it done here.
+	if !bytes.HasPrefix(data, []byte{0x1f, 0x8b}) {
+		return data, nil
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("decompressing %s: %w", key, err)
+	}
+	defer zr.Close()
+	data, err = io.ReadAll(zr)
+	if err != nil {
+		return nil, fmt.Errorf("decompressing %s: %w", key, err)
+	}
+	return data, nil
+}
+
+func (s *HTTPStore) authorize(req *http.Request) {
+	if s.token != "" {
+		req.Header.Set("Authorization", "Bearer "+s.token)
+	}
+}
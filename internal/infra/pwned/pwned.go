@@ -0,0 +1,70 @@
+// Package pwned checks passwords against the Have I Been Pwned password
+// corpus using its k-anonymity range API: only the first five hex characters
+// of the password's SHA-1 hash leave the process.
+package pwned
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultRangeURL is the Have I Been Pwned range endpoint.
+const DefaultRangeURL = "https://api.pwnedpasswords.com/range/"
+
+// Checker queries the range API.
+type Checker struct {
+	rangeURL string
+	client   *http.Client
+}
+
+// NewChecker creates a checker against the public range API.
+func NewChecker() *Checker {
+	return &Checker{
+		rangeURL: DefaultRangeURL,
+		client:   &http.Client{Timeout: 5 * time.Second},
+	}
+}
+
+// IsBreached reports whether password appears in the corpus.
+func (c *Checker) IsBreached(ctx context.Context, password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := hash[:5], hash[5:]
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.rangeURL+prefix, nil)
+	if err != nil {
+		return false, fmt.Errorf("building range request: %w", err)
+	}
+	// Padding hides the real number of matches from observers of the response size.
+	req.Header.Set("Add-Padding", "true")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return false, fmt.Errorf("querying range api: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return false, fmt.Errorf("range api returned status %d", resp.StatusCode)
+	}
+
+	// Each line is SUFFIX:COUNT; padding entries have a count of 0.
+	scanner := bufio.NewScanner(resp.Body)
+	for scanner.Scan() {
+		candidate, count, ok := strings.Cut(strings.TrimSpace(scanner.Text()), ":")
+		if ok && candidate == suffix && count != "0" {
+			return true, nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return false, fmt.Errorf("reading range response: %w", err)
+	}
+
+	return false, nil
+}
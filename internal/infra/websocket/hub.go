@@ -0,0 +1,207 @@
+// Package websocket provides real-time push notifications to connected players.
+package websocket
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/infra/http/middleware"
+	matchmakingusecase "github.com/alejaam/tourney-rank/internal/usecase/matchmaking"
+	"github.com/google/uuid"
+	gorillaws "github.com/gorilla/websocket"
+)
+
+const (
+	writeWait      = 10 * time.Second
+	pongWait       = 60 * time.Second
+	pingPeriod     = (pongWait * 9) / 10
+	sendBufferSize = 16
+)
+
+// client is a single WebSocket connection belonging to a player.
+type client struct {
+	playerID uuid.UUID
+	conn     *gorillaws.Conn
+	send     chan []byte
+}
+
+// Hub tracks player connections and fans out events to them.
+// A player may hold several connections, e.g. multiple browser tabs.
+type Hub struct {
+	mu       sync.RWMutex
+	clients  map[uuid.UUID]map[*client]struct{}
+	upgrader gorillaws.Upgrader
+	logger   *slog.Logger
+}
+
+// NewHub creates a new WebSocket hub.
+func NewHub(logger *slog.Logger) *Hub {
+	return &Hub{
+		clients: make(map[uuid.UUID]map[*client]struct{}),
+		upgrader: gorillaws.Upgrader{
+			ReadBufferSize:  1024,
+			WriteBufferSize: 1024,
+			CheckOrigin:     func(r *http.Request) bool { return true },
+		},
+		logger: logger,
+	}
+}
+
+// NotifyPlayers sends an event to every connection of the given players.
+// Slow connections whose buffer is full are dropped.
+func (h *Hub) NotifyPlayers(playerIDs []uuid.UUID, event matchmakingusecase.Event) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		h.logger.Error("failed to encode websocket event", "error", err, "type", event.Type)
+		return
+	}
+
+	h.mu.RLock()
+	var slow []*client
+	for _, playerID := range playerIDs {
+		for c := range h.clients[playerID] {
+			select {
+			case c.send <- payload:
+			default:
+				slow = append(slow, c)
+			}
+		}
+	}
+	h.mu.RUnlock()
+
+	for _, c := range slow {
+		h.unregister(c)
+	}
+}
+
+// ServeHTTP upgrades an authenticated request to a WebSocket connection.
+func (h *Hub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	userInfo, ok := middleware.GetUserInfo(r.Context())
+	if !ok {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	playerID, err := uuid.Parse(userInfo.ID)
+	if err != nil {
+		http.Error(w, "invalid user ID", http.StatusBadRequest)
+		return
+	}
+
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		h.logger.Debug("websocket upgrade failed", "error", err)
+		return
+	}
+
+	c := &client{
+		playerID: playerID,
+		conn:     conn,
+		send:     make(chan []byte, sendBufferSize),
+	}
+	h.register(c)
+
+	go h.writePump(c)
+	go h.readPump(c)
+}
+
+// Handler returns the WebSocket endpoint. Browsers cannot set headers on
+// WebSocket requests, so the JWT may also be passed as a token query parameter.
+func (h *Hub) Handler(jwtSecret string) http.Handler {
+	mux := http.NewServeMux()
+	authMw := middleware.Auth(jwtSecret, h.logger)
+
+	mux.Handle("GET /ws", tokenFromQuery(authMw(h)))
+
+	return mux
+}
+
+// tokenFromQuery copies a token query parameter into the Authorization header.
+func tokenFromQuery(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if token := r.URL.Query().Get("token"); token != "" && r.Header.Get("Authorization") == "" {
+			r.Header.Set("Authorization", "Bearer "+token)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (h *Hub) register(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.clients[c.playerID] == nil {
+		h.clients[c.playerID] = make(map[*client]struct{})
+	}
+	h.clients[c.playerID][c] = struct{}{}
+}
+
+func (h *Hub) unregister(c *client) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	conns, ok := h.clients[c.playerID]
+	if !ok {
+		return
+	}
+	if _, ok := conns[c]; !ok {
+		return
+	}
+
+	delete(conns, c)
+	if len(conns) == 0 {
+		delete(h.clients, c.playerID)
+	}
+	close(c.send)
+}
+
+// readPump discards client messages and keeps the connection alive via pongs.
+func (h *Hub) readPump(c *client) {
+	defer func() {
+		h.unregister(c)
+		c.conn.Close()
+	}()
+
+	c.conn.SetReadLimit(512)
+	_ = c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	c.conn.SetPongHandler(func(string) error {
+		return c.conn.SetReadDeadline(time.Now().Add(pongWait))
+	})
+
+	for {
+		if _, _, err := c.conn.ReadMessage(); err != nil {
+			return
+		}
+	}
+}
+
+// writePump delivers queued events and periodic pings to the client.
+func (h *Hub) writePump(c *client) {
+	ticker := time.NewTicker(pingPeriod)
+	defer func() {
+		ticker.Stop()
+		c.conn.Close()
+	}()
+
+	for {
+		select {
+		case message, ok := <-c.send:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if !ok {
+				_ = c.conn.WriteMessage(gorillaws.CloseMessage, []byte{})
+				return
+			}
+			if err := c.conn.WriteMessage(gorillaws.TextMessage, message); err != nil {
+				return
+			}
+		case <-ticker.C:
+			_ = c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(gorillaws.PingMessage, nil); err != nil {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,118 @@
+// Package activity provides use cases for reading player and site-wide activity feeds.
+package activity
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/activity"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultFeedLimit = 20
+	maxFeedLimit     = 100
+)
+
+// Service serves player activity feeds from the event log and the public site-wide feed.
+type Service struct {
+	repo       activity.Repository
+	siteFeed   activity.SiteFeedRepository
+	playerRepo player.Repository
+}
+
+// NewService creates a new activity service.
+func NewService(repo activity.Repository, siteFeed activity.SiteFeedRepository, playerRepo player.Repository) *Service {
+	return &Service{
+		repo:       repo,
+		siteFeed:   siteFeed,
+		playerRepo: playerRepo,
+	}
+}
+
+// FeedRequest represents a request for a page of a player's activity feed.
+type FeedRequest struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// FeedResponse is a page of a player's activity feed, newest first.
+type FeedResponse = pagination.Page[*activity.Event]
+
+// GetFeed returns a page of a player's activity timeline.
+func (s *Service) GetFeed(ctx context.Context, playerID uuid.UUID, req FeedRequest) (*FeedResponse, error) {
+	if req.Limit <= 0 {
+		req.Limit = defaultFeedLimit
+	}
+	if req.Limit > maxFeedLimit {
+		req.Limit = maxFeedLimit
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	events, err := s.repo.ListByPlayer(ctx, playerID, req.Limit, req.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("list activity events: %w", err)
+	}
+
+	total, err := s.repo.CountByPlayer(ctx, playerID)
+	if err != nil {
+		return nil, fmt.Errorf("count activity events: %w", err)
+	}
+
+	resp := pagination.New(events, total, int64(req.Limit), int64(req.Offset))
+	return &resp, nil
+}
+
+// GetRecent returns the newest public site-wide events. Events naming a player
+// who hides their activity, is banned, or no longer exists are left out, so
+// fewer than limit events may be returned.
+func (s *Service) GetRecent(ctx context.Context, limit int) ([]*activity.SiteEvent, error) {
+	if limit <= 0 {
+		limit = defaultFeedLimit
+	}
+	if limit > maxFeedLimit {
+		limit = maxFeedLimit
+	}
+
+	events, err := s.siteFeed.ListRecent(ctx, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list site activity: %w", err)
+	}
+
+	var ids []string
+	for _, e := range events {
+		if e.PlayerID != nil {
+			ids = append(ids, e.PlayerID.String())
+		}
+	}
+	players, err := s.playerRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get players: %w", err)
+	}
+	byID := make(map[uuid.UUID]*player.Player, len(players))
+	for _, p := range players {
+		byID[p.ID] = p
+	}
+
+	visible := make([]*activity.SiteEvent, 0, len(events))
+	for _, e := range events {
+		if e.PlayerID == nil {
+			visible = append(visible, e)
+			continue
+		}
+
+		p, ok := byID[*e.PlayerID]
+		if !ok || !p.ShowsPublicActivity() {
+			continue
+		}
+
+		e.PlayerName = p.DisplayName
+		visible = append(visible, e)
+	}
+
+	return visible, nil
+}
@@ -0,0 +1,76 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/user"
+)
+
+// WithConsent enables the consent report for the given current policy versions.
+func (s *UserService) WithConsent(consents user.ConsentRepository, versions user.PolicyVersions) *UserService {
+	s.consents = consents
+	s.policyVersions = versions
+	return s
+}
+
+// PolicyConsentReport summarizes where users stand on one policy.
+type PolicyConsentReport struct {
+	Policy         user.Policy                `json:"policy"`
+	CurrentVersion string                     `json:"current_version"`
+	UpToDate       int64                      `json:"up_to_date"`
+	Outdated       int64                      `json:"outdated"`
+	NeverAccepted  int64                      `json:"never_accepted"`
+	Versions       []user.ConsentVersionCount `json:"versions"`
+}
+
+// ConsentReport summarizes consent status across all users.
+type ConsentReport struct {
+	TotalUsers int64                 `json:"total_users"`
+	Policies   []PolicyConsentReport `json:"policies"`
+}
+
+// GetConsentReport counts, for each policy in force, how many users have
+// accepted its current version, an older one, or none.
+func (s *UserService) GetConsentReport(ctx context.Context) (*ConsentReport, error) {
+	total, err := s.userRepo.Count(ctx, user.ListFilter{})
+	if err != nil {
+		return nil, fmt.Errorf("counting users: %w", err)
+	}
+
+	report := &ConsentReport{TotalUsers: total, Policies: []PolicyConsentReport{}}
+	if s.consents == nil {
+		return report, nil
+	}
+
+	counts, err := s.consents.CountLatestVersions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("counting consents: %w", err)
+	}
+
+	for _, p := range s.policyVersions.Required() {
+		pr := PolicyConsentReport{
+			Policy:         p,
+			CurrentVersion: s.policyVersions.Of(p),
+			Versions:       []user.ConsentVersionCount{},
+		}
+		var accepted int64
+		for _, c := range counts {
+			if c.Policy != p {
+				continue
+			}
+			pr.Versions = append(pr.Versions, c)
+			accepted += c.Users
+			if c.Version == pr.CurrentVersion {
+				pr.UpToDate += c.Users
+			} else {
+				pr.Outdated += c.Users
+			}
+		}
+		// Deleted users keep their consent records, so never go below zero.
+		pr.NeverAccepted = max(total-accepted, 0)
+		report.Policies = append(report.Policies, pr)
+	}
+
+	return report, nil
+}
@@ -2,51 +2,101 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/ranking"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrNoCandidateConfig is returned when a ranking simulation has nothing to compare.
+	ErrNoCandidateConfig = errors.New("ranking_weights or ranking_formula is required")
+
+	// ErrInvalidSampleSize is returned when a ranking simulation sample size is negative.
+	ErrInvalidSampleSize = errors.New("sample_size cannot be negative")
 )
 
 // GameService provides admin operations for game management.
 type GameService struct {
-	gameRepo game.Repository
+	gameRepo       game.Repository
+	statsRepo      player.StatsRepository
+	rankingService *ranking.Service
 }
 
 // NewGameService creates a new GameService.
-func NewGameService(gameRepo game.Repository) *GameService {
+func NewGameService(gameRepo game.Repository, statsRepo player.StatsRepository, rankingService *ranking.Service) *GameService {
 	return &GameService{
-		gameRepo: gameRepo,
+		gameRepo:       gameRepo,
+		statsRepo:      statsRepo,
+		rankingService: rankingService,
 	}
 }
 
 // CreateGameRequest represents the data needed to create a game.
 type CreateGameRequest struct {
-	Name             string              `json:"name"`
-	Slug             string              `json:"slug"`
-	Description      string              `json:"description"`
-	PlatformIDFormat string              `json:"platform_id_format"`
-	StatSchema       game.StatSchema     `json:"stat_schema"`
-	RankingWeights   game.RankingWeights `json:"ranking_weights"`
+	Name             string               `json:"name"`
+	Slug             string               `json:"slug"`
+	Description      string               `json:"description"`
+	PlatformIDFormat string               `json:"platform_id_format"`
+	StatSchema       game.StatSchema      `json:"stat_schema"`
+	RankingWeights   game.RankingWeights  `json:"ranking_weights"`
+	RankingFormula   *game.RankingFormula `json:"ranking_formula,omitempty"`
+	Modes            []game.Mode          `json:"modes,omitempty"`
+	Maps             []string             `json:"maps,omitempty"`
+	Playlists        []string             `json:"playlists,omitempty"`
+	MinTeamSize      int                  `json:"min_team_size,omitempty"`
+	MaxTeamSize      int                  `json:"max_team_size,omitempty"`
 }
 
 // UpdateGameRequest represents the data needed to update a game.
 type UpdateGameRequest struct {
-	Name             string              `json:"name"`
-	Description      string              `json:"description"`
-	PlatformIDFormat string              `json:"platform_id_format"`
-	StatSchema       game.StatSchema     `json:"stat_schema"`
-	RankingWeights   game.RankingWeights `json:"ranking_weights"`
-	IsActive         bool                `json:"is_active"`
+	Name             string               `json:"name"`
+	Description      string               `json:"description"`
+	PlatformIDFormat string               `json:"platform_id_format"`
+	StatSchema       game.StatSchema      `json:"stat_schema"`
+	RankingWeights   game.RankingWeights  `json:"ranking_weights"`
+	RankingFormula   *game.RankingFormula `json:"ranking_formula,omitempty"`
+	Modes            []game.Mode          `json:"modes,omitempty"`
+	Maps             []string             `json:"maps,omitempty"`
+	Playlists        []string             `json:"playlists,omitempty"`
+	MinTeamSize      int                  `json:"min_team_size,omitempty"`
+	MaxTeamSize      int                  `json:"max_team_size,omitempty"`
+	IsActive         bool                 `json:"is_active"`
 }
 
-// ListGamesResponse contains the list of games.
-type ListGamesResponse struct {
-	Games []*game.Game `json:"games"`
-	Total int          `json:"total"`
+// SimulateRankingRequest holds a candidate ranking configuration to evaluate.
+// Omitted fields keep the game's current value. SampleSize limits the
+// simulation to the top players by current score; zero evaluates everyone.
+type SimulateRankingRequest struct {
+	RankingWeights game.RankingWeights  `json:"ranking_weights,omitempty"`
+	RankingFormula *game.RankingFormula `json:"ranking_formula,omitempty"`
+	SampleSize     int64                `json:"sample_size"`
 }
 
+// ListGamesResponse contains the list of games.
+type ListGamesResponse = pagination.Page[*game.Game]
+
 // CreateGame creates a new game.
 func (s *GameService) CreateGame(ctx context.Context, req CreateGameRequest) (*game.Game, error) {
+	if req.RankingFormula != nil {
+		if err := req.RankingFormula.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if err := game.ValidateModes(req.Modes); err != nil {
+		return nil, err
+	}
+	if err := game.ValidateMapsAndPlaylists(req.Maps, req.Playlists); err != nil {
+		return nil, err
+	}
+	if err := game.ValidateTeamSizeBounds(req.MinTeamSize, req.MaxTeamSize); err != nil {
+		return nil, err
+	}
+
 	g, err := game.NewGame(
 		req.Name,
 		req.Slug,
@@ -58,6 +108,12 @@ func (s *GameService) CreateGame(ctx context.Context, req CreateGameRequest) (*g
 	if err != nil {
 		return nil, fmt.Errorf("creating game entity: %w", err)
 	}
+	g.RankingFormula = req.RankingFormula
+	g.Modes = req.Modes
+	g.Maps = req.Maps
+	g.Playlists = req.Playlists
+	g.MinTeamSize = req.MinTeamSize
+	g.MaxTeamSize = req.MaxTeamSize
 
 	if err := s.gameRepo.Create(ctx, g); err != nil {
 		return nil, fmt.Errorf("saving game: %w", err)
@@ -73,10 +129,8 @@ func (s *GameService) ListGames(ctx context.Context) (*ListGamesResponse, error)
 		return nil, fmt.Errorf("listing games: %w", err)
 	}
 
-	return &ListGamesResponse{
-		Games: games,
-		Total: len(games),
-	}, nil
+	resp := pagination.All(games)
+	return &resp, nil
 }
 
 // GetGame retrieves a game by ID.
@@ -89,7 +143,27 @@ func (s *GameService) GetGame(ctx context.Context, id string) (*game.Game, error
 }
 
 // UpdateGame updates an existing game.
+// Omitting ranking_formula clears it and restores the compiled-in calculator.
 func (s *GameService) UpdateGame(ctx context.Context, id string, req UpdateGameRequest) (*game.Game, error) {
+	if req.RankingFormula != nil {
+		if err := req.RankingFormula.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if err := game.ValidateModes(req.Modes); err != nil {
+		return nil, err
+	}
+	if err := game.ValidateMapsAndPlaylists(req.Maps, req.Playlists); err != nil {
+		return nil, err
+	}
+	if err := game.ValidateTeamSizeBounds(req.MinTeamSize, req.MaxTeamSize); err != nil {
+		return nil, err
+	}
+
+	if err := req.StatSchema.Validate(); err != nil {
+		return nil, err
+	}
+
 	// Get existing game
 	g, err := s.gameRepo.GetByID(ctx, id)
 	if err != nil {
@@ -102,6 +176,12 @@ func (s *GameService) UpdateGame(ctx context.Context, id string, req UpdateGameR
 	g.PlatformIDFormat = req.PlatformIDFormat
 	g.StatSchema = req.StatSchema
 	g.RankingWeights = req.RankingWeights
+	g.RankingFormula = req.RankingFormula
+	g.Modes = req.Modes
+	g.Maps = req.Maps
+	g.Playlists = req.Playlists
+	g.MinTeamSize = req.MinTeamSize
+	g.MaxTeamSize = req.MaxTeamSize
 	g.IsActive = req.IsActive
 
 	if err := s.gameRepo.Update(ctx, g); err != nil {
@@ -118,3 +198,65 @@ func (s *GameService) DeleteGame(ctx context.Context, id string) error {
 	}
 	return nil
 }
+
+// SimulateRanking recomputes scores for a game's players under a candidate
+// configuration and reports how ranks and tiers would change. Nothing is persisted.
+func (s *GameService) SimulateRanking(ctx context.Context, id string, req SimulateRankingRequest) (*ranking.SimulationReport, error) {
+	if req.RankingWeights == nil && req.RankingFormula == nil {
+		return nil, ErrNoCandidateConfig
+	}
+	if req.SampleSize < 0 {
+		return nil, ErrInvalidSampleSize
+	}
+
+	current, err := s.gameRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("getting game: %w", err)
+	}
+
+	candidate := *current
+	if req.RankingWeights != nil {
+		if err := candidate.UpdateWeights(req.RankingWeights); err != nil {
+			return nil, err
+		}
+	}
+	if req.RankingFormula != nil {
+		if err := req.RankingFormula.Validate(); err != nil {
+			return nil, err
+		}
+		candidate.RankingFormula = req.RankingFormula
+	}
+
+	gameID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("parsing game id: %w", err)
+	}
+
+	stats, err := s.statsRepo.GetByGame(ctx, gameID, req.SampleSize)
+	if err != nil {
+		return nil, fmt.Errorf("getting player stats: %w", err)
+	}
+
+	players := make([]ranking.SimulatedPlayer, 0, len(stats))
+	for _, ps := range stats {
+		currentScore, currentTier, err := s.rankingService.CalculateRanking(ctx, ps, current)
+		if err != nil {
+			return nil, fmt.Errorf("calculating current ranking: %w", err)
+		}
+		candidateScore, candidateTier, err := s.rankingService.CalculateRanking(ctx, ps, &candidate)
+		if err != nil {
+			return nil, fmt.Errorf("calculating candidate ranking: %w", err)
+		}
+
+		players = append(players, ranking.SimulatedPlayer{
+			PlayerID:       ps.PlayerID,
+			CurrentScore:   currentScore,
+			CurrentTier:    currentTier,
+			CandidateScore: candidateScore,
+			CandidateTier:  candidateTier,
+		})
+	}
+
+	report := ranking.BuildSimulationReport(players)
+	return &report, nil
+}
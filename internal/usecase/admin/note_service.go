@@ -0,0 +1,81 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/note"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/google/uuid"
+)
+
+// NoteService provides admin operations for private notes on matches, players and teams.
+type NoteService struct {
+	noteRepo   note.Repository
+	matchRepo  match.Repository
+	playerRepo player.Repository
+	teamRepo   team.Repository
+}
+
+// NewNoteService creates a new NoteService.
+func NewNoteService(noteRepo note.Repository, matchRepo match.Repository, playerRepo player.Repository, teamRepo team.Repository) *NoteService {
+	return &NoteService{
+		noteRepo:   noteRepo,
+		matchRepo:  matchRepo,
+		playerRepo: playerRepo,
+		teamRepo:   teamRepo,
+	}
+}
+
+// AddNoteRequest represents the data needed to add a note.
+type AddNoteRequest struct {
+	Body string `json:"body"`
+}
+
+// AddNote attaches a note written by authorID to a match, player or team.
+func (s *NoteService) AddNote(ctx context.Context, subject note.Subject, subjectID, authorID uuid.UUID, req AddNoteRequest) (*note.Note, error) {
+	n, err := note.New(subject, subjectID, authorID, req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkSubject(ctx, subject, subjectID); err != nil {
+		return nil, err
+	}
+
+	if err := s.noteRepo.Create(ctx, n); err != nil {
+		return nil, fmt.Errorf("saving note: %w", err)
+	}
+
+	return n, nil
+}
+
+// ListNotes retrieves the notes on a match, player or team, newest first.
+func (s *NoteService) ListNotes(ctx context.Context, subject note.Subject, subjectID uuid.UUID) ([]*note.Note, error) {
+	notes, err := s.noteRepo.ListBySubject(ctx, subject, subjectID)
+	if err != nil {
+		return nil, fmt.Errorf("listing notes: %w", err)
+	}
+	return notes, nil
+}
+
+// checkSubject verifies the record a note is being attached to exists.
+func (s *NoteService) checkSubject(ctx context.Context, subject note.Subject, subjectID uuid.UUID) error {
+	var err error
+	switch subject {
+	case note.SubjectMatch:
+		_, err = s.matchRepo.GetByID(ctx, subjectID.String())
+	case note.SubjectPlayer:
+		_, err = s.playerRepo.GetByID(ctx, subjectID.String())
+	case note.SubjectTeam:
+		_, err = s.teamRepo.GetByID(ctx, subjectID)
+	default:
+		return note.ErrInvalidSubject
+	}
+	if err != nil {
+		return fmt.Errorf("getting %s: %w", subject, err)
+	}
+	return nil
+}
@@ -2,15 +2,30 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
+	teamusecase "github.com/alejaam/tourney-rank/internal/usecase/team"
 	"github.com/google/uuid"
 )
 
+var (
+	// ErrInvalidGameID is returned when a game_id filter is not a valid UUID.
+	ErrInvalidGameID = errors.New("invalid game_id")
+
+	// ErrStatsSortRequiresGame is returned when sorting by a stats field without a game.
+	ErrStatsSortRequiresGame = errors.New("sorting by matches_played or ranking_score requires game_id")
+)
+
 // PlayerService provides admin operations for player management.
 type PlayerService struct {
 	playerRepo player.Repository
+
+	teams *teamusecase.Service
 }
 
 // NewPlayerService creates a new PlayerService.
@@ -37,12 +52,34 @@ type UpdatePlayerRequest struct {
 	PlatformIDs map[string]string `json:"platform_ids"`
 }
 
-// ListPlayersResponse contains the list of players.
-type ListPlayersResponse struct {
-	Players []*player.Player `json:"players"`
-	Total   int              `json:"total"`
+const (
+	defaultPlayerListLimit = 50
+	maxPlayerListLimit     = 200
+)
+
+// ListPlayersRequest filters, sorts and pages the player listing. Zero values match everything.
+type ListPlayersRequest struct {
+	Banned       *bool
+	Region       string
+	Platform     string
+	GameID       string // Restricts stats to one game
+	HasStats     bool
+	IncludeStats bool
+	Sort         string
+	Descending   bool
+	Limit        int64
+	Offset       int64
+}
+
+// PlayerListItem is a player in the admin listing, with a stats summary when requested.
+type PlayerListItem struct {
+	*player.Player
+	Stats *player.StatsSummary `json:"stats,omitempty"`
 }
 
+// ListPlayersResponse contains a page of players and the total matching the filter.
+type ListPlayersResponse = pagination.Page[PlayerListItem]
+
 // CreatePlayer creates a new player.
 func (s *PlayerService) CreatePlayer(ctx context.Context, req CreatePlayerRequest) (*player.Player, error) {
 	p, err := player.NewPlayer(req.UserID, req.DisplayName)
@@ -64,17 +101,67 @@ func (s *PlayerService) CreatePlayer(ctx context.Context, req CreatePlayerReques
 	return p, nil
 }
 
-// ListPlayers retrieves all players.
-func (s *PlayerService) ListPlayers(ctx context.Context) (*ListPlayersResponse, error) {
-	players, err := s.playerRepo.GetAll(ctx)
+// ListPlayers retrieves a page of players matching the request's filters.
+// Sorting by a stats field requires a game, since stats are kept per game.
+func (s *PlayerService) ListPlayers(ctx context.Context, req ListPlayersRequest) (*ListPlayersResponse, error) {
+	sort, err := player.ParseListSort(req.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	var gameID uuid.UUID
+	if req.GameID != "" {
+		if gameID, err = uuid.Parse(req.GameID); err != nil {
+			return nil, ErrInvalidGameID
+		}
+	}
+	if sort.UsesStats() && gameID == uuid.Nil {
+		return nil, ErrStatsSortRequiresGame
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = defaultPlayerListLimit
+	}
+	if req.Limit > maxPlayerListLimit {
+		req.Limit = maxPlayerListLimit
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	filter := player.ListFilter{
+		Banned:       req.Banned,
+		Region:       strings.TrimSpace(req.Region),
+		Platform:     req.Platform,
+		GameID:       gameID,
+		HasStats:     req.HasStats,
+		IncludeStats: req.IncludeStats || sort.UsesStats(),
+		Sort:         sort,
+		Descending:   req.Descending,
+		Limit:        req.Limit,
+		Offset:       req.Offset,
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	entries, err := s.playerRepo.ListFiltered(ctx, filter)
 	if err != nil {
 		return nil, fmt.Errorf("listing players: %w", err)
 	}
 
-	return &ListPlayersResponse{
-		Players: players,
-		Total:   len(players),
-	}, nil
+	total, err := s.playerRepo.CountFiltered(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("counting players: %w", err)
+	}
+
+	items := make([]PlayerListItem, len(entries))
+	for i, e := range entries {
+		items[i] = PlayerListItem{Player: e.Player, Stats: e.Stats}
+	}
+
+	resp := pagination.New(items, total, req.Limit, req.Offset)
+	return &resp, nil
 }
 
 // GetPlayer retrieves a player by ID.
@@ -109,15 +196,20 @@ func (s *PlayerService) UpdatePlayer(ctx context.Context, id string, req UpdateP
 	return p, nil
 }
 
-// DeletePlayer removes a player by ID.
+// DeletePlayer removes a player by ID, first handing the captaincy of the
+// player's teams to a teammate.
 func (s *PlayerService) DeletePlayer(ctx context.Context, id string) error {
+	if err := s.succeedCaptain(ctx, id, team.SuccessionDeleted); err != nil {
+		return err
+	}
 	if err := s.playerRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("deleting player: %w", err)
 	}
 	return nil
 }
 
-// BanPlayer marks a player as banned.
+// BanPlayer marks a player as banned and hands the captaincy of the
+// player's teams to a teammate.
 func (s *PlayerService) BanPlayer(ctx context.Context, id string) (*player.Player, error) {
 	p, err := s.playerRepo.GetByID(ctx, id)
 	if err != nil {
@@ -129,6 +221,9 @@ func (s *PlayerService) BanPlayer(ctx context.Context, id string) (*player.Playe
 	if err := s.playerRepo.Update(ctx, p); err != nil {
 		return nil, fmt.Errorf("updating player: %w", err)
 	}
+	if err := s.succeedCaptain(ctx, id, team.SuccessionBanned); err != nil {
+		return nil, err
+	}
 
 	return p, nil
 }
@@ -0,0 +1,38 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	teamusecase "github.com/alejaam/tourney-rank/internal/usecase/team"
+	"github.com/google/uuid"
+)
+
+// WithCaptainSuccession hands the captaincy of banned and deleted players'
+// teams to their longest-tenured teammate.
+func (s *PlayerService) WithCaptainSuccession(teams *teamusecase.Service) *PlayerService {
+	s.teams = teams
+	return s
+}
+
+// WithCaptainSuccession hands the captaincy of deleted users' teams to their
+// longest-tenured teammate.
+func (s *UserService) WithCaptainSuccession(teams *teamusecase.Service) *UserService {
+	s.teams = teams
+	return s
+}
+
+func (s *PlayerService) succeedCaptain(ctx context.Context, id string, reason team.SuccessionReason) error {
+	if s.teams == nil {
+		return nil
+	}
+	playerID, err := uuid.Parse(id)
+	if err != nil {
+		return nil
+	}
+	if _, err := s.teams.SucceedCaptain(ctx, playerID, reason); err != nil {
+		return fmt.Errorf("succeeding captain: %w", err)
+	}
+	return nil
+}
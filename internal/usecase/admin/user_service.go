@@ -3,13 +3,22 @@ package admin
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
+	"github.com/alejaam/tourney-rank/internal/domain/team"
 	"github.com/alejaam/tourney-rank/internal/domain/user"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
+	teamusecase "github.com/alejaam/tourney-rank/internal/usecase/team"
 )
 
 // UserService provides admin operations for user management.
 type UserService struct {
 	userRepo user.Repository
+
+	consents       user.ConsentRepository
+	policyVersions user.PolicyVersions
+	teams          *teamusecase.Service
 }
 
 // NewUserService creates a new UserService.
@@ -19,28 +28,80 @@ func NewUserService(userRepo user.Repository) *UserService {
 	}
 }
 
-// ListUsersResponse contains the list of users.
-type ListUsersResponse struct {
-	Users []*user.User `json:"users"`
-	Total int          `json:"total"`
+const (
+	defaultUserListLimit = 50
+	maxUserListLimit     = 200
+)
+
+// ListUsersRequest filters, sorts and pages the user listing. Zero values match everything.
+type ListUsersRequest struct {
+	Role        string
+	Search      string
+	CreatedFrom *time.Time
+	CreatedTo   *time.Time
+	Banned      *bool
+	Sort        string
+	Descending  bool
+	Limit       int64
+	Offset      int64
 }
 
+// ListUsersResponse contains a page of users and the total matching the filter.
+type ListUsersResponse = pagination.Page[*user.User]
+
 // UpdateRoleRequest represents the data needed to update a user's role.
 type UpdateRoleRequest struct {
 	Role user.Role `json:"role"`
 }
 
-// ListUsers retrieves all users.
-func (s *UserService) ListUsers(ctx context.Context) (*ListUsersResponse, error) {
-	users, err := s.userRepo.GetAll(ctx)
+// ListUsers retrieves a page of users matching the request's filters.
+func (s *UserService) ListUsers(ctx context.Context, req ListUsersRequest) (*ListUsersResponse, error) {
+	role, err := user.ParseRole(req.Role)
+	if err != nil {
+		return nil, err
+	}
+	sort, err := user.ParseListSort(req.Sort)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Limit <= 0 {
+		req.Limit = defaultUserListLimit
+	}
+	if req.Limit > maxUserListLimit {
+		req.Limit = maxUserListLimit
+	}
+	if req.Offset < 0 {
+		req.Offset = 0
+	}
+
+	filter := user.ListFilter{
+		Role:        role,
+		Search:      strings.TrimSpace(req.Search),
+		CreatedFrom: req.CreatedFrom,
+		CreatedTo:   req.CreatedTo,
+		Banned:      req.Banned,
+		Sort:        sort,
+		Descending:  req.Descending,
+		Limit:       req.Limit,
+		Offset:      req.Offset,
+	}
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	users, err := s.userRepo.List(ctx, filter)
 	if err != nil {
 		return nil, fmt.Errorf("listing users: %w", err)
 	}
 
-	return &ListUsersResponse{
-		Users: users,
-		Total: len(users),
-	}, nil
+	total, err := s.userRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("counting users: %w", err)
+	}
+
+	resp := pagination.New(users, total, req.Limit, req.Offset)
+	return &resp, nil
 }
 
 // GetUser retrieves a user by ID.
@@ -52,8 +113,14 @@ func (s *UserService) GetUser(ctx context.Context, id string) (*user.User, error
 	return u, nil
 }
 
-// DeleteUser removes a user by ID.
+// DeleteUser removes a user by ID, first handing the captaincy of the user's
+// teams to a teammate.
 func (s *UserService) DeleteUser(ctx context.Context, id string) error {
+	if s.teams != nil {
+		if _, err := s.teams.SucceedUserCaptain(ctx, id, team.SuccessionDeleted); err != nil {
+			return fmt.Errorf("succeeding captain: %w", err)
+		}
+	}
 	if err := s.userRepo.Delete(ctx, id); err != nil {
 		return fmt.Errorf("deleting user: %w", err)
 	}
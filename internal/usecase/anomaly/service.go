@@ -0,0 +1,120 @@
+// Package anomaly flags players whose results look out of place for their
+// account, such as experienced players on new accounts.
+package anomaly
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+const (
+	// BaselineSampleSize caps the player stats read to build a game's tier baselines.
+	BaselineSampleSize = 5000
+	// BaselineCacheTTL is how long a game's tier baselines are reused.
+	BaselineCacheTTL = 10 * time.Minute
+)
+
+type baselineEntry struct {
+	baselines map[player.Tier]player.TierBaseline
+	builtAt   time.Time
+}
+
+// Service computes anomaly flags on demand.
+type Service struct {
+	players       player.Repository
+	stats         player.StatsRepository
+	maxAccountAge time.Duration
+
+	mu        sync.Mutex
+	baselines map[uuid.UUID]baselineEntry
+}
+
+// NewService creates a new anomaly service. Accounts younger than
+// maxAccountAge are checked for smurfing; zero uses player.DefaultNewAccountAge.
+func NewService(players player.Repository, stats player.StatsRepository, maxAccountAge time.Duration) *Service {
+	if maxAccountAge <= 0 {
+		maxAccountAge = player.DefaultNewAccountAge
+	}
+	return &Service{
+		players:       players,
+		stats:         stats,
+		maxAccountAge: maxAccountAge,
+		baselines:     make(map[uuid.UUID]baselineEntry),
+	}
+}
+
+// SmurfFlags checks playerIDs for new accounts performing well above their
+// tier in a game and returns the flagged ones keyed by player ID. Tier
+// baselines are cached for BaselineCacheTTL.
+func (s *Service) SmurfFlags(ctx context.Context, gameID uuid.UUID, playerIDs []uuid.UUID) (map[uuid.UUID]*player.SmurfFlag, error) {
+	flags := make(map[uuid.UUID]*player.SmurfFlag)
+	if len(playerIDs) == 0 {
+		return flags, nil
+	}
+
+	ids := make([]string, len(playerIDs))
+	for i, id := range playerIDs {
+		ids[i] = id.String()
+	}
+	players, err := s.players.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get players: %w", err)
+	}
+
+	// Only new accounts are checked, so most rosters need no baselines at all
+	now := time.Now().UTC()
+	var recent []*player.Player
+	for _, p := range players {
+		if now.Sub(p.CreatedAt) < s.maxAccountAge {
+			recent = append(recent, p)
+		}
+	}
+	if len(recent) == 0 {
+		return flags, nil
+	}
+
+	baselines, err := s.tierBaselines(ctx, gameID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range recent {
+		ps, err := s.stats.GetByPlayerAndGame(ctx, p.ID, gameID)
+		if errors.Is(err, player.ErrStatsNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("get player stats: %w", err)
+		}
+		if flag := player.CheckSmurf(p, ps, baselines, s.maxAccountAge, now); flag != nil {
+			flags[p.ID] = flag
+		}
+	}
+	return flags, nil
+}
+
+func (s *Service) tierBaselines(ctx context.Context, gameID uuid.UUID, now time.Time) (map[player.Tier]player.TierBaseline, error) {
+	s.mu.Lock()
+	entry, ok := s.baselines[gameID]
+	s.mu.Unlock()
+	if ok && now.Sub(entry.builtAt) < BaselineCacheTTL {
+		return entry.baselines, nil
+	}
+
+	stats, err := s.stats.GetByGame(ctx, gameID, BaselineSampleSize)
+	if err != nil {
+		return nil, fmt.Errorf("get game stats: %w", err)
+	}
+	baselines := player.BuildTierBaselines(stats)
+
+	s.mu.Lock()
+	s.baselines[gameID] = baselineEntry{baselines: baselines, builtAt: now}
+	s.mu.Unlock()
+	return baselines, nil
+}
@@ -0,0 +1,139 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrCaptchaRequired is returned when a request must include a CAPTCHA response.
+	ErrCaptchaRequired = errors.New("captcha required")
+
+	// ErrCaptchaFailed is returned when a CAPTCHA response is rejected by the provider.
+	ErrCaptchaFailed = errors.New("captcha verification failed")
+
+	// ErrCaptchaUnavailable is returned when the CAPTCHA provider cannot be reached.
+	ErrCaptchaUnavailable = errors.New("captcha verification unavailable")
+)
+
+// loginFailureWindow is how long failed logins count towards requiring a CAPTCHA.
+const loginFailureWindow = 15 * time.Minute
+
+// CaptchaVerifier checks a CAPTCHA response token.
+type CaptchaVerifier interface {
+	Verify(ctx context.Context, token, remoteIP string) (bool, error)
+}
+
+// WithCaptcha requires a CAPTCHA on registration, and on login once an email
+// has failed to log in loginFailures times within loginFailureWindow.
+func (s *Service) WithCaptcha(verifier CaptchaVerifier, loginFailures int) *Service {
+	s.captcha = verifier
+	s.loginFailureThreshold = loginFailures
+	s.loginFailures = newFailureTracker(loginFailureWindow)
+	return s
+}
+
+// verifyCaptcha checks a CAPTCHA response. The provider being unreachable
+// fails the request, so bots cannot slip through during an outage.
+func (s *Service) verifyCaptcha(ctx context.Context, token, remoteIP string) error {
+	if token == "" {
+		return ErrCaptchaRequired
+	}
+
+	ok, err := s.captcha.Verify(ctx, token, remoteIP)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrCaptchaUnavailable, err)
+	}
+	if !ok {
+		return ErrCaptchaFailed
+	}
+	return nil
+}
+
+// failureTracker counts recent failures per key, in memory. Counts are per
+// process, which is enough to slow down credential stuffing against one
+// instance.
+type failureTracker struct {
+	window time.Duration
+
+	mu       sync.Mutex
+	failures map[string]*failureCount
+}
+
+type failureCount struct {
+	count int
+	since time.Time
+}
+
+// maxTrackedFailures is the most keys tracked at once. Expired keys are pruned
+// when it is reached, then the oldest ones are evicted.
+const maxTrackedFailures = 1024
+
+func newFailureTracker(window time.Duration) *failureTracker {
+	return &failureTracker{
+		window:   window,
+		failures: make(map[string]*failureCount),
+	}
+}
+
+// count returns the number of failures for key in the current window.
+func (t *failureTracker) count(key string) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	f, ok := t.failures[strings.ToLower(key)]
+	if !ok || time.Since(f.since) > t.window {
+		return 0
+	}
+	return f.count
+}
+
+// add records a failure for key.
+func (t *failureTracker) add(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	now := time.Now()
+	key = strings.ToLower(key)
+	if _, tracked := t.failures[key]; !tracked && len(t.failures) >= maxTrackedFailures {
+		for k, f := range t.failures {
+			if now.Sub(f.since) > t.window {
+				delete(t.failures, k)
+			}
+		}
+		for len(t.failures) >= maxTrackedFailures {
+			t.evictOldest()
+		}
+	}
+
+	f, ok := t.failures[key]
+	if !ok || now.Sub(f.since) > t.window {
+		t.failures[key] = &failureCount{count: 1, since: now}
+		return
+	}
+	f.count++
+}
+
+// evictOldest forgets the key whose failures were first counted longest ago.
+func (t *failureTracker) evictOldest() {
+	var oldest string
+	var since time.Time
+	for k, f := range t.failures {
+		if since.IsZero() || f.since.Before(since) {
+			oldest, since = k, f.since
+		}
+	}
+	delete(t.failures, oldest)
+}
+
+// reset forgets the failures for key.
+func (t *failureTracker) reset(key string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	delete(t.failures, strings.ToLower(key))
+}
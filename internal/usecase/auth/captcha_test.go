@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFailureTracker_Cap(t *testing.T) {
+	t.Parallel()
+
+	tracker := newFailureTracker(time.Hour)
+	tracker.add("first@example.com")
+	tracker.add("first@example.com")
+	for i := 1; i < maxTrackedFailures; i++ {
+		tracker.add(fmt.Sprintf("user%d@example.com", i))
+	}
+	assert.Len(t, tracker.failures, maxTrackedFailures)
+	tracker.failures["first@example.com"].since = time.Now().Add(-time.Minute)
+
+	tracker.add("first@example.com")
+	assert.Len(t, tracker.failures, maxTrackedFailures, "a tracked key is counted without evicting")
+	assert.Equal(t, 3, tracker.count("first@example.com"))
+
+	tracker.add("new@example.com")
+	assert.Len(t, tracker.failures, maxTrackedFailures, "none of the keys has expired")
+	assert.Equal(t, 1, tracker.count("new@example.com"))
+	assert.Zero(t, tracker.count("first@example.com"), "the oldest key is evicted")
+	assert.Equal(t, 1, tracker.count("user1@example.com"))
+}
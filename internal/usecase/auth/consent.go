@@ -0,0 +1,82 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+// WithConsent requires users to accept the current version of each policy at
+// registration, and records their consent.
+func (s *Service) WithConsent(consents user.ConsentRepository, versions user.PolicyVersions) *Service {
+	s.consents = consents
+	s.policyVersions = versions
+	return s
+}
+
+// ConsentResponse is a user's standing on each policy in force.
+type ConsentResponse struct {
+	Policies []user.ConsentStatus `json:"policies"`
+	UpToDate bool                 `json:"up_to_date"`
+}
+
+// AcceptPoliciesRequest names the policy versions a user is accepting.
+type AcceptPoliciesRequest struct {
+	Terms    string `json:"terms_version"`
+	Privacy  string `json:"privacy_version"`
+	RemoteIP string `json:"-"`
+}
+
+// GetConsent returns a user's standing on each policy in force.
+func (s *Service) GetConsent(ctx context.Context, userID uuid.UUID) (*ConsentResponse, error) {
+	if s.consents == nil {
+		return &ConsentResponse{Policies: []user.ConsentStatus{}, UpToDate: true}, nil
+	}
+
+	latest, err := s.consents.Latest(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("getting consents: %w", err)
+	}
+
+	return &ConsentResponse{
+		Policies: user.ConsentStatuses(latest, s.policyVersions),
+		UpToDate: user.HasCurrentConsent(latest, s.policyVersions),
+	}, nil
+}
+
+// AcceptPolicies records a user accepting the current policy versions, e.g.
+// after a policy was updated.
+func (s *Service) AcceptPolicies(ctx context.Context, userID uuid.UUID, req AcceptPoliciesRequest) (*ConsentResponse, error) {
+	if s.consents != nil {
+		accepted := user.PolicyVersions{Terms: req.Terms, Privacy: req.Privacy}
+		if err := s.policyVersions.Check(accepted); err != nil {
+			return nil, err
+		}
+		if err := s.consents.Create(ctx, user.NewConsents(userID, s.policyVersions, req.RemoteIP)); err != nil {
+			return nil, fmt.Errorf("saving consents: %w", err)
+		}
+	}
+
+	return s.GetConsent(ctx, userID)
+}
+
+// HasCurrentConsent reports whether a user has accepted the current version
+// of every policy in force.
+func (s *Service) HasCurrentConsent(ctx context.Context, userID string) (bool, error) {
+	if s.consents == nil || len(s.policyVersions.Required()) == 0 {
+		return true, nil
+	}
+
+	id, err := uuid.Parse(userID)
+	if err != nil {
+		return false, fmt.Errorf("parsing user id: %w", err)
+	}
+
+	latest, err := s.consents.Latest(ctx, id)
+	if err != nil {
+		return false, fmt.Errorf("getting consents: %w", err)
+	}
+	return user.HasCurrentConsent(latest, s.policyVersions), nil
+}
@@ -0,0 +1,100 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+// WithPlayers lets account deactivation hide the user's player profile and
+// stats, and reactivation restore them.
+func (s *Service) WithPlayers(playerRepo player.Repository, statsRepo player.StatsRepository) *Service {
+	s.playerRepo = playerRepo
+	s.statsRepo = statsRepo
+	return s
+}
+
+// DeactivateAccountRequest represents the data needed to deactivate an account.
+type DeactivateAccountRequest struct {
+	Password string `json:"password"`
+}
+
+// DeactivateAccount hides a user's account after confirming their password.
+// Their player profile leaves leaderboards and search, they can no longer
+// log in and tokens already issued to them are refused, but nothing is
+// deleted: logging in with reactivate set within
+// user.DeactivationGracePeriod restores the account.
+func (s *Service) DeactivateAccount(ctx context.Context, userID uuid.UUID, req DeactivateAccountRequest) error {
+	u, err := s.userRepo.GetByID(ctx, userID.String())
+	if err != nil {
+		return fmt.Errorf("getting user: %w", err)
+	}
+	if !u.CheckPassword(req.Password) {
+		return ErrInvalidCredentials
+	}
+
+	if err := u.Deactivate(); err != nil {
+		return err
+	}
+	if err := s.userRepo.UpdateDeactivation(ctx, u.ID.String(), u.DeactivatedAt); err != nil {
+		return fmt.Errorf("deactivating user: %w", err)
+	}
+
+	return s.setPlayerDeactivated(ctx, u.ID, true)
+}
+
+// IsAccountActive reports whether a user's account exists and is not
+// deactivated, so tokens issued to it before deactivation stop working.
+func (s *Service) IsAccountActive(ctx context.Context, userID string) (bool, error) {
+	u, err := s.userRepo.GetByID(ctx, userID)
+	if errors.Is(err, user.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("getting user: %w", err)
+	}
+	return !u.IsDeactivated(), nil
+}
+
+// reactivate restores a deactivated account and its player profile.
+func (s *Service) reactivate(ctx context.Context, u *user.User) error {
+	if err := u.Reactivate(); err != nil {
+		return err
+	}
+	if err := s.userRepo.UpdateDeactivation(ctx, u.ID.String(), nil); err != nil {
+		return fmt.Errorf("reactivating user: %w", err)
+	}
+
+	return s.setPlayerDeactivated(ctx, u.ID, false)
+}
+
+// setPlayerDeactivated hides or restores the user's player profile and stats, if they have one.
+func (s *Service) setPlayerDeactivated(ctx context.Context, userID uuid.UUID, deactivated bool) error {
+	if s.playerRepo == nil {
+		return nil
+	}
+
+	p, err := s.playerRepo.GetByUserID(ctx, userID.String())
+	if errors.Is(err, player.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("getting player: %w", err)
+	}
+
+	p.SetDeactivated(deactivated)
+	if err := s.playerRepo.Update(ctx, p); err != nil {
+		return fmt.Errorf("updating player: %w", err)
+	}
+
+	if s.statsRepo != nil {
+		if err := s.statsRepo.SetPlayerDeactivated(ctx, p.ID, deactivated); err != nil {
+			return fmt.Errorf("updating player stats: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+// ErrEmailChangeUnavailable is returned when the service has no mailer to verify new addresses.
+var ErrEmailChangeUnavailable = errors.New("email change is not available")
+
+// Mailer sends transactional email.
+type Mailer interface {
+	SendMail(ctx context.Context, to, subject, body string) error
+}
+
+// WithEmailChange enables the email change flow. Verification links point
+// at baseURL, the frontend origin.
+func (s *Service) WithEmailChange(changes user.EmailChangeRepository, mailer Mailer, baseURL string) *Service {
+	s.emailChanges = changes
+	s.mailer = mailer
+	s.baseURL = baseURL
+	return s
+}
+
+// ChangeEmailRequest represents the data needed to start an email change.
+type ChangeEmailRequest struct {
+	NewEmail string `json:"new_email"`
+	Password string `json:"password"`
+}
+
+// RequestEmailChange starts switching a user to a new email address. The
+// password must be confirmed; the address is only switched once the link
+// sent to it is followed. The current address is told about the request.
+// Any earlier pending change is replaced.
+func (s *Service) RequestEmailChange(ctx context.Context, userID uuid.UUID, req ChangeEmailRequest) error {
+	if s.emailChanges == nil || s.mailer == nil {
+		return ErrEmailChangeUnavailable
+	}
+
+	u, err := s.userRepo.GetByID(ctx, userID.String())
+	if err != nil {
+		return fmt.Errorf("getting user: %w", err)
+	}
+	if !u.CheckPassword(req.Password) {
+		return ErrInvalidCredentials
+	}
+
+	change, token, err := user.NewEmailChange(u.ID, req.NewEmail)
+	if err != nil {
+		return err
+	}
+	if err := s.checkEmailAvailable(ctx, u, change.NewEmail); err != nil {
+		return err
+	}
+
+	if err := s.emailChanges.DeleteByUser(ctx, u.ID); err != nil {
+		return fmt.Errorf("clearing pending email changes: %w", err)
+	}
+	if err := s.emailChanges.Create(ctx, change); err != nil {
+		return fmt.Errorf("saving email change: %w", err)
+	}
+
+	link := s.baseURL + "/verify-email?token=" + url.QueryEscape(token)
+	verify := fmt.Sprintf("Hi %s,\n\nConfirm this address for your TourneyRank account by opening the link below. It expires in %d hours.\n\n%s\n\nIf you did not ask for this, ignore this message.",
+		u.Username, int(user.EmailChangeTTL.Hours()), link)
+	if err := s.mailer.SendMail(ctx, change.NewEmail, "Confirm your new email address", verify); err != nil {
+		return fmt.Errorf("sending verification email: %w", err)
+	}
+
+	notice := fmt.Sprintf("Hi %s,\n\nA request was made to change your TourneyRank email to %s. Nothing changes until the new address is confirmed.\n\nIf this wasn't you, change your password.",
+		u.Username, change.NewEmail)
+	if err := s.mailer.SendMail(ctx, u.Email, "Email change requested", notice); err != nil {
+		return fmt.Errorf("sending email change notice: %w", err)
+	}
+
+	return nil
+}
+
+// ConfirmEmailChange switches a user to the address a verification token was
+// sent to, and notifies both the old and new addresses. Once the switch is
+// saved the change succeeds, even if a notice cannot be sent.
+func (s *Service) ConfirmEmailChange(ctx context.Context, token string) (*user.User, error) {
+	if s.emailChanges == nil || s.mailer == nil {
+		return nil, ErrEmailChangeUnavailable
+	}
+
+	change, err := s.emailChanges.GetByTokenHash(ctx, user.HashEmailChangeToken(token))
+	if err != nil {
+		return nil, err
+	}
+	if change.Expired(time.Now()) {
+		return nil, user.ErrEmailChangeExpired
+	}
+
+	u, err := s.userRepo.GetByID(ctx, change.UserID.String())
+	if err != nil {
+		return nil, fmt.Errorf("getting user: %w", err)
+	}
+	if err := s.checkEmailAvailable(ctx, u, change.NewEmail); err != nil {
+		return nil, err
+	}
+
+	oldEmail := u.Email
+	if err := s.userRepo.UpdateEmail(ctx, u.ID.String(), change.NewEmail); err != nil {
+		return nil, err
+	}
+	u.Email = change.NewEmail
+	u.UpdatedAt = time.Now().UTC()
+
+	if err := s.emailChanges.DeleteByUser(ctx, u.ID); err != nil {
+		return nil, fmt.Errorf("clearing pending email changes: %w", err)
+	}
+
+	// The change is saved, so a notice that cannot be sent is logged rather
+	// than reported as a failed change
+	notice := fmt.Sprintf("Hi %s,\n\nThe email for your TourneyRank account was changed from %s to %s.\n\nIf this wasn't you, contact support.",
+		u.Username, oldEmail, u.Email)
+	for _, to := range []string{oldEmail, u.Email} {
+		if err := s.mailer.SendMail(ctx, to, "Your email address was changed", notice); err != nil {
+			s.logger.Warn("failed to send email changed notice", "user_id", u.ID, "error", err)
+		}
+	}
+
+	return u, nil
+}
+
+// checkEmailAvailable reports whether u can switch to email.
+func (s *Service) checkEmailAvailable(ctx context.Context, u *user.User, email string) error {
+	if strings.EqualFold(email, u.Email) {
+		return user.ErrEmailUnchanged
+	}
+
+	existing, err := s.userRepo.GetByEmail(ctx, email)
+	if err == nil && existing.ID != u.ID {
+		return user.ErrEmailTaken
+	}
+	if err != nil && !errors.Is(err, user.ErrNotFound) {
+		return fmt.Errorf("checking email: %w", err)
+	}
+	return nil
+}
@@ -4,10 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"log/slog"
 	"time"
 
+	"github.com/alejaam/tourney-rank/internal/domain/player"
 	"github.com/alejaam/tourney-rank/internal/domain/user"
 	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
 )
 
 // ErrInvalidCredentials is returned when login fails.
@@ -18,28 +21,78 @@ type Service struct {
 	userRepo  user.Repository
 	jwtSecret string
 	tokenTTL  time.Duration
+
+	emailChanges user.EmailChangeRepository
+	mailer       Mailer
+	baseURL      string
+
+	passwordPolicy user.PasswordPolicy
+	breachChecker  BreachChecker
+
+	captcha               CaptchaVerifier
+	loginFailureThreshold int
+	loginFailures         *failureTracker
+
+	consents       user.ConsentRepository
+	policyVersions user.PolicyVersions
+
+	playerRepo player.Repository
+	statsRepo  player.StatsRepository
+
+	logger *slog.Logger
+}
+
+// BreachChecker reports whether a password appears in a known data breach.
+type BreachChecker interface {
+	IsBreached(ctx context.Context, password string) (bool, error)
 }
 
 // NewService creates a new authentication service.
 func NewService(userRepo user.Repository, jwtSecret string, tokenTTL time.Duration) *Service {
 	return &Service{
-		userRepo:  userRepo,
-		jwtSecret: jwtSecret,
-		tokenTTL:  tokenTTL,
+		userRepo:       userRepo,
+		jwtSecret:      jwtSecret,
+		tokenTTL:       tokenTTL,
+		passwordPolicy: user.DefaultPasswordPolicy,
+		logger:         slog.Default(),
 	}
 }
 
+// WithLogger sets the logger for failures that do not fail the request, such
+// as a notice that could not be sent after a change was saved.
+func (s *Service) WithLogger(logger *slog.Logger) *Service {
+	s.logger = logger
+	return s
+}
+
+// WithPasswordPolicy sets the complexity rules for new passwords. The breach
+// checker is optional and may be nil.
+func (s *Service) WithPasswordPolicy(policy user.PasswordPolicy, checker BreachChecker) *Service {
+	s.passwordPolicy = policy
+	s.breachChecker = checker
+	return s
+}
+
 // RegisterRequest represents the data needed to register a user.
 type RegisterRequest struct {
-	Username string
-	Email    string
-	Password string
+	Username     string
+	Email        string
+	Password     string
+	CaptchaToken string `json:"captcha_token"`
+	RemoteIP     string `json:"-"`
+
+	// Policy versions the user accepted while registering.
+	TermsVersion   string `json:"terms_version"`
+	PrivacyVersion string `json:"privacy_version"`
 }
 
 // LoginRequest represents the data needed to login.
 type LoginRequest struct {
-	Email    string
-	Password string
+	Email        string
+	Password     string
+	CaptchaToken string `json:"captcha_token"`
+	RemoteIP     string `json:"-"`
+	Reactivate   bool   `json:"reactivate"` // Restore a deactivated account
 }
 
 // AuthResponse contains the token and user info.
@@ -48,8 +101,15 @@ type AuthResponse struct {
 	User  *user.User `json:"user"`
 }
 
-// Register creates a new user and returns a token.
+// Register creates a new user and returns a token. A CAPTCHA and consent to
+// the current policies are required when configured.
 func (s *Service) Register(ctx context.Context, req RegisterRequest) (*AuthResponse, error) {
+	if s.captcha != nil {
+		if err := s.verifyCaptcha(ctx, req.CaptchaToken, req.RemoteIP); err != nil {
+			return nil, err
+		}
+	}
+
 	// Check if user exists
 	_, err := s.userRepo.GetByEmail(ctx, req.Email)
 	if err == nil {
@@ -61,6 +121,17 @@ func (s *Service) Register(ctx context.Context, req RegisterRequest) (*AuthRespo
 		return nil, errors.New("username already taken")
 	}
 
+	if err := s.checkPassword(ctx, req.Password); err != nil {
+		return nil, err
+	}
+
+	if s.consents != nil {
+		accepted := user.PolicyVersions{Terms: req.TermsVersion, Privacy: req.PrivacyVersion}
+		if err := s.policyVersions.Check(accepted); err != nil {
+			return nil, err
+		}
+	}
+
 	// Create user
 	u, err := user.NewUser(req.Username, req.Email, req.Password)
 	if err != nil {
@@ -71,6 +142,12 @@ func (s *Service) Register(ctx context.Context, req RegisterRequest) (*AuthRespo
 		return nil, err
 	}
 
+	if s.consents != nil {
+		if err := s.consents.Create(ctx, user.NewConsents(u.ID, s.policyVersions, req.RemoteIP)); err != nil {
+			return nil, fmt.Errorf("saving consents: %w", err)
+		}
+	}
+
 	// Generate token
 	token, err := s.generateToken(u)
 	if err != nil {
@@ -84,18 +161,40 @@ func (s *Service) Register(ctx context.Context, req RegisterRequest) (*AuthRespo
 }
 
 // Login verifies credentials and returns a token.
+// Once an email has failed to log in too often, a CAPTCHA is required.
+// Deactivated accounts can only log in by asking to be reactivated.
 func (s *Service) Login(ctx context.Context, req LoginRequest) (*AuthResponse, error) {
+	if s.captcha != nil && s.loginFailures.count(req.Email) >= s.loginFailureThreshold {
+		if err := s.verifyCaptcha(ctx, req.CaptchaToken, req.RemoteIP); err != nil {
+			return nil, err
+		}
+	}
+
 	u, err := s.userRepo.GetByEmail(ctx, req.Email)
 	if err != nil {
 		if errors.Is(err, user.ErrNotFound) {
+			s.recordLoginFailure(req.Email)
 			return nil, ErrInvalidCredentials
 		}
 		return nil, err
 	}
 
 	if !u.CheckPassword(req.Password) {
+		s.recordLoginFailure(req.Email)
 		return nil, ErrInvalidCredentials
 	}
+	if s.loginFailures != nil {
+		s.loginFailures.reset(req.Email)
+	}
+
+	if u.IsDeactivated() {
+		if !req.Reactivate {
+			return nil, user.ErrAccountDeactivated
+		}
+		if err := s.reactivate(ctx, u); err != nil {
+			return nil, err
+		}
+	}
 
 	token, err := s.generateToken(u)
 	if err != nil {
@@ -108,6 +207,58 @@ func (s *Service) Login(ctx context.Context, req LoginRequest) (*AuthResponse, e
 	}, nil
 }
 
+// recordLoginFailure counts a failed login when CAPTCHAs are enabled.
+func (s *Service) recordLoginFailure(email string) {
+	if s.loginFailures != nil {
+		s.loginFailures.add(email)
+	}
+}
+
+// ChangePasswordRequest represents the data needed to change a password.
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"current_password"`
+	NewPassword     string `json:"new_password"`
+}
+
+// ChangePassword replaces a user's password after confirming the current one.
+func (s *Service) ChangePassword(ctx context.Context, userID uuid.UUID, req ChangePasswordRequest) error {
+	u, err := s.userRepo.GetByID(ctx, userID.String())
+	if err != nil {
+		return fmt.Errorf("getting user: %w", err)
+	}
+	if !u.CheckPassword(req.CurrentPassword) {
+		return ErrInvalidCredentials
+	}
+
+	if err := s.checkPassword(ctx, req.NewPassword); err != nil {
+		return err
+	}
+	if err := u.SetPassword(req.NewPassword); err != nil {
+		return err
+	}
+
+	if err := s.userRepo.UpdatePassword(ctx, u.ID.String(), u.PasswordHash); err != nil {
+		return fmt.Errorf("updating password: %w", err)
+	}
+	return nil
+}
+
+// checkPassword applies the password policy and, if configured, the breach
+// check. The breach check fails open: an unreachable checker does not block
+// the user.
+func (s *Service) checkPassword(ctx context.Context, password string) error {
+	if err := s.passwordPolicy.Validate(password); err != nil {
+		return err
+	}
+
+	if s.breachChecker != nil {
+		if breached, err := s.breachChecker.IsBreached(ctx, password); err == nil && breached {
+			return user.ErrBreachedPassword
+		}
+	}
+	return nil
+}
+
 func (s *Service) generateToken(u *user.User) (string, error) {
 	claims := jwt.MapClaims{
 		"sub":  u.ID.String(),
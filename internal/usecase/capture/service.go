@@ -0,0 +1,103 @@
+// Package capture provides use cases for inspecting and replaying captured
+// API requests.
+package capture
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/capture"
+	"github.com/alejaam/tourney-rank/internal/usecase/match"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultListLimit is how many captures are listed when no limit is requested.
+	DefaultListLimit = 50
+
+	// MaxListLimit is the most captures listed at once.
+	MaxListLimit = 200
+)
+
+// Service provides request capture operations.
+type Service struct {
+	repo    capture.Repository
+	matches *match.Service
+}
+
+// NewService creates a new request capture service. Captured submissions are
+// replayed through the match service's dry-run path.
+func NewService(repo capture.Repository, matches *match.Service) *Service {
+	return &Service{
+		repo:    repo,
+		matches: matches,
+	}
+}
+
+// ReplayResult is the outcome of running a captured submission again.
+type ReplayResult struct {
+	CaptureID      uuid.UUID            `json:"capture_id"`
+	OriginalStatus int                  `json:"original_status"`
+	Accepted       bool                 `json:"accepted"`        // The submission passes every check today
+	Error          string               `json:"error,omitempty"` // Why it is rejected today
+	Match          *match.MatchResponse `json:"match,omitempty"` // The draft that would be stored
+}
+
+// List returns the newest captures, of one kind or, for the empty kind, of
+// every kind.
+func (s *Service) List(ctx context.Context, kind capture.Kind, limit int64) ([]*capture.Capture, error) {
+	if kind != "" && !kind.IsValid() {
+		return nil, fmt.Errorf("%w: %s", capture.ErrInvalidKind, kind)
+	}
+	if limit <= 0 {
+		limit = DefaultListLimit
+	}
+	limit = min(limit, MaxListLimit)
+
+	captures, err := s.repo.List(ctx, kind, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list captures: %w", err)
+	}
+	return captures, nil
+}
+
+// Get returns a capture.
+func (s *Service) Get(ctx context.Context, id uuid.UUID) (*capture.Capture, error) {
+	return s.repo.GetByID(ctx, id)
+}
+
+// Replay runs a captured match submission through the dry-run submission
+// path as the user who made it, against the current state of the
+// tournament and team. Nothing is stored, so a replay can be repeated.
+func (s *Service) Replay(ctx context.Context, id uuid.UUID) (*ReplayResult, error) {
+	c, err := s.repo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !c.Replayable() {
+		return nil, capture.ErrNotReplayable
+	}
+
+	submitterID, err := uuid.Parse(c.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: the capture has no submitter", capture.ErrNotReplayable)
+	}
+
+	result := &ReplayResult{CaptureID: c.ID, OriginalStatus: c.Status}
+
+	var req match.SubmitMatchRequest
+	if err := json.Unmarshal([]byte(c.RequestBody), &req); err != nil {
+		result.Error = "invalid request body"
+		return result, nil
+	}
+
+	resp, err := s.matches.DryRunSubmission(ctx, req, submitterID)
+	if err != nil {
+		result.Error = err.Error()
+		return result, nil
+	}
+	result.Accepted = true
+	result.Match = resp
+	return result, nil
+}
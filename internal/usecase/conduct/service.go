@@ -0,0 +1,100 @@
+// Package conduct keeps players' conduct records and turns them, together
+// with the honors teammates gave them, into conduct scores.
+package conduct
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+// PlayerConduct is a player's conduct score with the records behind it.
+type PlayerConduct struct {
+	PlayerID uuid.UUID               `json:"player_id"`
+	Score    float64                 `json:"score"`
+	Honors   player.Honors           `json:"honors"`
+	Records  []*player.ConductRecord `json:"records"` // Newest first
+}
+
+// Service records and scores player conduct.
+type Service struct {
+	records player.ConductRepository
+	players player.Repository
+	policy  player.ConductPolicy
+}
+
+// NewService creates a new conduct service.
+func NewService(records player.ConductRepository, players player.Repository, policy player.ConductPolicy) *Service {
+	return &Service{records: records, players: players, policy: policy}
+}
+
+// Record stores a conduct record.
+func (s *Service) Record(ctx context.Context, record *player.ConductRecord) error {
+	return s.records.Create(ctx, record)
+}
+
+// Scores returns the current conduct scores of playerIDs, keyed by player
+// ID. Unknown players are left out.
+func (s *Service) Scores(ctx context.Context, playerIDs []uuid.UUID) (map[uuid.UUID]float64, error) {
+	conduct, err := s.conduct(ctx, playerIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make(map[uuid.UUID]float64, len(conduct))
+	for id, c := range conduct {
+		scores[id] = c.Score
+	}
+	return scores, nil
+}
+
+// Get returns a player's conduct score and records.
+func (s *Service) Get(ctx context.Context, playerID uuid.UUID) (*PlayerConduct, error) {
+	conduct, err := s.conduct(ctx, []uuid.UUID{playerID})
+	if err != nil {
+		return nil, err
+	}
+	c, ok := conduct[playerID]
+	if !ok {
+		return nil, player.ErrNotFound
+	}
+	return c, nil
+}
+
+func (s *Service) conduct(ctx context.Context, playerIDs []uuid.UUID) (map[uuid.UUID]*PlayerConduct, error) {
+	conduct := make(map[uuid.UUID]*PlayerConduct, len(playerIDs))
+	if len(playerIDs) == 0 {
+		return conduct, nil
+	}
+
+	ids := make([]string, len(playerIDs))
+	for i, id := range playerIDs {
+		ids[i] = id.String()
+	}
+	players, err := s.players.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get players: %w", err)
+	}
+	for _, p := range players {
+		conduct[p.ID] = &PlayerConduct{PlayerID: p.ID, Honors: p.Honors, Records: []*player.ConductRecord{}}
+	}
+
+	records, err := s.records.ListByPlayers(ctx, playerIDs)
+	if err != nil {
+		return nil, fmt.Errorf("list conduct records: %w", err)
+	}
+	for _, r := range records {
+		if c, ok := conduct[r.PlayerID]; ok {
+			c.Records = append(c.Records, r)
+		}
+	}
+
+	now := time.Now().UTC()
+	for _, c := range conduct {
+		c.Score = s.policy.Score(c.Records, c.Honors, now)
+	}
+	return conduct, nil
+}
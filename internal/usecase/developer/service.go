@@ -0,0 +1,212 @@
+// Package developer provides use cases for the public API tier: API key
+// signup, quota enforcement and usage reporting.
+package developer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/developer"
+	"github.com/google/uuid"
+)
+
+// UsageHistoryDays is how many days of usage, today included, are reported
+// for each key.
+const UsageHistoryDays = 30
+
+// Service handles API keys and their quotas.
+type Service struct {
+	keys      developer.KeyRepository
+	usage     developer.UsageRepository
+	quota     developer.Quota
+	anonymous developer.Quota
+}
+
+// NewService creates a new developer service enforcing quota on every key.
+func NewService(keys developer.KeyRepository, usage developer.UsageRepository, quota developer.Quota) *Service {
+	return &Service{
+		keys:  keys,
+		usage: usage,
+		quota: quota,
+	}
+}
+
+// WithAnonymousQuota limits requests made without an API key, counted per
+// client address. Without it they are unlimited.
+func (s *Service) WithAnonymousQuota(quota developer.Quota) *Service {
+	s.anonymous = quota
+	return s
+}
+
+// CreateKeyRequest represents the request to create an API key.
+type CreateKeyRequest struct {
+	Name string `json:"name"`
+}
+
+// CreatedKey is a new API key with the key itself, which is only ever shown
+// in this response.
+type CreatedKey struct {
+	*developer.APIKey
+	Key string `json:"key"`
+}
+
+// KeyUsage is one key's usage of the daily quota.
+type KeyUsage struct {
+	Key       *developer.APIKey  `json:"key"`
+	Today     int64              `json:"today"`     // Requests made today
+	Remaining int64              `json:"remaining"` // Requests left today; 0 when the daily quota is unlimited
+	ResetAt   time.Time          `json:"reset_at"`  // When today's count starts over
+	Days      []*developer.Usage `json:"days"`      // Daily usage, oldest first; days without requests are left out
+}
+
+// UsageResponse is the quota that applies to every key and each key's usage.
+type UsageResponse struct {
+	Quota developer.Quota `json:"quota"`
+	Keys  []*KeyUsage     `json:"keys"`
+}
+
+// CreateKey issues the user a new API key.
+func (s *Service) CreateKey(ctx context.Context, userID uuid.UUID, req CreateKeyRequest) (*CreatedKey, error) {
+	keys, err := s.keys.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	active := 0
+	for _, k := range keys {
+		if k.Active() {
+			active++
+		}
+	}
+	if active >= developer.MaxKeysPerUser {
+		return nil, developer.ErrTooManyKeys
+	}
+
+	k, secret, err := developer.NewAPIKey(userID, req.Name, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.keys.Create(ctx, k); err != nil {
+		return nil, err
+	}
+	return &CreatedKey{APIKey: k, Key: secret}, nil
+}
+
+// ListKeys returns the user's API keys, revoked ones included, oldest first.
+func (s *Service) ListKeys(ctx context.Context, userID uuid.UUID) ([]*developer.APIKey, error) {
+	return s.keys.ListByUser(ctx, userID)
+}
+
+// RevokeKey revokes one of the user's API keys. Keys of other users are
+// reported as not found.
+func (s *Service) RevokeKey(ctx context.Context, userID, keyID uuid.UUID) error {
+	k, err := s.keys.GetByID(ctx, keyID)
+	if err != nil {
+		return err
+	}
+	if k.UserID != userID {
+		return developer.ErrKeyNotFound
+	}
+
+	k.Revoke(time.Now().UTC())
+	return s.keys.Update(ctx, k)
+}
+
+// Usage reports the daily usage of each of the user's active keys.
+func (s *Service) Usage(ctx context.Context, userID uuid.UUID) (*UsageResponse, error) {
+	keys, err := s.keys.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	today := developer.WindowDay.Start(now)
+	from := today.AddDate(0, 0, 1-UsageHistoryDays)
+
+	resp := &UsageResponse{Quota: s.quota, Keys: []*KeyUsage{}}
+	for _, k := range keys {
+		if !k.Active() {
+			continue
+		}
+		days, err := s.usage.List(ctx, k.ID, developer.WindowDay, from)
+		if err != nil {
+			return nil, err
+		}
+
+		u := &KeyUsage{Key: k, ResetAt: developer.WindowDay.Reset(now), Days: days}
+		for _, d := range days {
+			if d.Start.Equal(today) {
+				u.Today = d.Requests
+			}
+		}
+		if s.quota.PerDay > 0 {
+			u.Remaining = max(s.quota.PerDay-u.Today, 0)
+		}
+		resp.Keys = append(resp.Keys, u)
+	}
+	return resp, nil
+}
+
+// Authorize counts a request made with an API key against the key's quota.
+// Unknown and revoked keys return developer.ErrInvalidKey.
+func (s *Service) Authorize(ctx context.Context, key string) (*developer.Decision, error) {
+	k, err := s.keys.GetByHash(ctx, developer.HashKey(key))
+	if errors.Is(err, developer.ErrKeyNotFound) {
+		return nil, developer.ErrInvalidKey
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !k.Active() {
+		return nil, developer.ErrInvalidKey
+	}
+
+	now := time.Now().UTC()
+	minute, err := s.usage.Count(ctx, k.ID, developer.WindowMinute, developer.WindowMinute.Start(now))
+	if err != nil {
+		return nil, err
+	}
+	day, err := s.usage.Count(ctx, k.ID, developer.WindowDay, developer.WindowDay.Start(now))
+	if err != nil {
+		return nil, err
+	}
+
+	d := s.quota.Check(k.ID, minute, day, now)
+	if !d.Allowed {
+		if err := s.usage.Reject(ctx, k.ID, developer.WindowDay, developer.WindowDay.Start(now)); err != nil {
+			return nil, err
+		}
+	}
+	return &d, nil
+}
+
+// AuthorizeAnonymous counts a request made without an API key against the
+// anonymous quota of the client address it came from. Windows the quota
+// leaves unlimited are not counted.
+func (s *Service) AuthorizeAnonymous(ctx context.Context, address string) (*developer.Decision, error) {
+	id := developer.AnonymousID(address)
+	now := time.Now().UTC()
+
+	var minute, day int64
+	var err error
+	if s.anonymous.PerMinute > 0 {
+		minute, err = s.usage.Count(ctx, id, developer.WindowMinute, developer.WindowMinute.Start(now))
+		if err != nil {
+			return nil, err
+		}
+	}
+	if s.anonymous.PerDay > 0 {
+		day, err = s.usage.Count(ctx, id, developer.WindowDay, developer.WindowDay.Start(now))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	d := s.anonymous.Check(id, minute, day, now)
+	if !d.Allowed {
+		if err := s.usage.Reject(ctx, id, developer.WindowDay, developer.WindowDay.Start(now)); err != nil {
+			return nil, err
+		}
+	}
+	return &d, nil
+}
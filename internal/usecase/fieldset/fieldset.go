@@ -0,0 +1,133 @@
+// Package fieldset implements sparse fieldsets: a client names the top-level
+// fields of a resource it renders with ?fields=a,b and gets only those back.
+package fieldset
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ErrUnknownField is returned when a fieldset names a field the resource does
+// not have.
+var ErrUnknownField = errors.New("unknown field")
+
+// Set is the fields of a resource a client asked for, by their JSON names.
+// The zero Set asks for every field.
+type Set struct {
+	fields []string
+}
+
+// Parse parses a comma separated list of the JSON fields of resource, a
+// struct or a pointer to one. An empty list asks for every field; the
+// resource's id is always included.
+func Parse(raw string, resource any) (Set, error) {
+	if strings.TrimSpace(raw) == "" {
+		return Set{}, nil
+	}
+
+	known := make(map[string]bool)
+	for _, name := range names(reflect.TypeOf(resource)) {
+		known[name] = true
+	}
+
+	var s Set
+	if known["id"] {
+		s.fields = append(s.fields, "id")
+	}
+	for _, field := range strings.Split(raw, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" || s.Has(field) {
+			continue
+		}
+		if !known[field] {
+			return Set{}, fmt.Errorf("%w: %s", ErrUnknownField, field)
+		}
+		s.fields = append(s.fields, field)
+	}
+	return s, nil
+}
+
+// All reports whether the set asks for every field.
+func (s Set) All() bool {
+	return s.fields == nil
+}
+
+// Has reports whether the set asks for a field.
+func (s Set) Has(field string) bool {
+	if s.All() {
+		return true
+	}
+	for _, f := range s.fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// Fields returns the fields asked for, or nil when the set asks for every
+// field.
+func (s Set) Fields() []string {
+	return s.fields
+}
+
+// Project returns v with only the fields in the set. v is returned as is when
+// the set asks for every field.
+func (s Set) Project(v any) (any, error) {
+	if s.All() {
+		return v, nil
+	}
+
+	body, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("encode resource: %w", err)
+	}
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(body, &all); err != nil {
+		return nil, fmt.Errorf("decode resource: %w", err)
+	}
+
+	projected := make(map[string]json.RawMessage, len(s.fields))
+	for _, f := range s.fields {
+		if value, ok := all[f]; ok {
+			projected[f] = value
+		}
+	}
+	return projected, nil
+}
+
+// names returns the JSON names of a struct type's fields, including those of
+// embedded structs.
+func names(t reflect.Type) []string {
+	for t != nil && t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var out []string
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := f.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if f.Anonymous && name == "" {
+			out = append(out, names(f.Type)...)
+			continue
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		out = append(out, name)
+	}
+	return out
+}
@@ -0,0 +1,68 @@
+package fieldset
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type base struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type resource struct {
+	*base
+	Bio     string         `json:"bio,omitempty"`
+	Stats   map[string]int `json:"stats"`
+	Secret  string         `json:"-"`
+	private string
+}
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+
+	s, err := Parse("", resource{})
+	require.NoError(t, err)
+	require.True(t, s.All())
+	require.True(t, s.Has("bio"))
+	require.Nil(t, s.Fields())
+
+	s, err = Parse(" name, stats,name,", &resource{})
+	require.NoError(t, err)
+	require.False(t, s.All())
+	require.Equal(t, []string{"id", "name", "stats"}, s.Fields())
+	require.False(t, s.Has("bio"))
+
+	_, err = Parse("name,Secret", resource{})
+	require.ErrorIs(t, err, ErrUnknownField)
+	_, err = Parse("private", resource{})
+	require.ErrorIs(t, err, ErrUnknownField)
+}
+
+func TestSet_Project(t *testing.T) {
+	t.Parallel()
+
+	r := &resource{base: &base{ID: "p1", Name: "Ana"}, Bio: "Long bio", Stats: map[string]int{"kills": 3}}
+
+	v, err := Set{}.Project(r)
+	require.NoError(t, err)
+	require.Same(t, r, v)
+
+	s, err := Parse("name,bio", resource{})
+	require.NoError(t, err)
+	v, err = s.Project(r)
+	require.NoError(t, err)
+	body, err := json.Marshal(v)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":"p1","name":"Ana","bio":"Long bio"}`, string(body))
+
+	// Fields left out by omitempty stay out
+	r.Bio = ""
+	v, err = s.Project(r)
+	require.NoError(t, err)
+	body, err = json.Marshal(v)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"id":"p1","name":"Ana"}`, string(body))
+}
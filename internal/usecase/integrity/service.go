@@ -0,0 +1,167 @@
+// Package integrity finds, and optionally removes, records left pointing at
+// players, teams or tournaments that have been deleted.
+package integrity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/alejaam/tourney-rank/internal/domain/integrity"
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/usecase/leaderboard"
+)
+
+// Service runs integrity checks and stores their reports.
+type Service struct {
+	leaderboards  *leaderboard.Service
+	orphanedStats player.OrphanedStatsRepository
+	matches       match.OrphanRepository
+	teams         team.OrphanRepository
+	reports       integrity.Repository
+}
+
+// NewService creates a new integrity service. Orphaned player stats are
+// repaired by the leaderboard service, which relinks them where it can.
+func NewService(
+	leaderboards *leaderboard.Service,
+	orphanedStats player.OrphanedStatsRepository,
+	matches match.OrphanRepository,
+	teams team.OrphanRepository,
+	reports integrity.Repository,
+) *Service {
+	return &Service{
+		leaderboards:  leaderboards,
+		orphanedStats: orphanedStats,
+		matches:       matches,
+		teams:         teams,
+		reports:       reports,
+	}
+}
+
+// Run checks for dangling references and stores the report. With repair set,
+// it also removes what it found: teams of a deleted tournament and unverified
+// matches of a deleted team. Verified matches are kept because player stats
+// and ratings already include them. Teams are checked before matches, so the
+// matches of a team removed here are reported by the next run.
+func (s *Service) Run(ctx context.Context, repair bool) (*integrity.Report, error) {
+	stats, err := s.checkPlayerStats(ctx, repair)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := s.checkTeams(ctx, repair)
+	if err != nil {
+		return nil, err
+	}
+	matches, err := s.checkMatches(ctx, repair)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &integrity.Report{
+		ID:          uuid.New(),
+		GeneratedAt: time.Now().UTC(),
+		Repair:      repair,
+		Checks:      []integrity.Check{stats, matches, teams},
+	}
+	if err := s.reports.Save(ctx, report); err != nil {
+		return nil, fmt.Errorf("save integrity report: %w", err)
+	}
+	return report, nil
+}
+
+// Latest returns the most recent integrity report.
+func (s *Service) Latest(ctx context.Context) (*integrity.Report, error) {
+	return s.reports.GetLatest(ctx)
+}
+
+func (s *Service) checkPlayerStats(ctx context.Context, repair bool) (integrity.Check, error) {
+	orphans, err := s.orphanedStats.FindOrphaned(ctx, integrity.ScanLimit)
+	if err != nil {
+		return integrity.Check{}, fmt.Errorf("find orphaned player stats: %w", err)
+	}
+
+	findings := make([]integrity.Finding, len(orphans))
+	for i, o := range orphans {
+		findings[i] = integrity.Finding{ID: o.ID, Reference: o.PlayerID}
+	}
+	check := integrity.NewCheck(integrity.KindOrphanedPlayerStats, findings)
+	if !repair || check.Found == 0 {
+		return check, nil
+	}
+
+	repaired, err := s.leaderboards.RepairOrphanedStats(ctx)
+	if err != nil {
+		return integrity.Check{}, fmt.Errorf("repair orphaned player stats: %w", err)
+	}
+	check.Repaired = repaired.Relinked + repaired.Deleted
+	check.Failed = repaired.Failed
+	return check, nil
+}
+
+func (s *Service) checkTeams(ctx context.Context, repair bool) (integrity.Check, error) {
+	teams, err := s.teams.FindWithMissingTournament(ctx, integrity.ScanLimit)
+	if err != nil {
+		return integrity.Check{}, fmt.Errorf("find teams with missing tournament: %w", err)
+	}
+
+	findings := make([]integrity.Finding, len(teams))
+	for i, t := range teams {
+		findings[i] = integrity.Finding{ID: t.ID.String(), Reference: t.TournamentID.String(), Note: t.Name}
+	}
+	check := integrity.NewCheck(integrity.KindTeamMissingTournament, findings)
+	if !repair {
+		return check, nil
+	}
+
+	for _, t := range teams {
+		err := s.teams.Delete(ctx, t.ID)
+		switch {
+		case err == nil, errors.Is(err, team.ErrNotFound):
+			check.Repaired++
+		case ctx.Err() != nil:
+			return integrity.Check{}, ctx.Err()
+		default:
+			check.Failed++
+		}
+	}
+	return check, nil
+}
+
+func (s *Service) checkMatches(ctx context.Context, repair bool) (integrity.Check, error) {
+	matches, err := s.matches.FindWithMissingTeam(ctx, integrity.ScanLimit)
+	if err != nil {
+		return integrity.Check{}, fmt.Errorf("find matches with missing team: %w", err)
+	}
+
+	findings := make([]integrity.Finding, len(matches))
+	for i, m := range matches {
+		findings[i] = integrity.Finding{ID: m.ID.String(), Reference: m.TeamID.String(), Note: string(m.Status)}
+	}
+	check := integrity.NewCheck(integrity.KindMatchMissingTeam, findings)
+	if !repair {
+		return check, nil
+	}
+
+	for _, m := range matches {
+		if m.IsVerified() {
+			check.Kept++
+			continue
+		}
+		err := s.matches.DeleteByID(ctx, m.ID.String())
+		switch {
+		case err == nil, errors.Is(err, match.ErrNotFound):
+			check.Repaired++
+		case ctx.Err() != nil:
+			return integrity.Check{}, ctx.Err()
+		default:
+			check.Failed++
+		}
+	}
+	return check, nil
+}
@@ -0,0 +1,65 @@
+package integrity
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Worker periodically runs the integrity checks.
+type Worker struct {
+	service  *Service
+	interval time.Duration
+	repair   bool
+	logger   *slog.Logger
+}
+
+// NewWorker creates an integrity worker that runs every interval, repairing
+// what it finds when repair is set.
+func NewWorker(service *Service, interval time.Duration, repair bool, logger *slog.Logger) *Worker {
+	return &Worker{
+		service:  service,
+		interval: interval,
+		repair:   repair,
+		logger:   logger,
+	}
+}
+
+// Run blocks, checking immediately and then on every tick until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	w.logger.Info("integrity worker started", "interval", w.interval, "repair", w.repair)
+
+	w.check(ctx)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("integrity worker stopped")
+			return
+		case <-ticker.C:
+			w.check(ctx)
+		}
+	}
+}
+
+func (w *Worker) check(ctx context.Context) {
+	report, err := w.service.Run(ctx, w.repair)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Error("integrity check failed", "error", err)
+		}
+		return
+	}
+	if report.Found() == 0 {
+		return
+	}
+
+	attrs := make([]any, 0, 2*len(report.Checks))
+	for _, c := range report.Checks {
+		attrs = append(attrs, string(c.Kind), c.Found)
+	}
+	w.logger.Warn("integrity check found dangling references", attrs...)
+}
@@ -0,0 +1,148 @@
+package ladder
+
+import (
+	"context"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/ladder"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/usecase/conduct"
+	"github.com/google/uuid"
+)
+
+// WithConduct records a no-show against every member of a team that forfeits
+// a challenge.
+func (s *Service) WithConduct(conduct *conduct.Service) *Service {
+	s.conduct = conduct
+	return s
+}
+
+// ForfeitRequest reports that the opponent did not show up for an accepted
+// challenge.
+type ForfeitRequest struct {
+	TeamID uuid.UUID `json:"team_id"` // The team that showed up
+	Reason string    `json:"reason,omitempty"`
+}
+
+// ContestForfeitRequest is the reported team's response to a forfeit report.
+type ContestForfeitRequest struct {
+	TeamID   uuid.UUID `json:"team_id"` // The reported team
+	Response string    `json:"response,omitempty"`
+}
+
+// DecideForfeitRequest settles a forfeit report.
+type DecideForfeitRequest struct {
+	Uphold bool   `json:"uphold"` // True forfeits the challenge, false lets it go on
+	Note   string `json:"note,omitempty"`
+}
+
+// ReportForfeit lets a captain report that the opponent did not show up for
+// an accepted challenge. Unless the opponent contests within the response
+// window, the challenge is forfeited to the reporting team.
+func (s *Service) ReportForfeit(ctx context.Context, challengeID uuid.UUID, req ForfeitRequest, playerID uuid.UUID) (*ladder.Challenge, error) {
+	c, err := s.challengeRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireCaptain(ctx, req.TeamID, playerID); err != nil {
+		return nil, err
+	}
+
+	if err := c.ReportForfeit(req.TeamID, playerID, req.Reason, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.challengeRepo.Update(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ContestForfeit lets the reported team's captain dispute a forfeit report
+// within the response window. The report then waits for an admin's decision.
+func (s *Service) ContestForfeit(ctx context.Context, challengeID uuid.UUID, req ContestForfeitRequest, playerID uuid.UUID) (*ladder.Challenge, error) {
+	c, err := s.challengeRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireCaptain(ctx, req.TeamID, playerID); err != nil {
+		return nil, err
+	}
+
+	if err := c.ContestForfeit(req.TeamID, req.Response, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.challengeRepo.Update(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// DecideForfeit lets an admin uphold or dismiss an open forfeit report.
+// Upholding it settles the challenge in favor of the reporting team.
+func (s *Service) DecideForfeit(ctx context.Context, challengeID uuid.UUID, req DecideForfeitRequest, adminID uuid.UUID) (*ladder.Challenge, error) {
+	c, err := s.challengeRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.DecideForfeit(req.Uphold, &adminID, req.Note, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if req.Uphold {
+		err = s.settle(ctx, c)
+	} else {
+		err = s.challengeRepo.Update(ctx, c)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (s *Service) requireCaptain(ctx context.Context, teamID, playerID uuid.UUID) error {
+	tm, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if !tm.IsCaptain(playerID) {
+		return team.ErrNotCaptain
+	}
+	return nil
+}
+
+func (s *Service) requireScheduler(ctx context.Context, teamID, playerID uuid.UUID) error {
+	tm, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return err
+	}
+	if !tm.CanSchedule(playerID) {
+		return team.ErrNotCaptain
+	}
+	return nil
+}
+
+// recordNoShows records a no-show against every member of the team that
+// forfeited the challenge.
+func (s *Service) recordNoShows(ctx context.Context, c *ladder.Challenge) error {
+	if s.conduct == nil {
+		return nil
+	}
+
+	tm, err := s.teamRepo.GetByID(ctx, c.Loser())
+	if err != nil {
+		return err
+	}
+
+	for _, memberID := range tm.MemberIDs {
+		record, err := player.NewConductRecord(memberID, player.ConductNoShow, "forfeited a ladder challenge", c.Forfeit.ReportedBy, *c.CompletedAt)
+		if err != nil {
+			return err
+		}
+		record.TournamentID = &c.TournamentID
+		if err := s.conduct.Record(ctx, record); err != nil {
+			return err
+		}
+	}
+	return nil
+}
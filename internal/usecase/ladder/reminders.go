@@ -0,0 +1,98 @@
+package ladder
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/activity"
+	"github.com/alejaam/tourney-rank/internal/domain/ladder"
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/google/uuid"
+)
+
+// WithActivityLog sends deadline reminders for open challenges through the
+// players' activity feeds. Reminders go to the captain and staff of each team
+// that has yet to act; the final one goes to the whole team.
+func (s *Service) WithActivityLog(repo activity.Repository) *Service {
+	s.activity = repo
+	return s
+}
+
+// remind sends the reminder due for an open challenge's current deadline, if
+// any.
+func (s *Service) remind(ctx context.Context, c *ladder.Challenge, now time.Time) error {
+	if s.activity == nil {
+		return nil
+	}
+	r := c.DueReminder(now)
+	if r == nil {
+		return nil
+	}
+
+	teamIDs := []uuid.UUID{c.DefenderTeamID}
+	if r.Deadline == ladder.DeadlinePlay {
+		teamIDs = []uuid.UUID{c.ChallengerTeamID, c.DefenderTeamID}
+	}
+
+	var events []*activity.Event
+	for _, teamID := range teamIDs {
+		if r.Deadline == ladder.DeadlinePlay {
+			submitted, err := s.submittedMatch(ctx, c, teamID)
+			if err != nil {
+				return err
+			}
+			if submitted {
+				continue
+			}
+		}
+
+		tm, err := s.teamRepo.GetByID(ctx, teamID)
+		if err != nil {
+			return err
+		}
+		opponent, err := s.teamRepo.GetByID(ctx, c.Opponent(teamID))
+		if err != nil {
+			return err
+		}
+
+		message := fmt.Sprintf("Submit your match for the ladder challenge against %s", opponent.Name)
+		if r.Deadline == ladder.DeadlineRespond {
+			message = fmt.Sprintf("Accept or decline the ladder challenge from %s", opponent.Name)
+		}
+		recipients := []uuid.UUID{tm.CaptainID}
+		for _, staff := range tm.Staff {
+			recipients = append(recipients, staff.PlayerID)
+		}
+		if r.Final() {
+			recipients = tm.Recipients()
+		}
+		for _, playerID := range recipients {
+			events = append(events, activity.NewDeadlineReminder(playerID, tm.ID, c.TournamentID, tm.Name, message, r.DueAt))
+		}
+	}
+
+	if len(events) > 0 {
+		if err := s.activity.Create(ctx, events...); err != nil {
+			return fmt.Errorf("record reminders: %w", err)
+		}
+	}
+
+	c.Remind(r, now)
+	return s.challengeRepo.Update(ctx, c)
+}
+
+// submittedMatch reports whether the team submitted a match in the
+// challenge's tournament since it was accepted, verified or not.
+func (s *Service) submittedMatch(ctx context.Context, c *ladder.Challenge, teamID uuid.UUID) (bool, error) {
+	matches, err := s.matchRepo.GetByTeam(ctx, teamID.String(), "", countingMatchWindow, 0)
+	if err != nil {
+		return false, err
+	}
+	for _, m := range matches {
+		if m.TournamentID == c.TournamentID && !m.CreatedAt.Before(*c.AcceptedAt) && m.Status != match.StatusRejected {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,85 @@
+package ladder
+
+import (
+	"context"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/ladder"
+	"github.com/google/uuid"
+)
+
+// RescheduleRequest proposes a new play deadline for an accepted challenge.
+type RescheduleRequest struct {
+	TeamID uuid.UUID `json:"team_id"` // The proposing team
+	PlayBy time.Time `json:"play_by"`
+	Reason string    `json:"reason,omitempty"`
+}
+
+// RescheduleResponse answers a reschedule proposal.
+type RescheduleResponse struct {
+	TeamID uuid.UUID `json:"team_id"` // The answering team
+	Accept bool      `json:"accept"`
+}
+
+// ScheduleOverrideRequest sets an accepted challenge's play deadline.
+type ScheduleOverrideRequest struct {
+	PlayBy time.Time `json:"play_by"`
+}
+
+// ProposeReschedule lets a captain or staff member propose moving an accepted challenge's
+// play deadline. The current deadline stands unless the other team accepts
+// in time.
+func (s *Service) ProposeReschedule(ctx context.Context, challengeID uuid.UUID, req RescheduleRequest, playerID uuid.UUID) (*ladder.Challenge, error) {
+	c, err := s.challengeRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireScheduler(ctx, req.TeamID, playerID); err != nil {
+		return nil, err
+	}
+
+	if err := c.ProposeReschedule(req.TeamID, playerID, req.PlayBy.UTC(), req.Reason, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.challengeRepo.Update(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// RespondToReschedule lets the other team's captain or staff accept or
+// decline a pending reschedule proposal.
+func (s *Service) RespondToReschedule(ctx context.Context, challengeID uuid.UUID, req RescheduleResponse, playerID uuid.UUID) (*ladder.Challenge, error) {
+	c, err := s.challengeRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireScheduler(ctx, req.TeamID, playerID); err != nil {
+		return nil, err
+	}
+
+	if err := c.RespondToReschedule(req.TeamID, req.Accept, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.challengeRepo.Update(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// OverrideSchedule lets an admin set an accepted challenge's play deadline,
+// replacing any pending proposal.
+func (s *Service) OverrideSchedule(ctx context.Context, challengeID uuid.UUID, req ScheduleOverrideRequest, adminID uuid.UUID) (*ladder.Challenge, error) {
+	c, err := s.challengeRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.OverrideSchedule(req.PlayBy.UTC(), adminID, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.challengeRepo.Update(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
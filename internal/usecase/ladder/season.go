@@ -0,0 +1,220 @@
+package ladder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/ladder"
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+)
+
+// ProcessLadders settles challenges that can be decided, reminds teams of the
+// deadlines of the rest, records each ladder's weekly standings and rolls
+// over ladders whose season ended. It returns the number of challenges
+// settled.
+func (s *Service) ProcessLadders(ctx context.Context) (int, error) {
+	now := time.Now().UTC()
+
+	challenges, err := s.challengeRepo.ListOpen(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list open challenges: %w", err)
+	}
+
+	settled := 0
+	for _, c := range challenges {
+		done, err := s.decide(ctx, c, now)
+		if err != nil {
+			return settled, fmt.Errorf("decide challenge %s: %w", c.ID, err)
+		}
+		if done {
+			settled++
+			continue
+		}
+		if err := s.remind(ctx, c, now); err != nil {
+			return settled, fmt.Errorf("remind challenge %s: %w", c.ID, err)
+		}
+	}
+
+	ladders, err := s.ladderRepo.List(ctx)
+	if err != nil {
+		return settled, fmt.Errorf("list ladders: %w", err)
+	}
+
+	for _, l := range ladders {
+		if l.SeasonOver(now) {
+			if err := s.rollover(ctx, l, now); err != nil {
+				return settled, fmt.Errorf("roll over ladder %s: %w", l.ID, err)
+			}
+			continue
+		}
+		if l.SnapshotDue(now) {
+			if err := s.snapshot(ctx, l, now); err != nil {
+				return settled, fmt.Errorf("snapshot ladder %s: %w", l.ID, err)
+			}
+		}
+	}
+
+	return settled, nil
+}
+
+// decide settles an open challenge once both teams have a counting match or
+// its deadline passed. A challenge with an open forfeit report waits for the
+// report instead, which is upheld once its response window lapses. It
+// reports whether the challenge was settled.
+func (s *Service) decide(ctx context.Context, c *ladder.Challenge, now time.Time) (bool, error) {
+	// An unanswered reschedule proposal lapses and the play deadline stands.
+	if c.LapseReschedule(now) {
+		if err := s.challengeRepo.Update(ctx, c); err != nil {
+			return false, err
+		}
+	}
+
+	if c.Forfeit != nil && c.Forfeit.Open() {
+		if !c.ForfeitLapsed(now) {
+			return false, nil
+		}
+		if err := c.DecideForfeit(true, nil, "", now); err != nil {
+			return false, err
+		}
+	} else if c.Status == ladder.ChallengeStatusAccepted {
+		challengerMatch, err := s.countingMatch(ctx, c, c.ChallengerTeamID)
+		if err != nil {
+			return false, err
+		}
+		defenderMatch, err := s.countingMatch(ctx, c, c.DefenderTeamID)
+		if err != nil {
+			return false, err
+		}
+
+		switch {
+		case challengerMatch != nil && defenderMatch != nil:
+			if err := c.Resolve(challengerMatch.ID, challengerMatch.TeamPlacement, defenderMatch.ID, defenderMatch.TeamPlacement, now); err != nil {
+				return false, err
+			}
+		case c.Expired(now):
+			c.Expire(challengerMatch != nil, defenderMatch != nil, now)
+		default:
+			return false, nil
+		}
+	} else {
+		if !c.Expired(now) {
+			return false, nil
+		}
+		c.Expire(false, false, now)
+	}
+
+	return true, s.settle(ctx, c)
+}
+
+// countingMatch returns the team's first verified match in the challenge's
+// tournament submitted within the play window, or nil if it has none yet.
+// Matches still awaiting verification when the window closes do not count.
+func (s *Service) countingMatch(ctx context.Context, c *ladder.Challenge, teamID uuid.UUID) (*match.Match, error) {
+	matches, err := s.matchRepo.GetByTeam(ctx, teamID.String(), match.StatusVerified, countingMatchWindow, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var first *match.Match
+	for i := range matches {
+		m := &matches[i]
+		if m.TournamentID != c.TournamentID || m.CreatedAt.Before(*c.AcceptedAt) || m.CreatedAt.After(*c.PlayBy) {
+			continue
+		}
+		if first == nil || m.CreatedAt.Before(first.CreatedAt) {
+			first = m
+		}
+	}
+	return first, nil
+}
+
+// snapshot records a ladder's weekly standings.
+func (s *Service) snapshot(ctx context.Context, l *ladder.Ladder, now time.Time) error {
+	snap := l.TakeSnapshot(false, now)
+	if err := s.snapshotRepo.Create(ctx, snap); err != nil && !errors.Is(err, ladder.ErrSnapshotExists) {
+		return err
+	}
+
+	// A conflict means another run changed the ladder; the next run sees the
+	// snapshot exists and records the week then.
+	if err := s.ladderRepo.Update(ctx, l); err != nil && !errors.Is(err, ladder.ErrConflict) {
+		return err
+	}
+	return nil
+}
+
+// rollover ends a ladder's season, recording its final standings and
+// finishing its tournament, and starts the next season on a new tournament.
+func (s *Service) rollover(ctx context.Context, l *ladder.Ladder, now time.Time) error {
+	if l.Season > 0 {
+		final := l.TakeSnapshot(true, now)
+		if err := s.snapshotRepo.Create(ctx, final); err != nil && !errors.Is(err, ladder.ErrSnapshotExists) {
+			return fmt.Errorf("record final standings: %w", err)
+		}
+	}
+
+	next, err := s.seasonTournament(ctx, l, now)
+	if err != nil {
+		return err
+	}
+
+	previous := l.TournamentID
+	l.StartSeason(next.ID, now)
+	if err := s.ladderRepo.Update(ctx, l); err != nil {
+		// Another run rolled the ladder over first; drop this run's tournament.
+		if errors.Is(err, ladder.ErrConflict) {
+			return s.endTournament(ctx, next.ID, tournament.StatusCanceled)
+		}
+		return err
+	}
+
+	if previous != uuid.Nil {
+		return s.endTournament(ctx, previous, tournament.StatusFinished)
+	}
+	return nil
+}
+
+// seasonTournament creates the active tournament the ladder's next season
+// runs on. Teams register for it late, at any point of the season.
+func (s *Service) seasonTournament(ctx context.Context, l *ladder.Ladder, now time.Time) (*tournament.Tournament, error) {
+	name := fmt.Sprintf("%s - Season %d", l.Name, l.Season+1)
+	t, err := tournament.NewTournament(l.GameID, l.CreatedBy, name, l.TeamSize, now, now.Add(l.SeasonLength()))
+	if err != nil {
+		return nil, err
+	}
+	t.SetDescription(fmt.Sprintf("Season %d of the %s ladder.", l.Season+1, l.Name))
+
+	rules := t.Rules
+	rules.AllowLateRegistration = true
+	t.SetRules(rules)
+
+	for _, status := range []tournament.Status{tournament.StatusOpen, tournament.StatusActive} {
+		if err := t.UpdateStatus(status); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.tournamentRepo.Create(ctx, t); err != nil {
+		return nil, fmt.Errorf("create season tournament: %w", err)
+	}
+	return t, nil
+}
+
+// endTournament moves a season tournament to a final status unless it already has one.
+func (s *Service) endTournament(ctx context.Context, id uuid.UUID, status tournament.Status) error {
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if t.Status == tournament.StatusFinished || t.Status == tournament.StatusCanceled {
+		return nil
+	}
+	if err := t.UpdateStatus(status); err != nil {
+		return err
+	}
+	return s.tournamentRepo.Update(ctx, t)
+}
@@ -0,0 +1,336 @@
+// Package ladder provides use cases for always-on ladder competition.
+package ladder
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/activity"
+	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/ladder"
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/usecase/conduct"
+	"github.com/google/uuid"
+)
+
+// maxUpdateAttempts is how many times a ladder change is retried when the
+// ladder was changed concurrently.
+const maxUpdateAttempts = 3
+
+// countingMatchWindow is how many of a team's latest verified matches are
+// searched for the one that decides a challenge.
+const countingMatchWindow = 50
+
+// Service provides ladder operations.
+type Service struct {
+	ladderRepo     ladder.Repository
+	challengeRepo  ladder.ChallengeRepository
+	snapshotRepo   ladder.SnapshotRepository
+	tournamentRepo tournament.Repository
+	teamRepo       team.Repository
+	matchRepo      match.Repository
+	gameRepo       game.Repository
+	conduct        *conduct.Service
+	activity       activity.Repository
+}
+
+// NewService creates a new ladder service.
+func NewService(
+	ladderRepo ladder.Repository,
+	challengeRepo ladder.ChallengeRepository,
+	snapshotRepo ladder.SnapshotRepository,
+	tournamentRepo tournament.Repository,
+	teamRepo team.Repository,
+	matchRepo match.Repository,
+	gameRepo game.Repository,
+) *Service {
+	return &Service{
+		ladderRepo:     ladderRepo,
+		challengeRepo:  challengeRepo,
+		snapshotRepo:   snapshotRepo,
+		tournamentRepo: tournamentRepo,
+		teamRepo:       teamRepo,
+		matchRepo:      matchRepo,
+		gameRepo:       gameRepo,
+	}
+}
+
+// CreateLadderRequest represents the data needed to create a ladder.
+type CreateLadderRequest struct {
+	GameID         uuid.UUID           `json:"game_id"`
+	Name           string              `json:"name"`
+	TeamSize       tournament.TeamSize `json:"team_size"`
+	SeasonWeeks    int                 `json:"season_weeks,omitempty"`
+	ChallengeRange int                 `json:"challenge_range,omitempty"`
+	ForfeitPenalty int                 `json:"forfeit_penalty,omitempty"` // Extra rungs a team that forfeits a challenge drops
+}
+
+// ChallengeRequest represents a captain's challenge of a higher-ranked team.
+type ChallengeRequest struct {
+	TeamID     uuid.UUID `json:"team_id"`     // The challenging team
+	OpponentID uuid.UUID `json:"opponent_id"` // The team whose rung is challenged
+}
+
+// StandingResponse is a team's rung with its name.
+type StandingResponse struct {
+	Position int       `json:"position"`
+	TeamID   uuid.UUID `json:"team_id"`
+	TeamName string    `json:"team_name"`
+}
+
+// LadderResponse is a ladder with its current standings.
+type LadderResponse struct {
+	*ladder.Ladder
+	Standings []StandingResponse `json:"standings"`
+}
+
+// CreateLadder creates a ladder for a game and opens its first season.
+func (s *Service) CreateLadder(ctx context.Context, req CreateLadderRequest, adminID uuid.UUID) (*ladder.Ladder, error) {
+	if _, err := s.gameRepo.GetByID(ctx, req.GameID.String()); err != nil {
+		return nil, err
+	}
+
+	l, err := ladder.NewLadder(req.GameID, adminID, req.Name, req.TeamSize, req.SeasonWeeks, req.ChallengeRange)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.SetForfeitPenalty(req.ForfeitPenalty); err != nil {
+		return nil, err
+	}
+
+	if err := s.ladderRepo.Create(ctx, l); err != nil {
+		return nil, err
+	}
+
+	// Should the first season fail to start, the worker starts it on its next run.
+	if err := s.rollover(ctx, l, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+
+	return l, nil
+}
+
+// GetLadder retrieves a ladder with its current standings.
+func (s *Service) GetLadder(ctx context.Context, id uuid.UUID) (*LadderResponse, error) {
+	l, err := s.ladderRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[uuid.UUID]string)
+	if l.Season > 0 {
+		teams, err := s.teamRepo.GetByTournamentID(ctx, l.TournamentID)
+		if err != nil {
+			return nil, err
+		}
+		for _, tm := range teams {
+			names[tm.ID] = tm.Name
+		}
+	}
+
+	standings := make([]StandingResponse, len(l.Rungs))
+	for i, id := range l.Rungs {
+		standings[i] = StandingResponse{Position: i + 1, TeamID: id, TeamName: names[id]}
+	}
+
+	return &LadderResponse{Ladder: l, Standings: standings}, nil
+}
+
+// ListLadders returns every ladder.
+func (s *Service) ListLadders(ctx context.Context) ([]*ladder.Ladder, error) {
+	return s.ladderRepo.List(ctx)
+}
+
+// JoinLadder places a team on the bottom rung. Teams enter a season by
+// registering for its tournament first; only their captain can join.
+func (s *Service) JoinLadder(ctx context.Context, ladderID, teamID, playerID uuid.UUID) (*ladder.Ladder, error) {
+	tm, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if !tm.IsCaptain(playerID) {
+		return nil, team.ErrNotCaptain
+	}
+	if tm.Status == team.StatusDisbanded || tm.Status == team.StatusDisqualified {
+		return nil, ladder.ErrTeamInactive
+	}
+
+	return s.mutateLadder(ctx, ladderID, func(l *ladder.Ladder) error {
+		if l.Season == 0 || tm.TournamentID != l.TournamentID {
+			return ladder.ErrNotSeasonTeam
+		}
+		return l.Join(teamID, time.Now().UTC())
+	})
+}
+
+// LeaveLadder takes a team off the ladder. Only its captain can remove it.
+func (s *Service) LeaveLadder(ctx context.Context, ladderID, teamID, playerID uuid.UUID) (*ladder.Ladder, error) {
+	tm, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if !tm.IsCaptain(playerID) {
+		return nil, team.ErrNotCaptain
+	}
+
+	return s.mutateLadder(ctx, ladderID, func(l *ladder.Ladder) error {
+		return l.Leave(teamID, time.Now().UTC())
+	})
+}
+
+// CreateChallenge challenges a team up to the ladder's challenge range above
+// the captain's team. A team can be in one open challenge at a time.
+func (s *Service) CreateChallenge(ctx context.Context, ladderID uuid.UUID, req ChallengeRequest, playerID uuid.UUID) (*ladder.Challenge, error) {
+	l, err := s.ladderRepo.GetByID(ctx, ladderID)
+	if err != nil {
+		return nil, err
+	}
+
+	tm, err := s.teamRepo.GetByID(ctx, req.TeamID)
+	if err != nil {
+		return nil, err
+	}
+	if !tm.IsCaptain(playerID) {
+		return nil, team.ErrNotCaptain
+	}
+
+	c, err := ladder.NewChallenge(l, req.TeamID, req.OpponentID, playerID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, teamID := range []uuid.UUID{req.TeamID, req.OpponentID} {
+		open, err := s.challengeRepo.HasOpen(ctx, ladderID, teamID)
+		if err != nil {
+			return nil, err
+		}
+		if open {
+			return nil, ladder.ErrChallengeInProgress
+		}
+	}
+
+	if err := s.challengeRepo.Create(ctx, c); err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+// RespondToChallenge lets the challenged team's captain accept or decline a
+// pending challenge. Declining forfeits the defender's rung to the challenger.
+func (s *Service) RespondToChallenge(ctx context.Context, challengeID, playerID uuid.UUID, accept bool) (*ladder.Challenge, error) {
+	c, err := s.challengeRepo.GetByID(ctx, challengeID)
+	if err != nil {
+		return nil, err
+	}
+
+	defender, err := s.teamRepo.GetByID(ctx, c.DefenderTeamID)
+	if err != nil {
+		return nil, err
+	}
+	if !defender.IsCaptain(playerID) {
+		return nil, ladder.ErrNotChallengedTeam
+	}
+
+	now := time.Now().UTC()
+	if c.Expired(now) {
+		return nil, ladder.ErrChallengeNotPending
+	}
+
+	if accept {
+		if err := c.Accept(now); err != nil {
+			return nil, err
+		}
+		if err := s.challengeRepo.Update(ctx, c); err != nil {
+			return nil, err
+		}
+		return c, nil
+	}
+
+	if err := c.Decline(now); err != nil {
+		return nil, err
+	}
+	if err := s.settle(ctx, c); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ListChallenges returns a ladder season's challenges, newest first. A zero
+// season selects the current one.
+func (s *Service) ListChallenges(ctx context.Context, ladderID uuid.UUID, season int) ([]*ladder.Challenge, error) {
+	l, err := s.ladderRepo.GetByID(ctx, ladderID)
+	if err != nil {
+		return nil, err
+	}
+	if season == 0 {
+		season = l.Season
+	}
+	return s.challengeRepo.ListByLadder(ctx, ladderID, season)
+}
+
+// ListSnapshots returns a ladder's standings snapshots, newest first. A zero
+// season lists every season.
+func (s *Service) ListSnapshots(ctx context.Context, ladderID uuid.UUID, season int) ([]*ladder.Snapshot, error) {
+	if _, err := s.ladderRepo.GetByID(ctx, ladderID); err != nil {
+		return nil, err
+	}
+	return s.snapshotRepo.ListByLadder(ctx, ladderID, season)
+}
+
+// mutateLadder applies a change to the latest version of a ladder and saves
+// it, retrying when the ladder was changed concurrently.
+func (s *Service) mutateLadder(ctx context.Context, id uuid.UUID, change func(*ladder.Ladder) error) (*ladder.Ladder, error) {
+	for attempt := 1; ; attempt++ {
+		l, err := s.ladderRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if err := change(l); err != nil {
+			return nil, err
+		}
+
+		err = s.ladderRepo.Update(ctx, l)
+		if err == nil {
+			return l, nil
+		}
+		if !errors.Is(err, ladder.ErrConflict) || attempt == maxUpdateAttempts {
+			return nil, err
+		}
+	}
+}
+
+// settle moves the winner of a completed challenge up the ladder and, after
+// a forfeit, drops the loser by the ladder's forfeit penalty, then saves the
+// challenge. The ladder goes first so a failed save is retried safely:
+// promoting a winner that is already above the loser changes nothing.
+func (s *Service) settle(ctx context.Context, c *ladder.Challenge) error {
+	_, err := s.mutateLadder(ctx, c.LadderID, func(l *ladder.Ladder) error {
+		// Challenges carried over from a finished season no longer move anyone.
+		if c.Season == l.Season {
+			l.Promote(*c.WinnerTeamID, c.Loser(), *c.CompletedAt)
+			if c.Outcome == ladder.OutcomeForfeit {
+				l.Demote(c.Loser(), l.ForfeitPenalty, *c.CompletedAt)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("promote challenge winner: %w", err)
+	}
+
+	if err := s.challengeRepo.Update(ctx, c); err != nil {
+		return err
+	}
+
+	if c.Outcome == ladder.OutcomeForfeit {
+		if err := s.recordNoShows(ctx, c); err != nil {
+			return fmt.Errorf("record no-shows: %w", err)
+		}
+	}
+	return nil
+}
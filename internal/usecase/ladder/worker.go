@@ -0,0 +1,49 @@
+package ladder
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Worker periodically settles challenges, snapshots standings and rolls over
+// ladder seasons in the background.
+type Worker struct {
+	service  *Service
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewWorker creates a ladder worker that runs every interval.
+func NewWorker(service *Service, interval time.Duration, logger *slog.Logger) *Worker {
+	return &Worker{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run blocks, processing ladders on every tick until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	w.logger.Info("ladder worker started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("ladder worker stopped")
+			return
+		case <-ticker.C:
+			settled, err := w.service.ProcessLadders(ctx)
+			if err != nil {
+				w.logger.Error("ladder run failed", "error", err)
+				continue
+			}
+			if settled > 0 {
+				w.logger.Info("ladder challenges settled", "count", settled)
+			}
+		}
+	}
+}
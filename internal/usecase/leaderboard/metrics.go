@@ -0,0 +1,79 @@
+package leaderboard
+
+import (
+	"fmt"
+	"io"
+	"sync/atomic"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+)
+
+// Reasons a leaderboard entry is served as a placeholder.
+const (
+	incompleteInvalidPlayerID = "invalid_player_id"
+	incompleteMissingProfile  = "missing_profile"
+	incompleteLookupFailed    = "lookup_failed"
+)
+
+// Metrics counts leaderboard entries served without a player profile and the
+// orphaned stats repairs that clean up after them.
+type Metrics struct {
+	invalidPlayerIDs atomic.Int64
+	missingProfiles  atomic.Int64
+	lookupFailed     atomic.Int64
+	lookupFailures   atomic.Int64
+
+	relinked       atomic.Int64
+	deleted        atomic.Int64
+	repairFailures atomic.Int64
+}
+
+func (m *Metrics) recordIncomplete(reason string) {
+	switch reason {
+	case incompleteInvalidPlayerID:
+		m.invalidPlayerIDs.Add(1)
+	case incompleteMissingProfile:
+		m.missingProfiles.Add(1)
+	case incompleteLookupFailed:
+		m.lookupFailed.Add(1)
+	}
+}
+
+// WriteMetrics writes the counters in the Prometheus text format.
+func (m *Metrics) WriteMetrics(w io.Writer) {
+	type sample struct {
+		labels string
+		value  int64
+	}
+	counters := []struct {
+		name, help string
+		samples    []sample
+	}{
+		{"tourneyrank_leaderboard_incomplete_entries_total", "Leaderboard entries served as placeholders because their player profile could not be resolved, by reason.", []sample{
+			{`reason="` + incompleteInvalidPlayerID + `"`, m.invalidPlayerIDs.Load()},
+			{`reason="` + incompleteMissingProfile + `"`, m.missingProfiles.Load()},
+			{`reason="` + incompleteLookupFailed + `"`, m.lookupFailed.Load()},
+		}},
+		{"tourneyrank_leaderboard_profile_lookup_failures_total", "Failed batch lookups of leaderboard player profiles.", []sample{
+			{"", m.lookupFailures.Load()},
+		}},
+		{"tourneyrank_leaderboard_orphaned_stats_repaired_total", "Orphaned player stats reconciled by the repair job, by action.", []sample{
+			{`action="` + string(player.OrphanRelink) + `"`, m.relinked.Load()},
+			{`action="` + string(player.OrphanDelete) + `"`, m.deleted.Load()},
+		}},
+		{"tourneyrank_leaderboard_orphaned_stats_repair_failures_total", "Orphaned player stats the repair job failed to reconcile.", []sample{
+			{"", m.repairFailures.Load()},
+		}},
+	}
+	for _, c := range counters {
+		fmt.Fprintf(w, "# HELP %s %s\n", c.name, c.help)
+		fmt.Fprintf(w, "# TYPE %s counter\n", c.name)
+		for _, s := range c.samples {
+			if s.labels == "" {
+				fmt.Fprintf(w, "%s %d\n", c.name, s.value)
+			} else {
+				fmt.Fprintf(w, "%s{%s} %d\n", c.name, s.labels, s.value)
+			}
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package leaderboard
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+)
+
+// RepairBatchSize caps how many orphaned stats one repair run reconciles.
+const RepairBatchSize = 500
+
+// ErrRepairUnavailable is returned when the service was built without an
+// orphaned stats repository.
+var ErrRepairUnavailable = errors.New("orphaned stats repair is not configured")
+
+// RepairReport summarizes one orphaned stats repair run.
+type RepairReport struct {
+	Found    int
+	Relinked int
+	Deleted  int
+	Failed   int
+}
+
+// WithOrphanRepair enables RepairOrphanedStats.
+func (s *Service) WithOrphanRepair(orphans player.OrphanedStatsRepository) *Service {
+	s.orphans = orphans
+	return s
+}
+
+// RepairOrphanedStats reconciles player stats no profile claims, which
+// leaderboards otherwise serve as placeholder entries. Stats naming an existing
+// profile in a non-canonical form are relinked to it; the rest belong to no one
+// and are deleted. A record that cannot be reconciled, for instance because the
+// player already has stats for that game and mode, is counted and left alone.
+func (s *Service) RepairOrphanedStats(ctx context.Context) (RepairReport, error) {
+	if s.orphans == nil {
+		return RepairReport{}, ErrRepairUnavailable
+	}
+
+	orphans, err := s.orphans.FindOrphaned(ctx, RepairBatchSize)
+	if err != nil {
+		return RepairReport{}, fmt.Errorf("find orphaned stats: %w", err)
+	}
+
+	report := RepairReport{Found: len(orphans)}
+	for _, o := range orphans {
+		action, err := s.repairOrphan(ctx, o)
+		if err != nil {
+			if ctx.Err() != nil {
+				return report, ctx.Err()
+			}
+			report.Failed++
+			s.metrics.repairFailures.Add(1)
+			continue
+		}
+		switch action {
+		case player.OrphanRelink:
+			report.Relinked++
+			s.metrics.relinked.Add(1)
+		case player.OrphanDelete:
+			report.Deleted++
+			s.metrics.deleted.Add(1)
+		}
+	}
+	return report, nil
+}
+
+func (s *Service) repairOrphan(ctx context.Context, o player.OrphanedStats) (player.OrphanRepair, error) {
+	if id, ok := o.CanonicalPlayerID(); ok {
+		_, err := s.playerRepo.GetByID(ctx, id.String())
+		switch {
+		case err == nil:
+			return player.OrphanRelink, s.orphans.RelinkPlayer(ctx, o.ID, id)
+		case !errors.Is(err, player.ErrNotFound):
+			return "", fmt.Errorf("get player: %w", err)
+		}
+	}
+	return player.OrphanDelete, s.orphans.DeleteOrphaned(ctx, o.ID)
+}
+
+// RepairWorker periodically reconciles orphaned player stats.
+type RepairWorker struct {
+	service  *Service
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewRepairWorker creates an orphaned stats repair worker that runs every interval.
+func NewRepairWorker(service *Service, interval time.Duration, logger *slog.Logger) *RepairWorker {
+	return &RepairWorker{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run blocks, repairing immediately and then on every tick until ctx is cancelled.
+func (w *RepairWorker) Run(ctx context.Context) {
+	w.logger.Info("orphaned stats repair worker started", "interval", w.interval)
+
+	w.repair(ctx)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("orphaned stats repair worker stopped")
+			return
+		case <-ticker.C:
+			w.repair(ctx)
+		}
+	}
+}
+
+func (w *RepairWorker) repair(ctx context.Context) {
+	report, err := w.service.RepairOrphanedStats(ctx)
+	if err != nil {
+		if ctx.Err() == nil {
+			w.logger.Error("orphaned stats repair failed", "error", err)
+		}
+		return
+	}
+	if report.Found == 0 {
+		return
+	}
+
+	level := slog.LevelInfo
+	if report.Failed > 0 {
+		level = slog.LevelWarn
+	}
+	w.logger.Log(ctx, level, "orphaned stats repaired",
+		"found", report.Found,
+		"relinked", report.Relinked,
+		"deleted", report.Deleted,
+		"failed", report.Failed,
+	)
+}
@@ -3,32 +3,72 @@ package leaderboard
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/alejaam/tourney-rank/internal/domain/game"
 	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/season"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
 	"github.com/google/uuid"
 )
 
 // LeaderboardEntry represents a single entry in the leaderboard response.
 type LeaderboardEntry struct {
-	Rank          int                    `json:"rank"`
-	PlayerID      uuid.UUID              `json:"player_id"`
-	DisplayName   string                 `json:"display_name"`
-	AvatarURL     string                 `json:"avatar_url"`
-	RankingScore  float64                `json:"ranking_score"`
-	Tier          string                 `json:"tier"`
-	MatchesPlayed int                    `json:"matches_played"`
-	Stats         map[string]interface{} `json:"stats"`
+	Rank               int                    `json:"rank"`
+	PlayerID           uuid.UUID              `json:"player_id"`
+	DisplayName        string                 `json:"display_name"`
+	AvatarURL          string                 `json:"avatar_url"`
+	RankingScore       float64                `json:"ranking_score"`
+	RatingDeviation    float64                `json:"rating_deviation"`
+	ConfidenceLow      float64                `json:"confidence_low"`
+	ConfidenceHigh     float64                `json:"confidence_high"`
+	ConservativeRating float64                `json:"conservative_rating"`
+	Tier               string                 `json:"tier"`
+	MatchesPlayed      int                    `json:"matches_played"`
+	IsProvisional      bool                   `json:"is_provisional"`
+	LastMatchAt        *time.Time             `json:"last_match_at"`
+	RankDelta24h       *int                   `json:"rank_delta_24h"` // Positive when the player climbed; null without a day-old snapshot
+	Stats              map[string]interface{} `json:"stats"`
+	DataIncomplete     bool                   `json:"data_incomplete"` // The player's profile could not be resolved; name and avatar are placeholders
+	Anonymous          bool                   `json:"anonymous"`       // The player opted out of public leaderboards; ID, name and avatar are withheld
+}
+
+// PlaceholderDisplayName stands in for the name of a player whose profile
+// could not be resolved.
+const PlaceholderDisplayName = "Unknown player"
+
+// AnonymousDisplayName stands in for the name of a player who opted out of
+// public leaderboards.
+const AnonymousDisplayName = "Anonymous"
+
+// DefaultPageSize is how many entries a leaderboard page holds when no limit is requested.
+const DefaultPageSize = 50
+
+// Page is a page of a game's leaderboard as served by the API.
+type Page struct {
+	pagination.Page[LeaderboardEntry]
+	GameID   string                 `json:"game_id"`
+	GameName string                 `json:"game_name"`
+	Sort     player.LeaderboardSort `json:"sort"`
+	Ranking  player.RankingMode     `json:"ranking"`
+	Mode     string                 `json:"mode"`
+	SeasonID *uuid.UUID             `json:"season_id,omitempty"`
 }
 
 // PlayerRankResponse represents a player's rank information.
 type PlayerRankResponse struct {
-	PlayerID     uuid.UUID `json:"player_id"`
-	GameID       uuid.UUID `json:"game_id"`
-	Rank         int64     `json:"rank"`
-	RankingScore float64   `json:"ranking_score"`
-	Tier         string    `json:"tier"`
-	Percentile   float64   `json:"percentile"`
+	PlayerID           uuid.UUID  `json:"player_id"`
+	GameID             uuid.UUID  `json:"game_id"`
+	Mode               string     `json:"mode,omitempty"`
+	SeasonID           *uuid.UUID `json:"season_id,omitempty"`
+	Rank               int64      `json:"rank"`
+	RankingScore       float64    `json:"ranking_score"`
+	RatingDeviation    float64    `json:"rating_deviation"`
+	ConfidenceLow      float64    `json:"confidence_low"`
+	ConfidenceHigh     float64    `json:"confidence_high"`
+	ConservativeRating float64    `json:"conservative_rating"`
+	Tier               string     `json:"tier"`
+	Percentile         float64    `json:"percentile"`
 }
 
 // TierDistribution represents the distribution of players across tiers.
@@ -36,20 +76,39 @@ type TierDistribution map[string]int64
 
 // Service provides leaderboard operations.
 type Service struct {
-	statsRepo player.StatsRepository
-	gameRepo  game.Repository
+	statsRepo  player.StatsRepository
+	gameRepo   game.Repository
+	playerRepo player.Repository
+	orphans    player.OrphanedStatsRepository
+	seasons    season.Repository
+	metrics    *Metrics
 }
 
 // NewService creates a new leaderboard service.
-func NewService(statsRepo player.StatsRepository, gameRepo game.Repository) *Service {
+func NewService(statsRepo player.StatsRepository, gameRepo game.Repository, playerRepo player.Repository) *Service {
 	return &Service{
-		statsRepo: statsRepo,
-		gameRepo:  gameRepo,
+		statsRepo:  statsRepo,
+		gameRepo:   gameRepo,
+		playerRepo: playerRepo,
+		metrics:    &Metrics{},
 	}
 }
 
-// GetLeaderboard retrieves the leaderboard for a game.
-func (s *Service) GetLeaderboard(ctx context.Context, gameID uuid.UUID, limit, offset int64) ([]LeaderboardEntry, string, int64, error) {
+// WithSeasons enables season leaderboards, read from the stats scoped to a
+// season.
+func (s *Service) WithSeasons(repo season.Repository) *Service {
+	s.seasons = repo
+	return s
+}
+
+// Metrics returns the service's placeholder entry and repair counters.
+func (s *Service) Metrics() *Metrics {
+	return s.metrics
+}
+
+// GetLeaderboard retrieves the leaderboard for a game. A scope without a mode
+// ranks stats across all modes, and one without a season all-time stats.
+func (s *Service) GetLeaderboard(ctx context.Context, gameID uuid.UUID, scope player.StatsScope, sortBy player.LeaderboardSort, ranking player.RankingMode, limit, offset int64) ([]LeaderboardEntry, string, int64, error) {
 	// Validate game exists
 	g, err := s.gameRepo.GetByID(ctx, gameID.String())
 	if err != nil {
@@ -59,29 +118,29 @@ func (s *Service) GetLeaderboard(ctx context.Context, gameID uuid.UUID, limit, o
 		return nil, "", 0, err
 	}
 
+	if !g.HasMode(scope.Mode) {
+		return nil, "", 0, fmt.Errorf("%w: %s", game.ErrInvalidMode, scope.Mode)
+	}
+	if err := s.validateSeason(ctx, gameID, scope.SeasonID); err != nil {
+		return nil, "", 0, err
+	}
+
 	// Get leaderboard entries
-	entries, err := s.statsRepo.GetLeaderboard(ctx, gameID, limit, offset)
+	entries, err := s.statsRepo.GetLeaderboard(ctx, gameID, scope, sortBy, ranking, limit, offset)
 	if err != nil {
 		return nil, "", 0, err
 	}
 
+	s.fillMissingProfiles(ctx, entries)
+
 	// Convert domain entries to response DTOs
 	response := make([]LeaderboardEntry, 0, len(entries))
 	for _, entry := range entries {
-		response = append(response, LeaderboardEntry{
-			Rank:          entry.Rank,
-			PlayerID:      entry.PlayerID,
-			DisplayName:   entry.DisplayName,
-			AvatarURL:     entry.AvatarURL,
-			RankingScore:  entry.RankingScore,
-			Tier:          string(entry.Tier),
-			MatchesPlayed: entry.MatchesPlayed,
-			Stats:         entry.Stats,
-		})
+		response = append(response, toLeaderboardEntry(entry))
 	}
 
 	// Get total count
-	total, err := s.statsRepo.CountByGame(ctx, gameID)
+	total, err := s.statsRepo.CountByGame(ctx, gameID, scope)
 	if err != nil {
 		total = 0
 	}
@@ -89,51 +148,64 @@ func (s *Service) GetLeaderboard(ctx context.Context, gameID uuid.UUID, limit, o
 	return response, g.Name, total, nil
 }
 
-// GetLeaderboardByTier retrieves the leaderboard filtered by tier.
-func (s *Service) GetLeaderboardByTier(ctx context.Context, gameID uuid.UUID, tierStr string, limit int64) ([]LeaderboardEntry, error) {
+// GetLeaderboardByTier retrieves the top of the leaderboard filtered by tier,
+// and the number of players in the tier.
+func (s *Service) GetLeaderboardByTier(ctx context.Context, gameID uuid.UUID, scope player.StatsScope, tierStr string, sortBy player.LeaderboardSort, ranking player.RankingMode, limit int64) ([]LeaderboardEntry, int64, error) {
 	// Validate tier
 	tier := player.Tier(tierStr)
 	if !isValidTier(tier) {
-		return nil, fmt.Errorf("invalid tier: %s", tierStr)
+		return nil, 0, fmt.Errorf("invalid tier: %s", tierStr)
+	}
+
+	if err := s.validateScope(ctx, gameID, scope); err != nil {
+		return nil, 0, err
 	}
 
 	// Get leaderboard entries by tier
-	entries, err := s.statsRepo.GetLeaderboardByTier(ctx, gameID, tier, limit)
+	entries, err := s.statsRepo.GetLeaderboardByTier(ctx, gameID, scope, tier, sortBy, ranking, limit)
 	if err != nil {
-		return nil, err
+		return nil, 0, err
 	}
 
+	distribution, err := s.statsRepo.GetTierDistribution(ctx, gameID, scope)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count tier players: %w", err)
+	}
+
+	s.fillMissingProfiles(ctx, entries)
+
 	// Convert to response DTOs
 	response := make([]LeaderboardEntry, 0, len(entries))
 	for _, entry := range entries {
-		response = append(response, LeaderboardEntry{
-			Rank:          entry.Rank,
-			PlayerID:      entry.PlayerID,
-			DisplayName:   entry.DisplayName,
-			AvatarURL:     entry.AvatarURL,
-			RankingScore:  entry.RankingScore,
-			Tier:          string(entry.Tier),
-			MatchesPlayed: entry.MatchesPlayed,
-			Stats:         entry.Stats,
-		})
+		response = append(response, toLeaderboardEntry(entry))
 	}
 
-	return response, nil
+	return response, distribution[tier], nil
 }
 
-// GetPlayerRank retrieves a player's rank in a specific game.
-func (s *Service) GetPlayerRank(ctx context.Context, playerID, gameID uuid.UUID) (*PlayerRankResponse, error) {
+// GetPlayerRank retrieves a player's rank in a specific game. Players who
+// opted out of public leaderboards are reported as having no stats; they see
+// their own rank through their profile instead.
+func (s *Service) GetPlayerRank(ctx context.Context, playerID, gameID uuid.UUID, scope player.StatsScope) (*PlayerRankResponse, error) {
+	if err := s.validateScope(ctx, gameID, scope); err != nil {
+		return nil, err
+	}
+
+	if p, err := s.playerRepo.GetByID(ctx, playerID.String()); err == nil && p.HideFromBoards {
+		return nil, fmt.Errorf("player has no stats for this game: %w", player.ErrStatsNotFound)
+	}
+
 	// Get player rank info
-	rankInfo, err := s.statsRepo.GetPlayerRank(ctx, playerID, gameID)
+	rankInfo, err := s.statsRepo.GetPlayerRank(ctx, playerID, gameID, scope)
 	if err != nil {
 		if err == player.ErrStatsNotFound {
-			return nil, fmt.Errorf("player has no stats for this game")
+			return nil, fmt.Errorf("player has no stats for this game: %w", err)
 		}
 		return nil, err
 	}
 
 	// Get total count for percentile
-	total, err := s.statsRepo.CountByGame(ctx, gameID)
+	total, err := s.statsRepo.CountByGame(ctx, gameID, scope)
 	if err != nil {
 		total = 1
 	}
@@ -147,19 +219,31 @@ func (s *Service) GetPlayerRank(ctx context.Context, playerID, gameID uuid.UUID)
 		}
 	}
 
+	low, high := player.ConfidenceInterval(rankInfo.RankingScore, rankInfo.RatingDeviation)
+
 	return &PlayerRankResponse{
-		PlayerID:     playerID,
-		GameID:       gameID,
-		Rank:         rankInfo.Rank,
-		RankingScore: rankInfo.RankingScore,
-		Tier:         string(rankInfo.Tier),
-		Percentile:   percentile,
+		PlayerID:           playerID,
+		GameID:             gameID,
+		Mode:               scope.Mode,
+		SeasonID:           SeasonRef(scope),
+		Rank:               rankInfo.Rank,
+		RankingScore:       rankInfo.RankingScore,
+		RatingDeviation:    rankInfo.RatingDeviation,
+		ConfidenceLow:      low,
+		ConfidenceHigh:     high,
+		ConservativeRating: player.ConservativeRating(rankInfo.RankingScore, rankInfo.RatingDeviation),
+		Tier:               string(rankInfo.Tier),
+		Percentile:         percentile,
 	}, nil
 }
 
 // GetTierDistribution retrieves the distribution of players across tiers.
-func (s *Service) GetTierDistribution(ctx context.Context, gameID uuid.UUID) (TierDistribution, int64, error) {
-	distribution, err := s.statsRepo.GetTierDistribution(ctx, gameID)
+func (s *Service) GetTierDistribution(ctx context.Context, gameID uuid.UUID, scope player.StatsScope) (TierDistribution, int64, error) {
+	if err := s.validateScope(ctx, gameID, scope); err != nil {
+		return nil, 0, err
+	}
+
+	distribution, err := s.statsRepo.GetTierDistribution(ctx, gameID, scope)
 	if err != nil {
 		return nil, 0, err
 	}
@@ -175,6 +259,221 @@ func (s *Service) GetTierDistribution(ctx context.Context, gameID uuid.UUID) (Ti
 	return response, total, nil
 }
 
+// SnapshotRanks records the current leaderboard ranks of every game, across
+// all modes and per mode, so later reads can report rank movement.
+func (s *Service) SnapshotRanks(ctx context.Context) error {
+	games, err := s.gameRepo.GetAll(ctx)
+	if err != nil {
+		return fmt.Errorf("get games: %w", err)
+	}
+
+	takenAt := time.Now().UTC()
+	for _, g := range games {
+		modes := []string{""}
+		for _, m := range g.Modes {
+			modes = append(modes, m.Slug)
+		}
+
+		for _, mode := range modes {
+			if err := s.statsRepo.SnapshotRanks(ctx, g.ID, mode, takenAt); err != nil {
+				return fmt.Errorf("snapshot ranks for game %s mode %q: %w", g.ID, mode, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// validateScope checks that a scope's mode and season exist for a game.
+func (s *Service) validateScope(ctx context.Context, gameID uuid.UUID, scope player.StatsScope) error {
+	if err := s.validateMode(ctx, gameID, scope.Mode); err != nil {
+		return err
+	}
+	return s.validateSeason(ctx, gameID, scope.SeasonID)
+}
+
+// validateMode checks that a mode exists for a game. The empty mode is always valid.
+func (s *Service) validateMode(ctx context.Context, gameID uuid.UUID, mode string) error {
+	if mode == "" {
+		return nil
+	}
+
+	g, err := s.gameRepo.GetByID(ctx, gameID.String())
+	if err != nil {
+		return err
+	}
+	if !g.HasMode(mode) {
+		return fmt.Errorf("%w: %s", game.ErrInvalidMode, mode)
+	}
+	return nil
+}
+
+// validateSeason checks that a season belongs to a game. The nil season,
+// for all-time stats, is always valid.
+func (s *Service) validateSeason(ctx context.Context, gameID, seasonID uuid.UUID) error {
+	if seasonID == uuid.Nil {
+		return nil
+	}
+	if s.seasons == nil {
+		return season.ErrNotFound
+	}
+
+	ss, err := s.seasons.GetByID(ctx, seasonID)
+	if err != nil {
+		return err
+	}
+	if ss.GameID != gameID {
+		return season.ErrNotFound
+	}
+	return nil
+}
+
+// SeasonRef returns the season a scope covers, or nil for all-time stats.
+func SeasonRef(scope player.StatsScope) *uuid.UUID {
+	if scope.SeasonID == uuid.Nil {
+		return nil
+	}
+	id := scope.SeasonID
+	return &id
+}
+
+// fillMissingProfiles looks up, in one batch, the display name and avatar of
+// entries the leaderboard query could not join to a player profile. Entries
+// still without a profile, including all of them when the lookup fails, are
+// marked incomplete and served as placeholders rather than failing the page.
+func (s *Service) fillMissingProfiles(ctx context.Context, entries []player.LeaderboardEntry) {
+	var ids []string
+	for _, entry := range entries {
+		if entry.DataIncomplete {
+			// The stored player ID is unreadable, so there is nothing to look up
+			s.metrics.recordIncomplete(incompleteInvalidPlayerID)
+			continue
+		}
+		if entry.DisplayName == "" {
+			ids = append(ids, entry.PlayerID.String())
+		}
+	}
+	if len(ids) == 0 {
+		return
+	}
+
+	reason := incompleteMissingProfile
+	players, err := s.playerRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		s.metrics.lookupFailures.Add(1)
+		reason = incompleteLookupFailed
+	}
+	byID := make(map[uuid.UUID]*player.Player, len(players))
+	for _, p := range players {
+		byID[p.ID] = p
+	}
+
+	for i := range entries {
+		entry := &entries[i]
+		if entry.DataIncomplete || entry.DisplayName != "" {
+			continue
+		}
+		if p, ok := byID[entry.PlayerID]; ok {
+			entry.DisplayName = p.DisplayName
+			entry.AvatarURL = p.AvatarURL
+			entry.Anonymous = p.HideFromBoards
+			continue
+		}
+		entry.DataIncomplete = true
+		s.metrics.recordIncomplete(reason)
+	}
+}
+
+// toLeaderboardEntry converts a domain leaderboard entry to a response DTO.
+// Anonymous entries keep their rank and stats so that the ranks around them
+// stay consistent, but nothing that identifies the player.
+func toLeaderboardEntry(entry player.LeaderboardEntry) LeaderboardEntry {
+	low, high := player.ConfidenceInterval(entry.RankingScore, entry.RatingDeviation)
+
+	if entry.DataIncomplete && entry.DisplayName == "" {
+		entry.DisplayName = PlaceholderDisplayName
+	}
+	if entry.Anonymous {
+		entry.PlayerID = uuid.Nil
+		entry.DisplayName = AnonymousDisplayName
+		entry.AvatarURL = ""
+	}
+
+	return LeaderboardEntry{
+		Rank:               entry.Rank,
+		PlayerID:           entry.PlayerID,
+		DisplayName:        entry.DisplayName,
+		AvatarURL:          entry.AvatarURL,
+		RankingScore:       entry.RankingScore,
+		RatingDeviation:    entry.RatingDeviation,
+		ConfidenceLow:      low,
+		ConfidenceHigh:     high,
+		ConservativeRating: player.ConservativeRating(entry.RankingScore, entry.RatingDeviation),
+		Tier:               string(entry.Tier),
+		MatchesPlayed:      entry.MatchesPlayed,
+		IsProvisional:      player.IsProvisional(entry.MatchesPlayed),
+		LastMatchAt:        entry.LastMatchAt,
+		RankDelta24h:       rankDelta(entry),
+		Stats:              entry.Stats,
+		DataIncomplete:     entry.DataIncomplete,
+		Anonymous:          entry.Anonymous,
+	}
+}
+
+// Standings returns the top of a leaderboard by score with every player
+// identified, for records kept after the leaderboard moves on such as a
+// season's archived standings. Players who opt out of public leaderboards are
+// withheld when the record is served, using HiddenPlayers, so a later change
+// of mind applies to it too.
+func (s *Service) Standings(ctx context.Context, gameID uuid.UUID, scope player.StatsScope, limit int64) ([]player.LeaderboardEntry, error) {
+	entries, err := s.statsRepo.GetLeaderboard(ctx, gameID, scope, player.LeaderboardSortScore, player.RankingCompetition, limit, 0)
+	if err != nil {
+		return nil, err
+	}
+	s.fillMissingProfiles(ctx, entries)
+
+	for i := range entries {
+		if entries[i].DataIncomplete && entries[i].DisplayName == "" {
+			entries[i].DisplayName = PlaceholderDisplayName
+		}
+	}
+	return entries, nil
+}
+
+// HiddenPlayers reports which of the given players are currently withheld
+// from public leaderboards, because they opted out or deactivated their
+// account.
+func (s *Service) HiddenPlayers(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID]bool, error) {
+	hidden := make(map[uuid.UUID]bool)
+	if len(ids) == 0 {
+		return hidden, nil
+	}
+
+	strs := make([]string, len(ids))
+	for i, id := range ids {
+		strs[i] = id.String()
+	}
+	players, err := s.playerRepo.GetByIDs(ctx, strs)
+	if err != nil {
+		return nil, fmt.Errorf("get players: %w", err)
+	}
+	for _, p := range players {
+		if p.HideFromBoards || p.IsDeactivated {
+			hidden[p.ID] = true
+		}
+	}
+	return hidden, nil
+}
+
+// rankDelta returns how many places an entry moved since its previous rank, if known.
+func rankDelta(entry player.LeaderboardEntry) *int {
+	if entry.PreviousRank == 0 {
+		return nil
+	}
+	delta := entry.PreviousRank - entry.Rank
+	return &delta
+}
+
 // isValidTier checks if a tier str represents a valid Tier.
 func isValidTier(tier player.Tier) bool {
 	switch tier {
@@ -0,0 +1,286 @@
+package leaderboard
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+// SyncPublisher pushes a leaderboard snapshot to a sync's external destination.
+type SyncPublisher interface {
+	Publish(ctx context.Context, sync *player.LeaderboardSync, snapshot *player.LeaderboardSnapshot) error
+}
+
+// Mailer sends plain-text email.
+type Mailer interface {
+	SendMail(ctx context.Context, to, subject, body string) error
+}
+
+// SyncService manages scheduled leaderboard pushes to external systems.
+type SyncService struct {
+	syncRepo  player.LeaderboardSyncRepository
+	gameRepo  game.Repository
+	service   *Service
+	publisher SyncPublisher
+	logger    *slog.Logger
+
+	mailer  Mailer
+	alertTo string
+}
+
+// NewSyncService creates a new leaderboard sync service.
+func NewSyncService(syncRepo player.LeaderboardSyncRepository, gameRepo game.Repository, service *Service, publisher SyncPublisher, logger *slog.Logger) *SyncService {
+	return &SyncService{
+		syncRepo:  syncRepo,
+		gameRepo:  gameRepo,
+		service:   service,
+		publisher: publisher,
+		logger:    logger,
+	}
+}
+
+// WithAlerts emails to when a sync reaches player.SyncAlertThreshold consecutive failures.
+func (s *SyncService) WithAlerts(mailer Mailer, to string) *SyncService {
+	s.mailer = mailer
+	s.alertTo = to
+	return s
+}
+
+// SyncRequest configures a leaderboard sync. Credential is write-only; when
+// updating, an empty credential keeps the stored one.
+type SyncRequest struct {
+	Name            string              `json:"name"`
+	Mode            string              `json:"mode"`
+	Provider        player.SyncProvider `json:"provider"`
+	Endpoint        string              `json:"endpoint"`
+	Sheet           string              `json:"sheet"`
+	Credential      string              `json:"credential"`
+	Limit           int                 `json:"limit"`
+	IntervalMinutes int                 `json:"interval_minutes"`
+	Enabled         bool                `json:"enabled"`
+}
+
+// ListSyncs returns a game's leaderboard syncs.
+func (s *SyncService) ListSyncs(ctx context.Context, gameID uuid.UUID) ([]*player.LeaderboardSync, error) {
+	return s.syncRepo.ListByGame(ctx, gameID)
+}
+
+// CreateSync adds a leaderboard sync for a game.
+func (s *SyncService) CreateSync(ctx context.Context, gameID uuid.UUID, req SyncRequest) (*player.LeaderboardSync, error) {
+	now := time.Now().UTC()
+	sync := &player.LeaderboardSync{
+		ID:        uuid.New(),
+		GameID:    gameID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	applySyncRequest(sync, req)
+
+	if err := s.validate(ctx, sync); err != nil {
+		return nil, err
+	}
+	if err := s.syncRepo.Create(ctx, sync); err != nil {
+		return nil, err
+	}
+	return sync, nil
+}
+
+// UpdateSync replaces a sync's settings, keeping its run history.
+func (s *SyncService) UpdateSync(ctx context.Context, id uuid.UUID, req SyncRequest) (*player.LeaderboardSync, error) {
+	sync, err := s.syncRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	credential := sync.Credential
+	applySyncRequest(sync, req)
+	if sync.Credential == "" {
+		sync.Credential = credential
+	}
+	sync.UpdatedAt = time.Now().UTC()
+
+	if err := s.validate(ctx, sync); err != nil {
+		return nil, err
+	}
+	if err := s.syncRepo.Update(ctx, sync); err != nil {
+		return nil, err
+	}
+	return sync, nil
+}
+
+// DeleteSync removes a leaderboard sync.
+func (s *SyncService) DeleteSync(ctx context.Context, id uuid.UUID) error {
+	return s.syncRepo.Delete(ctx, id)
+}
+
+// RunSync pushes a sync's snapshot immediately, regardless of its schedule,
+// and returns the sync with its updated run status.
+func (s *SyncService) RunSync(ctx context.Context, id uuid.UUID) (*player.LeaderboardSync, error) {
+	sync, err := s.syncRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.run(ctx, sync); err != nil {
+		return nil, err
+	}
+	return sync, nil
+}
+
+// RunDue pushes every enabled sync whose interval has elapsed and returns how
+// many ran. Failures are recorded on each sync rather than returned.
+func (s *SyncService) RunDue(ctx context.Context) (int, error) {
+	syncs, err := s.syncRepo.ListEnabled(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("list leaderboard syncs: %w", err)
+	}
+
+	ran := 0
+	now := time.Now().UTC()
+	for _, sync := range syncs {
+		if ctx.Err() != nil {
+			break
+		}
+		if !sync.Due(now) {
+			continue
+		}
+		if err := s.run(ctx, sync); err != nil {
+			return ran, err
+		}
+		ran++
+	}
+	return ran, nil
+}
+
+// run builds and publishes a sync's snapshot and records the outcome. Only
+// failures to save the outcome are returned.
+func (s *SyncService) run(ctx context.Context, sync *player.LeaderboardSync) error {
+	snapshot, err := s.snapshot(ctx, sync)
+	if err == nil {
+		err = s.publisher.Publish(ctx, sync, snapshot)
+	}
+
+	now := time.Now().UTC()
+	if err != nil {
+		s.logger.Warn("leaderboard sync failed", "sync_id", sync.ID, "provider", sync.Provider, "failures", sync.ConsecutiveFailures+1, "error", err)
+		if sync.RecordFailure(err, now) {
+			s.alert(ctx, sync)
+		}
+	} else {
+		sync.RecordSuccess(now)
+	}
+
+	return s.syncRepo.Update(ctx, sync)
+}
+
+// snapshot reads the top of the sync's leaderboard.
+func (s *SyncService) snapshot(ctx context.Context, sync *player.LeaderboardSync) (*player.LeaderboardSnapshot, error) {
+	entries, gameName, _, err := s.service.GetLeaderboard(ctx, sync.GameID, player.StatsScope{Mode: sync.Mode}, player.LeaderboardSortScore, player.RankingOrdinal, int64(sync.Limit), 0)
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := &player.LeaderboardSnapshot{
+		GameID:      sync.GameID,
+		GameName:    gameName,
+		Mode:        sync.Mode,
+		GeneratedAt: time.Now().UTC(),
+		Entries:     make([]player.LeaderboardSnapshotEntry, 0, len(entries)),
+	}
+	for _, e := range entries {
+		snapshot.Entries = append(snapshot.Entries, player.LeaderboardSnapshotEntry{
+			Rank:               e.Rank,
+			PlayerID:           e.PlayerID,
+			DisplayName:        e.DisplayName,
+			RankingScore:       e.RankingScore,
+			ConservativeRating: e.ConservativeRating,
+			Tier:               e.Tier,
+			MatchesPlayed:      e.MatchesPlayed,
+		})
+	}
+	return snapshot, nil
+}
+
+// alert emails the configured address about a failing sync. Without an
+// address the failure is only logged.
+func (s *SyncService) alert(ctx context.Context, sync *player.LeaderboardSync) {
+	s.logger.Error("leaderboard sync is failing", "sync_id", sync.ID, "name", sync.Name, "failures", sync.ConsecutiveFailures, "error", sync.LastError)
+	if s.mailer == nil || s.alertTo == "" {
+		return
+	}
+
+	subject := fmt.Sprintf("Leaderboard sync %q is failing", sync.Name)
+	body := fmt.Sprintf("The leaderboard sync %q (%s, id %s) has failed %d times in a row.\n\nLast error: %s\n",
+		sync.Name, sync.Provider, sync.ID, sync.ConsecutiveFailures, sync.LastError)
+	if err := s.mailer.SendMail(ctx, s.alertTo, subject, body); err != nil {
+		s.logger.Error("failed to send leaderboard sync alert", "sync_id", sync.ID, "error", err)
+	}
+}
+
+// validate checks the sync's settings and that its game has the mode.
+func (s *SyncService) validate(ctx context.Context, sync *player.LeaderboardSync) error {
+	if err := sync.Validate(); err != nil {
+		return err
+	}
+
+	g, err := s.gameRepo.GetByID(ctx, sync.GameID.String())
+	if err != nil {
+		return err
+	}
+	if !g.HasMode(sync.Mode) {
+		return fmt.Errorf("%w: %s", game.ErrInvalidMode, sync.Mode)
+	}
+	return nil
+}
+
+func applySyncRequest(sync *player.LeaderboardSync, req SyncRequest) {
+	sync.Name = req.Name
+	sync.Mode = req.Mode
+	sync.Provider = req.Provider
+	sync.Endpoint = req.Endpoint
+	sync.Sheet = req.Sheet
+	sync.Credential = req.Credential
+	sync.Limit = req.Limit
+	sync.IntervalMinutes = req.IntervalMinutes
+	sync.Enabled = req.Enabled
+}
+
+// SyncWorker periodically pushes due leaderboard syncs.
+type SyncWorker struct {
+	service  *SyncService
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewSyncWorker creates a leaderboard sync worker that checks for due syncs every interval.
+func NewSyncWorker(service *SyncService, interval time.Duration, logger *slog.Logger) *SyncWorker {
+	return &SyncWorker{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run blocks, pushing due syncs on every tick until ctx is cancelled.
+func (w *SyncWorker) Run(ctx context.Context) {
+	w.logger.Info("leaderboard sync worker started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("leaderboard sync worker stopped")
+			return
+		case <-ticker.C:
+			if _, err := w.service.RunDue(ctx); err != nil && ctx.Err() == nil {
+				w.logger.Error("leaderboard sync run failed", "error", err)
+			}
+		}
+	}
+}
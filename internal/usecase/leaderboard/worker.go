@@ -0,0 +1,49 @@
+package leaderboard
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Worker periodically snapshots leaderboard ranks.
+type Worker struct {
+	service  *Service
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewWorker creates a rank snapshot worker that runs every interval.
+func NewWorker(service *Service, interval time.Duration, logger *slog.Logger) *Worker {
+	return &Worker{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run blocks, snapshotting ranks immediately and then on every tick until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	w.logger.Info("rank snapshot worker started", "interval", w.interval)
+
+	w.snapshot(ctx)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("rank snapshot worker stopped")
+			return
+		case <-ticker.C:
+			w.snapshot(ctx)
+		}
+	}
+}
+
+func (w *Worker) snapshot(ctx context.Context) {
+	if err := w.service.SnapshotRanks(ctx); err != nil && ctx.Err() == nil {
+		w.logger.Error("rank snapshot failed", "error", err)
+	}
+}
@@ -0,0 +1,158 @@
+package match
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
+	teamdomain "github.com/alejaam/tourney-rank/internal/domain/team"
+)
+
+// AddCommentRequest represents a new comment on a match.
+type AddCommentRequest struct {
+	Body string `json:"body"`
+}
+
+// CommentResponse represents a match comment in API responses.
+type CommentResponse struct {
+	ID         uuid.UUID `json:"id"`
+	MatchID    uuid.UUID `json:"match_id"`
+	AuthorID   uuid.UUID `json:"author_id"`
+	AuthorName string    `json:"author_name,omitempty"` // Empty for authors without a player profile
+	Body       string    `json:"body"`
+	CreatedAt  string    `json:"created_at"`
+}
+
+// AddComment posts a comment to a match's thread. Members of the submitting
+// team and admins can comment.
+func (s *Service) AddComment(ctx context.Context, matchID, authorID uuid.UUID, isAdmin bool, req AddCommentRequest) (*CommentResponse, error) {
+	m, err := s.matchRepo.GetByID(ctx, matchID.String())
+	if err != nil {
+		return nil, fmt.Errorf("get match: %w", err)
+	}
+
+	if err := s.checkCanComment(ctx, m, authorID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	c, err := matchdomain.NewComment(m.ID, authorID, req.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.commentRepo.Create(ctx, c); err != nil {
+		return nil, fmt.Errorf("create comment: %w", err)
+	}
+
+	responses, err := s.commentResponses(ctx, []matchdomain.Comment{*c})
+	if err != nil {
+		return nil, err
+	}
+	return &responses[0], nil
+}
+
+// GetComments returns a match's comment thread, oldest first. The thread is
+// visible to the same members and admins who can post to it.
+func (s *Service) GetComments(ctx context.Context, matchID, viewerID uuid.UUID, isAdmin bool) ([]CommentResponse, error) {
+	m, err := s.matchRepo.GetByID(ctx, matchID.String())
+	if err != nil {
+		return nil, fmt.Errorf("get match: %w", err)
+	}
+
+	if err := s.checkCanComment(ctx, m, viewerID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	comments, err := s.commentRepo.ListByMatch(ctx, m.ID.String())
+	if err != nil {
+		return nil, fmt.Errorf("list comments: %w", err)
+	}
+
+	return s.commentResponses(ctx, comments)
+}
+
+// checkCanComment returns ErrCommentForbidden unless the user is an admin or
+// a member of the match's submitting team.
+func (s *Service) checkCanComment(ctx context.Context, m *matchdomain.Match, userID uuid.UUID, isAdmin bool) error {
+	if isAdmin {
+		return nil
+	}
+
+	t, err := s.teamRepo.GetByID(ctx, m.TeamID)
+	if err != nil {
+		if errors.Is(err, teamdomain.ErrNotFound) {
+			return matchdomain.ErrCommentForbidden
+		}
+		return fmt.Errorf("get team: %w", err)
+	}
+
+	if !t.HasMember(userID) {
+		return matchdomain.ErrCommentForbidden
+	}
+	return nil
+}
+
+// threadsByMatch loads the comment threads of the given matches in one batch,
+// keyed by match ID. Matches without comments are absent.
+func (s *Service) threadsByMatch(ctx context.Context, matches []matchdomain.Match) (map[string][]CommentResponse, error) {
+	ids := make([]string, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ID.String()
+	}
+
+	comments, err := s.commentRepo.ListByMatches(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("list comments: %w", err)
+	}
+
+	responses, err := s.commentResponses(ctx, comments)
+	if err != nil {
+		return nil, err
+	}
+
+	threads := make(map[string][]CommentResponse)
+	for _, c := range responses {
+		key := c.MatchID.String()
+		threads[key] = append(threads[key], c)
+	}
+	return threads, nil
+}
+
+// commentResponses converts comments to responses, looking up the authors'
+// display names in one batch.
+func (s *Service) commentResponses(ctx context.Context, comments []matchdomain.Comment) ([]CommentResponse, error) {
+	var ids []string
+	seen := make(map[uuid.UUID]bool)
+	for _, c := range comments {
+		if !seen[c.AuthorID] {
+			seen[c.AuthorID] = true
+			ids = append(ids, c.AuthorID.String())
+		}
+	}
+
+	players, err := s.playerRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get players: %w", err)
+	}
+	names := make(map[uuid.UUID]string, len(players))
+	for _, p := range players {
+		names[p.ID] = p.DisplayName
+	}
+
+	responses := make([]CommentResponse, len(comments))
+	for i, c := range comments {
+		responses[i] = CommentResponse{
+			ID:         c.ID,
+			MatchID:    c.MatchID,
+			AuthorID:   c.AuthorID,
+			AuthorName: names[c.AuthorID],
+			Body:       c.Body,
+			CreatedAt:  c.CreatedAt.Format(time.RFC3339),
+		}
+	}
+	return responses, nil
+}
@@ -0,0 +1,34 @@
+package match
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+
+	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
+	playerdomain "github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/usecase/conduct"
+)
+
+// WithConduct records a lost dispute against the submitter of every match
+// report an admin rejects or unverifies.
+func (s *Service) WithConduct(conduct *conduct.Service) *Service {
+	s.conduct = conduct
+	return s
+}
+
+// recordLostDispute records a lost dispute against the match's submitter.
+func (s *Service) recordLostDispute(ctx context.Context, m *matchdomain.Match, note string, adminID uuid.UUID) error {
+	if s.conduct == nil {
+		return nil
+	}
+
+	record, err := playerdomain.NewConductRecord(m.SubmittedBy, playerdomain.ConductDisputeLost, note, adminID, time.Now())
+	if err != nil {
+		return err
+	}
+	record.TournamentID = &m.TournamentID
+	record.MatchID = &m.ID
+	return s.conduct.Record(ctx, record)
+}
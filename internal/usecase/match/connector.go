@@ -0,0 +1,147 @@
+package match
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
+)
+
+var (
+	// ErrConnectorsUnavailable is returned when match connectors are not configured.
+	ErrConnectorsUnavailable = errors.New("match connectors are not available")
+
+	// ErrInvalidConnectorPayload is returned when a signed submission is not a valid match report.
+	ErrInvalidConnectorPayload = errors.New("invalid connector match payload")
+)
+
+// WithConnectors accepts signed match submissions from stats connectors.
+func (s *Service) WithConnectors(connectors matchdomain.ConnectorRepository, nonces matchdomain.NonceRepository) *Service {
+	s.connectors = connectors
+	s.nonces = nonces
+	return s
+}
+
+// ConnectorCredentials is a connector together with its secret. The secret
+// is only returned when a connector is created or its secret is rotated.
+type ConnectorCredentials struct {
+	*matchdomain.Connector
+	Secret string `json:"secret"`
+}
+
+// CreateConnector registers a new stats connector.
+func (s *Service) CreateConnector(ctx context.Context, name string, adminID uuid.UUID) (*ConnectorCredentials, error) {
+	if s.connectors == nil {
+		return nil, ErrConnectorsUnavailable
+	}
+
+	connector, err := matchdomain.NewConnector(name, adminID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.connectors.Create(ctx, connector); err != nil {
+		return nil, err
+	}
+	return &ConnectorCredentials{Connector: connector, Secret: connector.Secret}, nil
+}
+
+// ListConnectors returns every registered connector, without secrets.
+func (s *Service) ListConnectors(ctx context.Context) ([]*matchdomain.Connector, error) {
+	if s.connectors == nil {
+		return nil, ErrConnectorsUnavailable
+	}
+	return s.connectors.List(ctx)
+}
+
+// RotateConnectorSecret issues a new secret for a connector.
+func (s *Service) RotateConnectorSecret(ctx context.Context, id uuid.UUID) (*ConnectorCredentials, error) {
+	if s.connectors == nil {
+		return nil, ErrConnectorsUnavailable
+	}
+
+	connector, err := s.connectors.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := connector.Rotate(time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.connectors.Update(ctx, connector); err != nil {
+		return nil, err
+	}
+	return &ConnectorCredentials{Connector: connector, Secret: connector.Secret}, nil
+}
+
+// RevokeConnector permanently stops a connector from submitting.
+func (s *Service) RevokeConnector(ctx context.Context, id uuid.UUID) (*matchdomain.Connector, error) {
+	if s.connectors == nil {
+		return nil, ErrConnectorsUnavailable
+	}
+
+	connector, err := s.connectors.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	connector.Revoke(time.Now().UTC())
+	if err := s.connectors.Update(ctx, connector); err != nil {
+		return nil, err
+	}
+	return connector, nil
+}
+
+// ConnectorSubmission is a signed match report from a connector. Body is the
+// raw JSON SubmitMatchRequest exactly as signed.
+type ConnectorSubmission struct {
+	ConnectorID uuid.UUID
+	Timestamp   time.Time
+	Nonce       string
+	Signature   string
+	Body        []byte
+}
+
+// SubmitConnectorMatch verifies a connector's signature, rejects reused
+// nonces and stores the report as a draft for admin verification. The
+// connector is recorded as the submitter and team submit permissions do not
+// apply.
+func (s *Service) SubmitConnectorMatch(ctx context.Context, sub ConnectorSubmission) (*MatchResponse, error) {
+	if s.connectors == nil || s.nonces == nil {
+		return nil, ErrConnectorsUnavailable
+	}
+
+	connector, err := s.connectors.GetByID(ctx, sub.ConnectorID)
+	if err != nil {
+		if errors.Is(err, matchdomain.ErrConnectorNotFound) {
+			// Don't reveal which connector IDs exist.
+			return nil, matchdomain.ErrInvalidConnectorSignature
+		}
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if err := connector.VerifySubmission(sub.Timestamp, sub.Nonce, sub.Body, sub.Signature, now); err != nil {
+		return nil, err
+	}
+
+	// Claim the nonce only once the signature checks out, so forged requests
+	// cannot burn a connector's nonces.
+	if err := s.nonces.Claim(ctx, connector.ID, sub.Nonce, now.Add(matchdomain.ConnectorNonceTTL)); err != nil {
+		return nil, err
+	}
+
+	// Only the last use is written: saving the connector read above would
+	// undo a revocation or rotation made since.
+	if err := s.connectors.TouchLastUsed(ctx, connector.ID, now); err != nil {
+		return nil, fmt.Errorf("recording connector use: %w", err)
+	}
+
+	var req SubmitMatchRequest
+	if err := json.Unmarshal(sub.Body, &req); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidConnectorPayload, err)
+	}
+	return s.submitMatch(ctx, req, connector.ID, false)
+}
@@ -0,0 +1,127 @@
+package match
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
+)
+
+// ErrCorrectionsUnavailable is returned when stat corrections are not configured.
+var ErrCorrectionsUnavailable = errors.New("stat corrections are not available")
+
+// WithCorrections lets admins correct mis-keyed stats across many verified
+// matches at once, keeping a log of every correction.
+func (s *Service) WithCorrections(repo matchdomain.CorrectionRepository) *Service {
+	s.corrections = repo
+	return s
+}
+
+// CorrectStatsRequest selects verified matches and the transform applied to
+// their player lines. A dry run reports the changes without making them.
+type CorrectStatsRequest struct {
+	matchdomain.CorrectionFilter
+	Transform matchdomain.Transform `json:"transform"`
+	Reason    string                `json:"reason"`
+	DryRun    bool                  `json:"dry_run"`
+}
+
+// CorrectStats applies a transform to the player lines of every verified
+// match the filter selects. Each changed match is amended with the request's
+// reason, which subtracts the old values from the players' stats and adds
+// the corrected ones. The correction, with every change, is logged even when
+// it stops part way; a dry run is not logged.
+func (s *Service) CorrectStats(ctx context.Context, req CorrectStatsRequest, adminID uuid.UUID) (*matchdomain.StatCorrection, error) {
+	if s.corrections == nil {
+		return nil, ErrCorrectionsUnavailable
+	}
+
+	correction, err := matchdomain.NewStatCorrection(req.CorrectionFilter, req.Transform, req.Reason, adminID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	correction.DryRun = req.DryRun
+
+	if _, err := s.gameRepo.GetByID(ctx, req.GameID.String()); err != nil {
+		return nil, fmt.Errorf("get game: %w", err)
+	}
+
+	matches, err := s.matchRepo.GetVerifiedForCorrection(ctx, correction.Filter, matchdomain.MaxCorrectionMatches+1)
+	if err != nil {
+		return nil, fmt.Errorf("get matches: %w", err)
+	}
+	if len(matches) > matchdomain.MaxCorrectionMatches {
+		return nil, matchdomain.ErrTooManyCorrectionMatches
+	}
+	correction.Scanned = len(matches)
+
+	for i := range matches {
+		m := &matches[i]
+		corrected, changes := correction.Correct(m)
+		if len(changes) == 0 {
+			continue
+		}
+		if req.DryRun {
+			correction.Matches = append(correction.Matches, matchdomain.CorrectedMatch{MatchID: m.ID, Changes: changes})
+			continue
+		}
+
+		amendmentID, err := s.amendForCorrection(ctx, m, correction, corrected)
+		if err != nil {
+			correction.Error = err.Error()
+			if logErr := s.corrections.Create(ctx, correction); logErr != nil {
+				return nil, fmt.Errorf("correct match %s: %w (log correction: %v)", m.ID, err, logErr)
+			}
+			return nil, fmt.Errorf("correct match %s: %w", m.ID, err)
+		}
+		correction.Matches = append(correction.Matches, matchdomain.CorrectedMatch{MatchID: m.ID, AmendmentID: &amendmentID, Changes: changes})
+	}
+
+	if req.DryRun {
+		return correction, nil
+	}
+	if err := s.corrections.Create(ctx, correction); err != nil {
+		return nil, fmt.Errorf("log correction: %w", err)
+	}
+	return correction, nil
+}
+
+// amendForCorrection amends a match with its corrected player lines and
+// adjusts the players' stats by the difference.
+func (s *Service) amendForCorrection(ctx context.Context, m *matchdomain.Match, correction *matchdomain.StatCorrection, corrected []matchdomain.PlayerMatchStats) (uuid.UUID, error) {
+	previous := m.PlayerStats
+	amendment, err := m.Amend(correction.CreatedBy, correction.Reason, m.TeamPlacement, m.TeamKills, corrected, time.Now().UTC())
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("amend match: %w", err)
+	}
+
+	if err := s.matchRepo.Amend(ctx, m, amendment); err != nil {
+		return uuid.Nil, fmt.Errorf("store amendment: %w", err)
+	}
+
+	if err := s.correctPlayerStats(ctx, m, previous, m.TeamPlacement); err != nil {
+		return uuid.Nil, fmt.Errorf("correct player stats: %w", err)
+	}
+
+	return amendment.ID, nil
+}
+
+// ListStatCorrections returns a game's stat corrections, newest first.
+func (s *Service) ListStatCorrections(ctx context.Context, gameID uuid.UUID) ([]*matchdomain.StatCorrection, error) {
+	if s.corrections == nil {
+		return nil, ErrCorrectionsUnavailable
+	}
+	return s.corrections.ListByGame(ctx, gameID)
+}
+
+// GetStatCorrection returns a stat correction with every change it made.
+func (s *Service) GetStatCorrection(ctx context.Context, id uuid.UUID) (*matchdomain.StatCorrection, error) {
+	if s.corrections == nil {
+		return nil, ErrCorrectionsUnavailable
+	}
+	return s.corrections.GetByID(ctx, id)
+}
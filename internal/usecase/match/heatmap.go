@@ -0,0 +1,110 @@
+package match
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
+)
+
+// HeatmapCacheTTL is how long a computed activity heatmap is served before recomputing.
+const HeatmapCacheTTL = 10 * time.Minute
+
+// PlayerHeatmapResponse is a contribution-graph style count of the verified
+// matches a player played each day in a game.
+type PlayerHeatmapResponse struct {
+	PlayerID    uuid.UUID              `json:"player_id"`
+	GameID      uuid.UUID              `json:"game_id"`
+	Weeks       int                    `json:"weeks"`
+	Total       int                    `json:"total"`
+	ActiveDays  int                    `json:"active_days"`
+	Days        []matchdomain.DayCount `json:"days"` // Oldest first, starting on a Monday
+	GeneratedAt time.Time              `json:"generated_at"`
+}
+
+type heatmapKey struct {
+	playerID uuid.UUID
+	gameID   uuid.UUID
+	weeks    int
+}
+
+// heatmapCache holds computed heatmaps per player, game and length. Expired
+// entries are dropped whenever a new heatmap is stored.
+type heatmapCache struct {
+	mu      sync.Mutex
+	entries map[heatmapKey]*PlayerHeatmapResponse
+}
+
+func (c *heatmapCache) get(key heatmapKey, now time.Time) (*PlayerHeatmapResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	h, ok := c.entries[key]
+	if !ok || now.Sub(h.GeneratedAt) >= HeatmapCacheTTL {
+		return nil, false
+	}
+	return h, true
+}
+
+func (c *heatmapCache) put(key heatmapKey, h *PlayerHeatmapResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[heatmapKey]*PlayerHeatmapResponse)
+	}
+	for k, cached := range c.entries {
+		if h.GeneratedAt.Sub(cached.GeneratedAt) >= HeatmapCacheTTL {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = h
+}
+
+// GetPlayerHeatmap returns how many verified matches a player played each day
+// of the last weeks weeks in a game. Results are cached for HeatmapCacheTTL.
+func (s *Service) GetPlayerHeatmap(ctx context.Context, playerID, gameID uuid.UUID, weeks int) (*PlayerHeatmapResponse, error) {
+	now := time.Now().UTC()
+	start, err := matchdomain.HeatmapStart(now, weeks)
+	if err != nil {
+		return nil, err
+	}
+
+	key := heatmapKey{playerID: playerID, gameID: gameID, weeks: weeks}
+	if cached, ok := s.heatmaps.get(key, now); ok {
+		return cached, nil
+	}
+
+	if _, err := s.playerRepo.GetByID(ctx, playerID.String()); err != nil {
+		return nil, fmt.Errorf("get player: %w", err)
+	}
+	if _, err := s.gameRepo.GetByID(ctx, gameID.String()); err != nil {
+		return nil, fmt.Errorf("get game: %w", err)
+	}
+
+	counts, err := s.matchRepo.CountPlayerMatchesByDay(ctx, playerID.String(), gameID.String(), start)
+	if err != nil {
+		return nil, fmt.Errorf("count player matches by day: %w", err)
+	}
+
+	heatmap := &PlayerHeatmapResponse{
+		PlayerID:    playerID,
+		GameID:      gameID,
+		Weeks:       weeks,
+		Days:        matchdomain.Heatmap(counts, start, now),
+		GeneratedAt: now,
+	}
+	for _, d := range heatmap.Days {
+		heatmap.Total += d.Matches
+		if d.Matches > 0 {
+			heatmap.ActiveDays++
+		}
+	}
+	s.heatmaps.put(key, heatmap)
+
+	return heatmap, nil
+}
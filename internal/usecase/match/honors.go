@@ -0,0 +1,87 @@
+package match
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
+	playerdomain "github.com/alejaam/tourney-rank/internal/domain/player"
+)
+
+// ErrHonorsUnavailable is returned when match honors are not configured.
+var ErrHonorsUnavailable = errors.New("match honors are not available")
+
+// WithHonors lets teammates vote an MVP and commend each other after a
+// verified match. Votes accumulate on the players' profiles.
+func (s *Service) WithHonors(repo matchdomain.HonorVoteRepository) *Service {
+	s.honors = repo
+	return s
+}
+
+// HonorVoteRequest is a teammate's MVP pick and commends. Either may be omitted, not both.
+type HonorVoteRequest struct {
+	MVPID      *uuid.UUID  `json:"mvp_id,omitempty"`
+	CommendIDs []uuid.UUID `json:"commend_ids,omitempty"`
+}
+
+// VoteHonors records voterID's MVP pick and commends for a verified match
+// and adds them to the honored players' profiles. It returns the match's
+// updated tally.
+func (s *Service) VoteHonors(ctx context.Context, matchID, voterID uuid.UUID, req HonorVoteRequest) (*matchdomain.HonorSummary, error) {
+	if s.honors == nil {
+		return nil, ErrHonorsUnavailable
+	}
+
+	m, err := s.matchRepo.GetByID(ctx, matchID.String())
+	if err != nil {
+		return nil, fmt.Errorf("get match: %w", err)
+	}
+
+	vote, err := matchdomain.NewHonorVote(m, voterID, req.MVPID, req.CommendIDs, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.honors.Create(ctx, vote); err != nil {
+		return nil, err
+	}
+
+	deltas := make(map[uuid.UUID]playerdomain.Honors)
+	if vote.MVPID != nil {
+		d := deltas[*vote.MVPID]
+		d.MVPVotes++
+		deltas[*vote.MVPID] = d
+	}
+	for _, id := range vote.Commends {
+		d := deltas[id]
+		d.Commends++
+		deltas[id] = d
+	}
+	for id, delta := range deltas {
+		if err := s.playerRepo.AddHonors(ctx, id.String(), delta); err != nil {
+			return nil, fmt.Errorf("add player honors: %w", err)
+		}
+	}
+
+	return s.GetMatchHonors(ctx, matchID)
+}
+
+// GetMatchHonors returns the tally of a match's MVP votes and commends.
+func (s *Service) GetMatchHonors(ctx context.Context, matchID uuid.UUID) (*matchdomain.HonorSummary, error) {
+	if s.honors == nil {
+		return nil, ErrHonorsUnavailable
+	}
+
+	if _, err := s.matchRepo.GetByID(ctx, matchID.String()); err != nil {
+		return nil, fmt.Errorf("get match: %w", err)
+	}
+
+	votes, err := s.honors.ListByMatch(ctx, matchID)
+	if err != nil {
+		return nil, err
+	}
+	return matchdomain.SummarizeHonors(matchID, votes), nil
+}
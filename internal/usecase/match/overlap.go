@@ -0,0 +1,56 @@
+package match
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
+	teamdomain "github.com/alejaam/tourney-rank/internal/domain/team"
+)
+
+// WithRosterOverlaps checks, whenever a match is verified, whether any member
+// of the match's team shares a platform ID with a player on another team in
+// the tournament, and flags both teams for organizer review.
+func (s *Service) WithRosterOverlaps(repo teamdomain.RosterOverlapRepository) *Service {
+	s.overlaps = repo
+	return s
+}
+
+// flagRosterOverlaps records the roster overlaps of a verified match's team.
+// Overlaps flagged by an earlier verification are not flagged again.
+func (s *Service) flagRosterOverlaps(ctx context.Context, m *matchdomain.Match) error {
+	tm, err := s.teamRepo.GetByID(ctx, m.TeamID)
+	if err != nil {
+		return fmt.Errorf("get team: %w", err)
+	}
+	teams, err := s.teamRepo.GetByTournamentID(ctx, m.TournamentID)
+	if err != nil {
+		return fmt.Errorf("get tournament teams: %w", err)
+	}
+
+	var ids []string
+	for _, t := range teams {
+		for _, id := range t.MemberIDs {
+			ids = append(ids, id.String())
+		}
+	}
+	players, err := s.playerRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("get players: %w", err)
+	}
+	platformIDs := make(map[uuid.UUID]map[string]string, len(players))
+	for _, p := range players {
+		platformIDs[p.ID] = p.PlatformIDs
+	}
+
+	for _, overlap := range teamdomain.DetectRosterOverlaps(tm, teams, platformIDs, m.ID, time.Now()) {
+		if err := s.overlaps.Create(ctx, overlap); err != nil && !errors.Is(err, teamdomain.ErrRosterOverlapExists) {
+			return fmt.Errorf("create roster overlap: %w", err)
+		}
+	}
+	return nil
+}
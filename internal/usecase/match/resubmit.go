@@ -0,0 +1,75 @@
+package match
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
+	teamdomain "github.com/alejaam/tourney-rank/internal/domain/team"
+	tournamentdomain "github.com/alejaam/tourney-rank/internal/domain/tournament"
+)
+
+// ResubmitMatchRequest is the corrected result of a rejected match.
+type ResubmitMatchRequest struct {
+	TeamPlacement int                      `json:"team_placement"`
+	TeamKills     int                      `json:"team_kills"`
+	PlayerStats   []PlayerStatsInput       `json:"player_stats"`
+	ScreenshotURL string                   `json:"screenshot_url"`
+	Attachments   []matchdomain.Attachment `json:"attachments,omitempty"`
+}
+
+// ResubmitMatch corrects a rejected match and sends it back to review as a
+// draft. Only the team's captain and designated submitters may resubmit, and
+// only while the tournament is active. The rejected version is kept in the
+// match's revisions and the corrected result is sealed again.
+func (s *Service) ResubmitMatch(ctx context.Context, matchID uuid.UUID, req ResubmitMatchRequest, submitterID uuid.UUID) (*MatchResponse, error) {
+	m, err := s.matchRepo.GetByID(ctx, matchID.String())
+	if err != nil {
+		return nil, fmt.Errorf("get match: %w", err)
+	}
+
+	tournament, err := s.tournamentRepo.GetByID(ctx, m.TournamentID)
+	if err != nil {
+		if errors.Is(err, tournamentdomain.ErrNotFound) {
+			return nil, fmt.Errorf("tournament not found")
+		}
+		return nil, fmt.Errorf("get tournament: %w", err)
+	}
+	if tournament.Status != tournamentdomain.StatusActive {
+		return nil, matchdomain.ErrTournamentNotActive
+	}
+
+	team, err := s.teamRepo.GetByID(ctx, m.TeamID)
+	if err != nil {
+		if errors.Is(err, teamdomain.ErrNotFound) {
+			return nil, fmt.Errorf("team not found")
+		}
+		return nil, fmt.Errorf("get team: %w", err)
+	}
+	if !team.CanSubmit(submitterID) {
+		return nil, matchdomain.ErrNotCaptain
+	}
+
+	playerStats, err := lineupStats(tournament, team, req.PlayerStats)
+	if err != nil {
+		return nil, err
+	}
+
+	revision, err := m.Resubmit(submitterID, req.TeamPlacement, req.TeamKills, playerStats, req.ScreenshotURL, req.Attachments, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("resubmit match: %w", err)
+	}
+	if err := s.sealCustody(ctx, m); err != nil {
+		return nil, err
+	}
+
+	if err := s.matchRepo.Resubmit(ctx, m, revision); err != nil {
+		return nil, fmt.Errorf("store resubmission: %w", err)
+	}
+
+	return matchToResponse(m), nil
+}
@@ -0,0 +1,142 @@
+package match
+
+import (
+	gamedomain "github.com/alejaam/tourney-rank/internal/domain/game"
+	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
+	playerdomain "github.com/alejaam/tourney-rank/internal/domain/player"
+)
+
+// matchLine is a player's line in a match with the placement of their team,
+// which rollups of the win are read from.
+type matchLine struct {
+	stats     *matchdomain.PlayerMatchStats
+	placement int
+}
+
+// summedStats returns what a player line adds to the player's summed stats:
+// the game's sum rollups and the custom stats counted under their own key.
+func summedStats(g *gamedomain.Game, line *matchLine) map[string]interface{} {
+	summed := make(map[string]interface{})
+	for key, r := range g.StatSchema.Rollups() {
+		if r.Aggregate != gamedomain.AggregateSum {
+			continue
+		}
+		if val := rollupSource(line, r.Source); isNumber(val) {
+			summed[key] = val
+		}
+	}
+
+	// Derived stats are computed and rollups accumulated, never reported
+	for key, val := range line.stats.CustomStats {
+		if countsCustomStat(g, key) {
+			summed[key] = val
+		}
+	}
+
+	return summed
+}
+
+// statDeltas returns how replacing one player line with another changes the
+// player's summed stats; either line may be nil. Stats reported with a
+// fractional value on either line cannot be adjusted by whole amounts and
+// are left out.
+func statDeltas(g *gamedomain.Game, removed, added *matchLine) map[string]int {
+	deltas := make(map[string]int)
+	fractional := make(map[string]bool)
+	apply := func(line *matchLine, sign int) {
+		if line == nil {
+			return
+		}
+		for key, val := range summedStats(g, line) {
+			if n, ok := wholeNumber(val); ok {
+				deltas[key] += sign * n
+			} else {
+				fractional[key] = true
+			}
+		}
+	}
+	apply(removed, -1)
+	apply(added, 1)
+
+	for key := range fractional {
+		delete(deltas, key)
+	}
+	return deltas
+}
+
+// rolledStats recomputes a player's max and average rollups for replacing
+// one player line with another in their stats, and the number of matches
+// each average then covers; either line may be nil. An average the stats do
+// not count matches for yet starts from its summed source when there is one
+// and otherwise from none of the matches, so players with matches from
+// before the average was kept are not diluted.
+func rolledStats(g *gamedomain.Game, stats *playerdomain.PlayerStats, removed, added *matchLine) (map[string]float64, map[string]int) {
+	rollups := g.StatSchema.Rollups()
+	rolled := make(map[string]float64)
+	samples := make(map[string]int)
+	for key, r := range rollups {
+		if r.Aggregate == gamedomain.AggregateSum {
+			continue
+		}
+
+		value, matches := stats.GetStatAsFloat(key), stats.RollupSamples[key]
+		if _, ok := stats.RollupSamples[key]; !ok && r.Aggregate == gamedomain.AggregateAvg {
+			value, matches = gamedomain.StartingValue(rollups, key, stats.Stats, stats.MatchesPlayed)
+		}
+		if removed != nil && matches > 0 {
+			matches--
+			source, _ := number(rollupSource(removed, r.Source))
+			value = r.Aggregate.Remove(value, source, matches)
+		}
+		if added != nil {
+			matches++
+			source, _ := number(rollupSource(added, r.Source))
+			value = r.Aggregate.Accumulate(value, source, matches)
+		}
+		rolled[key] = value
+		if r.Aggregate == gamedomain.AggregateAvg {
+			samples[key] = matches
+		}
+	}
+	return rolled, samples
+}
+
+// rollupSource returns the value a player line reports for a rollup source:
+// whether their team won, a core match field or a custom stat. A source the
+// line does not report counts as zero.
+func rollupSource(line *matchLine, source string) interface{} {
+	if source == gamedomain.SourceWon {
+		if line.placement == 1 {
+			return 1
+		}
+		return 0
+	}
+	if field := matchdomain.StatField(source); field.IsValid() {
+		return field.Value(*line.stats)
+	}
+	if val, ok := line.stats.CustomStats[source]; ok {
+		return val
+	}
+	return 0
+}
+
+// isNumber reports whether a reported stat is numeric.
+func isNumber(val interface{}) bool {
+	_, ok := number(val)
+	return ok
+}
+
+// number returns a numeric stat as a float64.
+func number(val interface{}) (float64, bool) {
+	switch v := val.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	}
+	return 0, false
+}
@@ -0,0 +1,81 @@
+package match
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+
+	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
+	teamdomain "github.com/alejaam/tourney-rank/internal/domain/team"
+)
+
+// ScoutReportResponse is opponent intel on a team built from its verified matches.
+type ScoutReportResponse struct {
+	TeamID        uuid.UUID                `json:"team_id"`
+	TeamName      string                   `json:"team_name"`
+	TournamentID  uuid.UUID                `json:"tournament_id"`
+	RecentMatches []MatchResponse          `json:"recent_matches"` // Newest first
+	Summary       matchdomain.ScoutSummary `json:"summary"`
+	Players       map[string]string        `json:"players"`                // Display names of the players in Summary and RecentMatches, keyed by player ID
+	HeadToHead    *matchdomain.HeadToHead  `json:"head_to_head,omitempty"` // From the requester's team's side; omitted when they have no other team in the tournament
+}
+
+// ScoutTeam returns a team's recent verified matches, its average placement,
+// how its kills are split between members and, when the requester plays for
+// another team in the same tournament, their head-to-head record against it.
+func (s *Service) ScoutTeam(ctx context.Context, teamID, requesterID uuid.UUID) (*ScoutReportResponse, error) {
+	t, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("get team: %w", err)
+	}
+
+	matches, err := s.matchRepo.GetByTeam(ctx, teamID.String(), matchdomain.StatusVerified, matchdomain.ScoutWindow, 0)
+	if err != nil {
+		return nil, fmt.Errorf("get team matches: %w", err)
+	}
+
+	recent := matches
+	if len(recent) > matchdomain.ScoutRecentMatches {
+		recent = recent[:matchdomain.ScoutRecentMatches]
+	}
+	responses := make([]MatchResponse, len(recent))
+	for i := range recent {
+		responses[i] = *matchToResponse(&recent[i])
+	}
+
+	names, err := s.playerNames(ctx, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &ScoutReportResponse{
+		TeamID:        t.ID,
+		TeamName:      t.Name,
+		TournamentID:  t.TournamentID,
+		RecentMatches: responses,
+		Summary:       matchdomain.Scout(matches),
+		Players:       names,
+	}
+
+	own, err := s.teamRepo.GetPlayerTeamInTournament(ctx, requesterID, t.TournamentID)
+	switch {
+	case errors.Is(err, teamdomain.ErrNotFound):
+		return report, nil
+	case err != nil:
+		return nil, fmt.Errorf("get requester team: %w", err)
+	case own.ID == t.ID:
+		return report, nil
+	}
+
+	ownMatches, err := s.matchRepo.GetByTeam(ctx, own.ID.String(), matchdomain.StatusVerified, matchdomain.ScoutWindow, 0)
+	if err != nil {
+		return nil, fmt.Errorf("get requester team matches: %w", err)
+	}
+
+	record := matchdomain.HeadToHeadRecord(ownMatches, matches, t.ID)
+	report.HeadToHead = &record
+
+	return report, nil
+}
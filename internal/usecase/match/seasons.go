@@ -0,0 +1,63 @@
+package match
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
+	playerdomain "github.com/alejaam/tourney-rank/internal/domain/player"
+	seasondomain "github.com/alejaam/tourney-rank/internal/domain/season"
+)
+
+// WithSeasons counts verified matches towards the season running when they
+// were verified, as well as towards the all-time stats.
+func (s *Service) WithSeasons(repo seasondomain.Repository) *Service {
+	s.seasons = repo
+	return s
+}
+
+// statsScopes returns the stats a match counts towards: all-time and, when a
+// season was running at the given time, that season's, each across all
+// modes and for the match's mode. Archived seasons are frozen and never
+// change, so a match verified during one is no longer counted into it.
+func (s *Service) statsScopes(ctx context.Context, m *matchdomain.Match, at time.Time) ([]playerdomain.StatsScope, error) {
+	modes := []string{""}
+	if m.Mode != "" {
+		modes = append(modes, m.Mode)
+	}
+
+	scopes := make([]playerdomain.StatsScope, 0, 2*len(modes))
+	for _, mode := range modes {
+		scopes = append(scopes, playerdomain.StatsScope{Mode: mode})
+	}
+
+	if s.seasons == nil {
+		return scopes, nil
+	}
+	current, err := s.seasons.GetCurrent(ctx, m.GameID, at)
+	if errors.Is(err, seasondomain.ErrNotFound) {
+		return scopes, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get current season: %w", err)
+	}
+	for _, mode := range modes {
+		scopes = append(scopes, playerdomain.StatsScope{Mode: mode, SeasonID: current.ID})
+	}
+
+	return scopes, nil
+}
+
+// countedAt returns when a match's stats were counted: when it was verified
+// or, for a match whose verification was just withdrawn, when the withdrawn
+// verification happened.
+func countedAt(m *matchdomain.Match) time.Time {
+	if m.VerifiedAt == nil && len(m.Reversals) > 0 {
+		if snapshot := m.Reversals[len(m.Reversals)-1].Snapshot; snapshot != nil {
+			return snapshot.VerifiedAt
+		}
+	}
+	return verifiedAt(m)
+}
@@ -4,33 +4,59 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/google/uuid"
 
+	activitydomain "github.com/alejaam/tourney-rank/internal/domain/activity"
+	gamedomain "github.com/alejaam/tourney-rank/internal/domain/game"
 	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
+	notedomain "github.com/alejaam/tourney-rank/internal/domain/note"
 	playerdomain "github.com/alejaam/tourney-rank/internal/domain/player"
 	rankingdomain "github.com/alejaam/tourney-rank/internal/domain/ranking"
+	seasondomain "github.com/alejaam/tourney-rank/internal/domain/season"
 	teamdomain "github.com/alejaam/tourney-rank/internal/domain/team"
 	tournamentdomain "github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/usecase/anomaly"
+	"github.com/alejaam/tourney-rank/internal/usecase/conduct"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
 	usecaseplayer "github.com/alejaam/tourney-rank/internal/usecase/player"
 )
 
 // Service provides match operations.
 type Service struct {
 	matchRepo       matchdomain.Repository
+	commentRepo     matchdomain.CommentRepository
 	teamRepo        teamdomain.Repository
 	tournamentRepo  tournamentdomain.Repository
+	gameRepo        gamedomain.Repository
 	playerRepo      playerdomain.Repository
 	playerStatsRepo playerdomain.StatsRepository
 	playerService   *usecaseplayer.Service
 	ranking         *rankingdomain.Service
+	activity        activitydomain.Repository
+	siteFeed        activitydomain.SiteFeedRepository
+	notes           notedomain.Repository
+	connectors      matchdomain.ConnectorRepository
+	nonces          matchdomain.NonceRepository
+	evidence        EvidenceHasher
+	heatmaps        heatmapCache
+	overlaps        teamdomain.RosterOverlapRepository
+	anomalies       *anomaly.Service
+	honors          matchdomain.HonorVoteRepository
+	conduct         *conduct.Service
+	seasons         seasondomain.Repository
+	corrections     matchdomain.CorrectionRepository
 }
 
 // NewService creates a new match service.
 func NewService(
 	matchRepo matchdomain.Repository,
+	commentRepo matchdomain.CommentRepository,
 	teamRepo teamdomain.Repository,
 	tournamentRepo tournamentdomain.Repository,
+	gameRepo gamedomain.Repository,
 	playerRepo playerdomain.Repository,
 	playerStatsRepo playerdomain.StatsRepository,
 	playerService *usecaseplayer.Service,
@@ -38,8 +64,10 @@ func NewService(
 ) *Service {
 	return &Service{
 		matchRepo:       matchRepo,
+		commentRepo:     commentRepo,
 		teamRepo:        teamRepo,
 		tournamentRepo:  tournamentRepo,
+		gameRepo:        gameRepo,
 		playerRepo:      playerRepo,
 		playerStatsRepo: playerStatsRepo,
 		playerService:   playerService,
@@ -47,6 +75,37 @@ func NewService(
 	}
 }
 
+// WithActivityLog records verified matches in the players' activity feeds.
+func (s *Service) WithActivityLog(repo activitydomain.Repository) *Service {
+	s.activity = repo
+	return s
+}
+
+// WithSiteFeed publishes verified matches to the public site-wide activity feed.
+func (s *Service) WithSiteFeed(repo activitydomain.SiteFeedRepository) *Service {
+	s.siteFeed = repo
+	return s
+}
+
+// WithNotes includes private admin notes in the admin review queue.
+func (s *Service) WithNotes(repo notedomain.Repository) *Service {
+	s.notes = repo
+	return s
+}
+
+// EvidenceHasher fingerprints the screenshot at a URL.
+type EvidenceHasher interface {
+	HashScreenshot(ctx context.Context, url string) (string, error)
+}
+
+// WithEvidenceHasher fetches and hashes every screenshot when a match is
+// submitted, so its chain-of-custody record covers the evidence files.
+// Without it only the reported stats are sealed.
+func (s *Service) WithEvidenceHasher(hasher EvidenceHasher) *Service {
+	s.evidence = hasher
+	return s
+}
+
 // PlayerStatsInput represents player stats in a match submission.
 type PlayerStatsInput struct {
 	PlayerID    uuid.UUID              `json:"player_id"`
@@ -60,13 +119,17 @@ type PlayerStatsInput struct {
 
 // SubmitMatchRequest represents a match submission request.
 type SubmitMatchRequest struct {
-	TournamentID  uuid.UUID          `json:"tournament_id"`
-	TeamID        uuid.UUID          `json:"team_id"`
-	GameID        uuid.UUID          `json:"game_id"`
-	TeamPlacement int                `json:"team_placement"`
-	TeamKills     int                `json:"team_kills"`
-	PlayerStats   []PlayerStatsInput `json:"player_stats"`
-	ScreenshotURL string             `json:"screenshot_url"`
+	TournamentID  uuid.UUID                `json:"tournament_id"`
+	TeamID        uuid.UUID                `json:"team_id"`
+	GameID        uuid.UUID                `json:"game_id"`
+	Mode          string                   `json:"mode,omitempty"`
+	Map           string                   `json:"map,omitempty"`
+	Playlist      string                   `json:"playlist,omitempty"`
+	TeamPlacement int                      `json:"team_placement"`
+	TeamKills     int                      `json:"team_kills"`
+	PlayerStats   []PlayerStatsInput       `json:"player_stats"`
+	ScreenshotURL string                   `json:"screenshot_url"`
+	Attachments   []matchdomain.Attachment `json:"attachments,omitempty"` // Additional evidence files and VOD links
 }
 
 // MatchResponse represents a match in API responses.
@@ -75,31 +138,55 @@ type MatchResponse struct {
 	TournamentID    uuid.UUID                      `json:"tournament_id"`
 	TeamID          uuid.UUID                      `json:"team_id"`
 	GameID          uuid.UUID                      `json:"game_id"`
+	Mode            string                         `json:"mode,omitempty"`
+	Map             string                         `json:"map,omitempty"`
+	Playlist        string                         `json:"playlist,omitempty"`
 	Status          string                         `json:"status"`
 	TeamPlacement   int                            `json:"team_placement"`
 	TeamKills       int                            `json:"team_kills"`
 	PlayerStats     []matchdomain.PlayerMatchStats `json:"player_stats"`
 	ScreenshotURL   string                         `json:"screenshot_url"`
+	Attachments     []matchdomain.Attachment       `json:"attachments"`         // All evidence, including ScreenshotURL
+	VODLinks        []matchdomain.VODLink          `json:"vod_links,omitempty"` // Timestamped deep links into attached VODs, for reviewers
 	RejectionReason string                         `json:"rejection_reason,omitempty"`
 	SubmittedBy     uuid.UUID                      `json:"submitted_by"`
 	CreatedAt       string                         `json:"created_at"`
 	UpdatedAt       string                         `json:"updated_at"`
 	VerifiedAt      *string                        `json:"verified_at,omitempty"`
 	VerifiedBy      *uuid.UUID                     `json:"verified_by,omitempty"`
+	Custody         *matchdomain.Custody           `json:"custody,omitempty"`           // Evidence hashes sealed at submission, for disputes
+	Snapshot        *matchdomain.Snapshot          `json:"verified_snapshot,omitempty"` // Result as originally verified
+	Amendments      []matchdomain.Amendment        `json:"amendments,omitempty"`        // Corrections since verification, oldest first
+	Reversals       []matchdomain.Reversal         `json:"reversals,omitempty"`         // Verifications withdrawn by admins
+	Revisions       []matchdomain.Revision         `json:"revisions,omitempty"`         // Rejected versions replaced by resubmissions, oldest first
+}
+
+// MatchDetailResponse is a match with the names of the team, tournament, game
+// and players it references.
+type MatchDetailResponse struct {
+	MatchResponse
+	TeamName       string            `json:"team_name"`
+	TournamentName string            `json:"tournament_name"`
+	GameName       string            `json:"game_name"`
+	Players        map[string]string `json:"players"` // Display names keyed by player ID
 }
 
 // MatchHistoryRequest represents a request for match history with pagination.
 type MatchHistoryRequest struct {
-	Limit  int `json:"limit"`
-	Offset int `json:"offset"`
+	Limit             int  `json:"limit"`
+	Offset            int  `json:"offset"`
+	IncludeUnverified bool `json:"include_unverified"` // Player history only: also return drafts and rejected reports
 }
 
 // MatchListResponse represents a list of matches in API responses.
 type MatchListResponse struct {
-	Matches []MatchResponse `json:"matches"`
-	Total   int             `json:"total"`
-	Limit   int             `json:"limit"`
-	Offset  int             `json:"offset"`
+	pagination.Page[MatchResponse]
+	Players  map[string]string             `json:"players"`            // Display names of every player in Items, keyed by player ID
+	Comments map[string][]CommentResponse  `json:"comments,omitempty"` // Comment threads keyed by match ID, in the admin review queue only
+	Notes    map[string][]*notedomain.Note `json:"notes,omitempty"`    // Private admin notes keyed by match ID, in the admin review queue only
+
+	// NewAccountFlags lists possible smurfs keyed by match ID, in the admin review queue only
+	NewAccountFlags map[string][]*playerdomain.SmurfFlag `json:"new_account_flags,omitempty"`
 }
 
 // VerifyMatchRequest represents a request to verify or reject a match.
@@ -108,8 +195,50 @@ type VerifyMatchRequest struct {
 	Reason   string `json:"reason,omitempty"`
 }
 
+// AmendMatchRequest corrects the result of a verified match. PlayerStats must
+// list the same players as the match.
+type AmendMatchRequest struct {
+	Reason        string             `json:"reason"`
+	TeamPlacement int                `json:"team_placement"`
+	TeamKills     int                `json:"team_kills"`
+	PlayerStats   []PlayerStatsInput `json:"player_stats"`
+}
+
 // SubmitMatch submits a new match report for verification.
-func (s *Service) SubmitMatch(ctx context.Context, req SubmitMatchRequest, captainID uuid.UUID) (*MatchResponse, error) {
+func (s *Service) SubmitMatch(ctx context.Context, req SubmitMatchRequest, submitterID uuid.UUID) (*MatchResponse, error) {
+	return s.submitMatch(ctx, req, submitterID, true)
+}
+
+// DryRunSubmission runs a match report through every check SubmitMatch makes,
+// as submitterID, and returns the draft that would be stored without storing
+// it. It is used to replay captured submissions.
+func (s *Service) DryRunSubmission(ctx context.Context, req SubmitMatchRequest, submitterID uuid.UUID) (*MatchResponse, error) {
+	m, err := s.prepareMatch(ctx, req, submitterID, true)
+	if err != nil {
+		return nil, err
+	}
+	return matchToResponse(m), nil
+}
+
+// submitMatch validates and stores a match report as a draft. When
+// checkSubmitter is set, submitterID must be allowed to submit for the team;
+// connector submissions are authenticated by signature instead.
+func (s *Service) submitMatch(ctx context.Context, req SubmitMatchRequest, submitterID uuid.UUID, checkSubmitter bool) (*MatchResponse, error) {
+	m, err := s.prepareMatch(ctx, req, submitterID, checkSubmitter)
+	if err != nil {
+		return nil, err
+	}
+
+	// Store match
+	if err := s.matchRepo.Create(ctx, m); err != nil {
+		return nil, fmt.Errorf("store match: %w", err)
+	}
+
+	return matchToResponse(m), nil
+}
+
+// prepareMatch validates a match report and builds the draft it becomes.
+func (s *Service) prepareMatch(ctx context.Context, req SubmitMatchRequest, submitterID uuid.UUID, checkSubmitter bool) (*matchdomain.Match, error) {
 	// Verify tournament exists and is active
 	tournament, err := s.tournamentRepo.GetByID(ctx, req.TournamentID)
 	if err != nil {
@@ -132,14 +261,64 @@ func (s *Service) SubmitMatch(ctx context.Context, req SubmitMatchRequest, capta
 		return nil, fmt.Errorf("get team: %w", err)
 	}
 
-	// Verify captain is the team captain
-	if team.CaptainID != captainID {
+	// Verify the submitter is the captain or a member granted the permission
+	if checkSubmitter && !team.CanSubmit(submitterID) {
 		return nil, matchdomain.ErrNotCaptain
 	}
 
-	// Convert player stats
-	playerStats := make([]matchdomain.PlayerMatchStats, len(req.PlayerStats))
-	for i, ps := range req.PlayerStats {
+	// Verify the game mode, map and playlist, if any, are defined for the game
+	if req.Mode != "" || req.Map != "" || req.Playlist != "" {
+		g, err := s.gameRepo.GetByID(ctx, req.GameID.String())
+		if err != nil {
+			return nil, fmt.Errorf("get game: %w", err)
+		}
+		if !g.HasMode(req.Mode) {
+			return nil, fmt.Errorf("%w: %s", gamedomain.ErrInvalidMode, req.Mode)
+		}
+		if err := g.ValidateMatchMetadata(req.Map, req.Playlist); err != nil {
+			return nil, err
+		}
+	}
+
+	playerStats, err := lineupStats(tournament, team, req.PlayerStats)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create match entity
+	m, err := matchdomain.NewMatch(
+		req.TournamentID,
+		req.TeamID,
+		req.GameID,
+		req.TeamPlacement,
+		req.TeamKills,
+		playerStats,
+		req.ScreenshotURL,
+		submitterID,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("create match: %w", err)
+	}
+	m.Mode = req.Mode
+	m.Map = req.Map
+	m.Playlist = req.Playlist
+	if err := m.SetAttachments(req.Attachments); err != nil {
+		return nil, err
+	}
+	if err := s.sealCustody(ctx, m); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// lineupStats converts reported player lines, checking that they cover a full
+// lineup of the team: the tournament's team size, or every member of a team
+// that has not filled it. Substitutes sit out.
+func lineupStats(tournament *tournamentdomain.Tournament, team *teamdomain.Team, inputs []PlayerStatsInput) ([]matchdomain.PlayerMatchStats, error) {
+	playerStats := make([]matchdomain.PlayerMatchStats, len(inputs))
+	reported := make(map[uuid.UUID]bool, len(inputs))
+	for i, ps := range inputs {
 		playerStats[i] = matchdomain.PlayerMatchStats{
 			PlayerID:    ps.PlayerID,
 			Kills:       ps.Kills,
@@ -161,34 +340,35 @@ func (s *Service) SubmitMatch(ctx context.Context, req SubmitMatchRequest, capta
 		if !found {
 			return nil, matchdomain.ErrPlayerNotInTeam
 		}
+		if reported[ps.PlayerID] {
+			return nil, fmt.Errorf("%w: player %s is listed more than once", matchdomain.ErrInvalidPlayerStats, ps.PlayerID)
+		}
+		reported[ps.PlayerID] = true
 	}
 
-	// Verify all team members have stats (if team size is defined)
-	if len(playerStats) != len(team.MemberIDs) {
+	if len(playerStats) != tournament.LineupSize(len(team.MemberIDs)) {
 		return nil, matchdomain.ErrTeamSizeMismatch
 	}
+	return playerStats, nil
+}
 
-	// Create match entity
-	m, err := matchdomain.NewMatch(
-		req.TournamentID,
-		req.TeamID,
-		req.GameID,
-		req.TeamPlacement,
-		req.TeamKills,
-		playerStats,
-		req.ScreenshotURL,
-		captainID,
-	)
-	if err != nil {
-		return nil, fmt.Errorf("create match: %w", err)
-	}
-
-	// Store match
-	if err := s.matchRepo.Create(ctx, m); err != nil {
-		return nil, fmt.Errorf("store match: %w", err)
+// sealCustody hashes the match's stats and, when a hasher is configured,
+// every screenshot as it exists right now.
+func (s *Service) sealCustody(ctx context.Context, m *matchdomain.Match) error {
+	var screenshots []matchdomain.EvidenceDigest
+	if s.evidence != nil {
+		for _, a := range m.Evidence() {
+			if a.Type != matchdomain.AttachmentScreenshot {
+				continue
+			}
+			hash, err := s.evidence.HashScreenshot(ctx, a.URL)
+			if err != nil {
+				return fmt.Errorf("%w: screenshot %s could not be retrieved: %v", matchdomain.ErrInvalidAttachment, a.URL, err)
+			}
+			screenshots = append(screenshots, matchdomain.EvidenceDigest{URL: a.URL, SHA256: hash})
+		}
 	}
-
-	return matchToResponse(m), nil
+	return m.SealCustody(screenshots, time.Now().UTC())
 }
 
 // GetMatchHistory retrieves a player's match history.
@@ -200,30 +380,34 @@ func (s *Service) GetMatchHistory(ctx context.Context, playerID uuid.UUID, req M
 		req.Limit = 100
 	}
 
-	// Get verified matches for the player
-	matches, err := s.matchRepo.GetByPlayer(ctx, playerID.String(), req.Limit, req.Offset)
+	// Only verified matches unless the caller asked for pending and rejected reports too
+	status := matchdomain.StatusVerified
+	if req.IncludeUnverified {
+		status = ""
+	}
+
+	matches, err := s.matchRepo.GetByPlayer(ctx, playerID.String(), status, req.Limit, req.Offset)
 	if err != nil {
 		return nil, fmt.Errorf("get player matches: %w", err)
 	}
-
-	// Filter only verified matches
-	var verifiedMatches []matchdomain.Match
-	for _, m := range matches {
-		if m.Status == matchdomain.StatusVerified {
-			verifiedMatches = append(verifiedMatches, m)
-		}
+	total, err := s.matchRepo.CountByPlayer(ctx, playerID.String(), status)
+	if err != nil {
+		return nil, fmt.Errorf("count player matches: %w", err)
 	}
 
-	responses := make([]MatchResponse, len(verifiedMatches))
-	for i, m := range verifiedMatches {
+	responses := make([]MatchResponse, len(matches))
+	for i, m := range matches {
 		responses[i] = *matchToResponse(&m)
 	}
 
+	names, err := s.playerNames(ctx, matches)
+	if err != nil {
+		return nil, err
+	}
+
 	return &MatchListResponse{
-		Matches: responses,
-		Total:   len(verifiedMatches),
-		Limit:   req.Limit,
-		Offset:  req.Offset,
+		Page:    pagination.New(responses, int64(total), int64(req.Limit), int64(req.Offset)),
+		Players: names,
 	}, nil
 }
 
@@ -236,29 +420,184 @@ func (s *Service) GetTournamentMatches(ctx context.Context, tournamentID uuid.UU
 		req.Limit = 100
 	}
 
-	matches, err := s.matchRepo.GetByTournament(ctx, tournamentID.String(), req.Limit, req.Offset)
+	matches, err := s.matchRepo.GetByTournament(ctx, tournamentID.String(), matchdomain.StatusVerified, req.Limit, req.Offset)
 	if err != nil {
 		return nil, fmt.Errorf("get tournament matches: %w", err)
 	}
+	total, err := s.matchRepo.CountByTournament(ctx, tournamentID.String(), matchdomain.StatusVerified)
+	if err != nil {
+		return nil, fmt.Errorf("count tournament matches: %w", err)
+	}
 
-	// Filter only verified matches
-	var verifiedMatches []matchdomain.Match
-	for _, m := range matches {
-		if m.Status == matchdomain.StatusVerified {
-			verifiedMatches = append(verifiedMatches, m)
+	responses := make([]MatchResponse, len(matches))
+	for i, m := range matches {
+		responses[i] = *matchToResponse(&m)
+	}
+
+	names, err := s.playerNames(ctx, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	return &MatchListResponse{
+		Page:    pagination.New(responses, int64(total), int64(req.Limit), int64(req.Offset)),
+		Players: names,
+	}, nil
+}
+
+// GetMatch retrieves a single match with denormalized names. Unverified
+// matches are only visible to admins and members of the submitting team;
+// anyone else gets ErrNotFound. viewerID is uuid.Nil for anonymous requests.
+func (s *Service) GetMatch(ctx context.Context, matchID, viewerID uuid.UUID, isAdmin bool) (*MatchDetailResponse, error) {
+	detail, err := s.matchRepo.GetDetail(ctx, matchID.String())
+	if err != nil {
+		return nil, fmt.Errorf("get match: %w", err)
+	}
+
+	t, err := s.visibleTeam(ctx, &detail.Match, viewerID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &MatchDetailResponse{
+		MatchResponse: *matchToResponse(&detail.Match),
+		GameName:      detail.GameName,
+		Players:       make(map[string]string, len(detail.PlayerNames)),
+	}
+	if t != nil {
+		resp.TeamName = t.Name
+	}
+	for id, name := range detail.PlayerNames {
+		resp.Players[id.String()] = name
+	}
+
+	tourney, err := s.tournamentRepo.GetByID(ctx, detail.TournamentID)
+	switch {
+	case err == nil:
+		resp.TournamentName = tourney.Name
+	case !errors.Is(err, tournamentdomain.ErrNotFound):
+		return nil, fmt.Errorf("get tournament: %w", err)
+	}
+
+	return resp, nil
+}
+
+// MatchPlayerResponse is one player's line from a match, set against their
+// averages in the match's game.
+type MatchPlayerResponse struct {
+	MatchID     uuid.UUID                    `json:"match_id"`
+	PlayerID    uuid.UUID                    `json:"player_id"`
+	DisplayName string                       `json:"display_name"`
+	Line        matchdomain.PlayerMatchStats `json:"line"`
+	KillShare   float64                      `json:"kill_share"` // Percentage of the team's kills
+	Averages    *playerdomain.Averages       `json:"averages"`   // Null before the player has stats in the game
+}
+
+// GetMatchPlayer retrieves a player's line from a match, with the same
+// visibility rules as GetMatch.
+func (s *Service) GetMatchPlayer(ctx context.Context, matchID, playerID, viewerID uuid.UUID, isAdmin bool) (*MatchPlayerResponse, error) {
+	m, err := s.matchRepo.GetByID(ctx, matchID.String())
+	if err != nil {
+		return nil, fmt.Errorf("get match: %w", err)
+	}
+
+	if _, err := s.visibleTeam(ctx, m, viewerID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	line, ok := m.PlayerLine(playerID)
+	if !ok {
+		return nil, matchdomain.ErrPlayerNotInMatch
+	}
+
+	resp := &MatchPlayerResponse{
+		MatchID:   m.ID,
+		PlayerID:  playerID,
+		Line:      line,
+		KillShare: m.KillShare(playerID),
+	}
+
+	p, err := s.playerRepo.GetByID(ctx, playerID.String())
+	switch {
+	case err == nil:
+		resp.DisplayName = p.DisplayName
+	case !errors.Is(err, playerdomain.ErrNotFound):
+		return nil, fmt.Errorf("get player: %w", err)
+	}
+
+	stats, err := s.playerStatsRepo.GetByPlayerAndGame(ctx, playerID, m.GameID)
+	switch {
+	case err == nil:
+		avg := stats.Averages()
+		resp.Averages = &avg
+	case !errors.Is(err, playerdomain.ErrStatsNotFound):
+		return nil, fmt.Errorf("get player stats: %w", err)
+	}
+
+	return resp, nil
+}
+
+// visibleTeam returns the match's submitting team, or ErrNotFound when the
+// viewer may not see the match. Unverified matches are only visible to admins
+// and members and staff of the submitting team. The team is nil if it no longer exists.
+func (s *Service) visibleTeam(ctx context.Context, m *matchdomain.Match, viewerID uuid.UUID, isAdmin bool) (*teamdomain.Team, error) {
+	// Teams key their documents by binary UUIDs, so they cannot be joined onto
+	// the match's string IDs in an aggregation.
+	t, err := s.teamRepo.GetByID(ctx, m.TeamID)
+	if err != nil && !errors.Is(err, teamdomain.ErrNotFound) {
+		return nil, fmt.Errorf("get team: %w", err)
+	}
+
+	if !m.IsVerified() && !isAdmin {
+		if t == nil || viewerID == uuid.Nil || !t.CanView(viewerID) {
+			return nil, matchdomain.ErrNotFound
 		}
 	}
 
-	responses := make([]MatchResponse, len(verifiedMatches))
-	for i, m := range verifiedMatches {
+	return t, nil
+}
+
+// GetTeamMatches retrieves a team's matches, optionally filtered by status.
+// Only members and staff of the team and admins may list them, since they include
+// submissions still awaiting review.
+func (s *Service) GetTeamMatches(ctx context.Context, teamID, viewerID uuid.UUID, isAdmin bool, status matchdomain.Status, req MatchHistoryRequest) (*MatchListResponse, error) {
+	if req.Limit == 0 {
+		req.Limit = 20
+	}
+	if req.Limit > 100 {
+		req.Limit = 100
+	}
+
+	t, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, fmt.Errorf("get team: %w", err)
+	}
+	if !isAdmin && !t.CanView(viewerID) {
+		return nil, matchdomain.ErrNotTeamMember
+	}
+
+	matches, err := s.matchRepo.GetByTeam(ctx, teamID.String(), status, req.Limit, req.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("get team matches: %w", err)
+	}
+	total, err := s.matchRepo.CountByTeam(ctx, teamID.String(), status)
+	if err != nil {
+		return nil, fmt.Errorf("count team matches: %w", err)
+	}
+
+	responses := make([]MatchResponse, len(matches))
+	for i, m := range matches {
 		responses[i] = *matchToResponse(&m)
 	}
 
+	names, err := s.playerNames(ctx, matches)
+	if err != nil {
+		return nil, err
+	}
+
 	return &MatchListResponse{
-		Matches: responses,
-		Total:   len(verifiedMatches),
-		Limit:   req.Limit,
-		Offset:  req.Offset,
+		Page:    pagination.New(responses, int64(total), int64(req.Limit), int64(req.Offset)),
+		Players: names,
 	}, nil
 }
 
@@ -275,26 +614,308 @@ func (s *Service) AdminVerifyMatch(ctx context.Context, matchID uuid.UUID, req V
 		if err := m.VerifyMatch(adminID); err != nil {
 			return nil, fmt.Errorf("verify match: %w", err)
 		}
-
-		// Update player stats after verification
-		if err := s.updatePlayerStatsFromMatch(ctx, m); err != nil {
-			return nil, fmt.Errorf("update player stats: %w", err)
-		}
 	} else {
 		if err := m.RejectMatch(adminID, req.Reason); err != nil {
 			return nil, fmt.Errorf("reject match: %w", err)
 		}
 	}
 
-	// Update match in repository
-	if err := s.matchRepo.Update(ctx, m); err != nil {
-		return nil, fmt.Errorf("update match: %w", err)
+	// Persist the review first; this fails if another admin reviewed it
+	// meanwhile, so only one review applies the match to player stats
+	if err := s.matchRepo.Review(ctx, m); err != nil {
+		return nil, fmt.Errorf("review match: %w", err)
+	}
+
+	var records []brokenRecord
+	if m.Status == matchdomain.StatusVerified {
+		records, err = s.updatePlayerStatsFromMatch(ctx, m)
+		if err != nil {
+			return nil, fmt.Errorf("update player stats: %w", err)
+		}
+	}
+
+	if m.Status == matchdomain.StatusRejected {
+		if err := s.recordLostDispute(ctx, m, "match report rejected", adminID); err != nil {
+			return nil, fmt.Errorf("record conduct: %w", err)
+		}
+	}
+
+	if m.Status == matchdomain.StatusVerified && s.overlaps != nil {
+		if err := s.flagRosterOverlaps(ctx, m); err != nil {
+			return nil, fmt.Errorf("flag roster overlaps: %w", err)
+		}
+	}
+
+	if m.Status == matchdomain.StatusVerified && s.activity != nil {
+		events := make([]*activitydomain.Event, len(m.PlayerStats))
+		for i, ps := range m.PlayerStats {
+			events[i] = activitydomain.NewMatchVerified(ps.PlayerID, m.ID, m.TournamentID, m.GameID, m.TeamPlacement, ps.Kills)
+		}
+		for _, r := range records {
+			events = append(events, activitydomain.NewPersonalRecord(r.playerID, m.ID, m.GameID, r.record.Kind, r.record.Record.Value, r.record.Previous.Value))
+		}
+		if err := s.activity.Create(ctx, events...); err != nil {
+			return nil, fmt.Errorf("record activity: %w", err)
+		}
+	}
+
+	if m.Status == matchdomain.StatusVerified && s.siteFeed != nil {
+		if err := s.publishVerifiedMatch(ctx, m); err != nil {
+			return nil, fmt.Errorf("publish activity: %w", err)
+		}
+		for _, r := range records {
+			if err := s.siteFeed.Append(ctx, activitydomain.NewSitePersonalRecord(r.playerID, m.ID, m.GameID, r.record.Kind, r.record.Record.Value)); err != nil {
+				return nil, fmt.Errorf("publish personal record: %w", err)
+			}
+		}
+	}
+
+	return matchToResponse(m), nil
+}
+
+// AmendMatch records an admin correction of a verified match. The verified
+// snapshot is kept as it was and the players' stats are adjusted by the
+// difference between the previous and the corrected result.
+func (s *Service) AmendMatch(ctx context.Context, matchID uuid.UUID, req AmendMatchRequest, adminID uuid.UUID) (*MatchResponse, error) {
+	m, err := s.matchRepo.GetByID(ctx, matchID.String())
+	if err != nil {
+		return nil, fmt.Errorf("get match: %w", err)
+	}
+
+	playerStats := make([]matchdomain.PlayerMatchStats, len(req.PlayerStats))
+	for i, ps := range req.PlayerStats {
+		playerStats[i] = matchdomain.PlayerMatchStats{
+			PlayerID:    ps.PlayerID,
+			Kills:       ps.Kills,
+			Damage:      ps.Damage,
+			Assists:     ps.Assists,
+			Deaths:      ps.Deaths,
+			Downs:       ps.Downs,
+			CustomStats: ps.CustomStats,
+		}
+	}
+
+	previous, previousPlacement := m.PlayerStats, m.TeamPlacement
+	amendment, err := m.Amend(adminID, req.Reason, req.TeamPlacement, req.TeamKills, playerStats, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("amend match: %w", err)
+	}
+
+	if err := s.matchRepo.Amend(ctx, m, amendment); err != nil {
+		return nil, fmt.Errorf("store amendment: %w", err)
+	}
+
+	if err := s.correctPlayerStats(ctx, m, previous, previousPlacement); err != nil {
+		return nil, fmt.Errorf("correct player stats: %w", err)
+	}
+
+	return matchToResponse(m), nil
+}
+
+// correctPlayerStats adjusts the stats tracked for a verified match's players
+// from the previous to the current result, overall and for the match's mode.
+func (s *Service) correctPlayerStats(ctx context.Context, m *matchdomain.Match, previous []matchdomain.PlayerMatchStats, previousPlacement int) error {
+	before := make(map[uuid.UUID]matchdomain.PlayerMatchStats, len(previous))
+	for _, ps := range previous {
+		before[ps.PlayerID] = ps
+	}
+
+	for i := range m.PlayerStats {
+		ps := &m.PlayerStats[i]
+		old := before[ps.PlayerID]
+		removed := &matchLine{stats: &old, placement: previousPlacement}
+		added := &matchLine{stats: ps, placement: m.TeamPlacement}
+		if err := s.adjustPlayerStats(ctx, m, ps.PlayerID, removed, added); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// adjustPlayerStats replaces the line one of a match's players had in the
+// match, removed, with added in every stats scope the match counted towards,
+// then refreshes their consistency and ranking. Without an added line the
+// match no longer counts for the player.
+func (s *Service) adjustPlayerStats(ctx context.Context, m *matchdomain.Match, playerID uuid.UUID, removed, added *matchLine) error {
+	g, err := s.gameRepo.GetByID(ctx, m.GameID.String())
+	if err != nil {
+		return fmt.Errorf("get game: %w", err)
+	}
+
+	deltas := statDeltas(g, removed, added)
+	matchesPlayed := 0
+	if added == nil {
+		matchesPlayed = -1
+	}
+
+	scopes, err := s.statsScopes(ctx, m, countedAt(m))
+	if err != nil {
+		return err
+	}
+
+	for _, scope := range scopes {
+		stats, err := s.playerStatsRepo.GetOrCreateForScope(ctx, playerID, m.GameID, scope)
+		if err != nil {
+			return fmt.Errorf("get or create player stats: %w", err)
+		}
+
+		rolled, samples := rolledStats(g, stats, removed, added)
+		if err := s.playerStatsRepo.AdjustStats(ctx, stats.ID, deltas, matchesPlayed); err != nil {
+			return fmt.Errorf("adjust player stats: %w", err)
+		}
+		if len(rolled) > 0 {
+			if err := s.playerStatsRepo.SetRollups(ctx, stats.ID, rolled, samples); err != nil {
+				return fmt.Errorf("update rolled up stats: %w", err)
+			}
+		}
+
+		if err := s.refreshDerivedStats(ctx, stats.ID, g); err != nil {
+			return fmt.Errorf("refresh derived stats: %w", err)
+		}
+
+		if err := s.refreshConsistency(ctx, stats.ID, playerID, scope.Mode, m); err != nil {
+			return fmt.Errorf("refresh consistency: %w", err)
+		}
+
+		if err := s.recalculatePlayerRanking(ctx, stats.ID, g); err != nil {
+			return fmt.Errorf("recalculate ranking: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UnverifyMatchRequest withdraws the verification of a match approved by mistake.
+type UnverifyMatchRequest struct {
+	Reason string `json:"reason"`
+}
+
+// UnverifyMatch reverts a verified match to draft. Its contribution to the
+// players' stats is subtracted, their consistency and ranking are recomputed
+// and, when the activity log is enabled, every team member is notified.
+func (s *Service) UnverifyMatch(ctx context.Context, matchID uuid.UUID, req UnverifyMatchRequest, adminID uuid.UUID) (*MatchResponse, error) {
+	m, err := s.matchRepo.GetByID(ctx, matchID.String())
+	if err != nil {
+		return nil, fmt.Errorf("get match: %w", err)
+	}
+
+	reversal, err := m.Unverify(adminID, req.Reason, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("unverify match: %w", err)
+	}
+
+	if err := s.matchRepo.Unverify(ctx, m, reversal); err != nil {
+		return nil, fmt.Errorf("store reversal: %w", err)
+	}
+
+	if err := s.reverseMatchStats(ctx, m); err != nil {
+		return nil, fmt.Errorf("reverse player stats: %w", err)
+	}
+
+	if err := s.recordLostDispute(ctx, m, "verified match unverified", adminID); err != nil {
+		return nil, fmt.Errorf("record conduct: %w", err)
+	}
+
+	if s.activity != nil {
+		t, err := s.teamRepo.GetByID(ctx, m.TeamID)
+		if err != nil {
+			return nil, fmt.Errorf("get team: %w", err)
+		}
+		events := make([]*activitydomain.Event, len(t.MemberIDs))
+		for i, memberID := range t.MemberIDs {
+			events[i] = activitydomain.NewMatchUnverified(memberID, m.ID, m.TournamentID, m.GameID, m.TeamID)
+		}
+		if err := s.activity.Create(ctx, events...); err != nil {
+			return nil, fmt.Errorf("record activity: %w", err)
+		}
+	}
+
+	return matchToResponse(m), nil
+}
+
+// VoidMatchRequest voids a verified match that should never have counted.
+type VoidMatchRequest struct {
+	Reason string `json:"reason"`
+}
+
+// VoidMatch withdraws a verified match for good. Everything its verification
+// added to the players' stats is subtracted, one match is taken off their
+// match counts, their consistency and ranking are recomputed and, when the
+// activity log is enabled, every team member is notified. Unlike
+// UnverifyMatch, the match does not go back to review.
+func (s *Service) VoidMatch(ctx context.Context, matchID uuid.UUID, req VoidMatchRequest, adminID uuid.UUID) (*MatchResponse, error) {
+	m, err := s.matchRepo.GetByID(ctx, matchID.String())
+	if err != nil {
+		return nil, fmt.Errorf("get match: %w", err)
+	}
+
+	reversal, err := m.Void(adminID, req.Reason, time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("void match: %w", err)
+	}
+
+	if err := s.matchRepo.Unverify(ctx, m, reversal); err != nil {
+		return nil, fmt.Errorf("store reversal: %w", err)
+	}
+
+	if err := s.reverseMatchStats(ctx, m); err != nil {
+		return nil, fmt.Errorf("reverse player stats: %w", err)
+	}
+
+	if s.activity != nil {
+		t, err := s.teamRepo.GetByID(ctx, m.TeamID)
+		if err != nil {
+			return nil, fmt.Errorf("get team: %w", err)
+		}
+		events := make([]*activitydomain.Event, len(t.MemberIDs))
+		for i, memberID := range t.MemberIDs {
+			events[i] = activitydomain.NewMatchVoided(memberID, m.ID, m.TournamentID, m.GameID, m.TeamID)
+		}
+		if err := s.activity.Create(ctx, events...); err != nil {
+			return nil, fmt.Errorf("record activity: %w", err)
+		}
 	}
 
 	return matchToResponse(m), nil
 }
 
-// GetUnverifiedMatches retrieves all unverified matches for admin review.
+// reverseMatchStats subtracts what verifying a match added to its players'
+// stats: the game's rollups, the custom stats that were counted and one match
+// played. Stats reported with a fractional value cannot be adjusted by whole
+// amounts and best-match rollups cannot be taken back; both are left as they
+// are.
+func (s *Service) reverseMatchStats(ctx context.Context, m *matchdomain.Match) error {
+	for i := range m.PlayerStats {
+		ps := &m.PlayerStats[i]
+		removed := &matchLine{stats: ps, placement: m.TeamPlacement}
+		if err := s.adjustPlayerStats(ctx, m, ps.PlayerID, removed, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// wholeNumber returns a reported custom stat as an int, if it is one.
+func wholeNumber(val interface{}) (int, bool) {
+	switch v := val.(type) {
+	case int:
+		return v, true
+	case int32:
+		return int(v), true
+	case int64:
+		return int(v), true
+	case float64:
+		if v == math.Trunc(v) {
+			return int(v), true
+		}
+	}
+	return 0, false
+}
+
+// GetUnverifiedMatches retrieves all unverified matches for admin review,
+// together with their comment threads and admin notes.
 func (s *Service) GetUnverifiedMatches(ctx context.Context, req MatchHistoryRequest) (*MatchListResponse, error) {
 	if req.Limit == 0 {
 		req.Limit = 20
@@ -307,70 +928,292 @@ func (s *Service) GetUnverifiedMatches(ctx context.Context, req MatchHistoryRequ
 	if err != nil {
 		return nil, fmt.Errorf("get unverified matches: %w", err)
 	}
+	total, err := s.matchRepo.CountUnverified(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count unverified matches: %w", err)
+	}
 
 	responses := make([]MatchResponse, len(matches))
 	for i, m := range matches {
 		responses[i] = *matchToResponse(&m)
 	}
 
+	names, err := s.playerNames(ctx, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	threads, err := s.threadsByMatch(ctx, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	notes, err := s.notesByMatch(ctx, matches)
+	if err != nil {
+		return nil, err
+	}
+
+	flags, err := s.newAccountFlagsByMatch(ctx, matches)
+	if err != nil {
+		return nil, err
+	}
+
 	return &MatchListResponse{
-		Matches: responses,
-		Total:   len(matches),
-		Limit:   req.Limit,
-		Offset:  req.Offset,
+		Page:            pagination.New(responses, int64(total), int64(req.Limit), int64(req.Offset)),
+		Players:         names,
+		Comments:        threads,
+		Notes:           notes,
+		NewAccountFlags: flags,
 	}, nil
 }
 
+// publishVerifiedMatch adds a verified match to the site-wide activity feed.
+func (s *Service) publishVerifiedMatch(ctx context.Context, m *matchdomain.Match) error {
+	tm, err := s.teamRepo.GetByID(ctx, m.TeamID)
+	if err != nil {
+		return fmt.Errorf("get team: %w", err)
+	}
+
+	t, err := s.tournamentRepo.GetByID(ctx, m.TournamentID)
+	if err != nil {
+		return fmt.Errorf("get tournament: %w", err)
+	}
+
+	return s.siteFeed.Append(ctx, activitydomain.NewSiteMatchVerified(m.ID, m.TournamentID, m.GameID, m.TeamID, t.Name, tm.Name, m.TeamPlacement, m.TeamKills))
+}
+
+// notesByMatch loads the admin notes on the given matches in one batch, keyed
+// by match ID. It returns nil if no note repository is configured.
+func (s *Service) notesByMatch(ctx context.Context, matches []matchdomain.Match) (map[string][]*notedomain.Note, error) {
+	if s.notes == nil {
+		return nil, nil
+	}
+
+	ids := make([]uuid.UUID, len(matches))
+	for i, m := range matches {
+		ids[i] = m.ID
+	}
+
+	notes, err := s.notes.ListBySubjects(ctx, notedomain.SubjectMatch, ids)
+	if err != nil {
+		return nil, fmt.Errorf("list notes: %w", err)
+	}
+
+	byMatch := make(map[string][]*notedomain.Note)
+	for _, n := range notes {
+		key := n.SubjectID.String()
+		byMatch[key] = append(byMatch[key], n)
+	}
+	return byMatch, nil
+}
+
+// playerNames looks up the display names of every player in the given matches in one batch.
+func (s *Service) playerNames(ctx context.Context, matches []matchdomain.Match) (map[string]string, error) {
+	var ids []string
+	seen := make(map[uuid.UUID]bool)
+	for _, m := range matches {
+		for _, ps := range m.PlayerStats {
+			if !seen[ps.PlayerID] {
+				seen[ps.PlayerID] = true
+				ids = append(ids, ps.PlayerID.String())
+			}
+		}
+	}
+
+	players, err := s.playerRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get players: %w", err)
+	}
+
+	names := make(map[string]string, len(players))
+	for _, p := range players {
+		names[p.ID.String()] = p.DisplayName
+	}
+	return names, nil
+}
+
+// brokenRecord is a personal best a player beat in a verified match.
+type brokenRecord struct {
+	playerID uuid.UUID
+	record   playerdomain.BrokenRecord
+}
+
 // updatePlayerStatsFromMatch updates player stats after match verification.
-func (s *Service) updatePlayerStatsFromMatch(ctx context.Context, m *matchdomain.Match) error {
-	for _, ps := range m.PlayerStats {
-		// Get or create player stats for this game
-		stats, err := s.playerStatsRepo.GetOrCreate(ctx, ps.PlayerID, m.GameID)
+// Matches played in a mode count towards both the overall and the mode's
+// stats, all-time and for the running season. It returns the all-time overall
+// personal records the match beat.
+func (s *Service) updatePlayerStatsFromMatch(ctx context.Context, m *matchdomain.Match) ([]brokenRecord, error) {
+	scopes, err := s.statsScopes(ctx, m, verifiedAt(m))
+	if err != nil {
+		return nil, err
+	}
+
+	var records []brokenRecord
+	for _, scope := range scopes {
+		broken, err := s.applyMatchStats(ctx, m, scope)
 		if err != nil {
-			return fmt.Errorf("get or create player stats: %w", err)
+			return nil, err
+		}
+		if scope == (playerdomain.StatsScope{}) {
+			records = broken
 		}
+	}
 
-		// Update stats from match
-		statsToAdd := map[string]interface{}{
-			"total_kills":   stats.GetStatAsInt("total_kills") + ps.Kills,
-			"total_damage":  stats.GetStatAsInt("total_damage") + ps.Damage,
-			"total_assists": stats.GetStatAsInt("total_assists") + ps.Assists,
-			"total_deaths":  stats.GetStatAsInt("total_deaths") + ps.Deaths,
-			"total_downs":   stats.GetStatAsInt("total_downs") + ps.Downs,
+	return records, nil
+}
+
+// applyMatchStats adds a match's player stats to the stats tracked for a
+// scope and returns the personal records it beat.
+func (s *Service) applyMatchStats(ctx context.Context, m *matchdomain.Match, scope playerdomain.StatsScope) ([]brokenRecord, error) {
+	g, err := s.gameRepo.GetByID(ctx, m.GameID.String())
+	if err != nil {
+		return nil, fmt.Errorf("get game: %w", err)
+	}
+
+	var records []brokenRecord
+	for _, ps := range m.PlayerStats {
+		// Get or create player stats for this game, mode and season
+		stats, err := s.playerStatsRepo.GetOrCreateForScope(ctx, ps.PlayerID, m.GameID, scope)
+		if err != nil {
+			return nil, fmt.Errorf("get or create player stats: %w", err)
 		}
 
-		// Add custom stats if present
-		for key, val := range ps.CustomStats {
-			if key != "total_kills" && key != "total_damage" && key != "total_assists" && key != "total_deaths" && key != "total_downs" {
-				statsToAdd[key] = val
+		// Update stats from match; IncrementStats adds the game's summed
+		// stats to the totals, its max and average stats are recomputed
+		line := &matchLine{stats: &ps, placement: m.TeamPlacement}
+		rolled, samples := rolledStats(g, stats, nil, line)
+		if err := s.playerStatsRepo.IncrementStats(ctx, stats.ID, summedStats(g, line)); err != nil {
+			return nil, fmt.Errorf("increment player stats: %w", err)
+		}
+		if len(rolled) > 0 {
+			if err := s.playerStatsRepo.SetRollups(ctx, stats.ID, rolled, samples); err != nil {
+				return nil, fmt.Errorf("update rolled up stats: %w", err)
 			}
 		}
 
-		// Increment stats
-		if err := s.playerStatsRepo.IncrementStats(ctx, stats.ID, statsToAdd); err != nil {
-			return fmt.Errorf("increment player stats: %w", err)
+		if err := s.refreshDerivedStats(ctx, stats.ID, g); err != nil {
+			return nil, fmt.Errorf("refresh derived stats: %w", err)
 		}
 
-		// Recalculate KD ratio and ranking
-		if err := recalculatePlayerRanking(ctx, ps.PlayerID, m.GameID, s.playerStatsRepo, s.ranking); err != nil {
-			return fmt.Errorf("recalculate ranking: %w", err)
+		broken := stats.ApplyMatchRecords(m.ID, ps.Kills, ps.Damage, m.TeamPlacement, verifiedAt(m))
+		if err := s.playerStatsRepo.UpdateRecords(ctx, stats.ID, stats.Records); err != nil {
+			return nil, fmt.Errorf("update personal records: %w", err)
+		}
+		for _, b := range broken {
+			records = append(records, brokenRecord{playerID: ps.PlayerID, record: b})
+		}
+
+		if err := s.refreshConsistency(ctx, stats.ID, ps.PlayerID, scope.Mode, m); err != nil {
+			return nil, fmt.Errorf("refresh consistency: %w", err)
+		}
+
+		if err := s.recalculatePlayerRanking(ctx, stats.ID, g); err != nil {
+			return nil, fmt.Errorf("recalculate ranking: %w", err)
 		}
 	}
 
-	return nil
+	return records, nil
+}
+
+// countsCustomStat reports whether a reported custom stat is added to the
+// player's totals under its own key. Rollups are accumulated from match values
+// and derived stats are computed, never reported.
+func countsCustomStat(g *gamedomain.Game, key string) bool {
+	return !g.StatSchema.IsRollup(key) && !g.StatSchema[key].IsDerived()
+}
+
+// verifiedAt returns when a match was verified, or now for a match without a
+// verification time.
+func verifiedAt(m *matchdomain.Match) time.Time {
+	if m.VerifiedAt != nil {
+		return *m.VerifiedAt
+	}
+	return time.Now().UTC()
+}
+
+// refreshDerivedStats recomputes the game's derived stats from a player's
+// updated totals. Games without derived stats are skipped.
+func (s *Service) refreshDerivedStats(ctx context.Context, statsID uuid.UUID, g *gamedomain.Game) error {
+	if !g.StatSchema.HasDerived() {
+		return nil
+	}
+
+	stats, err := s.playerStatsRepo.GetByID(ctx, statsID)
+	if err != nil {
+		return err
+	}
+
+	return s.playerStatsRepo.SetDerivedStats(ctx, statsID, g.DerivedStats(stats.Stats, stats.MatchesPlayed))
 }
 
-// recalculatePlayerRanking updates player ranking after stats change.
-func recalculatePlayerRanking(ctx context.Context, playerID, gameID uuid.UUID, statsRepo playerdomain.StatsRepository, ranking *rankingdomain.Service) error {
-	stats, err := statsRepo.GetByID(ctx, playerID)
+// refreshConsistency recomputes a player's consistency from their recent
+// verified matches. The match being verified is not persisted as verified yet,
+// so it is prepended to the stored history; an unverified match is left out.
+func (s *Service) refreshConsistency(ctx context.Context, statsID, playerID uuid.UUID, mode string, current *matchdomain.Match) error {
+	history, err := s.matchRepo.GetVerifiedByPlayerAndGame(ctx, playerID.String(), current.GameID.String(), mode, playerdomain.ConsistencyWindow-1)
+	if err != nil {
+		return fmt.Errorf("get verified matches: %w", err)
+	}
+
+	matches := make([]*matchdomain.Match, 0, len(history)+1)
+	if current.IsVerified() {
+		matches = append(matches, current)
+	}
+	for i := range history {
+		if history[i].ID != current.ID {
+			matches = append(matches, &history[i])
+		}
+	}
+
+	samples := make([]playerdomain.MatchSample, 0, len(matches))
+	for _, m := range matches {
+		for _, ps := range m.PlayerStats {
+			if ps.PlayerID == playerID {
+				samples = append(samples, playerdomain.MatchSample{
+					Kills:     float64(ps.Kills),
+					Placement: float64(m.TeamPlacement),
+				})
+				break
+			}
+		}
+	}
+
+	score, ok := playerdomain.ConsistencyScore(samples)
+	if !ok {
+		score = playerdomain.BaselineConsistency
+	}
+
+	return s.playerStatsRepo.UpdateConsistency(ctx, statsID, score, len(samples))
+}
+
+// recalculatePlayerRanking recomputes the ranking score and tier of a stats
+// document from its updated totals and stores them. A player's tier moving in
+// their all-time stats across all modes is recorded in their activity feed.
+// Without a ranking service, rankings are left as they are.
+func (s *Service) recalculatePlayerRanking(ctx context.Context, statsID uuid.UUID, g *gamedomain.Game) error {
+	if s.ranking == nil {
+		return nil
+	}
+
+	stats, err := s.playerStatsRepo.GetByID(ctx, statsID)
 	if err != nil {
 		return fmt.Errorf("get stats: %w", err)
 	}
 
-	// You would get the game here and recalculate
-	// For now, this is a placeholder for the ranking recalculation logic
-	_ = stats
-	_ = ranking
+	score, tier, err := s.ranking.CalculateRanking(ctx, stats, g)
+	if err != nil {
+		return fmt.Errorf("calculate ranking: %w", err)
+	}
+
+	if err := s.playerStatsRepo.UpdateRanking(ctx, stats.ID, score, s.ranking.RatingDeviation(stats, g), tier); err != nil {
+		return fmt.Errorf("update ranking: %w", err)
+	}
+
+	if tier != stats.Tier && stats.Mode == "" && stats.SeasonID == uuid.Nil && s.activity != nil {
+		if err := s.activity.Create(ctx, activitydomain.NewTierChanged(stats.PlayerID, stats.GameID, stats.Tier, tier)); err != nil {
+			return fmt.Errorf("record activity: %w", err)
+		}
+	}
 
 	return nil
 }
@@ -383,15 +1226,25 @@ func matchToResponse(m *matchdomain.Match) *MatchResponse {
 		TournamentID:    m.TournamentID,
 		TeamID:          m.TeamID,
 		GameID:          m.GameID,
+		Mode:            m.Mode,
+		Map:             m.Map,
+		Playlist:        m.Playlist,
 		Status:          string(m.Status),
 		TeamPlacement:   m.TeamPlacement,
 		TeamKills:       m.TeamKills,
 		PlayerStats:     m.PlayerStats,
 		ScreenshotURL:   m.ScreenshotURL,
+		Attachments:     m.Evidence(),
+		VODLinks:        m.VODLinks(),
 		RejectionReason: m.RejectionReason,
 		SubmittedBy:     m.SubmittedBy,
 		CreatedAt:       m.CreatedAt.Format("2006-01-02T15:04:05Z07:00"),
 		UpdatedAt:       m.UpdatedAt.Format("2006-01-02T15:04:05Z07:00"),
+		Custody:         m.Custody,
+		Snapshot:        m.VerifiedSnapshot,
+		Amendments:      m.Amendments,
+		Reversals:       m.Reversals,
+		Revisions:       m.Revisions,
 	}
 
 	if m.VerifiedAt != nil {
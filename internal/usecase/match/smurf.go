@@ -0,0 +1,59 @@
+package match
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+
+	matchdomain "github.com/alejaam/tourney-rank/internal/domain/match"
+	playerdomain "github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/usecase/anomaly"
+)
+
+// WithAnomalies flags new accounts performing far above their tier in the
+// admin review queue.
+func (s *Service) WithAnomalies(anomalies *anomaly.Service) *Service {
+	s.anomalies = anomalies
+	return s
+}
+
+// newAccountFlagsByMatch returns the flagged players of each match, keyed by
+// match ID. Players are checked once per game.
+func (s *Service) newAccountFlagsByMatch(ctx context.Context, matches []matchdomain.Match) (map[string][]*playerdomain.SmurfFlag, error) {
+	if s.anomalies == nil {
+		return nil, nil
+	}
+
+	playersByGame := make(map[uuid.UUID][]uuid.UUID)
+	seen := make(map[uuid.UUID]map[uuid.UUID]bool)
+	for _, m := range matches {
+		if seen[m.GameID] == nil {
+			seen[m.GameID] = make(map[uuid.UUID]bool)
+		}
+		for _, ps := range m.PlayerStats {
+			if !seen[m.GameID][ps.PlayerID] {
+				seen[m.GameID][ps.PlayerID] = true
+				playersByGame[m.GameID] = append(playersByGame[m.GameID], ps.PlayerID)
+			}
+		}
+	}
+
+	flagsByGame := make(map[uuid.UUID]map[uuid.UUID]*playerdomain.SmurfFlag, len(playersByGame))
+	for gameID, ids := range playersByGame {
+		flags, err := s.anomalies.SmurfFlags(ctx, gameID, ids)
+		if err != nil {
+			return nil, err
+		}
+		flagsByGame[gameID] = flags
+	}
+
+	byMatch := make(map[string][]*playerdomain.SmurfFlag)
+	for _, m := range matches {
+		for _, ps := range m.PlayerStats {
+			if flag, ok := flagsByGame[m.GameID][ps.PlayerID]; ok {
+				byMatch[m.ID.String()] = append(byMatch[m.ID.String()], flag)
+			}
+		}
+	}
+	return byMatch, nil
+}
@@ -0,0 +1,396 @@
+// Package matchmaking provides use cases for pickup scrim matchmaking.
+package matchmaking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/activity"
+	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/matchmaking"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/ranking"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/google/uuid"
+)
+
+// Event types pushed to players through the Notifier.
+const (
+	EventLobbyFormed    = "lobby_formed"
+	EventLobbyCompleted = "lobby_completed"
+)
+
+// Event is a matchmaking notification delivered to players.
+type Event struct {
+	Type    string      `json:"type"`
+	Payload interface{} `json:"payload"`
+}
+
+// Notifier delivers matchmaking events to connected players.
+type Notifier interface {
+	NotifyPlayers(playerIDs []uuid.UUID, event Event)
+}
+
+// Service provides matchmaking operations.
+type Service struct {
+	queueRepo       matchmaking.QueueRepository
+	lobbyRepo       matchmaking.LobbyRepository
+	gameRepo        game.Repository
+	teamRepo        team.Repository
+	playerStatsRepo player.StatsRepository
+	ranking         *ranking.Service
+	notifier        Notifier
+	matcherConfig   matchmaking.MatcherConfig
+	activity        activity.Repository
+	siteFeed        activity.SiteFeedRepository
+	players         player.Repository
+}
+
+// NewService creates a new matchmaking service.
+// The ranking service and notifier are optional and may be nil.
+func NewService(
+	queueRepo matchmaking.QueueRepository,
+	lobbyRepo matchmaking.LobbyRepository,
+	gameRepo game.Repository,
+	teamRepo team.Repository,
+	playerStatsRepo player.StatsRepository,
+	ranking *ranking.Service,
+	notifier Notifier,
+	matcherConfig matchmaking.MatcherConfig,
+) *Service {
+	return &Service{
+		queueRepo:       queueRepo,
+		lobbyRepo:       lobbyRepo,
+		gameRepo:        gameRepo,
+		teamRepo:        teamRepo,
+		playerStatsRepo: playerStatsRepo,
+		ranking:         ranking,
+		notifier:        notifier,
+		matcherConfig:   matcherConfig,
+	}
+}
+
+// WithActivityLog records tier changes from scrim results in the players' activity feeds.
+func (s *Service) WithActivityLog(repo activity.Repository) *Service {
+	s.activity = repo
+	return s
+}
+
+// WithSiteFeed publishes tier promotions from scrim results to the public site-wide activity feed.
+func (s *Service) WithSiteFeed(repo activity.SiteFeedRepository) *Service {
+	s.siteFeed = repo
+	return s
+}
+
+// JoinQueueRequest represents a request to join a matchmaking queue.
+type JoinQueueRequest struct {
+	GameID uuid.UUID  `json:"game_id"`
+	TeamID *uuid.UUID `json:"team_id,omitempty"` // Queue as a team (captain only)
+}
+
+// ReportLobbyResultRequest represents the outcome of a scrim lobby.
+type ReportLobbyResultRequest struct {
+	Results []matchmaking.EntryResult `json:"results"`
+}
+
+// JoinQueue adds a player, or the player's team, to the queue for a game.
+// The queue bracket is the entry's tier; teams use their highest member tier
+// and the average member rating.
+func (s *Service) JoinQueue(ctx context.Context, playerID uuid.UUID, req JoinQueueRequest) (*matchmaking.QueueEntry, error) {
+	g, err := s.gameRepo.GetByID(ctx, req.GameID.String())
+	if err != nil {
+		return nil, err
+	}
+	if !g.IsActive {
+		return nil, matchmaking.ErrGameNotActive
+	}
+
+	memberIDs := []uuid.UUID{playerID}
+	if req.TeamID != nil {
+		tm, err := s.teamRepo.GetByID(ctx, *req.TeamID)
+		if err != nil {
+			return nil, err
+		}
+		if !tm.IsCaptain(playerID) {
+			return nil, team.ErrNotCaptain
+		}
+		memberIDs = tm.MemberIDs
+	}
+
+	for _, memberID := range memberIDs {
+		_, err := s.queueRepo.GetActiveByPlayer(ctx, memberID)
+		if err == nil {
+			return nil, matchmaking.ErrAlreadyQueued
+		}
+		if !errors.Is(err, matchmaking.ErrEntryNotFound) {
+			return nil, err
+		}
+	}
+
+	tier := player.TierBeginner
+	var ratingSum float64
+	for _, memberID := range memberIDs {
+		memberTier, rating, err := s.playerRating(ctx, memberID, g.ID)
+		if err != nil {
+			return nil, err
+		}
+		if memberTier.Level() > tier.Level() {
+			tier = memberTier
+		}
+		ratingSum += rating
+	}
+
+	entry, err := matchmaking.NewQueueEntry(g.ID, playerID, req.TeamID, memberIDs, tier, ratingSum/float64(len(memberIDs)))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.queueRepo.Create(ctx, entry); err != nil {
+		return nil, err
+	}
+
+	return entry, nil
+}
+
+// LeaveQueue cancels the waiting entry the player queued.
+func (s *Service) LeaveQueue(ctx context.Context, playerID uuid.UUID) error {
+	entry, err := s.queueRepo.GetActiveByPlayer(ctx, playerID)
+	if err != nil {
+		return err
+	}
+	if entry.PlayerID != playerID {
+		return matchmaking.ErrNotEntryOwner
+	}
+
+	if err := entry.Cancel(); err != nil {
+		return err
+	}
+
+	return s.queueRepo.Update(ctx, entry)
+}
+
+// GetQueueStatus returns the player's active queue entry.
+func (s *Service) GetQueueStatus(ctx context.Context, playerID uuid.UUID) (*matchmaking.QueueEntry, error) {
+	return s.queueRepo.GetActiveByPlayer(ctx, playerID)
+}
+
+// GetLobby retrieves a lobby by ID.
+func (s *Service) GetLobby(ctx context.Context, id uuid.UUID) (*matchmaking.Lobby, error) {
+	return s.lobbyRepo.GetByID(ctx, id)
+}
+
+// RunMatchmaking forms lobbies for every bracket with waiting entries and
+// notifies the matched players. It returns the number of lobbies formed.
+func (s *Service) RunMatchmaking(ctx context.Context) (int, error) {
+	brackets, err := s.queueRepo.GetWaitingBrackets(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("get waiting brackets: %w", err)
+	}
+
+	formed := 0
+	for _, b := range brackets {
+		entries, err := s.queueRepo.GetWaiting(ctx, b.GameID, b.Tier)
+		if err != nil {
+			return formed, fmt.Errorf("get waiting entries: %w", err)
+		}
+
+		for _, group := range matchmaking.FormLobbies(entries, s.matcherConfig) {
+			lobby, err := s.createLobby(ctx, b, group)
+			if err != nil {
+				return formed, err
+			}
+			formed++
+
+			s.notify(lobby.PlayerIDs, Event{Type: EventLobbyFormed, Payload: lobby})
+		}
+	}
+
+	return formed, nil
+}
+
+// ReportLobbyResult records the outcome of a lobby and feeds it back into
+// the participants' stats and rankings.
+func (s *Service) ReportLobbyResult(ctx context.Context, lobbyID, reporterID uuid.UUID, req ReportLobbyResultRequest) (*matchmaking.Lobby, error) {
+	lobby, err := s.lobbyRepo.GetByID(ctx, lobbyID)
+	if err != nil {
+		return nil, err
+	}
+	if !lobby.HasPlayer(reporterID) {
+		return nil, matchmaking.ErrNotInLobby
+	}
+
+	entries, err := s.queueRepo.GetByLobbyID(ctx, lobbyID)
+	if err != nil {
+		return nil, fmt.Errorf("get lobby entries: %w", err)
+	}
+	entriesByID := make(map[uuid.UUID]*matchmaking.QueueEntry, len(entries))
+	for _, e := range entries {
+		entriesByID[e.ID] = e
+	}
+
+	for _, r := range req.Results {
+		entry, ok := entriesByID[r.EntryID]
+		if !ok {
+			return nil, matchmaking.ErrInvalidResults
+		}
+		for _, ps := range r.PlayerStats {
+			if !entry.HasMember(ps.PlayerID) {
+				return nil, matchmaking.ErrPlayerNotInResult
+			}
+		}
+	}
+
+	if err := lobby.Complete(req.Results, reporterID); err != nil {
+		return nil, err
+	}
+
+	if err := s.lobbyRepo.Update(ctx, lobby); err != nil {
+		return nil, err
+	}
+
+	for _, r := range lobby.Results {
+		entry := entriesByID[r.EntryID]
+		if err := s.applyEntryResult(ctx, lobby.GameID, entry, r); err != nil {
+			return nil, fmt.Errorf("apply lobby result: %w", err)
+		}
+
+		entry.MarkCompleted()
+		if err := s.queueRepo.Update(ctx, entry); err != nil {
+			return nil, fmt.Errorf("update queue entry: %w", err)
+		}
+	}
+
+	s.notify(lobby.PlayerIDs, Event{Type: EventLobbyCompleted, Payload: lobby})
+
+	return lobby, nil
+}
+
+// createLobby persists a lobby and marks its entries as matched.
+func (s *Service) createLobby(ctx context.Context, b matchmaking.Bracket, group []*matchmaking.QueueEntry) (*matchmaking.Lobby, error) {
+	lobby, err := matchmaking.NewLobby(b.GameID, b.Tier, group)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.lobbyRepo.Create(ctx, lobby); err != nil {
+		return nil, fmt.Errorf("create lobby: %w", err)
+	}
+
+	for _, e := range group {
+		if err := e.AssignLobby(lobby.ID); err != nil {
+			return nil, err
+		}
+		if err := s.queueRepo.Update(ctx, e); err != nil {
+			return nil, fmt.Errorf("update queue entry: %w", err)
+		}
+	}
+
+	return lobby, nil
+}
+
+// applyEntryResult adds scrim stats to every member of an entry and recalculates their ranking.
+func (s *Service) applyEntryResult(ctx context.Context, gameID uuid.UUID, entry *matchmaking.QueueEntry, result matchmaking.EntryResult) error {
+	won := 0
+	if result.Placement == 1 {
+		won = 1
+	}
+
+	for _, memberID := range entry.MemberIDs {
+		stats, err := s.playerStatsRepo.GetOrCreate(ctx, memberID, gameID)
+		if err != nil {
+			return fmt.Errorf("get or create player stats: %w", err)
+		}
+
+		statsToAdd := map[string]interface{}{
+			"scrims_played": 1,
+			"scrim_wins":    won,
+		}
+		for _, ps := range result.PlayerStats {
+			if ps.PlayerID != memberID {
+				continue
+			}
+			statsToAdd["total_kills"] = ps.Kills
+			statsToAdd["total_damage"] = ps.Damage
+			statsToAdd["total_assists"] = ps.Assists
+			statsToAdd["total_deaths"] = ps.Deaths
+			statsToAdd["total_downs"] = ps.Downs
+		}
+
+		if err := s.playerStatsRepo.IncrementStats(ctx, stats.ID, statsToAdd); err != nil {
+			return fmt.Errorf("increment player stats: %w", err)
+		}
+
+		if err := s.recalculateRanking(ctx, memberID, gameID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recalculateRanking refreshes a player's ranking score and tier from their stats.
+func (s *Service) recalculateRanking(ctx context.Context, playerID, gameID uuid.UUID) error {
+	if s.ranking == nil {
+		return nil
+	}
+
+	stats, err := s.playerStatsRepo.GetByPlayerAndGame(ctx, playerID, gameID)
+	if err != nil {
+		return fmt.Errorf("get player stats: %w", err)
+	}
+
+	g, err := s.gameRepo.GetByID(ctx, gameID.String())
+	if err != nil {
+		return fmt.Errorf("get game: %w", err)
+	}
+
+	score, tier, err := s.ranking.CalculateRanking(ctx, stats, g)
+	if err != nil {
+		return fmt.Errorf("calculate ranking: %w", err)
+	}
+
+	if err := s.playerStatsRepo.UpdateRanking(ctx, stats.ID, score, s.ranking.RatingDeviation(stats, g), tier); err != nil {
+		return err
+	}
+
+	if tier != stats.Tier && s.activity != nil {
+		if err := s.activity.Create(ctx, activity.NewTierChanged(playerID, gameID, stats.Tier, tier)); err != nil {
+			return fmt.Errorf("record activity: %w", err)
+		}
+	}
+
+	if tier.Level() > stats.Tier.Level() && s.siteFeed != nil {
+		if err := s.siteFeed.Append(ctx, activity.NewSiteTierPromoted(playerID, gameID, stats.Tier, tier)); err != nil {
+			return fmt.Errorf("publish activity: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// playerRating returns a player's tier and ranking score for a game.
+// Players without stats are treated as unranked beginners.
+func (s *Service) playerRating(ctx context.Context, playerID, gameID uuid.UUID) (player.Tier, float64, error) {
+	stats, err := s.playerStatsRepo.GetByPlayerAndGame(ctx, playerID, gameID)
+	if err != nil {
+		if errors.Is(err, player.ErrStatsNotFound) {
+			return player.TierBeginner, 0, nil
+		}
+		return "", 0, err
+	}
+
+	tier := stats.Tier
+	if tier.Level() < 0 {
+		tier = player.TierBeginner
+	}
+	return tier, stats.RankingScore, nil
+}
+
+// notify delivers an event if a notifier is configured.
+func (s *Service) notify(playerIDs []uuid.UUID, event Event) {
+	if s.notifier == nil {
+		return
+	}
+	s.notifier.NotifyPlayers(playerIDs, event)
+}
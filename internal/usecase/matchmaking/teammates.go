@@ -0,0 +1,105 @@
+package matchmaking
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+// MaxTeammateSuggestions caps how many teammates SuggestTeammates returns.
+const MaxTeammateSuggestions = 10
+
+// ErrSuggestionsUnavailable is returned when teammate suggestions are not configured.
+var ErrSuggestionsUnavailable = errors.New("teammate suggestions are not available")
+
+// TeammateSuggestion is a solo player waiting in the caller's bracket.
+type TeammateSuggestion struct {
+	PlayerID       uuid.UUID                   `json:"player_id"`
+	DisplayName    string                      `json:"display_name"`
+	Tier           player.Tier                 `json:"tier"`
+	Rating         float64                     `json:"rating"`
+	OverlapMinutes int                         `json:"overlap_minutes"` // Weekly minutes both are available
+	Overlap        []player.AvailabilityWindow `json:"overlap"`         // In UTC
+}
+
+// WithTeammateSuggestions lets players look for teammates among the solo
+// players waiting in their bracket.
+func (s *Service) WithTeammateSuggestions(players player.Repository) *Service {
+	s.players = players
+	return s
+}
+
+// SuggestTeammates returns the solo players waiting in the caller's bracket
+// for a game, the ones whose availability overlaps the caller's most first
+// and the closest in rating among equals.
+func (s *Service) SuggestTeammates(ctx context.Context, playerID, gameID uuid.UUID) ([]TeammateSuggestion, error) {
+	if s.players == nil {
+		return nil, ErrSuggestionsUnavailable
+	}
+	if _, err := s.gameRepo.GetByID(ctx, gameID.String()); err != nil {
+		return nil, err
+	}
+
+	me, err := s.players.GetByID(ctx, playerID.String())
+	if err != nil {
+		return nil, err
+	}
+	tier, rating, err := s.playerRating(ctx, playerID, gameID)
+	if err != nil {
+		return nil, err
+	}
+
+	waiting, err := s.queueRepo.GetWaiting(ctx, gameID, tier)
+	if err != nil {
+		return nil, fmt.Errorf("get waiting entries: %w", err)
+	}
+	ids := make([]string, 0, len(waiting))
+	entries := make(map[uuid.UUID]float64, len(waiting))
+	for _, e := range waiting {
+		if e.TeamID != nil || e.PlayerID == playerID {
+			continue
+		}
+		ids = append(ids, e.PlayerID.String())
+		entries[e.PlayerID] = e.Rating
+	}
+	if len(ids) == 0 {
+		return []TeammateSuggestion{}, nil
+	}
+
+	candidates, err := s.players.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("get players: %w", err)
+	}
+
+	now := time.Now().UTC()
+	suggestions := make([]TeammateSuggestion, 0, len(candidates))
+	for _, p := range candidates {
+		windows, minutes := player.AvailabilityOverlap(me.Availability, p.Availability, now)
+		suggestions = append(suggestions, TeammateSuggestion{
+			PlayerID:       p.ID,
+			DisplayName:    p.DisplayName,
+			Tier:           tier,
+			Rating:         entries[p.ID],
+			OverlapMinutes: minutes,
+			Overlap:        windows,
+		})
+	}
+
+	sort.Slice(suggestions, func(i, j int) bool {
+		a, b := suggestions[i], suggestions[j]
+		if a.OverlapMinutes != b.OverlapMinutes {
+			return a.OverlapMinutes > b.OverlapMinutes
+		}
+		return math.Abs(a.Rating-rating) < math.Abs(b.Rating-rating)
+	})
+	if len(suggestions) > MaxTeammateSuggestions {
+		suggestions = suggestions[:MaxTeammateSuggestions]
+	}
+	return suggestions, nil
+}
@@ -0,0 +1,48 @@
+package matchmaking
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Worker periodically runs the matchmaker in the background.
+type Worker struct {
+	service  *Service
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewWorker creates a matchmaking worker that runs every interval.
+func NewWorker(service *Service, interval time.Duration, logger *slog.Logger) *Worker {
+	return &Worker{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run blocks, forming lobbies on every tick until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	w.logger.Info("matchmaking worker started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("matchmaking worker stopped")
+			return
+		case <-ticker.C:
+			formed, err := w.service.RunMatchmaking(ctx)
+			if err != nil {
+				w.logger.Error("matchmaking run failed", "error", err)
+				continue
+			}
+			if formed > 0 {
+				w.logger.Info("matchmaking lobbies formed", "count", formed)
+			}
+		}
+	}
+}
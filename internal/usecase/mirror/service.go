@@ -0,0 +1,169 @@
+// Package mirror publishes static JSON snapshots of hot read endpoints so
+// that read traffic can be served from object storage or a CDN under load.
+package mirror
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/usecase/leaderboard"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
+	"github.com/alejaam/tourney-rank/internal/usecase/tournament"
+)
+
+// Store uploads a snapshot object. Implementations handle compression.
+type Store interface {
+	Put(ctx context.Context, key string, data []byte) error
+}
+
+// Manifest lists the API paths mirrored by the latest publish.
+type Manifest struct {
+	GeneratedAt time.Time         `json:"generated_at"`
+	Paths       map[string]string `json:"paths"` // API path to object key
+}
+
+// Service publishes snapshots of the default leaderboard page of every
+// active game and the standings of every active tournament. Each snapshot
+// holds exactly what the matching API path returns without query parameters.
+type Service struct {
+	store       Store
+	publicURL   string
+	maxAge      time.Duration
+	gameRepo    game.Repository
+	leaderboard *leaderboard.Service
+	tournaments *tournament.Service
+
+	mu          sync.RWMutex
+	paths       map[string]string
+	publishedAt time.Time
+}
+
+// NewService creates a snapshot publisher. publicURL is where the store's
+// objects are served from; snapshots older than maxAge are not redirected to.
+func NewService(store Store, publicURL string, maxAge time.Duration, gameRepo game.Repository, leaderboardService *leaderboard.Service, tournamentService *tournament.Service) *Service {
+	return &Service{
+		store:       store,
+		publicURL:   strings.TrimSuffix(publicURL, "/"),
+		maxAge:      maxAge,
+		gameRepo:    gameRepo,
+		leaderboard: leaderboardService,
+		tournaments: tournamentService,
+	}
+}
+
+// Publish uploads a snapshot for every mirrored path, then the manifest.
+// Paths that fail are left out of the manifest and are no longer redirected;
+// the first failure is returned after the rest have been attempted.
+func (s *Service) Publish(ctx context.Context) (int, error) {
+	objects, err := s.snapshots(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var errs []error
+	paths := make(map[string]string, len(objects))
+	for path, obj := range objects {
+		if err := s.store.Put(ctx, obj.key, obj.data); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		paths[path] = obj.key
+	}
+
+	manifest, err := json.Marshal(Manifest{GeneratedAt: time.Now().UTC(), Paths: paths})
+	if err != nil {
+		return 0, fmt.Errorf("encoding manifest: %w", err)
+	}
+	if err := s.store.Put(ctx, "manifest.json", manifest); err != nil {
+		errs = append(errs, err)
+	}
+
+	s.mu.Lock()
+	s.paths = paths
+	s.publishedAt = time.Now()
+	s.mu.Unlock()
+
+	return len(paths), errors.Join(errs...)
+}
+
+// MirrorURL returns the public URL of the snapshot for an API path, if the
+// latest publish included it and is still fresh.
+func (s *Service) MirrorURL(path string) (string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if time.Since(s.publishedAt) > s.maxAge {
+		return "", false
+	}
+	key, ok := s.paths[path]
+	if !ok {
+		return "", false
+	}
+	return s.publicURL + "/" + key, true
+}
+
+type object struct {
+	key  string
+	data []byte
+}
+
+// snapshots renders every mirrored path, keyed by API path.
+func (s *Service) snapshots(ctx context.Context) (map[string]object, error) {
+	objects := make(map[string]object)
+
+	games, err := s.gameRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list games: %w", err)
+	}
+	for _, g := range games {
+		if !g.IsActive {
+			continue
+		}
+		entries, gameName, total, err := s.leaderboard.GetLeaderboard(ctx, g.ID, player.StatsScope{}, player.LeaderboardSortScore, player.RankingOrdinal, leaderboard.DefaultPageSize, 0)
+		if err != nil {
+			return nil, fmt.Errorf("get leaderboard for game %s: %w", g.ID, err)
+		}
+		page := leaderboard.Page{
+			Page:     pagination.New(entries, total, leaderboard.DefaultPageSize, 0),
+			GameID:   g.ID.String(),
+			GameName: gameName,
+			Sort:     player.LeaderboardSortScore,
+			Ranking:  player.RankingOrdinal,
+		}
+		if err := add(objects, "/api/v1/leaderboard/"+g.ID.String(), "leaderboard/"+g.ID.String()+".json", page); err != nil {
+			return nil, err
+		}
+	}
+
+	active, err := s.tournaments.GetActiveTournaments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list active tournaments: %w", err)
+	}
+	for _, t := range active {
+		standings, err := s.tournaments.GetStandings(ctx, t.ID)
+		if err != nil {
+			return nil, fmt.Errorf("get standings for tournament %s: %w", t.ID, err)
+		}
+		if err := add(objects, "/api/v1/tournaments/"+t.ID.String()+"/standings", "tournaments/"+t.ID.String()+"/standings.json", standings); err != nil {
+			return nil, err
+		}
+	}
+
+	return objects, nil
+}
+
+func add(objects map[string]object, path, key string, v any) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("encoding %s: %w", key, err)
+	}
+	objects[path] = object{key: key, data: data}
+	return nil
+}
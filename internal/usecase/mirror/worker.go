@@ -0,0 +1,49 @@
+package mirror
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Worker periodically publishes mirror snapshots.
+type Worker struct {
+	service  *Service
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewWorker creates a snapshot publishing worker that runs every interval.
+func NewWorker(service *Service, interval time.Duration, logger *slog.Logger) *Worker {
+	return &Worker{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run blocks, publishing immediately and then on every tick until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	w.logger.Info("mirror publisher started", "interval", w.interval)
+
+	w.publish(ctx)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("mirror publisher stopped")
+			return
+		case <-ticker.C:
+			w.publish(ctx)
+		}
+	}
+}
+
+func (w *Worker) publish(ctx context.Context) {
+	if _, err := w.service.Publish(ctx); err != nil && ctx.Err() == nil {
+		w.logger.Error("mirror publish failed", "error", err)
+	}
+}
@@ -0,0 +1,256 @@
+package organization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/organization"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+)
+
+// ErrCircuitUnavailable is returned when series circuits are not configured.
+var ErrCircuitUnavailable = errors.New("series circuits are not available")
+
+// WithCircuit turns series into circuits: finished tournaments award points
+// toward a cumulative leaderboard, and the top teams qualify to the finals.
+func (s *Service) WithCircuit(teams team.Repository, matches match.Repository) *Service {
+	s.teams = teams
+	s.matches = matches
+	return s
+}
+
+// CircuitRequest sets a series' points table and finals.
+type CircuitRequest struct {
+	Points             []int      `json:"points"`                         // Points by placement, first place first
+	FinalsTournamentID *uuid.UUID `json:"finals_tournament_id,omitempty"` // One of the series' tournaments
+	FinalsSpots        int        `json:"finals_spots,omitempty"`
+}
+
+// SeriesStandingsResponse is a series' cumulative leaderboard.
+type SeriesStandingsResponse struct {
+	SeriesID    uuid.UUID                      `json:"series_id"`
+	Tournaments int                            `json:"tournaments"` // Finished tournaments counted, not including the finals
+	Standings   []organization.CircuitStanding `json:"standings"`
+}
+
+// SetCircuit sets a series' points table and finals. Only the owner and
+// admins can change it, and the finals must be one of the series'
+// tournaments.
+func (s *Service) SetCircuit(ctx context.Context, id, seriesID uuid.UUID, req CircuitRequest, requesterID uuid.UUID) (*organization.Series, error) {
+	if s.teams == nil {
+		return nil, ErrCircuitUnavailable
+	}
+
+	o, err := s.adminOrganization(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	series := o.FindSeries(seriesID)
+	if series == nil {
+		return nil, organization.ErrSeriesNotFound
+	}
+
+	if req.FinalsTournamentID != nil {
+		finals, err := s.tournaments.GetByID(ctx, *req.FinalsTournamentID)
+		if err != nil {
+			return nil, err
+		}
+		if finals.SeriesID == nil || *finals.SeriesID != seriesID {
+			return nil, organization.ErrFinalsNotInSeries
+		}
+	}
+
+	if err := series.SetCircuit(req.Points, req.FinalsTournamentID, req.FinalsSpots); err != nil {
+		return nil, err
+	}
+	o.UpdatedAt = time.Now().UTC()
+	if err := s.organizations.Update(ctx, o); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// GetSeriesStandings returns a series' cumulative leaderboard over its
+// finished tournaments.
+func (s *Service) GetSeriesStandings(ctx context.Context, id, seriesID uuid.UUID) (*SeriesStandingsResponse, error) {
+	if s.teams == nil {
+		return nil, ErrCircuitUnavailable
+	}
+
+	o, err := s.organizations.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	series := o.FindSeries(seriesID)
+	if series == nil {
+		return nil, organization.ErrSeriesNotFound
+	}
+
+	placements, played, err := s.placements(ctx, series)
+	if err != nil {
+		return nil, err
+	}
+	return &SeriesStandingsResponse{
+		SeriesID:    series.ID,
+		Tournaments: played,
+		Standings:   series.Standings(placements),
+	}, nil
+}
+
+// QualifyFinals registers the top teams of a series' circuit for its finals
+// when the finals tournament opens registration. Each qualified team is
+// registered with the captain and roster of its latest entry, or, when the
+// finals are invite-only, its captain is invited; teams with a player already
+// registered for the finals are skipped. Tournaments that are not a series'
+// finals, and finals already qualified, are left alone.
+func (s *Service) QualifyFinals(ctx context.Context, t *tournament.Tournament) error {
+	if s.teams == nil || t.SeriesID == nil {
+		return nil
+	}
+
+	o, err := s.organizations.GetBySeries(ctx, *t.SeriesID)
+	if err != nil {
+		if errors.Is(err, organization.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	series := o.FindSeries(*t.SeriesID)
+	if !series.IsFinals(t.ID) || series.QualifiedAt != nil {
+		return nil
+	}
+
+	placements, _, err := s.placements(ctx, series)
+	if err != nil {
+		return err
+	}
+
+	qualified := 0
+	for _, st := range series.Standings(placements) {
+		if qualified == series.FinalsSpots {
+			break
+		}
+		ok, err := s.qualify(ctx, t, st.TeamID)
+		if err != nil {
+			return err
+		}
+		if ok {
+			qualified++
+		}
+	}
+
+	if t.Rules.InviteOnly {
+		if err := s.tournaments.Update(ctx, t); err != nil {
+			return err
+		}
+	}
+
+	if err := series.Qualify(time.Now().UTC()); err != nil {
+		return err
+	}
+	o.UpdatedAt = *series.QualifiedAt
+	return s.organizations.Update(ctx, o)
+}
+
+// qualify registers a copy of a circuit team for the finals, or invites its
+// captain to invite-only finals. It reports false when one of the team's
+// players is already registered or the captain is already invited.
+func (s *Service) qualify(ctx context.Context, finals *tournament.Tournament, teamID uuid.UUID) (bool, error) {
+	entry, err := s.teams.GetByID(ctx, teamID)
+	if err != nil {
+		if errors.Is(err, team.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	for _, memberID := range entry.MemberIDs {
+		_, err := s.teams.GetPlayerTeamInTournament(ctx, memberID, finals.ID)
+		if err == nil {
+			return false, nil
+		}
+		if !errors.Is(err, team.ErrNotFound) {
+			return false, err
+		}
+	}
+
+	if finals.Rules.InviteOnly {
+		name := []rune(entry.Name)
+		if len(name) > tournament.MaxInviteNameLength {
+			name = name[:tournament.MaxInviteNameLength]
+		}
+		if _, err := finals.Invite(entry.CaptainID, string(name), time.Now().UTC()); err != nil {
+			if errors.Is(err, tournament.ErrAlreadyInvited) {
+				return false, nil
+			}
+			return false, err
+		}
+		return true, nil
+	}
+
+	tm, err := team.NewTeam(finals.ID, entry.CaptainID, entry.Name)
+	if err != nil {
+		return false, err
+	}
+	tm.SetTag(entry.Tag)
+	for _, memberID := range entry.MemberIDs {
+		if memberID != entry.CaptainID {
+			if err := tm.AddMember(memberID); err != nil {
+				return false, err
+			}
+		}
+	}
+	if err := s.teams.Create(ctx, tm); err != nil {
+		return false, fmt.Errorf("register qualified team: %w", err)
+	}
+	return true, nil
+}
+
+// placements returns the final ranks of every team in the series' finished
+// tournaments other than the finals, in the order the tournaments started,
+// and how many tournaments they came from.
+func (s *Service) placements(ctx context.Context, series *organization.Series) ([]organization.Placement, int, error) {
+	finished := tournament.StatusFinished
+	tournaments, err := s.tournaments.List(ctx, tournament.ListFilter{SeriesID: &series.ID, Status: &finished})
+	if err != nil {
+		return nil, 0, err
+	}
+	sort.SliceStable(tournaments, func(i, j int) bool { return tournaments[i].StartDate.Before(tournaments[j].StartDate) })
+
+	var placements []organization.Placement
+	played := 0
+	for _, t := range tournaments {
+		if series.IsFinals(t.ID) {
+			continue
+		}
+		played++
+
+		teams, err := s.teams.GetByTournamentID(ctx, t.ID)
+		if err != nil {
+			return nil, 0, err
+		}
+		names := make(map[uuid.UUID]string, len(teams))
+		for _, tm := range teams {
+			names[tm.ID] = tm.Name
+		}
+
+		matches, err := s.matches.GetByTournament(ctx, t.ID.String(), "", 0, 0)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, st := range match.TeamStandings(matches) {
+			name, ok := names[st.TeamID]
+			if !ok {
+				continue
+			}
+			placements = append(placements, organization.Placement{TournamentID: t.ID, TeamID: st.TeamID, TeamName: name, Rank: st.Rank})
+		}
+	}
+	return placements, played, nil
+}
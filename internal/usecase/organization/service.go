@@ -0,0 +1,192 @@
+// Package organization provides use cases for organizations, their staff and
+// the tournament series they run.
+package organization
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/organization"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+// Service handles organization use cases.
+type Service struct {
+	organizations organization.Repository
+	users         user.Repository
+	tournaments   tournament.Repository
+	teams         team.Repository
+	matches       match.Repository
+}
+
+// NewService creates a new organization service.
+func NewService(organizations organization.Repository, users user.Repository, tournaments tournament.Repository) *Service {
+	return &Service{
+		organizations: organizations,
+		users:         users,
+		tournaments:   tournaments,
+	}
+}
+
+// CreateOrganizationRequest represents the request to create an organization.
+type CreateOrganizationRequest struct {
+	Name string `json:"name"`
+}
+
+// AddStaffRequest represents the request to add a user to an organization's staff.
+type AddStaffRequest struct {
+	UserID uuid.UUID         `json:"user_id"`
+	Role   organization.Role `json:"role"` // "admin" or "organizer"
+}
+
+// CreateSeriesRequest represents the request to start a tournament series.
+type CreateSeriesRequest struct {
+	Name string `json:"name"`
+}
+
+// CreateOrganization creates an organization owned by the requester.
+func (s *Service) CreateOrganization(ctx context.Context, req CreateOrganizationRequest, ownerID uuid.UUID) (*organization.Organization, error) {
+	o, err := organization.New(req.Name, ownerID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.organizations.Create(ctx, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// GetOrganization retrieves an organization by ID.
+func (s *Service) GetOrganization(ctx context.Context, id uuid.UUID) (*organization.Organization, error) {
+	return s.organizations.GetByID(ctx, id)
+}
+
+// AddStaff adds a user to the organization's staff. Only the owner and
+// admins can manage staff.
+func (s *Service) AddStaff(ctx context.Context, id uuid.UUID, req AddStaffRequest, requesterID uuid.UUID) (*organization.Organization, error) {
+	o, err := s.adminOrganization(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify user exists
+	if _, err := s.users.GetByID(ctx, req.UserID.String()); err != nil {
+		return nil, err
+	}
+
+	if err := o.AddStaff(req.UserID, req.Role, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.organizations.Update(ctx, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// RemoveStaff removes a user from the organization's staff. The owner and
+// admins can remove anyone; staff can remove themselves.
+func (s *Service) RemoveStaff(ctx context.Context, id, userID, requesterID uuid.UUID) (*organization.Organization, error) {
+	o, err := s.organizations.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !o.IsAdmin(requesterID) && requesterID != userID {
+		return nil, organization.ErrNotAdmin
+	}
+
+	if err := o.RemoveStaff(userID, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.organizations.Update(ctx, o); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// CreateSeries starts a tournament series. Only the owner and admins can
+// start series.
+func (s *Service) CreateSeries(ctx context.Context, id uuid.UUID, req CreateSeriesRequest, requesterID uuid.UUID) (*organization.Series, error) {
+	o, err := s.adminOrganization(ctx, id, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	series, err := o.AddSeries(req.Name, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.organizations.Update(ctx, o); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// AddTournament adds a tournament to a series, giving the organization's
+// staff organizer permissions in it. The requester must both manage the
+// organization and organize the tournament, unless they are a site admin.
+func (s *Service) AddTournament(ctx context.Context, id, seriesID, tournamentID, requesterID uuid.UUID, isAdmin bool) (*tournament.Tournament, error) {
+	o, err := s.organizations.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !o.HasSeries(seriesID) {
+		return nil, organization.ErrSeriesNotFound
+	}
+
+	t, err := s.tournaments.GetByID(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin && (!o.IsAdmin(requesterID) || t.CreatedBy != requesterID) {
+		return nil, organization.ErrNotAdmin
+	}
+	if t.SeriesID != nil {
+		if *t.SeriesID == seriesID {
+			return t, nil
+		}
+		return nil, organization.ErrTournamentInSeries
+	}
+
+	t.SeriesID = &seriesID
+	t.UpdatedAt = time.Now().UTC()
+	if err := s.tournaments.Update(ctx, t); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Organizes reports whether a user organizes a tournament: its creator does,
+// and so does the staff of the organization running its series.
+func (s *Service) Organizes(ctx context.Context, t *tournament.Tournament, userID uuid.UUID) (bool, error) {
+	if t.CreatedBy == userID {
+		return true, nil
+	}
+	if t.SeriesID == nil || userID == uuid.Nil {
+		return false, nil
+	}
+
+	o, err := s.organizations.GetBySeries(ctx, *t.SeriesID)
+	if err != nil {
+		if errors.Is(err, organization.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return o.CanOrganize(userID), nil
+}
+
+func (s *Service) adminOrganization(ctx context.Context, id, requesterID uuid.UUID) (*organization.Organization, error) {
+	o, err := s.organizations.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if !o.IsAdmin(requesterID) {
+		return nil, organization.ErrNotAdmin
+	}
+	return o, nil
+}
@@ -0,0 +1,26 @@
+// Package pagination provides the envelope every list endpoint responds with,
+// so clients can page through any list the same way.
+package pagination
+
+// Page is a page of a list. Limit and Offset echo the page that was served;
+// a Limit of 0 means the list is not paginated and every item is returned.
+type Page[T any] struct {
+	Items  []T   `json:"items"`
+	Total  int64 `json:"total"` // Items in the whole list, not just this page
+	Limit  int64 `json:"limit"`
+	Offset int64 `json:"offset"`
+}
+
+// New returns a page of a list holding total items. Items is never nil, so
+// an empty page encodes as an empty array.
+func New[T any](items []T, total, limit, offset int64) Page[T] {
+	if items == nil {
+		items = []T{}
+	}
+	return Page[T]{Items: items, Total: total, Limit: limit, Offset: offset}
+}
+
+// All returns a list that is not paginated.
+func All[T any](items []T) Page[T] {
+	return New(items, int64(len(items)), 0, 0)
+}
@@ -0,0 +1,44 @@
+package pagination
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	p := New([]string{"a", "b"}, 5, 2, 2)
+	require.Equal(t, []string{"a", "b"}, p.Items)
+	require.Equal(t, int64(5), p.Total)
+	require.Equal(t, int64(2), p.Limit)
+	require.Equal(t, int64(2), p.Offset)
+
+	body, err := json.Marshal(New[string](nil, 0, 10, 0))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"items":[],"total":0,"limit":10,"offset":0}`, string(body))
+}
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	p := All([]int{1, 2, 3})
+	require.Equal(t, int64(3), p.Total)
+	require.Zero(t, p.Limit)
+	require.Zero(t, p.Offset)
+}
+
+func TestPage_Embedded(t *testing.T) {
+	t.Parallel()
+
+	type response struct {
+		Page[int]
+		Mode string `json:"mode"`
+	}
+
+	body, err := json.Marshal(response{Page: All([]int{1}), Mode: "solo"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"items":[1],"total":1,"limit":0,"offset":0,"mode":"solo"}`, string(body))
+}
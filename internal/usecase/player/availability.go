@@ -0,0 +1,75 @@
+package player
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+// MaxOverlapPlayers caps how many players one overlap query compares against.
+const MaxOverlapPlayers = 20
+
+// AvailabilityOverlap is when the caller and another player are both
+// available each week.
+type AvailabilityOverlap struct {
+	PlayerID    uuid.UUID                   `json:"player_id"`
+	DisplayName string                      `json:"display_name"`
+	Minutes     int                         `json:"minutes"` // Weekly minutes both are available
+	Windows     []player.AvailabilityWindow `json:"windows"` // In UTC
+}
+
+// SetMyAvailability replaces the authenticated user's availability; nil
+// clears it.
+func (s *Service) SetMyAvailability(ctx context.Context, userID uuid.UUID, availability *player.Availability) (*player.Player, error) {
+	p, err := s.playerRepo.GetByUserID(ctx, userID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.SetAvailability(availability); err != nil {
+		return nil, err
+	}
+	if err := s.playerRepo.Update(ctx, p); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// GetAvailabilityOverlap compares the authenticated user's availability with
+// each of the given players', most overlap first. Unknown players are left
+// out; players without availability overlap for zero minutes.
+func (s *Service) GetAvailabilityOverlap(ctx context.Context, userID uuid.UUID, playerIDs []uuid.UUID) ([]AvailabilityOverlap, error) {
+	me, err := s.playerRepo.GetByUserID(ctx, userID.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(playerIDs) > MaxOverlapPlayers {
+		playerIDs = playerIDs[:MaxOverlapPlayers]
+	}
+
+	ids := make([]string, len(playerIDs))
+	for i, id := range playerIDs {
+		ids[i] = id.String()
+	}
+	others, err := s.playerRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	overlaps := make([]AvailabilityOverlap, 0, len(others))
+	for _, p := range others {
+		windows, minutes := player.AvailabilityOverlap(me.Availability, p.Availability, now)
+		overlaps = append(overlaps, AvailabilityOverlap{
+			PlayerID:    p.ID,
+			DisplayName: p.DisplayName,
+			Minutes:     minutes,
+			Windows:     windows,
+		})
+	}
+	sort.SliceStable(overlaps, func(i, j int) bool { return overlaps[i].Minutes > overlaps[j].Minutes })
+	return overlaps, nil
+}
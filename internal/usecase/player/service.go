@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/usecase/fieldset"
 	"github.com/google/uuid"
 )
 
@@ -30,6 +31,8 @@ type UpdateProfileRequest struct {
 	Region            string            `json:"region,omitempty"`
 	PreferredPlatform string            `json:"preferred_platform,omitempty"`
 	Language          string            `json:"language,omitempty"`
+	HideActivity      *bool             `json:"hide_activity,omitempty"`
+	HideFromBoards    *bool             `json:"hide_from_leaderboards,omitempty"`
 }
 
 // CreateProfileRequest represents the data needed to create a player profile.
@@ -74,6 +77,16 @@ func (s *Service) GetMyProfile(ctx context.Context, userID uuid.UUID) (*player.P
 	return p, nil
 }
 
+// GetMyProfileFields gets the authenticated user's player profile with only
+// the fields in fields, without loading the others.
+func (s *Service) GetMyProfileFields(ctx context.Context, userID uuid.UUID, fields fieldset.Set) (any, error) {
+	p, err := s.playerRepo.GetByUserIDFields(ctx, userID.String(), fields.Fields())
+	if err != nil {
+		return nil, err
+	}
+	return fields.Project(p)
+}
+
 // UpdateMyProfile updates the player profile for the authenticated user.
 func (s *Service) UpdateMyProfile(ctx context.Context, userID uuid.UUID, req UpdateProfileRequest) (*player.Player, error) {
 	// Get existing player
@@ -97,6 +110,13 @@ func (s *Service) UpdateMyProfile(ctx context.Context, userID uuid.UUID, req Upd
 		}
 	}
 
+	if req.HideActivity != nil {
+		p.SetHideActivity(*req.HideActivity)
+	}
+	if req.HideFromBoards != nil {
+		p.SetHideFromBoards(*req.HideFromBoards)
+	}
+
 	// Save to repository
 	if err := s.playerRepo.Update(ctx, p); err != nil {
 		return nil, err
@@ -0,0 +1,223 @@
+// Package season provides use cases for ranked seasons.
+package season
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/season"
+	"github.com/alejaam/tourney-rank/internal/usecase/leaderboard"
+	"github.com/google/uuid"
+)
+
+// Service provides season operations.
+type Service struct {
+	seasonRepo  season.Repository
+	gameRepo    game.Repository
+	leaderboard *leaderboard.Service
+}
+
+// NewService creates a new season service. Final standings are read from the
+// leaderboard service and archived with every player identified; when served
+// they withhold the players the live leaderboards withhold at that time.
+func NewService(seasonRepo season.Repository, gameRepo game.Repository, leaderboard *leaderboard.Service) *Service {
+	return &Service{
+		seasonRepo:  seasonRepo,
+		gameRepo:    gameRepo,
+		leaderboard: leaderboard,
+	}
+}
+
+// CreateSeasonRequest represents the data needed to schedule a season.
+type CreateSeasonRequest struct {
+	Name     string    `json:"name,omitempty"` // Defaults to "Season <number>"
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+}
+
+// SeasonResponse is a season with its current status.
+type SeasonResponse struct {
+	*season.Season
+	Status season.Status `json:"status"`
+}
+
+// RolloverResult summarizes a season rollover run.
+type RolloverResult struct {
+	Archived int `json:"archived"` // Seasons whose standings were archived
+	Started  int `json:"started"`  // Seasons started to follow them
+}
+
+// CreateSeason schedules a game's next season. It is numbered after the
+// game's latest season and must not overlap any of them.
+func (s *Service) CreateSeason(ctx context.Context, gameID, createdBy uuid.UUID, req CreateSeasonRequest) (*SeasonResponse, error) {
+	if _, err := s.gameRepo.GetByID(ctx, gameID.String()); err != nil {
+		return nil, err
+	}
+
+	existing, err := s.seasonRepo.ListByGame(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("list seasons: %w", err)
+	}
+	number := 1
+	for _, other := range existing {
+		if other.Number >= number {
+			number = other.Number + 1
+		}
+	}
+
+	created, err := season.NewSeason(gameID, createdBy, number, req.Name, req.StartsAt, req.EndsAt)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.seasonRepo.Create(ctx, created); err != nil {
+		return nil, err
+	}
+
+	return toResponse(created, time.Now().UTC()), nil
+}
+
+// ListSeasons returns a game's seasons, newest first.
+func (s *Service) ListSeasons(ctx context.Context, gameID uuid.UUID) ([]*SeasonResponse, error) {
+	if _, err := s.gameRepo.GetByID(ctx, gameID.String()); err != nil {
+		return nil, err
+	}
+
+	seasons, err := s.seasonRepo.ListByGame(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("list seasons: %w", err)
+	}
+
+	now := time.Now().UTC()
+	responses := make([]*SeasonResponse, 0, len(seasons))
+	for _, ss := range seasons {
+		resp, err := s.respond(ctx, ss, now)
+		if err != nil {
+			return nil, err
+		}
+		responses = append(responses, resp)
+	}
+	return responses, nil
+}
+
+// GetSeason returns a season, with its final standings once archived.
+func (s *Service) GetSeason(ctx context.Context, id uuid.UUID) (*SeasonResponse, error) {
+	ss, err := s.seasonRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.respond(ctx, ss, time.Now().UTC())
+}
+
+// RollOver closes every season that has ended: it starts the season that
+// follows and archives the ended season's final standings. Every player
+// begins the new season level because its stats are kept apart from the
+// ended season's; all-time stats carry on untouched. The season is archived
+// last, so a run that fails part way is repeated in full by the next one.
+func (s *Service) RollOver(ctx context.Context) (*RolloverResult, error) {
+	now := time.Now().UTC()
+
+	ended, err := s.seasonRepo.ListEnded(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("list ended seasons: %w", err)
+	}
+
+	result := &RolloverResult{}
+	for _, ss := range ended {
+		started, err := s.rollOver(ctx, ss, now)
+		if err != nil {
+			return result, fmt.Errorf("roll over season %s: %w", ss.ID, err)
+		}
+		result.Archived++
+		if started {
+			result.Started++
+		}
+	}
+
+	return result, nil
+}
+
+// rollOver closes one ended season. It reports whether a following season
+// was started; none is when an admin already scheduled one in its place.
+func (s *Service) rollOver(ctx context.Context, ss *season.Season, now time.Time) (bool, error) {
+	standings, err := s.standings(ctx, ss)
+	if err != nil {
+		return false, fmt.Errorf("final standings: %w", err)
+	}
+
+	started := true
+	if err := s.seasonRepo.Create(ctx, ss.Next()); err != nil {
+		if !errors.Is(err, season.ErrOverlap) {
+			return false, fmt.Errorf("start next season: %w", err)
+		}
+		started = false
+	}
+
+	if err := ss.Archive(standings, now); err != nil {
+		return false, err
+	}
+	if err := s.seasonRepo.Update(ctx, ss); err != nil {
+		return false, fmt.Errorf("archive season: %w", err)
+	}
+
+	return started, nil
+}
+
+// standings reads a season's top players off its leaderboard, identifying
+// every player; they are anonymized when served.
+func (s *Service) standings(ctx context.Context, ss *season.Season) ([]season.Standing, error) {
+	entries, err := s.leaderboard.Standings(ctx, ss.GameID, player.StatsScope{SeasonID: ss.ID}, season.MaxStandings)
+	if err != nil {
+		return nil, err
+	}
+
+	standings := make([]season.Standing, 0, len(entries))
+	for _, entry := range entries {
+		standings = append(standings, season.Standing{
+			Rank:          entry.Rank,
+			PlayerID:      entry.PlayerID,
+			DisplayName:   entry.DisplayName,
+			RankingScore:  entry.RankingScore,
+			Tier:          player.Tier(entry.Tier),
+			MatchesPlayed: entry.MatchesPlayed,
+		})
+	}
+	return standings, nil
+}
+
+// respond converts a season to a response with its status at now, withholding
+// the archived standings of players hidden from public leaderboards.
+func (s *Service) respond(ctx context.Context, ss *season.Season, now time.Time) (*SeasonResponse, error) {
+	if len(ss.Standings) == 0 {
+		return toResponse(ss, now), nil
+	}
+
+	ids := make([]uuid.UUID, len(ss.Standings))
+	for i, st := range ss.Standings {
+		ids[i] = st.PlayerID
+	}
+	hidden, err := s.leaderboard.HiddenPlayers(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("hidden players: %w", err)
+	}
+
+	served := *ss
+	served.Standings = make([]season.Standing, len(ss.Standings))
+	for i, st := range ss.Standings {
+		if hidden[st.PlayerID] {
+			st.PlayerID = uuid.Nil
+			st.DisplayName = leaderboard.AnonymousDisplayName
+			st.Anonymous = true
+		}
+		served.Standings[i] = st
+	}
+	return toResponse(&served, now), nil
+}
+
+// toResponse converts a season to a response with its status at now.
+func toResponse(ss *season.Season, now time.Time) *SeasonResponse {
+	return &SeasonResponse{Season: ss, Status: ss.Status(now)}
+}
@@ -0,0 +1,48 @@
+package season
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Worker periodically rolls over ended seasons in the background.
+type Worker struct {
+	service  *Service
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewWorker creates a season rollover worker that runs every interval.
+func NewWorker(service *Service, interval time.Duration, logger *slog.Logger) *Worker {
+	return &Worker{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run blocks, rolling over seasons on every tick until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	w.logger.Info("season worker started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("season worker stopped")
+			return
+		case <-ticker.C:
+			result, err := w.service.RollOver(ctx)
+			if err != nil {
+				w.logger.Error("season rollover failed", "error", err)
+				continue
+			}
+			if result.Archived > 0 {
+				w.logger.Info("seasons rolled over", "archived", result.Archived, "started", result.Started)
+			}
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package stats
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+// ErrInvalidCohortWeeks is returned when a cohort query asks for a non-positive number of weeks.
+var ErrInvalidCohortWeeks = errors.New("weeks must be positive")
+
+// CohortService generates and serves weekly retention cohort reports.
+type CohortService struct {
+	playerRepo player.Repository
+	matchRepo  match.Repository
+	reportRepo player.CohortRepository
+}
+
+// NewCohortService creates a new cohort service.
+func NewCohortService(playerRepo player.Repository, matchRepo match.Repository, reportRepo player.CohortRepository) *CohortService {
+	return &CohortService{
+		playerRepo: playerRepo,
+		matchRepo:  matchRepo,
+		reportRepo: reportRepo,
+	}
+}
+
+// Generate builds a cohort report for players who signed up within the last
+// CohortWindowWeeks weeks and stores it.
+func (s *CohortService) Generate(ctx context.Context) (*player.CohortReport, error) {
+	now := time.Now().UTC()
+	since := player.WeekStart(now).AddDate(0, 0, -7*(player.CohortWindowWeeks-1))
+
+	players, err := s.playerRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get players: %w", err)
+	}
+
+	signups := make(map[uuid.UUID]time.Time)
+	for _, p := range players {
+		if !p.CreatedAt.Before(since) {
+			signups[p.ID] = p.CreatedAt
+		}
+	}
+
+	weeks, err := s.matchRepo.GetPlayerActiveWeeks(ctx, since)
+	if err != nil {
+		return nil, fmt.Errorf("get player active weeks: %w", err)
+	}
+
+	activeWeeks := make(map[uuid.UUID][]time.Time)
+	for _, w := range weeks {
+		if _, ok := signups[w.PlayerID]; ok {
+			activeWeeks[w.PlayerID] = append(activeWeeks[w.PlayerID], w.Week)
+		}
+	}
+
+	report := &player.CohortReport{
+		ID:          uuid.New(),
+		GeneratedAt: now,
+		Cohorts:     player.BuildCohorts(signups, activeWeeks, now),
+	}
+	if err := s.reportRepo.Save(ctx, report); err != nil {
+		return nil, fmt.Errorf("save cohort report: %w", err)
+	}
+
+	return report, nil
+}
+
+// Latest returns the most recent cohort report, trimmed to the newest weeks
+// cohorts when weeks is non-zero.
+func (s *CohortService) Latest(ctx context.Context, weeks int) (*player.CohortReport, error) {
+	if weeks < 0 {
+		return nil, ErrInvalidCohortWeeks
+	}
+
+	report, err := s.reportRepo.GetLatest(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if weeks > 0 && len(report.Cohorts) > weeks {
+		report.Cohorts = report.Cohorts[len(report.Cohorts)-weeks:]
+	}
+	return report, nil
+}
+
+// CohortWorker periodically regenerates the cohort report.
+type CohortWorker struct {
+	service  *CohortService
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewCohortWorker creates a cohort report worker that runs every interval.
+func NewCohortWorker(service *CohortService, interval time.Duration, logger *slog.Logger) *CohortWorker {
+	return &CohortWorker{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run blocks, regenerating the cohort report on every tick until ctx is cancelled.
+func (w *CohortWorker) Run(ctx context.Context) {
+	w.logger.Info("cohort report worker started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("cohort report worker stopped")
+			return
+		case <-ticker.C:
+			report, err := w.service.Generate(ctx)
+			if err != nil {
+				w.logger.Error("cohort report generation failed", "error", err)
+				continue
+			}
+			w.logger.Info("cohort report generated", "cohorts", len(report.Cohorts))
+		}
+	}
+}
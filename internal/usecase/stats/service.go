@@ -0,0 +1,161 @@
+// Package stats provides platform-wide statistics for public pages.
+package stats
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+)
+
+// popularGamesLimit caps how many games the overview lists.
+const popularGamesLimit = 5
+
+// Overview is a snapshot of platform-wide activity.
+type Overview struct {
+	TotalPlayers        int64         `json:"total_players"`
+	MatchesVerifiedWeek int           `json:"matches_verified_this_week"`
+	ActiveTournaments   int           `json:"active_tournaments"`
+	PopularGames        []PopularGame `json:"popular_games"`
+	GeneratedAt         time.Time     `json:"generated_at"`
+}
+
+// PopularGame is a game ranked by how many players have stats in it.
+type PopularGame struct {
+	ID                uuid.UUID `json:"id"`
+	Name              string    `json:"name"`
+	Slug              string    `json:"slug"`
+	Players           int64     `json:"players"`
+	ActiveTournaments int       `json:"active_tournaments"`
+}
+
+// Service computes and caches the platform overview. The snapshot is
+// refreshed by a background worker; requests only read the cached copy.
+type Service struct {
+	playerRepo      player.Repository
+	playerStatsRepo player.StatsRepository
+	matchRepo       match.Repository
+	tournamentRepo  tournament.Repository
+	gameRepo        game.Repository
+
+	mu       sync.RWMutex
+	overview *Overview
+}
+
+// NewService creates a new stats service.
+func NewService(
+	playerRepo player.Repository,
+	playerStatsRepo player.StatsRepository,
+	matchRepo match.Repository,
+	tournamentRepo tournament.Repository,
+	gameRepo game.Repository,
+) *Service {
+	return &Service{
+		playerRepo:      playerRepo,
+		playerStatsRepo: playerStatsRepo,
+		matchRepo:       matchRepo,
+		tournamentRepo:  tournamentRepo,
+		gameRepo:        gameRepo,
+	}
+}
+
+// Overview returns the cached snapshot, computing it on first use.
+func (s *Service) Overview(ctx context.Context) (*Overview, error) {
+	s.mu.RLock()
+	overview := s.overview
+	s.mu.RUnlock()
+
+	if overview != nil {
+		return overview, nil
+	}
+	return s.Refresh(ctx)
+}
+
+// Refresh recomputes the overview and replaces the cached snapshot.
+func (s *Service) Refresh(ctx context.Context) (*Overview, error) {
+	now := time.Now().UTC()
+
+	totalPlayers, err := s.playerRepo.Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("count players: %w", err)
+	}
+
+	verified, err := s.matchRepo.CountVerifiedSince(ctx, player.WeekStart(now))
+	if err != nil {
+		return nil, fmt.Errorf("count verified matches: %w", err)
+	}
+
+	active, err := s.tournamentRepo.GetActiveTournaments(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get active tournaments: %w", err)
+	}
+
+	popular, err := s.popularGames(ctx, active)
+	if err != nil {
+		return nil, err
+	}
+
+	overview := &Overview{
+		TotalPlayers:        totalPlayers,
+		MatchesVerifiedWeek: verified,
+		ActiveTournaments:   len(active),
+		PopularGames:        popular,
+		GeneratedAt:         now,
+	}
+
+	s.mu.Lock()
+	s.overview = overview
+	s.mu.Unlock()
+
+	return overview, nil
+}
+
+// popularGames ranks active games by player count.
+func (s *Service) popularGames(ctx context.Context, activeTournaments []*tournament.Tournament) ([]PopularGame, error) {
+	games, err := s.gameRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get games: %w", err)
+	}
+
+	tournamentsByGame := make(map[uuid.UUID]int)
+	for _, t := range activeTournaments {
+		tournamentsByGame[t.GameID]++
+	}
+
+	popular := make([]PopularGame, 0, len(games))
+	for _, g := range games {
+		if !g.IsActive {
+			continue
+		}
+		players, err := s.playerStatsRepo.CountByGame(ctx, g.ID, player.StatsScope{})
+		if err != nil {
+			return nil, fmt.Errorf("count players for game %s: %w", g.ID, err)
+		}
+		popular = append(popular, PopularGame{
+			ID:                g.ID,
+			Name:              g.Name,
+			Slug:              g.Slug,
+			Players:           players,
+			ActiveTournaments: tournamentsByGame[g.ID],
+		})
+	}
+
+	sort.Slice(popular, func(i, j int) bool {
+		if popular[i].Players != popular[j].Players {
+			return popular[i].Players > popular[j].Players
+		}
+		return popular[i].Name < popular[j].Name
+	})
+	if len(popular) > popularGamesLimit {
+		popular = popular[:popularGamesLimit]
+	}
+
+	return popular, nil
+}
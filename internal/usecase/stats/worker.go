@@ -0,0 +1,49 @@
+package stats
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Worker periodically refreshes the cached platform overview.
+type Worker struct {
+	service  *Service
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewWorker creates an overview refresh worker that runs every interval.
+func NewWorker(service *Service, interval time.Duration, logger *slog.Logger) *Worker {
+	return &Worker{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run blocks, refreshing the overview immediately and then on every tick until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	w.logger.Info("stats overview worker started", "interval", w.interval)
+
+	w.refresh(ctx)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("stats overview worker stopped")
+			return
+		case <-ticker.C:
+			w.refresh(ctx)
+		}
+	}
+}
+
+func (w *Worker) refresh(ctx context.Context) {
+	if _, err := w.service.Refresh(ctx); err != nil && ctx.Err() == nil {
+		w.logger.Error("stats overview refresh failed", "error", err)
+	}
+}
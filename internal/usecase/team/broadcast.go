@@ -0,0 +1,72 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/activity"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/google/uuid"
+)
+
+// ErrBroadcastsUnavailable is returned when team broadcasts are not configured.
+var ErrBroadcastsUnavailable = errors.New("team broadcasts are not available")
+
+// WithBroadcasts lets captains broadcast messages to their teams. Broadcasts
+// reach members and staff through their activity feeds when an activity log
+// is configured.
+func (s *Service) WithBroadcasts(repo team.BroadcastRepository) *Service {
+	s.broadcasts = repo
+	return s
+}
+
+// BroadcastRequest represents a captain's message to their team.
+type BroadcastRequest struct {
+	Message string `json:"message"`
+}
+
+// Broadcast sends a captain's message to the rest of the team. A team may send
+// team.BroadcastsPerWindow broadcasts per team.BroadcastWindow.
+func (s *Service) Broadcast(ctx context.Context, teamID uuid.UUID, req BroadcastRequest, captainID uuid.UUID) (*team.Broadcast, error) {
+	if s.broadcasts == nil {
+		return nil, ErrBroadcastsUnavailable
+	}
+
+	tm, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	b, err := team.NewBroadcast(tm, captainID, req.Message, now)
+	if err != nil {
+		return nil, err
+	}
+
+	sent, err := s.broadcasts.CountSince(ctx, tm.ID, now.Add(-team.BroadcastWindow))
+	if err != nil {
+		return nil, err
+	}
+	if sent >= team.BroadcastsPerWindow {
+		return nil, team.ErrBroadcastRateLimited
+	}
+
+	if err := s.broadcasts.Create(ctx, b); err != nil {
+		return nil, err
+	}
+
+	if s.activity != nil && b.Recipients > 0 {
+		events := make([]*activity.Event, 0, b.Recipients)
+		for _, recipientID := range tm.Recipients() {
+			if recipientID != captainID {
+				events = append(events, activity.NewTeamBroadcast(recipientID, tm.ID, tm.TournamentID, tm.Name, b.Message))
+			}
+		}
+		if err := s.activity.Create(ctx, events...); err != nil {
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
@@ -0,0 +1,150 @@
+package team
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+)
+
+// WithTeamCleanup enables tournaments' cleanup policies, which count the
+// teams' verified matches.
+func (s *Service) WithTeamCleanup(matches match.Repository) *Service {
+	s.matches = matches
+	return s
+}
+
+// CleanupResult counts the teams a cleanup run changed.
+type CleanupResult struct {
+	Flagged   int // Teams newly flagged, or flagged for another reason
+	Cleared   int // Teams whose flag was lifted
+	Disbanded int // Flagged teams disbanded by an AutoDisband policy
+}
+
+// CleanupTeams applies the cleanup policy of every open and active
+// tournament. Flags are lifted from teams that take part again; with
+// AutoDisband, flagged teams are disbanded instead, freeing their slots and
+// invitations.
+func (s *Service) CleanupTeams(ctx context.Context) (CleanupResult, error) {
+	var result CleanupResult
+	if s.matches == nil {
+		return result, nil
+	}
+
+	for _, status := range []tournament.Status{tournament.StatusOpen, tournament.StatusActive} {
+		tournaments, err := s.tournamentRepo.GetByStatus(ctx, status)
+		if err != nil {
+			return result, err
+		}
+		for _, t := range tournaments {
+			if t.Rules.Cleanup == nil {
+				continue
+			}
+			if err := s.cleanupTournament(ctx, t, &result); err != nil {
+				return result, err
+			}
+		}
+	}
+	return result, nil
+}
+
+func (s *Service) cleanupTournament(ctx context.Context, t *tournament.Tournament, result *CleanupResult) error {
+	teams, err := s.teamRepo.GetByTournamentID(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+	matches, err := s.matches.GetByTournament(ctx, t.ID.String(), "", 0, 0)
+	if err != nil {
+		return err
+	}
+
+	verified := make(map[uuid.UUID]int, len(teams))
+	round := 0
+	for _, m := range matches {
+		if m.Status != match.StatusVerified {
+			continue
+		}
+		verified[m.TeamID]++
+		round = max(round, verified[m.TeamID])
+	}
+
+	now := time.Now().UTC()
+	policy := t.Rules.Cleanup
+	closed := t.RegistrationClosed(now)
+	roundReached := round >= policy.Round()
+
+	for _, tm := range teams {
+		reason := tm.CleanupCheck(closed, roundReached, verified[tm.ID])
+		changed := tm.SetCleanupFlag(reason, now)
+
+		switch {
+		case reason != "" && policy.AutoDisband:
+			if err := tm.UpdateStatus(team.StatusDisbanded); err != nil {
+				return err
+			}
+			result.Disbanded++
+		case !changed:
+			continue
+		case reason != "":
+			result.Flagged++
+		default:
+			result.Cleared++
+		}
+		if err := s.teamRepo.Update(ctx, tm); err != nil {
+			return err
+		}
+		if tm.Status == team.StatusDisbanded {
+			if err := s.recordHistory(ctx, team.NewCleanup(tm.ID, reason)); err != nil {
+				return err
+			}
+			if err := s.releaseInvite(ctx, tm); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// CleanupWorker periodically applies tournaments' team cleanup policies.
+type CleanupWorker struct {
+	service  *Service
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewCleanupWorker creates a team cleanup worker that runs every interval.
+func NewCleanupWorker(service *Service, interval time.Duration, logger *slog.Logger) *CleanupWorker {
+	return &CleanupWorker{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run blocks, cleaning up teams on every tick until ctx is cancelled.
+func (w *CleanupWorker) Run(ctx context.Context) {
+	w.logger.Info("team cleanup worker started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("team cleanup worker stopped")
+			return
+		case <-ticker.C:
+			result, err := w.service.CleanupTeams(ctx)
+			if err != nil && ctx.Err() == nil {
+				w.logger.Error("team cleanup failed", "error", err)
+			}
+			if result != (CleanupResult{}) {
+				w.logger.Info("teams cleaned up", "flagged", result.Flagged, "cleared", result.Cleared, "disbanded", result.Disbanded)
+			}
+		}
+	}
+}
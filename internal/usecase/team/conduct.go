@@ -0,0 +1,55 @@
+package team
+
+import (
+	"context"
+
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/usecase/conduct"
+	"github.com/google/uuid"
+)
+
+// WithConduct enforces tournaments' minimum conduct score on registration
+// and shows organizers each member's conduct score.
+func (s *Service) WithConduct(conduct *conduct.Service) *Service {
+	s.conduct = conduct
+	return s
+}
+
+// checkConductEligibility verifies the player's conduct score meets the
+// tournament's minimum.
+func (s *Service) checkConductEligibility(ctx context.Context, t *tournament.Tournament, playerID uuid.UUID) error {
+	if s.conduct == nil || !t.Rules.HasConductRequirement() {
+		return nil
+	}
+
+	scores, err := s.conduct.Scores(ctx, []uuid.UUID{playerID})
+	if err != nil {
+		return err
+	}
+	if score, ok := scores[playerID]; ok && !t.IsConductEligible(score) {
+		return tournament.ErrConductNotEligible
+	}
+	return nil
+}
+
+// scoreConduct sets each member's conduct score.
+func (s *Service) scoreConduct(ctx context.Context, members []*TeamMemberInfo) error {
+	if s.conduct == nil {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(members))
+	for i, m := range members {
+		ids[i] = m.PlayerID
+	}
+	scores, err := s.conduct.Scores(ctx, ids)
+	if err != nil {
+		return err
+	}
+	for _, m := range members {
+		if score, ok := scores[m.PlayerID]; ok {
+			m.Conduct = &score
+		}
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package team
+
+import (
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/google/uuid"
+)
+
+// WithSportsmanship shows organizers each member's sportsmanship score: the
+// commends they received plus mvpWeight per MVP vote.
+func (s *Service) WithSportsmanship(mvpWeight float64) *Service {
+	s.sportsmanship = true
+	s.mvpWeight = mvpWeight
+	return s
+}
+
+// scoreSportsmanship sets each member's sportsmanship score from their honors.
+func (s *Service) scoreSportsmanship(members []*TeamMemberInfo, players map[uuid.UUID]*player.Player) {
+	if !s.sportsmanship {
+		return
+	}
+	for _, m := range members {
+		score := players[m.PlayerID].Honors.Sportsmanship(s.mvpWeight)
+		m.Sportsmanship = &score
+	}
+}
@@ -0,0 +1,177 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+)
+
+// ErrQueueUnavailable is returned when the registration queue is not configured.
+var ErrQueueUnavailable = errors.New("registration queue is not available")
+
+// WithRegistrationQueue enables queued team registration. Tournaments with
+// the RegistrationQueue rule then only accept teams through the queue.
+func (s *Service) WithRegistrationQueue(repo team.RegistrationQueueRepository) *Service {
+	s.queue = repo
+	return s
+}
+
+// TicketResponse is a registration ticket with the holder's place in line.
+type TicketResponse struct {
+	*team.RegistrationTicket
+	Position int64 `json:"position,omitempty"` // 1 for the next ticket processed; only set while waiting
+}
+
+// EnqueueTeam issues the player a ticket to register a team. Only cheap
+// checks run here; the worker creates the team, or records why it could not,
+// when the ticket's turn comes.
+func (s *Service) EnqueueTeam(ctx context.Context, req CreateTeamRequest, captainID uuid.UUID) (*TicketResponse, error) {
+	if s.queue == nil {
+		return nil, ErrQueueUnavailable
+	}
+
+	t, err := s.tournamentRepo.GetByID(ctx, req.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+	if t.Status != tournament.StatusOpen && !t.Rules.AllowLateRegistration {
+		return nil, tournament.ErrRegistrationClosed
+	}
+	if !t.CanRegister(captainID) {
+		return nil, tournament.ErrNotInvited
+	}
+
+	ticket, err := team.NewRegistrationTicket(t.ID, captainID, req.Name, req.Tag, req.LogoURL)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.queue.Create(ctx, ticket); err != nil {
+		return nil, err
+	}
+
+	return s.ticketResponse(ctx, ticket)
+}
+
+// GetTicket returns one of the player's registration tickets. Tickets of
+// other players are reported as not found.
+func (s *Service) GetTicket(ctx context.Context, id, playerID uuid.UUID) (*TicketResponse, error) {
+	if s.queue == nil {
+		return nil, ErrQueueUnavailable
+	}
+
+	ticket, err := s.queue.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if ticket.PlayerID != playerID {
+		return nil, team.ErrTicketNotFound
+	}
+
+	return s.ticketResponse(ctx, ticket)
+}
+
+// ProcessRegistrationQueue registers the teams of waiting tickets, oldest
+// first, and returns how many tickets were processed.
+func (s *Service) ProcessRegistrationQueue(ctx context.Context) (int, error) {
+	if s.queue == nil {
+		return 0, nil
+	}
+
+	processed := 0
+	for ctx.Err() == nil {
+		ticket, err := s.queue.ClaimNext(ctx)
+		if errors.Is(err, team.ErrTicketNotFound) {
+			break
+		}
+		if err != nil {
+			return processed, err
+		}
+
+		tm, createErr := s.registerTicket(ctx, ticket)
+		now := time.Now().UTC()
+		if createErr != nil {
+			ticket.Fail(createErr.Error(), now)
+		} else {
+			ticket.Register(tm.ID, now)
+		}
+		if err := s.queue.Update(ctx, ticket); err != nil {
+			return processed, err
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+func (s *Service) registerTicket(ctx context.Context, ticket *team.RegistrationTicket) (*team.Team, error) {
+	t, err := s.tournamentRepo.GetByID(ctx, ticket.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	req := CreateTeamRequest{
+		TournamentID: ticket.TournamentID,
+		Name:         ticket.Name,
+		Tag:          ticket.Tag,
+		LogoURL:      ticket.LogoURL,
+	}
+	return s.createTeam(ctx, t, req, ticket.PlayerID)
+}
+
+func (s *Service) ticketResponse(ctx context.Context, ticket *team.RegistrationTicket) (*TicketResponse, error) {
+	resp := &TicketResponse{RegistrationTicket: ticket}
+	if ticket.Status != team.TicketWaiting {
+		return resp, nil
+	}
+
+	ahead, err := s.queue.CountAhead(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+	resp.Position = ahead + 1
+	return resp, nil
+}
+
+// QueueWorker periodically processes the team registration queue.
+type QueueWorker struct {
+	service  *Service
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewQueueWorker creates a registration queue worker that runs every interval.
+func NewQueueWorker(service *Service, interval time.Duration, logger *slog.Logger) *QueueWorker {
+	return &QueueWorker{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run blocks, processing waiting tickets on every tick until ctx is cancelled.
+func (w *QueueWorker) Run(ctx context.Context) {
+	w.logger.Info("registration queue worker started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("registration queue worker stopped")
+			return
+		case <-ticker.C:
+			processed, err := w.service.ProcessRegistrationQueue(ctx)
+			if err != nil && ctx.Err() == nil {
+				w.logger.Error("registration queue processing failed", "error", err)
+			}
+			if processed > 0 {
+				w.logger.Info("registration tickets processed", "count", processed)
+			}
+		}
+	}
+}
@@ -3,30 +3,72 @@ package team
 
 import (
 	"context"
+	"errors"
 	"time"
 
+	"github.com/alejaam/tourney-rank/internal/domain/activity"
+	"github.com/alejaam/tourney-rank/internal/domain/match"
 	"github.com/alejaam/tourney-rank/internal/domain/player"
 	"github.com/alejaam/tourney-rank/internal/domain/team"
 	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/usecase/anomaly"
+	"github.com/alejaam/tourney-rank/internal/usecase/conduct"
+	"github.com/alejaam/tourney-rank/internal/usecase/fieldset"
+	"github.com/alejaam/tourney-rank/internal/usecase/organization"
 	"github.com/google/uuid"
 )
 
 // Service handles team use cases.
 type Service struct {
-	teamRepo       team.Repository
-	tournamentRepo tournament.Repository
-	playerRepo     player.Repository
+	teamRepo        team.Repository
+	tournamentRepo  tournament.Repository
+	playerRepo      player.Repository
+	playerStatsRepo player.StatsRepository
+	joinRequests    team.JoinRequestRepository
+	activity        activity.Repository
+	history         team.HistoryRepository
+	queue           team.RegistrationQueueRepository
+	broadcasts      team.BroadcastRepository
+	maxConcurrent   int
+	anomalies       *anomaly.Service
+	sportsmanship   bool
+	mvpWeight       float64
+	conduct         *conduct.Service
+	organizations   *organization.Service
+	matches         match.Repository
 }
 
 // NewService creates a new team service.
-func NewService(teamRepo team.Repository, tournamentRepo tournament.Repository, playerRepo player.Repository) *Service {
+func NewService(teamRepo team.Repository, tournamentRepo tournament.Repository, playerRepo player.Repository, playerStatsRepo player.StatsRepository, joinRequests team.JoinRequestRepository) *Service {
 	return &Service{
-		teamRepo:       teamRepo,
-		tournamentRepo: tournamentRepo,
-		playerRepo:     playerRepo,
+		teamRepo:        teamRepo,
+		tournamentRepo:  tournamentRepo,
+		playerRepo:      playerRepo,
+		playerStatsRepo: playerStatsRepo,
+		joinRequests:    joinRequests,
 	}
 }
 
+// WithActivityLog records team joins in the players' activity feeds.
+func (s *Service) WithActivityLog(repo activity.Repository) *Service {
+	s.activity = repo
+	return s
+}
+
+// WithHistory records roster and permission changes in the team's history.
+func (s *Service) WithHistory(repo team.HistoryRepository) *Service {
+	s.history = repo
+	return s
+}
+
+// WithConcurrentTournamentLimit limits, platform-wide, how many overlapping
+// open or active tournaments a player may compete in. Zero leaves it to the
+// tournaments' own rules.
+func (s *Service) WithConcurrentTournamentLimit(limit int) *Service {
+	s.maxConcurrent = limit
+	return s
+}
+
 // CreateTeamRequest represents the request to create a team.
 type CreateTeamRequest struct {
 	TournamentID uuid.UUID `json:"tournament_id"`
@@ -41,12 +83,24 @@ type TeamMemberInfo struct {
 	DisplayName string    `json:"display_name"`
 	AvatarURL   string    `json:"avatar_url"`
 	IsCaptain   bool      `json:"is_captain"`
+	CanSubmit   bool      `json:"can_submit"`
+
+	// NewAccountFlag marks a new account performing far above its tier. Only
+	// the tournament's organizer and admins see it.
+	NewAccountFlag *player.SmurfFlag `json:"new_account_flag,omitempty"`
+	// Sportsmanship weighs the member's commends and MVP votes. Only the
+	// tournament's organizer and admins see it.
+	Sportsmanship *float64 `json:"sportsmanship,omitempty"`
+	// Conduct is the member's conduct score. Only the tournament's organizer
+	// and admins see it.
+	Conduct *float64 `json:"conduct,omitempty"`
 }
 
 // TeamWithMembers represents a team with full member information.
 type TeamWithMembers struct {
 	*team.Team
-	Members []*TeamMemberInfo `json:"members"`
+	Members    []*TeamMemberInfo `json:"members"`
+	Broadcasts []*team.Broadcast `json:"broadcasts"` // The captain's latest broadcasts, newest first
 }
 
 // JoinTeamRequest represents the request to join a team via invite code.
@@ -54,6 +108,16 @@ type JoinTeamRequest struct {
 	InviteCode string `json:"invite_code"`
 }
 
+// ApplyToTeamRequest represents a player's request to join a team that requires approval.
+type ApplyToTeamRequest struct {
+	Message string `json:"message,omitempty"`
+}
+
+// DisqualifyTeamRequest represents an admin's request to disqualify a team.
+type DisqualifyTeamRequest struct {
+	Reason string `json:"reason"`
+}
+
 // RemoveMemberRequest represents the request to remove a member from a team.
 type RemoveMemberRequest struct {
 	PlayerID uuid.UUID `json:"player_id"`
@@ -64,14 +128,23 @@ type TransferCaptaincyRequest struct {
 	NewCaptainID uuid.UUID `json:"new_captain_id"`
 }
 
+// SubmitPermissionRequest represents the request to grant or revoke a
+// member's permission to submit match reports.
+type SubmitPermissionRequest struct {
+	CanSubmit bool `json:"can_submit"`
+}
+
 // UpdateTeamRequest represents the request to update a team.
 type UpdateTeamRequest struct {
-	Name    *string `json:"name,omitempty"`
-	Tag     *string `json:"tag,omitempty"`
-	LogoURL *string `json:"logo_url,omitempty"`
+	Name     *string `json:"name,omitempty"`
+	Tag      *string `json:"tag,omitempty"`
+	LogoURL  *string `json:"logo_url,omitempty"`
+	JoinMode *string `json:"join_mode,omitempty"` // "invite" or "approval"
 }
 
-// CreateTeam creates a new team.
+// CreateTeam creates a new team. Tournaments that register through the
+// registration queue reject direct creation with ErrRegistrationQueued, and
+// invite-only tournaments reject captains without an offered invitation.
 func (s *Service) CreateTeam(ctx context.Context, req CreateTeamRequest, captainID uuid.UUID) (*team.Team, error) {
 	// Verify tournament exists and is open for registration
 	t, err := s.tournamentRepo.GetByID(ctx, req.TournamentID)
@@ -79,23 +152,56 @@ func (s *Service) CreateTeam(ctx context.Context, req CreateTeamRequest, captain
 		return nil, err
 	}
 
+	if t.Rules.RegistrationQueue && s.queue != nil {
+		return nil, tournament.ErrRegistrationQueued
+	}
+
+	return s.createTeam(ctx, t, req, captainID)
+}
+
+// createTeam registers a team with the given captain in the tournament.
+func (s *Service) createTeam(ctx context.Context, t *tournament.Tournament, req CreateTeamRequest, captainID uuid.UUID) (*team.Team, error) {
 	if t.Status != tournament.StatusOpen && !t.Rules.AllowLateRegistration {
 		return nil, tournament.ErrRegistrationClosed
 	}
 
 	// Verify player exists
-	_, err = s.playerRepo.GetByID(ctx, captainID.String())
+	_, err := s.playerRepo.GetByID(ctx, captainID.String())
 	if err != nil {
 		return nil, err
 	}
 
+	if err := s.checkTierEligibility(ctx, t, captainID); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkConductEligibility(ctx, t, captainID); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkTeamScoreCap(ctx, t, []uuid.UUID{captainID}); err != nil {
+		return nil, err
+	}
+
 	// Check if player already has a team in this tournament
-	existingTeam, err := s.GetPlayerTeamInTournament(ctx, captainID, req.TournamentID)
+	existingTeam, err := s.GetPlayerTeamInTournament(ctx, captainID, t.ID)
 	if err == nil && existingTeam != nil {
 		return nil, team.ErrPlayerAlreadyInTeam
 	}
 
-	tm, err := team.NewTeam(req.TournamentID, captainID, req.Name)
+	if !t.CanRegister(captainID) {
+		return nil, tournament.ErrNotInvited
+	}
+
+	if err := s.checkConcurrentTournaments(ctx, t, captainID); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkCapacity(ctx, t); err != nil {
+		return nil, err
+	}
+
+	tm, err := team.NewTeam(t.ID, captainID, req.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -111,6 +217,23 @@ func (s *Service) CreateTeam(ctx context.Context, req CreateTeamRequest, captain
 		return nil, err
 	}
 
+	if t.Rules.InviteOnly {
+		if err := t.AcceptInvite(captainID, tm.ID, time.Now().UTC()); err != nil {
+			return nil, err
+		}
+		if err := s.tournamentRepo.Update(ctx, t); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.recordTeamJoined(ctx, tm, captainID); err != nil {
+		return nil, err
+	}
+
+	if err := s.recordHistory(ctx, team.NewHistoryEntry(tm.ID, team.HistoryCreated, captainID, captainID)); err != nil {
+		return nil, err
+	}
+
 	return tm, nil
 }
 
@@ -119,22 +242,47 @@ func (s *Service) GetTeam(ctx context.Context, id uuid.UUID) (*team.Team, error)
 	return s.teamRepo.GetByID(ctx, id)
 }
 
+// GetTeamFields retrieves a team with only the fields in fields, without
+// loading the others.
+func (s *Service) GetTeamFields(ctx context.Context, id uuid.UUID, fields fieldset.Set) (any, error) {
+	t, err := s.teamRepo.GetByIDFields(ctx, id, fields.Fields())
+	if err != nil {
+		return nil, err
+	}
+	return fields.Project(t)
+}
+
 // GetTeamByInviteCode retrieves a team by its invite code.
 func (s *Service) GetTeamByInviteCode(ctx context.Context, inviteCode string) (*team.Team, error) {
 	return s.teamRepo.GetByInviteCode(ctx, inviteCode)
 }
 
-// GetTeamWithMembers retrieves a team with full member information.
-func (s *Service) GetTeamWithMembers(ctx context.Context, id uuid.UUID) (*TeamWithMembers, error) {
+// GetTeamWithMembers retrieves a team with full member information. The
+// tournament's organizer and admins also see which members are flagged as
+// possible smurfs and the members' sportsmanship and conduct scores.
+func (s *Service) GetTeamWithMembers(ctx context.Context, id, viewerID uuid.UUID, isAdmin bool) (*TeamWithMembers, error) {
 	tm, err := s.teamRepo.GetByID(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
+	ids := make([]string, len(tm.MemberIDs))
+	for i, memberID := range tm.MemberIDs {
+		ids[i] = memberID.String()
+	}
+	players, err := s.playerRepo.GetByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*player.Player, len(players))
+	for _, p := range players {
+		byID[p.ID] = p
+	}
+
 	members := make([]*TeamMemberInfo, 0, len(tm.MemberIDs))
 	for _, memberID := range tm.MemberIDs {
-		p, err := s.playerRepo.GetByID(ctx, memberID.String())
-		if err != nil {
+		p, ok := byID[memberID]
+		if !ok {
 			continue // Skip if player not found
 		}
 
@@ -143,12 +291,35 @@ func (s *Service) GetTeamWithMembers(ctx context.Context, id uuid.UUID) (*TeamWi
 			DisplayName: p.DisplayName,
 			AvatarURL:   p.AvatarURL,
 			IsCaptain:   tm.IsCaptain(p.ID),
+			CanSubmit:   tm.CanSubmit(p.ID),
 		})
 	}
 
+	t, err := s.organizerTournament(ctx, tm.TournamentID, viewerID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if t != nil {
+		if err := s.flagNewAccounts(ctx, t, members); err != nil {
+			return nil, err
+		}
+		s.scoreSportsmanship(members, byID)
+		if err := s.scoreConduct(ctx, members); err != nil {
+			return nil, err
+		}
+	}
+
+	broadcasts := []*team.Broadcast{}
+	if s.broadcasts != nil {
+		if broadcasts, err = s.broadcasts.ListByTeam(ctx, tm.ID, team.RecentBroadcasts); err != nil {
+			return nil, err
+		}
+	}
+
 	return &TeamWithMembers{
-		Team:    tm,
-		Members: members,
+		Team:       tm,
+		Members:    members,
+		Broadcasts: broadcasts,
 	}, nil
 }
 
@@ -160,16 +331,147 @@ func (s *Service) JoinTeam(ctx context.Context, req JoinTeamRequest, playerID uu
 		return nil, team.ErrInvalidInviteCode
 	}
 
+	if tm.RequiresApproval() {
+		return nil, team.ErrApprovalRequired
+	}
+
 	// Verify player exists
 	_, err = s.playerRepo.GetByID(ctx, playerID.String())
 	if err != nil {
 		return nil, err
 	}
 
+	if err := s.admit(ctx, tm, playerID, playerID); err != nil {
+		return nil, err
+	}
+
+	return tm, nil
+}
+
+// ApplyToTeam files a request to join a team that requires captain approval.
+// The same membership rules as joining are checked up front, and again when
+// the captain approves.
+func (s *Service) ApplyToTeam(ctx context.Context, teamID uuid.UUID, req ApplyToTeamRequest, playerID uuid.UUID) (*team.JoinRequest, error) {
+	tm, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tm.RequiresApproval() {
+		return nil, team.ErrInvalidJoinMode
+	}
+
+	if _, err := s.playerRepo.GetByID(ctx, playerID.String()); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.checkCanJoin(ctx, tm, playerID); err != nil {
+		return nil, err
+	}
+
+	jr := team.NewJoinRequest(tm, playerID, req.Message)
+	if err := s.joinRequests.Create(ctx, jr); err != nil {
+		return nil, err
+	}
+
+	return jr, nil
+}
+
+// ApproveJoinRequest admits the applicant to the team. Only the captain can
+// approve, and the team size, roster lock and eligibility rules still apply.
+func (s *Service) ApproveJoinRequest(ctx context.Context, teamID, requestID, captainID uuid.UUID) (*team.Team, error) {
+	tm, jr, err := s.pendingJoinRequest(ctx, teamID, requestID, captainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.admit(ctx, tm, jr.PlayerID, captainID); err != nil {
+		return nil, err
+	}
+
+	if err := jr.Approve(captainID); err != nil {
+		return nil, err
+	}
+	if err := s.joinRequests.Update(ctx, jr); err != nil {
+		return nil, err
+	}
+
+	return tm, nil
+}
+
+// DeclineJoinRequest turns down an applicant. Only the captain can decline.
+func (s *Service) DeclineJoinRequest(ctx context.Context, teamID, requestID, captainID uuid.UUID) (*team.JoinRequest, error) {
+	_, jr, err := s.pendingJoinRequest(ctx, teamID, requestID, captainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := jr.Decline(captainID); err != nil {
+		return nil, err
+	}
+	if err := s.joinRequests.Update(ctx, jr); err != nil {
+		return nil, err
+	}
+
+	return jr, nil
+}
+
+// ListTeamJoinRequests returns a team's pending join requests to its captain.
+func (s *Service) ListTeamJoinRequests(ctx context.Context, teamID, captainID uuid.UUID) ([]*team.JoinRequest, error) {
+	tm, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tm.IsCaptain(captainID) {
+		return nil, team.ErrNotCaptain
+	}
+
+	return s.joinRequests.ListPendingByTeam(ctx, teamID)
+}
+
+// ListPlayerJoinRequests returns a player's own pending join requests.
+func (s *Service) ListPlayerJoinRequests(ctx context.Context, playerID uuid.UUID) ([]*team.JoinRequest, error) {
+	return s.joinRequests.ListPendingByPlayer(ctx, playerID)
+}
+
+// pendingJoinRequest loads a team and one of its join requests on behalf of the captain.
+func (s *Service) pendingJoinRequest(ctx context.Context, teamID, requestID, captainID uuid.UUID) (*team.Team, *team.JoinRequest, error) {
+	tm, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !tm.IsCaptain(captainID) {
+		return nil, nil, team.ErrNotCaptain
+	}
+
+	jr, err := s.joinRequests.GetByID(ctx, requestID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if jr.TeamID != tm.ID {
+		return nil, nil, team.ErrJoinRequestNotFound
+	}
+	if jr.Status != team.JoinRequestPending {
+		return nil, nil, team.ErrJoinRequestNotPending
+	}
+
+	return tm, jr, nil
+}
+
+// checkCanJoin verifies a player may join a team: they are not on a team in
+// the tournament yet, the roster is not locked by closed registration, the
+// team has room, the player is eligible and not over the concurrent
+// tournament limit.
+func (s *Service) checkCanJoin(ctx context.Context, tm *team.Team, playerID uuid.UUID) (*tournament.Tournament, error) {
 	// Check if player already in team
 	if tm.HasMember(playerID) {
 		return nil, team.ErrPlayerAlreadyInTeam
 	}
+	if tm.IsStaff(playerID) {
+		return nil, team.ErrAlreadyStaff
+	}
 
 	// Check if player already has a team in this tournament
 	existingTeam, err := s.GetPlayerTeamInTournament(ctx, playerID, tm.TournamentID)
@@ -187,22 +489,53 @@ func (s *Service) JoinTeam(ctx context.Context, req JoinTeamRequest, playerID uu
 		return nil, tournament.ErrRegistrationClosed
 	}
 
-	// Check team size limit
-	if tm.MemberCount() >= int(t.TeamSize) {
+	// Check roster size limit
+	if tm.MemberCount() >= t.MaxRoster() {
 		return nil, team.ErrTeamFull
 	}
 
+	if err := s.checkTierEligibility(ctx, t, playerID); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkConductEligibility(ctx, t, playerID); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkConcurrentTournaments(ctx, t, playerID); err != nil {
+		return nil, err
+	}
+
+	return t, nil
+}
+
+// admit adds a player to a team on behalf of actorID after checking the membership rules.
+func (s *Service) admit(ctx context.Context, tm *team.Team, playerID, actorID uuid.UUID) error {
+	t, err := s.checkCanJoin(ctx, tm, playerID)
+	if err != nil {
+		return err
+	}
+
+	roster := append(append([]uuid.UUID{}, tm.MemberIDs...), playerID)
+	if err := s.checkTeamScoreCap(ctx, t, roster); err != nil {
+		return err
+	}
+
 	// Add member to team
 	if err := tm.AddMember(playerID); err != nil {
-		return nil, err
+		return err
 	}
 
 	// Update team in repository
 	if err := s.teamRepo.Update(ctx, tm); err != nil {
-		return nil, err
+		return err
 	}
 
-	return tm, nil
+	if err := s.recordTeamJoined(ctx, tm, playerID); err != nil {
+		return err
+	}
+
+	return s.recordHistory(ctx, team.NewHistoryEntry(tm.ID, team.HistoryMemberJoined, actorID, playerID))
 }
 
 // RemoveMember removes a member from a team.
@@ -225,6 +558,10 @@ func (s *Service) RemoveMember(ctx context.Context, teamID uuid.UUID, req Remove
 		return nil, err
 	}
 
+	if err := s.recordHistory(ctx, team.NewHistoryEntry(tm.ID, team.HistoryMemberLeft, requestorID, req.PlayerID)); err != nil {
+		return nil, err
+	}
+
 	return tm, nil
 }
 
@@ -244,7 +581,11 @@ func (s *Service) LeaveTeam(ctx context.Context, teamID, playerID uuid.UUID) err
 		return err
 	}
 
-	return s.teamRepo.Update(ctx, tm)
+	if err := s.teamRepo.Update(ctx, tm); err != nil {
+		return err
+	}
+
+	return s.recordHistory(ctx, team.NewHistoryEntry(tm.ID, team.HistoryMemberLeft, playerID, playerID))
 }
 
 // TransferCaptaincy transfers team captaincy to another member.
@@ -267,9 +608,83 @@ func (s *Service) TransferCaptaincy(ctx context.Context, teamID uuid.UUID, req T
 		return nil, err
 	}
 
+	if err := s.recordHistory(ctx, team.NewHistoryEntry(tm.ID, team.HistoryCaptainTransferred, requestorID, req.NewCaptainID)); err != nil {
+		return nil, err
+	}
+
+	return tm, nil
+}
+
+// SetSubmitPermission grants or revokes a member's permission to submit match
+// reports. Only the captain can change permissions.
+func (s *Service) SetSubmitPermission(ctx context.Context, teamID, playerID uuid.UUID, req SubmitPermissionRequest, requestorID uuid.UUID) (*team.Team, error) {
+	tm, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tm.IsCaptain(requestorID) {
+		return nil, team.ErrNotCaptain
+	}
+
+	if req.CanSubmit {
+		err = tm.GrantSubmit(playerID)
+	} else {
+		err = tm.RevokeSubmit(playerID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.teamRepo.Update(ctx, tm); err != nil {
+		return nil, err
+	}
+
+	entry := team.NewPermissionChange(tm.ID, requestorID, playerID, team.PermissionCanSubmit, req.CanSubmit)
+	if err := s.recordHistory(ctx, entry); err != nil {
+		return nil, err
+	}
+
 	return tm, nil
 }
 
+// DisqualifyTeam disqualifies a team from its tournament. Admin only; the
+// caller is responsible for checking the role.
+func (s *Service) DisqualifyTeam(ctx context.Context, teamID uuid.UUID, req DisqualifyTeamRequest, adminID uuid.UUID) (*team.Team, error) {
+	tm, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tm.Disqualify(); err != nil {
+		return nil, err
+	}
+
+	if err := s.teamRepo.Update(ctx, tm); err != nil {
+		return nil, err
+	}
+
+	if err := s.recordHistory(ctx, team.NewDisqualification(tm.ID, adminID, req.Reason)); err != nil {
+		return nil, err
+	}
+
+	return tm, nil
+}
+
+// GetHistory returns a team's history, newest first. The history is public so
+// roster disputes can be checked by anyone.
+func (s *Service) GetHistory(ctx context.Context, teamID uuid.UUID, limit int64) ([]*team.HistoryEntry, error) {
+	if _, err := s.teamRepo.GetByID(ctx, teamID); err != nil {
+		return nil, err
+	}
+
+	if s.history == nil {
+		return []*team.HistoryEntry{}, nil
+	}
+
+	return s.history.ListByTeam(ctx, teamID, limit)
+}
+
 // ListTeamsByTournament lists all teams in a tournament.
 func (s *Service) ListTeamsByTournament(ctx context.Context, tournamentID uuid.UUID) ([]*team.Team, error) {
 	return s.teamRepo.GetByTournamentID(ctx, tournamentID)
@@ -308,6 +723,7 @@ func (s *Service) UpdateTeam(ctx context.Context, teamID uuid.UUID, req UpdateTe
 		return nil, team.ErrNotCaptain
 	}
 
+	previousName := tm.Name
 	if req.Name != nil {
 		tm.Name = *req.Name
 	}
@@ -317,6 +733,13 @@ func (s *Service) UpdateTeam(ctx context.Context, teamID uuid.UUID, req UpdateTe
 	if req.LogoURL != nil {
 		tm.SetLogoURL(*req.LogoURL)
 	}
+	if req.JoinMode != nil {
+		mode, err := team.ParseJoinMode(*req.JoinMode)
+		if err != nil {
+			return nil, err
+		}
+		tm.SetJoinMode(mode)
+	}
 
 	tm.UpdatedAt = time.Now().UTC()
 
@@ -324,10 +747,17 @@ func (s *Service) UpdateTeam(ctx context.Context, teamID uuid.UUID, req UpdateTe
 		return nil, err
 	}
 
+	if tm.Name != previousName {
+		if err := s.recordHistory(ctx, team.NewRename(tm.ID, requestorID, previousName, tm.Name)); err != nil {
+			return nil, err
+		}
+	}
+
 	return tm, nil
 }
 
-// DisbandTeam disbands a team.
+// DisbandTeam disbands a team. In invite-only tournaments its slot goes to
+// the first reserve.
 func (s *Service) DisbandTeam(ctx context.Context, teamID, requestorID uuid.UUID) error {
 	tm, err := s.teamRepo.GetByID(ctx, teamID)
 	if err != nil {
@@ -343,5 +773,144 @@ func (s *Service) DisbandTeam(ctx context.Context, teamID, requestorID uuid.UUID
 		return err
 	}
 
-	return s.teamRepo.Update(ctx, tm)
+	if err := s.teamRepo.Update(ctx, tm); err != nil {
+		return err
+	}
+
+	return s.releaseInvite(ctx, tm)
+}
+
+// releaseInvite offers the invitation slot of a withdrawn team to the first
+// reserve of its tournament.
+func (s *Service) releaseInvite(ctx context.Context, tm *team.Team) error {
+	t, err := s.tournamentRepo.GetByID(ctx, tm.TournamentID)
+	if err != nil {
+		return err
+	}
+	if !t.ReleaseInvite(tm.ID, time.Now().UTC()) {
+		return nil
+	}
+	return s.tournamentRepo.Update(ctx, t)
+}
+
+// checkTierEligibility verifies the player's current tier in the tournament's game
+// satisfies the tournament's tier restriction. Players without stats are treated as beginners.
+func (s *Service) checkTierEligibility(ctx context.Context, t *tournament.Tournament, playerID uuid.UUID) error {
+	if !t.Rules.HasTierRestriction() {
+		return nil
+	}
+
+	tier := player.TierBeginner
+	stats, err := s.playerStatsRepo.GetByPlayerAndGame(ctx, playerID, t.GameID)
+	if err != nil {
+		if !errors.Is(err, player.ErrStatsNotFound) {
+			return err
+		}
+	} else {
+		tier = stats.Tier
+	}
+
+	if !t.IsTierEligible(playerID, tier) {
+		return tournament.ErrPlayerNotEligible
+	}
+
+	return nil
+}
+
+// checkConcurrentTournaments verifies that joining t keeps the player within
+// the concurrent tournament limit. Teams that are out of their tournament do
+// not count.
+func (s *Service) checkConcurrentTournaments(ctx context.Context, t *tournament.Tournament, playerID uuid.UUID) error {
+	teams, err := s.teamRepo.GetByPlayerID(ctx, playerID)
+	if err != nil {
+		return err
+	}
+
+	joined := make([]*tournament.Tournament, 0, len(teams))
+	for _, tm := range teams {
+		switch tm.Status {
+		case team.StatusEliminated, team.StatusDisbanded, team.StatusDisqualified:
+			continue
+		}
+		if tm.TournamentID == t.ID {
+			continue
+		}
+		other, err := s.tournamentRepo.GetByID(ctx, tm.TournamentID)
+		if errors.Is(err, tournament.ErrNotFound) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		joined = append(joined, other)
+	}
+
+	return t.CheckConcurrentTournaments(s.maxConcurrent, joined)
+}
+
+// checkCapacity verifies the tournament has a free team slot. Disbanded teams
+// do not hold a slot.
+func (s *Service) checkCapacity(ctx context.Context, t *tournament.Tournament) error {
+	if t.Rules.MaxTeams <= 0 {
+		return nil
+	}
+
+	teams, err := s.teamRepo.GetByTournamentID(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+
+	registered := 0
+	for _, tm := range teams {
+		if tm.Status != team.StatusDisbanded {
+			registered++
+		}
+	}
+	if registered >= t.Rules.MaxTeams {
+		return tournament.ErrTournamentFull
+	}
+	return nil
+}
+
+// checkTeamScoreCap verifies the combined ranking score of the given roster stays
+// within the tournament's team score cap.
+func (s *Service) checkTeamScoreCap(ctx context.Context, t *tournament.Tournament, memberIDs []uuid.UUID) error {
+	if !t.Rules.HasTeamScoreCap() {
+		return nil
+	}
+
+	scores := make([]float64, 0, len(memberIDs))
+	for _, memberID := range memberIDs {
+		stats, err := s.playerStatsRepo.GetByPlayerAndGame(ctx, memberID, t.GameID)
+		if err != nil {
+			if errors.Is(err, player.ErrStatsNotFound) {
+				scores = append(scores, 0)
+				continue
+			}
+			return err
+		}
+		scores = append(scores, stats.RankingScore)
+	}
+
+	if t.Rules.ExceedsTeamScoreCap(scores) {
+		return tournament.ErrTeamScoreCapExceeded
+	}
+
+	return nil
+}
+
+// recordHistory appends an entry to the team's history if a history repository is configured.
+func (s *Service) recordHistory(ctx context.Context, entry *team.HistoryEntry) error {
+	if s.history == nil {
+		return nil
+	}
+	return s.history.Create(ctx, entry)
+}
+
+// recordTeamJoined logs a player joining a team if an activity log is configured.
+func (s *Service) recordTeamJoined(ctx context.Context, tm *team.Team, playerID uuid.UUID) error {
+	if s.activity == nil {
+		return nil
+	}
+	return s.activity.Create(ctx, activity.NewTeamJoined(playerID, tm.ID, tm.TournamentID, tm.Name))
 }
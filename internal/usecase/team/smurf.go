@@ -0,0 +1,68 @@
+package team
+
+import (
+	"context"
+
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/usecase/anomaly"
+	"github.com/alejaam/tourney-rank/internal/usecase/organization"
+	"github.com/google/uuid"
+)
+
+// WithAnomalies flags new accounts performing far above their tier on the
+// rosters organizers see.
+func (s *Service) WithAnomalies(anomalies *anomaly.Service) *Service {
+	s.anomalies = anomalies
+	return s
+}
+
+// WithOrganizations lets the staff of an organization see the flags on the
+// rosters of its series' tournaments.
+func (s *Service) WithOrganizations(organizations *organization.Service) *Service {
+	s.organizations = organizations
+	return s
+}
+
+// organizerTournament returns the team's tournament when the viewer organizes
+// it or is an admin, and nil otherwise.
+func (s *Service) organizerTournament(ctx context.Context, tournamentID, viewerID uuid.UUID, isAdmin bool) (*tournament.Tournament, error) {
+	if viewerID == uuid.Nil && !isAdmin {
+		return nil, nil
+	}
+
+	t, err := s.tournamentRepo.GetByID(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	if isAdmin || t.CreatedBy == viewerID {
+		return t, nil
+	}
+	if s.organizations == nil {
+		return nil, nil
+	}
+	ok, err := s.organizations.Organizes(ctx, t, viewerID)
+	if err != nil || !ok {
+		return nil, err
+	}
+	return t, nil
+}
+
+// flagNewAccounts marks members flagged by the anomaly service.
+func (s *Service) flagNewAccounts(ctx context.Context, t *tournament.Tournament, members []*TeamMemberInfo) error {
+	if s.anomalies == nil {
+		return nil
+	}
+
+	ids := make([]uuid.UUID, len(members))
+	for i, m := range members {
+		ids[i] = m.PlayerID
+	}
+	flags, err := s.anomalies.SmurfFlags(ctx, t.GameID, ids)
+	if err != nil {
+		return err
+	}
+	for _, m := range members {
+		m.NewAccountFlag = flags[m.PlayerID]
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package team
+
+import (
+	"context"
+
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/google/uuid"
+)
+
+// AddStaffRequest represents a captain adding a coach or manager to the team.
+type AddStaffRequest struct {
+	PlayerID uuid.UUID      `json:"player_id"`
+	Role     team.StaffRole `json:"role"` // "coach" or "manager"
+}
+
+// AddStaff adds a non-playing coach or manager to the team. Only the captain
+// can add staff, and players on the roster cannot also be staff.
+func (s *Service) AddStaff(ctx context.Context, teamID uuid.UUID, req AddStaffRequest, requestorID uuid.UUID) (*team.Team, error) {
+	tm, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tm.IsCaptain(requestorID) {
+		return nil, team.ErrNotCaptain
+	}
+
+	// Verify player exists
+	if _, err := s.playerRepo.GetByID(ctx, req.PlayerID.String()); err != nil {
+		return nil, err
+	}
+
+	if err := tm.AddStaff(req.PlayerID, req.Role); err != nil {
+		return nil, err
+	}
+
+	if err := s.teamRepo.Update(ctx, tm); err != nil {
+		return nil, err
+	}
+
+	if err := s.recordHistory(ctx, team.NewHistoryEntry(tm.ID, team.HistoryStaffAdded, requestorID, req.PlayerID)); err != nil {
+		return nil, err
+	}
+
+	return tm, nil
+}
+
+// RemoveStaff removes a coach or manager from the team. The captain can
+// remove any staff member; staff can remove themselves.
+func (s *Service) RemoveStaff(ctx context.Context, teamID, playerID, requestorID uuid.UUID) (*team.Team, error) {
+	tm, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !tm.IsCaptain(requestorID) && requestorID != playerID {
+		return nil, team.ErrNotCaptain
+	}
+
+	if err := tm.RemoveStaff(playerID); err != nil {
+		return nil, err
+	}
+
+	if err := s.teamRepo.Update(ctx, tm); err != nil {
+		return nil, err
+	}
+
+	if err := s.recordHistory(ctx, team.NewHistoryEntry(tm.ID, team.HistoryStaffRemoved, requestorID, playerID)); err != nil {
+		return nil, err
+	}
+
+	return tm, nil
+}
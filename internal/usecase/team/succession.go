@@ -0,0 +1,168 @@
+package team
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/alejaam/tourney-rank/internal/domain/activity"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+)
+
+// ErrNotOrganizer is returned when someone other than the tournament's
+// organizers and admins appoints a team's captain.
+var ErrNotOrganizer = errors.New("only the tournament's organizers can appoint a team's captain")
+
+// AppointCaptainRequest is an organizer's choice of a team's new captain.
+type AppointCaptainRequest struct {
+	PlayerID uuid.UUID `json:"player_id"`
+}
+
+// SucceedCaptain hands captaincy of every team the player captains in an
+// unfinished tournament to the team's longest-tenured member who is neither
+// banned nor deactivated. Teams left without such a member are disbanded.
+// Members and staff learn of their new captain through their activity feeds.
+// It returns the teams that changed.
+func (s *Service) SucceedCaptain(ctx context.Context, playerID uuid.UUID, reason team.SuccessionReason) ([]*team.Team, error) {
+	teams, err := s.teamRepo.GetByPlayerID(ctx, playerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var changed []*team.Team
+	for _, tm := range teams {
+		if !tm.IsCaptain(playerID) || !competing(tm) {
+			continue
+		}
+		t, err := s.tournamentRepo.GetByID(ctx, tm.TournamentID)
+		if err != nil {
+			return nil, err
+		}
+		if t.Status == tournament.StatusFinished || t.Status == tournament.StatusCanceled {
+			continue
+		}
+
+		eligible, err := s.eligibleCaptains(ctx, tm)
+		if err != nil {
+			return nil, err
+		}
+		successor := tm.Successor(func(id uuid.UUID) bool { return eligible[id] })
+		if successor == uuid.Nil {
+			if err := tm.UpdateStatus(team.StatusDisbanded); err != nil {
+				return nil, err
+			}
+			if err := s.teamRepo.Update(ctx, tm); err != nil {
+				return nil, err
+			}
+			if err := s.releaseInvite(ctx, tm); err != nil {
+				return nil, err
+			}
+		} else if err := s.succeed(ctx, tm, successor, uuid.Nil, reason); err != nil {
+			return nil, err
+		}
+		changed = append(changed, tm)
+	}
+	return changed, nil
+}
+
+// SucceedUserCaptain runs SucceedCaptain for the player of a user account.
+// Users without a player profile captain no teams.
+func (s *Service) SucceedUserCaptain(ctx context.Context, userID string, reason team.SuccessionReason) ([]*team.Team, error) {
+	p, err := s.playerRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, player.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return s.SucceedCaptain(ctx, p.ID, reason)
+}
+
+// AppointCaptain lets the tournament's organizers and admins hand a team's
+// captaincy to another member, overriding the automatic succession.
+func (s *Service) AppointCaptain(ctx context.Context, teamID uuid.UUID, req AppointCaptainRequest, requesterID uuid.UUID, isAdmin bool) (*team.Team, error) {
+	tm, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.organizerTournament(ctx, tm.TournamentID, requesterID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if t == nil {
+		return nil, ErrNotOrganizer
+	}
+
+	if err := s.succeed(ctx, tm, req.PlayerID, requesterID, team.SuccessionOverride); err != nil {
+		return nil, err
+	}
+	return tm, nil
+}
+
+// succeed hands the team's captaincy to newCaptainID, records it in the
+// team's history and notifies the members and staff.
+func (s *Service) succeed(ctx context.Context, tm *team.Team, newCaptainID, actorID uuid.UUID, reason team.SuccessionReason) error {
+	if err := tm.SucceedCaptain(newCaptainID, reason); err != nil {
+		return err
+	}
+	if err := s.teamRepo.Update(ctx, tm); err != nil {
+		return err
+	}
+	if err := s.recordHistory(ctx, team.NewSuccession(tm.ID, actorID, newCaptainID, reason)); err != nil {
+		return err
+	}
+	if s.activity == nil {
+		return nil
+	}
+
+	name := "A teammate"
+	if p, err := s.playerRepo.GetByID(ctx, newCaptainID.String()); err == nil {
+		name = p.DisplayName
+	} else if !errors.Is(err, player.ErrNotFound) {
+		return err
+	}
+	message := fmt.Sprintf("%s is your new captain: %s", name, successionCause(reason))
+
+	recipients := tm.Recipients()
+	events := make([]*activity.Event, 0, len(recipients))
+	for _, recipientID := range recipients {
+		events = append(events, activity.NewCaptainSucceeded(recipientID, tm.ID, tm.TournamentID, tm.Name, message))
+	}
+	return s.activity.Create(ctx, events...)
+}
+
+// eligibleCaptains returns the team's members who may take over captaincy:
+// those who are neither banned nor deactivated.
+func (s *Service) eligibleCaptains(ctx context.Context, tm *team.Team) (map[uuid.UUID]bool, error) {
+	eligible := make(map[uuid.UUID]bool, len(tm.MemberIDs))
+	for _, id := range tm.MemberIDs {
+		p, err := s.playerRepo.GetByID(ctx, id.String())
+		if err != nil {
+			if errors.Is(err, player.ErrNotFound) {
+				continue
+			}
+			return nil, err
+		}
+		eligible[id] = !p.IsBanned && !p.IsDeactivated
+	}
+	return eligible, nil
+}
+
+// competing reports whether a team is still in its tournament.
+func competing(tm *team.Team) bool {
+	return tm.Status != team.StatusDisbanded && tm.Status != team.StatusDisqualified && tm.Status != team.StatusEliminated
+}
+
+func successionCause(reason team.SuccessionReason) string {
+	switch reason {
+	case team.SuccessionBanned:
+		return "the previous captain was banned"
+	case team.SuccessionDeleted:
+		return "the previous captain's account was deleted"
+	default:
+		return "appointed by the tournament organizers"
+	}
+}
@@ -0,0 +1,193 @@
+package tier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/activity"
+	"github.com/alejaam/tourney-rank/internal/domain/ranking"
+	"github.com/google/uuid"
+)
+
+// RewardResult summarizes a season reward distribution run for a game.
+// Season is empty when no season has closed.
+type RewardResult struct {
+	GameID      uuid.UUID `json:"game_id"`
+	Season      string    `json:"season,omitempty"`
+	Distributed int       `json:"distributed"`
+	Skipped     int       `json:"skipped"` // Players rewarded by an earlier run
+	Notified    int       `json:"notified"`
+}
+
+// WithRewards enables season reward distribution. Players are notified through
+// the activity log when it is enabled too.
+func (s *Service) WithRewards(repo ranking.RewardRepository) *Service {
+	s.rewards = repo
+	return s
+}
+
+// DistributeAllSeasonRewards distributes season rewards for every active game.
+func (s *Service) DistributeAllSeasonRewards(ctx context.Context) ([]RewardResult, error) {
+	if s.rewards == nil {
+		return nil, nil
+	}
+
+	games, err := s.gameRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list games: %w", err)
+	}
+
+	results := make([]RewardResult, 0, len(games))
+	for _, g := range games {
+		if !g.IsActive {
+			continue
+		}
+
+		result, err := s.DistributeSeasonRewards(ctx, g.ID)
+		if err != nil {
+			return results, fmt.Errorf("distribute rewards for game %s: %w", g.ID, err)
+		}
+		results = append(results, *result)
+	}
+
+	return results, nil
+}
+
+// DistributeSeasonRewards rewards every player who played a game for the tier
+// they finished its last closed season in, judged by that season's final
+// cutoffs. A season closes when a boundaries version for a new season takes
+// effect, and players are judged by the scores recorded then, so matches
+// played since do not change their reward. Each player is rewarded once per
+// season, so the run can be repeated; players whose notification failed are
+// notified again on the next run.
+func (s *Service) DistributeSeasonRewards(ctx context.Context, gameID uuid.UUID) (*RewardResult, error) {
+	result := &RewardResult{GameID: gameID}
+	if s.rewards == nil {
+		return result, nil
+	}
+
+	now := time.Now().UTC()
+
+	current, err := s.boundaryRepo.GetEffective(ctx, gameID, now)
+	if errors.Is(err, ranking.ErrBoundariesNotFound) {
+		return result, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get tier boundaries: %w", err)
+	}
+
+	previous, err := s.boundaryRepo.GetByVersion(ctx, gameID, current.Version-1)
+	if errors.Is(err, ranking.ErrBoundariesNotFound) {
+		return result, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("get previous tier boundaries: %w", err)
+	}
+
+	season, closed := ranking.ClosedSeason(current, previous)
+	if !closed {
+		return result, nil
+	}
+	result.Season = season
+
+	standings, err := s.seasonEnd(ctx, gameID, previous)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, entry := range standings {
+		d := ranking.NewRewardDistribution(entry.PlayerID, gameID, season, entry.Tier, now)
+		err := s.rewards.Create(ctx, d)
+		if errors.Is(err, ranking.ErrRewardAlreadyDistributed) {
+			result.Skipped++
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("record season reward: %w", err)
+		}
+		result.Distributed++
+	}
+
+	notified, err := s.notifyRewards(ctx, gameID, season)
+	result.Notified = notified
+	if err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// seasonEnd returns the scores and tiers players finished the season of a
+// boundaries version with, recording them in rating history the first time
+// the season is looked at after it closed. Only players who played are
+// recorded.
+func (s *Service) seasonEnd(ctx context.Context, gameID uuid.UUID, closed *ranking.TierBoundaries) ([]*ranking.HistoryEntry, error) {
+	standings, err := s.historyRepo.ListByBoundaryVersion(ctx, gameID, closed.Version, ranking.HistoryReasonSeasonEnd)
+	if err != nil {
+		return nil, fmt.Errorf("list season end standings: %w", err)
+	}
+	if len(standings) > 0 {
+		return standings, nil
+	}
+
+	stats, err := s.statsRepo.GetByGame(ctx, gameID, 0)
+	if err != nil {
+		return nil, fmt.Errorf("get player stats: %w", err)
+	}
+
+	for _, ps := range stats {
+		if ps.MatchesPlayed == 0 {
+			continue
+		}
+
+		entry := ranking.NewHistoryEntry(ps.PlayerID, gameID, ps.RankingScore, ps.Tier, closed.TierFor(ps.RankingScore), ranking.HistoryReasonSeasonEnd, closed.Version)
+		if err := s.historyRepo.Create(ctx, entry); err != nil {
+			return nil, fmt.Errorf("record season end standing: %w", err)
+		}
+		standings = append(standings, entry)
+	}
+
+	return standings, nil
+}
+
+// notifyRewards adds a season's unnotified rewards to the players' activity
+// feeds and marks them notified.
+func (s *Service) notifyRewards(ctx context.Context, gameID uuid.UUID, season string) (int, error) {
+	if s.activity == nil {
+		return 0, nil
+	}
+
+	pending, err := s.rewards.ListUnnotified(ctx, gameID, season)
+	if err != nil {
+		return 0, fmt.Errorf("list unnotified rewards: %w", err)
+	}
+
+	notified := 0
+	for _, d := range pending {
+		event := activity.NewSeasonReward(d.PlayerID, d.GameID, d.Season, d.Tier, d.Reward.Badge, d.Reward.Title, d.Reward.XP)
+		if err := s.activity.Create(ctx, event); err != nil {
+			return notified, fmt.Errorf("record activity: %w", err)
+		}
+		if err := s.rewards.MarkNotified(ctx, d.ID, time.Now().UTC()); err != nil {
+			return notified, err
+		}
+		notified++
+	}
+
+	return notified, nil
+}
+
+// ListPlayerRewards returns a player's season rewards in a game, newest first.
+func (s *Service) ListPlayerRewards(ctx context.Context, playerID, gameID uuid.UUID) ([]*ranking.RewardDistribution, error) {
+	if s.rewards == nil {
+		return []*ranking.RewardDistribution{}, nil
+	}
+
+	rewards, err := s.rewards.ListByPlayer(ctx, playerID, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("list season rewards: %w", err)
+	}
+	return rewards, nil
+}
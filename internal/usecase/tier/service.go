@@ -0,0 +1,241 @@
+// Package tier provides use cases for versioned tier boundaries and tier recalibration.
+package tier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/activity"
+	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/ranking"
+	"github.com/google/uuid"
+)
+
+// defaultHistoryLimit caps rating history responses when no limit is given.
+const defaultHistoryLimit = 50
+
+// Service manages tier boundaries and keeps stored tiers in line with them.
+type Service struct {
+	boundaryRepo ranking.BoundaryRepository
+	historyRepo  ranking.HistoryRepository
+	statsRepo    player.StatsRepository
+	gameRepo     game.Repository
+	ranking      *ranking.Service
+	activity     activity.Repository
+	siteFeed     activity.SiteFeedRepository
+	rewards      ranking.RewardRepository
+}
+
+// NewService creates a new tier service.
+func NewService(
+	boundaryRepo ranking.BoundaryRepository,
+	historyRepo ranking.HistoryRepository,
+	statsRepo player.StatsRepository,
+	gameRepo game.Repository,
+	rankingService *ranking.Service,
+) *Service {
+	return &Service{
+		boundaryRepo: boundaryRepo,
+		historyRepo:  historyRepo,
+		statsRepo:    statsRepo,
+		gameRepo:     gameRepo,
+		ranking:      rankingService,
+	}
+}
+
+// WithActivityLog records recalibration tier changes in the players' activity feeds.
+func (s *Service) WithActivityLog(repo activity.Repository) *Service {
+	s.activity = repo
+	return s
+}
+
+// WithSiteFeed publishes recalibration promotions to the public site-wide activity feed.
+func (s *Service) WithSiteFeed(repo activity.SiteFeedRepository) *Service {
+	s.siteFeed = repo
+	return s
+}
+
+// CreateBoundariesRequest holds the data for a new tier boundaries version.
+// EffectiveFrom defaults to now.
+type CreateBoundariesRequest struct {
+	Season        string                    `json:"season"`
+	Intermediate  float64                   `json:"intermediate"`
+	Advanced      float64                   `json:"advanced"`
+	Elite         float64                   `json:"elite"`
+	EffectiveFrom *time.Time                `json:"effective_from"`
+	Policy        ranking.GrandfatherPolicy `json:"policy"`
+	GraceUntil    *time.Time                `json:"grace_until"`
+}
+
+// RecalibrationResult summarizes a recalibration run for a game.
+type RecalibrationResult struct {
+	GameID          uuid.UUID `json:"game_id"`
+	BoundaryVersion int       `json:"boundary_version"`
+	Evaluated       int       `json:"evaluated"`
+	Promoted        int       `json:"promoted"`
+	Demoted         int       `json:"demoted"`
+	Grandfathered   int       `json:"grandfathered"`
+}
+
+// CreateBoundaries adds a new tier boundaries version for a game.
+func (s *Service) CreateBoundaries(ctx context.Context, gameID, adminID uuid.UUID, req CreateBoundariesRequest) (*ranking.TierBoundaries, error) {
+	if _, err := s.gameRepo.GetByID(ctx, gameID.String()); err != nil {
+		return nil, fmt.Errorf("get game: %w", err)
+	}
+
+	version := 1
+	latest, err := s.boundaryRepo.GetLatest(ctx, gameID)
+	switch {
+	case err == nil:
+		version = latest.Version + 1
+	case !errors.Is(err, ranking.ErrBoundariesNotFound):
+		return nil, fmt.Errorf("get latest tier boundaries: %w", err)
+	}
+
+	effectiveFrom := time.Now().UTC()
+	if req.EffectiveFrom != nil {
+		effectiveFrom = *req.EffectiveFrom
+	}
+
+	b, err := ranking.NewTierBoundaries(
+		gameID,
+		version,
+		req.Season,
+		req.Intermediate,
+		req.Advanced,
+		req.Elite,
+		effectiveFrom,
+		req.Policy,
+		req.GraceUntil,
+		adminID,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// A version that closes a season right away records how players finished
+	// it before anything else can change their scores
+	if _, closed := ranking.ClosedSeason(b, latest); closed && s.rewards != nil && !b.EffectiveFrom.After(time.Now().UTC()) {
+		if _, err := s.seasonEnd(ctx, gameID, latest); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := s.boundaryRepo.Create(ctx, b); err != nil {
+		return nil, fmt.Errorf("create tier boundaries: %w", err)
+	}
+
+	return b, nil
+}
+
+// ListBoundaries returns every tier boundaries version for a game, newest first.
+func (s *Service) ListBoundaries(ctx context.Context, gameID uuid.UUID) ([]*ranking.TierBoundaries, error) {
+	boundaries, err := s.boundaryRepo.ListByGame(ctx, gameID)
+	if err != nil {
+		return nil, fmt.Errorf("list tier boundaries: %w", err)
+	}
+	return boundaries, nil
+}
+
+// GetRatingHistory returns a player's rating history for a game, newest first.
+func (s *Service) GetRatingHistory(ctx context.Context, playerID, gameID uuid.UUID, limit int64) ([]*ranking.HistoryEntry, error) {
+	if limit <= 0 || limit > defaultHistoryLimit {
+		limit = defaultHistoryLimit
+	}
+
+	entries, err := s.historyRepo.ListByPlayer(ctx, playerID, gameID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list rating history: %w", err)
+	}
+	return entries, nil
+}
+
+// RecalibrateAll recalibrates tiers for every active game.
+func (s *Service) RecalibrateAll(ctx context.Context) ([]RecalibrationResult, error) {
+	games, err := s.gameRepo.GetAll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("list games: %w", err)
+	}
+
+	results := make([]RecalibrationResult, 0, len(games))
+	for _, g := range games {
+		if !g.IsActive {
+			continue
+		}
+
+		result, err := s.Recalibrate(ctx, g.ID)
+		if err != nil {
+			return results, fmt.Errorf("recalibrate game %s: %w", g.ID, err)
+		}
+		results = append(results, *result)
+	}
+
+	return results, nil
+}
+
+// Recalibrate reassigns stored tiers for a game under its effective boundaries.
+// Players whose tier would drop only because of a boundary change keep their
+// tier while the grace period lasts. Every tier change is logged in rating history.
+func (s *Service) Recalibrate(ctx context.Context, gameID uuid.UUID) (*RecalibrationResult, error) {
+	now := time.Now().UTC()
+
+	current, previous, err := s.ranking.EffectiveBoundaries(ctx, gameID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := s.statsRepo.GetByGame(ctx, gameID, 0)
+	if err != nil {
+		return nil, fmt.Errorf("get player stats: %w", err)
+	}
+
+	result := &RecalibrationResult{
+		GameID:          gameID,
+		BoundaryVersion: current.Version,
+		Evaluated:       len(stats),
+	}
+
+	for _, ps := range stats {
+		tier, grandfathered := ranking.ResolveTier(ps.RankingScore, current, previous, now)
+		if grandfathered {
+			result.Grandfathered++
+		}
+		if tier == ps.Tier {
+			continue
+		}
+
+		if err := s.statsRepo.UpdateRanking(ctx, ps.ID, ps.RankingScore, ps.RatingDeviation, tier); err != nil {
+			return nil, fmt.Errorf("update tier: %w", err)
+		}
+
+		reason := ranking.HistoryReasonRecalibration
+		if grandfathered {
+			reason = ranking.HistoryReasonGrandfathered
+		}
+		entry := ranking.NewHistoryEntry(ps.PlayerID, gameID, ps.RankingScore, ps.Tier, tier, reason, current.Version)
+		if err := s.historyRepo.Create(ctx, entry); err != nil {
+			return nil, fmt.Errorf("record rating history: %w", err)
+		}
+		if s.activity != nil {
+			if err := s.activity.Create(ctx, activity.NewTierChanged(ps.PlayerID, gameID, ps.Tier, tier)); err != nil {
+				return nil, fmt.Errorf("record activity: %w", err)
+			}
+		}
+
+		if ranking.TierRank(tier) > ranking.TierRank(ps.Tier) {
+			result.Promoted++
+			if s.siteFeed != nil {
+				if err := s.siteFeed.Append(ctx, activity.NewSiteTierPromoted(ps.PlayerID, gameID, ps.Tier, tier)); err != nil {
+					return nil, fmt.Errorf("publish activity: %w", err)
+				}
+			}
+		} else {
+			result.Demoted++
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,76 @@
+package tier
+
+import (
+	"context"
+	"log/slog"
+	"time"
+)
+
+// Worker periodically distributes closed seasons' rewards and recalibrates
+// tiers in the background.
+type Worker struct {
+	service  *Service
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewWorker creates a tier recalibration worker that runs every interval.
+func NewWorker(service *Service, interval time.Duration, logger *slog.Logger) *Worker {
+	return &Worker{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run blocks, recalibrating tiers on every tick until ctx is cancelled.
+func (w *Worker) Run(ctx context.Context) {
+	w.logger.Info("tier recalibration worker started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("tier recalibration worker stopped")
+			return
+		case <-ticker.C:
+			w.distributeRewards(ctx)
+
+			results, err := w.service.RecalibrateAll(ctx)
+			if err != nil {
+				w.logger.Error("tier recalibration failed", "error", err)
+			}
+			for _, r := range results {
+				if r.Promoted > 0 || r.Demoted > 0 {
+					w.logger.Info("tiers recalibrated",
+						"game_id", r.GameID,
+						"boundary_version", r.BoundaryVersion,
+						"promoted", r.Promoted,
+						"demoted", r.Demoted,
+						"grandfathered", r.Grandfathered,
+					)
+				}
+			}
+		}
+	}
+}
+
+// distributeRewards rewards players for seasons that closed since the last tick.
+func (w *Worker) distributeRewards(ctx context.Context) {
+	results, err := w.service.DistributeAllSeasonRewards(ctx)
+	if err != nil {
+		w.logger.Error("season reward distribution failed", "error", err)
+	}
+	for _, r := range results {
+		if r.Distributed > 0 || r.Notified > 0 {
+			w.logger.Info("season rewards distributed",
+				"game_id", r.GameID,
+				"season", r.Season,
+				"distributed", r.Distributed,
+				"notified", r.Notified,
+			)
+		}
+	}
+}
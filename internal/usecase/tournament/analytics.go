@@ -0,0 +1,128 @@
+package tournament
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/google/uuid"
+)
+
+// AnalyticsCacheTTL is how long computed tournament analytics are served before recomputing.
+const AnalyticsCacheTTL = 5 * time.Minute
+
+// ErrNotOrganizer is returned when a user other than one of the tournament's organizers or an admin requests organizer data.
+var ErrNotOrganizer = errors.New("only the tournament organizer can view analytics")
+
+// TournamentAnalytics is the organizer view of a tournament's activity.
+type TournamentAnalytics struct {
+	TournamentID           uuid.UUID                `json:"tournament_id"`
+	Bucket                 match.BucketUnit         `json:"bucket"`
+	Submissions            []match.SubmissionBucket `json:"submissions"`
+	VerificationTurnaround match.Turnaround         `json:"verification_turnaround"`
+	KillDistribution       []match.KillBucket       `json:"kill_distribution"`
+	PlacementHeatmap       []match.HeatmapCell      `json:"placement_heatmap"`
+	DropOff                DropOff                  `json:"drop_off"`
+	GeneratedAt            time.Time                `json:"generated_at"`
+}
+
+// DropOff compares registered teams against teams that submitted at least one match.
+type DropOff struct {
+	RegisteredTeams int     `json:"registered_teams"`
+	ActiveTeams     int     `json:"active_teams"`
+	Rate            float64 `json:"rate"`
+}
+
+type analyticsKey struct {
+	tournamentID uuid.UUID
+	unit         match.BucketUnit
+}
+
+// analyticsCache holds computed analytics per tournament and bucket unit.
+type analyticsCache struct {
+	mu      sync.Mutex
+	entries map[analyticsKey]*TournamentAnalytics
+}
+
+func (c *analyticsCache) get(key analyticsKey, now time.Time) (*TournamentAnalytics, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	a, ok := c.entries[key]
+	if !ok || now.Sub(a.GeneratedAt) >= AnalyticsCacheTTL {
+		return nil, false
+	}
+	return a, true
+}
+
+func (c *analyticsCache) put(key analyticsKey, a *TournamentAnalytics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[analyticsKey]*TournamentAnalytics)
+	}
+	c.entries[key] = a
+}
+
+// GetTournamentAnalytics returns organizer analytics for a tournament. Only the
+// tournament's organizers and admins may view them. Results are cached for AnalyticsCacheTTL.
+func (s *Service) GetTournamentAnalytics(ctx context.Context, id uuid.UUID, unit match.BucketUnit, requesterID uuid.UUID, isAdmin bool) (*TournamentAnalytics, error) {
+	if unit == "" {
+		unit = match.BucketDay
+	}
+	if !unit.IsValid() {
+		return nil, match.ErrInvalidBucketUnit
+	}
+
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOrganizer(ctx, t, requesterID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	key := analyticsKey{tournamentID: id, unit: unit}
+	now := time.Now().UTC()
+	if cached, ok := s.analytics.get(key, now); ok {
+		return cached, nil
+	}
+
+	agg, err := s.matchRepo.AggregateTournament(ctx, id.String(), unit)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := s.teamRepo.GetByTournamentID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	dropOff := DropOff{ActiveTeams: agg.ActiveTeams}
+	for _, tm := range teams {
+		if tm.Status != team.StatusDisbanded {
+			dropOff.RegisteredTeams++
+		}
+	}
+	if dropOff.RegisteredTeams > 0 && dropOff.ActiveTeams < dropOff.RegisteredTeams {
+		dropOff.Rate = float64(dropOff.RegisteredTeams-dropOff.ActiveTeams) / float64(dropOff.RegisteredTeams)
+	}
+
+	analytics := &TournamentAnalytics{
+		TournamentID:           id,
+		Bucket:                 unit,
+		Submissions:            agg.Submissions,
+		VerificationTurnaround: agg.Turnaround,
+		KillDistribution:       match.KillDistribution(agg.KillCounts),
+		PlacementHeatmap:       match.PlacementHeatmap(agg.Placements),
+		DropOff:                dropOff,
+		GeneratedAt:            now,
+	}
+	s.analytics.put(key, analytics)
+
+	return analytics, nil
+}
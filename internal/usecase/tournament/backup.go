@@ -0,0 +1,160 @@
+package tournament
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+)
+
+// backupFormatVersion is written to every backup object and checked on restore.
+const backupFormatVersion = 1
+
+var (
+	// ErrBackupUnavailable is returned when tournament backups are not configured.
+	ErrBackupUnavailable = errors.New("tournament backups are not available")
+	// ErrUnsupportedBackup is returned when a backup object cannot be read back.
+	ErrUnsupportedBackup = errors.New("unsupported tournament backup format")
+)
+
+// BackupStore holds backup objects.
+type BackupStore interface {
+	Put(ctx context.Context, key string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+}
+
+// backupObject is the stored form of a backup. Standings are derived from the
+// matches and are kept only to show what the tournament looked like.
+type backupObject struct {
+	Version      int        `json:"version"`
+	TournamentID uuid.UUID  `json:"tournament_id"`
+	CreatedAt    time.Time  `json:"created_at"`
+	Standings    []Standing `json:"standings"`
+	tournament.Snapshot
+}
+
+// RestoreResponse reports a restore. Safety is the backup of the state the
+// restore replaced; it is nil when the tournament no longer existed.
+type RestoreResponse struct {
+	Restored *tournament.Backup `json:"restored"`
+	Safety   *tournament.Backup `json:"safety_backup,omitempty"`
+}
+
+// WithBackups enables tournament backups to object storage.
+func (s *Service) WithBackups(snapshots tournament.Snapshotter, backups tournament.BackupRepository, store BackupStore) *Service {
+	s.snapshots = snapshots
+	s.backups = backups
+	s.backupStore = store
+	return s
+}
+
+// CreateBackup snapshots a tournament with its teams and matches and uploads
+// the snapshot to object storage.
+func (s *Service) CreateBackup(ctx context.Context, id, createdBy uuid.UUID, reason string) (*tournament.Backup, error) {
+	if s.snapshots == nil {
+		return nil, ErrBackupUnavailable
+	}
+	return s.backup(ctx, id, createdBy, reason)
+}
+
+// ListBackups returns a tournament's backups, newest first. Backups of a
+// deleted tournament are still listed so that it can be restored.
+func (s *Service) ListBackups(ctx context.Context, id uuid.UUID) ([]*tournament.Backup, error) {
+	if s.snapshots == nil {
+		return nil, ErrBackupUnavailable
+	}
+	return s.backups.ListByTournament(ctx, id)
+}
+
+// RestoreBackup rolls a tournament, its teams and its matches back to a
+// backup. The current state is backed up first, so a restore can be undone
+// by restoring that safety backup. Player stats and ratings are not part of
+// a tournament backup and are left as they are.
+func (s *Service) RestoreBackup(ctx context.Context, id, backupID, restoredBy uuid.UUID) (*RestoreResponse, error) {
+	if s.snapshots == nil {
+		return nil, ErrBackupUnavailable
+	}
+
+	b, err := s.backups.GetByID(ctx, backupID)
+	if err != nil {
+		return nil, err
+	}
+	if b.TournamentID != id {
+		return nil, tournament.ErrBackupNotFound
+	}
+
+	data, err := s.backupStore.Get(ctx, b.Key)
+	if err != nil {
+		return nil, fmt.Errorf("download backup: %w", err)
+	}
+	var obj backupObject
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedBackup, err)
+	}
+	if obj.Version != backupFormatVersion {
+		return nil, fmt.Errorf("%w: version %d", ErrUnsupportedBackup, obj.Version)
+	}
+	if obj.TournamentID != id {
+		return nil, tournament.ErrBackupMismatch
+	}
+
+	resp := &RestoreResponse{Restored: b}
+	safety, err := s.backup(ctx, id, restoredBy, fmt.Sprintf("automatic backup before restoring %s", b.ID))
+	switch {
+	case err == nil:
+		resp.Safety = safety
+	case !errors.Is(err, tournament.ErrNotFound):
+		return nil, fmt.Errorf("back up current state: %w", err)
+	}
+
+	if err := s.snapshots.Restore(ctx, id, &obj.Snapshot); err != nil {
+		return nil, fmt.Errorf("restore snapshot: %w", err)
+	}
+
+	b.MarkRestored(restoredBy, time.Now().UTC())
+	if err := s.backups.Update(ctx, b); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (s *Service) backup(ctx context.Context, id, createdBy uuid.UUID, reason string) (*tournament.Backup, error) {
+	snapshot, err := s.snapshots.Snapshot(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	b, err := tournament.NewBackup(id, createdBy, reason, snapshot, now)
+	if err != nil {
+		return nil, err
+	}
+
+	standings, err := s.GetStandings(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("compute standings: %w", err)
+	}
+	data, err := json.Marshal(backupObject{
+		Version:      backupFormatVersion,
+		TournamentID: id,
+		CreatedAt:    now,
+		Standings:    standings.Standings,
+		Snapshot:     *snapshot,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("encode backup: %w", err)
+	}
+	b.SizeBytes = len(data)
+
+	if err := s.backupStore.Put(ctx, b.Key, data); err != nil {
+		return nil, fmt.Errorf("upload backup: %w", err)
+	}
+	if err := s.backups.Create(ctx, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
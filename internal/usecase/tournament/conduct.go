@@ -0,0 +1,107 @@
+package tournament
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/usecase/conduct"
+	"github.com/google/uuid"
+)
+
+var (
+	// ErrConductUnavailable is returned when conduct scores are not configured.
+	ErrConductUnavailable = errors.New("player conduct is not available")
+	// ErrPlayerNotInTournament is returned when an organizer looks up or
+	// reports a player who is not on a team in their tournament.
+	ErrPlayerNotInTournament = errors.New("player is not on a team in this tournament")
+)
+
+// WithConduct lets organizers report players in their tournaments and see
+// the players' conduct scores.
+func (s *Service) WithConduct(conduct *conduct.Service) *Service {
+	s.conduct = conduct
+	return s
+}
+
+// RecordConductRequest reports a player's conduct in the tournament.
+type RecordConductRequest struct {
+	PlayerID uuid.UUID          `json:"player_id"`
+	Kind     player.ConductKind `json:"kind"`               // "report", "no_show" or "dispute_lost"
+	MatchID  *uuid.UUID         `json:"match_id,omitempty"` // The match it happened in, if any
+	Note     string             `json:"note,omitempty"`
+}
+
+// RecordConduct records a conduct report against a player on one of the
+// tournament's teams. Only the tournament's organizers and admins may report.
+func (s *Service) RecordConduct(ctx context.Context, id uuid.UUID, req RecordConductRequest, requesterID uuid.UUID, isAdmin bool) (*player.ConductRecord, error) {
+	if s.conduct == nil {
+		return nil, ErrConductUnavailable
+	}
+
+	t, err := s.conductTournament(ctx, id, req.PlayerID, requesterID, isAdmin)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := player.NewConductRecord(req.PlayerID, req.Kind, req.Note, requesterID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	record.TournamentID = &t.ID
+
+	if req.MatchID != nil {
+		m, err := s.matchRepo.GetByID(ctx, req.MatchID.String())
+		if err != nil {
+			return nil, fmt.Errorf("get match: %w", err)
+		}
+		if m.TournamentID != t.ID {
+			return nil, match.ErrNotFound
+		}
+		record.MatchID = &m.ID
+	}
+
+	if err := s.conduct.Record(ctx, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// GetPlayerConduct returns the conduct score and records of a player on one
+// of the tournament's teams. Only the tournament's organizers and admins may view
+// them.
+func (s *Service) GetPlayerConduct(ctx context.Context, id, playerID, requesterID uuid.UUID, isAdmin bool) (*conduct.PlayerConduct, error) {
+	if s.conduct == nil {
+		return nil, ErrConductUnavailable
+	}
+
+	if _, err := s.conductTournament(ctx, id, playerID, requesterID, isAdmin); err != nil {
+		return nil, err
+	}
+	return s.conduct.Get(ctx, playerID)
+}
+
+// conductTournament returns the tournament after checking that the requester
+// organizes it and that the player is on one of its teams.
+func (s *Service) conductTournament(ctx context.Context, id, playerID, requesterID uuid.UUID, isAdmin bool) (*tournament.Tournament, error) {
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOrganizer(ctx, t, requesterID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	if _, err := s.teamRepo.GetPlayerTeamInTournament(ctx, playerID, id); err != nil {
+		if errors.Is(err, team.ErrNotFound) {
+			return nil, ErrPlayerNotInTournament
+		}
+		return nil, err
+	}
+	return t, nil
+}
@@ -0,0 +1,239 @@
+package tournament
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+)
+
+// ErrExportUnavailable is returned when tournament exports are not configured.
+var ErrExportUnavailable = errors.New("tournament exports are not available")
+
+// WithExports enables whole-tournament exports. Download links are signed with signingKey.
+func (s *Service) WithExports(repo tournament.ExportRepository, signingKey []byte) *Service {
+	s.exports = repo
+	s.exportKey = signingKey
+	return s
+}
+
+// ExportResponse describes a tournament export. DownloadURL is only set once
+// the bundle is ready and is valid until DownloadExpiresAt.
+type ExportResponse struct {
+	*tournament.Export
+	DownloadURL       string     `json:"download_url,omitempty"`
+	DownloadExpiresAt *time.Time `json:"download_expires_at,omitempty"`
+}
+
+// RequestExport returns the tournament's current export, queueing a new one
+// when there is none or the previous one failed or expired. Only the
+// tournament's organizers and admins may export. The boolean result reports
+// whether a new export was queued.
+func (s *Service) RequestExport(ctx context.Context, id uuid.UUID, requesterID uuid.UUID, isAdmin bool) (*ExportResponse, bool, error) {
+	if s.exports == nil {
+		return nil, false, ErrExportUnavailable
+	}
+
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := s.requireOrganizer(ctx, t, requesterID, isAdmin); err != nil {
+		return nil, false, err
+	}
+
+	now := time.Now().UTC()
+	latest, err := s.exports.GetLatestByTournament(ctx, id)
+	if err != nil && !errors.Is(err, tournament.ErrExportNotFound) {
+		return nil, false, err
+	}
+	if latest != nil && latest.Reusable(now) {
+		return s.exportResponse(latest, now), false, nil
+	}
+
+	export := tournament.NewExport(id, requesterID)
+	if err := s.exports.Create(ctx, export); err != nil {
+		return nil, false, err
+	}
+	return s.exportResponse(export, now), true, nil
+}
+
+// DownloadExport returns a finished export bundle after checking the signed link.
+func (s *Service) DownloadExport(ctx context.Context, tournamentID, exportID uuid.UUID, expires time.Time, signature string) (*tournament.Export, error) {
+	if s.exports == nil {
+		return nil, ErrExportUnavailable
+	}
+
+	now := time.Now().UTC()
+	if err := tournament.VerifyExportDownload(s.exportKey, exportID, expires, signature, now); err != nil {
+		return nil, err
+	}
+
+	export, err := s.exports.GetByID(ctx, exportID)
+	if err != nil {
+		return nil, err
+	}
+	if export.TournamentID != tournamentID {
+		return nil, tournament.ErrExportNotFound
+	}
+	if export.Status != tournament.ExportDone || export.Expired(now) {
+		return nil, tournament.ErrExportNotReady
+	}
+	return export, nil
+}
+
+// ProcessPendingExports generates the bundle for every queued export and
+// returns how many were processed.
+func (s *Service) ProcessPendingExports(ctx context.Context) (int, error) {
+	if s.exports == nil {
+		return 0, nil
+	}
+
+	processed := 0
+	for ctx.Err() == nil {
+		export, err := s.exports.ClaimPending(ctx)
+		if errors.Is(err, tournament.ErrExportNotFound) {
+			break
+		}
+		if err != nil {
+			return processed, err
+		}
+
+		bundle, buildErr := s.buildExportBundle(ctx, export.TournamentID)
+		now := time.Now().UTC()
+		if buildErr != nil {
+			export.Fail(buildErr.Error(), now)
+		} else {
+			export.Complete(bundle, now)
+		}
+		if err := s.exports.Update(ctx, export); err != nil {
+			return processed, err
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// exportResponse attaches a signed download link to finished exports.
+func (s *Service) exportResponse(export *tournament.Export, now time.Time) *ExportResponse {
+	resp := &ExportResponse{Export: export}
+	if export.Status != tournament.ExportDone {
+		return resp
+	}
+
+	expires := now.Add(tournament.ExportLinkTTL).Truncate(time.Second)
+	if export.ExpiresAt != nil && export.ExpiresAt.Before(expires) {
+		expires = export.ExpiresAt.Truncate(time.Second)
+	}
+
+	query := url.Values{}
+	query.Set("expires", strconv.FormatInt(expires.Unix(), 10))
+	query.Set("signature", tournament.SignExportDownload(s.exportKey, export.ID, expires))
+	resp.DownloadURL = fmt.Sprintf("/api/v1/tournaments/%s/export/%s?%s", export.TournamentID, export.ID, query.Encode())
+	resp.DownloadExpiresAt = &expires
+	return resp
+}
+
+// buildExportBundle zips the tournament, its teams and rosters, all of its
+// matches with their stats, and the final standings as JSON files.
+func (s *Service) buildExportBundle(ctx context.Context, id uuid.UUID) ([]byte, error) {
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	teams, err := s.teamRepo.GetByTournamentID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if teams == nil {
+		teams = []*team.Team{}
+	}
+
+	matches, err := s.matchRepo.GetByTournament(ctx, id.String(), "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	if matches == nil {
+		matches = []match.Match{}
+	}
+
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	files := []struct {
+		name string
+		data any
+	}{
+		{name: "tournament.json", data: t},
+		{name: "teams.json", data: teams},
+		{name: "matches.json", data: matches},
+		{name: "standings.json", data: standings(teams, matches)},
+	}
+	for _, f := range files {
+		w, err := zw.Create(f.name)
+		if err != nil {
+			return nil, fmt.Errorf("adding %s to export: %w", f.name, err)
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(f.data); err != nil {
+			return nil, fmt.Errorf("encoding %s: %w", f.name, err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("closing export archive: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// ExportWorker periodically generates queued tournament exports.
+type ExportWorker struct {
+	service  *Service
+	interval time.Duration
+	logger   *slog.Logger
+}
+
+// NewExportWorker creates a tournament export worker that runs every interval.
+func NewExportWorker(service *Service, interval time.Duration, logger *slog.Logger) *ExportWorker {
+	return &ExportWorker{
+		service:  service,
+		interval: interval,
+		logger:   logger,
+	}
+}
+
+// Run blocks, processing queued exports on every tick until ctx is cancelled.
+func (w *ExportWorker) Run(ctx context.Context) {
+	w.logger.Info("tournament export worker started", "interval", w.interval)
+
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			w.logger.Info("tournament export worker stopped")
+			return
+		case <-ticker.C:
+			processed, err := w.service.ProcessPendingExports(ctx)
+			if err != nil && ctx.Err() == nil {
+				w.logger.Error("tournament export processing failed", "error", err)
+			}
+			if processed > 0 {
+				w.logger.Info("tournament exports processed", "count", processed)
+			}
+		}
+	}
+}
@@ -0,0 +1,49 @@
+package tournament
+
+import (
+	"context"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+)
+
+// FillProjectionResponse is the organizer view of whether a tournament will fill.
+type FillProjectionResponse struct {
+	TournamentID uuid.UUID `json:"tournament_id"`
+	tournament.FillProjection
+	GeneratedAt time.Time `json:"generated_at"`
+}
+
+// GetFillProjection projects whether a tournament's team slots fill before
+// registration closes, from when its teams registered. Disbanded teams do not
+// hold a slot. Only the tournament's organizers and admins may view it.
+func (s *Service) GetFillProjection(ctx context.Context, id uuid.UUID, requesterID uuid.UUID, isAdmin bool) (*FillProjectionResponse, error) {
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOrganizer(ctx, t, requesterID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	teams, err := s.teamRepo.GetByTournamentID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	registeredAt := make([]time.Time, 0, len(teams))
+	for _, tm := range teams {
+		if tm.Status != team.StatusDisbanded {
+			registeredAt = append(registeredAt, tm.CreatedAt)
+		}
+	}
+
+	now := time.Now().UTC()
+	return &FillProjectionResponse{
+		TournamentID:   id,
+		FillProjection: tournament.ProjectFill(t, registeredAt, now),
+		GeneratedAt:    now,
+	}, nil
+}
@@ -0,0 +1,272 @@
+package tournament
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+// ErrImportUnavailable is returned when tournament imports are not configured.
+var ErrImportUnavailable = errors.New("tournament imports are not available")
+
+// WithImports enables importing teams and results from external platforms.
+// Players and users are used to map imported rosters to local players.
+func (s *Service) WithImports(players player.Repository, users user.Repository) *Service {
+	s.players = players
+	s.users = users
+	return s
+}
+
+// ImportRequest is an external tournament export to import into a tournament.
+type ImportRequest struct {
+	Format      tournament.ImportFormat `json:"format"`
+	PlatformKey string                  `json:"platform_key"` // Player platform ID key imported IDs are matched against, e.g. "activision_id"
+	DryRun      bool                    `json:"dry_run"`
+	Data        json.RawMessage         `json:"data"`
+}
+
+// Import statuses reported for teams and players.
+const (
+	ImportTeamCreated  = "created"
+	ImportTeamExisting = "existing" // A team with the same name is already registered; left unchanged
+	ImportTeamSkipped  = "skipped"
+
+	ImportPlayerMatchedPlatformID = "matched_platform_id"
+	ImportPlayerMatchedEmail      = "matched_email"
+	ImportPlayerUnmatched         = "unmatched"
+	ImportPlayerAlreadyRostered   = "already_rostered" // Already on another team in the tournament
+	ImportPlayerRosterFull        = "roster_full"
+)
+
+// ImportPlayerReport is the reconciliation outcome for one imported player.
+type ImportPlayerReport struct {
+	tournament.ImportedPlayer
+	Status   string     `json:"status"`
+	PlayerID *uuid.UUID `json:"player_id,omitempty"`
+}
+
+// ImportTeamReport is the reconciliation outcome for one imported team.
+type ImportTeamReport struct {
+	Name    string               `json:"name"`
+	Status  string               `json:"status"`
+	TeamID  *uuid.UUID           `json:"team_id,omitempty"`
+	Reason  string               `json:"reason,omitempty"`
+	Players []ImportPlayerReport `json:"players"`
+}
+
+// ImportSkippedResult is an imported result that was not recorded.
+type ImportSkippedResult struct {
+	tournament.ImportedResult
+	Reason string `json:"reason"`
+}
+
+// ImportReport summarizes how an external export was reconciled with local data.
+type ImportReport struct {
+	Format          tournament.ImportFormat `json:"format"`
+	DryRun          bool                    `json:"dry_run"`
+	Teams           []ImportTeamReport      `json:"teams"`
+	TeamsCreated    int                     `json:"teams_created"`
+	PlayersMatched  int                     `json:"players_matched"`
+	PlayersMissing  int                     `json:"players_unmatched"`
+	MatchesImported int                     `json:"matches_imported"`
+	SkippedResults  []ImportSkippedResult   `json:"skipped_results"`
+}
+
+// ImportTournament imports teams and results exported from Battlefy or
+// Toornament into a tournament. Only the tournament's organizers and admins may
+// import. Teams whose name is already registered are left unchanged and their
+// results skipped, so re-running an import does not duplicate history.
+// Imported results are recorded as verified matches. With DryRun set the
+// reconciliation report is returned without writing anything.
+func (s *Service) ImportTournament(ctx context.Context, id uuid.UUID, req ImportRequest, requesterID uuid.UUID, isAdmin bool) (*ImportReport, error) {
+	if s.players == nil || s.users == nil {
+		return nil, ErrImportUnavailable
+	}
+
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOrganizer(ctx, t, requesterID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	data, err := tournament.ParseImport(req.Format, req.Data, req.PlatformKey)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.teamRepo.GetByTournamentID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	existingByName := make(map[string]*team.Team, len(existing))
+	rostered := make(map[uuid.UUID]bool)
+	for _, tm := range existing {
+		existingByName[strings.ToLower(tm.Name)] = tm
+		for _, memberID := range tm.MemberIDs {
+			rostered[memberID] = true
+		}
+	}
+
+	report := &ImportReport{
+		Format:         req.Format,
+		DryRun:         req.DryRun,
+		SkippedResults: []ImportSkippedResult{},
+	}
+	created := make(map[string]*team.Team)
+
+	for _, imported := range data.Teams {
+		teamReport := ImportTeamReport{Name: imported.Name, Players: []ImportPlayerReport{}}
+		var memberIDs []uuid.UUID
+		captainID := uuid.Nil
+
+		for _, ip := range imported.Players {
+			pr := ImportPlayerReport{ImportedPlayer: ip}
+			p, status, err := s.matchImportedPlayer(ctx, ip, req.PlatformKey)
+			if err != nil {
+				return nil, err
+			}
+			pr.Status = status
+			if p != nil {
+				pr.PlayerID = &p.ID
+				switch {
+				case rostered[p.ID]:
+					pr.Status = ImportPlayerAlreadyRostered
+				case len(memberIDs) >= t.MaxRoster():
+					pr.Status = ImportPlayerRosterFull
+				default:
+					memberIDs = append(memberIDs, p.ID)
+					if ip.Captain || captainID == uuid.Nil {
+						captainID = p.ID
+					}
+				}
+			}
+			if pr.Status == ImportPlayerMatchedPlatformID || pr.Status == ImportPlayerMatchedEmail {
+				report.PlayersMatched++
+			} else if pr.Status == ImportPlayerUnmatched {
+				report.PlayersMissing++
+			}
+			teamReport.Players = append(teamReport.Players, pr)
+		}
+
+		if tm, ok := existingByName[strings.ToLower(imported.Name)]; ok {
+			teamReport.Status = ImportTeamExisting
+			teamReport.TeamID = &tm.ID
+			report.Teams = append(report.Teams, teamReport)
+			continue
+		}
+		if len(memberIDs) == 0 {
+			teamReport.Status = ImportTeamSkipped
+			teamReport.Reason = "no players could be matched to local players"
+			report.Teams = append(report.Teams, teamReport)
+			continue
+		}
+
+		tm, err := team.NewTeam(id, captainID, imported.Name)
+		if err != nil {
+			return nil, err
+		}
+		for _, memberID := range memberIDs {
+			if memberID != captainID {
+				tm.MemberIDs = append(tm.MemberIDs, memberID)
+			}
+			rostered[memberID] = true
+		}
+		if len(tm.MemberIDs) >= int(t.TeamSize) {
+			tm.Status = team.StatusReady
+		}
+		if !req.DryRun {
+			if err := s.teamRepo.Create(ctx, tm); err != nil {
+				return nil, fmt.Errorf("creating imported team %q: %w", imported.Name, err)
+			}
+		}
+
+		created[strings.ToLower(imported.Name)] = tm
+		teamReport.Status = ImportTeamCreated
+		teamReport.TeamID = &tm.ID
+		report.TeamsCreated++
+		report.Teams = append(report.Teams, teamReport)
+	}
+
+	for _, result := range data.Results {
+		key := strings.ToLower(result.Team)
+		tm, ok := created[key]
+		if !ok {
+			reason := "team was not imported"
+			if _, exists := existingByName[key]; exists {
+				reason = "team was already registered"
+			}
+			report.SkippedResults = append(report.SkippedResults, ImportSkippedResult{ImportedResult: result, Reason: reason})
+			continue
+		}
+
+		stats := make([]match.PlayerMatchStats, 0, len(tm.MemberIDs))
+		for _, memberID := range tm.MemberIDs {
+			stats = append(stats, match.PlayerMatchStats{PlayerID: memberID})
+		}
+		m, err := match.NewMatch(id, tm.ID, t.GameID, result.Placement, 0, stats, "", requesterID)
+		if err != nil {
+			report.SkippedResults = append(report.SkippedResults, ImportSkippedResult{ImportedResult: result, Reason: err.Error()})
+			continue
+		}
+		if err := m.VerifyMatch(requesterID); err != nil {
+			return nil, err
+		}
+		if !req.DryRun {
+			if err := s.matchRepo.Create(ctx, m); err != nil {
+				return nil, fmt.Errorf("creating imported match: %w", err)
+			}
+		}
+		report.MatchesImported++
+	}
+
+	return report, nil
+}
+
+// matchImportedPlayer finds the local player for an imported roster entry,
+// by platform ID when a platform key is given and then by email.
+func (s *Service) matchImportedPlayer(ctx context.Context, ip tournament.ImportedPlayer, platformKey string) (*player.Player, string, error) {
+	if platformKey != "" && ip.PlatformID != "" {
+		p, err := s.players.GetByPlatformID(ctx, platformKey, ip.PlatformID)
+		if err == nil {
+			return p, ImportPlayerMatchedPlatformID, nil
+		}
+		if !errors.Is(err, player.ErrNotFound) {
+			return nil, "", err
+		}
+	}
+
+	if ip.Email != "" {
+		email, err := user.NormalizeEmail(ip.Email)
+		if err != nil {
+			return nil, ImportPlayerUnmatched, nil
+		}
+		u, err := s.users.GetByEmail(ctx, email)
+		if errors.Is(err, user.ErrNotFound) {
+			return nil, ImportPlayerUnmatched, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		p, err := s.players.GetByUserID(ctx, u.ID.String())
+		if errors.Is(err, player.ErrNotFound) {
+			return nil, ImportPlayerUnmatched, nil
+		}
+		if err != nil {
+			return nil, "", err
+		}
+		return p, ImportPlayerMatchedEmail, nil
+	}
+
+	return nil, ImportPlayerUnmatched, nil
+}
@@ -0,0 +1,102 @@
+package tournament
+
+import (
+	"context"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
+	"github.com/google/uuid"
+)
+
+// InviteRequest invites a player to register a team in the tournament.
+type InviteRequest struct {
+	PlayerID uuid.UUID `json:"player_id"`
+	Name     string    `json:"name,omitempty"` // Shown on the public list, e.g. the qualifying team's name
+}
+
+// InviteListResponse is the public list of a tournament's invited teams.
+type InviteListResponse struct {
+	pagination.Page[tournament.Invite]
+	InviteOnly bool `json:"invite_only"`
+	Slots      int  `json:"slots"`       // 0 when every invitation is offered
+	SlotsTaken int  `json:"slots_taken"` // Offered and accepted invitations
+}
+
+// ListInvites returns the tournament's invitations in the order they were
+// sent, with the slots they hold.
+func (s *Service) ListInvites(ctx context.Context, id uuid.UUID) (*InviteListResponse, error) {
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return inviteList(t), nil
+}
+
+// InvitePlayer invites a player to register a team. The invitation is
+// offered if a slot is free and kept as a reserve otherwise. Only the
+// tournament's organizers and admins may invite.
+func (s *Service) InvitePlayer(ctx context.Context, id uuid.UUID, req InviteRequest, requesterID uuid.UUID, isAdmin bool) (*InviteListResponse, error) {
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOrganizer(ctx, t, requesterID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	if _, err := t.Invite(req.PlayerID, req.Name, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.tournamentRepo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+	return inviteList(t), nil
+}
+
+// RevokeInvite withdraws a player's invitation that has not been accepted,
+// offering its slot to the first reserve. Only the tournament's organizers
+// and admins may revoke invitations.
+func (s *Service) RevokeInvite(ctx context.Context, id, playerID, requesterID uuid.UUID, isAdmin bool) (*InviteListResponse, error) {
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOrganizer(ctx, t, requesterID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	if err := t.RevokeInvite(playerID, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.tournamentRepo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+	return inviteList(t), nil
+}
+
+// DeclineInvite lets an invited player give up their invitation, offering
+// its slot to the first reserve.
+func (s *Service) DeclineInvite(ctx context.Context, id, playerID uuid.UUID) (*InviteListResponse, error) {
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := t.DeclineInvite(playerID, time.Now().UTC()); err != nil {
+		return nil, err
+	}
+	if err := s.tournamentRepo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+	return inviteList(t), nil
+}
+
+func inviteList(t *tournament.Tournament) *InviteListResponse {
+	return &InviteListResponse{
+		Page:       pagination.All(t.Invites),
+		InviteOnly: t.Rules.InviteOnly,
+		Slots:      t.InviteSlots(),
+		SlotsTaken: t.SlotsTaken(),
+	}
+}
@@ -0,0 +1,38 @@
+package tournament
+
+import (
+	"context"
+
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/usecase/organization"
+	"github.com/google/uuid"
+)
+
+// WithOrganizations gives the staff of an organization organizer permissions
+// in every tournament of its series, and qualifies the top teams of a series'
+// circuit when its finals open registration.
+func (s *Service) WithOrganizations(organizations *organization.Service) *Service {
+	s.organizations = organizations
+	return s
+}
+
+// requireOrganizer returns ErrNotOrganizer unless the requester is an admin,
+// created the tournament, or is on the staff of the organization running its
+// series.
+func (s *Service) requireOrganizer(ctx context.Context, t *tournament.Tournament, requesterID uuid.UUID, isAdmin bool) error {
+	if isAdmin || t.CreatedBy == requesterID {
+		return nil
+	}
+	if s.organizations == nil {
+		return ErrNotOrganizer
+	}
+
+	ok, err := s.organizations.Organizes(ctx, t, requesterID)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return ErrNotOrganizer
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package tournament
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/google/uuid"
+)
+
+// ErrRosterOverlapsUnavailable is returned when roster overlap detection is not configured.
+var ErrRosterOverlapsUnavailable = errors.New("roster overlap review is not available")
+
+// WithRosterOverlaps lets organizers review the roster overlaps flagged when
+// the tournament's matches are verified.
+func (s *Service) WithRosterOverlaps(repo team.RosterOverlapRepository) *Service {
+	s.overlaps = repo
+	return s
+}
+
+// ReviewRosterOverlapRequest records the organizer's decision on a roster overlap.
+type ReviewRosterOverlapRequest struct {
+	Status team.RosterOverlapStatus `json:"status"` // "confirmed" or "dismissed"
+	Note   string                   `json:"note,omitempty"`
+}
+
+// ListRosterOverlaps returns the tournament's flagged roster overlaps, newest
+// first. Only the tournament's organizers and admins may review them.
+func (s *Service) ListRosterOverlaps(ctx context.Context, id, requesterID uuid.UUID, isAdmin bool) ([]*team.RosterOverlap, error) {
+	if s.overlaps == nil {
+		return nil, ErrRosterOverlapsUnavailable
+	}
+
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOrganizer(ctx, t, requesterID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	return s.overlaps.ListByTournament(ctx, id)
+}
+
+// ReviewRosterOverlap confirms or dismisses a flagged roster overlap. Only the
+// tournament's organizers and admins may review it.
+func (s *Service) ReviewRosterOverlap(ctx context.Context, id, overlapID uuid.UUID, req ReviewRosterOverlapRequest, requesterID uuid.UUID, isAdmin bool) (*team.RosterOverlap, error) {
+	if s.overlaps == nil {
+		return nil, ErrRosterOverlapsUnavailable
+	}
+
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOrganizer(ctx, t, requesterID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	overlap, err := s.overlaps.GetByID(ctx, overlapID)
+	if err != nil {
+		return nil, err
+	}
+	if overlap.TournamentID != id {
+		return nil, team.ErrRosterOverlapNotFound
+	}
+
+	if err := overlap.Review(req.Status, requesterID, req.Note, time.Now()); err != nil {
+		return nil, err
+	}
+	if err := s.overlaps.Update(ctx, overlap); err != nil {
+		return nil, err
+	}
+	return overlap, nil
+}
@@ -0,0 +1,115 @@
+package tournament
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/usecase/fieldset"
+	"github.com/google/uuid"
+)
+
+// ErrRulebookUnavailable is returned when tournament rulebooks are not configured.
+var ErrRulebookUnavailable = errors.New("tournament rulebooks are not available")
+
+// WithRulebooks enables structured rules and FAQ sections per tournament.
+func (s *Service) WithRulebooks(repo tournament.RulebookRepository) *Service {
+	s.rulebooks = repo
+	return s
+}
+
+// SectionRequest is one rulebook section as sent by the organizer. ID is
+// left empty for new sections.
+type SectionRequest struct {
+	ID      uuid.UUID              `json:"id,omitempty"`
+	Kind    tournament.SectionKind `json:"kind"`
+	Title   string                 `json:"title"`
+	Content string                 `json:"content"`
+}
+
+// UpdateRulebookRequest replaces a tournament's rulebook. Sections are shown
+// in the order given.
+type UpdateRulebookRequest struct {
+	Sections []SectionRequest `json:"sections"`
+}
+
+// TournamentOverview is the public view of a tournament together with its
+// rulebook. Rulebook is omitted when rulebooks are not configured.
+type TournamentOverview struct {
+	*tournament.Tournament
+	Rulebook *tournament.Rulebook `json:"rulebook,omitempty"`
+}
+
+// GetTournamentOverview retrieves a tournament with its rules and FAQ, with
+// only the fields in fields. Fields left out are not loaded; the rulebook is
+// only read when asked for.
+func (s *Service) GetTournamentOverview(ctx context.Context, id uuid.UUID, fields fieldset.Set) (any, error) {
+	t, err := s.tournamentRepo.GetByIDFields(ctx, id, fields.Fields())
+	if err != nil {
+		return nil, err
+	}
+
+	overview := &TournamentOverview{Tournament: t}
+	if s.rulebooks != nil && fields.Has("rulebook") {
+		overview.Rulebook, err = s.rulebook(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return fields.Project(overview)
+}
+
+// GetRulebook retrieves a tournament's rules and FAQ. A tournament whose
+// organizer has not written any yet has an empty rulebook.
+func (s *Service) GetRulebook(ctx context.Context, id uuid.UUID) (*tournament.Rulebook, error) {
+	if s.rulebooks == nil {
+		return nil, ErrRulebookUnavailable
+	}
+	if _, err := s.tournamentRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+	return s.rulebook(ctx, id)
+}
+
+// UpdateRulebook replaces a tournament's rules and FAQ sections. Only the
+// tournament's organizers and admins may edit the rulebook.
+func (s *Service) UpdateRulebook(ctx context.Context, id uuid.UUID, req UpdateRulebookRequest, requesterID uuid.UUID, isAdmin bool) (*tournament.Rulebook, error) {
+	if s.rulebooks == nil {
+		return nil, ErrRulebookUnavailable
+	}
+
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOrganizer(ctx, t, requesterID, isAdmin); err != nil {
+		return nil, err
+	}
+
+	rb, err := s.rulebook(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	sections := make([]*tournament.Section, len(req.Sections))
+	for i, sec := range req.Sections {
+		sections[i] = &tournament.Section{ID: sec.ID, Kind: sec.Kind, Title: sec.Title, Content: sec.Content}
+	}
+	if err := rb.SetSections(sections, requesterID, time.Now()); err != nil {
+		return nil, err
+	}
+
+	if err := s.rulebooks.Save(ctx, rb); err != nil {
+		return nil, err
+	}
+	return rb, nil
+}
+
+func (s *Service) rulebook(ctx context.Context, id uuid.UUID) (*tournament.Rulebook, error) {
+	rb, err := s.rulebooks.GetByTournament(ctx, id)
+	if errors.Is(err, tournament.ErrRulebookNotFound) {
+		return tournament.NewRulebook(id), nil
+	}
+	return rb, err
+}
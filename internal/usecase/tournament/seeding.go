@@ -0,0 +1,162 @@
+package tournament
+
+import (
+	"context"
+	"time"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/google/uuid"
+)
+
+// ImportSeedingRequest names the finished tournament whose final standings
+// seed the tournament.
+type ImportSeedingRequest struct {
+	SourceTournamentID uuid.UUID `json:"source_tournament_id"`
+}
+
+// ResolveSeedRequest sets the source team a team is seeded from; null seeds
+// it without one.
+type ResolveSeedRequest struct {
+	SourceTeamID *uuid.UUID `json:"source_team_id"`
+}
+
+// SeedingResponse is a tournament's seeding with the number of changed
+// rosters still waiting for the organizer.
+type SeedingResponse struct {
+	*tournament.Seeding
+	Pending int `json:"pending"`
+}
+
+// GetSeeding returns the tournament's imported seeding.
+func (s *Service) GetSeeding(ctx context.Context, id uuid.UUID) (*SeedingResponse, error) {
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if t.Seeding == nil {
+		return nil, tournament.ErrSeedingNotFound
+	}
+	return seedingResponse(t.Seeding), nil
+}
+
+// ImportSeeding seeds the tournament's registered teams by the final
+// standings of a finished tournament, replacing any earlier import. Teams
+// registered later are not seeded until the next import. Only the
+// tournament's organizers and admins may import seeding.
+func (s *Service) ImportSeeding(ctx context.Context, id uuid.UUID, req ImportSeedingRequest, requesterID uuid.UUID, isAdmin bool) (*SeedingResponse, error) {
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOrganizer(ctx, t, requesterID, isAdmin); err != nil {
+		return nil, err
+	}
+	if !t.CanReseed() {
+		return nil, tournament.ErrSeedingLocked
+	}
+
+	if req.SourceTournamentID == t.ID {
+		return nil, tournament.ErrInvalidSeedingSource
+	}
+	src, err := s.tournamentRepo.GetByID(ctx, req.SourceTournamentID)
+	if err != nil {
+		return nil, err
+	}
+	if src.Status != tournament.StatusFinished {
+		return nil, tournament.ErrInvalidSeedingSource
+	}
+
+	source, err := s.sourcePlacements(ctx, src.ID)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := s.teamRepo.GetByTournamentID(ctx, t.ID)
+	if err != nil {
+		return nil, err
+	}
+	seedTeams := make([]tournament.SeedTeam, 0, len(teams))
+	for _, tm := range teams {
+		if tm.Status != team.StatusDisbanded {
+			seedTeams = append(seedTeams, tournament.SeedTeam{ID: tm.ID, Name: tm.Name, MemberIDs: tm.MemberIDs})
+		}
+	}
+
+	now := time.Now().UTC()
+	t.Seeding = tournament.NewSeeding(src.ID, source, seedTeams, requesterID, now)
+	t.UpdatedAt = now
+	if err := s.tournamentRepo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+	return seedingResponse(t.Seeding), nil
+}
+
+// ResolveSeed sets the source team of one of the seeded teams, confirming or
+// correcting the roster match. Only the tournament's organizers and admins
+// may resolve seeds.
+func (s *Service) ResolveSeed(ctx context.Context, id, teamID uuid.UUID, req ResolveSeedRequest, requesterID uuid.UUID, isAdmin bool) (*SeedingResponse, error) {
+	t, err := s.tournamentRepo.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.requireOrganizer(ctx, t, requesterID, isAdmin); err != nil {
+		return nil, err
+	}
+	if t.Seeding == nil {
+		return nil, tournament.ErrSeedingNotFound
+	}
+	if !t.CanReseed() {
+		return nil, tournament.ErrSeedingLocked
+	}
+
+	tm, err := s.teamRepo.GetByID(ctx, teamID)
+	if err != nil {
+		return nil, err
+	}
+	if tm.TournamentID != t.ID {
+		return nil, tournament.ErrSeedTeamNotFound
+	}
+
+	now := time.Now().UTC()
+	if err := t.Seeding.Resolve(tm.ID, tm.MemberIDs, req.SourceTeamID, now); err != nil {
+		return nil, err
+	}
+	t.UpdatedAt = now
+	if err := s.tournamentRepo.Update(ctx, t); err != nil {
+		return nil, err
+	}
+	return seedingResponse(t.Seeding), nil
+}
+
+// sourcePlacements ranks a finished tournament's teams by their verified
+// matches, with the rosters they finished with.
+func (s *Service) sourcePlacements(ctx context.Context, id uuid.UUID) ([]tournament.SourcePlacement, error) {
+	teams, err := s.teamRepo.GetByTournamentID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	byID := make(map[uuid.UUID]*team.Team, len(teams))
+	for _, tm := range teams {
+		byID[tm.ID] = tm
+	}
+
+	matches, err := s.matchRepo.GetByTournament(ctx, id.String(), "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	placements := []tournament.SourcePlacement{}
+	for _, st := range match.TeamStandings(matches) {
+		tm, ok := byID[st.TeamID]
+		if !ok {
+			continue
+		}
+		placements = append(placements, tournament.SourcePlacement{TeamID: tm.ID, TeamName: tm.Name, Rank: st.Rank, MemberIDs: tm.MemberIDs})
+	}
+	return placements, nil
+}
+
+func seedingResponse(seeding *tournament.Seeding) *SeedingResponse {
+	return &SeedingResponse{Seeding: seeding, Pending: seeding.Pending()}
+}
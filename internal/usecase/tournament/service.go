@@ -3,40 +3,89 @@ package tournament
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/alejaam/tourney-rank/internal/domain/activity"
 	"github.com/alejaam/tourney-rank/internal/domain/game"
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/player"
 	"github.com/alejaam/tourney-rank/internal/domain/team"
 	"github.com/alejaam/tourney-rank/internal/domain/tournament"
+	"github.com/alejaam/tourney-rank/internal/domain/user"
+	"github.com/alejaam/tourney-rank/internal/usecase/conduct"
+	"github.com/alejaam/tourney-rank/internal/usecase/organization"
+	"github.com/alejaam/tourney-rank/internal/usecase/pagination"
 	"github.com/google/uuid"
 )
 
 // Service handles tournament use cases.
 type Service struct {
-	tournamentRepo tournament.Repository
-	teamRepo       team.Repository
-	gameRepo       game.Repository
+	tournamentRepo  tournament.Repository
+	teamRepo        team.Repository
+	gameRepo        game.Repository
+	playerStatsRepo player.StatsRepository
+	matchRepo       match.Repository
+	analytics       analyticsCache
+	activity        activity.Repository
+	siteFeed        activity.SiteFeedRepository
+	exports         tournament.ExportRepository
+	exportKey       []byte
+	players         player.Repository
+	users           user.Repository
+	snapshots       tournament.Snapshotter
+	backups         tournament.BackupRepository
+	backupStore     BackupStore
+	rulebooks       tournament.RulebookRepository
+	profiles        player.Repository
+	overlaps        team.RosterOverlapRepository
+	conduct         *conduct.Service
+	organizations   *organization.Service
 }
 
 // NewService creates a new tournament service.
-func NewService(tournamentRepo tournament.Repository, teamRepo team.Repository, gameRepo game.Repository) *Service {
+func NewService(tournamentRepo tournament.Repository, teamRepo team.Repository, gameRepo game.Repository, playerStatsRepo player.StatsRepository, matchRepo match.Repository) *Service {
 	return &Service{
-		tournamentRepo: tournamentRepo,
-		teamRepo:       teamRepo,
-		gameRepo:       gameRepo,
+		tournamentRepo:  tournamentRepo,
+		teamRepo:        teamRepo,
+		gameRepo:        gameRepo,
+		playerStatsRepo: playerStatsRepo,
+		matchRepo:       matchRepo,
 	}
 }
 
+// WithActivityLog records final tournament results in the players' activity feeds.
+func (s *Service) WithActivityLog(repo activity.Repository) *Service {
+	s.activity = repo
+	return s
+}
+
+// WithSiteFeed publishes finished tournaments to the public site-wide activity feed.
+func (s *Service) WithSiteFeed(repo activity.SiteFeedRepository) *Service {
+	s.siteFeed = repo
+	return s
+}
+
+// WithPlayerProfiles lets tournament discovery default to the viewer's profile language.
+func (s *Service) WithPlayerProfiles(players player.Repository) *Service {
+	s.profiles = players
+	return s
+}
+
 // CreateTournamentRequest represents the request to create a tournament.
 type CreateTournamentRequest struct {
 	GameID      uuid.UUID           `json:"game_id"`
 	Name        string              `json:"name"`
 	Description string              `json:"description"`
-	TeamSize    tournament.TeamSize `json:"team_size"`
+	TeamSize    tournament.TeamSize `json:"team_size"`             // Players fielded in each match
+	RosterSize  int                 `json:"roster_size,omitempty"` // Players a team may register; defaults to TeamSize
 	StartDate   time.Time           `json:"start_date"`
 	EndDate     time.Time           `json:"end_date"`
 	PrizePool   string              `json:"prize_pool,omitempty"`
 	BannerURL   string              `json:"banner_url,omitempty"`
+	Languages   []string            `json:"languages,omitempty"`
 	Rules       tournament.Rules    `json:"rules"`
 }
 
@@ -48,6 +97,8 @@ type UpdateTournamentRequest struct {
 	EndDate     *time.Time        `json:"end_date,omitempty"`
 	PrizePool   *string           `json:"prize_pool,omitempty"`
 	BannerURL   *string           `json:"banner_url,omitempty"`
+	Languages   *[]string         `json:"languages,omitempty"`
+	RosterSize  *int              `json:"roster_size,omitempty"`
 	Rules       *tournament.Rules `json:"rules,omitempty"`
 }
 
@@ -56,36 +107,43 @@ type UpdateTournamentStatusRequest struct {
 	Status tournament.Status `json:"status"`
 }
 
+// AnyLanguage as the Language of a listing turns off the viewer's default language.
+const AnyLanguage = "any"
+
 // ListTournamentsRequest represents the request to list tournaments.
+// Language is a language code or name; when it is empty and ViewerID is
+// set, the viewer's profile language is used.
 type ListTournamentsRequest struct {
 	GameID    *uuid.UUID         `json:"game_id,omitempty"`
 	Status    *tournament.Status `json:"status,omitempty"`
 	CreatedBy *uuid.UUID         `json:"created_by,omitempty"`
+	Language  string             `json:"language,omitempty"`
+	ViewerID  *uuid.UUID         `json:"-"`
 	Limit     int                `json:"limit"`
 	Offset    int                `json:"offset"`
 }
 
 // TournamentListResponse represents a paginated list of tournaments.
+// Language is the language code the list was filtered by, if any.
 type TournamentListResponse struct {
-	Tournaments []*tournament.Tournament `json:"tournaments"`
-	Total       int64                    `json:"total"`
-	Limit       int                      `json:"limit"`
-	Offset      int                      `json:"offset"`
+	pagination.Page[*tournament.Tournament]
+	Language string `json:"language,omitempty"`
 }
 
 // TournamentStats represents statistics for a tournament.
 type TournamentStats struct {
-	TournamentID uuid.UUID `json:"tournament_id"`
-	TotalTeams   int64     `json:"total_teams"`
-	ActiveTeams  int64     `json:"active_teams"`
-	TotalMatches int64     `json:"total_matches"`
-	TotalPlayers int64     `json:"total_players"`
+	TournamentID uuid.UUID        `json:"tournament_id"`
+	TotalTeams   int64            `json:"total_teams"`
+	ActiveTeams  int64            `json:"active_teams"`
+	TotalMatches int64            `json:"total_matches"`
+	TotalPlayers int64            `json:"total_players"`
+	MapBreakdown []match.MapStats `json:"map_breakdown"`
 }
 
 // CreateTournament creates a new tournament.
 func (s *Service) CreateTournament(ctx context.Context, req CreateTournamentRequest, createdBy uuid.UUID) (*tournament.Tournament, error) {
 	// Validate game exists
-	_, err := s.gameRepo.GetByID(ctx, req.GameID.String())
+	g, err := s.gameRepo.GetByID(ctx, req.GameID.String())
 	if err != nil {
 		return nil, err
 	}
@@ -94,12 +152,26 @@ func (s *Service) CreateTournament(ctx context.Context, req CreateTournamentRequ
 	if err != nil {
 		return nil, err
 	}
+	if err := g.ValidateTeamSize(int(t.TeamSize)); err != nil {
+		return nil, err
+	}
+	if err := t.SetRosterSize(req.RosterSize); err != nil {
+		return nil, err
+	}
 
 	t.Description = req.Description
 	t.PrizePool = req.PrizePool
 	t.BannerURL = req.BannerURL
 	t.Rules = req.Rules
 
+	if err := t.SetLanguages(req.Languages); err != nil {
+		return nil, err
+	}
+
+	if err := t.Rules.Validate(); err != nil {
+		return nil, err
+	}
+
 	if err := s.tournamentRepo.Create(ctx, t); err != nil {
 		return nil, err
 	}
@@ -132,8 +204,23 @@ func (s *Service) UpdateTournament(ctx context.Context, id uuid.UUID, req Update
 	if req.BannerURL != nil {
 		t.BannerURL = *req.BannerURL
 	}
+	if req.Languages != nil {
+		if err := t.SetLanguages(*req.Languages); err != nil {
+			return nil, err
+		}
+	}
+	if req.RosterSize != nil {
+		if err := t.SetRosterSize(*req.RosterSize); err != nil {
+			return nil, err
+		}
+	}
 	if req.Rules != nil {
+		if err := req.Rules.Validate(); err != nil {
+			return nil, err
+		}
 		t.Rules = *req.Rules
+		// A raised team cap frees invitation slots for reserves.
+		t.ReallocateInvites(time.Now().UTC())
 	}
 
 	t.UpdatedAt = time.Now().UTC()
@@ -156,10 +243,30 @@ func (s *Service) UpdateTournamentStatus(ctx context.Context, id uuid.UUID, req
 		return nil, err
 	}
 
+	// Rosters lock when the tournament goes active; re-validate score caps
+	// since member rankings may have changed since they joined.
+	if t.Status == tournament.StatusActive {
+		if err := s.validateRosterScoreCaps(ctx, t); err != nil {
+			return nil, err
+		}
+	}
+
 	if err := s.tournamentRepo.Update(ctx, t); err != nil {
 		return nil, err
 	}
 
+	if t.Status == tournament.StatusOpen && s.organizations != nil {
+		if err := s.organizations.QualifyFinals(ctx, t); err != nil {
+			return nil, fmt.Errorf("qualify finals: %w", err)
+		}
+	}
+
+	if t.Status == tournament.StatusFinished {
+		if err := s.recordResults(ctx, t); err != nil {
+			return nil, fmt.Errorf("record activity: %w", err)
+		}
+	}
+
 	return t, nil
 }
 
@@ -170,10 +277,16 @@ func (s *Service) GetTournament(ctx context.Context, id uuid.UUID) (*tournament.
 
 // ListTournaments lists tournaments with optional filtering.
 func (s *Service) ListTournaments(ctx context.Context, req ListTournamentsRequest) (*TournamentListResponse, error) {
+	language, err := s.listLanguage(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
 	filter := tournament.ListFilter{
 		GameID:    req.GameID,
 		Status:    req.Status,
 		CreatedBy: req.CreatedBy,
+		Language:  language,
 		Limit:     req.Limit,
 		Offset:    req.Offset,
 	}
@@ -182,17 +295,44 @@ func (s *Service) ListTournaments(ctx context.Context, req ListTournamentsReques
 	if err != nil {
 		return nil, err
 	}
+	total, err := s.tournamentRepo.Count(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
 
-	// For simplicity, not implementing total count here
-	// In a real implementation, you'd add a Count method to the repository
 	return &TournamentListResponse{
-		Tournaments: tournaments,
-		Total:       int64(len(tournaments)),
-		Limit:       req.Limit,
-		Offset:      req.Offset,
+		Page:     pagination.New(tournaments, total, int64(req.Limit), int64(req.Offset)),
+		Language: language,
 	}, nil
 }
 
+// listLanguage resolves the language code a listing is filtered by. A viewer
+// whose profile language is unset or unsupported sees every tournament.
+func (s *Service) listLanguage(ctx context.Context, req ListTournamentsRequest) (string, error) {
+	switch {
+	case strings.EqualFold(req.Language, AnyLanguage):
+		return "", nil
+	case req.Language != "":
+		code, ok := tournament.LanguageCode(req.Language)
+		if !ok {
+			return "", tournament.ErrInvalidLanguage
+		}
+		return code, nil
+	case req.ViewerID == nil || s.profiles == nil:
+		return "", nil
+	}
+
+	p, err := s.profiles.GetByUserID(ctx, req.ViewerID.String())
+	if err != nil {
+		if errors.Is(err, player.ErrNotFound) {
+			return "", nil
+		}
+		return "", fmt.Errorf("get viewer profile: %w", err)
+	}
+	code, _ := tournament.LanguageCode(p.Language)
+	return code, nil
+}
+
 // GetActiveTournaments retrieves all active tournaments.
 func (s *Service) GetActiveTournaments(ctx context.Context) ([]*tournament.Tournament, error) {
 	return s.tournamentRepo.GetActiveTournaments(ctx)
@@ -252,11 +392,115 @@ func (s *Service) GetTournamentStats(ctx context.Context, id uuid.UUID) (*Tourna
 		totalPlayers += int64(t.MemberCount())
 	}
 
+	totalMatches, err := s.matchRepo.CountByTournament(ctx, id.String(), "")
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.matchRepo.GetByTournament(ctx, id.String(), "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
 	return &TournamentStats{
 		TournamentID: id,
 		TotalTeams:   totalTeams,
 		ActiveTeams:  activeTeams,
-		TotalMatches: 0, // Would need match repository to calculate
+		TotalMatches: int64(totalMatches),
 		TotalPlayers: totalPlayers,
+		MapBreakdown: match.TeamMapBreakdown(matches),
 	}, nil
 }
+
+// validateRosterScoreCaps checks every registered team against the tournament's team score cap.
+func (s *Service) validateRosterScoreCaps(ctx context.Context, t *tournament.Tournament) error {
+	if !t.Rules.HasTeamScoreCap() {
+		return nil
+	}
+
+	teams, err := s.teamRepo.GetByTournamentID(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, tm := range teams {
+		if tm.Status == team.StatusDisbanded {
+			continue
+		}
+
+		scores := make([]float64, 0, len(tm.MemberIDs))
+		for _, memberID := range tm.MemberIDs {
+			stats, err := s.playerStatsRepo.GetByPlayerAndGame(ctx, memberID, t.GameID)
+			if err != nil {
+				if errors.Is(err, player.ErrStatsNotFound) {
+					scores = append(scores, 0)
+					continue
+				}
+				return err
+			}
+			scores = append(scores, stats.RankingScore)
+		}
+
+		if t.Rules.ExceedsTeamScoreCap(scores) {
+			return fmt.Errorf("%w: team %q", tournament.ErrTeamScoreCapExceeded, tm.Name)
+		}
+	}
+
+	return nil
+}
+
+// recordResults logs each ranked team's final standing for its members and
+// announces the winner on the site-wide feed, for whichever of the two are
+// configured. Teams without verified matches are not ranked.
+func (s *Service) recordResults(ctx context.Context, t *tournament.Tournament) error {
+	if s.activity == nil && s.siteFeed == nil {
+		return nil
+	}
+
+	matches, err := s.matchRepo.GetByTournament(ctx, t.ID.String(), "", 0, 0)
+	if err != nil {
+		return err
+	}
+
+	teams, err := s.teamRepo.GetByTournamentID(ctx, t.ID)
+	if err != nil {
+		return err
+	}
+	byID := make(map[uuid.UUID]*team.Team, len(teams))
+	for _, tm := range teams {
+		byID[tm.ID] = tm
+	}
+
+	standings := match.TeamStandings(matches)
+
+	if s.activity != nil {
+		var events []*activity.Event
+		for _, st := range standings {
+			tm, ok := byID[st.TeamID]
+			if !ok {
+				continue
+			}
+			for _, memberID := range tm.MemberIDs {
+				events = append(events, activity.NewTournamentResult(memberID, t.ID, t.GameID, tm.ID, tm.Name, st.Rank, len(standings)))
+			}
+		}
+		if err := s.activity.Create(ctx, events...); err != nil {
+			return err
+		}
+	}
+
+	if s.siteFeed != nil {
+		var winnerID *uuid.UUID
+		var winnerName string
+		if len(standings) > 0 {
+			if tm, ok := byID[standings[0].TeamID]; ok {
+				winnerID, winnerName = &tm.ID, tm.Name
+			}
+		}
+		if err := s.siteFeed.Append(ctx, activity.NewSiteTournamentFinished(t.ID, t.GameID, t.Name, winnerID, winnerName)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package tournament
+
+import (
+	"context"
+
+	"github.com/alejaam/tourney-rank/internal/domain/match"
+	"github.com/alejaam/tourney-rank/internal/domain/team"
+	"github.com/google/uuid"
+)
+
+// Standing is a team's position in a tournament together with its name.
+type Standing struct {
+	match.TeamStanding
+	TeamName string `json:"team_name"`
+}
+
+// StandingsResponse is a tournament's current standings.
+type StandingsResponse struct {
+	TournamentID uuid.UUID  `json:"tournament_id"`
+	Standings    []Standing `json:"standings"`
+}
+
+// GetStandings ranks a tournament's teams by their verified matches.
+func (s *Service) GetStandings(ctx context.Context, id uuid.UUID) (*StandingsResponse, error) {
+	if _, err := s.tournamentRepo.GetByID(ctx, id); err != nil {
+		return nil, err
+	}
+
+	teams, err := s.teamRepo.GetByTournamentID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	matches, err := s.matchRepo.GetByTournament(ctx, id.String(), "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StandingsResponse{TournamentID: id, Standings: standings(teams, matches)}, nil
+}
+
+// standings ranks teams by their verified matches and attaches team names.
+func standings(teams []*team.Team, matches []match.Match) []Standing {
+	names := make(map[uuid.UUID]string, len(teams))
+	for _, tm := range teams {
+		names[tm.ID] = tm.Name
+	}
+
+	ranked := match.TeamStandings(matches)
+	result := make([]Standing, 0, len(ranked))
+	for _, st := range ranked {
+		result = append(result, Standing{TeamStanding: st, TeamName: names[st.TeamID]})
+	}
+	return result
+}
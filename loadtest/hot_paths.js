@@ -0,0 +1,151 @@
+// k6 load profile for the API's hot paths: the public leaderboard, match
+// submission and admin verification. The request bodies follow the contract
+// fixtures in internal/infra/http/testdata/contract, so a change that breaks
+// them breaks the contract suite first.
+//
+// Run against a seeded environment (never production: it submits and verifies
+// real matches):
+//
+//   k6 run \
+//     -e BASE_URL=http://localhost:8080 \
+//     -e GAME_ID=... -e TOURNAMENT_ID=... -e TEAM_ID=... \
+//     -e CAPTAIN_EMAIL=... -e CAPTAIN_PASSWORD=... \
+//     -e ADMIN_EMAIL=... -e ADMIN_PASSWORD=... \
+//     loadtest/hot_paths.js
+//
+// The team must belong to the active tournament and be captained by the
+// captain account. Thresholds are the regression gate: k6 exits non-zero when
+// one is crossed. See docs/PERFORMANCE.md.
+
+import http from 'k6/http';
+import { check, fail } from 'k6';
+
+const BASE_URL = __ENV.BASE_URL || 'http://localhost:8080';
+const JSON_HEADERS = { 'Content-Type': 'application/json' };
+
+export const options = {
+  scenarios: {
+    leaderboard: {
+      executor: 'constant-arrival-rate',
+      exec: 'leaderboard',
+      rate: Number(__ENV.LEADERBOARD_RPS || 200),
+      timeUnit: '1s',
+      duration: __ENV.DURATION || '2m',
+      preAllocatedVUs: 50,
+      maxVUs: 200,
+    },
+    submit: {
+      executor: 'constant-arrival-rate',
+      exec: 'submit',
+      rate: Number(__ENV.SUBMIT_RPS || 10),
+      timeUnit: '1s',
+      duration: __ENV.DURATION || '2m',
+      preAllocatedVUs: 10,
+      maxVUs: 50,
+    },
+    verify: {
+      executor: 'constant-arrival-rate',
+      exec: 'verify',
+      rate: Number(__ENV.VERIFY_RPS || 5),
+      timeUnit: '1s',
+      duration: __ENV.DURATION || '2m',
+      preAllocatedVUs: 10,
+      maxVUs: 50,
+    },
+  },
+  thresholds: {
+    'http_req_failed': ['rate<0.01'],
+    'http_req_duration{name:leaderboard}': ['p(95)<150', 'p(99)<300'],
+    'http_req_duration{name:submit_match}': ['p(95)<250', 'p(99)<500'],
+    'http_req_duration{name:verify_match}': ['p(95)<300', 'p(99)<600'],
+  },
+};
+
+function login(email, password) {
+  const res = http.post(`${BASE_URL}/api/v1/auth/login`, JSON.stringify({ email, password }), {
+    headers: JSON_HEADERS,
+    tags: { name: 'login' },
+  });
+  if (res.status !== 200) {
+    fail(`login as ${email}: status ${res.status}`);
+  }
+  return res.json('token');
+}
+
+function env(name) {
+  const value = __ENV[name];
+  if (!value) {
+    fail(`${name} is required`);
+  }
+  return value;
+}
+
+export function setup() {
+  const captain = login(env('CAPTAIN_EMAIL'), env('CAPTAIN_PASSWORD'));
+  const admin = login(env('ADMIN_EMAIL'), env('ADMIN_PASSWORD'));
+
+  const teamID = env('TEAM_ID');
+  const team = http.get(`${BASE_URL}/api/v1/teams/${teamID}`, { tags: { name: 'setup' } });
+  if (team.status !== 200) {
+    fail(`team ${teamID}: status ${team.status}`);
+  }
+
+  return {
+    captain,
+    admin,
+    gameID: env('GAME_ID'),
+    tournamentID: env('TOURNAMENT_ID'),
+    teamID,
+    memberIDs: team.json('member_ids'),
+  };
+}
+
+function auth(token, name) {
+  return { headers: Object.assign({ Authorization: `Bearer ${token}` }, JSON_HEADERS), tags: { name } };
+}
+
+function randomInt(max) {
+  return Math.floor(Math.random() * max);
+}
+
+function submitMatch(data, name) {
+  const playerStats = data.memberIDs.map((id) => {
+    const kills = randomInt(12);
+    return { player_id: id, kills, damage: kills * 250 + randomInt(500), assists: randomInt(5), deaths: randomInt(3), downs: randomInt(8) };
+  });
+  const body = {
+    tournament_id: data.tournamentID,
+    team_id: data.teamID,
+    game_id: data.gameID,
+    team_placement: 1 + randomInt(20),
+    team_kills: playerStats.reduce((sum, line) => sum + line.kills, 0),
+    player_stats: playerStats,
+    screenshot_url: 'https://example.com/loadtest.png',
+  };
+  return http.post(`${BASE_URL}/api/v1/matches/report`, JSON.stringify(body), auth(data.captain, name));
+}
+
+export function leaderboard(data) {
+  const res = http.get(`${BASE_URL}/api/v1/leaderboard/${data.gameID}?limit=50`, { tags: { name: 'leaderboard' } });
+  check(res, { 'leaderboard 200': (r) => r.status === 200 });
+}
+
+export function submit(data) {
+  const res = submitMatch(data, 'submit_match');
+  check(res, { 'submit 201': (r) => r.status === 201 });
+}
+
+// verify submits its own draft so concurrent iterations never race for the
+// same match; only the verification request is measured under verify_match.
+export function verify(data) {
+  const draft = submitMatch(data, 'submit_for_verify');
+  if (!check(draft, { 'draft 201': (r) => r.status === 201 })) {
+    return;
+  }
+  const res = http.patch(
+    `${BASE_URL}/api/v1/admin/matches/${draft.json('id')}/verify`,
+    JSON.stringify({ approved: true }),
+    auth(data.admin, 'verify_match'),
+  );
+  check(res, { 'verify 200': (r) => r.status === 200 });
+}